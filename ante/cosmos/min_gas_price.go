@@ -2,22 +2,22 @@ package cosmos
 
 import (
 	"fmt"
-	"math/big"
 	"slices"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
-	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // MinGasPriceDecorator will check if the transaction's fee is at least as large
-// as the MinGasPrices param. If fee is too low, decorator returns error and tx
-// is rejected. This applies for both CheckTx and DeliverTx
+// as the MinGasPrices param, translated from the EVM gas price into the EVM coin
+// denom and the Cosmos gas, so that Cosmos txs can't undercut the fee floor of
+// the EVM txs. If fee is too low, decorator returns error and tx is rejected.
+// This applies for both CheckTx and DeliverTx
 // If fee is high enough, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
 type MinGasPriceDecorator struct {
@@ -37,7 +37,9 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
 	}
 
-	minGasPrice := mpd.feemarketKeeper.GetParams(ctx).MinGasPrice
+	// the global min gas price is the one of the EVM txs, with 18 decimals and
+	// per unit of EVM gas, translated below into the fee of the Cosmos tx
+	minGasPrice := mpd.evmKeeper.GetMinGasPrice(ctx)
 
 	feeCoins := feeTx.GetFee()
 	evmDenom := evmtypes.GetEVMCoinDenom()
@@ -56,26 +58,14 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return next(ctx, tx, simulate)
 	}
 
-	minGasPrices := sdk.DecCoins{
-		{
-			Denom:  evmDenom,
-			Amount: minGasPrice,
-		},
-	}
-
-	gas := feeTx.GetGas()
-
 	requiredFees := make(sdk.Coins, 0)
 
-	// Determine the required fees by multiplying each required minimum gas
-	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	gasLimit := math.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
-
-	for _, gp := range minGasPrices {
-		fee := gp.Amount.Mul(gasLimit).Ceil().RoundInt()
-		if fee.IsPositive() {
-			requiredFees = requiredFees.Add(sdk.Coin{Denom: gp.Denom, Amount: fee})
-		}
+	// Determine the required fee by translating the min gas price into the
+	// decimals of the EVM coin, and the gas limit into EVM gas, where
+	// fee = ceil(minGasPrice * evmGasLimit / conversionFactor).
+	fee := evmtypes.ConvertEVMGasPriceToCosmosFee(minGasPrice, feeTx.GetGas())
+	if fee.IsPositive() {
+		requiredFees = requiredFees.Add(sdk.Coin{Denom: evmDenom, Amount: fee})
 	}
 
 	// Fees not provided (or flag "auto"). Then use the base fee to make the check pass
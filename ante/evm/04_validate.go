@@ -88,8 +88,9 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	// the fee granter sponsors the fees of the eth tx
+	if authInfo.Fee.Payer != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
 	}
 
 	sigs := protoTx.Signatures
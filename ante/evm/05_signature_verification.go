@@ -2,14 +2,18 @@ package evm
 
 import (
 	"math/big"
+	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hashicorp/go-metrics"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -33,10 +37,8 @@ func NewEthSigVerificationDecorator(ek anteinterfaces.EVMKeeper) EthSigVerificat
 // won't see the error message.
 func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	evmParams := esvd.evmKeeper.GetParams(ctx)
-	ethCfg := evmtypes.GetEthChainConfig()
 	blockNum := big.NewInt(ctx.BlockHeight())
-	signer := ethtypes.MakeSigner(ethCfg, blockNum, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
-	allowUnprotectedTxs := evmParams.GetAllowUnprotectedTxs()
+	signer := evmtypes.MakeSigner(blockNum, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 
 	msgs := tx.GetMsgs()
 	if msgs == nil {
@@ -49,7 +51,7 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
 		}
 
-		err := SignatureVerification(msgEthTx, signer, allowUnprotectedTxs)
+		err := SignatureVerification(ctx, msgEthTx, signer, evmParams)
 		if err != nil {
 			return ctx, err
 		}
@@ -62,20 +64,21 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 // that the signer address matches the one defined on the message.
 // The function set the field from of the given message equal to the sender
 // computed from the signature of the Ethereum transaction.
+// Unprotected transactions are only accepted from the senders the evm params
+// allow them for, and their submissions are counted whether they are accepted
+// or not, so that the use of unprotected transactions can be audited. Only the
+// CheckTx of a submission counts it, not its rechecks, simulations and block
+// execution.
 func SignatureVerification(
+	ctx sdk.Context,
 	msg *evmtypes.MsgEthereumTx,
 	signer ethtypes.Signer,
-	allowUnprotectedTxs bool,
+	evmParams evmtypes.Params,
 ) error {
 	ethTx := msg.AsTransaction()
-	ethCfg := evmtypes.GetEthChainConfig()
 
-	if !allowUnprotectedTxs {
-		if !ethTx.Protected() {
-			return errorsmod.Wrapf(
-				errortypes.ErrNotSupported,
-				"rejected unprotected ethereum transaction; please sign your transaction according to EIP-155 to protect it against replay-attacks")
-		}
+	if ethTx.Protected() {
+		ethCfg := evmtypes.GetEthChainConfig()
 		if ethTx.ChainId().Uint64() != ethCfg.ChainID.Uint64() {
 			return errorsmod.Wrapf(
 				errortypes.ErrInvalidChainID,
@@ -86,5 +89,23 @@ func SignatureVerification(
 	if err := msg.VerifySender(signer); err != nil {
 		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "signature verification failed: %s", err.Error())
 	}
+
+	if !ethTx.Protected() {
+		allowed := evmParams.IsUnprotectedTxAllowed(common.BytesToAddress(msg.From))
+		if ctx.IsCheckTx() && !ctx.IsReCheckTx() && ctx.ExecMode() != sdk.ExecModeSimulate {
+			telemetry.IncrCounterWithLabels(
+				[]string{"tx", "ante", "unprotected_tx", "total"},
+				1,
+				[]metrics.Label{telemetry.NewLabel("allowed", strconv.FormatBool(allowed))},
+			)
+		}
+
+		if !allowed {
+			return errorsmod.Wrapf(
+				errortypes.ErrNotSupported,
+				"rejected unprotected ethereum transaction; please sign your transaction according to EIP-155 to protect it against replay-attacks")
+		}
+	}
+
 	return nil
 }
@@ -27,9 +27,54 @@ func VerifyAccountBalance(
 	from common.Address,
 	txData evmtypes.TxData,
 ) error {
+	account, err := verifySenderAccount(ctx, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// VerifySponsoredAccountBalance checks that the account balance is greater than the value
+// of a transaction whose fees are paid by a sponsor.
+// The account will be set to store if it doesn't exist, i.e. cannot be found on store.
+func VerifySponsoredAccountBalance(
+	ctx sdk.Context,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+) error {
+	account, err := verifySenderAccount(ctx, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if value := txData.GetValue(); value != nil && account.Balance.ToBig().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx value (%s < %s)", account.Balance, value,
+		)
+	}
+
+	return nil
+}
+
+// verifySenderAccount checks that the sender is an EOA and creates its account
+// if it doesn't exist.
+func verifySenderAccount(
+	ctx sdk.Context,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+) (*statedb.Account, error) {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
-		return errorsmod.Wrapf(
+		return nil, errorsmod.Wrapf(
 			errortypes.ErrInvalidType,
 			"the sender is not EOA: address %s", from,
 		)
@@ -41,9 +86,5 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
-		return errorsmod.Wrap(err, "failed to check sender balance")
-	}
-
-	return nil
+	return account, nil
 }
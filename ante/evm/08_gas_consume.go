@@ -13,6 +13,7 @@ import (
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // UpdateCumulativeGasWanted updates the cumulative gas wanted
@@ -60,6 +61,61 @@ func ConsumeFeesAndEmitEvent(
 	return nil
 }
 
+// ConsumeSponsoredFeesAndEmitEvent deducts the fees of the sender tx from the fee
+// allowance granted by the sponsor in x/feegrant and from the sponsor balance, and
+// emits the sponsorship event. The allowance can be granted to the sender, or to
+// the contract called by the tx to sponsor all of its callers.
+func ConsumeSponsoredFeesAndEmitEvent(
+	ctx sdktypes.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
+	fees sdktypes.Coins,
+	sponsor sdktypes.AccAddress,
+	from sdktypes.AccAddress,
+	to *common.Address,
+	msgs []sdktypes.Msg,
+) error {
+	// the allowances are spent in the bank denom, in which the fees are deducted
+	grantedFees := evmtypes.ConvertCoinsDenomToExtendedDenom(fees)
+
+	grantee := from
+	err := feegrantKeeper.UseGrantedFees(ctx, sponsor, grantee, grantedFees, msgs)
+	if err != nil && to != nil {
+		grantee = to.Bytes()
+		if feegrantKeeper.UseGrantedFees(ctx, sponsor, grantee, grantedFees, msgs) == nil {
+			err = nil
+		}
+	}
+	if err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", sponsor, from)
+	}
+
+	if err := deductFees(
+		ctx,
+		evmKeeper,
+		fees,
+		sponsor,
+	); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeTx,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
+			sdktypes.NewAttribute(sdktypes.AttributeKeyFeePayer, sponsor.String()),
+		),
+		sdktypes.NewEvent(
+			evmtypes.EventTypeFeeSponsorship,
+			sdktypes.NewAttribute(evmtypes.AttributeKeySponsor, common.BytesToAddress(sponsor).Hex()),
+			sdktypes.NewAttribute(evmtypes.AttributeKeySender, common.BytesToAddress(from).Hex()),
+			sdktypes.NewAttribute(evmtypes.AttributeKeyGrantee, common.BytesToAddress(grantee).Hex()),
+			sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
+		),
+	})
+	return nil
+}
+
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
 func deductFees(
 	ctx sdktypes.Context,
@@ -20,6 +20,8 @@ func IncrementNonce(
 	// we merged the nonce verification to nonce increment, so when tx includes multiple messages
 	// with same sender, they'll be accepted.
 	if txNonce != nonce {
+		// NOTE: the json-rpc converts this error into the geth nonce errors,
+		// so the format of the message must be kept.
 		return errorsmod.Wrapf(
 			errortypes.ErrInvalidSequence,
 			"invalid nonce; got %d, expected %d", txNonce, nonce,
@@ -0,0 +1,37 @@
+package evm
+
+import (
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SimulateExecution executes the eth txs of the cosmos tx, in order, against a
+// cache of the check state, after the fees are deducted and the nonces are
+// incremented, and returns an error if an execution fails, e.g. a transfer
+// exceeding the token balance of the sender. The state changes of the
+// executions are discarded.
+//
+// The execution has its own gas meter, the gas wanted by the tx is unchanged.
+func SimulateExecution(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	msgs []*evmtypes.MsgEthereumTx,
+) error {
+	cacheCtx, _ := ctx.CacheContext()
+
+	for _, msg := range msgs {
+		res, err := evmKeeper.ApplyTransaction(cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()), msg)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to simulate the tx execution")
+		}
+		if res.Failed() {
+			return errorsmod.Wrapf(evmtypes.ErrVMExecution, "simulated tx execution failed: %s", res.VmError)
+		}
+	}
+	return nil
+}
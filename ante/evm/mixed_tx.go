@@ -0,0 +1,128 @@
+package evm
+
+import (
+	"math/big"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// MixedTxDecorator handles the eth tx of a mixed tx, a cosmos tx combining a
+// single MsgEthereumTx with cosmos messages, which are executed atomically.
+//
+// The mixed tx is authenticated and pays its fees as a cosmos tx, its fees
+// cover the gas used by the eth tx, which must not pay fees itself. The eth tx
+// is still signed by the signer of the cosmos tx, with the sequence signed by
+// the cosmos tx as nonce, so that it can be served by the JSON-RPC and can't be
+// included in another tx.
+//
+// It must run after the signature verification and before the sequence
+// increment of the cosmos ante handler.
+type MixedTxDecorator struct {
+	accountKeeper anteinterfaces.AccountKeeper
+	evmKeeper     anteinterfaces.EVMKeeper
+}
+
+// NewMixedTxDecorator creates a new MixedTxDecorator
+func NewMixedTxDecorator(
+	accountKeeper anteinterfaces.AccountKeeper,
+	evmKeeper anteinterfaces.EVMKeeper,
+) MixedTxDecorator {
+	return MixedTxDecorator{
+		accountKeeper: accountKeeper,
+		evmKeeper:     evmKeeper,
+	}
+}
+
+// IsMixedTx returns true if the tx combines a MsgEthereumTx with cosmos
+// messages.
+func IsMixedTx(tx sdk.Tx) bool {
+	var ethMsgs, cosmosMsgs int
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			ethMsgs++
+		} else {
+			cosmosMsgs++
+		}
+	}
+	return ethMsgs > 0 && cosmosMsgs > 0
+}
+
+// AnteHandle validates the eth tx of the mixed tx and emits its tx hash event.
+func (mtd MixedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgs := tx.GetMsgs()
+
+	var ethMsg *evmtypes.MsgEthereumTx
+	for _, msg := range msgs {
+		if msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			if ethMsg != nil {
+				return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "a mixed tx can't contain more than 1 MsgEthereumTx")
+			}
+			ethMsg = msgEthTx
+		}
+	}
+	if ethMsg == nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "a mixed tx must contain a MsgEthereumTx")
+	}
+
+	txData, err := ethMsg.GetTxData()
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to unpack tx data any for tx")
+	}
+
+	evmParams := mtd.evmKeeper.GetBlockParams(ctx)
+	if err := ValidateMsg(evmParams, txData, ethMsg.GetFrom()); err != nil {
+		return ctx, err
+	}
+
+	signer := evmtypes.MakeSigner(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	if err := SignatureVerification(ctx, ethMsg, signer, evmParams); err != nil {
+		return ctx, err
+	}
+
+	if txData.Fee().Sign() != 0 {
+		return ctx, errorsmod.Wrap(
+			errortypes.ErrInvalidRequest,
+			"the eth tx of a mixed tx must have a zero gas price, its gas is paid by the cosmos tx fees",
+		)
+	}
+
+	gasTx, ok := tx.(authante.GasTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected GasTx", tx)
+	}
+	if txData.GetGas() > gasTx.GetGas() {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrOutOfGas,
+			"the eth tx gas limit %d exceeds the gas limit %d of the tx", txData.GetGas(), gasTx.GetGas(),
+		)
+	}
+
+	// the signature of the cosmos signer was verified, and its sequence isn't
+	// incremented yet
+	acc := mtd.accountKeeper.GetAccount(ctx, ethMsg.GetFrom())
+	if acc == nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s does not exist", ethMsg.GetFrom())
+	}
+	if txData.GetNonce() != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected %d", txData.GetNonce(), acc.GetSequence(),
+		)
+	}
+
+	mtd.evmKeeper.ResetTransientGasUsed(ctx)
+	mtd.evmKeeper.SetTransientMixedTx(ctx)
+	mtd.evmKeeper.SetTransientFeePayer(ctx, ethMsg.GetSender())
+	mtd.evmKeeper.SetTransientMsgCount(ctx, uint64(len(msgs)))
+
+	EmitTxHashEvent(ctx, ethMsg, mtd.evmKeeper.GetTxIndexTransient(ctx), 0)
+
+	return next(ctx, tx, simulate)
+}
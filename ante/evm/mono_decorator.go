@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"bytes"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -16,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // MonoDecorator is a single decorator that handles all the prechecks for
@@ -24,7 +26,11 @@ type MonoDecorator struct {
 	accountKeeper   anteinterfaces.AccountKeeper
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
+	feegrantKeeper  authante.FeegrantKeeper
 	maxGasWanted    uint64
+	simulateCheckTx bool
+	priorityFloor   int64
+	priorityCeiling int64
 }
 
 // NewEVMMonoDecorator creates the 'mono' decorator, that is used to run the ante handle logic
@@ -47,6 +53,31 @@ func NewEVMMonoDecorator(
 	}
 }
 
+// WithFeegrantKeeper enables the fee sponsorship of the EVM transactions, whose
+// fees are paid by the fee granter of the cosmos tx from the fee allowance it
+// granted to the sender or to the called contract.
+func (md MonoDecorator) WithFeegrantKeeper(feegrantKeeper authante.FeegrantKeeper) MonoDecorator {
+	md.feegrantKeeper = feegrantKeeper
+	return md
+}
+
+// WithCheckTxSimulation enables executing the EVM transactions against a cache
+// of the check state during CheckTx, to reject the ones whose execution fails
+// before they are included in a block. It isn't done on ReCheckTx.
+func (md MonoDecorator) WithCheckTxSimulation(enabled bool) MonoDecorator {
+	md.simulateCheckTx = enabled
+	return md
+}
+
+// WithPriorityBounds sets the floor and the ceiling of the mempool priority of
+// the EVM transactions, a zero ceiling is no ceiling. The priority is otherwise
+// derived from the effective tip paid after the base fee.
+func (md MonoDecorator) WithPriorityBounds(floor, ceiling int64) MonoDecorator {
+	md.priorityFloor = floor
+	md.priorityCeiling = ceiling
+	return md
+}
+
 // AnteHandle handles the entire decorator chain using a mono decorator.
 func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// 0. Basic validation of the transaction
@@ -61,8 +92,6 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 	}
 
-	evmDenom := evmtypes.GetEVMCoinDenom()
-
 	// 1. setup ctx
 	ctx, err = SetupContextAndResetTransientGas(ctx, tx, md.evmKeeper)
 	if err != nil {
@@ -70,24 +99,92 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	}
 
 	// 2. get utils
-	decUtils, err := NewMonoDecoratorUtils(ctx, md.evmKeeper)
+	decUtils := NewMonoDecoratorUtils(ctx, md.evmKeeper)
+
+	// the cosmos tx can batch the eth txs of a single sender, with contiguous
+	// nonces, which are executed atomically
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "expected at least 1 message")
+	}
+
+	ethMsgs := make([]*evmtypes.MsgEthereumTx, len(msgs))
+	txDatas := make([]evmtypes.TxData, len(msgs))
+	for i, msg := range msgs {
+		ethMsg, txData, err := evmtypes.UnpackEthMsg(msg)
+		if err != nil {
+			return ctx, err
+		}
+		if i > 0 && !bytes.Equal(ethMsg.GetFrom(), ethMsgs[0].GetFrom()) {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"the messages of the tx must have the same sender, got %s and %s",
+				common.BytesToAddress(ethMsgs[0].GetFrom()), common.BytesToAddress(ethMsg.GetFrom()),
+			)
+		}
+		ethMsgs[i] = ethMsg
+		txDatas[i] = txData
+	}
+
+	// the fees are paid by the fee granter of the cosmos tx, if any
+	sponsor, err := md.getSponsor(tx)
 	if err != nil {
 		return ctx, err
 	}
 
-	// NOTE: the protocol does not support multiple EVM messages currently so
-	// this loop will complete after the first message.
-	msgs := tx.GetMsgs()
-	if len(msgs) != 1 {
-		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "expected 1 message, got %d", len(msgs))
+	for msgIndex, ethMsg := range ethMsgs {
+		if err := md.handleMsg(ctx, decUtils, msgs, msgIndex, ethMsg, txDatas[msgIndex], sponsor, simulate); err != nil {
+			return ctx, err
+		}
+	}
+
+	// the leftover gas is refunded to the fee payer after the execution
+	feePayer := ethMsgs[0].GetFrom()
+	if sponsor != nil {
+		feePayer = sponsor
+	}
+	md.evmKeeper.SetTransientFeePayer(ctx, common.BytesToAddress(feePayer))
+	md.evmKeeper.SetTransientMsgCount(ctx, uint64(len(ethMsgs)))
+
+	// 10. gas wanted
+	if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules().IsLondon); err != nil {
+		return ctx, err
 	}
-	msgIndex := 0
 
-	ethMsg, txData, err := evmtypes.UnpackEthMsg(msgs[msgIndex])
+	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
+		return ctx, err
+	}
+
+	priority := evmtypes.ClampTxPriority(decUtils.MinPriority, md.priorityFloor, md.priorityCeiling)
+	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, priority)
 	if err != nil {
 		return ctx, err
 	}
 
+	// 12. simulated execution
+	if md.simulateCheckTx && ctx.IsCheckTx() && !ctx.IsReCheckTx() && !simulate {
+		if err := SimulateExecution(ctx, md.evmKeeper, ethMsgs); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// handleMsg runs the checks of the eth tx of the message at the given index of
+// the cosmos tx, deducts its fees and increments the nonce of its sender.
+func (md MonoDecorator) handleMsg(
+	ctx sdk.Context,
+	decUtils *DecoratorUtils,
+	msgs []sdk.Msg,
+	msgIndex int,
+	ethMsg *evmtypes.MsgEthereumTx,
+	txData evmtypes.TxData,
+	sponsor sdk.AccAddress,
+	simulate bool,
+) error {
+	evmDenom := evmtypes.GetEVMCoinDenom()
+
 	feeAmt := txData.Fee()
 	gas := txData.GetGas()
 	fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
@@ -99,42 +196,48 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// 2. mempool inclusion fee
 	if ctx.IsCheckTx() && !simulate {
 		// FIX: Mempool dec should be converted
-		if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
-			return ctx, err
+		if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice(), gasLimit, decUtils.Rules().IsLondon); err != nil {
+			return err
 		}
 	}
 
-	if txData.TxType() == ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
+	baseFee, err := decUtils.BaseFee()
+	if err != nil {
+		return err
+	}
+
+	if txData.TxType() == ethtypes.DynamicFeeTxType && baseFee != nil {
 		// If the base fee is not empty, we compute the effective gas price
 		// according to current base fee price. The gas limit is specified
 		// by the user, while the price is given by the minimum between the
 		// max price paid for the entire tx, and the sum between the price
 		// for the tip and the base fee.
-		feeAmt = txData.EffectiveFee(decUtils.BaseFee)
+		feeAmt = txData.EffectiveFee(baseFee)
 		fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
 	}
 
 	// 3. min gas price (global min fee)
-	if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit); err != nil {
-		return ctx, err
+	if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice(), gasLimit); err != nil {
+		return err
 	}
 
 	// 4. validate msg contents
 	if err := ValidateMsg(
-		decUtils.EvmParams,
+		decUtils.EvmParams(),
 		txData,
 		ethMsg.GetFrom(),
 	); err != nil {
-		return ctx, err
+		return err
 	}
 
 	// 5. signature verification
 	if err := SignatureVerification(
+		ctx,
 		ethMsg,
-		decUtils.Signer,
-		decUtils.EvmParams.AllowUnprotectedTxs,
+		decUtils.Signer(),
+		decUtils.EvmParams(),
 	); err != nil {
-		return ctx, err
+		return err
 	}
 
 	from := ethMsg.GetFrom()
@@ -143,24 +246,28 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// 6. account balance verification
 	// We get the account with the balance from the EVM keeper because it is
 	// using a wrapper of the bank keeper as a dependency to scale all
-	// balances to 18 decimals.
-	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
+	// balances to 18 decimals. The auth account is kept to increment its nonce.
+	acc, account := md.evmKeeper.GetSenderAccount(ctx, fromAddr)
+	verifyAccountBalance := VerifyAccountBalance
+	if sponsor != nil {
+		verifyAccountBalance = VerifySponsoredAccountBalance
+	}
+	if err := verifyAccountBalance(
 		ctx,
 		md.accountKeeper,
 		account,
 		fromAddr,
 		txData,
 	); err != nil {
-		return ctx, err
+		return err
 	}
 
 	// 7. can transfer
-	coreMsg, err := ethMsg.AsMessage(decUtils.BaseFee)
+	coreMsg, err := ethMsg.AsMessage(baseFee)
 	if err != nil {
-		return ctx, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			err,
-			"failed to create an ethereum core.Message from signer %T", decUtils.Signer,
+			"failed to create an ethereum core.Message from signer %T", decUtils.Signer(),
 		)
 	}
 
@@ -168,35 +275,48 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		ctx,
 		md.evmKeeper,
 		*coreMsg,
-		decUtils.BaseFee,
-		decUtils.EvmParams,
-		decUtils.Rules.IsLondon,
+		baseFee,
+		decUtils.EvmParams(),
+		decUtils.Rules().IsLondon,
 	); err != nil {
-		return ctx, err
+		return err
 	}
 
 	// 8. gas consumption
 	msgFees, err := evmkeeper.VerifyFee(
 		txData,
 		evmDenom,
-		decUtils.BaseFee,
-		decUtils.Rules.IsHomestead,
-		decUtils.Rules.IsIstanbul,
-		decUtils.Rules.IsShanghai,
+		baseFee,
+		decUtils.Rules().IsHomestead,
+		decUtils.Rules().IsIstanbul,
+		decUtils.Rules().IsShanghai,
 		ctx.IsCheckTx(),
 	)
 	if err != nil {
-		return ctx, err
+		return err
 	}
 
-	err = ConsumeFeesAndEmitEvent(
-		ctx,
-		md.evmKeeper,
-		msgFees,
-		from,
-	)
+	if sponsor != nil {
+		err = ConsumeSponsoredFeesAndEmitEvent(
+			ctx,
+			md.evmKeeper,
+			md.feegrantKeeper,
+			msgFees,
+			sponsor,
+			from,
+			txData.GetTo(),
+			msgs,
+		)
+	} else {
+		err = ConsumeFeesAndEmitEvent(
+			ctx,
+			md.evmKeeper,
+			msgFees,
+			from,
+		)
+	}
 	if err != nil {
-		return ctx, err
+		return err
 	}
 
 	gasWanted := UpdateCumulativeGasWanted(
@@ -210,7 +330,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	minPriority := GetMsgPriority(
 		txData,
 		decUtils.MinPriority,
-		decUtils.BaseFee,
+		baseFee,
 	)
 	decUtils.MinPriority = minPriority
 
@@ -223,10 +343,13 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	decUtils.TxGasLimit += gas
 
 	// 9. increment sequence
-	acc := md.accountKeeper.GetAccount(ctx, from)
+	if acc == nil {
+		// the account was created by the balance verification
+		acc = md.accountKeeper.GetAccount(ctx, from)
+	}
 	if acc == nil {
 		// safety check: shouldn't happen
-		return ctx, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			errortypes.ErrUnknownAddress,
 			"account %s does not exist",
 			from,
@@ -234,26 +357,27 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	}
 
 	if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
-		return ctx, err
-	}
-
-	// 10. gas wanted
-	if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
-		return ctx, err
+		return err
 	}
 
 	// 11. emit events
 	txIdx := uint64(msgIndex) //nolint:gosec // G115
 	EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, txIdx)
 
-	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
-		return ctx, err
+	return nil
+}
+
+// getSponsor returns the fee granter of the cosmos tx, or nil if the tx fees
+// aren't sponsored.
+func (md MonoDecorator) getSponsor(tx sdk.Tx) (sdk.AccAddress, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.FeeGranter()) == 0 {
+		return nil, nil
 	}
 
-	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
-	if err != nil {
-		return ctx, err
+	if md.feegrantKeeper == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled for eth txs")
 	}
 
-	return next(ctx, tx, simulate)
+	return feeTx.FeeGranter(), nil
 }
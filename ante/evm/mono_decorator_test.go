@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
@@ -61,13 +62,38 @@ func (k *ExtendedEVMKeeper) GetBalance(ctx sdk.Context, addr common.Address) *ui
 	}
 	return uint256.NewInt(0)
 }
-func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context) {}
+
+func (k *ExtendedEVMKeeper) GetSenderAccount(ctx sdk.Context, addr common.Address) (sdk.AccountI, *statedb.Account) {
+	account := k.GetAccount(ctx, addr)
+	if account == nil {
+		return nil, nil
+	}
+	return &authtypes.BaseAccount{Address: sdk.AccAddress(addr.Bytes()).String(), Sequence: account.Nonce}, account
+}
+
+func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context)                  {}
+func (k *ExtendedEVMKeeper) SetTransientFeePayer(_ sdk.Context, _ common.Address) {}
+func (k *ExtendedEVMKeeper) SetTransientMsgCount(_ sdk.Context, _ uint64)         {}
+func (k *ExtendedEVMKeeper) SetTransientMixedTx(_ sdk.Context)                    {}
 func (k *ExtendedEVMKeeper) GetParams(_ sdk.Context) evmsdktypes.Params {
 	return evmsdktypes.DefaultParams()
 }
-func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int           { return big.NewInt(0) }
+func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int { return big.NewInt(0) }
+func (k *ExtendedEVMKeeper) GetBlockParams(ctx sdk.Context) evmsdktypes.Params {
+	return k.GetParams(ctx)
+}
+func (k *ExtendedEVMKeeper) GetBlockBaseFee(ctx sdk.Context) *big.Int { return k.GetBaseFee(ctx) }
+func (k *ExtendedEVMKeeper) GetBlockRules(ctx sdk.Context) params.Rules {
+	return evmsdktypes.GetEthChainConfig().Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+}
+func (k *ExtendedEVMKeeper) GetBlockMinGasPrice(ctx sdk.Context) math.LegacyDec {
+	return k.GetMinGasPrice(ctx)
+}
 func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
 func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64    { return 0 }
+func (k *ExtendedEVMKeeper) ApplyTransaction(_ sdk.Context, _ *evmsdktypes.MsgEthereumTx) (*evmsdktypes.MsgEthereumTxResponse, error) {
+	return &evmsdktypes.MsgEthereumTxResponse{}, nil
+}
 
 // only methods called by EVMMonoDecorator
 type MockFeeMarketKeeper struct{}
@@ -85,6 +111,8 @@ func (m MockFeeMarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec { return m
 // matches the actual signatures
 type MockAccountKeeper struct {
 	FundedAddr sdk.AccAddress
+	// EVMKeeper stores the nonces of the accounts set
+	EVMKeeper *ExtendedEVMKeeper
 }
 
 func (m MockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
@@ -93,7 +121,16 @@ func (m MockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sd
 	}
 	return nil
 }
-func (m MockAccountKeeper) SetAccount(_ context.Context, _ sdk.AccountI) {}
+func (m MockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	if m.EVMKeeper == nil {
+		return
+	}
+	addr := common.BytesToAddress(acc.GetAddress())
+	if account := m.EVMKeeper.GetAccount(sdk.Context{}, addr); account != nil {
+		account.Nonce = acc.GetSequence()
+		_ = m.EVMKeeper.SetAccount(sdk.Context{}, addr, *account)
+	}
+}
 func (m MockAccountKeeper) NewAccountWithAddress(_ context.Context, _ sdk.AccAddress) sdk.AccountI {
 	return nil
 }
@@ -168,7 +205,7 @@ func TestMonoDecorator(t *testing.T) {
 			"",
 		},
 		{
-			"failure with two evm txs",
+			"success with two evm txs",
 			true,
 			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
 				args1 := &evmsdktypes.EvmTxArgs{
@@ -188,7 +225,54 @@ func TestMonoDecorator(t *testing.T) {
 					signMsgEthereumTx(t, privKey, args2),
 				}
 			},
-			"expected 1 message, got 2",
+			"",
+		},
+		{
+			"failure with two evm txs with non contiguous nonces",
+			true,
+			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
+				args1 := &evmsdktypes.EvmTxArgs{
+					Nonce:    0,
+					GasLimit: 100000,
+					GasPrice: big.NewInt(1),
+					Input:    []byte("test"),
+				}
+				args2 := &evmsdktypes.EvmTxArgs{
+					Nonce:    2,
+					GasLimit: 100000,
+					GasPrice: big.NewInt(1),
+					Input:    []byte("test2"),
+				}
+				return []*evmsdktypes.MsgEthereumTx{
+					signMsgEthereumTx(t, privKey, args1),
+					signMsgEthereumTx(t, privKey, args2),
+				}
+			},
+			"invalid nonce; got 2, expected 1",
+		},
+		{
+			"failure with two evm txs from different senders",
+			true,
+			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
+				otherKey, _ := ethsecp256k1.GenerateKey()
+				args1 := &evmsdktypes.EvmTxArgs{
+					Nonce:    0,
+					GasLimit: 100000,
+					GasPrice: big.NewInt(1),
+					Input:    []byte("test"),
+				}
+				args2 := &evmsdktypes.EvmTxArgs{
+					Nonce:    0,
+					GasLimit: 100000,
+					GasPrice: big.NewInt(1),
+					Input:    []byte("test2"),
+				}
+				return []*evmsdktypes.MsgEthereumTx{
+					signMsgEthereumTx(t, privKey, args1),
+					signMsgEthereumTx(t, otherKey, args2),
+				}
+			},
+			"the messages of the tx must have the same sender",
 		},
 	}
 
@@ -196,7 +280,7 @@ func TestMonoDecorator(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			privKey, _ := ethsecp256k1.GenerateKey()
 			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
-			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
+			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr, EVMKeeper: keeper}
 
 			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, 0)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
@@ -18,65 +18,113 @@ import (
 
 // DecoratorUtils contain a bunch of relevant variables used for a variety of checks
 // throughout the verification of an Ethereum transaction.
+//
+// The values of the block are loaded on first use, from the keeper caches that
+// load them once per block, so that the checks that aren't run, e.g. the
+// mempool fee check out of CheckTx, don't load them.
 type DecoratorUtils struct {
-	EvmParams          evmtypes.Params
-	Rules              params.Rules
-	Signer             ethtypes.Signer
-	BaseFee            *big.Int
-	MempoolMinGasPrice sdkmath.LegacyDec
-	GlobalMinGasPrice  sdkmath.LegacyDec
-	BlockTxIndex       uint64
-	TxGasLimit         uint64
-	GasWanted          uint64
-	MinPriority        int64
-	TxFee              *big.Int
+	ctx sdk.Context
+	ek  anteinterfaces.EVMKeeper
+
+	evmParams          *evmtypes.Params
+	rules              *params.Rules
+	signer             ethtypes.Signer
+	baseFee            *big.Int
+	baseFeeLoaded      bool
+	mempoolMinGasPrice *sdkmath.LegacyDec
+	globalMinGasPrice  *sdkmath.LegacyDec
+
+	BlockTxIndex uint64
+	TxGasLimit   uint64
+	GasWanted    uint64
+	MinPriority  int64
+	TxFee        *big.Int
 }
 
 // NewMonoDecoratorUtils returns a new DecoratorUtils instance.
 //
-// These utilities are extracted once at the beginning of the ante handle process,
-// and are used throughout the entire decorator chain.
-// This avoids redundant calls to the keeper and thus improves speed of transaction processing.
+// These utilities are used throughout the entire decorator chain, the values of
+// the block are loaded once on first use. This avoids redundant calls to the
+// keeper and thus improves speed of transaction processing.
 //
-// All prices, fees and balances are converted into 18 decimals here
+// All prices, fees and balances are converted into 18 decimals
 // to be correctly used in the EVM.
 func NewMonoDecoratorUtils(
 	ctx sdk.Context,
 	ek anteinterfaces.EVMKeeper,
-) (*DecoratorUtils, error) {
-	evmParams := ek.GetParams(ctx)
-	ethCfg := evmtypes.GetEthChainConfig()
-	evmDenom := evmtypes.GetEVMCoinDenom()
-	blockHeight := big.NewInt(ctx.BlockHeight())
-	rules := ethCfg.Rules(blockHeight, true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
-	baseFee := ek.GetBaseFee(ctx)
-
-	if rules.IsLondon && baseFee == nil {
-		return nil, errorsmod.Wrap(
-			evmtypes.ErrInvalidBaseFee,
-			"base fee is supported but evm block context value is nil",
-		)
-	}
-
-	globalMinGasPrice := ek.GetMinGasPrice(ctx)
-
-	// Mempool gas price should be scaled to the 18 decimals representation.
-	// If it is already a 18 decimal token, this is a no-op.
-	mempoolMinGasPrice := evmtypes.ConvertAmountTo18DecimalsLegacy(ctx.MinGasPrices().AmountOf(evmDenom))
-
+) *DecoratorUtils {
 	return &DecoratorUtils{
-		EvmParams:          evmParams,
-		Rules:              rules,
-		Signer:             ethtypes.MakeSigner(ethCfg, blockHeight, uint64(ctx.BlockTime().Unix())), //#nosec G115 -- int overflow is not a concern here
-		BaseFee:            baseFee,
-		MempoolMinGasPrice: mempoolMinGasPrice,
-		GlobalMinGasPrice:  globalMinGasPrice,
-		BlockTxIndex:       ek.GetTxIndexTransient(ctx),
-		GasWanted:          0,
-		MinPriority:        int64(math.MaxInt64),
+		ctx:          ctx,
+		ek:           ek,
+		BlockTxIndex: ek.GetTxIndexTransient(ctx),
+		GasWanted:    0,
+		MinPriority:  int64(math.MaxInt64),
 		// TxGasLimit and TxFee are set to zero because they are updated
 		// summing up the values of all messages contained in a tx.
 		TxGasLimit: 0,
 		TxFee:      big.NewInt(0),
-	}, nil
+	}
+}
+
+// EvmParams returns the evm params of the block, they must not be modified.
+func (d *DecoratorUtils) EvmParams() evmtypes.Params {
+	if d.evmParams == nil {
+		evmParams := d.ek.GetBlockParams(d.ctx)
+		d.evmParams = &evmParams
+	}
+	return *d.evmParams
+}
+
+// Rules returns the chain rules of the block.
+func (d *DecoratorUtils) Rules() params.Rules {
+	if d.rules == nil {
+		rules := d.ek.GetBlockRules(d.ctx)
+		d.rules = &rules
+	}
+	return *d.rules
+}
+
+// Signer returns the tx signer of the block.
+func (d *DecoratorUtils) Signer() ethtypes.Signer {
+	if d.signer == nil {
+		d.signer = evmtypes.MakeSigner(big.NewInt(d.ctx.BlockHeight()), uint64(d.ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	}
+	return d.signer
+}
+
+// BaseFee returns the base fee of the block, nil before London.
+func (d *DecoratorUtils) BaseFee() (*big.Int, error) {
+	if !d.baseFeeLoaded {
+		baseFee := d.ek.GetBlockBaseFee(d.ctx)
+		if d.Rules().IsLondon && baseFee == nil {
+			return nil, errorsmod.Wrap(
+				evmtypes.ErrInvalidBaseFee,
+				"base fee is supported but evm block context value is nil",
+			)
+		}
+		d.baseFee = baseFee
+		d.baseFeeLoaded = true
+	}
+	return d.baseFee, nil
+}
+
+// MempoolMinGasPrice returns the min gas price of the node mempool.
+func (d *DecoratorUtils) MempoolMinGasPrice() sdkmath.LegacyDec {
+	if d.mempoolMinGasPrice == nil {
+		// Mempool gas price should be scaled to the 18 decimals representation.
+		// If it is already a 18 decimal token, this is a no-op.
+		evmDenom := evmtypes.GetEVMCoinDenom()
+		mempoolMinGasPrice := evmtypes.ConvertAmountTo18DecimalsLegacy(d.ctx.MinGasPrices().AmountOf(evmDenom))
+		d.mempoolMinGasPrice = &mempoolMinGasPrice
+	}
+	return *d.mempoolMinGasPrice
+}
+
+// GlobalMinGasPrice returns the min gas price of the fee market params.
+func (d *DecoratorUtils) GlobalMinGasPrice() sdkmath.LegacyDec {
+	if d.globalMinGasPrice == nil {
+		globalMinGasPrice := d.ek.GetBlockMinGasPrice(d.ctx)
+		d.globalMinGasPrice = &globalMinGasPrice
+	}
+	return *d.globalMinGasPrice
 }
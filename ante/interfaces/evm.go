@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
@@ -27,7 +28,13 @@ type EVMKeeper interface {
 		stateDB vm.StateDB) *vm.EVM
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
 	GetBalance(ctx sdk.Context, addr common.Address) *uint256.Int
+	// GetSenderAccount returns the auth account of the address together with
+	// its EVM account, reading the auth account once
+	GetSenderAccount(ctx sdk.Context, addr common.Address) (sdk.AccountI, *statedb.Account)
 	ResetTransientGasUsed(ctx sdk.Context)
+	SetTransientFeePayer(ctx sdk.Context, feePayer common.Address)
+	SetTransientMsgCount(ctx sdk.Context, count uint64)
+	SetTransientMixedTx(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
 	// GetBaseFee returns the BaseFee param from the fee market module
@@ -36,6 +43,14 @@ type EVMKeeper interface {
 	// GetMinGasPrice returns the MinGasPrice param from the fee market module
 	// adapted according to the evm denom decimals
 	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
+	// GetBlockParams, GetBlockBaseFee and GetBlockMinGasPrice return the same
+	// values, loaded from the stores once per block
+	GetBlockParams(ctx sdk.Context) evmtypes.Params
+	// GetBlockRules returns the chain rules of the block, derived once per block
+	GetBlockRules(ctx sdk.Context) params.Rules
+	GetBlockBaseFee(ctx sdk.Context) *big.Int
+	GetBlockMinGasPrice(ctx sdk.Context) math.LegacyDec
+	ApplyTransaction(ctx sdk.Context, msgEth *evmtypes.MsgEthereumTx) (*evmtypes.MsgEthereumTxResponse, error)
 }
 
 // FeeMarketKeeper exposes the required feemarket keeper interface required for ante handlers
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.3.0
+// - protoc-gen-go-grpc v1.6.2
 // - protoc             (unknown)
 // source: cosmos/evm/feemarket/v1/query.proto
 
@@ -15,25 +15,34 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName   = "/cosmos.evm.feemarket.v1.Query/Params"
-	Query_BaseFee_FullMethodName  = "/cosmos.evm.feemarket.v1.Query/BaseFee"
-	Query_BlockGas_FullMethodName = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_Params_FullMethodName          = "/cosmos.evm.feemarket.v1.Query/Params"
+	Query_BaseFee_FullMethodName         = "/cosmos.evm.feemarket.v1.Query/BaseFee"
+	Query_BaseFeeAt_FullMethodName       = "/cosmos.evm.feemarket.v1.Query/BaseFeeAt"
+	Query_BlockGas_FullMethodName        = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_SimulateBaseFee_FullMethodName = "/cosmos.evm.feemarket.v1.Query/SimulateBaseFee"
 )
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the gRPC querier service.
 type QueryClient interface {
 	// Params queries the parameters of x/feemarket module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// BaseFeeAt queries the base fee of a block height from the base fee history.
+	BaseFeeAt(ctx context.Context, in *QueryBaseFeeAtRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// SimulateBaseFee projects the base fees of the next blocks for a sequence of
+	// block utilizations under the current parameters.
+	SimulateBaseFee(ctx context.Context, in *QuerySimulateBaseFeeRequest, opts ...grpc.CallOption) (*QuerySimulateBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -45,8 +54,9 @@ func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
 }
 
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -54,8 +64,19 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 }
 
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryBaseFeeResponse)
-	err := c.cc.Invoke(ctx, Query_BaseFee_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_BaseFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFeeAt(ctx context.Context, in *QueryBaseFeeAtRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBaseFeeAtResponse)
+	err := c.cc.Invoke(ctx, Query_BaseFeeAt_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +84,19 @@ func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts
 }
 
 func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryBlockGasResponse)
-	err := c.cc.Invoke(ctx, Query_BlockGas_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_BlockGas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateBaseFee(ctx context.Context, in *QuerySimulateBaseFeeRequest, opts ...grpc.CallOption) (*QuerySimulateBaseFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySimulateBaseFeeResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateBaseFee_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -73,31 +105,48 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
-// for forward compatibility
+// for forward compatibility.
+//
+// Query defines the gRPC querier service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// BaseFeeAt queries the base fee of a block height from the base fee history.
+	BaseFeeAt(context.Context, *QueryBaseFeeAtRequest) (*QueryBaseFeeAtResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// SimulateBaseFee projects the base fees of the next blocks for a sequence of
+	// block utilizations under the current parameters.
+	SimulateBaseFee(context.Context, *QuerySimulateBaseFeeRequest) (*QuerySimulateBaseFeeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
-// UnimplementedQueryServer must be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
 
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+	return nil, status.Error(codes.Unimplemented, "method Params not implemented")
 }
 func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+	return nil, status.Error(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (UnimplementedQueryServer) BaseFeeAt(context.Context, *QueryBaseFeeAtRequest) (*QueryBaseFeeAtResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BaseFeeAt not implemented")
 }
 func (UnimplementedQueryServer) BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
+	return nil, status.Error(codes.Unimplemented, "method BlockGas not implemented")
+}
+func (UnimplementedQueryServer) SimulateBaseFee(context.Context, *QuerySimulateBaseFeeRequest) (*QuerySimulateBaseFeeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateBaseFee not implemented")
 }
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to QueryServer will
@@ -107,6 +156,13 @@ type UnsafeQueryServer interface {
 }
 
 func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call panics, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&Query_ServiceDesc, srv)
 }
 
@@ -146,6 +202,24 @@ func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseFeeAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeAt(ctx, req.(*QueryBaseFeeAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBlockGasRequest)
 	if err := dec(in); err != nil {
@@ -164,6 +238,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateBaseFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBaseFee(ctx, req.(*QuerySimulateBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -179,10 +271,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
 		},
+		{
+			MethodName: "BaseFeeAt",
+			Handler:    _Query_BaseFeeAt_Handler,
+		},
 		{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "SimulateBaseFee",
+			Handler:    _Query_SimulateBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
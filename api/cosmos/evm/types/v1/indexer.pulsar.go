@@ -22,6 +22,7 @@ var (
 	fd_TxResult_failed              protoreflect.FieldDescriptor
 	fd_TxResult_gas_used            protoreflect.FieldDescriptor
 	fd_TxResult_cumulative_gas_used protoreflect.FieldDescriptor
+	fd_TxResult_sender              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -34,6 +35,7 @@ func init() {
 	fd_TxResult_failed = md_TxResult.Fields().ByName("failed")
 	fd_TxResult_gas_used = md_TxResult.Fields().ByName("gas_used")
 	fd_TxResult_cumulative_gas_used = md_TxResult.Fields().ByName("cumulative_gas_used")
+	fd_TxResult_sender = md_TxResult.Fields().ByName("sender")
 }
 
 var _ protoreflect.Message = (*fastReflection_TxResult)(nil)
@@ -143,6 +145,12 @@ func (x *fastReflection_TxResult) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if len(x.Sender) != 0 {
+		value := protoreflect.ValueOfBytes(x.Sender)
+		if !f(fd_TxResult_sender, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -172,6 +180,8 @@ func (x *fastReflection_TxResult) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.GasUsed != uint64(0)
 	case "cosmos.evm.types.v1.TxResult.cumulative_gas_used":
 		return x.CumulativeGasUsed != uint64(0)
+	case "cosmos.evm.types.v1.TxResult.sender":
+		return len(x.Sender) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.types.v1.TxResult"))
@@ -202,6 +212,8 @@ func (x *fastReflection_TxResult) Clear(fd protoreflect.FieldDescriptor) {
 		x.GasUsed = uint64(0)
 	case "cosmos.evm.types.v1.TxResult.cumulative_gas_used":
 		x.CumulativeGasUsed = uint64(0)
+	case "cosmos.evm.types.v1.TxResult.sender":
+		x.Sender = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.types.v1.TxResult"))
@@ -239,6 +251,9 @@ func (x *fastReflection_TxResult) Get(descriptor protoreflect.FieldDescriptor) p
 	case "cosmos.evm.types.v1.TxResult.cumulative_gas_used":
 		value := x.CumulativeGasUsed
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.types.v1.TxResult.sender":
+		value := x.Sender
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.types.v1.TxResult"))
@@ -273,6 +288,8 @@ func (x *fastReflection_TxResult) Set(fd protoreflect.FieldDescriptor, value pro
 		x.GasUsed = value.Uint()
 	case "cosmos.evm.types.v1.TxResult.cumulative_gas_used":
 		x.CumulativeGasUsed = value.Uint()
+	case "cosmos.evm.types.v1.TxResult.sender":
+		x.Sender = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.types.v1.TxResult"))
@@ -307,6 +324,8 @@ func (x *fastReflection_TxResult) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field gas_used of message cosmos.evm.types.v1.TxResult is not mutable"))
 	case "cosmos.evm.types.v1.TxResult.cumulative_gas_used":
 		panic(fmt.Errorf("field cumulative_gas_used of message cosmos.evm.types.v1.TxResult is not mutable"))
+	case "cosmos.evm.types.v1.TxResult.sender":
+		panic(fmt.Errorf("field sender of message cosmos.evm.types.v1.TxResult is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.types.v1.TxResult"))
@@ -334,6 +353,8 @@ func (x *fastReflection_TxResult) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.evm.types.v1.TxResult.cumulative_gas_used":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.types.v1.TxResult.sender":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.types.v1.TxResult"))
@@ -424,6 +445,10 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 		if x.CumulativeGasUsed != 0 {
 			n += 1 + runtime.Sov(uint64(x.CumulativeGasUsed))
 		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -453,6 +478,13 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x42
+		}
 		if x.CumulativeGasUsed != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.CumulativeGasUsed))
 			i--
@@ -676,6 +708,40 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = append(x.Sender[:0], dAtA[iNdEx:postIndex]...)
+				if x.Sender == nil {
+					x.Sender = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -747,6 +813,9 @@ type TxResult struct {
 	// cumulative_gas_used specifies the cumulated amount of gas used for all
 	// processed messages within the current batch transaction.
 	CumulativeGasUsed uint64 `protobuf:"varint,7,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// sender is the address of the eth tx signer, stored so that it isn't
+	// recovered from the signature on every read
+	Sender []byte `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (x *TxResult) Reset() {
@@ -818,6 +887,13 @@ func (x *TxResult) GetCumulativeGasUsed() uint64 {
 	return 0
 }
 
+func (x *TxResult) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
 var File_cosmos_evm_types_v1_indexer_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_types_v1_indexer_proto_rawDesc = []byte{
@@ -825,7 +901,7 @@ var file_cosmos_evm_types_v1_indexer_proto_rawDesc = []byte{
 	0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe5,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfd,
 	0x01, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
 	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
 	0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
@@ -840,20 +916,21 @@ var file_cosmos_evm_types_v1_indexer_proto_rawDesc = []byte{
 	0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67,
 	0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63,
 	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64,
-	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x42, 0x0c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x43, 0x45, 0x54, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x45, 0x76, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45,
-	0x76, 0x6d, 0x3a, 0x3a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x42, 0xc4,
+	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31,
+	0x3b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x54, 0xaa, 0x02,
+	0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76,
+	0x6d, 0x5c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -152,14 +152,163 @@ func (x *_Params_9_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]string
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field UnprotectedTxsAllowlist as it is not of Message kind"))
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]*ScheduledEIP
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledEIP)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledEIP)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledEIP)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := new(ScheduledEIP)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_15_list)(nil)
+
+type _Params_15_list struct {
+	list *[]string
+}
+
+func (x *_Params_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_15_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field IbcCallbackContractsAllowlist as it is not of Message kind"))
+}
+
+func (x *_Params_15_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_15_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_15_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_evm_denom                 protoreflect.FieldDescriptor
-	fd_Params_extra_eips                protoreflect.FieldDescriptor
-	fd_Params_allow_unprotected_txs     protoreflect.FieldDescriptor
-	fd_Params_evm_channels              protoreflect.FieldDescriptor
-	fd_Params_access_control            protoreflect.FieldDescriptor
-	fd_Params_active_static_precompiles protoreflect.FieldDescriptor
+	md_Params                                  protoreflect.MessageDescriptor
+	fd_Params_evm_denom                        protoreflect.FieldDescriptor
+	fd_Params_extra_eips                       protoreflect.FieldDescriptor
+	fd_Params_allow_unprotected_txs            protoreflect.FieldDescriptor
+	fd_Params_evm_channels                     protoreflect.FieldDescriptor
+	fd_Params_access_control                   protoreflect.FieldDescriptor
+	fd_Params_active_static_precompiles        protoreflect.FieldDescriptor
+	fd_Params_evm_chain_id                     protoreflect.FieldDescriptor
+	fd_Params_unprotected_txs_allowlist        protoreflect.FieldDescriptor
+	fd_Params_scheduled_eips                   protoreflect.FieldDescriptor
+	fd_Params_empty_account_sweep_batch        protoreflect.FieldDescriptor
+	fd_Params_storage_expiry_blocks            protoreflect.FieldDescriptor
+	fd_Params_ibc_callback_contracts_allowlist protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -171,6 +320,12 @@ func init() {
 	fd_Params_evm_channels = md_Params.Fields().ByName("evm_channels")
 	fd_Params_access_control = md_Params.Fields().ByName("access_control")
 	fd_Params_active_static_precompiles = md_Params.Fields().ByName("active_static_precompiles")
+	fd_Params_evm_chain_id = md_Params.Fields().ByName("evm_chain_id")
+	fd_Params_unprotected_txs_allowlist = md_Params.Fields().ByName("unprotected_txs_allowlist")
+	fd_Params_scheduled_eips = md_Params.Fields().ByName("scheduled_eips")
+	fd_Params_empty_account_sweep_batch = md_Params.Fields().ByName("empty_account_sweep_batch")
+	fd_Params_storage_expiry_blocks = md_Params.Fields().ByName("storage_expiry_blocks")
+	fd_Params_ibc_callback_contracts_allowlist = md_Params.Fields().ByName("ibc_callback_contracts_allowlist")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -274,6 +429,42 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.EvmChainId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EvmChainId)
+		if !f(fd_Params_evm_chain_id, value) {
+			return
+		}
+	}
+	if len(x.UnprotectedTxsAllowlist) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.UnprotectedTxsAllowlist})
+		if !f(fd_Params_unprotected_txs_allowlist, value) {
+			return
+		}
+	}
+	if len(x.ScheduledEips) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.ScheduledEips})
+		if !f(fd_Params_scheduled_eips, value) {
+			return
+		}
+	}
+	if x.EmptyAccountSweepBatch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EmptyAccountSweepBatch)
+		if !f(fd_Params_empty_account_sweep_batch, value) {
+			return
+		}
+	}
+	if x.StorageExpiryBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StorageExpiryBlocks)
+		if !f(fd_Params_storage_expiry_blocks, value) {
+			return
+		}
+	}
+	if len(x.IbcCallbackContractsAllowlist) != 0 {
+		value := protoreflect.ValueOfList(&_Params_15_list{list: &x.IbcCallbackContractsAllowlist})
+		if !f(fd_Params_ibc_callback_contracts_allowlist, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -301,6 +492,18 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AccessControl != nil
 	case "cosmos.evm.vm.v1.Params.active_static_precompiles":
 		return len(x.ActiveStaticPrecompiles) != 0
+	case "cosmos.evm.vm.v1.Params.evm_chain_id":
+		return x.EvmChainId != uint64(0)
+	case "cosmos.evm.vm.v1.Params.unprotected_txs_allowlist":
+		return len(x.UnprotectedTxsAllowlist) != 0
+	case "cosmos.evm.vm.v1.Params.scheduled_eips":
+		return len(x.ScheduledEips) != 0
+	case "cosmos.evm.vm.v1.Params.empty_account_sweep_batch":
+		return x.EmptyAccountSweepBatch != uint64(0)
+	case "cosmos.evm.vm.v1.Params.storage_expiry_blocks":
+		return x.StorageExpiryBlocks != uint64(0)
+	case "cosmos.evm.vm.v1.Params.ibc_callback_contracts_allowlist":
+		return len(x.IbcCallbackContractsAllowlist) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -329,6 +532,18 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AccessControl = nil
 	case "cosmos.evm.vm.v1.Params.active_static_precompiles":
 		x.ActiveStaticPrecompiles = nil
+	case "cosmos.evm.vm.v1.Params.evm_chain_id":
+		x.EvmChainId = uint64(0)
+	case "cosmos.evm.vm.v1.Params.unprotected_txs_allowlist":
+		x.UnprotectedTxsAllowlist = nil
+	case "cosmos.evm.vm.v1.Params.scheduled_eips":
+		x.ScheduledEips = nil
+	case "cosmos.evm.vm.v1.Params.empty_account_sweep_batch":
+		x.EmptyAccountSweepBatch = uint64(0)
+	case "cosmos.evm.vm.v1.Params.storage_expiry_blocks":
+		x.StorageExpiryBlocks = uint64(0)
+	case "cosmos.evm.vm.v1.Params.ibc_callback_contracts_allowlist":
+		x.IbcCallbackContractsAllowlist = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -372,6 +587,33 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_9_list{list: &x.ActiveStaticPrecompiles}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.Params.evm_chain_id":
+		value := x.EvmChainId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Params.unprotected_txs_allowlist":
+		if len(x.UnprotectedTxsAllowlist) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.UnprotectedTxsAllowlist}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.Params.scheduled_eips":
+		if len(x.ScheduledEips) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.ScheduledEips}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.Params.empty_account_sweep_batch":
+		value := x.EmptyAccountSweepBatch
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Params.storage_expiry_blocks":
+		value := x.StorageExpiryBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Params.ibc_callback_contracts_allowlist":
+		if len(x.IbcCallbackContractsAllowlist) == 0 {
+			return protoreflect.ValueOfList(&_Params_15_list{})
+		}
+		listValue := &_Params_15_list{list: &x.IbcCallbackContractsAllowlist}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -410,6 +652,24 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_9_list)
 		x.ActiveStaticPrecompiles = *clv.list
+	case "cosmos.evm.vm.v1.Params.evm_chain_id":
+		x.EvmChainId = value.Uint()
+	case "cosmos.evm.vm.v1.Params.unprotected_txs_allowlist":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.UnprotectedTxsAllowlist = *clv.list
+	case "cosmos.evm.vm.v1.Params.scheduled_eips":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.ScheduledEips = *clv.list
+	case "cosmos.evm.vm.v1.Params.empty_account_sweep_batch":
+		x.EmptyAccountSweepBatch = value.Uint()
+	case "cosmos.evm.vm.v1.Params.storage_expiry_blocks":
+		x.StorageExpiryBlocks = value.Uint()
+	case "cosmos.evm.vm.v1.Params.ibc_callback_contracts_allowlist":
+		lv := value.List()
+		clv := lv.(*_Params_15_list)
+		x.IbcCallbackContractsAllowlist = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -453,10 +713,34 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_9_list{list: &x.ActiveStaticPrecompiles}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.Params.unprotected_txs_allowlist":
+		if x.UnprotectedTxsAllowlist == nil {
+			x.UnprotectedTxsAllowlist = []string{}
+		}
+		value := &_Params_11_list{list: &x.UnprotectedTxsAllowlist}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.Params.scheduled_eips":
+		if x.ScheduledEips == nil {
+			x.ScheduledEips = []*ScheduledEIP{}
+		}
+		value := &_Params_12_list{list: &x.ScheduledEips}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.Params.ibc_callback_contracts_allowlist":
+		if x.IbcCallbackContractsAllowlist == nil {
+			x.IbcCallbackContractsAllowlist = []string{}
+		}
+		value := &_Params_15_list{list: &x.IbcCallbackContractsAllowlist}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.evm.vm.v1.Params.evm_denom":
 		panic(fmt.Errorf("field evm_denom of message cosmos.evm.vm.v1.Params is not mutable"))
 	case "cosmos.evm.vm.v1.Params.allow_unprotected_txs":
 		panic(fmt.Errorf("field allow_unprotected_txs of message cosmos.evm.vm.v1.Params is not mutable"))
+	case "cosmos.evm.vm.v1.Params.evm_chain_id":
+		panic(fmt.Errorf("field evm_chain_id of message cosmos.evm.vm.v1.Params is not mutable"))
+	case "cosmos.evm.vm.v1.Params.empty_account_sweep_batch":
+		panic(fmt.Errorf("field empty_account_sweep_batch of message cosmos.evm.vm.v1.Params is not mutable"))
+	case "cosmos.evm.vm.v1.Params.storage_expiry_blocks":
+		panic(fmt.Errorf("field storage_expiry_blocks of message cosmos.evm.vm.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -486,6 +770,21 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cosmos.evm.vm.v1.Params.active_static_precompiles":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "cosmos.evm.vm.v1.Params.evm_chain_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Params.unprotected_txs_allowlist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
+	case "cosmos.evm.vm.v1.Params.scheduled_eips":
+		list := []*ScheduledEIP{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
+	case "cosmos.evm.vm.v1.Params.empty_account_sweep_batch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Params.storage_expiry_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Params.ibc_callback_contracts_allowlist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_15_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -585,6 +884,33 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.EvmChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EvmChainId))
+		}
+		if len(x.UnprotectedTxsAllowlist) > 0 {
+			for _, s := range x.UnprotectedTxsAllowlist {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ScheduledEips) > 0 {
+			for _, e := range x.ScheduledEips {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EmptyAccountSweepBatch != 0 {
+			n += 1 + runtime.Sov(uint64(x.EmptyAccountSweepBatch))
+		}
+		if x.StorageExpiryBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.StorageExpiryBlocks))
+		}
+		if len(x.IbcCallbackContractsAllowlist) > 0 {
+			for _, s := range x.IbcCallbackContractsAllowlist {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -614,6 +940,55 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.IbcCallbackContractsAllowlist) > 0 {
+			for iNdEx := len(x.IbcCallbackContractsAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.IbcCallbackContractsAllowlist[iNdEx])
+				copy(dAtA[i:], x.IbcCallbackContractsAllowlist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IbcCallbackContractsAllowlist[iNdEx])))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.StorageExpiryBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StorageExpiryBlocks))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.EmptyAccountSweepBatch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EmptyAccountSweepBatch))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.ScheduledEips) > 0 {
+			for iNdEx := len(x.ScheduledEips) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScheduledEips[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.UnprotectedTxsAllowlist) > 0 {
+			for iNdEx := len(x.UnprotectedTxsAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.UnprotectedTxsAllowlist[iNdEx])
+				copy(dAtA[i:], x.UnprotectedTxsAllowlist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnprotectedTxsAllowlist[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.EvmChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EvmChainId))
+			i--
+			dAtA[i] = 0x50
+		}
 		if len(x.ActiveStaticPrecompiles) > 0 {
 			for iNdEx := len(x.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ActiveStaticPrecompiles[iNdEx])
@@ -961,63 +1336,218 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.ActiveStaticPrecompiles = append(x.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmChainId", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.EvmChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EvmChainId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnprotectedTxsAllowlist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.UnprotectedTxsAllowlist = append(x.UnprotectedTxsAllowlist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledEips", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScheduledEips = append(x.ScheduledEips, &ScheduledEIP{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledEips[len(x.ScheduledEips)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmptyAccountSweepBatch", wireType)
+				}
+				x.EmptyAccountSweepBatch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EmptyAccountSweepBatch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageExpiryBlocks", wireType)
+				}
+				x.StorageExpiryBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StorageExpiryBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcCallbackContractsAllowlist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IbcCallbackContractsAllowlist = append(x.IbcCallbackContractsAllowlist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
 var (
-	md_AccessControl        protoreflect.MessageDescriptor
-	fd_AccessControl_create protoreflect.FieldDescriptor
-	fd_AccessControl_call   protoreflect.FieldDescriptor
+	md_ScheduledEIP        protoreflect.MessageDescriptor
+	fd_ScheduledEIP_eip    protoreflect.FieldDescriptor
+	fd_ScheduledEIP_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_AccessControl = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControl")
-	fd_AccessControl_create = md_AccessControl.Fields().ByName("create")
-	fd_AccessControl_call = md_AccessControl.Fields().ByName("call")
+	md_ScheduledEIP = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ScheduledEIP")
+	fd_ScheduledEIP_eip = md_ScheduledEIP.Fields().ByName("eip")
+	fd_ScheduledEIP_height = md_ScheduledEIP.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessControl)(nil)
+var _ protoreflect.Message = (*fastReflection_ScheduledEIP)(nil)
 
-type fastReflection_AccessControl AccessControl
+type fastReflection_ScheduledEIP ScheduledEIP
 
-func (x *AccessControl) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessControl)(x)
+func (x *ScheduledEIP) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledEIP)(x)
 }
 
-func (x *AccessControl) slowProtoReflect() protoreflect.Message {
+func (x *ScheduledEIP) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1029,43 +1559,43 @@ func (x *AccessControl) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessControl_messageType fastReflection_AccessControl_messageType
-var _ protoreflect.MessageType = fastReflection_AccessControl_messageType{}
+var _fastReflection_ScheduledEIP_messageType fastReflection_ScheduledEIP_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledEIP_messageType{}
 
-type fastReflection_AccessControl_messageType struct{}
+type fastReflection_ScheduledEIP_messageType struct{}
 
-func (x fastReflection_AccessControl_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessControl)(nil)
+func (x fastReflection_ScheduledEIP_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledEIP)(nil)
 }
-func (x fastReflection_AccessControl_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessControl)
+func (x fastReflection_ScheduledEIP_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledEIP)
 }
-func (x fastReflection_AccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControl
+func (x fastReflection_ScheduledEIP_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledEIP
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessControl) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControl
+func (x *fastReflection_ScheduledEIP) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledEIP
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessControl) Type() protoreflect.MessageType {
-	return _fastReflection_AccessControl_messageType
+func (x *fastReflection_ScheduledEIP) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledEIP_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessControl) New() protoreflect.Message {
-	return new(fastReflection_AccessControl)
+func (x *fastReflection_ScheduledEIP) New() protoreflect.Message {
+	return new(fastReflection_ScheduledEIP)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
-	return (*AccessControl)(x)
+func (x *fastReflection_ScheduledEIP) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledEIP)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1073,16 +1603,16 @@ func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Create != nil {
-		value := protoreflect.ValueOfMessage(x.Create.ProtoReflect())
-		if !f(fd_AccessControl_create, value) {
+func (x *fastReflection_ScheduledEIP) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Eip != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Eip)
+		if !f(fd_ScheduledEIP_eip, value) {
 			return
 		}
 	}
-	if x.Call != nil {
-		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
-		if !f(fd_AccessControl_call, value) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ScheduledEIP_height, value) {
 			return
 		}
 	}
@@ -1099,17 +1629,17 @@ func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ScheduledEIP) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		return x.Create != nil
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		return x.Call != nil
+	case "cosmos.evm.vm.v1.ScheduledEIP.eip":
+		return x.Eip != int64(0)
+	case "cosmos.evm.vm.v1.ScheduledEIP.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1119,17 +1649,17 @@ func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ScheduledEIP) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		x.Create = nil
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		x.Call = nil
+	case "cosmos.evm.vm.v1.ScheduledEIP.eip":
+		x.Eip = int64(0)
+	case "cosmos.evm.vm.v1.ScheduledEIP.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1139,19 +1669,19 @@ func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledEIP) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		value := x.Create
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		value := x.Call
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.ScheduledEIP.eip":
+		value := x.Eip
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.ScheduledEIP.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ScheduledEIP does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1165,17 +1695,17 @@ func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ScheduledEIP) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		x.Create = value.Message().Interface().(*AccessControlType)
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		x.Call = value.Message().Interface().(*AccessControlType)
+	case "cosmos.evm.vm.v1.ScheduledEIP.eip":
+		x.Eip = value.Int()
+	case "cosmos.evm.vm.v1.ScheduledEIP.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1189,52 +1719,44 @@ func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledEIP) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		if x.Create == nil {
-			x.Create = new(AccessControlType)
-		}
-		return protoreflect.ValueOfMessage(x.Create.ProtoReflect())
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		if x.Call == nil {
-			x.Call = new(AccessControlType)
-		}
-		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+	case "cosmos.evm.vm.v1.ScheduledEIP.eip":
+		panic(fmt.Errorf("field eip of message cosmos.evm.vm.v1.ScheduledEIP is not mutable"))
+	case "cosmos.evm.vm.v1.ScheduledEIP.height":
+		panic(fmt.Errorf("field height of message cosmos.evm.vm.v1.ScheduledEIP is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledEIP) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		m := new(AccessControlType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		m := new(AccessControlType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.ScheduledEIP.eip":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.ScheduledEIP.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ScheduledEIP"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ScheduledEIP does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ScheduledEIP) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControl", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ScheduledEIP", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1242,7 +1764,7 @@ func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ScheduledEIP) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1253,7 +1775,7 @@ func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ScheduledEIP) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1265,7 +1787,7 @@ func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessControl) IsValid() bool {
+func (x *fastReflection_ScheduledEIP) IsValid() bool {
 	return x != nil
 }
 
@@ -1275,9 +1797,9 @@ func (x *fastReflection_AccessControl) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ScheduledEIP) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*ScheduledEIP)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1289,13 +1811,11 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Create != nil {
-			l = options.Size(x.Create)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Eip != 0 {
+			n += 1 + runtime.Sov(uint64(x.Eip))
 		}
-		if x.Call != nil {
-			l = options.Size(x.Call)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1307,7 +1827,7 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*ScheduledEIP)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1326,33 +1846,15 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Call != nil {
-			encoded, err := options.Marshal(x.Call)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Create != nil {
-			encoded, err := options.Marshal(x.Create)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Eip != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Eip))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1365,7 +1867,7 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*ScheduledEIP)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1397,17 +1899,17 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledEIP: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledEIP: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip", wireType)
 				}
-				var msglen int
+				x.Eip = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1417,33 +1919,16 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Eip |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Create == nil {
-					x.Create = &AccessControlType{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Create); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var msglen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1453,28 +1938,11 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Call == nil {
-					x.Call = &AccessControlType{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1510,74 +1978,28 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_AccessControlType_2_list)(nil)
-
-type _AccessControlType_2_list struct {
-	list *[]string
-}
-
-func (x *_AccessControlType_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_AccessControlType_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_AccessControlType_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_AccessControlType_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_AccessControlType_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message AccessControlType at list field AccessControlList as it is not of Message kind"))
-}
-
-func (x *_AccessControlType_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_AccessControlType_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_AccessControlType_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_AccessControlType                     protoreflect.MessageDescriptor
-	fd_AccessControlType_access_type         protoreflect.FieldDescriptor
-	fd_AccessControlType_access_control_list protoreflect.FieldDescriptor
+	md_AccessControl        protoreflect.MessageDescriptor
+	fd_AccessControl_create protoreflect.FieldDescriptor
+	fd_AccessControl_call   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_AccessControlType = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControlType")
-	fd_AccessControlType_access_type = md_AccessControlType.Fields().ByName("access_type")
-	fd_AccessControlType_access_control_list = md_AccessControlType.Fields().ByName("access_control_list")
+	md_AccessControl = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControl")
+	fd_AccessControl_create = md_AccessControl.Fields().ByName("create")
+	fd_AccessControl_call = md_AccessControl.Fields().ByName("call")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessControlType)(nil)
+var _ protoreflect.Message = (*fastReflection_AccessControl)(nil)
 
-type fastReflection_AccessControlType AccessControlType
+type fastReflection_AccessControl AccessControl
 
-func (x *AccessControlType) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessControlType)(x)
+func (x *AccessControl) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessControl)(x)
 }
 
-func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
+func (x *AccessControl) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1589,43 +2011,43 @@ func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessControlType_messageType fastReflection_AccessControlType_messageType
-var _ protoreflect.MessageType = fastReflection_AccessControlType_messageType{}
+var _fastReflection_AccessControl_messageType fastReflection_AccessControl_messageType
+var _ protoreflect.MessageType = fastReflection_AccessControl_messageType{}
 
-type fastReflection_AccessControlType_messageType struct{}
+type fastReflection_AccessControl_messageType struct{}
 
-func (x fastReflection_AccessControlType_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessControlType)(nil)
+func (x fastReflection_AccessControl_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessControl)(nil)
 }
-func (x fastReflection_AccessControlType_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessControlType)
+func (x fastReflection_AccessControl_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessControl)
 }
-func (x fastReflection_AccessControlType_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControlType
+func (x fastReflection_AccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControl
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessControlType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControlType
+func (x *fastReflection_AccessControl) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControl
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessControlType) Type() protoreflect.MessageType {
-	return _fastReflection_AccessControlType_messageType
+func (x *fastReflection_AccessControl) Type() protoreflect.MessageType {
+	return _fastReflection_AccessControl_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessControlType) New() protoreflect.Message {
-	return new(fastReflection_AccessControlType)
+func (x *fastReflection_AccessControl) New() protoreflect.Message {
+	return new(fastReflection_AccessControl)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage {
-	return (*AccessControlType)(x)
+func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
+	return (*AccessControl)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1633,16 +2055,16 @@ func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AccessType != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AccessType))
-		if !f(fd_AccessControlType_access_type, value) {
+func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Create != nil {
+		value := protoreflect.ValueOfMessage(x.Create.ProtoReflect())
+		if !f(fd_AccessControl_create, value) {
 			return
 		}
 	}
-	if len(x.AccessControlList) != 0 {
-		value := protoreflect.ValueOfList(&_AccessControlType_2_list{list: &x.AccessControlList})
-		if !f(fd_AccessControlType_access_control_list, value) {
+	if x.Call != nil {
+		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+		if !f(fd_AccessControl_call, value) {
 			return
 		}
 	}
@@ -1659,17 +2081,17 @@ func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		return x.AccessType != 0
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		return len(x.AccessControlList) != 0
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		return x.Create != nil
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		return x.Call != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1679,17 +2101,17 @@ func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		x.AccessType = 0
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		x.AccessControlList = nil
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		x.Create = nil
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		x.Call = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1699,22 +2121,19 @@ func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		value := x.AccessType
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		if len(x.AccessControlList) == 0 {
-			return protoreflect.ValueOfList(&_AccessControlType_2_list{})
-		}
-		listValue := &_AccessControlType_2_list{list: &x.AccessControlList}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		value := x.Create
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		value := x.Call
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1728,19 +2147,17 @@ func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		x.AccessType = (AccessType)(value.Enum())
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		lv := value.List()
-		clv := lv.(*_AccessControlType_2_list)
-		x.AccessControlList = *clv.list
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		x.Create = value.Message().Interface().(*AccessControlType)
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		x.Call = value.Message().Interface().(*AccessControlType)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1754,49 +2171,52 @@ func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		if x.AccessControlList == nil {
-			x.AccessControlList = []string{}
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		if x.Create == nil {
+			x.Create = new(AccessControlType)
 		}
-		value := &_AccessControlType_2_list{list: &x.AccessControlList}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		panic(fmt.Errorf("field access_type of message cosmos.evm.vm.v1.AccessControlType is not mutable"))
+		return protoreflect.ValueOfMessage(x.Create.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		if x.Call == nil {
+			x.Call = new(AccessControlType)
+		}
+		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessControlType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		list := []string{}
-		return protoreflect.ValueOfList(&_AccessControlType_2_list{list: &list})
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControlType", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControl", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1804,7 +2224,7 @@ func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1815,7 +2235,7 @@ func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1827,7 +2247,7 @@ func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessControlType) IsValid() bool {
+func (x *fastReflection_AccessControl) IsValid() bool {
 	return x != nil
 }
 
@@ -1837,9 +2257,9 @@ func (x *fastReflection_AccessControlType) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*AccessControl)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1851,14 +2271,13 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.AccessType != 0 {
-			n += 1 + runtime.Sov(uint64(x.AccessType))
+		if x.Create != nil {
+			l = options.Size(x.Create)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.AccessControlList) > 0 {
-			for _, s := range x.AccessControlList {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Call != nil {
+			l = options.Size(x.Call)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1870,7 +2289,7 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*AccessControl)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1889,19 +2308,33 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AccessControlList) > 0 {
-			for iNdEx := len(x.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AccessControlList[iNdEx])
-				copy(dAtA[i:], x.AccessControlList[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessControlList[iNdEx])))
-				i--
-				dAtA[i] = 0x12
+		if x.Call != nil {
+			encoded, err := options.Marshal(x.Call)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
 		}
-		if x.AccessType != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccessType))
+		if x.Create != nil {
+			encoded, err := options.Marshal(x.Create)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1914,7 +2347,7 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*AccessControl)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1946,17 +2379,17 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
 				}
-				x.AccessType = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1966,16 +2399,33 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AccessType |= AccessType(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Create == nil {
+					x.Create = &AccessControlType{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Create); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1985,23 +2435,27 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AccessControlList = append(x.AccessControlList, string(dAtA[iNdEx:postIndex]))
+				if x.Call == nil {
+					x.Call = &AccessControlType{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2038,72 +2492,74 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_AccessControlType_2_list)(nil)
+
+type _AccessControlType_2_list struct {
+	list *[]string
+}
+
+func (x *_AccessControlType_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessControlType_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccessControlType_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessControlType_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessControlType_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccessControlType at list field AccessControlList as it is not of Message kind"))
+}
+
+func (x *_AccessControlType_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessControlType_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccessControlType_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ChainConfig                      protoreflect.MessageDescriptor
-	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
-	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
-	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
-	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
-	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
-	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
-	fd_ChainConfig_denom                protoreflect.FieldDescriptor
-	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
-	fd_ChainConfig_shanghai_time        protoreflect.FieldDescriptor
-	fd_ChainConfig_cancun_time          protoreflect.FieldDescriptor
-	fd_ChainConfig_prague_time          protoreflect.FieldDescriptor
-	fd_ChainConfig_verkle_time          protoreflect.FieldDescriptor
-	fd_ChainConfig_osaka_time           protoreflect.FieldDescriptor
+	md_AccessControlType                     protoreflect.MessageDescriptor
+	fd_AccessControlType_access_type         protoreflect.FieldDescriptor
+	fd_AccessControlType_access_control_list protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_ChainConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ChainConfig")
-	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
-	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
-	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
-	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
-	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
-	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
-	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
-	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
-	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
-	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
-	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
-	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
-	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
-	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
-	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
-	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
-	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
-	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
-	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
-	fd_ChainConfig_shanghai_time = md_ChainConfig.Fields().ByName("shanghai_time")
-	fd_ChainConfig_cancun_time = md_ChainConfig.Fields().ByName("cancun_time")
-	fd_ChainConfig_prague_time = md_ChainConfig.Fields().ByName("prague_time")
-	fd_ChainConfig_verkle_time = md_ChainConfig.Fields().ByName("verkle_time")
-	fd_ChainConfig_osaka_time = md_ChainConfig.Fields().ByName("osaka_time")
+	md_AccessControlType = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControlType")
+	fd_AccessControlType_access_type = md_AccessControlType.Fields().ByName("access_type")
+	fd_AccessControlType_access_control_list = md_AccessControlType.Fields().ByName("access_control_list")
 }
 
-var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_AccessControlType)(nil)
 
-type fastReflection_ChainConfig ChainConfig
+type fastReflection_AccessControlType AccessControlType
 
-func (x *ChainConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(x)
+func (x *AccessControlType) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessControlType)(x)
 }
 
-func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2115,43 +2571,43 @@ func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
-var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+var _fastReflection_AccessControlType_messageType fastReflection_AccessControlType_messageType
+var _ protoreflect.MessageType = fastReflection_AccessControlType_messageType{}
 
-type fastReflection_ChainConfig_messageType struct{}
+type fastReflection_AccessControlType_messageType struct{}
 
-func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(nil)
+func (x fastReflection_AccessControlType_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessControlType)(nil)
 }
-func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x fastReflection_AccessControlType_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessControlType)
 }
-func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x fastReflection_AccessControlType_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlType
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x *fastReflection_AccessControlType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlType
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
-	return _fastReflection_ChainConfig_messageType
+func (x *fastReflection_AccessControlType) Type() protoreflect.MessageType {
+	return _fastReflection_AccessControlType_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ChainConfig) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x *fastReflection_AccessControlType) New() protoreflect.Message {
+	return new(fastReflection_AccessControlType)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
-	return (*ChainConfig)(x)
+func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage {
+	return (*AccessControlType)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2159,148 +2615,16 @@ func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.HomesteadBlock != "" {
-		value := protoreflect.ValueOfString(x.HomesteadBlock)
-		if !f(fd_ChainConfig_homestead_block, value) {
-			return
-		}
-	}
-	if x.DaoForkBlock != "" {
-		value := protoreflect.ValueOfString(x.DaoForkBlock)
-		if !f(fd_ChainConfig_dao_fork_block, value) {
-			return
-		}
-	}
-	if x.DaoForkSupport != false {
-		value := protoreflect.ValueOfBool(x.DaoForkSupport)
-		if !f(fd_ChainConfig_dao_fork_support, value) {
-			return
-		}
-	}
-	if x.Eip150Block != "" {
-		value := protoreflect.ValueOfString(x.Eip150Block)
-		if !f(fd_ChainConfig_eip150_block, value) {
-			return
-		}
-	}
-	if x.Eip155Block != "" {
-		value := protoreflect.ValueOfString(x.Eip155Block)
-		if !f(fd_ChainConfig_eip155_block, value) {
-			return
-		}
-	}
-	if x.Eip158Block != "" {
-		value := protoreflect.ValueOfString(x.Eip158Block)
-		if !f(fd_ChainConfig_eip158_block, value) {
-			return
-		}
-	}
-	if x.ByzantiumBlock != "" {
-		value := protoreflect.ValueOfString(x.ByzantiumBlock)
-		if !f(fd_ChainConfig_byzantium_block, value) {
-			return
-		}
-	}
-	if x.ConstantinopleBlock != "" {
-		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
-		if !f(fd_ChainConfig_constantinople_block, value) {
-			return
-		}
-	}
-	if x.PetersburgBlock != "" {
-		value := protoreflect.ValueOfString(x.PetersburgBlock)
-		if !f(fd_ChainConfig_petersburg_block, value) {
-			return
-		}
-	}
-	if x.IstanbulBlock != "" {
-		value := protoreflect.ValueOfString(x.IstanbulBlock)
-		if !f(fd_ChainConfig_istanbul_block, value) {
-			return
-		}
-	}
-	if x.MuirGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
-		if !f(fd_ChainConfig_muir_glacier_block, value) {
-			return
-		}
-	}
-	if x.BerlinBlock != "" {
-		value := protoreflect.ValueOfString(x.BerlinBlock)
-		if !f(fd_ChainConfig_berlin_block, value) {
-			return
-		}
-	}
-	if x.LondonBlock != "" {
-		value := protoreflect.ValueOfString(x.LondonBlock)
-		if !f(fd_ChainConfig_london_block, value) {
-			return
-		}
-	}
-	if x.ArrowGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
-		if !f(fd_ChainConfig_arrow_glacier_block, value) {
-			return
-		}
-	}
-	if x.GrayGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
-		if !f(fd_ChainConfig_gray_glacier_block, value) {
-			return
-		}
-	}
-	if x.MergeNetsplitBlock != "" {
-		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
-		if !f(fd_ChainConfig_merge_netsplit_block, value) {
-			return
-		}
-	}
-	if x.ChainId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ChainId)
-		if !f(fd_ChainConfig_chain_id, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_ChainConfig_denom, value) {
-			return
-		}
-	}
-	if x.Decimals != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Decimals)
-		if !f(fd_ChainConfig_decimals, value) {
-			return
-		}
-	}
-	if x.ShanghaiTime != "" {
-		value := protoreflect.ValueOfString(x.ShanghaiTime)
-		if !f(fd_ChainConfig_shanghai_time, value) {
-			return
-		}
-	}
-	if x.CancunTime != "" {
-		value := protoreflect.ValueOfString(x.CancunTime)
-		if !f(fd_ChainConfig_cancun_time, value) {
-			return
-		}
-	}
-	if x.PragueTime != "" {
-		value := protoreflect.ValueOfString(x.PragueTime)
-		if !f(fd_ChainConfig_prague_time, value) {
-			return
-		}
-	}
-	if x.VerkleTime != "" {
-		value := protoreflect.ValueOfString(x.VerkleTime)
-		if !f(fd_ChainConfig_verkle_time, value) {
+func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AccessType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AccessType))
+		if !f(fd_AccessControlType_access_type, value) {
 			return
 		}
 	}
-	if x.OsakaTime != "" {
-		value := protoreflect.ValueOfString(x.OsakaTime)
-		if !f(fd_ChainConfig_osaka_time, value) {
+	if len(x.AccessControlList) != 0 {
+		value := protoreflect.ValueOfList(&_AccessControlType_2_list{list: &x.AccessControlList})
+		if !f(fd_AccessControlType_access_control_list, value) {
 			return
 		}
 	}
@@ -2317,61 +2641,17 @@ func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		return x.HomesteadBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		return x.DaoForkBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		return x.DaoForkSupport != false
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		return x.Eip150Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		return x.Eip155Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		return x.Eip158Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		return x.ByzantiumBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		return x.ConstantinopleBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		return x.PetersburgBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		return x.IstanbulBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		return x.MuirGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		return x.BerlinBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		return x.LondonBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		return x.ArrowGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		return x.GrayGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		return x.MergeNetsplitBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		return x.ChainId != uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		return x.Denom != ""
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		return x.Decimals != uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		return x.ShanghaiTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		return x.CancunTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		return x.PragueTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		return x.VerkleTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		return x.OsakaTime != ""
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		return x.AccessType != 0
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		return len(x.AccessControlList) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2381,61 +2661,17 @@ func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = false
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		x.LondonBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		x.ChainId = uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		x.Denom = ""
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		x.Decimals = uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		x.ShanghaiTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		x.CancunTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		x.PragueTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		x.VerkleTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		x.OsakaTime = ""
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		x.AccessType = 0
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		x.AccessControlList = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2445,85 +2681,22 @@ func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		value := x.HomesteadBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		value := x.DaoForkBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		value := x.DaoForkSupport
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		value := x.Eip150Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		value := x.Eip155Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		value := x.Eip158Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		value := x.ByzantiumBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		value := x.ConstantinopleBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		value := x.PetersburgBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		value := x.IstanbulBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		value := x.MuirGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		value := x.BerlinBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		value := x.LondonBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		value := x.ArrowGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		value := x.GrayGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		value := x.MergeNetsplitBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		value := x.Decimals
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		value := x.ShanghaiTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		value := x.CancunTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		value := x.PragueTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		value := x.VerkleTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		value := x.OsakaTime
-		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		value := x.AccessType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		if len(x.AccessControlList) == 0 {
+			return protoreflect.ValueOfList(&_AccessControlType_2_list{})
+		}
+		listValue := &_AccessControlType_2_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2537,61 +2710,19 @@ func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = value.Bool()
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		x.LondonBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		x.ChainId = value.Uint()
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		x.Denom = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		x.Decimals = value.Uint()
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		x.ShanghaiTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		x.CancunTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		x.PragueTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		x.VerkleTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		x.OsakaTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		x.AccessType = (AccessType)(value.Enum())
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		lv := value.List()
+		clv := lv.(*_AccessControlType_2_list)
+		x.AccessControlList = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2605,132 +2736,49 @@ func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControlType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		panic(fmt.Errorf("field homestead_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		panic(fmt.Errorf("field dao_fork_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		panic(fmt.Errorf("field dao_fork_support of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		panic(fmt.Errorf("field eip150_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		panic(fmt.Errorf("field eip155_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		panic(fmt.Errorf("field eip158_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		panic(fmt.Errorf("field byzantium_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		panic(fmt.Errorf("field constantinople_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		panic(fmt.Errorf("field petersburg_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		panic(fmt.Errorf("field istanbul_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		panic(fmt.Errorf("field muir_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		panic(fmt.Errorf("field berlin_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		panic(fmt.Errorf("field london_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		panic(fmt.Errorf("field arrow_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		panic(fmt.Errorf("field gray_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		panic(fmt.Errorf("field merge_netsplit_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		panic(fmt.Errorf("field denom of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		panic(fmt.Errorf("field decimals of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		panic(fmt.Errorf("field shanghai_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		panic(fmt.Errorf("field cancun_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		panic(fmt.Errorf("field prague_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		panic(fmt.Errorf("field verkle_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		panic(fmt.Errorf("field osaka_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		if x.AccessControlList == nil {
+			x.AccessControlList = []string{}
+		}
+		value := &_AccessControlType_2_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		panic(fmt.Errorf("field access_type of message cosmos.evm.vm.v1.AccessControlType is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControlType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccessControlType_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ChainConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControlType", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2738,7 +2786,7 @@ func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2749,7 +2797,7 @@ func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2761,7 +2809,7 @@ func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ChainConfig) IsValid() bool {
+func (x *fastReflection_AccessControlType) IsValid() bool {
 	return x != nil
 }
 
@@ -2771,9 +2819,9 @@ func (x *fastReflection_ChainConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*AccessControlType)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2785,98 +2833,14 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.HomesteadBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DaoForkBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.DaoForkSupport {
-			n += 2
-		}
-		l = len(x.Eip150Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Eip155Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Eip158Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ByzantiumBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ConstantinopleBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PetersburgBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.IstanbulBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MuirGlacierBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BerlinBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.LondonBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ArrowGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.GrayGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MergeNetsplitBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 2 + runtime.Sov(uint64(x.ChainId))
-		}
-		l = len(x.Denom)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.Decimals != 0 {
-			n += 2 + runtime.Sov(uint64(x.Decimals))
-		}
-		l = len(x.ShanghaiTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.CancunTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PragueTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.VerkleTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
+		if x.AccessType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccessType))
 		}
-		l = len(x.OsakaTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.AccessControlList) > 0 {
+			for _, s := range x.AccessControlList {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2888,7 +2852,7 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*AccessControlType)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2907,214 +2871,37 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.OsakaTime) > 0 {
-			i -= len(x.OsakaTime)
-			copy(dAtA[i:], x.OsakaTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OsakaTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xfa
-		}
-		if len(x.VerkleTime) > 0 {
-			i -= len(x.VerkleTime)
-			copy(dAtA[i:], x.VerkleTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerkleTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xf2
-		}
-		if len(x.PragueTime) > 0 {
-			i -= len(x.PragueTime)
-			copy(dAtA[i:], x.PragueTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PragueTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xea
-		}
-		if len(x.CancunTime) > 0 {
-			i -= len(x.CancunTime)
-			copy(dAtA[i:], x.CancunTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xe2
-		}
-		if len(x.ShanghaiTime) > 0 {
-			i -= len(x.ShanghaiTime)
-			copy(dAtA[i:], x.ShanghaiTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xda
-		}
-		if x.Decimals != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xd0
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xca
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc0
+		if len(x.AccessControlList) > 0 {
+			for iNdEx := len(x.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AccessControlList[iNdEx])
+				copy(dAtA[i:], x.AccessControlList[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessControlList[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.MergeNetsplitBlock) > 0 {
-			i -= len(x.MergeNetsplitBlock)
-			copy(dAtA[i:], x.MergeNetsplitBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
-			i--
-			dAtA[i] = 0x1
+		if x.AccessType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccessType))
 			i--
-			dAtA[i] = 0xaa
+			dAtA[i] = 0x8
 		}
-		if len(x.GrayGlacierBlock) > 0 {
-			i -= len(x.GrayGlacierBlock)
-			copy(dAtA[i:], x.GrayGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		if len(x.ArrowGlacierBlock) > 0 {
-			i -= len(x.ArrowGlacierBlock)
-			copy(dAtA[i:], x.ArrowGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-		if len(x.LondonBlock) > 0 {
-			i -= len(x.LondonBlock)
-			copy(dAtA[i:], x.LondonBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.BerlinBlock) > 0 {
-			i -= len(x.BerlinBlock)
-			copy(dAtA[i:], x.BerlinBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.MuirGlacierBlock) > 0 {
-			i -= len(x.MuirGlacierBlock)
-			copy(dAtA[i:], x.MuirGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if len(x.IstanbulBlock) > 0 {
-			i -= len(x.IstanbulBlock)
-			copy(dAtA[i:], x.IstanbulBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if len(x.PetersburgBlock) > 0 {
-			i -= len(x.PetersburgBlock)
-			copy(dAtA[i:], x.PetersburgBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.ConstantinopleBlock) > 0 {
-			i -= len(x.ConstantinopleBlock)
-			copy(dAtA[i:], x.ConstantinopleBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.ByzantiumBlock) > 0 {
-			i -= len(x.ByzantiumBlock)
-			copy(dAtA[i:], x.ByzantiumBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.Eip158Block) > 0 {
-			i -= len(x.Eip158Block)
-			copy(dAtA[i:], x.Eip158Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Eip155Block) > 0 {
-			i -= len(x.Eip155Block)
-			copy(dAtA[i:], x.Eip155Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Eip150Block) > 0 {
-			i -= len(x.Eip150Block)
-			copy(dAtA[i:], x.Eip150Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.DaoForkSupport {
-			i--
-			if x.DaoForkSupport {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.DaoForkBlock) > 0 {
-			i -= len(x.DaoForkBlock)
-			copy(dAtA[i:], x.DaoForkBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.HomesteadBlock) > 0 {
-			i -= len(x.HomesteadBlock)
-			copy(dAtA[i:], x.HomesteadBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
@@ -3141,81 +2928,17 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
 				}
-				var v int
+				x.AccessType = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3225,15 +2948,14 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.AccessType |= AccessType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.DaoForkSupport = bool(v != 0)
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3261,69 +2983,1329 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Eip150Block = string(dAtA[iNdEx:postIndex])
+				x.AccessControlList = append(x.AccessControlList, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Eip155Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ChainConfig                      protoreflect.MessageDescriptor
+	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
+	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
+	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
+	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
+	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
+	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
+	fd_ChainConfig_denom                protoreflect.FieldDescriptor
+	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
+	fd_ChainConfig_shanghai_time        protoreflect.FieldDescriptor
+	fd_ChainConfig_cancun_time          protoreflect.FieldDescriptor
+	fd_ChainConfig_prague_time          protoreflect.FieldDescriptor
+	fd_ChainConfig_verkle_time          protoreflect.FieldDescriptor
+	fd_ChainConfig_osaka_time           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_ChainConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ChainConfig")
+	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
+	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
+	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
+	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
+	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
+	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
+	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
+	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
+	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
+	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
+	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
+	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
+	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
+	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
+	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
+	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
+	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
+	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
+	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
+	fd_ChainConfig_shanghai_time = md_ChainConfig.Fields().ByName("shanghai_time")
+	fd_ChainConfig_cancun_time = md_ChainConfig.Fields().ByName("cancun_time")
+	fd_ChainConfig_prague_time = md_ChainConfig.Fields().ByName("prague_time")
+	fd_ChainConfig_verkle_time = md_ChainConfig.Fields().ByName("verkle_time")
+	fd_ChainConfig_osaka_time = md_ChainConfig.Fields().ByName("osaka_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+
+type fastReflection_ChainConfig ChainConfig
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(x)
+}
+
+func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
+var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+
+type fastReflection_ChainConfig_messageType struct{}
+
+func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(nil)
+}
+func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
+	return _fastReflection_ChainConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChainConfig) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
+	return (*ChainConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.HomesteadBlock != "" {
+		value := protoreflect.ValueOfString(x.HomesteadBlock)
+		if !f(fd_ChainConfig_homestead_block, value) {
+			return
+		}
+	}
+	if x.DaoForkBlock != "" {
+		value := protoreflect.ValueOfString(x.DaoForkBlock)
+		if !f(fd_ChainConfig_dao_fork_block, value) {
+			return
+		}
+	}
+	if x.DaoForkSupport != false {
+		value := protoreflect.ValueOfBool(x.DaoForkSupport)
+		if !f(fd_ChainConfig_dao_fork_support, value) {
+			return
+		}
+	}
+	if x.Eip150Block != "" {
+		value := protoreflect.ValueOfString(x.Eip150Block)
+		if !f(fd_ChainConfig_eip150_block, value) {
+			return
+		}
+	}
+	if x.Eip155Block != "" {
+		value := protoreflect.ValueOfString(x.Eip155Block)
+		if !f(fd_ChainConfig_eip155_block, value) {
+			return
+		}
+	}
+	if x.Eip158Block != "" {
+		value := protoreflect.ValueOfString(x.Eip158Block)
+		if !f(fd_ChainConfig_eip158_block, value) {
+			return
+		}
+	}
+	if x.ByzantiumBlock != "" {
+		value := protoreflect.ValueOfString(x.ByzantiumBlock)
+		if !f(fd_ChainConfig_byzantium_block, value) {
+			return
+		}
+	}
+	if x.ConstantinopleBlock != "" {
+		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
+		if !f(fd_ChainConfig_constantinople_block, value) {
+			return
+		}
+	}
+	if x.PetersburgBlock != "" {
+		value := protoreflect.ValueOfString(x.PetersburgBlock)
+		if !f(fd_ChainConfig_petersburg_block, value) {
+			return
+		}
+	}
+	if x.IstanbulBlock != "" {
+		value := protoreflect.ValueOfString(x.IstanbulBlock)
+		if !f(fd_ChainConfig_istanbul_block, value) {
+			return
+		}
+	}
+	if x.MuirGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
+		if !f(fd_ChainConfig_muir_glacier_block, value) {
+			return
+		}
+	}
+	if x.BerlinBlock != "" {
+		value := protoreflect.ValueOfString(x.BerlinBlock)
+		if !f(fd_ChainConfig_berlin_block, value) {
+			return
+		}
+	}
+	if x.LondonBlock != "" {
+		value := protoreflect.ValueOfString(x.LondonBlock)
+		if !f(fd_ChainConfig_london_block, value) {
+			return
+		}
+	}
+	if x.ArrowGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
+		if !f(fd_ChainConfig_arrow_glacier_block, value) {
+			return
+		}
+	}
+	if x.GrayGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
+		if !f(fd_ChainConfig_gray_glacier_block, value) {
+			return
+		}
+	}
+	if x.MergeNetsplitBlock != "" {
+		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
+		if !f(fd_ChainConfig_merge_netsplit_block, value) {
+			return
+		}
+	}
+	if x.ChainId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChainId)
+		if !f(fd_ChainConfig_chain_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ChainConfig_denom, value) {
+			return
+		}
+	}
+	if x.Decimals != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Decimals)
+		if !f(fd_ChainConfig_decimals, value) {
+			return
+		}
+	}
+	if x.ShanghaiTime != "" {
+		value := protoreflect.ValueOfString(x.ShanghaiTime)
+		if !f(fd_ChainConfig_shanghai_time, value) {
+			return
+		}
+	}
+	if x.CancunTime != "" {
+		value := protoreflect.ValueOfString(x.CancunTime)
+		if !f(fd_ChainConfig_cancun_time, value) {
+			return
+		}
+	}
+	if x.PragueTime != "" {
+		value := protoreflect.ValueOfString(x.PragueTime)
+		if !f(fd_ChainConfig_prague_time, value) {
+			return
+		}
+	}
+	if x.VerkleTime != "" {
+		value := protoreflect.ValueOfString(x.VerkleTime)
+		if !f(fd_ChainConfig_verkle_time, value) {
+			return
+		}
+	}
+	if x.OsakaTime != "" {
+		value := protoreflect.ValueOfString(x.OsakaTime)
+		if !f(fd_ChainConfig_osaka_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		return x.HomesteadBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		return x.DaoForkBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		return x.DaoForkSupport != false
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		return x.Eip150Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		return x.Eip155Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		return x.Eip158Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		return x.ByzantiumBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		return x.ConstantinopleBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		return x.PetersburgBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		return x.IstanbulBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		return x.MuirGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		return x.BerlinBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		return x.LondonBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		return x.ArrowGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		return x.GrayGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		return x.MergeNetsplitBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		return x.ChainId != uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		return x.Denom != ""
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		return x.Decimals != uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		return x.ShanghaiTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		return x.CancunTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		return x.PragueTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		return x.VerkleTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		return x.OsakaTime != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = false
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		x.LondonBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		x.ChainId = uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		x.Denom = ""
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		x.Decimals = uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		x.ShanghaiTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		x.CancunTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		x.PragueTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		x.VerkleTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		x.OsakaTime = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		value := x.HomesteadBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		value := x.DaoForkBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		value := x.DaoForkSupport
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		value := x.Eip150Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		value := x.Eip155Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		value := x.Eip158Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		value := x.ByzantiumBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		value := x.ConstantinopleBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		value := x.PetersburgBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		value := x.IstanbulBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		value := x.MuirGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		value := x.BerlinBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		value := x.LondonBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		value := x.ArrowGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		value := x.GrayGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		value := x.MergeNetsplitBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		value := x.ShanghaiTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		value := x.CancunTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		value := x.PragueTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		value := x.VerkleTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		value := x.OsakaTime
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = value.Bool()
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		x.LondonBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		x.ChainId = value.Uint()
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		x.Decimals = value.Uint()
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		x.ShanghaiTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		x.CancunTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		x.PragueTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		x.VerkleTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		x.OsakaTime = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		panic(fmt.Errorf("field homestead_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		panic(fmt.Errorf("field dao_fork_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		panic(fmt.Errorf("field dao_fork_support of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		panic(fmt.Errorf("field eip150_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		panic(fmt.Errorf("field eip155_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		panic(fmt.Errorf("field eip158_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		panic(fmt.Errorf("field byzantium_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		panic(fmt.Errorf("field constantinople_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		panic(fmt.Errorf("field petersburg_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		panic(fmt.Errorf("field istanbul_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		panic(fmt.Errorf("field muir_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		panic(fmt.Errorf("field berlin_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		panic(fmt.Errorf("field london_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		panic(fmt.Errorf("field arrow_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		panic(fmt.Errorf("field gray_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		panic(fmt.Errorf("field merge_netsplit_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		panic(fmt.Errorf("field denom of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		panic(fmt.Errorf("field decimals of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		panic(fmt.Errorf("field shanghai_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		panic(fmt.Errorf("field cancun_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		panic(fmt.Errorf("field prague_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		panic(fmt.Errorf("field verkle_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		panic(fmt.Errorf("field osaka_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ChainConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChainConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.HomesteadBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DaoForkBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DaoForkSupport {
+			n += 2
+		}
+		l = len(x.Eip150Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip155Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip158Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ByzantiumBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConstantinopleBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PetersburgBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IstanbulBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MuirGlacierBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BerlinBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LondonBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ArrowGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrayGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MergeNetsplitBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 2 + runtime.Sov(uint64(x.ChainId))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 2 + runtime.Sov(uint64(x.Decimals))
+		}
+		l = len(x.ShanghaiTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CancunTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PragueTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VerkleTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OsakaTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OsakaTime) > 0 {
+			i -= len(x.OsakaTime)
+			copy(dAtA[i:], x.OsakaTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OsakaTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+		if len(x.VerkleTime) > 0 {
+			i -= len(x.VerkleTime)
+			copy(dAtA[i:], x.VerkleTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerkleTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.PragueTime) > 0 {
+			i -= len(x.PragueTime)
+			copy(dAtA[i:], x.PragueTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PragueTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+		if len(x.CancunTime) > 0 {
+			i -= len(x.CancunTime)
+			copy(dAtA[i:], x.CancunTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+		if len(x.ShanghaiTime) > 0 {
+			i -= len(x.ShanghaiTime)
+			copy(dAtA[i:], x.ShanghaiTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if len(x.MergeNetsplitBlock) > 0 {
+			i -= len(x.MergeNetsplitBlock)
+			copy(dAtA[i:], x.MergeNetsplitBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.GrayGlacierBlock) > 0 {
+			i -= len(x.GrayGlacierBlock)
+			copy(dAtA[i:], x.GrayGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.ArrowGlacierBlock) > 0 {
+			i -= len(x.ArrowGlacierBlock)
+			copy(dAtA[i:], x.ArrowGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.LondonBlock) > 0 {
+			i -= len(x.LondonBlock)
+			copy(dAtA[i:], x.LondonBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.BerlinBlock) > 0 {
+			i -= len(x.BerlinBlock)
+			copy(dAtA[i:], x.BerlinBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MuirGlacierBlock) > 0 {
+			i -= len(x.MuirGlacierBlock)
+			copy(dAtA[i:], x.MuirGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.IstanbulBlock) > 0 {
+			i -= len(x.IstanbulBlock)
+			copy(dAtA[i:], x.IstanbulBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.PetersburgBlock) > 0 {
+			i -= len(x.PetersburgBlock)
+			copy(dAtA[i:], x.PetersburgBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ConstantinopleBlock) > 0 {
+			i -= len(x.ConstantinopleBlock)
+			copy(dAtA[i:], x.ConstantinopleBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ByzantiumBlock) > 0 {
+			i -= len(x.ByzantiumBlock)
+			copy(dAtA[i:], x.ByzantiumBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Eip158Block) > 0 {
+			i -= len(x.Eip158Block)
+			copy(dAtA[i:], x.Eip158Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Eip155Block) > 0 {
+			i -= len(x.Eip155Block)
+			copy(dAtA[i:], x.Eip155Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Eip150Block) > 0 {
+			i -= len(x.Eip150Block)
+			copy(dAtA[i:], x.Eip150Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.DaoForkSupport {
+			i--
+			if x.DaoForkSupport {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DaoForkBlock) > 0 {
+			i -= len(x.DaoForkBlock)
+			copy(dAtA[i:], x.DaoForkBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.HomesteadBlock) > 0 {
+			i -= len(x.HomesteadBlock)
+			copy(dAtA[i:], x.HomesteadBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DaoForkSupport = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip150Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip155Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				x.Eip158Block = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
@@ -3776,46 +4758,530 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CancunTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PragueTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PragueTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerkleTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerkleTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OsakaTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OsakaTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_State       protoreflect.MessageDescriptor
+	fd_State_key   protoreflect.FieldDescriptor
+	fd_State_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_State = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("State")
+	fd_State_key = md_State.Fields().ByName("key")
+	fd_State_value = md_State.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_State)(nil)
+
+type fastReflection_State State
+
+func (x *State) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_State)(x)
+}
+
+func (x *State) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_State_messageType fastReflection_State_messageType
+var _ protoreflect.MessageType = fastReflection_State_messageType{}
+
+type fastReflection_State_messageType struct{}
+
+func (x fastReflection_State_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_State)(nil)
+}
+func (x fastReflection_State_messageType) New() protoreflect.Message {
+	return new(fastReflection_State)
+}
+func (x fastReflection_State_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_State
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_State) Descriptor() protoreflect.MessageDescriptor {
+	return md_State
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_State) Type() protoreflect.MessageType {
+	return _fastReflection_State_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_State) New() protoreflect.Message {
+	return new(fastReflection_State)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
+	return (*State)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_State_key, value) {
+			return
+		}
+	}
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_State_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		return x.Key != ""
+	case "cosmos.evm.vm.v1.State.value":
+		return x.Value != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		x.Key = ""
+	case "cosmos.evm.vm.v1.State.value":
+		x.Value = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		value := x.Key
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.State.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		x.Key = value.Interface().(string)
+	case "cosmos.evm.vm.v1.State.value":
+		x.Value = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		panic(fmt.Errorf("field key of message cosmos.evm.vm.v1.State is not mutable"))
+	case "cosmos.evm.vm.v1.State.value":
+		panic(fmt.Errorf("field value of message cosmos.evm.vm.v1.State is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_State) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.State.value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.State", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_State) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CancunTime = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 29:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PragueTime", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.PragueTime = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 30:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerkleTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3843,11 +5309,11 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VerkleTime = string(dAtA[iNdEx:postIndex])
+				x.Key = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 31:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OsakaTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3875,7 +5341,7 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OsakaTime = string(dAtA[iNdEx:postIndex])
+				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3912,29 +5378,77 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_StorageProof_3_list)(nil)
+
+type _StorageProof_3_list struct {
+	list *[]string
+}
+
+func (x *_StorageProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StorageProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_StorageProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StorageProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StorageProof_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message StorageProof at list field Siblings as it is not of Message kind"))
+}
+
+func (x *_StorageProof_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StorageProof_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_StorageProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_State       protoreflect.MessageDescriptor
-	fd_State_key   protoreflect.FieldDescriptor
-	fd_State_value protoreflect.FieldDescriptor
+	md_StorageProof          protoreflect.MessageDescriptor
+	fd_StorageProof_state    protoreflect.FieldDescriptor
+	fd_StorageProof_index    protoreflect.FieldDescriptor
+	fd_StorageProof_siblings protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_State = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("State")
-	fd_State_key = md_State.Fields().ByName("key")
-	fd_State_value = md_State.Fields().ByName("value")
+	md_StorageProof = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("StorageProof")
+	fd_StorageProof_state = md_StorageProof.Fields().ByName("state")
+	fd_StorageProof_index = md_StorageProof.Fields().ByName("index")
+	fd_StorageProof_siblings = md_StorageProof.Fields().ByName("siblings")
 }
 
-var _ protoreflect.Message = (*fastReflection_State)(nil)
+var _ protoreflect.Message = (*fastReflection_StorageProof)(nil)
 
-type fastReflection_State State
+type fastReflection_StorageProof StorageProof
 
-func (x *State) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_State)(x)
+func (x *StorageProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StorageProof)(x)
 }
 
-func (x *State) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+func (x *StorageProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3945,43 +5459,43 @@ func (x *State) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_State_messageType fastReflection_State_messageType
-var _ protoreflect.MessageType = fastReflection_State_messageType{}
+var _fastReflection_StorageProof_messageType fastReflection_StorageProof_messageType
+var _ protoreflect.MessageType = fastReflection_StorageProof_messageType{}
 
-type fastReflection_State_messageType struct{}
+type fastReflection_StorageProof_messageType struct{}
 
-func (x fastReflection_State_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_State)(nil)
+func (x fastReflection_StorageProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StorageProof)(nil)
 }
-func (x fastReflection_State_messageType) New() protoreflect.Message {
-	return new(fastReflection_State)
+func (x fastReflection_StorageProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_StorageProof)
 }
-func (x fastReflection_State_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_State
+func (x fastReflection_StorageProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_State) Descriptor() protoreflect.MessageDescriptor {
-	return md_State
+func (x *fastReflection_StorageProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_State) Type() protoreflect.MessageType {
-	return _fastReflection_State_messageType
+func (x *fastReflection_StorageProof) Type() protoreflect.MessageType {
+	return _fastReflection_StorageProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_State) New() protoreflect.Message {
-	return new(fastReflection_State)
+func (x *fastReflection_StorageProof) New() protoreflect.Message {
+	return new(fastReflection_StorageProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
-	return (*State)(x)
+func (x *fastReflection_StorageProof) Interface() protoreflect.ProtoMessage {
+	return (*StorageProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3989,16 +5503,22 @@ func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Key != "" {
-		value := protoreflect.ValueOfString(x.Key)
-		if !f(fd_State_key, value) {
+func (x *fastReflection_StorageProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.State != nil {
+		value := protoreflect.ValueOfMessage(x.State.ProtoReflect())
+		if !f(fd_StorageProof_state, value) {
 			return
 		}
 	}
-	if x.Value != "" {
-		value := protoreflect.ValueOfString(x.Value)
-		if !f(fd_State_value, value) {
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_StorageProof_index, value) {
+			return
+		}
+	}
+	if len(x.Siblings) != 0 {
+		value := protoreflect.ValueOfList(&_StorageProof_3_list{list: &x.Siblings})
+		if !f(fd_StorageProof_siblings, value) {
 			return
 		}
 	}
@@ -4015,17 +5535,19 @@ func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StorageProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		return x.Key != ""
-	case "cosmos.evm.vm.v1.State.value":
-		return x.Value != ""
+	case "cosmos.evm.vm.v1.StorageProof.state":
+		return x.State != nil
+	case "cosmos.evm.vm.v1.StorageProof.index":
+		return x.Index != uint64(0)
+	case "cosmos.evm.vm.v1.StorageProof.siblings":
+		return len(x.Siblings) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4035,17 +5557,19 @@ func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StorageProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		x.Key = ""
-	case "cosmos.evm.vm.v1.State.value":
-		x.Value = ""
+	case "cosmos.evm.vm.v1.StorageProof.state":
+		x.State = nil
+	case "cosmos.evm.vm.v1.StorageProof.index":
+		x.Index = uint64(0)
+	case "cosmos.evm.vm.v1.StorageProof.siblings":
+		x.Siblings = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4055,19 +5579,25 @@ func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		value := x.Key
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.State.value":
-		value := x.Value
-		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.StorageProof.state":
+		value := x.State
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.StorageProof.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.StorageProof.siblings":
+		if len(x.Siblings) == 0 {
+			return protoreflect.ValueOfList(&_StorageProof_3_list{})
+		}
+		listValue := &_StorageProof_3_list{list: &x.Siblings}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4081,17 +5611,21 @@ func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StorageProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		x.Key = value.Interface().(string)
-	case "cosmos.evm.vm.v1.State.value":
-		x.Value = value.Interface().(string)
+	case "cosmos.evm.vm.v1.StorageProof.state":
+		x.State = value.Message().Interface().(*State)
+	case "cosmos.evm.vm.v1.StorageProof.index":
+		x.Index = value.Uint()
+	case "cosmos.evm.vm.v1.StorageProof.siblings":
+		lv := value.List()
+		clv := lv.(*_StorageProof_3_list)
+		x.Siblings = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4105,44 +5639,57 @@ func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		panic(fmt.Errorf("field key of message cosmos.evm.vm.v1.State is not mutable"))
-	case "cosmos.evm.vm.v1.State.value":
-		panic(fmt.Errorf("field value of message cosmos.evm.vm.v1.State is not mutable"))
+	case "cosmos.evm.vm.v1.StorageProof.state":
+		if x.State == nil {
+			x.State = new(State)
+		}
+		return protoreflect.ValueOfMessage(x.State.ProtoReflect())
+	case "cosmos.evm.vm.v1.StorageProof.siblings":
+		if x.Siblings == nil {
+			x.Siblings = []string{}
+		}
+		value := &_StorageProof_3_list{list: &x.Siblings}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.StorageProof.index":
+		panic(fmt.Errorf("field index of message cosmos.evm.vm.v1.StorageProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_State) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.State.value":
-		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.StorageProof.state":
+		m := new(State)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.StorageProof.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.StorageProof.siblings":
+		list := []string{}
+		return protoreflect.ValueOfList(&_StorageProof_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.StorageProof"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.StorageProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StorageProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.State", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.StorageProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4150,7 +5697,7 @@ func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StorageProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4161,7 +5708,7 @@ func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StorageProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4173,7 +5720,7 @@ func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_State) IsValid() bool {
+func (x *fastReflection_StorageProof) IsValid() bool {
 	return x != nil
 }
 
@@ -4183,9 +5730,9 @@ func (x *fastReflection_State) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StorageProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*StorageProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4197,13 +5744,18 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Key)
-		if l > 0 {
+		if x.State != nil {
+			l = options.Size(x.State)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Value)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if len(x.Siblings) > 0 {
+			for _, s := range x.Siblings {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4215,7 +5767,7 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*StorageProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4234,17 +5786,31 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Value) > 0 {
-			i -= len(x.Value)
-			copy(dAtA[i:], x.Value)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+		if len(x.Siblings) > 0 {
+			for iNdEx := len(x.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Siblings[iNdEx])
+				copy(dAtA[i:], x.Siblings[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Siblings[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Key) > 0 {
-			i -= len(x.Key)
-			copy(dAtA[i:], x.Key)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+		if x.State != nil {
+			encoded, err := options.Marshal(x.State)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4259,7 +5825,7 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*StorageProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4291,17 +5857,17 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4311,27 +5877,50 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Key = string(dAtA[iNdEx:postIndex])
+				if x.State == nil {
+					x.State = &State{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.State); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4359,7 +5948,7 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Value = string(dAtA[iNdEx:postIndex])
+				x.Siblings = append(x.Siblings, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4469,7 +6058,7 @@ func (x *TransactionLogs) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5036,7 +6625,7 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Log) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5920,7 +7509,7 @@ func (x *TxResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TxResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6691,7 +8280,7 @@ func (x *AccessTuple) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7207,7 +8796,7 @@ func (x *TraceConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8184,7 +9773,7 @@ func (x *Preinstall) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Preinstall) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8795,6 +10384,30 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// evm_chain_id pins the EIP-155 chain id of the chain, so that a chain
+	// can't be started with an EVM chain config of a different chain id.
+	// It's not pinned when it's 0.
+	EvmChainId uint64 `protobuf:"varint,10,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	// unprotected_txs_allowlist defines the slice of hex addresses allowed to
+	// submit unprotected (i.e non EIP155 signed) transactions when
+	// allow_unprotected_txs is disabled.
+	UnprotectedTxsAllowlist []string `protobuf:"bytes,11,rep,name=unprotected_txs_allowlist,json=unprotectedTxsAllowlist,proto3" json:"unprotected_txs_allowlist,omitempty"`
+	// scheduled_eips defines the additional EIPs for the vm.Config that are
+	// only activated from a block height, on top of the extra_eips
+	ScheduledEips []*ScheduledEIP `protobuf:"bytes,12,rep,name=scheduled_eips,json=scheduledEips,proto3" json:"scheduled_eips,omitempty"`
+	// empty_account_sweep_batch is the number of accounts scanned at the end of
+	// every block for the empty accounts (zero nonce and balance, no code) to
+	// remove, as EIP-158 does. 0 disables the sweep.
+	EmptyAccountSweepBatch uint64 `protobuf:"varint,13,opt,name=empty_account_sweep_batch,json=emptyAccountSweepBatch,proto3" json:"empty_account_sweep_batch,omitempty"`
+	// storage_expiry_blocks is the number of blocks after which the storage of a
+	// contract which hasn't been read or written can be archived, until it's
+	// restored from its entries. 0 disables the recording of the contract
+	// accesses and the archival.
+	StorageExpiryBlocks uint64 `protobuf:"varint,14,opt,name=storage_expiry_blocks,json=storageExpiryBlocks,proto3" json:"storage_expiry_blocks,omitempty"`
+	// ibc_callback_contracts_allowlist defines the slice of hex addresses of the
+	// contracts the destination callbacks of the received ICS-20 packets can
+	// call. Any contract can be called when it's empty.
+	IbcCallbackContractsAllowlist []string `protobuf:"bytes,15,rep,name=ibc_callback_contracts_allowlist,json=ibcCallbackContractsAllowlist,proto3" json:"ibc_callback_contracts_allowlist,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -8859,6 +10472,95 @@ func (x *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (x *Params) GetEvmChainId() uint64 {
+	if x != nil {
+		return x.EvmChainId
+	}
+	return 0
+}
+
+func (x *Params) GetUnprotectedTxsAllowlist() []string {
+	if x != nil {
+		return x.UnprotectedTxsAllowlist
+	}
+	return nil
+}
+
+func (x *Params) GetScheduledEips() []*ScheduledEIP {
+	if x != nil {
+		return x.ScheduledEips
+	}
+	return nil
+}
+
+func (x *Params) GetEmptyAccountSweepBatch() uint64 {
+	if x != nil {
+		return x.EmptyAccountSweepBatch
+	}
+	return 0
+}
+
+func (x *Params) GetStorageExpiryBlocks() uint64 {
+	if x != nil {
+		return x.StorageExpiryBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetIbcCallbackContractsAllowlist() []string {
+	if x != nil {
+		return x.IbcCallbackContractsAllowlist
+	}
+	return nil
+}
+
+// ScheduledEIP defines an additional EIP for the vm.Config activated from a
+// block height
+type ScheduledEIP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// eip is the number of the EIP
+	Eip int64 `protobuf:"varint,1,opt,name=eip,proto3" json:"eip,omitempty"`
+	// height is the block height the EIP is activated from
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ScheduledEIP) Reset() {
+	*x = ScheduledEIP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledEIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledEIP) ProtoMessage() {}
+
+// Deprecated: Use ScheduledEIP.ProtoReflect.Descriptor instead.
+func (*ScheduledEIP) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduledEIP) GetEip() int64 {
+	if x != nil {
+		return x.Eip
+	}
+	return 0
+}
+
+func (x *ScheduledEIP) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -8875,7 +10577,7 @@ type AccessControl struct {
 func (x *AccessControl) Reset() {
 	*x = AccessControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[1]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8889,7 +10591,7 @@ func (*AccessControl) ProtoMessage() {}
 
 // Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AccessControl) GetCreate() *AccessControlType {
@@ -8927,7 +10629,7 @@ type AccessControlType struct {
 func (x *AccessControlType) Reset() {
 	*x = AccessControlType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[2]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8941,7 +10643,7 @@ func (*AccessControlType) ProtoMessage() {}
 
 // Deprecated: Use AccessControlType.ProtoReflect.Descriptor instead.
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AccessControlType) GetAccessType() AccessType {
@@ -9028,7 +10730,7 @@ type ChainConfig struct {
 func (x *ChainConfig) Reset() {
 	*x = ChainConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9042,7 +10744,7 @@ func (*ChainConfig) ProtoMessage() {}
 
 // Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ChainConfig) GetHomesteadBlock() string {
@@ -9228,7 +10930,7 @@ type State struct {
 func (x *State) Reset() {
 	*x = State{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9242,7 +10944,7 @@ func (*State) ProtoMessage() {}
 
 // Deprecated: Use State.ProtoReflect.Descriptor instead.
 func (*State) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *State) GetKey() string {
@@ -9259,6 +10961,63 @@ func (x *State) GetValue() string {
 	return ""
 }
 
+// StorageProof defines an entry of an archived storage with the proof of its
+// inclusion in the root of the archived storage.
+type StorageProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// state is the key value pair of the entry
+	State *State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// index is the position of the entry in the archived storage sorted by key
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// siblings are the hex hashes of the sibling nodes on the path from the leaf
+	// of the entry to the root
+	Siblings []string `protobuf:"bytes,3,rep,name=siblings,proto3" json:"siblings,omitempty"`
+}
+
+func (x *StorageProof) Reset() {
+	*x = StorageProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageProof) ProtoMessage() {}
+
+// Deprecated: Use StorageProof.ProtoReflect.Descriptor instead.
+func (*StorageProof) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StorageProof) GetState() *State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *StorageProof) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *StorageProof) GetSiblings() []string {
+	if x != nil {
+		return x.Siblings
+	}
+	return nil
+}
+
 // TransactionLogs define the logs generated from a transaction execution
 // with a given hash. It it used for import/export data as transactions are not
 // persisted on blockchain state after an upgrade.
@@ -9276,7 +11035,7 @@ type TransactionLogs struct {
 func (x *TransactionLogs) Reset() {
 	*x = TransactionLogs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9290,7 +11049,7 @@ func (*TransactionLogs) ProtoMessage() {}
 
 // Deprecated: Use TransactionLogs.ProtoReflect.Descriptor instead.
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TransactionLogs) GetHash() string {
@@ -9343,7 +11102,7 @@ type Log struct {
 func (x *Log) Reset() {
 	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9357,7 +11116,7 @@ func (*Log) ProtoMessage() {}
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Log) GetAddress() string {
@@ -9449,7 +11208,7 @@ type TxResult struct {
 func (x *TxResult) Reset() {
 	*x = TxResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9463,7 +11222,7 @@ func (*TxResult) ProtoMessage() {}
 
 // Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TxResult) GetContractAddress() string {
@@ -9523,7 +11282,7 @@ type AccessTuple struct {
 func (x *AccessTuple) Reset() {
 	*x = AccessTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9537,7 +11296,7 @@ func (*AccessTuple) ProtoMessage() {}
 
 // Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AccessTuple) GetAddress() string {
@@ -9588,7 +11347,7 @@ type TraceConfig struct {
 func (x *TraceConfig) Reset() {
 	*x = TraceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9602,7 +11361,7 @@ func (*TraceConfig) ProtoMessage() {}
 
 // Deprecated: Use TraceConfig.ProtoReflect.Descriptor instead.
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TraceConfig) GetTracer() string {
@@ -9700,7 +11459,7 @@ type Preinstall struct {
 func (x *Preinstall) Reset() {
 	*x = Preinstall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9714,7 +11473,7 @@ func (*Preinstall) ProtoMessage() {}
 
 // Deprecated: Use Preinstall.ProtoReflect.Descriptor instead.
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Preinstall) GetName() string {
@@ -9746,7 +11505,7 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x65, 0x76, 0x6d,
@@ -9770,279 +11529,317 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x3a, 0x0a, 0x19, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
 	0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
 	0x28, 0x09, 0x52, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
-	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x3a, 0x1b, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x76,
-	0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04,
-	0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x06,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
-	0x3d, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0xdd,
-	0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0a, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0a, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x13, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x33, 0xe2, 0xde, 0x1f, 0x11, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0xf2, 0xde, 0x1f,
-	0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x11, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xa8,
-	0x10, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c,
-	0x0a, 0x0f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0c, 0x65,
+	0x76, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x45, 0x56, 0x4d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x44, 0x52, 0x0a, 0x65, 0x76, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a,
+	0x19, 0x75, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x17, 0x75, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x73,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x0e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x49,
+	0x50, 0x42, 0x15, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x45, 0x49, 0x50, 0x73, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x45, 0x69, 0x70, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x77, 0x65, 0x65, 0x70, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x6a, 0x0a, 0x20, 0x69, 0x62, 0x63, 0x5f, 0x63, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x21, 0xe2, 0xde, 0x1f, 0x1d, 0x49, 0x42, 0x43, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x1d, 0x69, 0x62, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x3a, 0x1b, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x76, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x06, 0x10,
+	0x07, 0x22, 0x41, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x49,
+	0x50, 0x12, 0x19, 0x0a, 0x03, 0x65, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xe2, 0xde, 0x1f, 0x03, 0x45, 0x49, 0x50, 0x52, 0x03, 0x65, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x63, 0x61, 0x6c,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0xdd, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x33, 0xe2, 0xde, 0x1f, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xa8, 0x10, 0x0a, 0x0b, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0f, 0x68, 0x6f, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x68, 0x0a, 0x0e, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f,
+	0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x41, 0x4f,
+	0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x52, 0x0c, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x57, 0x0a, 0x10, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2d, 0xe2, 0xde, 0x1f, 0x0e,
+	0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0xf2, 0xde,
+	0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x52, 0x0e, 0x64, 0x61, 0x6f, 0x46, 0x6f,
+	0x72, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70,
+	0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49,
+	0x50, 0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x62, 0x0a,
+	0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde,
+	0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f,
+	0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
-	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x6f, 0x6d, 0x65,
-	0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x68, 0x6f,
-	0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x68, 0x0a, 0x0e,
-	0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2,
-	0xde, 0x1f, 0x0c, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2,
-	0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72,
-	0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0c, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72,
-	0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x57, 0x0a, 0x10, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f,
-	0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x2d, 0xe2, 0xde, 0x1f, 0x0e, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70,
-	0x70, 0x6f, 0x72, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61,
-	0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x52,
-	0x0e, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
-	0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2,
-	0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35,
+	0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5c, 0x0a, 0x0f, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69,
+	0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x52, 0x0e, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x6b, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x13, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x5f, 0x0a, 0x10, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x52, 0x0f, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x59, 0x0a, 0x0e, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xda, 0xde, 0x1f, 0x15, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70,
-	0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31,
-	0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35,
-	0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda,
+	0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0d, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12,
+	0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x75, 0x69,
+	0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x52, 0x10, 0x6d, 0x75, 0x69, 0x72, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x65, 0x72,
+	0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x62, 0x65, 0x72, 0x6c,
+	0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x6c, 0x6f, 0x6e, 0x64, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda,
 	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31,
-	0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b,
-	0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5c, 0x0a, 0x0f, 0x62,
-	0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2,
-	0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69,
-	0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x62, 0x79, 0x7a, 0x61, 0x6e,
-	0x74, 0x69, 0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x6b, 0x0a, 0x14, 0x63, 0x6f, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
-	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x22, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c,
-	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5f, 0x0a, 0x10, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x62, 0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x34, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0f, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75,
-	0x72, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x0e, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x32, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x22, 0x52, 0x0d, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69,
-	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36,
-	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10, 0x6d, 0x75, 0x69, 0x72, 0x47, 0x6c, 0x61, 0x63,
-	0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x62, 0x65, 0x72, 0x6c,
-	0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
-	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x52, 0x0b, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a,
-	0x0c, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde,
-	0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x67, 0x0a, 0x13, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63,
-	0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x37, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65,
-	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x11, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x47,
-	0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x67,
-	0x72, 0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
-	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x72, 0x61, 0x79,
-	0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
-	0x10, 0x67, 0x72, 0x61, 0x79, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x6a, 0x0a, 0x14, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70,
-	0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c,
-	0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x12, 0x6d, 0x65, 0x72, 0x67, 0x65,
-	0x4e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x0d, 0x73, 0x68,
-	0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x31, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x14,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x22, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
-	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x61, 0x6e, 0x63,
-	0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x70, 0x72, 0x61, 0x67, 0x75, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
+	0x0b, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x67, 0x0a, 0x13,
+	0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xda, 0xde, 0x1f, 0x15, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72,
-	0x61, 0x67, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x70, 0x72, 0x61, 0x67,
-	0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x6b, 0x6c, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f,
+	0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x72,
+	0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x52, 0x11, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x67, 0x72, 0x61, 0x79, 0x5f, 0x67, 0x6c,
+	0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x72, 0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69,
+	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10, 0x67, 0x72, 0x61, 0x79, 0x47,
+	0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x6a, 0x0a, 0x14, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x52, 0x12, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4e, 0x65, 0x74, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xda, 0xde, 0x1f,
 	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x76, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x76, 0x65,
-	0x72, 0x6b, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x6f, 0x73, 0x61, 0x6b,
-	0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xda, 0xde,
-	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x6f, 0x73,
-	0x61, 0x6b, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08,
-	0x16, 0x10, 0x17, 0x4a, 0x04, 0x08, 0x17, 0x10, 0x18, 0x22, 0x2f, 0x0a, 0x05, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x50, 0x0a, 0x0f, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xca, 0x02, 0x0a,
-	0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c,
-	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08,
-	0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14,
-	0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a,
-	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08,
-	0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x90, 0x02, 0x0a, 0x08, 0x54, 0x78,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62,
-	0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde,
-	0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x22,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a,
-	0x03, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67,
-	0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
-	0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x61, 0x0a, 0x0b,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f,
-	0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0b, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22,
-	0xa0, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61,
-	0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b,
-	0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea,
-	0xde, 0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
-	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a,
-	0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea,
-	0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x10, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x2a, 0xc0, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x53, 0x53, 0x10,
-	0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12,
-	0x34, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
-	0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20,
-	0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x45,
-	0x44, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x1a,
-	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xab, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08,
-	0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6d,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0c,
+	0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0b,
+	0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x50,
+	0x0a, 0x0b, 0x70, 0x72, 0x61, 0x67, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde,
+	0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x61, 0x67, 0x75, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x70, 0x72, 0x61, 0x67, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x50, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x73, 0x61, 0x6b, 0x61,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x54, 0x69, 0x6d,
+	0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x16, 0x10, 0x17, 0x4a, 0x04, 0x08,
+	0x17, 0x10, 0x18, 0x22, 0x2f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x75, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x50, 0x0a, 0x0f, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xca, 0x02,
+	0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x2c, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a,
+	0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x14, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xea, 0xde, 0x1f,
+	0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x90, 0x02, 0x0a, 0x08, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
+	0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x61, 0x0a,
+	0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde,
+	0x1f, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0b, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
+	0x22, 0xa0, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63,
+	0x6b, 0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10,
+	0xea, 0xde, 0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42,
+	0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
+	0xea, 0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x10, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0e,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x13,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x2a, 0xc0, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x53, 0x53,
+	0x10, 0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73,
+	0x12, 0x34, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d,
+	0x20, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x45, 0x44, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xab, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42,
+	0x08, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76,
+	0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10058,34 +11855,38 @@ func file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_evm_vm_v1_evm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_cosmos_evm_vm_v1_evm_proto_goTypes = []interface{}{
 	(AccessType)(0),           // 0: cosmos.evm.vm.v1.AccessType
 	(*Params)(nil),            // 1: cosmos.evm.vm.v1.Params
-	(*AccessControl)(nil),     // 2: cosmos.evm.vm.v1.AccessControl
-	(*AccessControlType)(nil), // 3: cosmos.evm.vm.v1.AccessControlType
-	(*ChainConfig)(nil),       // 4: cosmos.evm.vm.v1.ChainConfig
-	(*State)(nil),             // 5: cosmos.evm.vm.v1.State
-	(*TransactionLogs)(nil),   // 6: cosmos.evm.vm.v1.TransactionLogs
-	(*Log)(nil),               // 7: cosmos.evm.vm.v1.Log
-	(*TxResult)(nil),          // 8: cosmos.evm.vm.v1.TxResult
-	(*AccessTuple)(nil),       // 9: cosmos.evm.vm.v1.AccessTuple
-	(*TraceConfig)(nil),       // 10: cosmos.evm.vm.v1.TraceConfig
-	(*Preinstall)(nil),        // 11: cosmos.evm.vm.v1.Preinstall
+	(*ScheduledEIP)(nil),      // 2: cosmos.evm.vm.v1.ScheduledEIP
+	(*AccessControl)(nil),     // 3: cosmos.evm.vm.v1.AccessControl
+	(*AccessControlType)(nil), // 4: cosmos.evm.vm.v1.AccessControlType
+	(*ChainConfig)(nil),       // 5: cosmos.evm.vm.v1.ChainConfig
+	(*State)(nil),             // 6: cosmos.evm.vm.v1.State
+	(*StorageProof)(nil),      // 7: cosmos.evm.vm.v1.StorageProof
+	(*TransactionLogs)(nil),   // 8: cosmos.evm.vm.v1.TransactionLogs
+	(*Log)(nil),               // 9: cosmos.evm.vm.v1.Log
+	(*TxResult)(nil),          // 10: cosmos.evm.vm.v1.TxResult
+	(*AccessTuple)(nil),       // 11: cosmos.evm.vm.v1.AccessTuple
+	(*TraceConfig)(nil),       // 12: cosmos.evm.vm.v1.TraceConfig
+	(*Preinstall)(nil),        // 13: cosmos.evm.vm.v1.Preinstall
 }
 var file_cosmos_evm_vm_v1_evm_proto_depIdxs = []int32{
-	2, // 0: cosmos.evm.vm.v1.Params.access_control:type_name -> cosmos.evm.vm.v1.AccessControl
-	3, // 1: cosmos.evm.vm.v1.AccessControl.create:type_name -> cosmos.evm.vm.v1.AccessControlType
-	3, // 2: cosmos.evm.vm.v1.AccessControl.call:type_name -> cosmos.evm.vm.v1.AccessControlType
-	0, // 3: cosmos.evm.vm.v1.AccessControlType.access_type:type_name -> cosmos.evm.vm.v1.AccessType
-	7, // 4: cosmos.evm.vm.v1.TransactionLogs.logs:type_name -> cosmos.evm.vm.v1.Log
-	6, // 5: cosmos.evm.vm.v1.TxResult.tx_logs:type_name -> cosmos.evm.vm.v1.TransactionLogs
-	4, // 6: cosmos.evm.vm.v1.TraceConfig.overrides:type_name -> cosmos.evm.vm.v1.ChainConfig
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	3, // 0: cosmos.evm.vm.v1.Params.access_control:type_name -> cosmos.evm.vm.v1.AccessControl
+	2, // 1: cosmos.evm.vm.v1.Params.scheduled_eips:type_name -> cosmos.evm.vm.v1.ScheduledEIP
+	4, // 2: cosmos.evm.vm.v1.AccessControl.create:type_name -> cosmos.evm.vm.v1.AccessControlType
+	4, // 3: cosmos.evm.vm.v1.AccessControl.call:type_name -> cosmos.evm.vm.v1.AccessControlType
+	0, // 4: cosmos.evm.vm.v1.AccessControlType.access_type:type_name -> cosmos.evm.vm.v1.AccessType
+	6, // 5: cosmos.evm.vm.v1.StorageProof.state:type_name -> cosmos.evm.vm.v1.State
+	9, // 6: cosmos.evm.vm.v1.TransactionLogs.logs:type_name -> cosmos.evm.vm.v1.Log
+	8, // 7: cosmos.evm.vm.v1.TxResult.tx_logs:type_name -> cosmos.evm.vm.v1.TransactionLogs
+	5, // 8: cosmos.evm.vm.v1.TraceConfig.overrides:type_name -> cosmos.evm.vm.v1.ChainConfig
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_vm_v1_evm_proto_init() }
@@ -10107,7 +11908,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControl); i {
+			switch v := v.(*ScheduledEIP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10119,7 +11920,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControlType); i {
+			switch v := v.(*AccessControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10131,7 +11932,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainConfig); i {
+			switch v := v.(*AccessControlType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10143,7 +11944,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*State); i {
+			switch v := v.(*ChainConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10155,7 +11956,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionLogs); i {
+			switch v := v.(*State); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10167,7 +11968,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+			switch v := v.(*StorageProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10179,7 +11980,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxResult); i {
+			switch v := v.(*TransactionLogs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10191,7 +11992,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessTuple); i {
+			switch v := v.(*Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10203,7 +12004,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraceConfig); i {
+			switch v := v.(*TxResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10215,6 +12016,30 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Preinstall); i {
 			case 0:
 				return &v.state
@@ -10233,7 +12058,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_vm_v1_evm_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
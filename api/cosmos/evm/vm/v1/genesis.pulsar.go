@@ -777,10 +777,12 @@ func (x *_GenesisAccount_3_list) IsValid() bool {
 }
 
 var (
-	md_GenesisAccount         protoreflect.MessageDescriptor
-	fd_GenesisAccount_address protoreflect.FieldDescriptor
-	fd_GenesisAccount_code    protoreflect.FieldDescriptor
-	fd_GenesisAccount_storage protoreflect.FieldDescriptor
+	md_GenesisAccount                          protoreflect.MessageDescriptor
+	fd_GenesisAccount_address                  protoreflect.FieldDescriptor
+	fd_GenesisAccount_code                     protoreflect.FieldDescriptor
+	fd_GenesisAccount_storage                  protoreflect.FieldDescriptor
+	fd_GenesisAccount_archived_storage_root    protoreflect.FieldDescriptor
+	fd_GenesisAccount_archived_storage_entries protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -789,6 +791,8 @@ func init() {
 	fd_GenesisAccount_address = md_GenesisAccount.Fields().ByName("address")
 	fd_GenesisAccount_code = md_GenesisAccount.Fields().ByName("code")
 	fd_GenesisAccount_storage = md_GenesisAccount.Fields().ByName("storage")
+	fd_GenesisAccount_archived_storage_root = md_GenesisAccount.Fields().ByName("archived_storage_root")
+	fd_GenesisAccount_archived_storage_entries = md_GenesisAccount.Fields().ByName("archived_storage_entries")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisAccount)(nil)
@@ -874,6 +878,18 @@ func (x *fastReflection_GenesisAccount) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.ArchivedStorageRoot != "" {
+		value := protoreflect.ValueOfString(x.ArchivedStorageRoot)
+		if !f(fd_GenesisAccount_archived_storage_root, value) {
+			return
+		}
+	}
+	if x.ArchivedStorageEntries != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ArchivedStorageEntries)
+		if !f(fd_GenesisAccount_archived_storage_entries, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -895,6 +911,10 @@ func (x *fastReflection_GenesisAccount) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Code != ""
 	case "cosmos.evm.vm.v1.GenesisAccount.storage":
 		return len(x.Storage) != 0
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_root":
+		return x.ArchivedStorageRoot != ""
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_entries":
+		return x.ArchivedStorageEntries != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GenesisAccount"))
@@ -917,6 +937,10 @@ func (x *fastReflection_GenesisAccount) Clear(fd protoreflect.FieldDescriptor) {
 		x.Code = ""
 	case "cosmos.evm.vm.v1.GenesisAccount.storage":
 		x.Storage = nil
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_root":
+		x.ArchivedStorageRoot = ""
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_entries":
+		x.ArchivedStorageEntries = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GenesisAccount"))
@@ -945,6 +969,12 @@ func (x *fastReflection_GenesisAccount) Get(descriptor protoreflect.FieldDescrip
 		}
 		listValue := &_GenesisAccount_3_list{list: &x.Storage}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_root":
+		value := x.ArchivedStorageRoot
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_entries":
+		value := x.ArchivedStorageEntries
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GenesisAccount"))
@@ -973,6 +1003,10 @@ func (x *fastReflection_GenesisAccount) Set(fd protoreflect.FieldDescriptor, val
 		lv := value.List()
 		clv := lv.(*_GenesisAccount_3_list)
 		x.Storage = *clv.list
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_root":
+		x.ArchivedStorageRoot = value.Interface().(string)
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_entries":
+		x.ArchivedStorageEntries = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GenesisAccount"))
@@ -1003,6 +1037,10 @@ func (x *fastReflection_GenesisAccount) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.GenesisAccount is not mutable"))
 	case "cosmos.evm.vm.v1.GenesisAccount.code":
 		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.GenesisAccount is not mutable"))
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_root":
+		panic(fmt.Errorf("field archived_storage_root of message cosmos.evm.vm.v1.GenesisAccount is not mutable"))
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_entries":
+		panic(fmt.Errorf("field archived_storage_entries of message cosmos.evm.vm.v1.GenesisAccount is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GenesisAccount"))
@@ -1023,6 +1061,10 @@ func (x *fastReflection_GenesisAccount) NewField(fd protoreflect.FieldDescriptor
 	case "cosmos.evm.vm.v1.GenesisAccount.storage":
 		list := []*State{}
 		return protoreflect.ValueOfList(&_GenesisAccount_3_list{list: &list})
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_root":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.GenesisAccount.archived_storage_entries":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GenesisAccount"))
@@ -1106,6 +1148,13 @@ func (x *fastReflection_GenesisAccount) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.ArchivedStorageRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ArchivedStorageEntries != 0 {
+			n += 1 + runtime.Sov(uint64(x.ArchivedStorageEntries))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1135,6 +1184,18 @@ func (x *fastReflection_GenesisAccount) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ArchivedStorageEntries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ArchivedStorageEntries))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ArchivedStorageRoot) > 0 {
+			i -= len(x.ArchivedStorageRoot)
+			copy(dAtA[i:], x.ArchivedStorageRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArchivedStorageRoot)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.Storage) > 0 {
 			for iNdEx := len(x.Storage) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Storage[iNdEx])
@@ -1312,6 +1373,57 @@ func (x *fastReflection_GenesisAccount) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchivedStorageRoot", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ArchivedStorageRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArchivedStorageEntries", wireType)
+				}
+				x.ArchivedStorageEntries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ArchivedStorageEntries |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1429,6 +1541,12 @@ type GenesisAccount struct {
 	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	// storage defines the set of state key values for the account.
 	Storage []*State `protobuf:"bytes,3,rep,name=storage,proto3" json:"storage,omitempty"`
+	// archived_storage_root defines the hex merkle root of the archived storage
+	// of the account, in which case the storage only holds its restored entries.
+	ArchivedStorageRoot string `protobuf:"bytes,4,opt,name=archived_storage_root,json=archivedStorageRoot,proto3" json:"archived_storage_root,omitempty"`
+	// archived_storage_entries defines the number of entries of the archived
+	// storage of the account.
+	ArchivedStorageEntries uint64 `protobuf:"varint,5,opt,name=archived_storage_entries,json=archivedStorageEntries,proto3" json:"archived_storage_entries,omitempty"`
 }
 
 func (x *GenesisAccount) Reset() {
@@ -1472,6 +1590,20 @@ func (x *GenesisAccount) GetStorage() []*State {
 	return nil
 }
 
+func (x *GenesisAccount) GetArchivedStorageRoot() string {
+	if x != nil {
+		return x.ArchivedStorageRoot
+	}
+	return ""
+}
+
+func (x *GenesisAccount) GetArchivedStorageEntries() uint64 {
+	if x != nil {
+		return x.ArchivedStorageEntries
+	}
+	return 0
+}
+
 var File_cosmos_evm_vm_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_vm_v1_genesis_proto_rawDesc = []byte{
@@ -1496,7 +1628,7 @@ var file_cosmos_evm_vm_v1_genesis_proto_rawDesc = []byte{
 	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
 	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
 	0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x70, 0x72,
-	0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x47, 0x65,
+	0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x0e, 0x47, 0x65,
 	0x6e, 0x65, 0x73, 0x69, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
 	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
@@ -1505,18 +1637,25 @@ var file_cosmos_evm_vm_v1_genesis_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x42, 0x14, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x07, 0x53, 0x74,
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x42, 0xaf, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b,
-	0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56,
-	0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x42, 0xaf, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6d,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -20,15 +20,15 @@ var supportedTxs = map[string]func() TxDataV2{
 }
 
 // getSender extracts the sender address from the signature values using the latest signer for the given chainID.
-func getSender(txData TxDataV2) (common.Address, error) {
-	chainID := txData.GetChainID()
+func getSender(tx *ethtypes.Transaction) (common.Address, error) {
+	chainID := tx.ChainId()
 	// legacy tx returns `0` as chainID when EIP-155 is not used
 	// seee: DeriveChainID
 	if chainID != nil && chainID.Sign() == 0 {
 		chainID = nil
 	}
 	signer := ethtypes.LatestSignerForChainID(chainID)
-	from, err := signer.Sender(ethtypes.NewTx(txData.AsEthereumData()))
+	from, err := signer.Sender(tx)
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -43,6 +43,33 @@ func GetSigners(msg protov2.Message) ([][]byte, error) {
 		return nil, fmt.Errorf("invalid type, expected MsgEthereumTx and got %T", msg)
 	}
 
+	tx, err := getTransaction(msgEthTx)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := getSender(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return [][]byte{sender.Bytes()}, nil
+}
+
+// getTransaction decodes the raw transaction, or rebuilds it from the data
+// field of legacy encoded messages
+func getTransaction(msgEthTx *MsgEthereumTx) (*ethtypes.Transaction, error) {
+	if len(msgEthTx.Raw) > 0 {
+		tx := new(ethtypes.Transaction)
+		if err := tx.UnmarshalBinary(msgEthTx.Raw); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	}
+
+	if msgEthTx.Data == nil {
+		return nil, fmt.Errorf("tx data is missing")
+	}
 	txDataFn, found := supportedTxs[msgEthTx.Data.TypeUrl]
 	if !found {
 		return nil, fmt.Errorf("invalid TypeUrl %s", msgEthTx.Data.TypeUrl)
@@ -53,11 +80,5 @@ func GetSigners(msg protov2.Message) ([][]byte, error) {
 	if err := msgEthTx.Data.UnmarshalTo(txData); err != nil {
 		return nil, err
 	}
-
-	sender, err := getSender(txData)
-	if err != nil {
-		return nil, err
-	}
-
-	return [][]byte{sender.Bytes()}, nil
+	return ethtypes.NewTx(txData.AsEthereumData()), nil
 }
@@ -28,8 +28,12 @@ const (
 	Query_Params_FullMethodName            = "/cosmos.evm.vm.v1.Query/Params"
 	Query_EthCall_FullMethodName           = "/cosmos.evm.vm.v1.Query/EthCall"
 	Query_EstimateGas_FullMethodName       = "/cosmos.evm.vm.v1.Query/EstimateGas"
+	Query_AccessList_FullMethodName        = "/cosmos.evm.vm.v1.Query/AccessList"
 	Query_TraceTx_FullMethodName           = "/cosmos.evm.vm.v1.Query/TraceTx"
 	Query_TraceBlock_FullMethodName        = "/cosmos.evm.vm.v1.Query/TraceBlock"
+	Query_TraceCall_FullMethodName         = "/cosmos.evm.vm.v1.Query/TraceCall"
+	Query_StorageRange_FullMethodName      = "/cosmos.evm.vm.v1.Query/StorageRange"
+	Query_StorageSlots_FullMethodName      = "/cosmos.evm.vm.v1.Query/StorageSlots"
 	Query_BaseFee_FullMethodName           = "/cosmos.evm.vm.v1.Query/BaseFee"
 	Query_Config_FullMethodName            = "/cosmos.evm.vm.v1.Query/Config"
 	Query_GlobalMinGasPrice_FullMethodName = "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice"
@@ -59,11 +63,19 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// AccessList implements the `eth_createAccessList` rpc api
+	AccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
+	// StorageRange implements the `debug_storageRangeAt` rpc api
+	StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error)
+	// StorageSlots implements the `evm_getStorageSlots` rpc api
+	StorageSlots(ctx context.Context, in *QueryStorageSlotsRequest, opts ...grpc.CallOption) (*QueryStorageSlotsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
@@ -166,6 +178,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) AccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryAccessListResponse, error) {
+	out := new(QueryAccessListResponse)
+	err := c.cc.Invoke(ctx, Query_AccessList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, Query_TraceTx_FullMethodName, in, out, opts...)
@@ -184,6 +205,33 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, Query_TraceCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error) {
+	out := new(QueryStorageRangeResponse)
+	err := c.cc.Invoke(ctx, Query_StorageRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageSlots(ctx context.Context, in *QueryStorageSlotsRequest, opts ...grpc.CallOption) (*QueryStorageSlotsResponse, error) {
+	out := new(QueryStorageSlotsResponse)
+	err := c.cc.Invoke(ctx, Query_StorageSlots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, Query_BaseFee_FullMethodName, in, out, opts...)
@@ -235,11 +283,19 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// AccessList implements the `eth_createAccessList` rpc api
+	AccessList(context.Context, *EthCallRequest) (*QueryAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
+	// StorageRange implements the `debug_storageRangeAt` rpc api
+	StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error)
+	// StorageSlots implements the `evm_getStorageSlots` rpc api
+	StorageSlots(context.Context, *QueryStorageSlotsRequest) (*QueryStorageSlotsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
@@ -285,12 +341,24 @@ func (UnimplementedQueryServer) EthCall(context.Context, *EthCallRequest) (*MsgE
 func (UnimplementedQueryServer) EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (UnimplementedQueryServer) AccessList(context.Context, *EthCallRequest) (*QueryAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccessList not implemented")
+}
 func (UnimplementedQueryServer) TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
 func (UnimplementedQueryServer) TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (UnimplementedQueryServer) TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
+func (UnimplementedQueryServer) StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageRange not implemented")
+}
+func (UnimplementedQueryServer) StorageSlots(context.Context, *QueryStorageSlotsRequest) (*QueryStorageSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageSlots not implemented")
+}
 func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -475,6 +543,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccessList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -511,6 +597,60 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TraceCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRange(ctx, req.(*QueryStorageRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageSlots(ctx, req.(*QueryStorageSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -608,6 +748,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "AccessList",
+			Handler:    _Query_AccessList_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -616,6 +760,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
+		{
+			MethodName: "StorageRange",
+			Handler:    _Query_StorageRange_Handler,
+		},
+		{
+			MethodName: "StorageSlots",
+			Handler:    _Query_StorageSlots_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
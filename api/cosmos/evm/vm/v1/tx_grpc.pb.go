@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.3.0
+// - protoc-gen-go-grpc v1.6.2
 // - protoc             (unknown)
 // source: cosmos/evm/vm/v1/tx.proto
 
@@ -15,18 +15,23 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_EthereumTx_FullMethodName          = "/cosmos.evm.vm.v1.Msg/EthereumTx"
-	Msg_UpdateParams_FullMethodName        = "/cosmos.evm.vm.v1.Msg/UpdateParams"
-	Msg_RegisterPreinstalls_FullMethodName = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_EthereumTx_FullMethodName             = "/cosmos.evm.vm.v1.Msg/EthereumTx"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.evm.vm.v1.Msg/UpdateParams"
+	Msg_RegisterPreinstalls_FullMethodName    = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_RecoverStuckFunds_FullMethodName      = "/cosmos.evm.vm.v1.Msg/RecoverStuckFunds"
+	Msg_ArchiveContractStorage_FullMethodName = "/cosmos.evm.vm.v1.Msg/ArchiveContractStorage"
+	Msg_RestoreContractStorage_FullMethodName = "/cosmos.evm.vm.v1.Msg/RestoreContractStorage"
 )
 
 // MsgClient is the client API for Msg service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Msg defines the evm Msg service.
 type MsgClient interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
 	EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
@@ -38,6 +43,18 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// RecoverStuckFunds defines a governance operation for sending the funds
+	// stuck in a module account, e.g. sent to its address by an EVM value
+	// transfer, to a recipient. The authority is the same as is used for Params
+	// updates.
+	RecoverStuckFunds(ctx context.Context, in *MsgRecoverStuckFunds, opts ...grpc.CallOption) (*MsgRecoverStuckFundsResponse, error)
+	// ArchiveContractStorage defines a governance operation archiving the
+	// storage of a contract which hasn't been accessed for the storage expiry
+	// blocks.
+	ArchiveContractStorage(ctx context.Context, in *MsgArchiveContractStorage, opts ...grpc.CallOption) (*MsgArchiveContractStorageResponse, error)
+	// RestoreContractStorage defines a method restoring entries of the archived
+	// storage of a contract with the proofs of their inclusion in its root.
+	RestoreContractStorage(ctx context.Context, in *MsgRestoreContractStorage, opts ...grpc.CallOption) (*MsgRestoreContractStorageResponse, error)
 }
 
 type msgClient struct {
@@ -49,8 +66,9 @@ func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
 }
 
 func (c *msgClient) EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgEthereumTxResponse)
-	err := c.cc.Invoke(ctx, Msg_EthereumTx_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_EthereumTx_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -58,8 +76,9 @@ func (c *msgClient) EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...g
 }
 
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,8 +86,39 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 }
 
 func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgRegisterPreinstallsResponse)
-	err := c.cc.Invoke(ctx, Msg_RegisterPreinstalls_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_RegisterPreinstalls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverStuckFunds(ctx context.Context, in *MsgRecoverStuckFunds, opts ...grpc.CallOption) (*MsgRecoverStuckFundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRecoverStuckFundsResponse)
+	err := c.cc.Invoke(ctx, Msg_RecoverStuckFunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ArchiveContractStorage(ctx context.Context, in *MsgArchiveContractStorage, opts ...grpc.CallOption) (*MsgArchiveContractStorageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgArchiveContractStorageResponse)
+	err := c.cc.Invoke(ctx, Msg_ArchiveContractStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RestoreContractStorage(ctx context.Context, in *MsgRestoreContractStorage, opts ...grpc.CallOption) (*MsgRestoreContractStorageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRestoreContractStorageResponse)
+	err := c.cc.Invoke(ctx, Msg_RestoreContractStorage_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +127,9 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
-// for forward compatibility
+// for forward compatibility.
+//
+// Msg defines the evm Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
 	EthereumTx(context.Context, *MsgEthereumTx) (*MsgEthereumTxResponse, error)
@@ -89,23 +141,48 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// RecoverStuckFunds defines a governance operation for sending the funds
+	// stuck in a module account, e.g. sent to its address by an EVM value
+	// transfer, to a recipient. The authority is the same as is used for Params
+	// updates.
+	RecoverStuckFunds(context.Context, *MsgRecoverStuckFunds) (*MsgRecoverStuckFundsResponse, error)
+	// ArchiveContractStorage defines a governance operation archiving the
+	// storage of a contract which hasn't been accessed for the storage expiry
+	// blocks.
+	ArchiveContractStorage(context.Context, *MsgArchiveContractStorage) (*MsgArchiveContractStorageResponse, error)
+	// RestoreContractStorage defines a method restoring entries of the archived
+	// storage of a contract with the proofs of their inclusion in its root.
+	RestoreContractStorage(context.Context, *MsgRestoreContractStorage) (*MsgRestoreContractStorageResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
-// UnimplementedMsgServer must be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
-}
+// UnimplementedMsgServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMsgServer struct{}
 
 func (UnimplementedMsgServer) EthereumTx(context.Context, *MsgEthereumTx) (*MsgEthereumTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EthereumTx not implemented")
+	return nil, status.Error(codes.Unimplemented, "method EthereumTx not implemented")
 }
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+	return nil, status.Error(codes.Unimplemented, "method UpdateParams not implemented")
 }
 func (UnimplementedMsgServer) RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
+	return nil, status.Error(codes.Unimplemented, "method RegisterPreinstalls not implemented")
+}
+func (UnimplementedMsgServer) RecoverStuckFunds(context.Context, *MsgRecoverStuckFunds) (*MsgRecoverStuckFundsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecoverStuckFunds not implemented")
+}
+func (UnimplementedMsgServer) ArchiveContractStorage(context.Context, *MsgArchiveContractStorage) (*MsgArchiveContractStorageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveContractStorage not implemented")
+}
+func (UnimplementedMsgServer) RestoreContractStorage(context.Context, *MsgRestoreContractStorage) (*MsgRestoreContractStorageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreContractStorage not implemented")
 }
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to MsgServer will
@@ -115,6 +192,13 @@ type UnsafeMsgServer interface {
 }
 
 func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	// If the following call panics, it indicates UnimplementedMsgServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&Msg_ServiceDesc, srv)
 }
 
@@ -172,6 +256,60 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RecoverStuckFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverStuckFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverStuckFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RecoverStuckFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverStuckFunds(ctx, req.(*MsgRecoverStuckFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ArchiveContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArchiveContractStorage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ArchiveContractStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveContractStorage(ctx, req.(*MsgArchiveContractStorage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RestoreContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestoreContractStorage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RestoreContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RestoreContractStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestoreContractStorage(ctx, req.(*MsgRestoreContractStorage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +329,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "RecoverStuckFunds",
+			Handler:    _Msg_RecoverStuckFunds_Handler,
+		},
+		{
+			MethodName: "ArchiveContractStorage",
+			Handler:    _Msg_ArchiveContractStorage_Handler,
+		},
+		{
+			MethodName: "RestoreContractStorage",
+			Handler:    _Msg_RestoreContractStorage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
@@ -0,0 +1,144 @@
+// Package loadtest implements a load test of the JSON-RPC server of a node,
+// sending EVM txs at a target TPS from funded accounts and recording their
+// inclusion latency, the achieved TPS and the size of the mempool.
+package loadtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagRPC            = "rpc"
+	flagPrivateKey     = "private-key"
+	flagScenario       = "scenario"
+	flagTPS            = "tps"
+	flagDuration       = "duration"
+	flagAccounts       = "accounts"
+	flagFundAmount     = "fund-amount"
+	flagReportInterval = "report-interval"
+	flagDrainTimeout   = "drain-timeout"
+	flagOutput         = "output"
+)
+
+// Config is the configuration of a load test.
+type Config struct {
+	// RPC is the URL of the JSON-RPC server.
+	RPC string
+	// PrivateKey is the hex private key of the account funding the accounts
+	// of the load test.
+	PrivateKey string
+	// Scenario is the scenario of the txs sent.
+	Scenario string
+	// TPS is the target number of txs sent per second.
+	TPS uint64
+	// Duration is the time during which the txs are sent.
+	Duration time.Duration
+	// Accounts is the number of accounts sending the txs.
+	Accounts int
+	// FundAmount is the amount of wei sent to each account.
+	FundAmount *big.Int
+	// ReportInterval is the interval at which the progress is printed.
+	ReportInterval time.Duration
+	// DrainTimeout is the maximum time to wait for the inclusion of the sent
+	// txs after the load test.
+	DrainTimeout time.Duration
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	switch {
+	case c.RPC == "":
+		return errors.New("rpc url cannot be empty")
+	case c.PrivateKey == "":
+		return errors.New("private key of the funder cannot be empty")
+	case !slices.Contains(Scenarios, c.Scenario):
+		return fmt.Errorf("invalid scenario %q, expected one of %s", c.Scenario, strings.Join(Scenarios, ", "))
+	case c.TPS == 0:
+		return errors.New("tps must be positive")
+	case c.Duration <= 0:
+		return errors.New("duration must be positive")
+	case c.Accounts <= 0:
+		return errors.New("number of accounts must be positive")
+	case c.FundAmount == nil || c.FundAmount.Sign() <= 0:
+		return errors.New("fund amount must be positive")
+	case c.ReportInterval <= 0:
+		return errors.New("report interval must be positive")
+	case c.DrainTimeout < 0:
+		return errors.New("drain timeout cannot be negative")
+	}
+	return nil
+}
+
+// Cmd returns the command running a load test against the JSON-RPC server of a
+// node.
+func Cmd() *cobra.Command {
+	var (
+		cfg        Config
+		fundAmount string
+		output     string
+	)
+	cmd := &cobra.Command{
+		Use:   "loadtest",
+		Short: "Send a sustained load of EVM txs to a node and report the latency, TPS and mempool stats",
+		Long: `Send a sustained load of EVM txs to the JSON-RPC server of a node and report the latency, TPS and mempool stats.
+
+The funder account of the private key funds the generated accounts, which send the txs of the scenario in turn at the target TPS:
+  transfer  native transfers between the accounts
+  erc20     ERC20 transfers between the accounts, of a token deployed and minted to them before the load test
+  deploy    deployments of ERC20 contracts
+  mixed     the transfer, erc20 and deploy txs in turn
+
+The mempool stats require the txpool JSON-RPC namespace to be enabled.`,
+		Example: "evmd loadtest --private-key 0x... --scenario erc20 --tps 200 --duration 5m --accounts 100",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var ok bool
+			cfg.FundAmount, ok = new(big.Int).SetString(fundAmount, 10)
+			if !ok {
+				return fmt.Errorf("invalid fund amount %q", fundAmount)
+			}
+
+			runner, err := NewRunner(cfg, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			report, err := runner.Run(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), report)
+			if output == "" {
+				return nil
+			}
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(output, bz, 0o600)
+		},
+	}
+
+	cmd.Flags().StringVar(&cfg.RPC, flagRPC, "http://localhost:8545", "URL of the JSON-RPC server")
+	cmd.Flags().StringVar(&cfg.PrivateKey, flagPrivateKey, "", "hex private key of the account funding the accounts of the load test")
+	cmd.Flags().StringVar(&cfg.Scenario, flagScenario, ScenarioTransfer, fmt.Sprintf("scenario of the txs (%s)", strings.Join(Scenarios, "|")))
+	cmd.Flags().Uint64Var(&cfg.TPS, flagTPS, 100, "target number of txs sent per second")
+	cmd.Flags().DurationVar(&cfg.Duration, flagDuration, time.Minute, "duration of the load test")
+	cmd.Flags().IntVar(&cfg.Accounts, flagAccounts, 50, "number of accounts sending the txs")
+	cmd.Flags().StringVar(&fundAmount, flagFundAmount, "1000000000000000000", "amount of wei sent to each account")
+	cmd.Flags().DurationVar(&cfg.ReportInterval, flagReportInterval, 5*time.Second, "interval at which the progress is printed")
+	cmd.Flags().DurationVar(&cfg.DrainTimeout, flagDrainTimeout, 30*time.Second, "maximum time to wait for the inclusion of the sent txs after the load test")
+	cmd.Flags().StringVar(&output, flagOutput, "", "path of the JSON file of the final report")
+	_ = cmd.MarkFlagRequired(flagPrivateKey)
+
+	return cmd
+}
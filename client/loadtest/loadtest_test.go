@@ -0,0 +1,80 @@
+package loadtest
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/contracts"
+)
+
+func TestConfigValidate(t *testing.T) {
+	valid := Config{
+		RPC:            "http://localhost:8545",
+		PrivateKey:     "0x01",
+		Scenario:       ScenarioMixed,
+		TPS:            100,
+		Duration:       time.Minute,
+		Accounts:       10,
+		FundAmount:     big.NewInt(1),
+		ReportInterval: time.Second,
+	}
+	require.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(cfg *Config)
+		expErr   string
+	}{
+		{"empty rpc", func(cfg *Config) { cfg.RPC = "" }, "rpc url cannot be empty"},
+		{"empty private key", func(cfg *Config) { cfg.PrivateKey = "" }, "private key of the funder cannot be empty"},
+		{"unknown scenario", func(cfg *Config) { cfg.Scenario = "swap" }, `invalid scenario "swap"`},
+		{"zero tps", func(cfg *Config) { cfg.TPS = 0 }, "tps must be positive"},
+		{"zero duration", func(cfg *Config) { cfg.Duration = 0 }, "duration must be positive"},
+		{"zero accounts", func(cfg *Config) { cfg.Accounts = 0 }, "number of accounts must be positive"},
+		{"nil fund amount", func(cfg *Config) { cfg.FundAmount = nil }, "fund amount must be positive"},
+		{"zero report interval", func(cfg *Config) { cfg.ReportInterval = 0 }, "report interval must be positive"},
+		{"negative drain timeout", func(cfg *Config) { cfg.DrainTimeout = -time.Second }, "drain timeout cannot be negative"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := valid
+			tc.malleate(&cfg)
+			require.ErrorContains(t, cfg.Validate(), tc.expErr)
+		})
+	}
+}
+
+func TestNextCall(t *testing.T) {
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	token := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	transfer, err := nextCall(ScenarioTransfer, 0, to, token)
+	require.NoError(t, err)
+	require.Equal(t, call{kind: ScenarioTransfer, to: &to, value: big.NewInt(1)}, transfer)
+
+	erc20, err := nextCall(ScenarioERC20, 0, to, token)
+	require.NoError(t, err)
+	require.Equal(t, &token, erc20.to)
+	method, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.MethodById(erc20.data)
+	require.NoError(t, err)
+	require.Equal(t, "transfer", method.Name)
+
+	deploy, err := nextCall(ScenarioDeploy, 0, to, token)
+	require.NoError(t, err)
+	require.Nil(t, deploy.to)
+	require.Equal(t, []byte(contracts.ERC20MinterBurnerDecimalsContract.Bin), deploy.data[:len(contracts.ERC20MinterBurnerDecimalsContract.Bin)])
+
+	// the mixed scenario cycles through the other scenarios
+	for i, kind := range []string{ScenarioTransfer, ScenarioERC20, ScenarioDeploy, ScenarioTransfer} {
+		c, err := nextCall(ScenarioMixed, uint64(i), to, token)
+		require.NoError(t, err)
+		require.Equal(t, kind, c.kind)
+	}
+
+	_, err = nextCall("swap", 0, to, token)
+	require.ErrorContains(t, err, "unknown scenario")
+}
@@ -0,0 +1,496 @@
+package loadtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/cosmos/evm/contracts"
+)
+
+const (
+	// dispatchInterval is the interval at which the txs due are dispatched
+	// to the accounts.
+	dispatchInterval = 10 * time.Millisecond
+	// pollInterval is the interval at which the node is polled for new blocks
+	// and receipts.
+	pollInterval = 200 * time.Millisecond
+	// receiptTimeout is the time to wait for the receipt of a setup tx.
+	receiptTimeout = time.Minute
+	// tokenSupply is the amount of tokens minted to each account.
+	tokenSupply = 1e24
+)
+
+// account is an account sending the txs of the load test.
+type account struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+	// nonce is the nonce of the next tx of the account, tracked locally to
+	// not query it before each tx.
+	nonce uint64
+	// txs are the indices of the txs to send, one at a time.
+	txs chan uint64
+}
+
+func newAccount(key *ecdsa.PrivateKey) *account {
+	return &account{
+		key:  key,
+		addr: crypto.PubkeyToAddress(key.PublicKey),
+		txs:  make(chan uint64, 1),
+	}
+}
+
+// fees are the fees of the txs, refreshed during the load test as the base fee
+// grows with the load.
+type fees struct {
+	mu     sync.RWMutex
+	tipCap *big.Int
+	feeCap *big.Int
+}
+
+func (f *fees) get() (*big.Int, *big.Int) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.tipCap, f.feeCap
+}
+
+func (f *fees) set(tipCap, feeCap *big.Int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tipCap, f.feeCap = tipCap, feeCap
+}
+
+// Runner runs a load test against the JSON-RPC server of a node.
+type Runner struct {
+	cfg Config
+	out io.Writer
+
+	rpc    *rpc.Client
+	client *ethclient.Client
+	signer ethtypes.Signer
+
+	funder   *account
+	accounts []*account
+	token    common.Address
+	// gas is the gas limit of the txs of each kind of call
+	gas  map[string]uint64
+	fees fees
+
+	stats *Stats
+}
+
+// NewRunner returns the runner of a load test, printing its progress to out.
+func NewRunner(cfg Config, out io.Writer) (*Runner, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(cfg.PrivateKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	return &Runner{
+		cfg:    cfg,
+		out:    out,
+		funder: newAccount(key),
+		gas:    make(map[string]uint64),
+	}, nil
+}
+
+// Run sets up the accounts of the load test, sends the txs for the configured
+// duration, waits for their inclusion and returns the report.
+func (r *Runner) Run(ctx context.Context) (Report, error) {
+	var err error
+	r.rpc, err = rpc.DialContext(ctx, r.cfg.RPC)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to dial %s: %w", r.cfg.RPC, err)
+	}
+	defer r.rpc.Close()
+	r.client = ethclient.NewClient(r.rpc)
+
+	if err := r.setup(ctx); err != nil {
+		return Report{}, err
+	}
+
+	fmt.Fprintf(r.out, "sending %s txs at %d TPS for %s from %d accounts\n",
+		r.cfg.Scenario, r.cfg.TPS, r.cfg.Duration, len(r.accounts))
+
+	r.stats = NewStats(time.Now())
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	defer stopWatching()
+	go r.watchBlocks(watchCtx)
+	go r.watchNode(watchCtx)
+
+	loadCtx, cancel := context.WithTimeout(ctx, r.cfg.Duration)
+	defer cancel()
+	var wg sync.WaitGroup
+	for i, acc := range r.accounts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range acc.txs {
+				r.send(loadCtx, acc, r.accounts[(i+1)%len(r.accounts)].addr, idx)
+			}
+		}()
+	}
+	r.dispatch(loadCtx)
+	wg.Wait()
+
+	r.drain(ctx)
+	stopWatching()
+
+	return r.stats.Report(time.Now()), nil
+}
+
+// setup fetches the chain id and the fees, funds the accounts of the load
+// test, deploys the token of the ERC20 transfers and estimates the gas of the
+// txs.
+func (r *Runner) setup(ctx context.Context) error {
+	chainID, err := r.client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the chain id: %w", err)
+	}
+	r.signer = ethtypes.LatestSignerForChainID(chainID)
+
+	if err := r.refreshFees(ctx); err != nil {
+		return err
+	}
+
+	r.funder.nonce, err = r.client.PendingNonceAt(ctx, r.funder.addr)
+	if err != nil {
+		return fmt.Errorf("failed to get the nonce of the funder: %w", err)
+	}
+
+	fmt.Fprintf(r.out, "funding %d accounts with %s\n", r.cfg.Accounts, r.cfg.FundAmount)
+	hashes := make([]common.Hash, 0, r.cfg.Accounts)
+	for range r.cfg.Accounts {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			return err
+		}
+		acc := newAccount(key)
+		r.accounts = append(r.accounts, acc)
+
+		hash, err := r.sendSetupTx(ctx, &acc.addr, r.cfg.FundAmount, nil, params.TxGas)
+		if err != nil {
+			return fmt.Errorf("failed to fund %s: %w", acc.addr, err)
+		}
+		hashes = append(hashes, hash)
+	}
+	if _, err := r.waitReceipts(ctx, hashes); err != nil {
+		return fmt.Errorf("failed to fund the accounts: %w", err)
+	}
+
+	if needsToken(r.cfg.Scenario) {
+		if err := r.setupToken(ctx); err != nil {
+			return err
+		}
+	}
+
+	return r.estimateGas(ctx)
+}
+
+// setupToken deploys the token of the ERC20 transfers and mints it to the
+// accounts.
+func (r *Runner) setupToken(ctx context.Context) error {
+	data, err := deployData("LoadTest", "LT", 18)
+	if err != nil {
+		return err
+	}
+	gas, err := r.client.EstimateGas(ctx, ethereum.CallMsg{From: r.funder.addr, Data: data})
+	if err != nil {
+		return fmt.Errorf("failed to estimate the gas of the token deployment: %w", err)
+	}
+	hash, err := r.sendSetupTx(ctx, nil, new(big.Int), data, withMargin(gas))
+	if err != nil {
+		return fmt.Errorf("failed to deploy the token: %w", err)
+	}
+	receipts, err := r.waitReceipts(ctx, []common.Hash{hash})
+	if err != nil {
+		return fmt.Errorf("failed to deploy the token: %w", err)
+	}
+	r.token = receipts[0].ContractAddress
+	fmt.Fprintf(r.out, "deployed the token at %s\n", r.token)
+
+	supply, _ := new(big.Float).SetFloat64(tokenSupply).Int(nil)
+	hashes := make([]common.Hash, 0, len(r.accounts))
+	for _, acc := range r.accounts {
+		data, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("mint", acc.addr, supply)
+		if err != nil {
+			return err
+		}
+		gas, err := r.client.EstimateGas(ctx, ethereum.CallMsg{From: r.funder.addr, To: &r.token, Data: data})
+		if err != nil {
+			return fmt.Errorf("failed to estimate the gas of the mint: %w", err)
+		}
+		hash, err := r.sendSetupTx(ctx, &r.token, new(big.Int), data, withMargin(gas))
+		if err != nil {
+			return fmt.Errorf("failed to mint the token to %s: %w", acc.addr, err)
+		}
+		hashes = append(hashes, hash)
+	}
+	if _, err := r.waitReceipts(ctx, hashes); err != nil {
+		return fmt.Errorf("failed to mint the token: %w", err)
+	}
+	return nil
+}
+
+// estimateGas estimates the gas limit of each kind of call of the scenario.
+func (r *Runner) estimateGas(ctx context.Context) error {
+	from, to := r.accounts[0].addr, r.accounts[len(r.accounts)-1].addr
+	for i := range uint64(len(Scenarios)) {
+		c, err := nextCall(r.cfg.Scenario, i, to, r.token)
+		if err != nil {
+			return err
+		}
+		if _, found := r.gas[c.kind]; found {
+			continue
+		}
+		gas, err := r.client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: c.to, Value: c.value, Data: c.data})
+		if err != nil {
+			return fmt.Errorf("failed to estimate the gas of the %s txs: %w", c.kind, err)
+		}
+		r.gas[c.kind] = withMargin(gas)
+	}
+	return nil
+}
+
+// withMargin returns the estimated gas with a margin of 20%.
+func withMargin(gas uint64) uint64 {
+	return gas + gas/5
+}
+
+// refreshFees sets the fee cap of the txs to twice the gas price of the node,
+// so that they remain valid while the base fee grows.
+func (r *Runner) refreshFees(ctx context.Context) error {
+	gasPrice, err := r.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the gas price: %w", err)
+	}
+	tipCap, err := r.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the gas tip cap: %w", err)
+	}
+	feeCap := new(big.Int).Mul(gasPrice, big.NewInt(2))
+	r.fees.set(tipCap, feeCap.Add(feeCap, tipCap))
+	return nil
+}
+
+// newTx returns the signed dynamic fee tx of the account.
+func (r *Runner) newTx(acc *account, to *common.Address, value *big.Int, data []byte, gas uint64) (*ethtypes.Transaction, error) {
+	tipCap, feeCap := r.fees.get()
+	return ethtypes.SignNewTx(acc.key, r.signer, &ethtypes.DynamicFeeTx{
+		ChainID:   r.signer.ChainID(),
+		Nonce:     acc.nonce,
+		GasTipCap: tipCap,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
+}
+
+// sendSetupTx sends a tx of the funder.
+func (r *Runner) sendSetupTx(ctx context.Context, to *common.Address, value *big.Int, data []byte, gas uint64) (common.Hash, error) {
+	tx, err := r.newTx(r.funder, to, value, data, gas)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := r.client.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	r.funder.nonce++
+	return tx.Hash(), nil
+}
+
+// waitReceipts waits for the successful receipts of the setup txs.
+func (r *Runner) waitReceipts(ctx context.Context, hashes []common.Hash) ([]*ethtypes.Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, receiptTimeout)
+	defer cancel()
+
+	receipts := make([]*ethtypes.Receipt, 0, len(hashes))
+	for _, hash := range hashes {
+		for {
+			receipt, err := r.client.TransactionReceipt(ctx, hash)
+			if err == nil {
+				if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+					return nil, fmt.Errorf("tx %s failed", hash)
+				}
+				receipts = append(receipts, receipt)
+				break
+			}
+			if !errors.Is(err, ethereum.NotFound) {
+				return nil, err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("tx %s not included: %w", hash, ctx.Err())
+			case <-time.After(pollInterval):
+			}
+		}
+	}
+	return receipts, nil
+}
+
+// dispatch dispatches the txs due at the target TPS to the accounts in turn
+// until the context is done. A tx is skipped if its account is still sending
+// its previous tx.
+func (r *Runner) dispatch(ctx context.Context) {
+	defer func() {
+		for _, acc := range r.accounts {
+			close(acc.txs)
+		}
+	}()
+
+	ticker := time.NewTicker(dispatchInterval)
+	defer ticker.Stop()
+
+	start := time.Now()
+	var dispatched uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			due := uint64(now.Sub(start).Seconds() * float64(r.cfg.TPS))
+			for ; dispatched < due; dispatched++ {
+				acc := r.accounts[dispatched%uint64(len(r.accounts))]
+				select {
+				case acc.txs <- dispatched:
+				default:
+					r.stats.Skipped()
+				}
+			}
+		}
+	}
+}
+
+// send sends the idx-th tx of the load test from the account. The nonce of
+// the account is resynced with the node if the tx is rejected.
+func (r *Runner) send(ctx context.Context, acc *account, to common.Address, idx uint64) {
+	c, err := nextCall(r.cfg.Scenario, idx, to, r.token)
+	if err != nil {
+		r.stats.SendError()
+		return
+	}
+	tx, err := r.newTx(acc, c.to, c.value, c.data, r.gas[c.kind])
+	if err != nil {
+		r.stats.SendError()
+		return
+	}
+
+	sentAt := time.Now()
+	if err := r.client.SendTransaction(ctx, tx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		r.stats.SendError()
+		if nonce, err := r.client.PendingNonceAt(ctx, acc.addr); err == nil {
+			acc.nonce = nonce
+		}
+		return
+	}
+	r.stats.Sent(tx.Hash(), sentAt)
+	acc.nonce++
+}
+
+// drain waits for the inclusion of the sent txs, up to the drain timeout.
+func (r *Runner) drain(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.DrainTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for r.stats.Pending() > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchBlocks records the txs of the new blocks until the context is done.
+func (r *Runner) watchBlocks(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	// the blocks are recorded from the first polled one
+	var last uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		latest, err := r.client.BlockNumber(ctx)
+		if err != nil {
+			continue
+		}
+		if last == 0 {
+			last = latest
+		}
+		for ; last < latest; last++ {
+			// the block is fetched without decoding its txs, which are only
+			// matched by hash
+			var block struct {
+				Transactions []common.Hash `json:"transactions"`
+			}
+			if err := r.rpc.CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.EncodeUint64(last+1), false); err != nil {
+				break
+			}
+			r.stats.Included(block.Transactions, time.Now())
+		}
+	}
+}
+
+// watchNode records the size of the mempool, refreshes the fees and prints
+// the report at each report interval until the context is done.
+func (r *Runner) watchNode(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.ReportInterval)
+	defer ticker.Stop()
+
+	mempool := true
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if mempool {
+			var status map[string]hexutil.Uint
+			if err := r.rpc.CallContext(ctx, &status, "txpool_status"); err != nil {
+				// the txpool namespace may be disabled
+				fmt.Fprintf(r.out, "mempool stats unavailable: %s\n", err)
+				mempool = false
+			} else {
+				r.stats.Mempool(uint64(status["pending"]), uint64(status["queued"]))
+			}
+		}
+		if err := r.refreshFees(ctx); err != nil && ctx.Err() == nil {
+			fmt.Fprintf(r.out, "%s\n", err)
+		}
+
+		fmt.Fprintln(r.out, r.stats.Report(time.Now()))
+	}
+}
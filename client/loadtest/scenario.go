@@ -0,0 +1,80 @@
+package loadtest
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+)
+
+// The scenarios of the load test.
+const (
+	// ScenarioTransfer sends native transfers between the accounts.
+	ScenarioTransfer = "transfer"
+	// ScenarioERC20 sends ERC20 transfers between the accounts.
+	ScenarioERC20 = "erc20"
+	// ScenarioDeploy deploys ERC20 contracts.
+	ScenarioDeploy = "deploy"
+	// ScenarioMixed cycles through the transfer, erc20 and deploy scenarios.
+	ScenarioMixed = "mixed"
+)
+
+// Scenarios are the scenarios of the load test.
+var Scenarios = []string{ScenarioTransfer, ScenarioERC20, ScenarioDeploy, ScenarioMixed}
+
+// call is the content of a tx sent by the load test.
+type call struct {
+	kind  string
+	to    *common.Address
+	value *big.Int
+	data  []byte
+}
+
+// needsToken returns whether the scenario sends ERC20 transfers, which
+// requires a token funding the accounts.
+func needsToken(scenario string) bool {
+	return scenario == ScenarioERC20 || scenario == ScenarioMixed
+}
+
+// nextCall returns the call of the i-th tx of the scenario, sent from an
+// account to the next one.
+func nextCall(scenario string, i uint64, to, token common.Address) (call, error) {
+	kind := scenario
+	if scenario == ScenarioMixed {
+		kind = Scenarios[i%3]
+	}
+
+	switch kind {
+	case ScenarioTransfer:
+		return call{kind: kind, to: &to, value: big.NewInt(1)}, nil
+	case ScenarioERC20:
+		data, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", to, big.NewInt(1))
+		if err != nil {
+			return call{}, err
+		}
+		return call{kind: kind, to: &token, value: new(big.Int), data: data}, nil
+	case ScenarioDeploy:
+		data, err := deployData("LoadTest", "LT", 18)
+		if err != nil {
+			return call{}, err
+		}
+		return call{kind: kind, value: new(big.Int), data: data}, nil
+	default:
+		return call{}, fmt.Errorf("unknown scenario %q", scenario)
+	}
+}
+
+// deployData returns the data of the deployment of an ERC20.
+func deployData(name, symbol string, decimals uint8) ([]byte, error) {
+	contract := contracts.ERC20MinterBurnerDecimalsContract
+	args, err := contract.ABI.Pack("", name, symbol, decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, len(contract.Bin)+len(args))
+	data = append(data, contract.Bin...)
+	return append(data, args...), nil
+}
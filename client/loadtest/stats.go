@@ -0,0 +1,183 @@
+package loadtest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Stats records the txs sent by the load test, their inclusion in the blocks
+// and the size of the mempool of the node. It is safe for concurrent use.
+type Stats struct {
+	mu sync.Mutex
+
+	start time.Time
+	// sentAt are the send times of the txs not included yet
+	sentAt map[common.Hash]time.Time
+
+	sent       uint64
+	sendErrors uint64
+	skipped    uint64
+	included   uint64
+	blocks     uint64
+	latencies  []time.Duration
+
+	mempoolPending    uint64
+	mempoolQueued     uint64
+	maxMempoolPending uint64
+}
+
+// NewStats returns the stats of a load test started at the given time.
+func NewStats(start time.Time) *Stats {
+	return &Stats{
+		start:  start,
+		sentAt: make(map[common.Hash]time.Time),
+	}
+}
+
+// Sent records a tx sent at the given time.
+func (s *Stats) Sent(hash common.Hash, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent++
+	s.sentAt[hash] = at
+}
+
+// SendError records a tx rejected by the node.
+func (s *Stats) SendError() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sendErrors++
+}
+
+// Skipped records a tx not sent because its account was still sending the
+// previous one, i.e. the load test can't sustain the target TPS.
+func (s *Stats) Skipped() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.skipped++
+}
+
+// Included records the txs of a block seen at the given time. The txs not sent
+// by the load test are ignored.
+func (s *Stats) Included(hashes []common.Hash, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blocks++
+	for _, hash := range hashes {
+		sentAt, found := s.sentAt[hash]
+		if !found {
+			continue
+		}
+		delete(s.sentAt, hash)
+		s.included++
+		s.latencies = append(s.latencies, at.Sub(sentAt))
+	}
+}
+
+// Mempool records the number of pending and queued txs of the mempool.
+func (s *Stats) Mempool(pending, queued uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mempoolPending = pending
+	s.mempoolQueued = queued
+	s.maxMempoolPending = max(s.maxMempoolPending, pending)
+}
+
+// Pending returns the number of sent txs not included yet.
+func (s *Stats) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.sentAt)
+}
+
+// Report returns the report of the load test at the given time.
+func (s *Stats) Report(now time.Time) Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elapsed := now.Sub(s.start)
+	report := Report{
+		Elapsed:           elapsed,
+		Sent:              s.sent,
+		SendErrors:        s.sendErrors,
+		Skipped:           s.skipped,
+		Included:          s.included,
+		Pending:           uint64(len(s.sentAt)),
+		Blocks:            s.blocks,
+		MempoolPending:    s.mempoolPending,
+		MempoolQueued:     s.mempoolQueued,
+		MaxMempoolPending: s.maxMempoolPending,
+	}
+	if elapsed > 0 {
+		report.SendTPS = float64(s.sent) / elapsed.Seconds()
+		report.IncludedTPS = float64(s.included) / elapsed.Seconds()
+	}
+
+	latencies := make([]time.Duration, len(s.latencies))
+	copy(latencies, s.latencies)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	report.LatencyP50 = percentile(latencies, 50)
+	report.LatencyP90 = percentile(latencies, 90)
+	report.LatencyP99 = percentile(latencies, 99)
+	if len(latencies) > 0 {
+		report.LatencyMax = latencies[len(latencies)-1]
+	}
+
+	return report
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+// Report is the summary of a load test, where the latency of a tx is the time
+// from its sending to the first poll of the node seeing the block including
+// it.
+type Report struct {
+	Elapsed           time.Duration `json:"elapsed"`
+	Sent              uint64        `json:"sent"`
+	SendErrors        uint64        `json:"send_errors"`
+	Skipped           uint64        `json:"skipped"`
+	Included          uint64        `json:"included"`
+	Pending           uint64        `json:"pending"`
+	Blocks            uint64        `json:"blocks"`
+	SendTPS           float64       `json:"send_tps"`
+	IncludedTPS       float64       `json:"included_tps"`
+	LatencyP50        time.Duration `json:"latency_p50"`
+	LatencyP90        time.Duration `json:"latency_p90"`
+	LatencyP99        time.Duration `json:"latency_p99"`
+	LatencyMax        time.Duration `json:"latency_max"`
+	MempoolPending    uint64        `json:"mempool_pending"`
+	MempoolQueued     uint64        `json:"mempool_queued"`
+	MaxMempoolPending uint64        `json:"max_mempool_pending"`
+}
+
+// String returns the report on one line, to be printed during the load test.
+func (r Report) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "elapsed=%s sent=%d errors=%d skipped=%d included=%d pending=%d blocks=%d",
+		r.Elapsed.Round(time.Second), r.Sent, r.SendErrors, r.Skipped, r.Included, r.Pending, r.Blocks)
+	fmt.Fprintf(&sb, " send_tps=%.2f included_tps=%.2f", r.SendTPS, r.IncludedTPS)
+	fmt.Fprintf(&sb, " latency_p50=%s latency_p90=%s latency_p99=%s latency_max=%s",
+		r.LatencyP50.Round(time.Millisecond), r.LatencyP90.Round(time.Millisecond),
+		r.LatencyP99.Round(time.Millisecond), r.LatencyMax.Round(time.Millisecond))
+	fmt.Fprintf(&sb, " mempool_pending=%d mempool_queued=%d max_mempool_pending=%d",
+		r.MempoolPending, r.MempoolQueued, r.MaxMempoolPending)
+	return sb.String()
+}
@@ -0,0 +1,64 @@
+package loadtest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/client/loadtest"
+)
+
+func TestStats(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	stats := loadtest.NewStats(start)
+
+	// 100 txs sent each 10ms after the start, included after 1ms to 100ms
+	var hashes []common.Hash
+	for i := range 100 {
+		hash := common.BigToHash(common.Big1)
+		hash[0] = byte(i)
+		hashes = append(hashes, hash)
+		stats.Sent(hash, start.Add(time.Duration(i)*10*time.Millisecond))
+	}
+	stats.SendError()
+	stats.Skipped()
+	stats.Mempool(30, 2)
+	stats.Mempool(10, 1)
+	require.Equal(t, 100, stats.Pending())
+
+	for i, hash := range hashes[:90] {
+		at := start.Add(time.Duration(i)*10*time.Millisecond + time.Duration(i+1)*time.Millisecond)
+		// the txs not sent by the load test are ignored
+		stats.Included([]common.Hash{hash, {0xff}}, at)
+	}
+	require.Equal(t, 10, stats.Pending())
+
+	report := stats.Report(start.Add(10 * time.Second))
+	require.Equal(t, loadtest.Report{
+		Elapsed:           10 * time.Second,
+		Sent:              100,
+		SendErrors:        1,
+		Skipped:           1,
+		Included:          90,
+		Pending:           10,
+		Blocks:            90,
+		SendTPS:           10,
+		IncludedTPS:       9,
+		LatencyP50:        45 * time.Millisecond,
+		LatencyP90:        81 * time.Millisecond,
+		LatencyP99:        90 * time.Millisecond,
+		LatencyMax:        90 * time.Millisecond,
+		MempoolPending:    10,
+		MempoolQueued:     1,
+		MaxMempoolPending: 30,
+	}, report)
+	require.Contains(t, report.String(), "included=90 pending=10")
+}
+
+func TestStatsEmpty(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	report := loadtest.NewStats(start).Report(start)
+	require.Equal(t, loadtest.Report{}, report)
+}
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	evmante "github.com/cosmos/evm/ante/evm"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,7 +30,7 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 					anteHandler = newMonoEVMAnteHandler(options)
 				case "/cosmos.evm.types.v1.ExtensionOptionDynamicFeeTx":
 					// cosmos-sdk tx with dynamic fee extension
-					anteHandler = newCosmosAnteHandler(options)
+					anteHandler = newCosmosOrMixedAnteHandler(options, tx)
 				default:
 					return ctx, errorsmod.Wrapf(
 						errortypes.ErrUnknownExtensionOptions,
@@ -43,7 +45,7 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		// handle as totally normal Cosmos SDK tx
 		switch tx.(type) {
 		case sdk.Tx:
-			anteHandler = newCosmosAnteHandler(options)
+			anteHandler = newCosmosOrMixedAnteHandler(options, tx)
 		default:
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid transaction type: %T", tx)
 		}
@@ -51,3 +53,13 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		return anteHandler(ctx, tx, sim)
 	}
 }
+
+// newCosmosOrMixedAnteHandler returns the mixed ante handler if the Cosmos tx
+// combines a MsgEthereumTx with Cosmos messages, and the default Cosmos ante
+// handler otherwise.
+func newCosmosOrMixedAnteHandler(options HandlerOptions, tx sdk.Tx) sdk.AnteHandler {
+	if evmante.IsMixedTx(tx) {
+		return newMixedAnteHandler(options)
+	}
+	return newCosmosAnteHandler(options)
+}
@@ -14,6 +14,8 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.FeeMarketKeeper,
 			options.EvmKeeper,
 			options.MaxTxGasWanted,
-		),
+		).WithFeegrantKeeper(options.FeegrantKeeper).
+			WithCheckTxSimulation(options.SimulateCheckTx).
+			WithPriorityBounds(options.PriorityFloor, options.PriorityCeiling),
 	)
 }
@@ -29,6 +29,9 @@ type HandlerOptions struct {
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
+	SimulateCheckTx        bool
+	PriorityFloor          int64
+	PriorityCeiling        int64
 	TxFeeChecker           ante.TxFeeChecker
 }
 
@@ -13,6 +13,7 @@ import (
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	dbm "github.com/cosmos/cosmos-db"
 	evmante "github.com/cosmos/evm/ante"
@@ -40,6 +41,7 @@ import (
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/store/versioned"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
@@ -287,6 +289,11 @@ func NewExampleApp(
 		panic("version db not supported in this example chain")
 	}
 
+	// serve the queries from the committed versions of the stores they read
+	if cast.ToBool(appOpts.Get(srvflags.EVMVersionedQueries)) {
+		bApp.SetQueryMultiStore(versioned.NewStore(bApp.CommitMultiStore()))
+	}
+
 	app := &EVMD{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -481,7 +488,17 @@ func NewExampleApp(
 		app.FeeMarketKeeper,
 		&app.Erc20Keeper,
 		tracer,
-	)
+	).SetRecordWitness(cast.ToBool(appOpts.Get(srvflags.EVMRecordWitness))).
+		SetRecordModifiedAccounts(cast.ToBool(appOpts.Get(srvflags.EVMRecordModifiedAccounts))).
+		SetCompactEvents(cast.ToBool(appOpts.Get(srvflags.EVMCompactEvents))).
+		SetStrictGasAccounting(cast.ToBool(appOpts.Get(srvflags.EVMStrictGasAccounting))).
+		SetTraceCaps(
+			cast.ToDuration(appOpts.Get(srvflags.JSONRPCTraceTimeoutCap)),
+			cast.ToInt(appOpts.Get(srvflags.JSONRPCTracerSizeCap)),
+			cast.ToInt(appOpts.Get(srvflags.JSONRPCTracerResultSizeCap)),
+		).
+		SetBlockedAddrs(evmdconfig.BlockedModuleAddresses()).
+		SetRecoverableModules(minttypes.ModuleName)
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
@@ -741,15 +758,19 @@ func NewExampleApp(
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
 
-	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
-
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetPrepareCheckStater(app.PrepareCheckStater)
 
-	app.setAnteHandler(app.txConfig, maxGasWanted)
+	app.setAnteHandler(app.txConfig, appOpts)
+
+	// the eth txs go through the ante handler before their parallel execution
+	if cast.ToBool(appOpts.Get(srvflags.EVMParallelExecution)) {
+		app.EVMKeeper.SetParallelExecution(app.txConfig.TxDecoder(), app.AnteHandler())
+	}
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
@@ -789,12 +810,24 @@ func NewExampleApp(
 			logger.Error("error on loading last version", "err", err)
 			os.Exit(1)
 		}
+
+		// restore the evm forks activated by previous upgrades
+		ctx := app.NewContextLegacy(true, tmproto.Header{Height: app.LastBlockHeight()})
+		if err := app.EVMKeeper.LoadForkActivations(ctx); err != nil {
+			logger.Error("error on loading evm fork activations", "err", err)
+			os.Exit(1)
+		}
+		// refuse to start with the evm chain config of another chain
+		if err := app.EVMKeeper.ValidateEVMChainID(ctx); err != nil {
+			logger.Error("error on validating the evm chain id", "err", err)
+			os.Exit(1)
+		}
 	}
 
 	return app
 }
 
-func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *EVMD) setAnteHandler(txConfig client.TxConfig, appOpts servertypes.AppOptions) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -806,7 +839,10 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 		FeeMarketKeeper:        app.FeeMarketKeeper,
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         evmante.SigVerificationGasConsumer,
-		MaxTxGasWanted:         maxGasWanted,
+		MaxTxGasWanted:         cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted)),
+		SimulateCheckTx:        cast.ToBool(appOpts.Get(srvflags.EVMSimulateCheckTx)),
+		PriorityFloor:          cast.ToInt64(appOpts.Get(srvflags.EVMPriorityFloor)),
+		PriorityCeiling:        cast.ToInt64(appOpts.Get(srvflags.EVMPriorityCeiling)),
 		TxFeeChecker:           cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
 	}
 	if err := options.Validate(); err != nil {
@@ -832,7 +868,14 @@ func (app *EVMD) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *EVMD) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
-	return app.ModuleManager.BeginBlock(ctx)
+	res, err := app.ModuleManager.BeginBlock(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	// the eth txs are executed in parallel once the block state is set up
+	app.EVMKeeper.ExecuteParallel(ctx)
+	return res, nil
 }
 
 // EndBlocker application updates every end block
@@ -840,6 +883,13 @@ func (app *EVMD) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 	return app.ModuleManager.EndBlock(ctx)
 }
 
+// PrepareCheckStater application updates after every commit
+func (app *EVMD) PrepareCheckStater(ctx sdk.Context) {
+	if err := app.ModuleManager.PrepareCheckState(ctx); err != nil {
+		app.Logger().Error("error on preparing the check state", "err", err)
+	}
+}
+
 func (app *EVMD) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
 	return app.BaseApp.FinalizeBlock(req)
 }
@@ -862,7 +912,8 @@ func (app *EVMD) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci
 	return app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
-func (app *EVMD) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *EVMD) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	app.EVMKeeper.ScheduleParallelExecution(req.Txs)
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -0,0 +1,155 @@
+package evmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// StoreDivergence is the first key, in the order of the keys, whose value
+// differs between a store of the app and the same store of a reference app.
+// A nil value means that the key is missing from the corresponding state.
+type StoreDivergence struct {
+	Store    string
+	Key      []byte
+	Value    []byte
+	RefValue []byte
+}
+
+// commitInfoStore is implemented by the root multi store, which keeps the
+// commit info of every committed version.
+type commitInfoStore interface {
+	GetCommitInfo(version int64) (*storetypes.CommitInfo, error)
+}
+
+// ReplayBlock finalizes and commits a block of the block store of a node, as
+// the consensus engine does when it replays its blocks. The state of the app
+// must be at the height preceding the block, and lastValSet is the validator
+// set which signed the last commit of the block.
+func (app *EVMD) ReplayBlock(
+	block *cmttypes.Block,
+	lastValSet *cmttypes.ValidatorSet,
+	initialHeight int64,
+) (*abci.ResponseFinalizeBlock, error) {
+	if block.Height != app.LastBlockHeight()+1 {
+		return nil, fmt.Errorf("block at height %d doesn't follow the state at height %d", block.Height, app.LastBlockHeight())
+	}
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  sm.BuildLastCommitInfo(block, lastValSet, initialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize block at height %d: %w", block.Height, err)
+	}
+	if len(res.TxResults) != len(block.Txs) {
+		return nil, fmt.Errorf("got %d tx results for the %d txs of the block", len(res.TxResults), len(block.Txs))
+	}
+	if _, err := app.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit block at height %d: %w", block.Height, err)
+	}
+	return res, nil
+}
+
+// StoreDivergences compares the stores of the app with the stores of a
+// reference app at the given height, which both must still keep, and returns
+// the first divergent key of every store whose hash differs, ordered by store
+// name.
+func (app *EVMD) StoreDivergences(ref *EVMD, height int64) ([]StoreDivergence, error) {
+	hashes, err := storeHashes(app, height)
+	if err != nil {
+		return nil, err
+	}
+	refHashes, err := storeHashes(ref, height)
+	if err != nil {
+		return nil, fmt.Errorf("reference state: %w", err)
+	}
+
+	var names []string
+	for name, hash := range hashes {
+		if !bytes.Equal(hash, refHashes[name]) {
+			names = append(names, name)
+		}
+	}
+	for name := range refHashes {
+		if _, ok := hashes[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	ms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, err
+	}
+	refMs, err := ref.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("reference state: %w", err)
+	}
+
+	divergences := make([]StoreDivergence, 0, len(names))
+	for _, name := range names {
+		divergence := StoreDivergence{Store: name}
+		key, refKey := app.keys[name], ref.keys[name]
+		if key != nil && refKey != nil {
+			divergence.Key, divergence.Value, divergence.RefValue = firstDivergence(ms.GetKVStore(key), refMs.GetKVStore(refKey))
+		}
+		divergences = append(divergences, divergence)
+	}
+	return divergences, nil
+}
+
+// storeHashes returns the hashes of the stores of the app committed at the
+// given height, by store name.
+func storeHashes(app *EVMD, height int64) (map[string][]byte, error) {
+	cms, ok := app.CommitMultiStore().(commitInfoStore)
+	if !ok {
+		return nil, errors.New("commit infos not supported by the multi store")
+	}
+	info, err := cms.GetCommitInfo(height)
+	if err != nil {
+		return nil, fmt.Errorf("commit info at height %d: %w", height, err)
+	}
+
+	hashes := make(map[string][]byte, len(info.StoreInfos))
+	for _, storeInfo := range info.StoreInfos {
+		hashes[storeInfo.Name] = storeInfo.CommitId.Hash
+	}
+	return hashes, nil
+}
+
+// firstDivergence iterates the two stores in the order of their keys, and
+// returns the first key missing from one of them or whose values differ.
+func firstDivergence(store, ref storetypes.KVStore) (key, value, refValue []byte) {
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	refIt := ref.Iterator(nil, nil)
+	defer refIt.Close()
+
+	for it.Valid() || refIt.Valid() {
+		switch {
+		case !refIt.Valid() || (it.Valid() && bytes.Compare(it.Key(), refIt.Key()) < 0):
+			return bytes.Clone(it.Key()), bytes.Clone(it.Value()), nil
+		case !it.Valid() || bytes.Compare(it.Key(), refIt.Key()) > 0:
+			return bytes.Clone(refIt.Key()), nil, bytes.Clone(refIt.Value())
+		case !bytes.Equal(it.Value(), refIt.Value()):
+			return bytes.Clone(it.Key()), bytes.Clone(it.Value()), bytes.Clone(refIt.Value())
+		}
+		it.Next()
+		refIt.Next()
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,87 @@
+package evmd_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// setupReplayApp returns an app initialized with a genesis which is the same
+// on every call, so that the apps replaying the same blocks have the same
+// state.
+func setupReplayApp(t *testing.T) *evmd.EVMD {
+	t.Helper()
+
+	validator := cmttypes.NewValidator(ed25519.GenPrivKeyFromSecret([]byte("validator")).PubKey(), 1)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{validator})
+
+	pubKey := secp256k1.GenPrivKeyFromSecret([]byte("account")).PubKey()
+	acc := authtypes.NewBaseAccount(pubKey.Address().Bytes(), pubKey, 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000000000000))),
+	}
+
+	return evmd.SetupWithGenesisValSet(
+		t, constants.ExampleChainID.ChainID, constants.ExampleChainID.EVMChainID, valSet, []authtypes.GenesisAccount{acc}, balance,
+	)
+}
+
+func TestReplayBlock(t *testing.T) {
+	app, ref := setupReplayApp(t), setupReplayApp(t)
+
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	block := cmttypes.MakeBlock(1, nil, &cmttypes.Commit{}, nil)
+	block.Time = blockTime
+	res, err := app.ReplayBlock(block, nil, 1)
+	require.NoError(t, err)
+	refRes, err := ref.ReplayBlock(block, nil, 1)
+	require.NoError(t, err)
+	require.Equal(t, refRes.AppHash, res.AppHash)
+
+	divergences, err := app.StoreDivergences(ref, 1)
+	require.NoError(t, err)
+	require.Empty(t, divergences)
+
+	_, err = app.ReplayBlock(block, nil, 1)
+	require.ErrorContains(t, err, "doesn't follow the state at height 1")
+
+	// a code stored by the app only
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	app.EVMKeeper.SetCode(app.NewUncachedContext(false, *block.Header.ToProto()), codeHash, code)
+
+	// the votes of the last commit are left out, as the genesis has no
+	// signing info of the validator
+	block = cmttypes.MakeBlock(2, nil, &cmttypes.Commit{Height: 1}, nil)
+	block.Time = blockTime.Add(time.Second)
+	res, err = app.ReplayBlock(block, &cmttypes.ValidatorSet{}, 1)
+	require.NoError(t, err)
+	refRes, err = ref.ReplayBlock(block, &cmttypes.ValidatorSet{}, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, refRes.AppHash, res.AppHash)
+
+	divergences, err = app.StoreDivergences(ref, 2)
+	require.NoError(t, err)
+	require.Equal(t, []evmd.StoreDivergence{{
+		Store: evmtypes.StoreKey,
+		Key:   append(append([]byte{}, evmtypes.KeyPrefixCode...), codeHash...),
+		Value: code,
+	}}, divergences)
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/evmd"
+	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flagHeight  = "height"
+	flagModules = "modules"
+)
+
+// NewCheckInvariantsCmd returns the command running the invariants of the
+// modules against the state of the node, e.g. to check that the evm balances
+// match the supply of the evm denom.
+func NewCheckInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Run the invariants of the modules against the state of the node",
+		Long: `Load the state of the node at its latest height, or at the given height, and
+run the invariants registered by the modules, as the crisis module does. The
+node must be stopped, as its database is opened.
+
+The command fails if any invariant is broken.
+`,
+		Example: "evmd check-invariants --modules evm --height 1000",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			moduleNames, err := cmd.Flags().GetStringSlice(flagModules)
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(serverCtx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := evmd.NewExampleApp(
+				log.NewNopLogger(),
+				db,
+				nil,
+				height == 0,
+				serverCtx.Viper,
+				getEVMChainIDFromOpts(serverCtx.Viper),
+				evmdconfig.EvmAppOptions,
+			)
+			if height != 0 {
+				if err := app.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+			if app.LastBlockHeight() == 0 {
+				return errors.New("no committed state")
+			}
+
+			ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+			broken := 0
+			for _, res := range app.RunInvariants(ctx, moduleNames...) {
+				status := "ok"
+				if res.Broken {
+					status = "BROKEN"
+					broken++
+				}
+				cmd.Printf("[%s] %s", status, res.Message)
+			}
+			if broken > 0 {
+				return fmt.Errorf("%d invariants broken at height %d", broken, app.LastBlockHeight())
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height of the state to check, the latest one if 0")
+	cmd.Flags().StringSlice(flagModules, nil, "Modules whose invariants are run, all of them if empty")
+
+	return cmd
+}
@@ -137,7 +137,7 @@ func (a appCreator) appExport(
 		traceStore,
 		loadLatest,
 		appOpts,
-		evmdconfig.EVMChainID,
+		getEVMChainIDFromOpts(appOpts),
 		evmdconfig.EvmAppOptions,
 	)
 
@@ -0,0 +1,282 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/evmd"
+	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flagFromHeight = "from"
+	flagToHeight   = "to"
+	flagWorkDir    = "work-dir"
+)
+
+// NewReplayVerifyCmd returns the command re-executing a range of blocks of the
+// block store of the node and verifying their app hashes, to diagnose the
+// consensus failures of the node.
+func NewReplayVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-verify",
+		Short: "Re-execute a range of blocks of the node and verify their app hashes",
+		Long: `Copy the application database of the node, roll the copy back to the height
+preceding the first block of the range, and re-execute the blocks of the block
+store up to the last block of the range. The app hash of every block is
+verified against the app hash committed by the following block, or the one of
+the node state for its last block. The node must be stopped, as its databases
+are opened, and must still keep the state preceding the range.
+
+On the first divergent app hash, the results of the txs of the block are
+compared with the results stored by the node, and the stores whose hash
+differs from the state of the node at that height are reported with their
+first divergent key.
+`,
+		Example: "evmd replay-verify --from 1000 --to 1100",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+
+			from, err := cmd.Flags().GetInt64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagToHeight)
+			if err != nil {
+				return err
+			}
+			workDir, err := cmd.Flags().GetString(flagWorkDir)
+			if err != nil {
+				return err
+			}
+
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := store.NewBlockStore(blockStoreDB)
+
+			stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: cfg.Storage.DiscardABCIResponses})
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+			if state.IsEmpty() {
+				return errors.New("no committed state")
+			}
+
+			if to == 0 {
+				to = blockStore.Height()
+			}
+			switch {
+			case from <= state.InitialHeight:
+				return fmt.Errorf("first height %d must be above the initial height %d", from, state.InitialHeight)
+			case from > to:
+				return fmt.Errorf("first height %d is above the last height %d", from, to)
+			case from < blockStore.Base() || to > blockStore.Height():
+				return fmt.Errorf("blocks %d to %d not in the block store, which keeps blocks %d to %d", from, to, blockStore.Base(), blockStore.Height())
+			}
+
+			// the blocks are replayed on a copy of the application database,
+			// and the database of the node is the reference state
+			backend := server.GetAppDBBackend(serverCtx.Viper)
+			dataDir := filepath.Join(cfg.RootDir, "data")
+			refDB, err := dbm.NewDB("application", backend, dataDir)
+			if err != nil {
+				return err
+			}
+			defer refDB.Close()
+
+			replayDir, err := os.MkdirTemp(workDir, "replay-verify-")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(replayDir)
+			if err := copyDir(filepath.Join(dataDir, "application.db"), filepath.Join(replayDir, "application.db")); err != nil {
+				return fmt.Errorf("failed to copy the application database: %w", err)
+			}
+			db, err := dbm.NewDB("application", backend, replayDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			newApp := func(db dbm.DB) *evmd.EVMD {
+				return evmd.NewExampleApp(
+					log.NewNopLogger(),
+					db,
+					nil,
+					true,
+					serverCtx.Viper,
+					getEVMChainIDFromOpts(serverCtx.Viper),
+					evmdconfig.EvmAppOptions,
+					baseapp.SetChainID(state.ChainID),
+				)
+			}
+			refApp, app := newApp(refDB), newApp(db)
+			if err := app.CommitMultiStore().RollbackToVersion(from - 1); err != nil {
+				return fmt.Errorf("failed to load the state at height %d, which may be pruned: %w", from-1, err)
+			}
+
+			for height := from; height <= to; height++ {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("no block at height %d", height)
+				}
+				lastValSet, err := stateStore.LoadValidators(height - 1)
+				if err != nil {
+					return err
+				}
+				res, err := app.ReplayBlock(block, lastValSet, state.InitialHeight)
+				if err != nil {
+					return err
+				}
+
+				expected := expectedAppHash(blockStore, stateStore, state, height)
+				switch {
+				case expected == nil:
+					cmd.Printf("height %d: app hash %X, no app hash to verify\n", height, res.AppHash)
+					continue
+				case bytes.Equal(expected, res.AppHash):
+					cmd.Printf("height %d: app hash %X, %d txs\n", height, res.AppHash, len(block.Txs))
+					continue
+				}
+
+				cmd.Printf("height %d: app hash %X, expected %X\n", height, res.AppHash, expected)
+				printTxDivergence(cmd, app, block, res, stateStore)
+				divergences, err := app.StoreDivergences(refApp, height)
+				if err != nil {
+					cmd.Printf("unable to compare the stores: %s\n", err)
+				}
+				for _, d := range divergences {
+					cmd.Printf("store %s: first divergent key %X, value %X, node value %X\n", d.Store, d.Key, d.Value, d.RefValue)
+				}
+				return fmt.Errorf("app hash mismatch at height %d", height)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagFromHeight, 0, "Height of the first block to re-execute")
+	cmd.Flags().Int64(flagToHeight, 0, "Height of the last block to re-execute, the last block of the block store if 0")
+	cmd.Flags().String(flagWorkDir, "", "Directory of the copy of the application database, the temporary directory if empty")
+
+	return cmd
+}
+
+// expectedAppHash returns the app hash of the state after the block at the
+// given height, as committed by the following block, as stored with the
+// results of the block, or as kept by the node state for its last block. It
+// returns nil if none of them is available.
+func expectedAppHash(blockStore *store.BlockStore, stateStore sm.Store, state sm.State, height int64) []byte {
+	if meta := blockStore.LoadBlockMeta(height + 1); meta != nil {
+		return meta.Header.AppHash
+	}
+	if height == state.LastBlockHeight {
+		return state.AppHash
+	}
+	if res, err := stateStore.LoadFinalizeBlockResponse(height); err == nil {
+		return res.AppHash
+	}
+	return nil
+}
+
+// printTxDivergence prints the first tx of the block whose result differs from
+// the result stored by the node, with the hashes of its Ethereum txs.
+func printTxDivergence(
+	cmd *cobra.Command,
+	app *evmd.EVMD,
+	block *cmttypes.Block,
+	res *abci.ResponseFinalizeBlock,
+	stateStore sm.Store,
+) {
+	stored, err := stateStore.LoadFinalizeBlockResponse(block.Height)
+	if err != nil {
+		cmd.Printf("unable to compare the tx results: %s\n", err)
+		return
+	}
+
+	for i, txRes := range res.TxResults {
+		if i >= len(stored.TxResults) {
+			break
+		}
+		storedRes := stored.TxResults[i]
+		if txRes.Code == storedRes.Code && txRes.GasUsed == storedRes.GasUsed && bytes.Equal(txRes.Data, storedRes.Data) {
+			continue
+		}
+
+		cmd.Printf("tx %d %X: code %d, gas used %d, expected code %d, gas used %d\n",
+			i, block.Txs[i].Hash(), txRes.Code, txRes.GasUsed, storedRes.Code, storedRes.GasUsed)
+		if tx, err := app.TxConfig().TxDecoder()(block.Txs[i]); err == nil {
+			for _, msg := range tx.GetMsgs() {
+				if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+					cmd.Printf("  ethereum tx %s\n", ethMsg.AsTransaction().Hash().Hex())
+				}
+			}
+		}
+		cmd.Printf("  log: %s\n  expected log: %s\n", txRes.Log, storedRes.Log)
+		return
+	}
+	cmd.Println("the tx results match the stored results")
+}
+
+// copyDir copies the files of the directory src, which must not be in use, to
+// the directory dst.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0o750)
+		}
+
+		in, err := os.Open(path) //#nosec G304 -- file of the application database
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.Create(target) //#nosec G304 -- file of the copy of the application database
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, in); err != nil {
+			out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
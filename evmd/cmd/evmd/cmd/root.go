@@ -15,11 +15,14 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	cosmosevmcmd "github.com/cosmos/evm/client"
+	"github.com/cosmos/evm/client/loadtest"
 	cosmosevmkeyring "github.com/cosmos/evm/crypto/keyring"
 	"github.com/cosmos/evm/evmd"
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
 	cosmosevmserver "github.com/cosmos/evm/server"
 	srvflags "github.com/cosmos/evm/server/flags"
+	erc20cli "github.com/cosmos/evm/x/erc20/client/cli"
+	evmcli "github.com/cosmos/evm/x/vm/client/cli"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
@@ -175,15 +178,24 @@ func initRootCmd(rootCmd *cobra.Command, evmApp *evmd.EVMD) {
 	cfg.Seal()
 
 	defaultNodeHome := evmdconfig.MustGetDefaultNodeHome()
+	genesisCmd := genutilcli.Commands(evmApp.TxConfig(), evmApp.BasicModuleManager, defaultNodeHome)
+	genesisCmd.AddCommand(evmcli.AddGenesisPreinstallsCmd(defaultNodeHome))
+	genesisCmd.AddCommand(evmcli.SweepGenesisEmptyAccountsCmd(defaultNodeHome))
+	genesisCmd.AddCommand(erc20cli.MigrateGenesisCmd(defaultNodeHome))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(evmApp.BasicModuleManager, defaultNodeHome),
-		genutilcli.Commands(evmApp.TxConfig(), evmApp.BasicModuleManager, defaultNodeHome),
+		genesisCmd,
 		cmtcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, defaultNodeHome),
 		snapshot.Cmd(newApp),
 		NewTestnetCmd(evmApp.BasicModuleManager, banktypes.GenesisBalancesIterator{}, appCreator{}),
+		loadtest.Cmd(),
+		NewTestUpgradeCmd(),
+		NewCheckInvariantsCmd(),
+		NewReplayVerifyCmd(),
 	)
 
 	// add Cosmos EVM' flavored TM commands to start server, etc.
@@ -337,7 +349,7 @@ func newApp(
 	return evmd.NewExampleApp(
 		logger, db, traceStore, true,
 		appOpts,
-		evmdconfig.EVMChainID,
+		getEVMChainIDFromOpts(appOpts),
 		evmdconfig.EvmAppOptions,
 		baseappOptions...,
 	)
@@ -379,13 +391,13 @@ func appExport(
 	}
 
 	if height != -1 {
-		exampleApp = evmd.NewExampleApp(logger, db, traceStore, false, appOpts, evmdconfig.EVMChainID, evmdconfig.EvmAppOptions, baseapp.SetChainID(chainID))
+		exampleApp = evmd.NewExampleApp(logger, db, traceStore, false, appOpts, getEVMChainIDFromOpts(appOpts), evmdconfig.EvmAppOptions, baseapp.SetChainID(chainID))
 
 		if err := exampleApp.LoadHeight(height); err != nil {
 			return servertypes.ExportedApp{}, err
 		}
 	} else {
-		exampleApp = evmd.NewExampleApp(logger, db, traceStore, true, appOpts, evmdconfig.EVMChainID, evmdconfig.EvmAppOptions, baseapp.SetChainID(chainID))
+		exampleApp = evmd.NewExampleApp(logger, db, traceStore, true, appOpts, getEVMChainIDFromOpts(appOpts), evmdconfig.EvmAppOptions, baseapp.SetChainID(chainID))
 	}
 
 	return exampleApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
@@ -408,3 +420,13 @@ func getChainIDFromOpts(appOpts servertypes.AppOptions) (chainID string, err err
 
 	return
 }
+
+// getEVMChainIDFromOpts returns the EVM chain id from app Opts, which is the
+// one served by the JSON-RPC. It's independent of the cosmos chain id, and
+// defaults to the chain id of the example chain if it's not set.
+func getEVMChainIDFromOpts(appOpts servertypes.AppOptions) uint64 {
+	if evmChainID := cast.ToUint64(appOpts.Get(srvflags.EVMChainID)); evmChainID != 0 {
+		return evmChainID
+	}
+	return evmdconfig.EVMChainID
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/evmd"
+	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
+	srvflags "github.com/cosmos/evm/server/flags"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagUpgradeName  = "upgrade-name"
+	flagFromVersions = "from-versions"
+	flagOutput       = "output"
+)
+
+// NewTestUpgradeCmd returns the command replaying an exported genesis through
+// an upgrade of the modules, to check their store migrations before upgrading
+// a chain.
+func NewTestUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test-upgrade [genesis-file]",
+		Short: "Replay an exported genesis through an upgrade and its store migrations",
+		Long: `Initialize an in-memory chain with an exported genesis and run an upgrade in
+the block following the genesis block, as if the modules were at the given
+versions, which run the store migrations up to their current consensus version.
+
+The state exported after the upgrade is validated, and written to the output
+file if one is given, e.g. to compare it with the exported genesis.
+`,
+		Example: "evmd test-upgrade exported-genesis.json --from-versions evm=1,erc20=1 --output migrated-genesis.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			upgradeName, err := cmd.Flags().GetString(flagUpgradeName)
+			if err != nil {
+				return err
+			}
+			fromVersions, err := cmd.Flags().GetStringToInt64(flagFromVersions)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			evmChainID, err := cmd.Flags().GetUint64(srvflags.EVMChainID)
+			if err != nil {
+				return err
+			}
+
+			fromVM := make(module.VersionMap, len(fromVersions))
+			for moduleName, version := range fromVersions {
+				if version < 1 {
+					return fmt.Errorf("invalid version %d of module %s", version, moduleName)
+				}
+				fromVM[moduleName] = uint64(version)
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			app := evmd.NewExampleApp(
+				log.NewNopLogger(),
+				dbm.NewMemDB(),
+				nil,
+				true,
+				simtestutil.EmptyAppOptions{},
+				evmChainID,
+				evmdconfig.EvmAppOptions,
+				baseapp.SetChainID(appGenesis.ChainID),
+			)
+			exported, toVM, err := app.ReplayUpgrade(appGenesis, upgradeName, fromVM)
+			if err != nil {
+				return err
+			}
+
+			var genState map[string]json.RawMessage
+			if err := json.Unmarshal(exported.AppState, &genState); err != nil {
+				return err
+			}
+			if err := app.BasicModuleManager.ValidateGenesis(app.AppCodec(), app.TxConfig(), genState); err != nil {
+				return fmt.Errorf("invalid genesis state after the upgrade: %w", err)
+			}
+
+			moduleNames := make([]string, 0, len(fromVM))
+			for moduleName := range fromVM {
+				moduleNames = append(moduleNames, moduleName)
+			}
+			slices.Sort(moduleNames)
+			for _, moduleName := range moduleNames {
+				cmd.Printf("%s: migrated from version %d to %d\n", moduleName, fromVM[moduleName], toVM[moduleName])
+			}
+
+			if output == "" {
+				return nil
+			}
+			appGenesis.AppState = exported.AppState
+			appGenesis.InitialHeight = exported.Height
+			appGenesis.Consensus = genutiltypes.NewConsensusGenesis(exported.ConsensusParams, exported.Validators)
+			return appGenesis.SaveAs(output)
+		},
+	}
+
+	cmd.Flags().String(flagUpgradeName, evmd.StoreMigrationsUpgradeName, "Name of the upgrade to run")
+	cmd.Flags().StringToInt64(
+		flagFromVersions,
+		map[string]int64{evmtypes.ModuleName: 1, erc20types.ModuleName: 1},
+		"Versions of the modules the upgrade migrates from, the other modules are at their current consensus version",
+	)
+	cmd.Flags().String(flagOutput, "", "File to write the genesis exported after the upgrade to")
+	cmd.Flags().Uint64(srvflags.EVMChainID, evmdconfig.EVMChainID, "EVM chain ID of the chain")
+
+	return cmd
+}
@@ -56,9 +56,25 @@ var maccPerms = map[string][]string{
 //   - Ethereum's native precompiled smart contracts
 //   - Cosmos EVM' available static precompiled contracts
 func BlockedAddresses() map[string]bool {
+	blockedAddrs := BlockedModuleAddresses()
+
+	blockedPrecompilesHex := evmtypes.AvailableStaticPrecompiles
+	for _, addr := range corevm.PrecompiledAddressesBerlin {
+		blockedPrecompilesHex = append(blockedPrecompilesHex, addr.Hex())
+	}
+
+	for _, precompile := range blockedPrecompilesHex {
+		blockedAddrs[cosmosevmutils.Bech32StringFromHexAddress(precompile)] = true
+	}
+
+	return blockedAddrs
+}
+
+// BlockedModuleAddresses returns the app's module account addresses, which
+// can't receive EVM value transfers.
+func BlockedModuleAddresses() map[string]bool {
 	blockedAddrs := make(map[string]bool)
 
-	maps.Clone(maccPerms)
 	maccPerms := GetMaccPerms()
 	accs := make([]string, 0, len(maccPerms))
 	for acc := range maccPerms {
@@ -70,15 +86,6 @@ func BlockedAddresses() map[string]bool {
 		blockedAddrs[authtypes.NewModuleAddress(acc).String()] = true
 	}
 
-	blockedPrecompilesHex := evmtypes.AvailableStaticPrecompiles
-	for _, addr := range corevm.PrecompiledAddressesBerlin {
-		blockedPrecompilesHex = append(blockedPrecompilesHex, addr.Hex())
-	}
-
-	for _, precompile := range blockedPrecompilesHex {
-		blockedAddrs[cosmosevmutils.Bech32StringFromHexAddress(precompile)] = true
-	}
-
 	return blockedAddrs
 }
 
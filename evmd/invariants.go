@@ -0,0 +1,56 @@
+package evmd
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// InvariantResult is the result of an invariant registered by a module.
+type InvariantResult struct {
+	Module  string
+	Route   string
+	Message string
+	Broken  bool
+}
+
+// invariantRoute is an invariant registered by a module.
+type invariantRoute struct {
+	module, route string
+	invariant     sdk.Invariant
+}
+
+// invariantRegistry collects the invariants registered by the modules, as the
+// crisis module does.
+type invariantRegistry []invariantRoute
+
+var _ sdk.InvariantRegistry = (*invariantRegistry)(nil)
+
+// RegisterRoute implements sdk.InvariantRegistry.
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, invariant sdk.Invariant) {
+	*r = append(*r, invariantRoute{module: moduleName, route: route, invariant: invariant})
+}
+
+// RunInvariants runs the invariants registered by the given modules of the app,
+// or by all of them if none is given, in the order of the modules. The state
+// of the context isn't written by the invariants.
+func (app *EVMD) RunInvariants(ctx sdk.Context, moduleNames ...string) []InvariantResult {
+	var registry invariantRegistry
+	for _, moduleName := range app.ModuleManager.OrderInitGenesis {
+		if len(moduleNames) > 0 && !slices.Contains(moduleNames, moduleName) {
+			continue
+		}
+		if m, ok := app.ModuleManager.Modules[moduleName].(module.HasInvariants); ok {
+			m.RegisterInvariants(&registry)
+		}
+	}
+
+	results := make([]InvariantResult, 0, len(registry))
+	for _, route := range registry {
+		cacheCtx, _ := ctx.CacheContext()
+		msg, broken := route.invariant(cacheCtx)
+		results = append(results, InvariantResult{Module: route.module, Route: route.route, Message: msg, Broken: broken})
+	}
+	return results
+}
@@ -0,0 +1,54 @@
+package evmd_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/testutil/constants"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestRunInvariants(t *testing.T) {
+	app := evmd.Setup(t, constants.ExampleChainID.ChainID, constants.ExampleChainID.EVMChainID)
+	ctx := app.NewContext(false)
+
+	coins := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), math.NewInt(1e18)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.AccAddress(common.HexToAddress("0x2000").Bytes()), coins))
+
+	brokenRoutes := func() []string {
+		var routes []string
+		for _, res := range app.RunInvariants(ctx, evmtypes.ModuleName) {
+			require.Equal(t, evmtypes.ModuleName, res.Module)
+			if res.Broken {
+				routes = append(routes, res.Route)
+			}
+		}
+		return routes
+	}
+	require.Len(t, app.RunInvariants(ctx, evmtypes.ModuleName), 2)
+	require.Empty(t, brokenRoutes())
+
+	// a code hash without its code
+	app.EVMKeeper.SetCodeHash(ctx, common.HexToAddress("0x1000").Bytes(), crypto.Keccak256([]byte{0x60}))
+	require.Equal(t, []string{evmkeeper.CodeHashesInvariantRoute}, brokenRoutes())
+
+	// a supply of the evm denom exceeding the balances by one unit
+	denom := evmtypes.GetEVMCoinDenom()
+	bankKeeper, ok := app.BankKeeper.(bankkeeper.BaseKeeper)
+	require.True(t, ok)
+	supply := bankKeeper.GetSupply(ctx, denom).Amount
+	require.NoError(t, bankKeeper.Supply.Set(ctx, denom, supply.AddRaw(1)))
+	require.Equal(t, []string{evmkeeper.BalancesInvariantRoute, evmkeeper.CodeHashesInvariantRoute}, brokenRoutes())
+}
@@ -0,0 +1,105 @@
+package evmd_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/evmd"
+	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
+	"github.com/cosmos/evm/testutil/constants"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmsimulation "github.com/cosmos/evm/x/vm/simulation"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+)
+
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// TestFullAppSimulation runs the operations of the modules of the app, including
+// the Ethereum txs of the evm module, from a random genesis. It's skipped unless
+// the simulator is enabled, e.g. with:
+//
+//	go test ./evmd -run TestFullAppSimulation -Enabled=true -NumBlocks=50 -BlockSize=50 -Commit=true -Seed=42 -v
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = constants.ExampleChainID.ChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(
+		config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue,
+	)
+	if skip {
+		t.Skip("skipping the application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	app := evmd.NewExampleApp(
+		logger,
+		db,
+		nil,
+		true,
+		simtestutil.EmptyAppOptions{},
+		constants.ExampleChainID.EVMChainID,
+		evmdconfig.EvmAppOptions,
+		baseapp.SetChainID(config.ChainID),
+	)
+	require.Equal(t, "evmd", app.Name())
+
+	// the random genesis balances and stakes are in the bond denom, which is
+	// the evm denom, so that the accounts can send Ethereum txs
+	defaultBondDenom := sdk.DefaultBondDenom
+	sdk.DefaultBondDenom = evmtypes.GetEVMCoinDenom()
+	t.Cleanup(func() { sdk.DefaultBondDenom = defaultBondDenom })
+
+	// the base fee is disabled as on the example chain, as the cosmos txs of
+	// the operations of the other modules pay random fees
+	genesis := app.DefaultGenesis()
+	genesis[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(evmd.NewFeeMarketGenesisState())
+
+	_, simParams, err := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), genesis),
+		evmsimulation.RandomAccounts,
+		simulationOperations(app),
+		evmdconfig.BlockedAddresses(),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, simtestutil.CheckExportSimulation(app, config, simParams))
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
+// simulationOperations returns the weighted operations of the modules of the
+// app. Unlike simtestutil.BuildSimulationOperations, the legacy proposal
+// contents are left out, as the app has no legacy governance router to run
+// them.
+func simulationOperations(app *evmd.EVMD) []simtypes.WeightedOperation {
+	simState := module.SimulationState{
+		AppParams: make(simtypes.AppParams),
+		Cdc:       app.AppCodec(),
+		TxConfig:  app.TxConfig(),
+		BondDenom: sdk.DefaultBondDenom,
+	}
+	simState.ProposalMsgs = app.SimulationManager().GetProposalMsgs(simState)
+	return app.SimulationManager().WeightedOperations(simState)
+}
@@ -128,6 +128,52 @@ func (suite *MiddlewareTestSuite) TestOnRecvPacketWithCallback() {
 			},
 			expError: "",
 		},
+		{
+			name: "success - callback to a contract of the allowlist",
+			malleate: func() {
+				suite.setIBCCallbackContractsAllowlist(contractAddr)
+			},
+			memo: func() string {
+				amountInt, _ := math.NewIntFromString(ibctesting.DefaultCoinAmount.String())
+				voucherDenom := testutil.GetVoucherDenomFromPacketData(data, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				singleTokenRepresentation, _ := types.NewTokenPairSTRv2(voucherDenom)
+				erc20Contract := singleTokenRepresentation.GetERC20Contract()
+				packedBytes, _ := contractData.ABI.Pack("add", erc20Contract, amountInt.BigInt())
+
+				return fmt.Sprintf(`{
+					"dest_callback": {
+						"address": "%s",
+						"gas_limit": "%d",
+						"calldata": "%x"
+					}
+				}`, contractAddr, 1_000_000, packedBytes)
+			},
+			expError: "",
+		},
+
+		// FAILURE CASES - Contract Not Allowed
+		{
+			name: "failure - callback to a contract outside of the allowlist",
+			malleate: func() {
+				suite.setIBCCallbackContractsAllowlist(common.HexToAddress("0x1234567890123456789012345678901234567890"))
+			},
+			memo: func() string {
+				amountInt, _ := math.NewIntFromString(ibctesting.DefaultCoinAmount.String())
+				voucherDenom := testutil.GetVoucherDenomFromPacketData(data, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				singleTokenRepresentation, _ := types.NewTokenPairSTRv2(voucherDenom)
+				erc20Contract := singleTokenRepresentation.GetERC20Contract()
+				packedBytes, _ := contractData.ABI.Pack("add", erc20Contract, amountInt.BigInt())
+
+				return fmt.Sprintf(`{
+					"dest_callback": {
+						"address": "%s",
+						"gas_limit": "%d",
+						"calldata": "%x"
+					}
+				}`, contractAddr, 1_000_000, packedBytes)
+			},
+			expError: "ABCI code: 10",
+		},
 
 		// FAILURE CASES - Invalid Contract
 		{
@@ -415,6 +461,18 @@ func (suite *MiddlewareTestSuite) TestOnRecvPacketWithCallback() {
 	}
 }
 
+// setIBCCallbackContractsAllowlist restricts the contracts the received packets
+// can call on the evm chain to the given ones.
+func (suite *MiddlewareTestSuite) setIBCCallbackContractsAllowlist(contracts ...common.Address) {
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	ctx := suite.evmChainA.GetContext()
+	params := evmApp.EVMKeeper.GetParams(ctx)
+	for _, contract := range contracts {
+		params.IBCCallbackContractsAllowlist = append(params.IBCCallbackContractsAllowlist, contract.Hex())
+	}
+	suite.Require().NoError(evmApp.EVMKeeper.SetParams(ctx, params))
+}
+
 // TestNewIBCMiddleware verifies the middleware instantiation logic.
 func (suite *MiddlewareTestSuite) TestNewIBCMiddleware() {
 	testCases := []struct {
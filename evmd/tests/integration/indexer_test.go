@@ -9,3 +9,15 @@ import (
 func TestKVIndexer(t *testing.T) {
 	indexer.TestKVIndexer(t, CreateEvmd)
 }
+
+func TestKVIndexerAddressTxs(t *testing.T) {
+	indexer.TestKVIndexerAddressTxs(t, CreateEvmd)
+}
+
+func TestKVIndexerTokenTransfers(t *testing.T) {
+	indexer.TestKVIndexerTokenTransfers(t, CreateEvmd)
+}
+
+func TestKVIndexerNFTBalances(t *testing.T) {
+	indexer.TestKVIndexerNFTBalances(t, CreateEvmd)
+}
@@ -4,13 +4,10 @@ import (
 	"math/big"
 	"testing"
 
-	"github.com/ethereum/go-ethereum/common"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/evm/tests/integration/x/vm"
 	utiltx "github.com/cosmos/evm/testutil/tx"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 // BenchmarkApplyTransaction runs the ApplyTransaction benchmark
@@ -21,31 +18,18 @@ func BenchmarkApplyTransaction(b *testing.B) {
 	suite.SetT(&testing.T{})
 	suite.SetupTest()
 
-	ethSigner := ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID)
-
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		addr := suite.Keyring.GetAddr(0)
-		krSigner := utiltx.NewSigner(suite.Keyring.GetPrivKey(0))
-		
+
 		// Create access list transaction
-		templateAccessListTx := &ethtypes.AccessListTx{
-			GasPrice: big.NewInt(1),
-			Gas:      21000,
-			To:       &common.Address{},
-			Value:    big.NewInt(0),
-			Data:     []byte{},
-			Nonce:    suite.Network.App.GetEVMKeeper().GetNonce(suite.Network.GetContext(), addr),
-		}
-		
-		ethTx := ethtypes.NewTx(templateAccessListTx)
-		msg := &evmtypes.MsgEthereumTx{}
-		err := msg.FromEthereumTx(ethTx)
-		require.NoError(b, err)
-		msg.From = addr.Bytes()
-		err = msg.Sign(ethSigner, krSigner)
+		msg, err := utiltx.NewEthTxBuilder(suite.Keyring.GetPrivKey(0)).
+			WithNonce(suite.Network.App.GetEVMKeeper().GetNonce(suite.Network.GetContext(), addr)).
+			WithGasPrice(big.NewInt(1)).
+			WithAccessList(nil).
+			Msg()
 		require.NoError(b, err)
 
 		b.StartTimer()
@@ -55,4 +39,4 @@ func BenchmarkApplyTransaction(b *testing.B) {
 		require.NoError(b, err)
 		require.False(b, resp.Failed())
 	}
-}
\ No newline at end of file
+}
@@ -33,3 +33,7 @@ func TestVmAnteTestSuite(t *testing.T) {
 func TestIterateContracts(t *testing.T) {
 	vm.TestIterateContracts(t, CreateEvmd)
 }
+
+func FuzzDifferentialEVM(f *testing.F) {
+	vm.FuzzDifferentialEVM(f, CreateEvmd)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/cosmos/evm/evmd"
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
 	"github.com/cosmos/evm/server/config"
+	"github.com/cosmos/evm/testutil/chaos"
 	testconfig "github.com/cosmos/evm/testutil/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	cosmosevmtypes "github.com/cosmos/evm/types"
@@ -98,6 +99,9 @@ type Config struct {
 	EnableTMLogging   bool   // enable Tendermint logging to STDOUT
 	CleanupDir        bool   // remove base temporary directory during cleanup
 	PrintMnemonic     bool   // print the mnemonic of first validator as log output for testing
+	// Faults are injected in the CometBFT RPC clients of the validators and in
+	// the DBs of their EVM indexers, which are enabled if set
+	Faults *chaos.Injector
 }
 
 // DefaultConfig returns a sane default configuration suitable for nearly all
@@ -4,6 +4,8 @@
 package network_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -13,12 +15,14 @@ import (
 
 	cosmosevmnetwork "github.com/cosmos/evm/evmd/tests/network"
 	"github.com/cosmos/evm/server/config"
+	"github.com/cosmos/evm/testutil/chaos"
 )
 
 type IntegrationTestSuite struct {
 	suite.Suite
 
 	network *cosmosevmnetwork.Network
+	faults  *chaos.Injector
 }
 
 func (s *IntegrationTestSuite) SetupSuite() {
@@ -28,6 +32,8 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	cfg := cosmosevmnetwork.DefaultConfig()
 	cfg.JSONRPCAddress = config.DefaultJSONRPCAddress
 	cfg.NumValidators = 1
+	s.faults = chaos.NewInjector()
+	cfg.Faults = s.faults
 
 	s.network, err = cosmosevmnetwork.New(s.T(), s.T().TempDir(), cfg)
 	s.Require().NoError(err)
@@ -57,6 +63,45 @@ func (s *IntegrationTestSuite) TestNetwork_Liveness() {
 	s.Require().GreaterOrEqual(latestHeight, h)
 }
 
+func (s *IntegrationTestSuite) TestNetwork_Faults() {
+	defer s.faults.Reset()
+	rpcClient := s.network.Validators[0].JSONRPCClient.Client()
+	getBlock := func(ctx context.Context) (json.RawMessage, error) {
+		var block json.RawMessage
+		err := rpcClient.CallContext(ctx, &block, "eth_getBlockByNumber", "0x1", false)
+		return block, err
+	}
+
+	// the blocks whose results can't be fetched from CometBFT are not found
+	s.faults.Inject("BlockResults", chaos.Fault{Err: chaos.ErrInjected})
+	block, err := getBlock(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal("null", string(block))
+	s.Require().Positive(s.faults.Faulted("BlockResults"))
+
+	s.faults.Clear("BlockResults")
+	block, err = getBlock(context.Background())
+	s.Require().NoError(err)
+	s.Require().NotEqual("null", string(block))
+
+	// the responses wait for the delayed CometBFT responses
+	s.faults.Inject("Block", chaos.Fault{Delay: 200 * time.Millisecond})
+	start := time.Now()
+	block, err = getBlock(context.Background())
+	s.Require().NoError(err)
+	s.Require().NotEqual("null", string(block))
+	s.Require().GreaterOrEqual(time.Since(start), 200*time.Millisecond)
+	s.faults.Clear("Block")
+
+	// the indexer keeps indexing the next blocks after a failed write
+	for i := 1; i <= 2; i++ {
+		s.faults.Inject("Batch.Write", chaos.Fault{Err: chaos.ErrInjected, Times: 1})
+		s.Require().Eventually(func() bool {
+			return s.faults.Faulted("Batch.Write") == i
+		}, 10*time.Second, 100*time.Millisecond)
+	}
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -19,7 +19,11 @@ import (
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/server"
+	"github.com/cosmos/evm/testutil/chaos"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -80,6 +84,9 @@ func startInProcess(cfg Config, val *Validator) error {
 
 	if val.RPCAddress != "" {
 		val.RPCClient = local.New(tmNode)
+		if cfg.Faults != nil {
+			val.RPCClient = chaos.NewCometRPC(val.RPCClient, cfg.Faults)
+		}
 	}
 
 	// We'll need a RPC client if the validator exposes a gRPC or REST endpoint.
@@ -133,7 +140,12 @@ func startInProcess(cfg Config, val *Validator) error {
 		tmEndpoint := "/websocket"
 		tmRPCAddr := fmt.Sprintf("tcp://%s", val.AppConfig.GRPC.Address)
 
-		val.jsonrpc, val.jsonrpcDone, err = server.StartJSONRPC(val.Ctx, val.ClientCtx, tmRPCAddr, tmEndpoint, val.AppConfig, nil)
+		var idxer cosmosevmtypes.EVMTxIndexer
+		if cfg.Faults != nil {
+			idxer = startFaultyIndexer(val, cfg.Faults)
+		}
+
+		val.jsonrpc, val.jsonrpcDone, err = server.StartJSONRPC(val.Ctx, val.ClientCtx, tmRPCAddr, tmEndpoint, val.AppConfig, idxer)
 		if err != nil {
 			return err
 		}
@@ -149,6 +161,20 @@ func startInProcess(cfg Config, val *Validator) error {
 	return nil
 }
 
+// startFaultyIndexer starts the EVM indexer of the validator on an in-memory
+// DB injecting the faults.
+func startFaultyIndexer(val *Validator, faults *chaos.Injector) cosmosevmtypes.EVMTxIndexer {
+	idxLogger := val.Ctx.Logger.With("indexer", "evm")
+	idxer := indexer.NewKVIndexer(chaos.NewDB(dbm.NewMemDB(), faults), idxLogger, val.ClientCtx)
+	indexerService := server.NewEVMIndexerService(idxer, val.RPCClient)
+	indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
+
+	val.errGroup.Go(func() error {
+		return indexerService.Start()
+	})
+	return idxer
+}
+
 func collectGenFiles(cfg Config, vals []*Validator, outputDir string) error {
 	genTime := cmttime.Now()
 
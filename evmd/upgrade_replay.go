@@ -0,0 +1,98 @@
+package evmd
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// ReplayUpgrade initializes the chain of the app, which must not be initialized
+// yet, with the given genesis, and runs the given upgrade in the block following
+// the genesis block, as if the modules were at the versions of fromVM. It
+// returns the state exported after the upgrade and the module versions set by
+// the upgrade.
+func (app *EVMD) ReplayUpgrade(
+	appGenesis *genutiltypes.AppGenesis,
+	upgradeName string,
+	fromVM module.VersionMap,
+) (servertypes.ExportedApp, module.VersionMap, error) {
+	if !app.UpgradeKeeper.HasHandler(upgradeName) {
+		return servertypes.ExportedApp{}, nil, fmt.Errorf("no upgrade handler registered for upgrade %s", upgradeName)
+	}
+
+	versions := app.ModuleManager.GetVersionMap()
+	for moduleName, version := range fromVM {
+		if _, ok := versions[moduleName]; !ok {
+			return servertypes.ExportedApp{}, nil, fmt.Errorf("unknown module %s", moduleName)
+		}
+		if version > versions[moduleName] {
+			return servertypes.ExportedApp{}, nil, fmt.Errorf(
+				"version %d of module %s is above its consensus version %d", version, moduleName, versions[moduleName],
+			)
+		}
+	}
+	maps.Copy(versions, fromVM)
+
+	if appGenesis.Consensus == nil || appGenesis.Consensus.Params == nil {
+		return servertypes.ExportedApp{}, nil, errors.New("genesis without consensus params")
+	}
+	consensusParams := appGenesis.Consensus.Params.ToProto()
+	if _, err := app.InitChain(&abci.RequestInitChain{
+		Time:            appGenesis.GenesisTime,
+		ChainId:         appGenesis.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   appGenesis.AppState,
+		InitialHeight:   appGenesis.InitialHeight,
+	}); err != nil {
+		return servertypes.ExportedApp{}, nil, fmt.Errorf("failed to init chain: %w", err)
+	}
+
+	height, blockTime := appGenesis.InitialHeight, appGenesis.GenesisTime
+	if err := app.finalizeBlockAndCommit(height, blockTime); err != nil {
+		return servertypes.ExportedApp{}, nil, err
+	}
+
+	// the upgrade is scheduled for the next block, with the versions of the
+	// modules it migrates from
+	header := cmtproto.Header{ChainID: appGenesis.ChainID, Height: height, Time: blockTime}
+	ctx := app.NewUncachedContext(false, header)
+	if err := app.UpgradeKeeper.SetModuleVersionMap(ctx, versions); err != nil {
+		return servertypes.ExportedApp{}, nil, err
+	}
+	if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: upgradeName, Height: height + 1}); err != nil {
+		return servertypes.ExportedApp{}, nil, err
+	}
+	if err := app.finalizeBlockAndCommit(height+1, blockTime.Add(time.Second)); err != nil {
+		return servertypes.ExportedApp{}, nil, fmt.Errorf("failed to run upgrade %s: %w", upgradeName, err)
+	}
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	if err != nil {
+		return servertypes.ExportedApp{}, nil, err
+	}
+	toVM, err := app.UpgradeKeeper.GetModuleVersionMap(app.NewContextLegacy(true, header))
+	if err != nil {
+		return servertypes.ExportedApp{}, nil, err
+	}
+	return exported, toVM, nil
+}
+
+// finalizeBlockAndCommit finalizes and commits an empty block at the given
+// height and time.
+func (app *EVMD) finalizeBlockAndCommit(height int64, blockTime time.Time) error {
+	if _, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: blockTime}); err != nil {
+		return err
+	}
+	_, err := app.Commit()
+	return err
+}
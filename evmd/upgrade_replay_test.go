@@ -0,0 +1,92 @@
+package evmd_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/testutil/constants"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func newAppGenesis(t *testing.T) *genutiltypes.AppGenesis {
+	t.Helper()
+
+	app, genesisState := evmd.SetupTestingApp(constants.ExampleChainID.ChainID, constants.ExampleChainID.EVMChainID)()
+	evmApp := app.(*evmd.EVMD)
+
+	pubKey, err := mock.NewPV().GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	senderPubKey := secp256k1.GenPrivKey().PubKey()
+	acc := authtypes.NewBaseAccount(senderPubKey.Address().Bytes(), senderPubKey, 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000000000000))),
+	}
+	genesisState, err = simtestutil.GenesisStateWithValSet(evmApp.AppCodec(), genesisState, valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	appGenesis := &genutiltypes.AppGenesis{
+		ChainID:       constants.ExampleChainID.ChainID,
+		GenesisTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		InitialHeight: 10,
+		AppState:      appState,
+		Consensus:     &genutiltypes.ConsensusGenesis{Params: cmttypes.DefaultConsensusParams()},
+	}
+	require.NoError(t, appGenesis.ValidateAndComplete())
+	return appGenesis
+}
+
+func newReplayApp() *evmd.EVMD {
+	app, _ := evmd.SetupTestingApp(constants.ExampleChainID.ChainID, constants.ExampleChainID.EVMChainID)()
+	return app.(*evmd.EVMD)
+}
+
+func TestReplayUpgrade(t *testing.T) {
+	appGenesis := newAppGenesis(t)
+
+	fromVM := module.VersionMap{evmtypes.ModuleName: 1, erc20types.ModuleName: 1}
+	exported, toVM, err := newReplayApp().ReplayUpgrade(appGenesis, evmd.StoreMigrationsUpgradeName, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), toVM[evmtypes.ModuleName])
+	require.Equal(t, uint64(2), toVM[erc20types.ModuleName])
+	require.Equal(t, appGenesis.InitialHeight+2, exported.Height)
+
+	var genState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &genState))
+	require.Contains(t, genState, evmtypes.ModuleName)
+	require.Contains(t, genState, erc20types.ModuleName)
+}
+
+func TestReplayUpgradeInvalid(t *testing.T) {
+	appGenesis := newAppGenesis(t)
+
+	_, _, err := newReplayApp().ReplayUpgrade(appGenesis, "unknown", nil)
+	require.ErrorContains(t, err, "no upgrade handler registered for upgrade unknown")
+
+	_, _, err = newReplayApp().ReplayUpgrade(appGenesis, evmd.StoreMigrationsUpgradeName, module.VersionMap{"unknown": 1})
+	require.ErrorContains(t, err, "unknown module unknown")
+
+	_, _, err = newReplayApp().ReplayUpgrade(appGenesis, evmd.StoreMigrationsUpgradeName, module.VersionMap{evmtypes.ModuleName: 3})
+	require.ErrorContains(t, err, "version 3 of module evm is above its consensus version 2")
+}
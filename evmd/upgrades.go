@@ -1,5 +1,76 @@
 package evmd
 
+import (
+	"context"
+
+	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	evmlegacy "github.com/cosmos/evm/x/vm/migrations/legacy"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// StoreMigrationsUpgradeName is the name of the upgrade running the store
+// migrations of the modules whose consensus version was bumped, e.g. the evm
+// and erc20 modules from version 1 to 2.
+const StoreMigrationsUpgradeName = "store-migrations"
+
+// LegacyImportUpgradeName is the name of the upgrade converting the evm and
+// feemarket stores of an Ethermint or Evmos chain, with the layout of
+// LegacyImportLayout, into the stores of the modules, so that the chain adopts
+// them in place.
+const LegacyImportUpgradeName = "legacy-evm-import"
+
+// LegacyImportLayout is the layout of the evm store converted by the legacy
+// import upgrade.
+const LegacyImportLayout = evmlegacy.LayoutEvmos
+
 func (app EVMD) RegisterUpgradeHandlers() {
-	// No upgrades registered yet
+	app.UpgradeKeeper.SetUpgradeHandler(
+		StoreMigrationsUpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		LegacyImportUpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+			if err := evmkeeper.NewMigrator(app.EVMKeeper).MigrateLegacyStore(sdkCtx, LegacyImportLayout); err != nil {
+				return nil, err
+			}
+			if err := feemarketkeeper.NewMigrator(app.FeeMarketKeeper).MigrateLegacyStore(sdkCtx); err != nil {
+				return nil, err
+			}
+
+			// the legacy stores are converted into the stores of version 1,
+			// whatever the consensus versions of the legacy modules
+			fromVM[evmtypes.ModuleName] = 1
+			fromVM[feemarkettypes.ModuleName] = 1
+			return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+
+	// The chain config isn't set when the app is only built to set up the CLI.
+	chainConfig := evmtypes.GetChainConfig()
+	if chainConfig == nil {
+		return
+	}
+
+	// An upgrade named after an EVM fork that is not yet active (e.g. "osaka")
+	// activates the fork in the chain config at the upgrade height.
+	for _, fork := range chainConfig.PendingForks() {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			fork,
+			app.EVMKeeper.ForkUpgradeHandler(func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+			}),
+		)
+	}
 }
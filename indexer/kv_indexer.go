@@ -1,9 +1,13 @@
 package indexer
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -15,6 +19,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,11 +28,36 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash               = 1
+	KeyPrefixTxIndex              = 2
+	KeyPrefixTxWitness            = 3
+	KeyPrefixCallTrace            = 4
+	KeyPrefixModifiedAccounts     = 5
+	KeyPrefixCosmosTxHash         = 6
+	KeyPrefixEthToCosmosHash      = 7
+	KeyPrefixMigration            = 8
+	KeyPrefixAddressTx            = 9
+	KeyPrefixContractCreation     = 10
+	KeyPrefixBlockAddresses       = 11
+	KeyPrefixTokenTransfer        = 12
+	KeyPrefixAddressTokenTransfer = 13
+	KeyPrefixNFTBalance           = 14
+	KeyPrefixIndexedRange         = 15
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// AddressTxKeyLength is the length of address-tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 8
+
+	// MigrationSenders is the migration storing the senders of the txs indexed
+	// by older versions without them
+	MigrationSenders = "senders"
+	// MigrationNFTBalances is the migration rebuilding the balances of the NFT
+	// owners from the token transfers indexed by older versions without them
+	MigrationNFTBalances = "nft-balances"
+
+	// backfillBatchSize is the number of txs updated at once by the migrations
+	backfillBatchSize = 1000
 )
 
 var _ cosmosevmtypes.EVMTxIndexer = &KVIndexer{}
@@ -57,19 +87,26 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
+	// the accounts modified by the eth txs of the block
+	var modifiedAccounts []common.Address
+	// the senders and recipients of the eth txs of the block
+	var addresses []common.Address
+	// the balances of the NFT owners updated by the token transfers of the block
+	balances := newNFTBalances(kv.db)
 	for txIndex, tx := range block.Txs {
 		result := txResults[txIndex]
 		if !rpctypes.TxSucessOrExpectedFailure(result) {
 			continue
 		}
 
+		cosmosTxHash := tx.Hash()
 		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
 		if err != nil {
 			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
-		if !isEthTx(tx) {
+		if !isEthTx(tx) && !isMixedTx(tx) {
 			continue
 		}
 
@@ -79,19 +116,40 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			continue
 		}
 
+		if err := saveTxWitnesses(batch, result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+
+		accounts, err := rpctypes.ParseModifiedAccounts(result)
+		if err != nil {
+			kv.logger.Error("Fail to parse modified accounts", "err", err, "block", height, "txIndex", txIndex)
+		}
+		modifiedAccounts = append(modifiedAccounts, accounts...)
+
 		var cumulativeGasUsed uint64
 		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// a cosmos msg of a mixed tx
+				continue
+			}
 			txHash := common.HexToHash(ethMsg.Hash)
 
+			sender, err := rpctypes.DefaultSenderCache.GetSender(ethMsg)
+			if err != nil {
+				kv.logger.Error("Fail to recover sender", "err", err, "block", height, "txIndex", txIndex)
+				continue
+			}
+
 			txResult := cosmosevmtypes.TxResult{
 				Height:     height,
 				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
 				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
 				EthTxIndex: ethTxIndex,
+				Sender:     sender.Bytes(),
 			}
 			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// exceeds block gas limit or failed batch scenario, set gas used to gas limit because that's what's charged by ante handler.
 				// some old versions don't emit any events, so workaround here directly.
 				txResult.GasUsed = ethMsg.GetGas()
 				txResult.Failed = true
@@ -115,8 +173,66 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+			if err := saveCosmosTxHash(batch, cosmosTxHash, msgIndex, txHash); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+
+			// the sender and the recipient are the same address for a self transfer
+			txAddresses := map[common.Address]cosmosevmtypes.AddressTxKind{sender: cosmosevmtypes.AddressTxSent}
+			ethTx := ethMsg.AsTransaction()
+			if to := ethTx.To(); to != nil {
+				txAddresses[*to] |= cosmosevmtypes.AddressTxReceived
+			} else if !txResult.Failed {
+				contract := crypto.CreateAddress(sender, ethTx.Nonce())
+				if err := batch.Set(ContractCreationKey(contract), txHash.Bytes()); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d, set contract-creation key", height)
+				}
+				txAddresses[contract] |= cosmosevmtypes.AddressTxReceived
+			}
+			for address, kind := range txAddresses {
+				if err := batch.Set(AddressTxKey(address, height, txResult.EthTxIndex), addressTxValue(txHash, kind)); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d, set address-tx key", height)
+				}
+				addresses = append(addresses, address)
+			}
+
+			if result.Code == abci.CodeTypeOK && !txResult.Failed {
+				logs, err := rpctypes.TxLogsFromResult(result, msgIndex)
+				if err != nil {
+					kv.logger.Error("Fail to parse tx logs", "err", err, "block", height, "txIndex", txIndex)
+				} else if err := saveTokenTransfers(batch, balances, logs, txHash, &txResult); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				}
+			}
 		}
 	}
+	if err := saveModifiedAccounts(batch, height, modifiedAccounts); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if err := saveBlockAddresses(batch, height, addresses); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if err := balances.write(batch); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
+	first, last, err := LoadIndexedRange(kv.db)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	switch {
+	case first == -1 || height > last+1:
+		// a gap starts a new range
+		first, last = height, height
+	case height == last+1:
+		last = height
+	case height == first-1:
+		first = height
+	}
+	if err := saveIndexedRange(batch, first, last); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -146,6 +262,10 @@ func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*cosmosevmtypes.TxResult, er
 	if err := kv.clientCtx.Codec.Unmarshal(bz, &txKey); err != nil {
 		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
 	}
+	// txs indexed by older versions have no sender
+	if len(txKey.Sender) > 0 {
+		rpctypes.DefaultSenderCache.Add(hash, common.BytesToAddress(txKey.Sender))
+	}
 	return &txKey, nil
 }
 
@@ -161,6 +281,443 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosm
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetWitnessByTxHash finds the witness recorded for an eth tx by eth tx hash
+func (kv *KVIndexer) GetWitnessByTxHash(hash common.Hash) (*cosmosevmtypes.TxWitness, error) {
+	bz, err := kv.db.Get(TxWitnessKey(hash))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetWitnessByTxHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("tx witness not found, hash: %s", hash.Hex())
+	}
+	var witness cosmosevmtypes.TxWitness
+	if err := json.Unmarshal(bz, &witness); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetWitnessByTxHash %s", hash.Hex())
+	}
+	return &witness, nil
+}
+
+// IndexCallTraces stores the flat call traces of all the eth txs in a block, so
+// that trace_filter doesn't need to re-execute the block on the next request.
+// The addresses of the internal calls of the traces are indexed along, as the
+// internal txs of the addresses, with the contracts created by the calls.
+func (kv *KVIndexer) IndexCallTraces(blockNumber int64, traces json.RawMessage) error {
+	var flatTraces []flatTrace
+	if err := json.Unmarshal(traces, &flatTraces); err != nil {
+		return errorsmod.Wrapf(err, "IndexCallTraces %d, decode traces", blockNumber)
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(CallTraceKey(blockNumber), traces); err != nil {
+		return errorsmod.Wrapf(err, "IndexCallTraces %d", blockNumber)
+	}
+
+	var addresses []common.Address
+	for _, trace := range flatTraces {
+		// the top level calls are indexed with their txs
+		if len(trace.TraceAddress) == 0 || trace.TransactionHash == nil {
+			continue
+		}
+		txHash := *trace.TransactionHash
+		bz, err := kv.db.Get(TxHashKey(txHash))
+		if err != nil {
+			return errorsmod.Wrapf(err, "IndexCallTraces %d", blockNumber)
+		}
+		var txResult cosmosevmtypes.TxResult
+		if len(bz) == 0 {
+			// the tx isn't indexed
+			continue
+		}
+		if err := kv.clientCtx.Codec.Unmarshal(bz, &txResult); err != nil {
+			return errorsmod.Wrapf(err, "IndexCallTraces %d", blockNumber)
+		}
+		if txResult.Height != blockNumber {
+			continue
+		}
+
+		for _, address := range trace.addresses() {
+			key := AddressTxKey(address, blockNumber, txResult.EthTxIndex)
+			value, err := kv.db.Get(key)
+			if err != nil {
+				return errorsmod.Wrapf(err, "IndexCallTraces %d", blockNumber)
+			}
+			kind := cosmosevmtypes.AddressTxInternal
+			if len(value) > 0 {
+				kind |= parseAddressTx(key, value).Kind
+			}
+			if err := batch.Set(key, addressTxValue(txHash, kind)); err != nil {
+				return errorsmod.Wrapf(err, "IndexCallTraces %d, set address-tx key", blockNumber)
+			}
+			addresses = append(addresses, address)
+		}
+
+		if trace.Type == "create" && trace.Error == "" && trace.Result != nil && trace.Result.Address != nil {
+			created, err := kv.db.Has(ContractCreationKey(*trace.Result.Address))
+			if err != nil {
+				return errorsmod.Wrapf(err, "IndexCallTraces %d", blockNumber)
+			}
+			if !created {
+				if err := batch.Set(ContractCreationKey(*trace.Result.Address), txHash.Bytes()); err != nil {
+					return errorsmod.Wrapf(err, "IndexCallTraces %d, set contract-creation key", blockNumber)
+				}
+			}
+		}
+	}
+
+	if len(addresses) > 0 {
+		bz, err := kv.db.Get(BlockAddressesKey(blockNumber))
+		if err != nil {
+			return errorsmod.Wrapf(err, "IndexCallTraces %d", blockNumber)
+		}
+		if len(bz) > 0 {
+			var indexed []common.Address
+			if err := json.Unmarshal(bz, &indexed); err != nil {
+				return errorsmod.Wrapf(err, "IndexCallTraces %d, decode block addresses", blockNumber)
+			}
+			addresses = append(addresses, indexed...)
+		}
+		if err := saveBlockAddresses(batch, blockNumber, addresses); err != nil {
+			return errorsmod.Wrapf(err, "IndexCallTraces %d", blockNumber)
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexCallTraces %d, write batch", blockNumber)
+	}
+	return nil
+}
+
+// GetCallTracesByBlock returns the flat call traces stored for a block, returns
+// nil if the traces of the block were not stored.
+func (kv *KVIndexer) GetCallTracesByBlock(blockNumber int64) (json.RawMessage, error) {
+	bz, err := kv.db.Get(CallTraceKey(blockNumber))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetCallTracesByBlock %d", blockNumber)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	return bz, nil
+}
+
+// GetModifiedAccountsByBlock returns the accounts modified by the eth txs of a
+// block, sorted by address, returns nil if no modified accounts were recorded.
+func (kv *KVIndexer) GetModifiedAccountsByBlock(blockNumber int64) ([]common.Address, error) {
+	bz, err := kv.db.Get(ModifiedAccountsKey(blockNumber))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetModifiedAccountsByBlock %d", blockNumber)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var accounts []common.Address
+	if err := json.Unmarshal(bz, &accounts); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetModifiedAccountsByBlock %d", blockNumber)
+	}
+	return accounts, nil
+}
+
+// GetEthTxHashesByCosmosTxHash returns the hashes of the eth txs included in a
+// cosmos tx, in the order of their msgs, returns nil if no eth tx was indexed
+// for the cosmos tx.
+func (kv *KVIndexer) GetEthTxHashesByCosmosTxHash(hash []byte) ([]common.Hash, error) {
+	prefix := append([]byte{KeyPrefixCosmosTxHash}, hash...)
+	it, err := kv.db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetEthTxHashesByCosmosTxHash %X", hash)
+	}
+	defer it.Close()
+
+	var hashes []common.Hash
+	for ; it.Valid(); it.Next() {
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+	}
+	if err := it.Error(); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetEthTxHashesByCosmosTxHash %X", hash)
+	}
+	return hashes, nil
+}
+
+// GetCosmosTxHashByEthTxHash returns the hash of the cosmos tx including an eth
+// tx, returns nil if the eth tx was indexed without it.
+func (kv *KVIndexer) GetCosmosTxHashByEthTxHash(hash common.Hash) ([]byte, error) {
+	bz, err := kv.db.Get(EthToCosmosHashKey(hash))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetCosmosTxHashByEthTxHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	return bz, nil
+}
+
+// GetAddressTxs returns up to limit eth txs of an address of the given kinds,
+// newest first, from the given cursor, and the cursor to continue from, nil once
+// all were returned. A cursor is the block number and eth tx index of the next
+// tx to return, a nil cursor starts from the latest tx.
+//
+// The txs of the other kinds are skipped while iterating the txs of the address,
+// so that a query of a rare kind iterates most of the txs of a busy address.
+func (kv *KVIndexer) GetAddressTxs(
+	address common.Address,
+	kind cosmosevmtypes.AddressTxKind,
+	cursor []byte,
+	limit int,
+) ([]cosmosevmtypes.AddressTx, []byte, error) {
+	prefix := append([]byte{KeyPrefixAddressTx}, address.Bytes()...)
+	end := storetypes.PrefixEndBytes(prefix)
+	if cursor != nil {
+		if len(cursor) != AddressTxKeyLength-len(prefix) {
+			return nil, nil, fmt.Errorf("invalid cursor length, expect: %d, got: %d", AddressTxKeyLength-len(prefix), len(cursor))
+		}
+		// the cursor is included
+		end = slices.Concat(prefix, cursor, []byte{0})
+	}
+	it, err := kv.db.ReverseIterator(prefix, end)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "GetAddressTxs %s", address.Hex())
+	}
+	defer it.Close()
+
+	var txs []cosmosevmtypes.AddressTx
+	for ; it.Valid(); it.Next() {
+		tx := parseAddressTx(it.Key(), it.Value())
+		if tx.Kind&kind == 0 {
+			continue
+		}
+		if len(txs) == limit {
+			return txs, bytes.Clone(it.Key()[len(prefix):]), nil
+		}
+		txs = append(txs, tx)
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "GetAddressTxs %s", address.Hex())
+	}
+	return txs, nil, nil
+}
+
+// GetContractCreation returns the hash of the eth tx which created the contract
+// at an address, returns nil if the contract wasn't created by an eth tx, or by
+// an internal call of an eth tx whose block traces were stored.
+func (kv *KVIndexer) GetContractCreation(address common.Address) (*common.Hash, error) {
+	bz, err := kv.db.Get(ContractCreationKey(address))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreation %s", address.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
+// BackfillSenders stores the senders of the eth txs indexed by older versions
+// without them, so that they're never recovered from the signatures when the
+// txs are queried, and returns the number of txs updated. The blocks of the txs
+// are loaded with the given function, which returns nil if the block isn't
+// available, e.g. if it was pruned, in which case its txs are skipped.
+//
+// It's a one-off migration, it's a no-op once it completed.
+func (kv *KVIndexer) BackfillSenders(loadBlock func(height int64) (*cmttypes.Block, error)) (int, error) {
+	done, err := kv.db.Has(MigrationKey(MigrationSenders))
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "BackfillSenders")
+	}
+	if done {
+		return 0, nil
+	}
+
+	// the txs are iterated by height, so that the blocks are loaded once, and
+	// updated by batches, the db not being written while it's iterated
+	var (
+		block   *cmttypes.Block
+		height  int64 = -1
+		updated int
+	)
+	for start := []byte{KeyPrefixTxIndex}; start != nil; {
+		var txHashes []common.Hash
+		txHashes, start, err = kv.loadTxHashes(start, backfillBatchSize)
+		if err != nil {
+			return updated, errorsmod.Wrap(err, "BackfillSenders")
+		}
+
+		batch := kv.db.NewBatch()
+		for _, txHash := range txHashes {
+			txResult, err := kv.GetByTxHash(txHash)
+			if err != nil {
+				batch.Close()
+				return updated, errorsmod.Wrap(err, "BackfillSenders")
+			}
+			if len(txResult.Sender) > 0 {
+				continue
+			}
+
+			if txResult.Height != height {
+				height = txResult.Height
+				if block, err = loadBlock(height); err != nil {
+					batch.Close()
+					return updated, errorsmod.Wrapf(err, "BackfillSenders %d", height)
+				}
+			}
+			if block == nil {
+				continue
+			}
+
+			sender, err := kv.senderOf(block, txResult)
+			if err != nil {
+				batch.Close()
+				return updated, errorsmod.Wrapf(err, "BackfillSenders %d", height)
+			}
+			txResult.Sender = sender.Bytes()
+			if err := batch.Set(TxHashKey(txHash), kv.clientCtx.Codec.MustMarshal(txResult)); err != nil {
+				batch.Close()
+				return updated, errorsmod.Wrap(err, "set tx-hash key")
+			}
+			updated++
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return updated, errorsmod.Wrapf(err, "BackfillSenders %d, write batch", height)
+		}
+	}
+
+	if err := kv.db.SetSync(MigrationKey(MigrationSenders), []byte{1}); err != nil {
+		return updated, errorsmod.Wrap(err, "set migration key")
+	}
+	return updated, nil
+}
+
+// loadTxHashes returns up to limit tx hashes indexed by height from the given
+// tx-index key, and the key to continue from, nil once all were returned.
+func (kv *KVIndexer) loadTxHashes(start []byte, limit int) ([]common.Hash, []byte, error) {
+	it, err := kv.db.Iterator(start, []byte{KeyPrefixTxIndex + 1})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var txHashes []common.Hash
+	for ; it.Valid(); it.Next() {
+		if len(txHashes) == limit {
+			return txHashes, bytes.Clone(it.Key()), nil
+		}
+		txHashes = append(txHashes, common.BytesToHash(it.Value()))
+	}
+	return txHashes, nil, it.Error()
+}
+
+// senderOf returns the sender of the eth msg of a tx result in its block, from
+// the msg or recovered from its signature if the msg has no sender.
+func (kv *KVIndexer) senderOf(block *cmttypes.Block, txResult *cosmosevmtypes.TxResult) (common.Address, error) {
+	if int(txResult.TxIndex) >= len(block.Txs) {
+		return common.Address{}, fmt.Errorf("tx index %d out of range", txResult.TxIndex)
+	}
+	tx, err := kv.clientCtx.TxConfig.TxDecoder()(block.Txs[txResult.TxIndex])
+	if err != nil {
+		return common.Address{}, err
+	}
+	msgs := tx.GetMsgs()
+	if int(txResult.MsgIndex) >= len(msgs) {
+		return common.Address{}, fmt.Errorf("msg index %d out of range", txResult.MsgIndex)
+	}
+	ethMsg, ok := msgs[txResult.MsgIndex].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return common.Address{}, fmt.Errorf("msg %d of tx %d isn't an eth msg", txResult.MsgIndex, txResult.TxIndex)
+	}
+	return rpctypes.DefaultSenderCache.GetSender(ethMsg)
+}
+
+// Rollback removes all the indexed eth txs above the target height in a single
+// batch, so the indexer never references heights that were rolled back on the
+// consensus side. It's idempotent, rolling back to a height the indexer is
+// already at or below is a no-op.
+func (kv *KVIndexer) Rollback(height int64) error {
+	it, err := kv.db.Iterator(TxIndexKey(height+1, 0), []byte{KeyPrefixTxIndex + 1})
+	if err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+	defer it.Close()
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	traceIt, err := kv.db.Iterator(CallTraceKey(height+1), []byte{KeyPrefixCallTrace + 1})
+	if err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+	defer traceIt.Close()
+
+	for ; traceIt.Valid(); traceIt.Next() {
+		if err := batch.Delete(traceIt.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete call-trace key")
+		}
+	}
+	if err := traceIt.Error(); err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+
+	accountsIt, err := kv.db.Iterator(ModifiedAccountsKey(height+1), []byte{KeyPrefixModifiedAccounts + 1})
+	if err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+	defer accountsIt.Close()
+
+	for ; accountsIt.Valid(); accountsIt.Next() {
+		if err := batch.Delete(accountsIt.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete modified-accounts key")
+		}
+	}
+	if err := accountsIt.Error(); err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+
+	if err := kv.deleteAddressTxs(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+	if err := kv.deleteTokenTransfers(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+
+	first, last, err := LoadIndexedRange(kv.db)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+	if first > height {
+		if err := batch.Delete(IndexedRangeKey()); err != nil {
+			return errorsmod.Wrap(err, "delete indexed-range key")
+		}
+	} else if last > height {
+		if err := saveIndexedRange(batch, first, height); err != nil {
+			return errorsmod.Wrapf(err, "Rollback %d", height)
+		}
+	}
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(TxHashKey(common.BytesToHash(it.Value()))); err != nil {
+			return errorsmod.Wrap(err, "delete tx-hash key")
+		}
+		if err := batch.Delete(TxWitnessKey(common.BytesToHash(it.Value()))); err != nil {
+			return errorsmod.Wrap(err, "delete tx-witness key")
+		}
+		if err := kv.deleteCosmosTxHash(batch, common.BytesToHash(it.Value())); err != nil {
+			return err
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete tx-index key")
+		}
+	}
+	if err := it.Error(); err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+	if err := batch.WriteSync(); err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d, write batch", height)
+	}
+	return nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +730,63 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// TxWitnessKey returns the key for db entry: `tx hash -> tx witness json`
+func TxWitnessKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixTxWitness}, hash.Bytes()...)
+}
+
+// CallTraceKey returns the key for db entry: `block number -> flat call traces json`
+func CallTraceKey(blockNumber int64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	return append([]byte{KeyPrefixCallTrace}, bz...)
+}
+
+// CosmosTxHashKey returns the key for db entry: `(cosmos tx hash, msg index) -> eth tx hash`
+func CosmosTxHashKey(hash []byte, msgIndex int) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(msgIndex)) //nolint:gosec // G115 // index won't exceed uint64
+	return append(append([]byte{KeyPrefixCosmosTxHash}, hash...), bz...)
+}
+
+// EthToCosmosHashKey returns the key for db entry: `eth tx hash -> cosmos tx hash`
+func EthToCosmosHashKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixEthToCosmosHash}, hash.Bytes()...)
+}
+
+// ModifiedAccountsKey returns the key for db entry: `block number -> modified accounts json`
+func ModifiedAccountsKey(blockNumber int64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	return append([]byte{KeyPrefixModifiedAccounts}, bz...)
+}
+
+// AddressTxKey returns the key for db entry: `(address, block number, tx index) -> (tx hash, kind)`
+func AddressTxKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	return append(append(append([]byte{KeyPrefixAddressTx}, address.Bytes()...), bz1...), bz2...)
+}
+
+// ContractCreationKey returns the key for db entry: `contract address -> creation tx hash`
+func ContractCreationKey(address common.Address) []byte {
+	return append([]byte{KeyPrefixContractCreation}, address.Bytes()...)
+}
+
+// BlockAddressesKey returns the key for db entry: `block number -> tx and internal call addresses json`
+func BlockAddressesKey(blockNumber int64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	return append([]byte{KeyPrefixBlockAddresses}, bz...)
+}
+
+// IndexedRangeKey returns the key for db entry: `-> first block | last block`
+// of the blocks indexed without gap
+func IndexedRangeKey() []byte {
+	return []byte{KeyPrefixIndexedRange}
+}
+
+// MigrationKey returns the key for db entry: `migration name -> completed`
+func MigrationKey(name string) []byte {
+	return append([]byte{KeyPrefixMigration}, name...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -199,6 +813,48 @@ func LoadFirstBlock(db dbm.DB) (int64, error) {
 	return parseBlockNumberFromKey(it.Key())
 }
 
+// LoadIndexedRange returns the range of the blocks indexed without gap up to the
+// latest indexed one, returns -1, -1 if no block is indexed. Unlike
+// LoadFirstBlock and LoadLastBlock, it accounts the blocks without eth txs.
+func LoadIndexedRange(db dbm.DB) (first, last int64, err error) {
+	bz, err := db.Get(IndexedRangeKey())
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LoadIndexedRange")
+	}
+	if len(bz) == 0 {
+		return -1, -1, nil
+	}
+	return decodeIndexedRange(bz)
+}
+
+// saveIndexedRange stores the range of the blocks indexed without gap into the
+// kv db batch
+func saveIndexedRange(batch dbm.Batch, first, last int64) error {
+	if err := batch.Set(IndexedRangeKey(), encodeIndexedRange(first, last)); err != nil {
+		return errorsmod.Wrap(err, "set indexed-range key")
+	}
+	return nil
+}
+
+// encodeIndexedRange encodes a range of blocks as `first block | last block`
+func encodeIndexedRange(first, last int64) []byte {
+	//#nosec G115 -- the block numbers are positive
+	return append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...)
+}
+
+// decodeIndexedRange decodes a range of blocks encoded by encodeIndexedRange
+func decodeIndexedRange(bz []byte) (first, last int64, err error) {
+	if len(bz) != 8+8 {
+		return 0, 0, fmt.Errorf("wrong indexed range length, expect: %d, got: %d", 8+8, len(bz))
+	}
+	//#nosec G115 -- int overflow is not a concern here, block number is unlikely to exceed 9,223,372,036,854,775,807
+	first, last = int64(sdk.BigEndianToUint64(bz[:8])), int64(sdk.BigEndianToUint64(bz[8:]))
+	if first <= 0 || first > last {
+		return 0, 0, fmt.Errorf("invalid indexed range [%d, %d]", first, last)
+	}
+	return first, last, nil
+}
+
 // isEthTx check if the tx is an eth tx
 func isEthTx(tx sdk.Tx) bool {
 	extTx, ok := tx.(authante.HasExtensionOptionsTx)
@@ -212,6 +868,14 @@ func isEthTx(tx sdk.Tx) bool {
 	return true
 }
 
+// isMixedTx check if the tx is a mixed tx, combining an eth msg with cosmos msgs
+func isMixedTx(tx sdk.Tx) bool {
+	return slices.ContainsFunc(tx.GetMsgs(), func(msg sdk.Msg) bool {
+		_, ok := msg.(*evmtypes.MsgEthereumTx)
+		return ok
+	})
+}
+
 // saveTxResult index the txResult into the kv db batch
 func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *cosmosevmtypes.TxResult) error {
 	bz := codec.MustMarshal(txResult)
@@ -224,6 +888,216 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveCosmosTxHash index the cross reference between an eth tx and the cosmos tx
+// including it into the kv db batch
+func saveCosmosTxHash(batch dbm.Batch, cosmosTxHash []byte, msgIndex int, txHash common.Hash) error {
+	if err := batch.Set(CosmosTxHashKey(cosmosTxHash, msgIndex), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set cosmos-tx-hash key")
+	}
+	if err := batch.Set(EthToCosmosHashKey(txHash), cosmosTxHash); err != nil {
+		return errorsmod.Wrap(err, "set eth-to-cosmos-hash key")
+	}
+	return nil
+}
+
+// deleteCosmosTxHash deletes the cross reference between an eth tx and the cosmos
+// tx including it from the kv db batch
+func (kv *KVIndexer) deleteCosmosTxHash(batch dbm.Batch, txHash common.Hash) error {
+	cosmosTxHash, err := kv.db.Get(EthToCosmosHashKey(txHash))
+	if err != nil {
+		return errorsmod.Wrap(err, "get eth-to-cosmos-hash key")
+	}
+	if len(cosmosTxHash) == 0 {
+		return nil
+	}
+	prefix := append([]byte{KeyPrefixCosmosTxHash}, cosmosTxHash...)
+	it, err := kv.db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate cosmos-tx-hash keys")
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete cosmos-tx-hash key")
+		}
+	}
+	if err := it.Error(); err != nil {
+		return errorsmod.Wrap(err, "iterate cosmos-tx-hash keys")
+	}
+	if err := batch.Delete(EthToCosmosHashKey(txHash)); err != nil {
+		return errorsmod.Wrap(err, "delete eth-to-cosmos-hash key")
+	}
+	return nil
+}
+
+// saveTxWitnesses index the tx witnesses emitted by the evm module into the kv db batch
+func saveTxWitnesses(batch dbm.Batch, result *abci.ExecTxResult) error {
+	for _, event := range result.Events {
+		if event.Type != evmtypes.EventTypeTxWitness {
+			continue
+		}
+		var txHash, witness string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case evmtypes.AttributeKeyEthereumTxHash:
+				txHash = attr.Value
+			case evmtypes.AttributeKeyTxWitness:
+				witness = attr.Value
+			}
+		}
+		if txHash == "" || witness == "" {
+			continue
+		}
+		if err := batch.Set(TxWitnessKey(common.HexToHash(txHash)), []byte(witness)); err != nil {
+			return errorsmod.Wrap(err, "set tx-witness key")
+		}
+	}
+	return nil
+}
+
+// saveModifiedAccounts index the accounts modified by the eth txs of a block into
+// the kv db batch, sorted and without duplicates
+func saveModifiedAccounts(batch dbm.Batch, blockNumber int64, accounts []common.Address) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+	slices.SortFunc(accounts, func(a, b common.Address) int { return a.Cmp(b) })
+	bz, err := json.Marshal(slices.Compact(accounts))
+	if err != nil {
+		return errorsmod.Wrap(err, "encode modified accounts")
+	}
+	if err := batch.Set(ModifiedAccountsKey(blockNumber), bz); err != nil {
+		return errorsmod.Wrap(err, "set modified-accounts key")
+	}
+	return nil
+}
+
+// saveBlockAddresses index the addresses of the eth txs of a block, and of their
+// internal calls, into the kv db batch, sorted and without duplicates, so that their address-tx
+// entries can be found when the block is rolled back
+func saveBlockAddresses(batch dbm.Batch, blockNumber int64, addresses []common.Address) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	slices.SortFunc(addresses, func(a, b common.Address) int { return a.Cmp(b) })
+	bz, err := json.Marshal(slices.Compact(addresses))
+	if err != nil {
+		return errorsmod.Wrap(err, "encode block addresses")
+	}
+	if err := batch.Set(BlockAddressesKey(blockNumber), bz); err != nil {
+		return errorsmod.Wrap(err, "set block-addresses key")
+	}
+	return nil
+}
+
+// deleteAddressTxs deletes the address-tx entries of the blocks above the height,
+// and the contract creations of their txs, from the kv db batch
+func (kv *KVIndexer) deleteAddressTxs(batch dbm.Batch, height int64) error {
+	it, err := kv.db.Iterator(BlockAddressesKey(height+1), []byte{KeyPrefixBlockAddresses + 1})
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate block-addresses keys")
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		blockNumber := int64(sdk.BigEndianToUint64(it.Key()[1:])) //#nosec G115 -- int overflow is not a concern here
+		var addresses []common.Address
+		if err := json.Unmarshal(it.Value(), &addresses); err != nil {
+			return errorsmod.Wrapf(err, "decode block addresses %d", blockNumber)
+		}
+		for _, address := range addresses {
+			if err := kv.deleteBlockAddressTxs(batch, address, blockNumber); err != nil {
+				return err
+			}
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete block-addresses key")
+		}
+	}
+	if err := it.Error(); err != nil {
+		return errorsmod.Wrap(err, "iterate block-addresses keys")
+	}
+	return nil
+}
+
+// deleteBlockAddressTxs deletes the address-tx entries of an address in a block
+// from the kv db batch, and its contract creation if it's one of their txs
+func (kv *KVIndexer) deleteBlockAddressTxs(batch dbm.Batch, address common.Address, blockNumber int64) error {
+	creation, err := kv.db.Get(ContractCreationKey(address))
+	if err != nil {
+		return errorsmod.Wrap(err, "get contract-creation key")
+	}
+	prefix := append(append([]byte{KeyPrefixAddressTx}, address.Bytes()...), sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+	it, err := kv.db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate address-tx keys")
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if len(creation) > 0 && bytes.Equal(creation, it.Value()[:common.HashLength]) {
+			if err := batch.Delete(ContractCreationKey(address)); err != nil {
+				return errorsmod.Wrap(err, "delete contract-creation key")
+			}
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete address-tx key")
+		}
+	}
+	if err := it.Error(); err != nil {
+		return errorsmod.Wrap(err, "iterate address-tx keys")
+	}
+	return nil
+}
+
+// flatTrace are the fields of a flat call trace read by the indexer
+type flatTrace struct {
+	Action struct {
+		From           *common.Address `json:"from"`
+		To             *common.Address `json:"to"`
+		SelfDestructed *common.Address `json:"address"`
+		RefundAddress  *common.Address `json:"refundAddress"`
+	} `json:"action"`
+	Result *struct {
+		Address *common.Address `json:"address"`
+	} `json:"result"`
+	Error           string       `json:"error"`
+	TraceAddress    []int        `json:"traceAddress"`
+	TransactionHash *common.Hash `json:"transactionHash"`
+	Type            string       `json:"type"`
+}
+
+// addresses returns the addresses of the call of the trace
+func (t *flatTrace) addresses() []common.Address {
+	var addresses []common.Address
+	for _, address := range []*common.Address{t.Action.From, t.Action.To, t.Action.SelfDestructed, t.Action.RefundAddress} {
+		if address != nil {
+			addresses = append(addresses, *address)
+		}
+	}
+	if t.Result != nil && t.Result.Address != nil {
+		addresses = append(addresses, *t.Result.Address)
+	}
+	return addresses
+}
+
+// addressTxValue returns the value of an address-tx entry, the tx hash followed by
+// the kind of the tx for the address
+func addressTxValue(txHash common.Hash, kind cosmosevmtypes.AddressTxKind) []byte {
+	return append(txHash.Bytes(), byte(kind))
+}
+
+// parseAddressTx parses an address-tx entry
+func parseAddressTx(key, value []byte) cosmosevmtypes.AddressTx {
+	return cosmosevmtypes.AddressTx{
+		Hash:       common.BytesToHash(value[:common.HashLength]),
+		Height:     int64(sdk.BigEndianToUint64(key[1+common.AddressLength : 1+common.AddressLength+8])), //#nosec G115 -- int overflow is not a concern here
+		EthTxIndex: int32(sdk.BigEndianToUint64(key[1+common.AddressLength+8:])),                         //#nosec G115 -- the eth tx index is an int32
+		Kind:       cosmosevmtypes.AddressTxKind(value[common.HashLength]),
+	}
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
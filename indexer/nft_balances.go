@@ -0,0 +1,281 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	dbm "github.com/cosmos/cosmos-db"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// NFTBalanceKeyLength is the length of nft-balance key
+const NFTBalanceKeyLength = 1 + common.AddressLength + common.AddressLength + common.HashLength
+
+// GetNFTsByOwner returns up to limit ERC721 and ERC1155 tokens held by an owner,
+// ordered by token contract and token id, from a cursor, and the cursor to
+// continue from, nil once all were returned.
+func (kv *KVIndexer) GetNFTsByOwner(owner common.Address, cursor []byte, limit int) ([]*cosmosevmtypes.NFT, []byte, error) {
+	prefix := append([]byte{KeyPrefixNFTBalance}, owner.Bytes()...)
+	start := prefix
+	if cursor != nil {
+		if len(cursor) != NFTBalanceKeyLength-len(prefix) {
+			return nil, nil, fmt.Errorf("invalid cursor length, expect: %d, got: %d", NFTBalanceKeyLength-len(prefix), len(cursor))
+		}
+		start = slices.Concat(prefix, cursor)
+	}
+	it, err := kv.db.Iterator(start, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "GetNFTsByOwner %s", owner.Hex())
+	}
+	defer it.Close()
+
+	var nfts []*cosmosevmtypes.NFT
+	for ; it.Valid(); it.Next() {
+		if len(nfts) == limit {
+			return nfts, bytes.Clone(it.Key()[len(prefix):]), nil
+		}
+		var nft cosmosevmtypes.NFT
+		if err := json.Unmarshal(it.Value(), &nft); err != nil {
+			return nil, nil, errorsmod.Wrapf(err, "GetNFTsByOwner %s", owner.Hex())
+		}
+		nfts = append(nfts, &nft)
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "GetNFTsByOwner %s", owner.Hex())
+	}
+	return nfts, nil, nil
+}
+
+// BackfillNFTBalances rebuilds the balances of the NFT owners from the token
+// transfers indexed by older versions without them, and returns the number of
+// transfers replayed.
+//
+// It's a one-off migration, it's a no-op once it completed.
+func (kv *KVIndexer) BackfillNFTBalances() (int, error) {
+	done, err := kv.db.Has(MigrationKey(MigrationNFTBalances))
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "BackfillNFTBalances")
+	}
+	if done {
+		return 0, nil
+	}
+
+	replayed, err := kv.RebuildNFTBalances()
+	if err != nil {
+		return replayed, err
+	}
+	if err := kv.db.SetSync(MigrationKey(MigrationNFTBalances), []byte{1}); err != nil {
+		return replayed, errorsmod.Wrap(err, "set migration key")
+	}
+	return replayed, nil
+}
+
+// RebuildNFTBalances drops the balances of the NFT owners and replays the token
+// transfer index oldest first, and returns the number of transfers replayed.
+// The balances are updated as the blocks are indexed, so they need a rebuild
+// once blocks were indexed out of order, e.g. backward.
+func (kv *KVIndexer) RebuildNFTBalances() (int, error) {
+	replayed, err := rebuildNFTBalances(kv.db)
+	if err != nil {
+		return replayed, errorsmod.Wrap(err, "RebuildNFTBalances")
+	}
+	return replayed, nil
+}
+
+// NFTBalanceKey returns the key for db entry: `(owner, token, token id) -> nft json`
+func NFTBalanceKey(owner, token common.Address, tokenID *big.Int) []byte {
+	return slices.Concat([]byte{KeyPrefixNFTBalance}, owner.Bytes(), token.Bytes(), common.BigToHash(tokenID).Bytes())
+}
+
+// rebuildNFTBalances rebuilds the balances of the NFT owners from the token
+// transfer index, by batches, the db not being written while it's iterated
+func rebuildNFTBalances(db dbm.DB) (int, error) {
+	for {
+		keys, err := loadKeys(db, []byte{KeyPrefixNFTBalance}, backfillBatchSize)
+		if err != nil {
+			return 0, err
+		}
+		if len(keys) == 0 {
+			break
+		}
+		batch := db.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return 0, errorsmod.Wrap(err, "delete nft-balance key")
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return 0, errorsmod.Wrap(err, "write batch")
+		}
+	}
+
+	var replayed int
+	for start := []byte{KeyPrefixTokenTransfer}; start != nil; {
+		var transfers []*cosmosevmtypes.TokenTransfer
+		var err error
+		transfers, start, err = loadTokenTransfers(db, start, backfillBatchSize)
+		if err != nil {
+			return replayed, err
+		}
+
+		balances := newNFTBalances(db)
+		for _, transfer := range transfers {
+			if err := balances.apply(transfer, false); err != nil {
+				return replayed, err
+			}
+		}
+		batch := db.NewBatch()
+		if err := balances.write(batch); err != nil {
+			batch.Close()
+			return replayed, err
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return replayed, errorsmod.Wrap(err, "write batch")
+		}
+		replayed += len(transfers)
+	}
+	return replayed, nil
+}
+
+// loadKeys returns up to limit keys of the entries with the given prefix
+func loadKeys(db dbm.DB, prefix []byte, limit int) ([][]byte, error) {
+	it, err := db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid() && len(keys) < limit; it.Next() {
+		keys = append(keys, bytes.Clone(it.Key()))
+	}
+	return keys, it.Error()
+}
+
+// loadTokenTransfers returns up to limit token transfers from the given
+// token-transfer key, and the key to continue from, nil once all were returned.
+func loadTokenTransfers(db dbm.DB, start []byte, limit int) ([]*cosmosevmtypes.TokenTransfer, []byte, error) {
+	it, err := db.Iterator(start, []byte{KeyPrefixTokenTransfer + 1})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var transfers []*cosmosevmtypes.TokenTransfer
+	for ; it.Valid(); it.Next() {
+		if len(transfers) == limit {
+			return transfers, bytes.Clone(it.Key()), nil
+		}
+		var transfer cosmosevmtypes.TokenTransfer
+		if err := json.Unmarshal(it.Value(), &transfer); err != nil {
+			return nil, nil, errorsmod.Wrap(err, "decode token transfer")
+		}
+		transfers = append(transfers, &transfer)
+	}
+	return transfers, nil, it.Error()
+}
+
+// nftBalances applies token transfers to the balances of the NFT owners, the
+// updated balances are kept in memory until they're written to a batch, so that
+// the transfers of a token within a batch see each other.
+type nftBalances struct {
+	db dbm.DB
+	// nfts are the updated balances by nft-balance key, nil once the balance
+	// dropped to zero
+	nfts map[string]*cosmosevmtypes.NFT
+}
+
+func newNFTBalances(db dbm.DB) *nftBalances {
+	return &nftBalances{db, make(map[string]*cosmosevmtypes.NFT)}
+}
+
+// apply moves the value of an ERC721 or ERC1155 transfer from its sender to its
+// recipient, or back to revert it, the ERC20 transfers are skipped
+func (b *nftBalances) apply(transfer *cosmosevmtypes.TokenTransfer, revert bool) error {
+	if transfer.Standard == cosmosevmtypes.TokenStandardERC20 || transfer.TokenID == nil || transfer.Value == nil {
+		return nil
+	}
+	from, to := transfer.From, transfer.To
+	if revert {
+		from, to = to, from
+	}
+	if err := b.add(from, transfer, new(big.Int).Neg(transfer.Value.ToInt())); err != nil {
+		return err
+	}
+	return b.add(to, transfer, transfer.Value.ToInt())
+}
+
+// add adds delta to the balance of the owner of the token of the transfer, the
+// zero address of the mints and burns has no balance
+func (b *nftBalances) add(owner common.Address, transfer *cosmosevmtypes.TokenTransfer, delta *big.Int) error {
+	if owner == (common.Address{}) {
+		return nil
+	}
+
+	key := NFTBalanceKey(owner, transfer.Token, transfer.TokenID.ToInt())
+	nft, ok := b.nfts[string(key)]
+	if !ok {
+		bz, err := b.db.Get(key)
+		if err != nil {
+			return errorsmod.Wrap(err, "get nft-balance key")
+		}
+		if len(bz) > 0 {
+			nft = new(cosmosevmtypes.NFT)
+			if err := json.Unmarshal(bz, nft); err != nil {
+				return errorsmod.Wrap(err, "decode nft balance")
+			}
+		}
+	}
+
+	balance := new(big.Int).Set(delta)
+	if nft != nil {
+		balance.Add(balance, nft.Balance.ToInt())
+	}
+	// the balance goes negative if the earlier transfers of the token weren't
+	// indexed, e.g. they're older than the state sync snapshot
+	if balance.Sign() <= 0 {
+		b.nfts[string(key)] = nil
+		return nil
+	}
+	b.nfts[string(key)] = &cosmosevmtypes.NFT{
+		Standard: transfer.Standard,
+		Token:    transfer.Token,
+		TokenID:  transfer.TokenID,
+		Balance:  (*hexutil.Big)(balance),
+	}
+	return nil
+}
+
+// write sets the updated balances in the batch, deleting the zero ones
+func (b *nftBalances) write(batch dbm.Batch) error {
+	for key, nft := range b.nfts {
+		if nft == nil {
+			if err := batch.Delete([]byte(key)); err != nil {
+				return errorsmod.Wrap(err, "delete nft-balance key")
+			}
+			continue
+		}
+		bz, err := json.Marshal(nft)
+		if err != nil {
+			return errorsmod.Wrap(err, "encode nft balance")
+		}
+		if err := batch.Set([]byte(key), bz); err != nil {
+			return errorsmod.Wrap(err, "set nft-balance key")
+		}
+	}
+	return nil
+}
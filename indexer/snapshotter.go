@@ -0,0 +1,412 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	dbm "github.com/cosmos/cosmos-db"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	snapshot "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// SnapshotName is the name of the evm indexer snapshot extension
+	SnapshotName = "evm_indexer"
+	// SnapshotFormat format 2 is a header payload, the range of the blocks
+	// covered by the snapshot `first block | last block`, followed by a payload
+	// per indexer db entry: `key length (uvarint) | key | value`
+	SnapshotFormat = 2
+
+	// snapshotFormatV1 is the format 1, the payloads of the indexer db entries
+	// without the header, which are discarded on restore as their range is
+	// unknown
+	snapshotFormatV1 = 1
+	// snapshotPollInterval is the interval at which the indexed range is polled
+	// while waiting for the indexer to index the snapshot height
+	snapshotPollInterval = 100 * time.Millisecond
+)
+
+var _ snapshot.ExtensionSnapshotter = &Snapshotter{}
+
+// Snapshotter is a state-sync snapshot extension that ships a baseline of the
+// evm indexer, so that a node joining through state sync can serve the txs of
+// the most recent blocks instead of only the ones after the sync height.
+//
+// All the entries of the indexed blocks are included: the tx results, which
+// hold the tx senders, the tx witnesses, the cosmos tx cross references, the
+// call traces, the modified accounts, the txs by address, the contract
+// creations and the token transfers. The NFT balances aren't included, they're
+// rebuilt from the restored token transfers, so they only account for the
+// transfers of the snapshot blocks.
+//
+// The indexer is node-local and runs behind the commits, so the entries are
+// only included if the indexer has indexed the snapshot height, and only for
+// the blocks it has indexed without gap up to it. The covered range is shipped
+// in the header of the payloads, and recorded as the indexed range of the
+// restored indexer. A snapshot without payloads covers no block.
+//
+// Contract code lives in the x/vm store and is already part of the multistore
+// snapshot, so eth_getCode works after a state sync without this extension.
+//
+// The extension is registered on every node, even if the indexer is disabled,
+// because the snapshot manager refuses to restore unknown extensions. A nil db
+// writes no payloads and discards the restored ones.
+type Snapshotter struct {
+	db dbm.DB
+	// blocks is the number of blocks, counted back from the snapshot height,
+	// whose indexed entries are included in a snapshot
+	blocks uint64
+	// waitTimeout is the maximum duration to wait for the indexer to index the
+	// snapshot height before the snapshot is taken without the indexer entries
+	waitTimeout time.Duration
+}
+
+// NewSnapshotter creates the evm indexer snapshot extension
+func NewSnapshotter(db dbm.DB, blocks uint64, waitTimeout time.Duration) *Snapshotter {
+	return &Snapshotter{db, blocks, waitTimeout}
+}
+
+// SnapshotName implements the ExtensionSnapshotter interface
+func (s *Snapshotter) SnapshotName() string {
+	return SnapshotName
+}
+
+// SnapshotFormat implements the ExtensionSnapshotter interface
+func (s *Snapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements the ExtensionSnapshotter interface
+func (s *Snapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat, snapshotFormatV1}
+}
+
+// SnapshotExtension writes the range of the covered blocks, then the indexer
+// entries of the blocks in `(height - blocks, height]` which are indexed without
+// gap up to the snapshot height, as payloads.
+func (s *Snapshotter) SnapshotExtension(height uint64, payloadWriter snapshot.ExtensionPayloadWriter) error {
+	if s.db == nil || s.blocks == 0 {
+		return nil
+	}
+
+	//#nosec G115 -- int overflow is not a concern here, block number is unlikely to exceed 9,223,372,036,854,775,807
+	endHeight := int64(height)
+	first, last, err := s.waitIndexedRange(endHeight)
+	if err != nil {
+		return errorsmod.Wrapf(err, "SnapshotExtension %d", height)
+	}
+	if first == -1 || first > endHeight || last < endHeight {
+		// the indexer doesn't cover the snapshot height
+		return nil
+	}
+	startHeight := first
+	if height > s.blocks {
+		startHeight = max(first, endHeight-int64(s.blocks)+1) //#nosec G115 -- the blocks are below the height
+	}
+	if err := payloadWriter(encodeIndexedRange(startHeight, endHeight)); err != nil {
+		return err
+	}
+
+	write := func(key []byte) error {
+		value, err := s.db.Get(key)
+		if err != nil {
+			return errorsmod.Wrapf(err, "SnapshotExtension %d", height)
+		}
+		if len(value) == 0 {
+			return nil
+		}
+		return payloadWriter(encodeSnapshotEntry(key, value))
+	}
+
+	// the range is iterated up to the end height excluded
+	endHeight++
+	// the cosmos txs including several eth txs are only cross referenced once
+	cosmosTxHashes := make(map[string]bool)
+	err = s.iterate(TxIndexKey(startHeight, 0), TxIndexKey(endHeight, 0), func(key, txHashBz []byte) error {
+		txHash := common.BytesToHash(txHashBz)
+		cosmosTxHash, err := s.db.Get(EthToCosmosHashKey(txHash))
+		if err != nil {
+			return errorsmod.Wrapf(err, "SnapshotExtension %d", height)
+		}
+		for _, k := range [][]byte{TxHashKey(txHash), TxWitnessKey(txHash), EthToCosmosHashKey(txHash)} {
+			if err := write(k); err != nil {
+				return err
+			}
+		}
+		if len(cosmosTxHash) > 0 && !cosmosTxHashes[string(cosmosTxHash)] {
+			cosmosTxHashes[string(cosmosTxHash)] = true
+			prefix := append([]byte{KeyPrefixCosmosTxHash}, cosmosTxHash...)
+			if err := s.iterate(prefix, storetypes.PrefixEndBytes(prefix), func(key, value []byte) error {
+				return payloadWriter(encodeSnapshotEntry(key, value))
+			}); err != nil {
+				return err
+			}
+		}
+		return payloadWriter(encodeSnapshotEntry(key, txHashBz))
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, keyFn := range []func(int64) []byte{CallTraceKey, ModifiedAccountsKey} {
+		if err := s.iterate(keyFn(startHeight), keyFn(endHeight), func(key, value []byte) error {
+			return payloadWriter(encodeSnapshotEntry(key, value))
+		}); err != nil {
+			return err
+		}
+	}
+
+	err = s.iterate(TokenTransferKey(startHeight, 0, 0), TokenTransferKey(endHeight, 0, 0), func(key, value []byte) error {
+		var transfer cosmosevmtypes.TokenTransfer
+		if err := json.Unmarshal(value, &transfer); err != nil {
+			return errorsmod.Wrapf(err, "SnapshotExtension %d, decode token transfer", height)
+		}
+		for _, address := range tokenTransferAddresses(&transfer) {
+			addressKey := append(append([]byte{KeyPrefixAddressTokenTransfer}, address.Bytes()...), key[1:]...)
+			if err := write(addressKey); err != nil {
+				return err
+			}
+		}
+		return payloadWriter(encodeSnapshotEntry(key, value))
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.iterate(BlockAddressesKey(startHeight), BlockAddressesKey(endHeight), func(key, value []byte) error {
+		blockNumber := sdk.BigEndianToUint64(key[1:])
+		var addresses []common.Address
+		if err := json.Unmarshal(value, &addresses); err != nil {
+			return errorsmod.Wrapf(err, "SnapshotExtension %d, decode block addresses %d", height, blockNumber)
+		}
+		for _, address := range addresses {
+			creation, err := s.db.Get(ContractCreationKey(address))
+			if err != nil {
+				return errorsmod.Wrapf(err, "SnapshotExtension %d", height)
+			}
+			prefix := append(append([]byte{KeyPrefixAddressTx}, address.Bytes()...), key[1:]...)
+			if err := s.iterate(prefix, storetypes.PrefixEndBytes(prefix), func(key, value []byte) error {
+				// the contract is created by one of the txs of the block
+				if len(creation) > 0 && bytes.Equal(creation, value[:common.HashLength]) {
+					if err := payloadWriter(encodeSnapshotEntry(ContractCreationKey(address), creation)); err != nil {
+						return err
+					}
+				}
+				return payloadWriter(encodeSnapshotEntry(key, value))
+			}); err != nil {
+				return err
+			}
+		}
+		return payloadWriter(encodeSnapshotEntry(key, value))
+	})
+}
+
+// waitIndexedRange returns the indexed range once it reaches the height, or
+// after the wait timeout
+func (s *Snapshotter) waitIndexedRange(height int64) (first, last int64, err error) {
+	deadline := time.Now().Add(s.waitTimeout)
+	for {
+		first, last, err = LoadIndexedRange(s.db)
+		if err != nil || last >= height || !time.Now().Before(deadline) {
+			return first, last, err
+		}
+		time.Sleep(snapshotPollInterval)
+	}
+}
+
+// iterate calls fn for every db entry in `[start, end)`
+func (s *Snapshotter) iterate(start, end []byte, fn func(key, value []byte) error) error {
+	it, err := s.db.Iterator(start, end)
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate indexer entries")
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := fn(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// RestoreExtension imports the indexer entries from the payloads in a single
+// batch, each entry being validated against the covered range of the header
+// before it's written, records the covered range as the indexed range, then
+// rebuilds the NFT balances from the token transfers.
+func (s *Snapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshot.ExtensionPayloadReader) error {
+	if format != SnapshotFormat && format != snapshotFormatV1 {
+		return errorsmod.Wrapf(snapshot.ErrUnknownFormat, "format %v", format)
+	}
+
+	var batch dbm.Batch
+	if s.db != nil && format == SnapshotFormat {
+		batch = s.db.NewBatch()
+		defer batch.Close()
+	}
+
+	// the covered range, read from the header
+	first, last := int64(-1), int64(-1)
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if batch == nil {
+			continue
+		}
+
+		if first == -1 {
+			if first, last, err = decodeIndexedRange(payload); err != nil {
+				return errorsmod.Wrap(err, "invalid evm indexer snapshot header")
+			}
+			//#nosec G115 -- int overflow is not a concern here, block number is unlikely to exceed 9,223,372,036,854,775,807
+			if last != int64(height) {
+				return fmt.Errorf("invalid evm indexer snapshot header, range [%d, %d] doesn't end at the snapshot height %d", first, last, height)
+			}
+			if err := saveIndexedRange(batch, first, last); err != nil {
+				return err
+			}
+			continue
+		}
+
+		key, value, err := decodeSnapshotEntry(payload)
+		if err != nil {
+			return err
+		}
+		if err := validateSnapshotEntry(key, value); err != nil {
+			return errorsmod.Wrapf(err, "invalid evm indexer snapshot entry %X", key)
+		}
+		if blockNumber, ok := snapshotEntryBlock(key); ok && (blockNumber < first || blockNumber > last) {
+			return fmt.Errorf("invalid evm indexer snapshot entry %X, block %d out of the range [%d, %d]", key, blockNumber, first, last)
+		}
+		if err := batch.Set(key, value); err != nil {
+			return errorsmod.Wrap(err, "set indexer entry")
+		}
+	}
+
+	if batch == nil {
+		return nil
+	}
+	if err := batch.WriteSync(); err != nil {
+		return errorsmod.Wrapf(err, "RestoreExtension %d, write batch", height)
+	}
+	if _, err := rebuildNFTBalances(s.db); err != nil {
+		return errorsmod.Wrapf(err, "RestoreExtension %d, rebuild nft balances", height)
+	}
+	return nil
+}
+
+// encodeSnapshotEntry encodes an indexer db entry as a snapshot payload
+func encodeSnapshotEntry(key, value []byte) []byte {
+	payload := make([]byte, 0, binary.MaxVarintLen64+len(key)+len(value))
+	payload = binary.AppendUvarint(payload, uint64(len(key)))
+	payload = append(payload, key...)
+	return append(payload, value...)
+}
+
+// decodeSnapshotEntry decodes an indexer db entry from a snapshot payload
+func decodeSnapshotEntry(payload []byte) (key, value []byte, err error) {
+	keyLen, n := binary.Uvarint(payload)
+	if n <= 0 || keyLen == 0 || keyLen >= uint64(len(payload)-n) {
+		return nil, nil, fmt.Errorf("invalid evm indexer snapshot payload, length: %d", len(payload))
+	}
+	return payload[n : n+int(keyLen)], payload[n+int(keyLen):], nil //#nosec G115 -- keyLen is bounded by the payload length
+}
+
+// snapshotEntryBlock returns the block number of a validated entry whose key
+// holds one
+func snapshotEntryBlock(key []byte) (int64, bool) {
+	var bz []byte
+	switch key[0] {
+	case KeyPrefixTxIndex, KeyPrefixCallTrace, KeyPrefixModifiedAccounts, KeyPrefixBlockAddresses, KeyPrefixTokenTransfer:
+		bz = key[1 : 1+8]
+	case KeyPrefixAddressTx, KeyPrefixAddressTokenTransfer:
+		bz = key[1+common.AddressLength : 1+common.AddressLength+8]
+	default:
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true //#nosec G115 -- int overflow is not a concern here, block number is unlikely to exceed 9,223,372,036,854,775,807
+}
+
+// validateSnapshotEntry checks that the key of a restored entry has a known
+// prefix and length, and that its value can be decoded.
+func validateSnapshotEntry(key, value []byte) error {
+	switch key[0] {
+	case KeyPrefixTxHash:
+		if len(key) != 1+common.HashLength {
+			return errors.New("invalid tx-hash key length")
+		}
+		var txResult cosmosevmtypes.TxResult
+		return txResult.Unmarshal(value)
+	case KeyPrefixTxIndex:
+		if len(key) != TxIndexKeyLength || len(value) != common.HashLength {
+			return errors.New("invalid tx-index entry length")
+		}
+	case KeyPrefixTxWitness:
+		if len(key) != 1+common.HashLength {
+			return errors.New("invalid tx-witness key length")
+		}
+		var witness cosmosevmtypes.TxWitness
+		return json.Unmarshal(value, &witness)
+	case KeyPrefixCallTrace:
+		if len(key) != 1+8 || !json.Valid(value) {
+			return errors.New("invalid call-trace entry")
+		}
+	case KeyPrefixModifiedAccounts:
+		if len(key) != 1+8 {
+			return errors.New("invalid modified-accounts key length")
+		}
+		var accounts []common.Address
+		return json.Unmarshal(value, &accounts)
+	case KeyPrefixCosmosTxHash:
+		if len(key) <= 1+8 || len(value) != common.HashLength {
+			return errors.New("invalid cosmos-tx-hash entry length")
+		}
+	case KeyPrefixEthToCosmosHash:
+		if len(key) != 1+common.HashLength || len(value) == 0 {
+			return errors.New("invalid eth-to-cosmos-hash entry length")
+		}
+	case KeyPrefixAddressTx:
+		if len(key) != AddressTxKeyLength || len(value) != common.HashLength+1 {
+			return errors.New("invalid address-tx entry length")
+		}
+	case KeyPrefixContractCreation:
+		if len(key) != 1+common.AddressLength || len(value) != common.HashLength {
+			return errors.New("invalid contract-creation entry length")
+		}
+	case KeyPrefixBlockAddresses:
+		if len(key) != 1+8 {
+			return errors.New("invalid block-addresses key length")
+		}
+		var addresses []common.Address
+		return json.Unmarshal(value, &addresses)
+	case KeyPrefixTokenTransfer:
+		if len(key) != TokenTransferKeyLength {
+			return errors.New("invalid token-transfer key length")
+		}
+		var transfer cosmosevmtypes.TokenTransfer
+		return json.Unmarshal(value, &transfer)
+	case KeyPrefixAddressTokenTransfer:
+		if len(key) != AddressTokenTransferKeyLength || len(value) != 1 {
+			return errors.New("invalid address-token-transfer entry length")
+		}
+	default:
+		return fmt.Errorf("unknown key prefix %d", key[0])
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package indexer
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	dbm "github.com/cosmos/cosmos-db"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TokenTransferKeyLength is the length of token-transfer key
+	TokenTransferKeyLength = 1 + 8 + 8 + 8
+	// AddressTokenTransferKeyLength is the length of address-token-transfer key
+	AddressTokenTransferKeyLength = 1 + common.AddressLength + 8 + 8 + 8
+)
+
+var (
+	// transferTopic is the topic of the ERC20 and ERC721 Transfer events
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// transferSingleTopic is the topic of the ERC1155 TransferSingle event
+	transferSingleTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	// transferBatchTopic is the topic of the ERC1155 TransferBatch event
+	transferBatchTopic = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+
+	// transferBatchArgs are the non indexed arguments of the TransferBatch event
+	transferBatchArgs = abi.Arguments{{Type: mustNewType("uint256[]")}, {Type: mustNewType("uint256[]")}}
+)
+
+// GetTokenTransfers returns up to limit token transfers sent by or to an address,
+// or of the token contract at the address, in the blocks in `[fromBlock, toBlock]`,
+// oldest first.
+func (kv *KVIndexer) GetTokenTransfers(address common.Address, fromBlock, toBlock int64, limit int) ([]*cosmosevmtypes.TokenTransfer, error) {
+	it, err := kv.db.Iterator(AddressTokenTransferKey(address, fromBlock, 0, 0), AddressTokenTransferKey(address, toBlock+1, 0, 0))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetTokenTransfers %s", address.Hex())
+	}
+	defer it.Close()
+
+	var transfers []*cosmosevmtypes.TokenTransfer
+	for ; it.Valid() && len(transfers) < limit; it.Next() {
+		key := append([]byte{KeyPrefixTokenTransfer}, it.Key()[1+common.AddressLength:]...)
+		bz, err := kv.db.Get(key)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetTokenTransfers %s", address.Hex())
+		}
+		var transfer cosmosevmtypes.TokenTransfer
+		if err := json.Unmarshal(bz, &transfer); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetTokenTransfers %s", address.Hex())
+		}
+		transfers = append(transfers, &transfer)
+	}
+	if err := it.Error(); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetTokenTransfers %s", address.Hex())
+	}
+	return transfers, nil
+}
+
+// TokenTransferKey returns the key for db entry: `(block number, log index, batch index) -> token transfer json`
+func TokenTransferKey(blockNumber int64, logIndex uint64, batchIndex int) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(logIndex)
+	bz3 := sdk.Uint64ToBigEndian(uint64(batchIndex)) //nolint:gosec // G115 // index won't exceed uint64
+	return append(append(append([]byte{KeyPrefixTokenTransfer}, bz1...), bz2...), bz3...)
+}
+
+// AddressTokenTransferKey returns the key for db entry: `(address, block number, log index, batch index) -> 1`
+func AddressTokenTransferKey(address common.Address, blockNumber int64, logIndex uint64, batchIndex int) []byte {
+	key := TokenTransferKey(blockNumber, logIndex, batchIndex)
+	return append(append([]byte{KeyPrefixAddressTokenTransfer}, address.Bytes()...), key[1:]...)
+}
+
+// saveTokenTransfers index the token transfers decoded from the logs of an eth
+// tx into the kv db batch, with the addresses of their senders, recipients and
+// token contracts, the zero address of the mints and burns being left out, and
+// applies them to the NFT balances
+func saveTokenTransfers(
+	batch dbm.Batch,
+	balances *nftBalances,
+	logs []*ethtypes.Log,
+	txHash common.Hash,
+	txResult *cosmosevmtypes.TxResult,
+) error {
+	for _, log := range logs {
+		for batchIndex, transfer := range parseTokenTransfers(log) {
+			transfer.TransactionHash = txHash
+			transfer.BlockNumber = hexutil.Uint64(txResult.Height)          //#nosec G115 -- the height is positive
+			transfer.TransactionIndex = hexutil.Uint64(txResult.EthTxIndex) //#nosec G115 -- the index is positive
+			transfer.LogIndex = hexutil.Uint64(log.Index)
+
+			bz, err := json.Marshal(transfer)
+			if err != nil {
+				return errorsmod.Wrap(err, "encode token transfer")
+			}
+			if err := batch.Set(TokenTransferKey(txResult.Height, uint64(log.Index), batchIndex), bz); err != nil {
+				return errorsmod.Wrap(err, "set token-transfer key")
+			}
+			for _, address := range tokenTransferAddresses(transfer) {
+				if err := batch.Set(AddressTokenTransferKey(address, txResult.Height, uint64(log.Index), batchIndex), []byte{1}); err != nil {
+					return errorsmod.Wrap(err, "set address-token-transfer key")
+				}
+			}
+			if err := balances.apply(transfer, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteTokenTransfers deletes the token transfers of the blocks above the height
+// from the kv db batch, and reverts them from the NFT balances, newest first
+func (kv *KVIndexer) deleteTokenTransfers(batch dbm.Batch, height int64) error {
+	it, err := kv.db.Iterator(TokenTransferKey(height+1, 0, 0), []byte{KeyPrefixTokenTransfer + 1})
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate token-transfer keys")
+	}
+	defer it.Close()
+
+	var transfers []*cosmosevmtypes.TokenTransfer
+	for ; it.Valid(); it.Next() {
+		var transfer cosmosevmtypes.TokenTransfer
+		if err := json.Unmarshal(it.Value(), &transfer); err != nil {
+			return errorsmod.Wrap(err, "decode token transfer")
+		}
+		transfers = append(transfers, &transfer)
+		for _, address := range tokenTransferAddresses(&transfer) {
+			key := append(append([]byte{KeyPrefixAddressTokenTransfer}, address.Bytes()...), it.Key()[1:]...)
+			if err := batch.Delete(key); err != nil {
+				return errorsmod.Wrap(err, "delete address-token-transfer key")
+			}
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete token-transfer key")
+		}
+	}
+	if err := it.Error(); err != nil {
+		return errorsmod.Wrap(err, "iterate token-transfer keys")
+	}
+
+	balances := newNFTBalances(kv.db)
+	for _, transfer := range slices.Backward(transfers) {
+		if err := balances.apply(transfer, true); err != nil {
+			return err
+		}
+	}
+	return balances.write(batch)
+}
+
+// tokenTransferAddresses returns the addresses a token transfer is indexed by,
+// without duplicates and without the zero address
+func tokenTransferAddresses(transfer *cosmosevmtypes.TokenTransfer) []common.Address {
+	var addresses []common.Address
+	for _, address := range []common.Address{transfer.Token, transfer.From, transfer.To} {
+		if address == (common.Address{}) || slices.Contains(addresses, address) {
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+// parseTokenTransfers decodes the token transfers of a log, returns nil if it's
+// not a standard transfer event.
+//
+// The Transfer events of ERC20 and ERC721 share their topic, they're told apart
+// by the token id of ERC721 being indexed.
+func parseTokenTransfers(log *ethtypes.Log) []*cosmosevmtypes.TokenTransfer {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+
+	switch {
+	case log.Topics[0] == transferTopic && len(log.Topics) == 3 && len(log.Data) == 32:
+		return []*cosmosevmtypes.TokenTransfer{{
+			Standard: cosmosevmtypes.TokenStandardERC20,
+			Token:    log.Address,
+			From:     common.BytesToAddress(log.Topics[1].Bytes()),
+			To:       common.BytesToAddress(log.Topics[2].Bytes()),
+			Value:    (*hexutil.Big)(new(big.Int).SetBytes(log.Data)),
+		}}
+	case log.Topics[0] == transferTopic && len(log.Topics) == 4 && len(log.Data) == 0:
+		return []*cosmosevmtypes.TokenTransfer{{
+			Standard: cosmosevmtypes.TokenStandardERC721,
+			Token:    log.Address,
+			From:     common.BytesToAddress(log.Topics[1].Bytes()),
+			To:       common.BytesToAddress(log.Topics[2].Bytes()),
+			TokenID:  (*hexutil.Big)(log.Topics[3].Big()),
+			Value:    (*hexutil.Big)(big.NewInt(1)),
+		}}
+	case log.Topics[0] == transferSingleTopic && len(log.Topics) == 4 && len(log.Data) == 64:
+		operator := common.BytesToAddress(log.Topics[1].Bytes())
+		return []*cosmosevmtypes.TokenTransfer{{
+			Standard: cosmosevmtypes.TokenStandardERC1155,
+			Token:    log.Address,
+			Operator: &operator,
+			From:     common.BytesToAddress(log.Topics[2].Bytes()),
+			To:       common.BytesToAddress(log.Topics[3].Bytes()),
+			TokenID:  (*hexutil.Big)(new(big.Int).SetBytes(log.Data[:32])),
+			Value:    (*hexutil.Big)(new(big.Int).SetBytes(log.Data[32:])),
+		}}
+	case log.Topics[0] == transferBatchTopic && len(log.Topics) == 4:
+		ids, values, err := unpackTransferBatch(log.Data)
+		if err != nil {
+			return nil
+		}
+		operator := common.BytesToAddress(log.Topics[1].Bytes())
+		transfers := make([]*cosmosevmtypes.TokenTransfer, 0, len(ids))
+		for i := range ids {
+			transfers = append(transfers, &cosmosevmtypes.TokenTransfer{
+				Standard: cosmosevmtypes.TokenStandardERC1155,
+				Token:    log.Address,
+				Operator: &operator,
+				From:     common.BytesToAddress(log.Topics[2].Bytes()),
+				To:       common.BytesToAddress(log.Topics[3].Bytes()),
+				TokenID:  (*hexutil.Big)(ids[i]),
+				Value:    (*hexutil.Big)(values[i]),
+			})
+		}
+		return transfers
+	}
+	return nil
+}
+
+// unpackTransferBatch decodes the ids and the values of a TransferBatch event
+func unpackTransferBatch(data []byte) ([]*big.Int, []*big.Int, error) {
+	args, err := transferBatchArgs.Unpack(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids, ok := args[0].([]*big.Int)
+	if !ok {
+		return nil, nil, errors.New("invalid TransferBatch ids")
+	}
+	values, ok := args[1].([]*big.Int)
+	if !ok || len(values) != len(ids) {
+		return nil, nil, errors.New("invalid TransferBatch values")
+	}
+	return ids, values, nil
+}
+
+// mustNewType returns the abi type of the given name, it panics if the type is
+// invalid
+func mustNewType(name string) abi.Type {
+	t, err := abi.NewType(name, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/holiman/uint256"
 
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -47,15 +48,17 @@ func NewBalanceChangeEntry(acc common.Address, amt *uint256.Int, op Operation) B
 
 // RequiredGas calculates the base minimum required gas for a transaction or a query.
 // It uses the method ID to determine if the input is a transaction or a query and
-// uses the Cosmos SDK gas config flat cost and the flat per byte cost * len(argBz) to calculate the gas.
+// uses the Cosmos SDK gas config flat cost and the flat per byte cost * len(argBz) to calculate the gas,
+// which is translated into EVM gas with the gas ratio of the chain.
 func (p Precompile) RequiredGas(input []byte, isTransaction bool) uint64 {
 	argsBz := input[4:]
 
+	gas := p.KvGasConfig.ReadCostFlat + (p.KvGasConfig.ReadCostPerByte * uint64(len(argsBz)))
 	if isTransaction {
-		return p.KvGasConfig.WriteCostFlat + (p.KvGasConfig.WriteCostPerByte * uint64(len(argsBz)))
+		gas = p.KvGasConfig.WriteCostFlat + (p.KvGasConfig.WriteCostPerByte * uint64(len(argsBz)))
 	}
 
-	return p.KvGasConfig.ReadCostFlat + (p.KvGasConfig.ReadCostPerByte * uint64(len(argsBz)))
+	return evmtypes.GetGasRatio().CosmosToEVMGas(gas)
 }
 
 // RunSetup runs the initial setup required to run a transaction or a query.
@@ -140,16 +143,26 @@ func (p Precompile) RunSetup(
 	defer HandleGasError(ctx, contract, initialGas, &err)()
 
 	// set the default SDK gas configuration to track gas usage
-	// we are changing the gas meter type, so it panics gracefully when out of gas
-	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(contract.Gas)).
+	// we are changing the gas meter type, so it panics gracefully when out of gas.
+	// The precompile can consume the gas left to the contract, translated into
+	// Cosmos gas, on top of the gas already consumed by the context.
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(initialGas + evmtypes.GetGasRatio().EVMToCosmosGas(contract.Gas))).
 		WithKVGasConfig(p.KvGasConfig).
 		WithTransientKVGasConfig(p.TransientKVGasConfig)
-	// we need to consume the gas that was already used by the EVM
+	// we need to consume the gas that was already used by the context
 	ctx.GasMeter().ConsumeGas(initialGas, "creating a new gas meter")
 
 	return ctx, stateDB, method, initialGas, args, nil
 }
 
+// UseGas charges the contract the Cosmos gas consumed by the precompile since
+// initialGas, translated into EVM gas with the gas ratio of the chain. It
+// returns false if the contract has not enough gas left.
+func UseGas(ctx sdk.Context, contract *vm.Contract, initialGas storetypes.Gas) bool {
+	cost := evmtypes.GetGasRatio().CosmosToEVMGas(ctx.GasMeter().GasConsumed() - initialGas)
+	return contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract)
+}
+
 // HandleGasError handles the out of gas panic by resetting the gas meter and returning an error.
 // This is used in order to avoid panics and to allow for the EVM to continue cleanup if the tx or query run out of gas.
 func HandleGasError(ctx sdk.Context, contract *vm.Contract, initialGas storetypes.Gas, err *error) func() {
@@ -157,9 +170,11 @@ func HandleGasError(ctx sdk.Context, contract *vm.Contract, initialGas storetype
 		if r := recover(); r != nil {
 			switch r.(type) {
 			case storetypes.ErrorOutOfGas:
-				// update contract gas
-				usedGas := ctx.GasMeter().GasConsumed() - initialGas
-				_ = contract.UseGas(usedGas, nil, tracing.GasChangeCallFailedExecution)
+				// update contract gas, all its gas is used if the translated gas exceeds it
+				usedGas := evmtypes.GetGasRatio().CosmosToEVMGas(ctx.GasMeter().GasConsumed() - initialGas)
+				if !contract.UseGas(usedGas, nil, tracing.GasChangeCallFailedExecution) {
+					_ = contract.UseGas(contract.Gas, nil, tracing.GasChangeCallFailedExecution)
+				}
 
 				*err = vm.ErrOutOfGas
 				// FIXME: add InfiniteGasMeter with previous Gas limit.
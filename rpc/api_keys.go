@@ -0,0 +1,167 @@
+package rpc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"golang.org/x/time/rate"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+// APIKeyHeader is the header of the API key of the JSON-RPC requests. The API
+// key can also be the path of the request URL.
+const APIKeyHeader = "X-API-Key"
+
+// apiKeysHandler applies the rate and method policy of the tier of the API key
+// of the JSON-RPC requests over HTTP, and accounts the calls of each API key.
+type apiKeysHandler struct {
+	next http.Handler
+	// keys are the API keys by key
+	keys map[string]*apiKey
+	// anonymous is the API key of the requests without one, nil if they're
+	// rejected
+	anonymous *apiKey
+}
+
+// apiKey is the policy and the usage of an API key.
+type apiKey struct {
+	tier config.APITier
+	// limiter limits the rate of the calls, nil if unlimited
+	limiter *rate.Limiter
+
+	callsCounter       *metrics.Counter
+	deniedCounter      *metrics.Counter
+	rateLimitedCounter *metrics.Counter
+}
+
+// NewAPIKeysHandler wraps the JSON-RPC handler with the policies of the tiers
+// of the API keys. The calls of the requests without an API key use the
+// config.DefaultAPITier tier, and are rejected if there's no such tier. The
+// handler is returned as is if there are no tiers.
+//
+// The usage of each API key is exported with the rpc/apikeys/<tier>/<id>
+// metrics, where the id is derived from the hash of the key so that the keys
+// aren't exposed, and is "anonymous" for the requests without an API key.
+func NewAPIKeysHandler(next http.Handler, tiers, keys map[string]config.APITier) http.Handler {
+	if len(tiers) == 0 {
+		return next
+	}
+
+	h := &apiKeysHandler{
+		next: next,
+		keys: make(map[string]*apiKey, len(keys)),
+	}
+	for key, tier := range keys {
+		hash := sha256.Sum256([]byte(key))
+		h.keys[key] = newAPIKey(tier, hex.EncodeToString(hash[:4]))
+	}
+	if tier, ok := tiers[config.DefaultAPITier]; ok {
+		h.anonymous = newAPIKey(tier, "anonymous")
+	}
+	return h
+}
+
+func newAPIKey(tier config.APITier, id string) *apiKey {
+	prefix := fmt.Sprintf("rpc/apikeys/%s/%s/", tier.Name, id)
+	key := &apiKey{
+		tier:               tier,
+		callsCounter:       metrics.GetOrRegisterCounter(prefix+"calls", nil),
+		deniedCounter:      metrics.GetOrRegisterCounter(prefix+"denied", nil),
+		rateLimitedCounter: metrics.GetOrRegisterCounter(prefix+"ratelimited", nil),
+	}
+	// a second of calls can be made at once
+	if tier.RequestsPerSecond > 0 {
+		key.limiter = rate.NewLimiter(rate.Limit(tier.RequestsPerSecond), int(math.Ceil(tier.RequestsPerSecond)))
+	}
+	return key
+}
+
+func (h *apiKeysHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := h.anonymous
+	if value := requestAPIKey(r); value != "" {
+		key = h.keys[value]
+		if key == nil {
+			writeHTTPError(w, http.StatusUnauthorized, errInvalidRequest, "invalid API key")
+			return
+		}
+	}
+	if key == nil {
+		writeHTTPError(w, http.StatusUnauthorized, errInvalidRequest, "missing API key")
+		return
+	}
+
+	var data []byte
+	if r.Body != nil {
+		var err error
+		if data, err = io.ReadAll(r.Body); err != nil {
+			writeHTTPError(w, http.StatusBadRequest, errInvalidRequest, "failed to read request body")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	// the malformed requests count as a call, and are answered by the server
+	methods := requestMethods(data)
+	for _, method := range methods {
+		if !key.tier.Allows(method) {
+			key.deniedCounter.Inc(1)
+			writeHTTPError(w, http.StatusForbidden, errMethodNotFound, fmt.Sprintf("the method %s is not available in the %s tier", method, key.tier.Name))
+			return
+		}
+	}
+
+	calls := max(len(methods), 1)
+	if key.limiter != nil && !key.limiter.AllowN(time.Now(), calls) {
+		key.rateLimitedCounter.Inc(1)
+		writeHTTPError(w, http.StatusTooManyRequests, errLimitExceeded, fmt.Sprintf("rate limit of the %s tier exceeded", key.tier.Name))
+		return
+	}
+	key.callsCounter.Inc(int64(calls))
+
+	h.next.ServeHTTP(w, r)
+}
+
+// requestAPIKey returns the API key of the request, from its header or else
+// from the path of its URL.
+func requestAPIKey(r *http.Request) string {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return key
+	}
+	return strings.Trim(r.URL.Path, "/")
+}
+
+// requestMethods returns the methods of the calls of the JSON-RPC request, or
+// of the batch, or nil if it's malformed.
+func requestMethods(data []byte) []string {
+	type call struct {
+		Method string `json:"method"`
+	}
+
+	if isBatch(data) {
+		var calls []call
+		if err := json.Unmarshal(data, &calls); err != nil {
+			return nil
+		}
+		methods := make([]string, len(calls))
+		for i, call := range calls {
+			methods[i] = call.Method
+		}
+		return methods
+	}
+
+	var c call
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil
+	}
+	return []string{c.Method}
+}
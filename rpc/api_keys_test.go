@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+func TestAPIKeysHandler(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.APITiers = []string{"default:1:test_echo", "free:2:test_*", "pro:0:*"}
+	cfg.APIKeys = []string{"freekey=free", "prokey=pro"}
+	tiers, keys, err := cfg.ParseAPIKeys()
+	require.NoError(t, err)
+
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("test", echoService{}))
+	defer rpcServer.Stop()
+	srv := httptest.NewServer(NewAPIKeysHandler(rpcServer, tiers, keys))
+	defer srv.Close()
+
+	call := func(path, key, body string) (int, string) {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set(APIKeyHeader, key)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		var resp struct {
+			Error *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if res.StatusCode != http.StatusOK {
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+			return res.StatusCode, resp.Error.Message
+		}
+		return res.StatusCode, ""
+	}
+	echo := `{"jsonrpc":"2.0","id":1,"method":"test_echo","params":[1]}`
+	modules := `{"jsonrpc":"2.0","id":1,"method":"rpc_modules","params":[]}`
+
+	// the requests without an API key use the default tier
+	status, _ := call("/", "", echo)
+	require.Equal(t, http.StatusOK, status)
+	status, msg := call("/", "", echo)
+	require.Equal(t, http.StatusTooManyRequests, status)
+	require.Contains(t, msg, "rate limit of the default tier exceeded")
+
+	status, msg = call("/", "unknown", echo)
+	require.Equal(t, http.StatusUnauthorized, status)
+	require.Equal(t, "invalid API key", msg)
+
+	// the API key can be the path of the URL
+	status, _ = call("/freekey", "", echo)
+	require.Equal(t, http.StatusOK, status)
+	status, msg = call("/", "freekey", modules)
+	require.Equal(t, http.StatusForbidden, status)
+	require.Contains(t, msg, "the method rpc_modules is not available in the free tier")
+
+	// a batch counts as its number of calls
+	status, _ = call("/", "freekey", "["+echo+","+echo+"]")
+	require.Equal(t, http.StatusTooManyRequests, status)
+
+	for range 10 {
+		status, _ = call("/", "prokey", "["+echo+","+modules+"]")
+		require.Equal(t, http.StatusOK, status)
+	}
+
+	// the requests without an API key are rejected without a default tier
+	delete(tiers, config.DefaultAPITier)
+	handler := NewAPIKeysHandler(rpcServer, tiers, keys)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(echo)))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Contains(t, rec.Body.String(), "missing API key")
+}
@@ -8,12 +8,17 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/admin"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/engine"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/evm"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/explorer"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/net"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/personal"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/trace"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/txpool"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/web3"
 	"github.com/cosmos/evm/types"
@@ -37,6 +42,11 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	EVMNamespace      = "evm"
+	EngineNamespace   = "engine"
+	ExplorerNamespace = "explorer"
+	AdminNamespace    = "admin"
 
 	apiVersion = "1.0"
 )
@@ -155,6 +165,86 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		EVMNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EVMNamespace,
+					Version:   apiVersion,
+					Service:   evm.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		ExplorerNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: ExplorerNamespace,
+					Version:   apiVersion,
+					Service:   explorer.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		AdminNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewAPI(ctx.Logger, evmBackend),
+					Public:    false,
+				},
+			}
+		},
+		EngineNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EngineNamespace,
+					Version:   apiVersion,
+					Service:   engine.NewAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -33,7 +33,7 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 		Address: address.String(),
 	}
 
-	res, err := b.QueryClient.Code(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	res, err := b.QueryClient.Code(b.QueryContexts.Context(blockNum.Int64()), req)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +55,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		// the error message imitates geth behavior
 		return nil, errors.New("header not found")
 	}
-	ctx := rpctypes.ContextWithHeight(height)
+	ctx := b.QueryContexts.Context(height)
 
 	// if the height is equal to zero, meaning the query condition of the block is either "pending" or "latest"
 	if height == 0 {
@@ -135,7 +135,7 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 		Key:     key,
 	}
 
-	res, err := b.QueryClient.Storage(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	res, err := b.QueryClient.Storage(b.QueryContexts.Context(blockNum.Int64()), req)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +144,52 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 	return value.Bytes(), nil
 }
 
+// GetStorageSlots returns the values of the given storage slots, in the order
+// they are requested. The slots are grouped by block, so the storage of each
+// block is read in a single query.
+func (b *Backend) GetStorageSlots(slots []rpctypes.StorageSlotArgs) ([]hexutil.Bytes, error) {
+	var heights []rpctypes.BlockNumber
+	reqs := make(map[rpctypes.BlockNumber]*evmtypes.QueryStorageSlotsRequest)
+	indexes := make(map[rpctypes.BlockNumber][]int)
+	for i, slot := range slots {
+		blockNum := rpctypes.EthLatestBlockNumber
+		if slot.BlockTag.BlockNumber != nil || slot.BlockTag.BlockHash != nil {
+			var err error
+			blockNum, err = b.BlockNumberFromTendermint(slot.BlockTag)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		req, ok := reqs[blockNum]
+		if !ok {
+			req = &evmtypes.QueryStorageSlotsRequest{}
+			reqs[blockNum] = req
+			heights = append(heights, blockNum)
+		}
+		req.Slots = append(req.Slots, &evmtypes.QueryStorageRequest{
+			Address: slot.Address.String(),
+			Key:     slot.Slot,
+		})
+		indexes[blockNum] = append(indexes[blockNum], i)
+	}
+
+	values := make([]hexutil.Bytes, len(slots))
+	for _, blockNum := range heights {
+		res, err := b.QueryClient.StorageSlots(b.QueryContexts.Context(blockNum.Int64()), reqs[blockNum])
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Values) != len(indexes[blockNum]) {
+			return nil, fmt.Errorf("expected %d storage values at block %d, got %d", len(indexes[blockNum]), blockNum, len(res.Values))
+		}
+		for j, i := range indexes[blockNum] {
+			values[i] = common.HexToHash(res.Values[j]).Bytes()
+		}
+	}
+	return values, nil
+}
+
 // GetBalance returns the provided account's balance up to the provided block number.
 func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -160,7 +206,7 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
-	res, err := b.QueryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	res, err := b.QueryClient.Balance(b.QueryContexts.Context(blockNum.Int64()), req)
 	if err != nil {
 		return nil, err
 	}
@@ -195,18 +241,26 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 			currentHeight, height,
 		)
 	}
-	// Get nonce (sequence) from account
-	from := sdk.AccAddress(address.Bytes())
-	accRet := b.ClientCtx.AccountRetriever
+	// the latest and pending nonces are the ones of the current height, so that
+	// they're cached by height
+	if blockNum < 0 {
+		height = currentHeight
+	}
 
-	err = accRet.EnsureExists(b.ClientCtx, from)
-	if err != nil {
-		// account doesn't exist yet, return 0
-		return &n, nil
+	// Get nonce (sequence) from account, which exists if its nonce is cached
+	if !b.Nonces.Contains(address, height) {
+		from := sdk.AccAddress(address.Bytes())
+		accRet := b.ClientCtx.AccountRetriever
+
+		err = accRet.EnsureExists(b.ClientCtx, from)
+		if err != nil {
+			// account doesn't exist yet, return 0
+			return &n, nil
+		}
 	}
 
 	includePending := blockNum == rpctypes.EthPendingBlockNumber
-	nonce, err := b.getAccountNonce(address, includePending, blockNum.Int64(), b.Logger)
+	nonce, err := b.getAccountNonce(address, includePending, height, b.Logger)
 	if err != nil {
 		return nil, err
 	}
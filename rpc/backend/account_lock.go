@@ -0,0 +1,85 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// defaultUnlockDuration is the duration the accounts are unlocked for if none is given.
+const defaultUnlockDuration = 300 * time.Second
+
+// unlockedAccounts tracks the keyring accounts unlocked by personal_unlockAccount,
+// with the time their unlock expires at. A zero expiry never expires.
+type unlockedAccounts struct {
+	mu       sync.Mutex
+	accounts map[common.Address]time.Time
+}
+
+// unlocked is shared by the backends of all the namespaces, which use the same keyring.
+var unlocked = &unlockedAccounts{accounts: make(map[common.Address]time.Time)}
+
+// UnlockAccount unlocks the keyring account of the given address for the given
+// number of seconds, 300 if nil, or until it's locked if zero.
+func (b *Backend) UnlockAccount(address common.Address, duration *uint64) (bool, error) {
+	if !b.Cfg.JSONRPC.AllowInsecureUnlock {
+		b.Logger.Debug("account unlock with HTTP access is forbidden")
+		return false, errors.New("account unlock with HTTP access is forbidden")
+	}
+
+	if _, err := b.ClientCtx.Keyring.KeyByAddress(sdk.AccAddress(address.Bytes())); err != nil {
+		b.Logger.Debug("failed to find key in keyring", "address", address.String())
+		return false, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+	}
+
+	var expiry time.Time
+	switch {
+	case duration == nil:
+		expiry = time.Now().Add(defaultUnlockDuration)
+	case *duration > 0:
+		expiry = time.Now().Add(time.Duration(*duration) * time.Second) //nolint:gosec // G115 // unlock durations don't overflow
+	}
+
+	unlocked.mu.Lock()
+	defer unlocked.mu.Unlock()
+	unlocked.accounts[address] = expiry
+	return true, nil
+}
+
+// LockAccount locks the keyring account of the given address, and returns
+// whether it was unlocked.
+func (b *Backend) LockAccount(address common.Address) bool {
+	unlocked.mu.Lock()
+	defer unlocked.mu.Unlock()
+
+	expiry, found := unlocked.accounts[address]
+	delete(unlocked.accounts, address)
+	return found && (expiry.IsZero() || time.Now().Before(expiry))
+}
+
+// checkUnlocked returns an error if the keyring accounts must be unlocked to
+// sign with them and the account of the given address isn't unlocked.
+func (b *Backend) checkUnlocked(address common.Address) error {
+	if !b.Cfg.JSONRPC.RequireUnlock {
+		return nil
+	}
+
+	unlocked.mu.Lock()
+	defer unlocked.mu.Unlock()
+
+	expiry, found := unlocked.accounts[address]
+	if found && !expiry.IsZero() && !time.Now().Before(expiry) {
+		delete(unlocked.accounts, address)
+		found = false
+	}
+	if !found {
+		return fmt.Errorf("authentication needed: account %s is locked", address)
+	}
+	return nil
+}
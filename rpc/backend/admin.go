@@ -0,0 +1,64 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// The names of the caches resized by admin_setCacheSize
+const (
+	NonceCacheName        = "nonces"
+	SenderCacheName       = "senders"
+	QueryContextCacheName = "query-contexts"
+)
+
+var (
+	// tracingDisabled disables the tracing queries of the backends of all the
+	// namespaces
+	tracingDisabled atomic.Bool
+
+	errTracingDisabled = errors.New("tracing is disabled on this node")
+)
+
+// TracingEnabled returns true if the debug_trace* and trace_* queries, which
+// re-execute the txs of the node, are served.
+func (b *Backend) TracingEnabled() bool {
+	return !tracingDisabled.Load()
+}
+
+// SetTracingEnabled enables or disables the tracing queries of the node, e.g.
+// to shed their load without a restart, and returns the previous setting.
+func (b *Backend) SetTracingEnabled(enabled bool) bool {
+	return !tracingDisabled.Swap(!enabled)
+}
+
+// CacheSizes returns the sizes of the caches of the backend by name.
+func (b *Backend) CacheSizes() map[string]int {
+	return map[string]int{
+		NonceCacheName:        b.Nonces.Size(),
+		SenderCacheName:       rpctypes.DefaultSenderCache.Size(),
+		QueryContextCacheName: b.QueryContexts.Size(),
+	}
+}
+
+// SetCacheSize resizes the cache of the given name, dropping its entries, and
+// returns its previous size. The caches are shared by the backends of all the
+// namespaces.
+func (b *Backend) SetCacheSize(name string, size int) (int, error) {
+	if size <= 0 {
+		return 0, fmt.Errorf("invalid cache size %d, expected a positive size", size)
+	}
+	switch name {
+	case NonceCacheName:
+		return b.Nonces.Resize(size), nil
+	case SenderCacheName:
+		return rpctypes.DefaultSenderCache.Resize(size), nil
+	case QueryContextCacheName:
+		return b.QueryContexts.Resize(size), nil
+	default:
+		return 0, fmt.Errorf("unknown cache %q, expected one of %s, %s or %s", name, NonceCacheName, SenderCacheName, QueryContextCacheName)
+	}
+}
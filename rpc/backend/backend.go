@@ -2,8 +2,10 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -14,9 +16,12 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
+	"github.com/cosmos/evm/rpc/contractmetadata"
+	"github.com/cosmos/evm/rpc/eventsig"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	cosmosevmtypes "github.com/cosmos/evm/types"
@@ -38,8 +43,26 @@ type BackendI interface { //nolint: revive
 // EVMBackend implements the functionality shared within ethereum namespaces
 // as defined by EIP-1474: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1474.md
 // Implemented by Backend.
+//
+// It's composed of the smaller interfaces of each group of methods, so that a
+// namespace handler can depend on the ones it uses only, and be unit tested
+// with their mocks.
 type EVMBackend interface {
-	// Node specific queries
+	NodeBackend
+	SignBackend
+	UserOperationBackend
+	BlocksBackend
+	AccountBackend
+	ChainBackend
+	TxBackend
+	FilterBackend
+	TraceBackend
+	ExplorerBackend
+	AdminBackend
+}
+
+// NodeBackend implements the node specific queries.
+type NodeBackend interface {
 	Accounts() ([]common.Address, error)
 	Syncing() (interface{}, error)
 	SetEtherbase(etherbase common.Address) bool
@@ -52,13 +75,27 @@ type EVMBackend interface {
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
+}
 
-	// Sign Tx
+// SignBackend implements the signing of the txs and data with the keyring.
+type SignBackend interface {
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
+	SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
+	UnlockAccount(address common.Address, duration *uint64) (bool, error)
+	LockAccount(address common.Address) bool
+}
 
-	// Blocks Info
+// UserOperationBackend implements the bundling of the ERC-4337 user operations.
+type UserOperationBackend interface {
+	SendUserOperation(op rpctypes.UserOperation, entryPoint common.Address) (common.Hash, error)
+	EstimateUserOperationGas(op rpctypes.UserOperation, entryPoint common.Address) (*rpctypes.UserOperationGasEstimate, error)
+	SupportedEntryPoints() ([]common.Address, error)
+}
+
+// BlocksBackend implements the queries of the blocks.
+type BlocksBackend interface {
 	BlockNumber() (hexutil.Uint64, error)
 	GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
 	GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error)
@@ -66,6 +103,7 @@ type EVMBackend interface {
 	GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) *hexutil.Uint
 	TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error)
 	TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error)
+	TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error)
 	BlockNumberFromTendermint(blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error)
 	BlockNumberFromTendermintByHash(blockHash common.Hash) (*big.Int, error)
 	EthMsgsFromTendermintBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
@@ -76,26 +114,38 @@ type EVMBackend interface {
 	EthBlockByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Block, error)
 	EthBlockFromTendermintBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) (*ethtypes.Block, error)
 	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+	GetInternalTransactions(blockNum rpctypes.BlockNumber) ([]*rpctypes.InternalTransaction, error)
+}
 
-	// Account Info
+// AccountBackend implements the queries of the accounts.
+type AccountBackend interface {
 	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
+	GetStorageSlots(slots []rpctypes.StorageSlotArgs) ([]hexutil.Bytes, error)
+	GetContractMetadata(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.ContractMetadata, error)
+	SubmitContractMetadata(args rpctypes.ContractMetadataArgs) (*rpctypes.ContractMetadata, error)
+	GetNFTsByOwner(args rpctypes.NFTsByOwnerArgs) (*rpctypes.NFTsByOwnerResult, error)
 	GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
+}
 
-	// Chain Info
+// ChainBackend implements the queries of the chain and of its fees.
+type ChainBackend interface {
 	ChainID() (*hexutil.Big, error)
 	ChainConfig() *params.ChainConfig
 	GlobalMinGasPrice() (*big.Int, error)
 	BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error)
 	CurrentHeader() (*ethtypes.Header, error)
 	PendingTransactions() ([]*sdk.Tx, error)
+	PoolStats() (*rpctypes.PoolStats, error)
 	GetCoinbase() (sdk.AccAddress, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
+}
 
-	// Tx Info
+// TxBackend implements the queries of the txs, and their sending and execution.
+type TxBackend interface {
 	GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error)
 	GetTxByEthHash(txHash common.Hash) (*cosmosevmtypes.TxResult, error)
 	GetTxByTxIndex(height int64, txIndex uint) (*cosmosevmtypes.TxResult, error)
@@ -104,6 +154,11 @@ type EVMBackend interface {
 	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
+	GetCosmosTxByEthHash(txHash common.Hash) (*rpctypes.CosmosTxResult, error)
+	GetEthTxsByCosmosHash(hash cmtbytes.HexBytes) ([]*rpctypes.RPCTransaction, error)
+	GetTransactionProof(txHash common.Hash) (*rpctypes.TransactionProof, error)
+	GetAddressTransactions(args rpctypes.AddressTxsArgs) (*rpctypes.AddressTxsResult, error)
+	GetTokenTransfers(args rpctypes.TokenTransfersArgs) ([]*cosmosevmtypes.TokenTransfer, error)
 
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
@@ -112,15 +167,55 @@ type EVMBackend interface {
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
 	GasPrice() (*hexutil.Big, error)
+}
 
-	// Filter API
+// FilterBackend implements the queries of the logs and of the blocks used by
+// the filters.
+type FilterBackend interface {
+	GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
+	HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Header, error)
+	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
+	TendermintBlockByHash(hash common.Hash) (*tmrpctypes.ResultBlock, error)
+	TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error)
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error)
 	BloomStatus() (uint64, uint64)
+	DecodeLogs(logs []*ethtypes.Log) []*rpctypes.Log
+
+	RPCFilterCap() int32
+	RPCLogsCap() int32
+	RPCBlockRangeCap() int32
+}
 
-	// Tracing
+// TraceBackend implements the tracing of the txs and the debug queries of the
+// state.
+type TraceBackend interface {
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *evmtypes.TraceConfig) (interface{}, error)
+	GetTxWitness(hash common.Hash) (*cosmosevmtypes.TxWitness, error)
+	GetModifiedAccountsByNumber(startNum uint64, endNum *uint64) ([]common.Address, error)
+	GetModifiedAccountsByHash(startHash common.Hash, endHash *common.Hash) ([]common.Address, error)
+	StorageRangeAt(blockHash common.Hash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (rpctypes.StorageRangeResult, error)
+	TraceFilter(args rpctypes.TraceFilterArgs) ([]json.RawMessage, error)
+}
+
+// ExplorerBackend implements the queries of the explorer namespace, served from
+// the secondary indexes of the custom tx indexer.
+type ExplorerBackend interface {
+	GetTopAccounts(count int) ([]rpctypes.AccountBalance, error)
+	GetTransactionsByAddress(address common.Address, cursor hexutil.Bytes, limit int) (*rpctypes.AddressTransactions, error)
+	GetContractCreation(address common.Address) (*rpctypes.ContractCreation, error)
+}
+
+// AdminBackend implements the runtime tunables of the backend adjusted by the
+// admin namespace.
+type AdminBackend interface {
+	TracingEnabled() bool
+	SetTracingEnabled(enabled bool) bool
+	CacheSizes() map[string]int
+	SetCacheSize(name string, size int) (int, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -152,12 +247,89 @@ type Backend struct {
 	ClientCtx           client.Context
 	RPCClient           tmrpcclient.SignClient
 	QueryClient         *rpctypes.QueryClient // gRPC query client
+	QueryContexts       *rpctypes.QueryContextPool
+	Nonces              *rpctypes.NonceCache
 	Logger              log.Logger
 	EvmChainID          *big.Int
 	Cfg                 config.Config
 	AllowUnprotectedTxs bool
 	Indexer             cosmosevmtypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
+	// ValidatorCoinbases maps the validator consensus address bytes to their EVM coinbase
+	ValidatorCoinbases map[string]common.Address
+	// ContractMetadata stores the contract metadata submitted to the node, nil if disabled
+	ContractMetadata *contractmetadata.Store
+	// EventSignatures is the registry of the event signatures decoding the logs
+	EventSignatures *eventsig.Registry
+}
+
+var (
+	queryContextsOnce sync.Once
+	queryContexts     *rpctypes.QueryContextPool
+)
+
+// sharedQueryContexts returns the query context pool shared by the backends of
+// all the namespaces, so that the max concurrent queries bound the queries of
+// the node rather than the ones of each namespace.
+func sharedQueryContexts(maxConcurrent int) *rpctypes.QueryContextPool {
+	queryContextsOnce.Do(func() {
+		queryContexts = rpctypes.NewQueryContextPool(rpctypes.QueryContextCacheSize, maxConcurrent)
+	})
+	return queryContexts
+}
+
+var (
+	nonceCacheOnce sync.Once
+	nonceCache     *rpctypes.NonceCache
+)
+
+// sharedNonceCache returns the nonce cache shared by the backends of all the
+// namespaces, so that the txs sent through any of them are pending for all.
+func sharedNonceCache() *rpctypes.NonceCache {
+	nonceCacheOnce.Do(func() {
+		nonceCache = rpctypes.NewNonceCache(rpctypes.NonceCacheSize)
+	})
+	return nonceCache
+}
+
+var (
+	contractMetadataOnce sync.Once
+	contractMetadata     *contractmetadata.Store
+)
+
+// sharedContractMetadata returns the store of the contract metadata shared by
+// the backends of all the namespaces, as its database can only be opened once,
+// or nil if the contract metadata is disabled.
+func sharedContractMetadata(ctx *server.Context, enabled bool) *contractmetadata.Store {
+	if !enabled {
+		return nil
+	}
+	contractMetadataOnce.Do(func() {
+		db, err := contractmetadata.OpenDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			panic(err)
+		}
+		contractMetadata = contractmetadata.NewStore(db)
+	})
+	return contractMetadata
+}
+
+var (
+	eventSignaturesOnce sync.Once
+	eventSignatures     *eventsig.Registry
+)
+
+// sharedEventSignatures returns the registry of the event signatures shared by
+// the backends of all the namespaces, as the signatures file is loaded once.
+func sharedEventSignatures(file string) *eventsig.Registry {
+	eventSignaturesOnce.Do(func() {
+		var err error
+		eventSignatures, err = eventsig.NewRegistry(file)
+		if err != nil {
+			panic(err)
+		}
+	})
+	return eventSignatures
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -173,21 +345,32 @@ func NewBackend(
 		panic(err)
 	}
 
+	validatorCoinbases, err := appConf.JSONRPC.ParseValidatorCoinbases()
+	if err != nil {
+		panic(err)
+	}
+
 	rpcClient, ok := clientCtx.Client.(tmrpcclient.SignClient)
 	if !ok {
 		panic(fmt.Sprintf("invalid rpc client, expected: tmrpcclient.SignClient, got: %T", clientCtx.Client))
 	}
 
+	queryContexts := sharedQueryContexts(appConf.JSONRPC.MaxConcurrentQueries)
 	b := &Backend{
 		Ctx:                 context.Background(),
 		ClientCtx:           clientCtx,
 		RPCClient:           rpcClient,
-		QueryClient:         rpctypes.NewQueryClient(clientCtx),
+		QueryClient:         rpctypes.NewQueryClient(queryContexts.Conn(clientCtx)),
+		QueryContexts:       queryContexts,
+		Nonces:              sharedNonceCache(),
 		Logger:              logger.With("module", "backend"),
 		EvmChainID:          big.NewInt(int64(appConf.EVM.EVMChainID)), //nolint:gosec // G115 // won't exceed uint64
 		Cfg:                 appConf,
 		AllowUnprotectedTxs: allowUnprotectedTxs,
 		Indexer:             indexer,
+		ValidatorCoinbases:  validatorCoinbases,
+		ContractMetadata:    sharedContractMetadata(ctx, appConf.JSONRPC.EnableContractMetadata),
+		EventSignatures:     sharedEventSignatures(appConf.JSONRPC.EventSignaturesFile),
 	}
 	b.ProcessBlocker = b.ProcessBlock
 	return b
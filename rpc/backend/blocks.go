@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
@@ -258,6 +259,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 		// Check if tx exists on EVM by cross checking with blockResults:
 		//  - Include unsuccessful tx that exceeds block gas limit
 		//  - Include unsuccessful tx that failed when committing changes to stateDB
+		//  - Include unsuccessful tx batching eth txs, one of which failed
 		//  - Exclude unsuccessful tx with any other error but ExceedBlockGasLimit
 		if !rpctypes.TxSucessOrExpectedFailure(txResults[i]) {
 			b.Logger.Debug("invalid tx result code", "cosmos-hash", hexutil.Encode(tx.Hash()))
@@ -410,32 +412,20 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		b.Logger.Debug("failed to query BlockBloom", "height", block.Height, "error", err.Error())
 	}
 
-	req := &evmtypes.QueryValidatorAccountRequest{
-		ConsAddress: sdk.ConsAddress(block.Header.ProposerAddress).String(),
-	}
-
-	var validatorAccAddr sdk.AccAddress
-
-	ctx := rpctypes.ContextWithHeight(block.Height)
-	res, err := b.QueryClient.ValidatorAccount(ctx, req)
+	ctx := b.QueryContexts.Context(block.Height)
+	consAddr := sdk.ConsAddress(block.Header.ProposerAddress)
+	validatorAddr, err := b.ValidatorCoinbase(ctx, consAddr)
 	if err != nil {
 		b.Logger.Debug(
 			"failed to query validator operator address",
 			"height", block.Height,
-			"cons-address", req.ConsAddress,
+			"cons-address", consAddr.String(),
 			"error", err.Error(),
 		)
 		// use zero address as the validator operator address
-		validatorAccAddr = sdk.AccAddress(common.Address{}.Bytes())
-	} else {
-		validatorAccAddr, err = sdk.AccAddressFromBech32(res.AccountAddress)
-		if err != nil {
-			return nil, err
-		}
+		validatorAddr = common.Address{}
 	}
 
-	validatorAddr := common.BytesToAddress(validatorAccAddr)
-
 	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(ctx, b.ClientCtx, block.Height)
 	if err != nil {
 		b.Logger.Error("failed to query consensus params", "error", err.Error())
@@ -556,13 +546,61 @@ func (b *Backend) GetBlockReceipts(
 	return result, nil
 }
 
+// GetInternalTransactions returns the evm calls committed by the cosmos msgs of
+// the given block, with their logs.
+func (b *Backend) GetInternalTransactions(blockNum rpctypes.BlockNumber) ([]*rpctypes.InternalTransaction, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block by number: %w", err)
+	}
+
+	if resBlock == nil {
+		return nil, fmt.Errorf("block not found for height %d", *blockNum.TmHeight())
+	}
+
+	blockRes, err := b.RPCClient.BlockResults(b.Ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	internalTxs := []*rpctypes.InternalTransaction{}
+	var logIndex uint
+	for txIndex, txResult := range blockRes.TxsResults {
+		if txResult.Code != abci.CodeTypeOK || txIndex >= len(resBlock.Block.Txs) {
+			continue
+		}
+		for _, event := range txResult.Events {
+			if event.Type != evmtypes.EventTypeInternalTx {
+				continue
+			}
+			internalTx, err := ParseInternalTxFromEvent(event)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse internal tx of tx %d: %w", txIndex, err)
+			}
+			internalTx.CosmosTxHash = resBlock.Block.Txs[txIndex].Hash()
+			internalTx.BlockHash = blockHash
+			internalTx.BlockNumber = hexutil.Uint64(resBlock.Block.Height) //nolint:gosec // G115 // block height won't exceed uint64
+			internalTx.TxIndex = hexutil.Uint64(txIndex)                   //nolint:gosec // G115 // tx index won't exceed uint64
+			for _, log := range internalTx.Logs {
+				log.BlockHash = blockHash
+				log.BlockNumber = uint64(resBlock.Block.Height) //nolint:gosec // G115 // block height won't exceed uint64
+				log.Index = logIndex
+				logIndex++
+			}
+			internalTxs = append(internalTxs, internalTx)
+		}
+	}
+	return internalTxs, nil
+}
+
 func (b *Backend) formatTxReceipt(ethMsg *evmtypes.MsgEthereumTx, blockMsgs []*evmtypes.MsgEthereumTx, blockRes *tmrpctypes.ResultBlockResults, blockHeaderHash string) (map[string]interface{}, error) {
 	txResult, err := b.GetTxByEthHash(common.HexToHash(ethMsg.Hash))
 	if err != nil {
 		return nil, fmt.Errorf("tx not found: hash=%s, error=%s", ethMsg.Hash, err.Error())
 	}
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	txData, err := ethMsg.GetTxData()
 	if err != nil {
 		return nil, fmt.Errorf("failed to unpack tx data: %w", err)
 	}
@@ -582,19 +620,14 @@ func (b *Backend) formatTxReceipt(ethMsg *evmtypes.MsgEthereumTx, blockMsgs []*e
 		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
 	}
 
-	chainID, err := b.ChainID()
-	if err != nil {
-		return nil, err
-	}
-
-	from, err := ethMsg.GetSenderLegacy(ethtypes.LatestSignerForChainID(chainID.ToInt()))
+	from, err := rpctypes.DefaultSenderCache.GetSender(ethMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	// parse tx logs from events
+	// parse tx logs from the tx result
 	msgIndex := int(txResult.MsgIndex) // #nosec G115 -- checked for int overflow already
-	logs, err := TxLogsFromEvents(blockRes.TxsResults[txResult.TxIndex].Events, msgIndex)
+	logs, err := rpctypes.TxLogsFromResult(blockRes.TxsResults[txResult.TxIndex], msgIndex)
 	if err != nil {
 		b.Logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
 	}
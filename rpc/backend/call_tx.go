@@ -38,12 +38,7 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 	// The signer used should always be the 'latest' known one because we expect
 	// signers to be backwards-compatible with old transactions.
-	cfg := b.ChainConfig()
-	if cfg == nil {
-		cfg = evmtypes.DefaultChainConfig(b.EvmChainID.Uint64()).EthereumConfig(nil)
-	}
-
-	signer := ethtypes.LatestSigner(cfg)
+	signer := evmtypes.LatestSigner()
 
 	matchTx := args.ToTransaction().AsTransaction()
 
@@ -118,7 +113,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	}
 
 	ethereumTx := &evmtypes.MsgEthereumTx{}
-	if err := ethereumTx.FromSignedEthereumTx(tx, ethtypes.LatestSignerForChainID(b.EvmChainID)); err != nil {
+	if err := ethereumTx.FromSignedEthereumTx(tx, evmtypes.LatestSigner()); err != nil {
 		b.Logger.Error("transaction converting failed", "error", err.Error())
 		return common.Hash{}, err
 	}
@@ -152,8 +147,9 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	}
 	if err != nil {
 		b.Logger.Error("failed to broadcast tx", "error", err.Error())
-		return txHash, err
+		return txHash, rpctypes.NewTxError(err, ethereumTx.GetSender())
 	}
+	b.Nonces.AddPending(ethereumTx.GetSender(), tx.Nonce())
 
 	return txHash, nil
 }
@@ -314,7 +310,15 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	res, err := b.QueryClient.EstimateGas(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	ctx := b.QueryContexts.Context(blockNr.Int64())
+	// the estimation is interrupted like eth_call once the timeout is exceeded
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	res, err := b.QueryClient.EstimateGas(ctx, &req)
 	if err != nil {
 		return 0, err
 	}
@@ -349,7 +353,7 @@ func (b *Backend) DoCall(
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	ctx := b.QueryContexts.Context(blockNr.Int64())
 	timeout := b.RPCEVMTimeout()
 
 	// Setup context so it may be canceled the call has completed
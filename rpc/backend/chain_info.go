@@ -1,11 +1,14 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	gomath "math"
 	"math/big"
+	"slices"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -21,7 +24,6 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -65,20 +67,14 @@ func (b *Backend) GlobalMinGasPrice() (*big.Int, error) {
 // return nil.
 func (b *Backend) BaseFee(blockRes *cmtrpctypes.ResultBlockResults) (*big.Int, error) {
 	// return BaseFee if London hard fork is activated and feemarket is enabled
-	res, err := b.QueryClient.BaseFee(rpctypes.ContextWithHeight(blockRes.Height), &evmtypes.QueryBaseFeeRequest{})
+	res, err := b.QueryClient.BaseFee(b.QueryContexts.Context(blockRes.Height), &evmtypes.QueryBaseFeeRequest{})
 	if err != nil || res.BaseFee == nil {
 		// we can't tell if it's london HF not enabled or the state is pruned,
-		// in either case, we'll fallback to parsing from begin blocker event,
-		// faster to iterate reversely
-		for i := len(blockRes.FinalizeBlockEvents) - 1; i >= 0; i-- {
-			evt := blockRes.FinalizeBlockEvents[i]
-			if evt.Type == evmtypes.EventTypeFeeMarket && len(evt.Attributes) > 0 {
-				baseFee, ok := sdkmath.NewIntFromString(evt.Attributes[0].Value)
-				if ok {
-					return baseFee.BigInt(), nil
-				}
-				break
-			}
+		// in either case, we'll fallback to the base fee history kept by the
+		// fee market module in the latest state
+		historyRes, historyErr := b.QueryClient.FeeMarket.BaseFeeAt(b.Ctx, &feemarkettypes.QueryBaseFeeAtRequest{Height: blockRes.Height})
+		if historyErr == nil {
+			return evmtypes.ConvertAmountTo18DecimalsLegacy(historyRes.BaseFee).TruncateInt().BigInt(), nil
 		}
 		return nil, err
 	}
@@ -122,6 +118,55 @@ func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 	return result, nil
 }
 
+// PoolStats returns the priority distribution of the eth txs in the mempool.
+// The priorities are derived from the effective tip of each tx over the base
+// fee of the latest block and clamped to the priority bounds of the node.
+func (b *Backend) PoolStats() (*rpctypes.PoolStats, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := b.CurrentHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	priorities := make([]int64, 0, len(txs))
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			txData, err := ethMsg.GetTxData()
+			if err != nil {
+				b.Logger.Debug("failed to unpack tx data", "error", err.Error())
+				continue
+			}
+			priority := evmtypes.GetTxPriority(txData, head.BaseFee)
+			priorities = append(priorities, evmtypes.ClampTxPriority(priority, b.Cfg.EVM.PriorityFloor, b.Cfg.EVM.PriorityCeiling))
+		}
+	}
+
+	stats := &rpctypes.PoolStats{
+		Pending:         hexutil.Uint64(len(priorities)),
+		PriorityFloor:   b.Cfg.EVM.PriorityFloor,
+		PriorityCeiling: b.Cfg.EVM.PriorityCeiling,
+	}
+	if head.BaseFee != nil {
+		stats.BaseFee = (*hexutil.Big)(head.BaseFee)
+	}
+	if len(priorities) > 0 {
+		slices.Sort(priorities)
+		stats.MinPriority = priorities[0]
+		stats.MedianPriority = priorities[len(priorities)/2]
+		stats.MaxPriority = priorities[len(priorities)-1]
+	}
+
+	return stats, nil
+}
+
 // GetCoinbase is the address that staking rewards will be send to (alias for Etherbase).
 func (b *Backend) GetCoinbase() (sdk.AccAddress, error) {
 	node, err := b.ClientCtx.GetNode()
@@ -134,17 +179,33 @@ func (b *Backend) GetCoinbase() (sdk.AccAddress, error) {
 		return nil, err
 	}
 
+	coinbase, err := b.ValidatorCoinbase(b.Ctx, sdk.ConsAddress(status.ValidatorInfo.Address))
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.AccAddress(coinbase.Bytes()), nil
+}
+
+// ValidatorCoinbase returns the EVM address the block rewards of the validator with the given
+// consensus address are attributed to. The configured validator coinbases take precedence over
+// the account of the validator operator.
+func (b *Backend) ValidatorCoinbase(ctx context.Context, consAddr sdk.ConsAddress) (common.Address, error) {
+	if coinbase, ok := b.ValidatorCoinbases[string(consAddr)]; ok {
+		return coinbase, nil
+	}
+
 	req := &evmtypes.QueryValidatorAccountRequest{
-		ConsAddress: sdk.ConsAddress(status.ValidatorInfo.Address).String(),
+		ConsAddress: consAddr.String(),
 	}
 
-	res, err := b.QueryClient.ValidatorAccount(b.Ctx, req)
+	res, err := b.QueryClient.ValidatorAccount(ctx, req)
 	if err != nil {
-		return nil, err
+		return common.Address{}, err
 	}
 
 	address, _ := sdk.AccAddressFromBech32(res.AccountAddress) // #nosec G703
-	return address, nil
+	return common.BytesToAddress(address), nil
 }
 
 var (
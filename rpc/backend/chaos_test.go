@@ -0,0 +1,146 @@
+package backend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/chaos"
+
+	"cosmossdk.io/log"
+)
+
+// newChaosChain returns the golden chain with the faults injected in its
+// CometBFT RPC client.
+func newChaosChain(t *testing.T) (*goldenChain, *chaos.Injector) {
+	t.Helper()
+
+	chain := newGoldenChain(t)
+	faults := chaos.NewInjector()
+	client := chaos.NewCometRPC(chain.backend.ClientCtx.Client.(cmtrpcclient.Client), faults)
+	chain.backend.ClientCtx = chain.backend.ClientCtx.WithClient(client)
+	chain.backend.RPCClient = client
+	return chain, faults
+}
+
+// TestCometRPCFaults checks the responses of the JSON-RPC methods when the
+// CometBFT RPC fails or responds after the deadline of the request.
+func TestCometRPCFaults(t *testing.T) {
+	testCases := []struct {
+		name   string
+		method string
+		fault  chaos.Fault
+		call   func(chain *goldenChain) (interface{}, error)
+		expErr string
+	}{
+		{
+			"eth_getBlockByNumber - failed block",
+			"Block",
+			chaos.Fault{Err: chaos.ErrInjected},
+			func(chain *goldenChain) (interface{}, error) { return chain.backend.GetBlockByNumber(goldenHeight, false) },
+			"",
+		},
+		{
+			"eth_getBlockByNumber - failed block results",
+			"BlockResults",
+			chaos.Fault{Err: chaos.ErrInjected},
+			func(chain *goldenChain) (interface{}, error) { return chain.backend.GetBlockByNumber(goldenHeight, true) },
+			"",
+		},
+		{
+			"eth_getBlockByNumber - delayed block results",
+			"BlockResults",
+			chaos.Fault{Delay: time.Hour},
+			func(chain *goldenChain) (interface{}, error) { return chain.backend.GetBlockByNumber(goldenHeight, false) },
+			"",
+		},
+		{
+			"eth_getTransactionReceipt - failed block results",
+			"BlockResults",
+			chaos.Fault{Err: chaos.ErrInjected},
+			func(chain *goldenChain) (interface{}, error) { return chain.backend.GetTransactionReceipt(chain.transfer) },
+			"",
+		},
+		{
+			"eth_getTransactionReceipt - delayed block",
+			"Block",
+			chaos.Fault{Delay: time.Hour},
+			func(chain *goldenChain) (interface{}, error) { return chain.backend.GetTransactionReceipt(chain.transfer) },
+			"",
+		},
+		{
+			"eth_getTransactionByHash - failed block",
+			"Block",
+			chaos.Fault{Err: chaos.ErrInjected},
+			func(chain *goldenChain) (interface{}, error) { return chain.backend.GetTransactionByHash(chain.transfer) },
+			chaos.ErrInjected.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain, faults := newChaosChain(t)
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			chain.backend.Ctx = ctx
+
+			// the response is served without the fault
+			res, err := tc.call(chain)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			faults.Inject(tc.method, tc.fault)
+			start := time.Now()
+			res, err = tc.call(chain)
+			require.Less(t, time.Since(start), time.Second, "the delayed call must end on the deadline")
+			require.Positive(t, faults.Faulted(tc.method))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Nil(t, res)
+		})
+	}
+}
+
+// TestIndexerDBFaults checks that the failed writes of the EVM indexer are
+// reported and leave no partially indexed block, so that the block can be
+// indexed again.
+func TestIndexerDBFaults(t *testing.T) {
+	chain, _ := newChaosChain(t)
+	b := chain.backend
+
+	// the golden block, with its results as served by the CometBFT RPC
+	height := int64(goldenHeight)
+	resBlock, err := b.ClientCtx.Client.(cmtrpcclient.Client).Block(b.Ctx, &height)
+	require.NoError(t, err)
+	blockRes, err := b.ClientCtx.Client.(cmtrpcclient.Client).BlockResults(b.Ctx, &height)
+	require.NoError(t, err)
+
+	faults := chaos.NewInjector()
+	b.Indexer = indexer.NewKVIndexer(chaos.NewDB(dbm.NewMemDB(), faults), log.NewNopLogger(), b.ClientCtx)
+
+	faults.Inject("Batch.Write", chaos.Fault{Err: chaos.ErrInjected, Times: 1})
+	require.ErrorIs(t, b.Indexer.IndexBlock(resBlock.Block, blockRes.TxsResults), chaos.ErrInjected)
+	receipt, err := b.GetTransactionReceipt(chain.transfer)
+	require.NoError(t, err)
+	require.Nil(t, receipt, "the tx of the failed block must not be indexed")
+
+	require.NoError(t, b.Indexer.IndexBlock(resBlock.Block, blockRes.TxsResults))
+	receipt, err = b.GetTransactionReceipt(chain.transfer)
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+
+	// the failed reads of the indexer are served as not found
+	faults.Inject("Get", chaos.Fault{Err: chaos.ErrInjected})
+	receipt, err = b.GetTransactionReceipt(chain.transfer)
+	require.NoError(t, err)
+	require.Nil(t, receipt)
+}
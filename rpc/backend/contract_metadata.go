@@ -0,0 +1,58 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/rpc/contractmetadata"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+var errContractMetadataDisabled = errors.New("the contract metadata is disabled on this node")
+
+// SubmitContractMetadata checks the Solidity metadata of the contract deployed
+// at the given address against the metadata hash appended to its code at the
+// latest block, and stores it with the sources of the contract, keyed by the
+// hash of the code.
+func (b *Backend) SubmitContractMetadata(args rpctypes.ContractMetadataArgs) (*rpctypes.ContractMetadata, error) {
+	if b.ContractMetadata == nil {
+		return nil, errContractMetadataDisabled
+	}
+
+	latest := rpctypes.EthLatestBlockNumber
+	code, err := b.GetCode(args.Address, rpctypes.BlockNumberOrHash{BlockNumber: &latest})
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("no contract at address %s", args.Address.Hex())
+	}
+
+	m, err := contractmetadata.Verify(code, args.Metadata, args.Sources)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.ContractMetadata.Set(m); err != nil {
+		b.Logger.Error("failed to store contract metadata", "code-hash", m.CodeHash.Hex(), "error", err.Error())
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetContractMetadata returns the Solidity metadata submitted for the code of
+// the contract deployed at the given address at the given block, nil if there's
+// no contract or no metadata of its code.
+func (b *Backend) GetContractMetadata(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.ContractMetadata, error) {
+	if b.ContractMetadata == nil {
+		return nil, errContractMetadataDisabled
+	}
+
+	code, err := b.GetCode(address, blockNrOrHash)
+	if err != nil || len(code) == 0 {
+		return nil, err
+	}
+	return b.ContractMetadata.Get(crypto.Keccak256Hash(code))
+}
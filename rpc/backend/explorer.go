@@ -0,0 +1,145 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// DefaultExplorerPageSize is the number of items returned by the explorer
+	// queries when the request doesn't set it
+	DefaultExplorerPageSize = 25
+	// MaxExplorerPageSize is the max number of items returned by an explorer query
+	MaxExplorerPageSize = 100
+)
+
+var errExplorerDisabled = errors.New("the explorer namespace is only served by the custom tx indexer")
+
+// GetTopAccounts returns the count accounts with the largest balances of the evm
+// coin at the latest block, largest first. The balances are the bank balances
+// of the evm denom scaled to 18 decimals, the fractional balances held by the
+// precisebank module are left out.
+//
+// The holders of the evm denom are loaded from the bank module on every call,
+// which suits the chains with a few thousand holders.
+func (b *Backend) GetTopAccounts(count int) ([]rpctypes.AccountBalance, error) {
+	count = explorerPageSize(count)
+	height, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	queryClient := banktypes.NewQueryClient(b.QueryContexts.Conn(b.ClientCtx))
+	ctx := b.QueryContexts.Context(int64(height))
+	req := &banktypes.QueryDenomOwnersRequest{
+		Denom:      evmtypes.GetEVMCoinDenom(),
+		Pagination: &query.PageRequest{Limit: query.PaginationMaxLimit},
+	}
+	var accounts []rpctypes.AccountBalance
+	for {
+		res, err := queryClient.DenomOwners(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, owner := range res.DenomOwners {
+			accAddr, err := sdk.AccAddressFromBech32(owner.Address)
+			if err != nil {
+				return nil, fmt.Errorf("invalid owner address %s: %w", owner.Address, err)
+			}
+			accounts = append(accounts, rpctypes.AccountBalance{
+				Address: common.BytesToAddress(accAddr),
+				Balance: (*hexutil.Big)(evmtypes.ConvertAmountTo18DecimalsBigInt(owner.Balance.Amount.BigInt())),
+			})
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: query.PaginationMaxLimit}
+	}
+
+	slices.SortStableFunc(accounts, func(a, b rpctypes.AccountBalance) int {
+		return b.Balance.ToInt().Cmp(a.Balance.ToInt())
+	})
+	if len(accounts) > count {
+		accounts = accounts[:count]
+	}
+	return accounts, nil
+}
+
+// GetTransactionsByAddress returns a page of up to limit eth txs of the given
+// address, of any kind, newest first, from the cursor returned with
+// the previous page, or from the latest tx if the cursor is empty.
+func (b *Backend) GetTransactionsByAddress(address common.Address, cursor hexutil.Bytes, limit int) (*rpctypes.AddressTransactions, error) {
+	if b.Indexer == nil {
+		return nil, errExplorerDisabled
+	}
+	if len(cursor) == 0 {
+		cursor = nil
+	}
+
+	txs, next, err := b.Indexer.GetAddressTxs(address, cosmosevmtypes.AddressTxAll, cursor, explorerPageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	res := &rpctypes.AddressTransactions{
+		Transactions: make([]*rpctypes.RPCTransaction, 0, len(txs)),
+		NextCursor:   next,
+	}
+	for _, addressTx := range txs {
+		tx, err := b.GetTransactionByHash(addressTx.Hash)
+		if err != nil {
+			return nil, err
+		}
+		// the block of the tx is pruned
+		if tx == nil {
+			continue
+		}
+		res.Transactions = append(res.Transactions, tx)
+	}
+	return res, nil
+}
+
+// GetContractCreation returns the eth tx which created the contract at the given
+// address, nil if the contract wasn't created by an eth tx, or by an internal
+// call of an eth tx whose block traces were stored by trace_filter.
+func (b *Backend) GetContractCreation(address common.Address) (*rpctypes.ContractCreation, error) {
+	if b.Indexer == nil {
+		return nil, errExplorerDisabled
+	}
+
+	hash, err := b.Indexer.GetContractCreation(address)
+	if err != nil || hash == nil {
+		return nil, err
+	}
+	tx, err := b.GetTransactionByHash(*hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &rpctypes.ContractCreation{
+		Address:     address,
+		Creator:     tx.From,
+		TxHash:      *hash,
+		BlockNumber: hexutil.Uint64(tx.BlockNumber.ToInt().Uint64()),
+	}, nil
+}
+
+// explorerPageSize returns the number of items returned by an explorer query
+// for the requested one, the default if it's not positive and capped otherwise.
+func explorerPageSize(size int) int {
+	if size <= 0 {
+		return DefaultExplorerPageSize
+	}
+	return min(size, MaxExplorerPageSize)
+}
@@ -1,9 +1,15 @@
 package backend
 
 import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
+
+	"github.com/cosmos/evm/rpc/contractmetadata"
+	"github.com/cosmos/evm/rpc/eventsig"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -34,3 +40,55 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// DecodeLogs returns the logs with their decoded events. An event is decoded
+// with the ABI of the metadata submitted for the code of its contract if any,
+// or with the event signature registry, and is left out if neither knows it.
+func (b *Backend) DecodeLogs(logs []*ethtypes.Log) []*rpctypes.Log {
+	res := rpctypes.NewLogs(logs)
+	abis := make(map[common.Address]*abi.ABI)
+	for _, log := range res {
+		contractABI, ok := abis[log.Address]
+		if !ok {
+			contractABI = b.contractABI(log.Address, log.BlockNumber)
+			abis[log.Address] = contractABI
+		}
+		event, ok := eventsig.ContractEvent(contractABI, log.Log)
+		if !ok && b.EventSignatures != nil {
+			event, ok = b.EventSignatures.Event(log.Log)
+		}
+		if !ok {
+			continue
+		}
+		decoded, err := eventsig.Decode(event, log.Log)
+		if err != nil {
+			b.Logger.Debug("failed to decode log", "tx", log.TxHash.Hex(), "index", log.Index, "error", err.Error())
+			continue
+		}
+		log.Decoded = decoded
+	}
+	return res
+}
+
+// contractABI returns the ABI of the metadata submitted for the code of the
+// contract at the block, nil if there's none.
+func (b *Backend) contractABI(address common.Address, blockNumber uint64) *abi.ABI {
+	if b.ContractMetadata == nil {
+		return nil
+	}
+	blockNum := rpctypes.BlockNumber(blockNumber) //nolint:gosec // G115 // block number won't exceed int64
+	code, err := b.GetCode(address, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	if err != nil || len(code) == 0 {
+		return nil
+	}
+	m, err := b.ContractMetadata.Get(crypto.Keccak256Hash(code))
+	if err != nil || m == nil {
+		return nil
+	}
+	contractABI, err := contractmetadata.ABI(m.Metadata)
+	if err != nil {
+		b.Logger.Debug("invalid contract metadata ABI", "address", address.Hex(), "error", err.Error())
+		return nil
+	}
+	return contractABI
+}
@@ -0,0 +1,308 @@
+package backend_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/indexer"
+	rpcbackend "github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	"github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// updateGolden rewrites the golden files with the current responses, to be
+// run when a response changes on purpose:
+//
+//	go test -tags=test ./rpc/backend/ -run TestGoldenResponses -update-golden
+var updateGolden = flag.Bool("update-golden", false, "update the golden files of the JSON-RPC responses")
+
+const goldenHeight = 1
+
+// goldenChain is the canned chain state the golden responses are generated
+// from: a block with a legacy transfer emitting a log and a dynamic fee
+// contract creation, served by mocked CometBFT and gRPC clients.
+type goldenChain struct {
+	backend  *rpcbackend.Backend
+	transfer common.Hash
+	creation common.Hash
+}
+
+// TestGoldenResponses checks the JSON encoding of the responses of the
+// JSON-RPC methods against the golden files of testdata/golden, so that the
+// renamed or omitted fields, which break the client libraries, are caught.
+func TestGoldenResponses(t *testing.T) {
+	chain := newGoldenChain(t)
+	b := chain.backend
+
+	testCases := []struct {
+		name string
+		call func() (interface{}, error)
+	}{
+		{
+			"eth_getBlockByNumber",
+			func() (interface{}, error) { return b.GetBlockByNumber(goldenHeight, false) },
+		},
+		{
+			"eth_getBlockByNumber_fullTx",
+			func() (interface{}, error) { return b.GetBlockByNumber(goldenHeight, true) },
+		},
+		{
+			"eth_getTransactionByHash_legacy",
+			func() (interface{}, error) { return b.GetTransactionByHash(chain.transfer) },
+		},
+		{
+			"eth_getTransactionByHash_dynamicFee",
+			func() (interface{}, error) { return b.GetTransactionByHash(chain.creation) },
+		},
+		{
+			"eth_getTransactionReceipt_legacy",
+			func() (interface{}, error) { return b.GetTransactionReceipt(chain.transfer) },
+		},
+		{
+			"eth_getTransactionReceipt_contractCreation",
+			func() (interface{}, error) { return b.GetTransactionReceipt(chain.creation) },
+		},
+		{
+			"eth_feeHistory",
+			func() (interface{}, error) {
+				return b.FeeHistory(1, rpc.LatestBlockNumber, []float64{25, 75})
+			},
+		},
+		{
+			"debug_traceTransaction",
+			func() (interface{}, error) {
+				return b.TraceTransaction(chain.transfer, &evmtypes.TraceConfig{Tracer: "callTracer"})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tc.call()
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			got, err := json.MarshalIndent(res, "", "  ")
+			require.NoError(t, err)
+			got = append(got, '\n')
+
+			path := filepath.Join("testdata", "golden", tc.name+".json")
+			if *updateGolden {
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+				require.NoError(t, os.WriteFile(path, got, 0o600))
+			}
+			want, err := os.ReadFile(path) // #nosec G304 -- the golden files are fixed
+			require.NoError(t, err, "missing golden file, run the test with -update-golden")
+			require.JSONEq(t, string(want), string(got))
+		})
+	}
+}
+
+func newGoldenChain(t *testing.T) *goldenChain {
+	t.Helper()
+
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.
+		WithChainConfig(evmtypes.DefaultChainConfig(constants.ExampleChainID.EVMChainID)).
+		WithEVMCoinInfo(constants.ExampleChainCoinInfo[constants.ExampleChainID]).
+		Configure())
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Viper.Set("telemetry.global-labels", []interface{}{})
+	serverCtx.Viper.Set("evm.evm-chain-id", constants.ExampleChainID.EVMChainID)
+
+	encodingConfig := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	rpcClient := mocks.NewClient(t)
+	clientCtx := client.Context{}.WithChainID(constants.ExampleChainID.ChainID).
+		WithHeight(goldenHeight).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithCodec(encodingConfig.Codec).
+		WithClient(rpcClient)
+
+	b := rpcbackend.NewBackend(serverCtx, log.NewNopLogger(), clientCtx, false, nil)
+	queryClient := mocks.NewEVMQueryClient(t)
+	feeMarketClient := mocks.NewFeeMarketQueryClient(t)
+	b.QueryClient.QueryClient = queryClient
+	b.QueryClient.FeeMarket = feeMarketClient
+
+	// the txs are signed by a fixed key for their hashes to be stable
+	key, err := crypto.HexToECDSA("45a915e4d060149eb4365960e6a7a45f334393093061116b197e3240065ff2d8")
+	require.NoError(t, err)
+	priv := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(key)}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	transfer := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  b.EvmChainID,
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 50_000,
+		GasPrice: big.NewInt(2_000_000_000),
+		Input:    []byte{},
+	})
+	creation := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   b.EvmChainID,
+		Nonce:     1,
+		Amount:    big.NewInt(0),
+		GasLimit:  100_000,
+		GasFeeCap: big.NewInt(3_000_000_000),
+		GasTipCap: big.NewInt(1_000_000_000),
+		Input:     common.FromHex("0x602a60005260206000f3"),
+		Accesses: &ethtypes.AccessList{{
+			Address:     to,
+			StorageKeys: []common.Hash{common.HexToHash("0x01")},
+		}},
+	})
+
+	var txs []cmttypes.Tx
+	for _, msg := range []*evmtypes.MsgEthereumTx{transfer, creation} {
+		msg.From = from.Bytes()
+		require.NoError(t, msg.Sign(ethtypes.LatestSigner(b.ChainConfig()), utiltx.NewSigner(priv)))
+		tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txs = append(txs, txBz)
+	}
+
+	block := cmttypes.MakeBlock(goldenHeight, txs, &cmttypes.Commit{}, nil)
+	block.ChainID = constants.ExampleChainID.ChainID
+	block.Time = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	block.AppHash = common.HexToHash("0x04").Bytes()
+	block.ValidatorsHash = common.HexToHash("0x05").Bytes()
+	block.ProposerAddress = common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes()
+	resBlock := &cmtrpctypes.ResultBlock{
+		BlockID: cmttypes.BlockID{Hash: block.Hash()},
+		Block:   block,
+	}
+
+	transferLog := &evmtypes.Log{
+		Address:     to.Hex(),
+		Topics:      []string{common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef").Hex()},
+		Data:        common.LeftPadBytes([]byte{0x2a}, 32),
+		BlockNumber: goldenHeight,
+		TxHash:      transfer.Hash,
+		TxIndex:     0,
+		BlockHash:   common.BytesToHash(block.Hash()).Hex(),
+		Index:       0,
+	}
+
+	blockRes := &cmtrpctypes.ResultBlockResults{
+		Height: goldenHeight,
+		TxsResults: []*abci.ExecTxResult{
+			goldenTxResult(t, transfer, 0, 26_000, []*evmtypes.Log{transferLog}),
+			goldenTxResult(t, creation, 1, 60_000, nil),
+		},
+		FinalizeBlockEvents: []abci.Event{{
+			Type: evmtypes.EventTypeBlockBloom,
+			Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumBloom, Value: string(common.LeftPadBytes([]byte{0x01}, 256))},
+			},
+		}},
+	}
+
+	kvIndexer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	require.NoError(t, kvIndexer.IndexBlock(block, blockRes.TxsResults))
+	b.Indexer = kvIndexer
+
+	rpcClient.On("Block", mock.Anything, mock.Anything).Return(resBlock, nil).Maybe()
+	rpcClient.On("BlockResults", mock.Anything, mock.Anything).Return(blockRes, nil).Maybe()
+	consensusParams := cmttypes.DefaultConsensusParams()
+	consensusParams.Block.MaxGas = 30_000_000
+	rpcClient.On("ConsensusParams", mock.Anything, mock.Anything).Return(&cmtrpctypes.ResultConsensusParams{
+		BlockHeight:     goldenHeight,
+		ConsensusParams: *consensusParams,
+	}, nil).Maybe()
+
+	baseFee := sdkmath.NewInt(1_000_000_000)
+	queryClient.On("BaseFee", mock.Anything, mock.Anything).
+		Return(&evmtypes.QueryBaseFeeResponse{BaseFee: &baseFee}, nil).Maybe()
+	queryClient.On("ValidatorAccount", mock.Anything, mock.Anything).
+		Return(&evmtypes.QueryValidatorAccountResponse{
+			AccountAddress: sdk.AccAddress(common.HexToAddress("0x3000000000000000000000000000000000000003").Bytes()).String(),
+		}, nil).Maybe()
+	queryClient.On("Params", mock.Anything, mock.Anything, mock.Anything).
+		Return(&evmtypes.QueryParamsResponse{Params: evmtypes.DefaultParams()}, nil).
+		Run(func(args mock.Arguments) {
+			header := args.Get(2).(grpc.HeaderCallOption)
+			md := metadata.MD{}
+			md.Set(grpctypes.GRPCBlockHeightHeader, fmt.Sprint(goldenHeight))
+			*header.HeaderAddr = md
+		}).Maybe()
+	queryClient.On("TraceTx", mock.Anything, mock.Anything).
+		Return(&evmtypes.QueryTraceTxResponse{Data: []byte(`{"from":"` + from.Hex() + `","gas":"0xc350","gasUsed":"0x6590","input":"0x","to":"` + to.Hex() + `","type":"CALL","value":"0x3e8"}`)}, nil).Maybe()
+	feeMarketClient.On("Params", mock.Anything, mock.Anything).
+		Return(&feemarkettypes.QueryParamsResponse{Params: feemarkettypes.DefaultParams()}, nil).Maybe()
+
+	return &goldenChain{
+		backend:  b,
+		transfer: common.HexToHash(transfer.Hash),
+		creation: common.HexToHash(creation.Hash),
+	}
+}
+
+// goldenTxResult returns the result of the cosmos tx of the eth tx, with the
+// events indexed by the EVM indexer and the logs of its response.
+func goldenTxResult(t *testing.T, msg *evmtypes.MsgEthereumTx, index, gasUsed int, logs []*evmtypes.Log) *abci.ExecTxResult {
+	t.Helper()
+
+	data, err := (&sdk.TxMsgData{
+		MsgResponses: []*types.Any{types.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{
+			Hash:    msg.Hash,
+			Logs:    logs,
+			GasUsed: uint64(gasUsed), //#nosec G115 -- the gas used is small
+		})},
+	}).Marshal()
+	require.NoError(t, err)
+
+	return &abci.ExecTxResult{
+		Code:    abci.CodeTypeOK,
+		Data:    data,
+		GasUsed: int64(gasUsed),
+		Events: []abci.Event{{
+			Type: evmtypes.EventTypeEthereumTx,
+			Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumTxHash, Value: msg.Hash},
+				{Key: evmtypes.AttributeKeyTxIndex, Value: fmt.Sprint(index)},
+				{Key: evmtypes.AttributeKeyTxGasUsed, Value: fmt.Sprint(gasUsed)},
+			},
+		}},
+	}
+}
@@ -47,6 +47,36 @@ func (_m *FeeMarketQueryClient) BaseFee(ctx context.Context, in *types.QueryBase
 	return r0, r1
 }
 
+// BaseFeeAt provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) BaseFeeAt(ctx context.Context, in *types.QueryBaseFeeAtRequest, opts ...grpc.CallOption) (*types.QueryBaseFeeAtResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryBaseFeeAtResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBaseFeeAtRequest, ...grpc.CallOption) *types.QueryBaseFeeAtResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBaseFeeAtResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBaseFeeAtRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockGas provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlockGasRequest, opts ...grpc.CallOption) (*types.QueryBlockGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -107,6 +137,36 @@ func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParam
 	return r0, r1
 }
 
+// SimulateBaseFee provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) SimulateBaseFee(ctx context.Context, in *types.QuerySimulateBaseFeeRequest, opts ...grpc.CallOption) (*types.QuerySimulateBaseFeeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QuerySimulateBaseFeeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySimulateBaseFeeRequest, ...grpc.CallOption) *types.QuerySimulateBaseFeeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySimulateBaseFeeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySimulateBaseFeeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewQueryClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,77 @@
+package backend
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// GetNFTsByOwner returns a page of the ERC721 and ERC1155 tokens held by an
+// owner, as tracked by the indexer from the token transfers, with the URIs of
+// their metadata, from the cursor returned with the previous page, or from the
+// first token if the cursor is empty.
+func (b *Backend) GetNFTsByOwner(args rpctypes.NFTsByOwnerArgs) (*rpctypes.NFTsByOwnerResult, error) {
+	if b.Indexer == nil {
+		return nil, errors.New("the NFTs of the owners are only served by the custom tx indexer")
+	}
+	var limit int
+	if args.Limit != nil {
+		limit = int(*args.Limit)
+	}
+	var cursor []byte
+	if len(args.Cursor) > 0 {
+		cursor = args.Cursor
+	}
+
+	nfts, next, err := b.Indexer.GetNFTsByOwner(args.Owner, cursor, explorerPageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	res := &rpctypes.NFTsByOwnerResult{
+		NFTs:       make([]rpctypes.NFT, 0, len(nfts)),
+		NextCursor: next,
+	}
+	for _, nft := range nfts {
+		res.NFTs = append(res.NFTs, rpctypes.NFT{
+			Standard: nft.Standard,
+			Token:    nft.Token,
+			TokenID:  nft.TokenID,
+			Balance:  nft.Balance,
+			TokenURI: b.nftTokenURI(nft),
+		})
+	}
+	return res, nil
+}
+
+// nftTokenURI returns the URI of the metadata of an NFT at the latest block, nil
+// if the call fails, e.g. if the token doesn't implement the metadata extension.
+func (b *Backend) nftTokenURI(nft *cosmosevmtypes.NFT) *string {
+	method := "tokenURI"
+	if nft.Standard == cosmosevmtypes.TokenStandardERC1155 {
+		method = "uri"
+	}
+	data, err := rpctypes.NFTMetadataABI.Pack(method, nft.TokenID.ToInt())
+	if err != nil {
+		return nil
+	}
+
+	input := hexutil.Bytes(data)
+	res, err := b.DoCall(evmtypes.TransactionArgs{To: &nft.Token, Input: &input}, rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		b.Logger.Debug("failed to call the metadata method of the NFT", "token", nft.Token.Hex(), "method", method, "error", err.Error())
+		return nil
+	}
+	values, err := rpctypes.NFTMetadataABI.Unpack(method, res.Ret)
+	if err != nil || len(values) != 1 {
+		return nil
+	}
+	uri, ok := values[0].(string)
+	if !ok {
+		return nil
+	}
+	return &uri
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
@@ -57,12 +58,13 @@ func (b *Backend) Accounts() ([]common.Address, error) {
 }
 
 // Syncing returns false in case the node is currently not syncing with the network. It can be up to date or has not
-// yet received the latest block headers from its pears. In case it is synchronizing:
-// - startingBlock: block number this node started to synchronize from
-// - currentBlock:  block number this node is currently importing
-// - highestBlock:  block number of the highest block header this node has received from peers
-// - pulledStates:  number of state entries processed until now
-// - knownStates:   number of known state entries that still need to be pulled
+// yet received the latest block headers from its pears. In case it is synchronizing, i.e. CometBFT is catching up
+// with state sync or block sync:
+// - startingBlock:  block number of the earliest block of this node, i.e. the one it started to synchronize from
+// - currentBlock:   block number this node last committed, 0 until the snapshot is restored during state sync
+// - highestBlock:   block number of the highest block committed by the peers this node is connected to
+// - safeBlock:      block number of the latest safe block, i.e. the current one as the blocks have instant finality
+// - finalizedBlock: block number of the latest finalized block, i.e. the current one as well
 func (b *Backend) Syncing() (interface{}, error) {
 	status, err := b.ClientCtx.Client.Status(b.Ctx)
 	if err != nil {
@@ -73,12 +75,26 @@ func (b *Backend) Syncing() (interface{}, error) {
 		return false, nil
 	}
 
+	// the peers' heights are best effort, the highest block is at least the
+	// current one
+	highestBlock := status.SyncInfo.LatestBlockHeight
+	if nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient); ok {
+		consensusState, err := nc.DumpConsensusState(b.Ctx)
+		if err != nil {
+			b.Logger.Debug("failed to get the consensus state of the peers", "error", err.Error())
+		} else {
+			highestBlock = max(highestBlock, rpctypes.HighestPeerBlock(consensusState.Peers))
+		}
+	}
+
+	// the blocks are final once committed, so the latest block synced is both
+	// the safe and the finalized block
 	return map[string]interface{}{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
-		// "highestBlock":  nil, // NA
-		// "pulledStates":  nil, // NA
-		// "knownStates":   nil, // NA
+		"startingBlock":  hexutil.Uint64(status.SyncInfo.EarliestBlockHeight), //nolint:gosec // G115 // won't exceed uint64
+		"currentBlock":   hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
+		"highestBlock":   hexutil.Uint64(highestBlock),                        //nolint:gosec // G115 // won't exceed uint64
+		"safeBlock":      hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
+		"finalizedBlock": hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // won't exceed uint64
 	}, nil
 }
 
@@ -8,10 +8,10 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -29,46 +29,38 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, fmt.Errorf("account unlock with HTTP access is forbidden")
 	}
 
-	_, err := b.ClientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
-	if err != nil {
-		b.Logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	if err := b.checkUnlocked(args.GetFrom()); err != nil {
+		return common.Hash{}, err
 	}
 
-	if args.ChainID != nil && (b.EvmChainID).Cmp((*big.Int)(args.ChainID)) != 0 {
-		return common.Hash{}, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.EvmChainID))
-	}
+	return b.sendTransaction(args)
+}
 
-	args, err = b.SetTxDefaults(args)
-	if err != nil {
-		return common.Hash{}, err
+// SignTransaction signs the transaction with the key of the sender in the
+// node's keyring, without broadcasting it.
+func (b *Backend) SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error) {
+	if err := b.checkUnlocked(args.GetFrom()); err != nil {
+		return nil, err
 	}
 
-	bn, err := b.BlockNumber()
+	msg, err := b.signTransaction(args)
 	if err != nil {
-		b.Logger.Debug("failed to fetch latest block number", "error", err.Error())
-		return common.Hash{}, err
+		return nil, err
 	}
 
-	header, err := b.CurrentHeader()
+	tx := msg.AsTransaction()
+	data, err := tx.MarshalBinary()
 	if err != nil {
-		return common.Hash{}, err
-	}
-
-	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)), header.Time)
-
-	// LegacyTx derives EvmChainID from the signature. To make sure the msg.ValidateBasic makes
-	// the corresponding EvmChainID validation, we need to sign the transaction before calling it
-
-	// Sign transaction
-	msg := args.ToTransaction()
-	if err := msg.Sign(signer, b.ClientCtx.Keyring); err != nil {
-		b.Logger.Debug("failed to sign tx", "error", err.Error())
-		return common.Hash{}, err
+		return nil, err
 	}
+	return &rpctypes.SignTransactionResult{Raw: data, Tx: tx}, nil
+}
 
-	if err := msg.ValidateBasic(); err != nil {
-		b.Logger.Debug("tx failed basic validation", "error", err.Error())
+// sendTransaction signs the transaction with the key of the sender in the
+// node's keyring and broadcasts it
+func (b *Backend) sendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
+	msg, err := b.signTransaction(args)
+	if err != nil {
 		return common.Hash{}, err
 	}
 
@@ -108,17 +100,71 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 	}
 	if err != nil {
 		b.Logger.Error("failed to broadcast tx", "error", err.Error())
-		return txHash, err
+		return txHash, rpctypes.NewTxError(err, args.GetFrom())
 	}
+	b.Nonces.AddPending(args.GetFrom(), ethTx.Nonce())
 
 	// Return transaction hash
 	return txHash, nil
 }
 
+// signTransaction fills the defaults of the transaction and signs it with the
+// key of the sender in the node's keyring
+func (b *Backend) signTransaction(args evmtypes.TransactionArgs) (*evmtypes.MsgEthereumTx, error) {
+	_, err := b.ClientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
+	if err != nil {
+		b.Logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
+		return nil, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	}
+
+	if args.ChainID != nil && (b.EvmChainID).Cmp((*big.Int)(args.ChainID)) != 0 {
+		return nil, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.EvmChainID))
+	}
+
+	args, err = b.SetTxDefaults(args)
+	if err != nil {
+		return nil, err
+	}
+
+	bn, err := b.BlockNumber()
+	if err != nil {
+		b.Logger.Debug("failed to fetch latest block number", "error", err.Error())
+		return nil, err
+	}
+
+	header, err := b.CurrentHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	signer := evmtypes.MakeSigner(new(big.Int).SetUint64(uint64(bn)), header.Time)
+
+	// LegacyTx derives EvmChainID from the signature. To make sure the msg.ValidateBasic makes
+	// the corresponding EvmChainID validation, we need to sign the transaction before calling it
+
+	// Sign transaction
+	msg := args.ToTransaction()
+	if err := msg.Sign(signer, b.ClientCtx.Keyring); err != nil {
+		b.Logger.Debug("failed to sign tx", "error", err.Error())
+		return nil, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		b.Logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, err
+	}
+
+	return msg, nil
+}
+
 // Sign signs the provided data using the private key of address via Geth's signature standard.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
 	from := sdk.AccAddress(address.Bytes())
 
+	if err := b.checkUnlocked(address); err != nil {
+		return nil, err
+	}
+
 	_, err := b.ClientCtx.Keyring.KeyByAddress(from)
 	if err != nil {
 		b.Logger.Error("failed to find key in keyring", "address", address.String())
@@ -140,12 +186,20 @@ func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Byte
 func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
 	from := sdk.AccAddress(address.Bytes())
 
+	if err := b.checkUnlocked(address); err != nil {
+		return nil, err
+	}
+
 	_, err := b.ClientCtx.Keyring.KeyByAddress(from)
 	if err != nil {
 		b.Logger.Error("failed to find key in keyring", "address", address.String())
 		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
 	}
 
+	if err := b.validateTypedDataDomain(typedData.Domain); err != nil {
+		return nil, err
+	}
+
 	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
 	if err != nil {
 		return nil, err
@@ -161,3 +215,19 @@ func (b *Backend) SignTypedData(address common.Address, typedData apitypes.Typed
 	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
 	return signature, nil
 }
+
+// validateTypedDataDomain checks that the EIP-712 domain separator of the typed
+// data binds the signature to this chain, so that it can't be replayed on
+// another one.
+func (b *Backend) validateTypedDataDomain(domain apitypes.TypedDataDomain) error {
+	if domain.ChainId == nil {
+		return errors.New("typed data domain must include the chain id")
+	}
+	if chainID := (*big.Int)(domain.ChainId); chainID.Cmp(b.EvmChainID) != 0 {
+		return fmt.Errorf("typed data domain chain id %s doesn't match the chain id %s", chainID, b.EvmChainID)
+	}
+	if domain.VerifyingContract != "" && !common.IsHexAddress(domain.VerifyingContract) {
+		return fmt.Errorf("invalid typed data domain verifying contract %s", domain.VerifyingContract)
+	}
+	return nil
+}
@@ -4,14 +4,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"slices"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/pkg/errors"
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +26,13 @@ import (
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+	if !b.TracingEnabled() {
+		return nil, errTracingDisabled
+	}
+	if err := b.validateTraceConfig(config); err != nil {
+		return nil, err
+	}
+
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(hash)
 	if err != nil {
@@ -118,7 +131,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
-	traceResult, err := b.QueryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
+	traceResult, err := b.QueryClient.TraceTx(b.QueryContexts.Context(contextHeight), &traceTxRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +154,13 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	config *evmtypes.TraceConfig,
 	block *tmrpctypes.ResultBlock,
 ) ([]*evmtypes.TxTraceResult, error) {
+	if !b.TracingEnabled() {
+		return nil, errTracingDisabled
+	}
+	if err := b.validateTraceConfig(config); err != nil {
+		return nil, err
+	}
+
 	txs := block.Block.Txs
 	txsLength := len(txs)
 
@@ -175,7 +195,7 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		// 0 is a special value for `ContextWithHeight`.
 		contextHeight = 1
 	}
-	ctxWithHeight := rpctypes.ContextWithHeight(int64(contextHeight))
+	ctxWithHeight := b.QueryContexts.Context(int64(contextHeight))
 
 	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
 	if !ok {
@@ -210,3 +230,431 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the requested block. The return
+// value is dependent on the requested tracer.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *evmtypes.TraceConfig,
+) (interface{}, error) {
+	if !b.TracingEnabled() {
+		return nil, errTracingDisabled
+	}
+	if err := b.validateTraceConfig(config); err != nil {
+		return nil, err
+	}
+
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.Block == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.Ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		TraceConfig:     config,
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	traceResult, err := b.QueryClient.TraceCall(b.QueryContexts.Context(blk.Block.Height), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// validateTraceConfig checks the requested timeout and the size of the
+// JavaScript tracer against the node caps before querying the node, the trace
+// queries enforce the same caps for the gRPC clients. The size of the results of
+// the JavaScript tracers is capped by the trace queries.
+func (b *Backend) validateTraceConfig(config *evmtypes.TraceConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	if config.Timeout != "" && b.Cfg.JSONRPC.TraceTimeoutCap > 0 {
+		timeout, err := time.ParseDuration(config.Timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout value: %w", err)
+		}
+		if timeout > b.Cfg.JSONRPC.TraceTimeoutCap {
+			return fmt.Errorf("trace timeout %s exceeds the cap of %s", timeout, b.Cfg.JSONRPC.TraceTimeoutCap)
+		}
+	}
+
+	sizeCap := b.Cfg.JSONRPC.TracerSizeCap
+	if config.Tracer != "" && sizeCap > 0 && tracers.DefaultDirectory.IsJS(config.Tracer) && len(config.Tracer) > sizeCap {
+		return fmt.Errorf("javascript tracer size %d exceeds the cap of %d bytes", len(config.Tracer), sizeCap)
+	}
+	return nil
+}
+
+// GetTxWitness returns the accounts and storage slots read and written by an eth
+// tx, as recorded by the node when `evm.record-witness` is enabled.
+func (b *Backend) GetTxWitness(hash common.Hash) (*cosmosevmtypes.TxWitness, error) {
+	if b.Indexer == nil {
+		return nil, errors.New("tx witnesses are only served by the custom tx indexer")
+	}
+	return b.Indexer.GetWitnessByTxHash(hash)
+}
+
+// GetModifiedAccountsByNumber returns the accounts modified by the eth txs of the
+// blocks in `(startNum, endNum]`, or of the block startNum if endNum is nil, as
+// recorded by the node when `evm.record-modified-accounts` is enabled.
+func (b *Backend) GetModifiedAccountsByNumber(startNum uint64, endNum *uint64) ([]common.Address, error) {
+	if endNum == nil {
+		if startNum == 0 {
+			return nil, errors.New("block 0 has no parent")
+		}
+		return b.getModifiedAccounts(startNum-1, startNum)
+	}
+	return b.getModifiedAccounts(startNum, *endNum)
+}
+
+// GetModifiedAccountsByHash returns the accounts modified by the eth txs of the
+// blocks in `(startHash, endHash]`, or of the block startHash if endHash is nil.
+func (b *Backend) GetModifiedAccountsByHash(startHash common.Hash, endHash *common.Hash) ([]common.Address, error) {
+	startNum, err := b.blockNumberByHash(startHash)
+	if err != nil {
+		return nil, err
+	}
+	if endHash == nil {
+		return b.GetModifiedAccountsByNumber(startNum, nil)
+	}
+	endNum, err := b.blockNumberByHash(*endHash)
+	if err != nil {
+		return nil, err
+	}
+	return b.GetModifiedAccountsByNumber(startNum, &endNum)
+}
+
+// getModifiedAccounts returns the modified accounts of the blocks in `(start, end]`
+// stored by the indexer, sorted and without duplicates
+func (b *Backend) getModifiedAccounts(start, end uint64) ([]common.Address, error) {
+	if b.Indexer == nil {
+		return nil, errors.New("modified accounts are only served by the custom tx indexer")
+	}
+	if start >= end {
+		return nil, fmt.Errorf("start block height (%d) must be less than end block height (%d)", start, end)
+	}
+
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	if end > uint64(latest) {
+		return nil, fmt.Errorf("block %d is greater than the latest block %d", end, latest)
+	}
+	if blockRangeCap := uint64(b.RPCBlockRangeCap()); blockRangeCap > 0 && end-start > blockRangeCap { //#nosec G115 -- the cap is not negative
+		return nil, fmt.Errorf("block range %d exceeds the cap of %d blocks", end-start, blockRangeCap)
+	}
+
+	accounts := []common.Address{}
+	for height := start + 1; height <= end; height++ {
+		blockAccounts, err := b.Indexer.GetModifiedAccountsByBlock(int64(height)) //#nosec G115 -- checked against the latest block already
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, blockAccounts...)
+	}
+	slices.SortFunc(accounts, func(a, b common.Address) int { return a.Cmp(b) })
+	return slices.Compact(accounts), nil
+}
+
+// blockNumberByHash returns the height of the block with the given hash
+func (b *Backend) blockNumberByHash(hash common.Hash) (uint64, error) {
+	block, err := b.TendermintBlockByHash(hash)
+	if err != nil {
+		return 0, err
+	}
+	if block == nil || block.Block == nil {
+		return 0, fmt.Errorf("block %s not found", hash.Hex())
+	}
+	return uint64(block.Block.Height), nil //#nosec G115 -- block heights are not negative
+}
+
+// StorageRangeAt returns the storage entries of a contract, starting from the
+// given key, at the state before the eth tx of the given index in the block is
+// executed. Unlike geth, the storage is not a secure trie, so the entries are
+// ordered by their key instead of the hash of the key, and keyStart is a key.
+func (b *Backend) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	if len(keyStart) > common.HashLength {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("key start %s is longer than %d bytes", keyStart, common.HashLength)
+	}
+	if maxResult < 0 {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("max result cannot be negative, got %d", maxResult)
+	}
+
+	block, err := b.TendermintBlockByHash(blockHash)
+	if err != nil {
+		b.Logger.Debug("block not found", "hash", blockHash.Hex(), "error", err.Error())
+		return rpctypes.StorageRangeResult{}, err
+	}
+	if block == nil || block.Block == nil {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("block %s not found", blockHash.Hex())
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
+	if err != nil {
+		b.Logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(block, blockRes)
+	if txIndex < 0 || txIndex > len(msgs) {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("transaction index %d out of range for block %s", txIndex, blockHash.Hex())
+	}
+
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return rpctypes.StorageRangeResult{}, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.Ctx, &block.Block.Height)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	req := &evmtypes.QueryStorageRangeRequest{
+		Address:         address.Hex(),
+		KeyStart:        common.BytesToHash(keyStart).Hex(),
+		MaxResult:       uint64(maxResult), //#nosec G115 -- checked for negative values already
+		Predecessors:    msgs[:txIndex],
+		BlockNumber:     block.Block.Height,
+		BlockTime:       block.Block.Time,
+		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := block.Block.Height - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+	res, err := b.QueryClient.StorageRange(b.QueryContexts.Context(contextHeight), req)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	result := rpctypes.StorageRangeResult{Storage: make(rpctypes.StorageMap, len(res.Storage))}
+	for _, state := range res.Storage {
+		key := common.HexToHash(state.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(state.Value),
+		}
+	}
+	if res.NextKey != "" {
+		nextKey := common.HexToHash(res.NextKey)
+		result.NextKey = &nextKey
+	}
+	return result, nil
+}
+
+// flatCallTracerName is the geth native tracer which returns the call frames of
+// a tx in the flat (parity) format served by trace_filter
+const flatCallTracerName = "flatCallTracer"
+
+// flatTrace holds the fields of a flat call frame that trace_filter matches on
+type flatTrace struct {
+	Action struct {
+		From           *common.Address `json:"from"`
+		To             *common.Address `json:"to"`
+		SelfDestructed *common.Address `json:"address"`
+		RefundAddress  *common.Address `json:"refundAddress"`
+	} `json:"action"`
+	Result *struct {
+		Address *common.Address `json:"address"`
+	} `json:"result"`
+}
+
+// TraceFilter returns the flat call traces of the blocks in the requested range
+// whose sender and recipient match the filter. The traces of a block are served
+// from the indexer if they were stored by a previous request, otherwise the block
+// is re-executed with the flat call tracer and, if `json-rpc.enable-call-trace-index`
+// is set, the traces are stored in the indexer.
+func (b *Backend) TraceFilter(args rpctypes.TraceFilterArgs) ([]json.RawMessage, error) {
+	if !b.TracingEnabled() {
+		return nil, errTracingDisabled
+	}
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := int64(latest), int64(latest) //#nosec G115 -- checked for int overflow already
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = args.ToBlock.Int64()
+	}
+	if from < 1 {
+		// genesis is not traceable
+		from = 1
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range, from %d is greater than to %d", from, to)
+	}
+	if to > int64(latest) { //#nosec G115 -- checked for int overflow already
+		return nil, fmt.Errorf("block %d is greater than the latest block %d", to, latest)
+	}
+	if blockRangeCap := int64(b.RPCBlockRangeCap()); blockRangeCap > 0 && to-from+1 > blockRangeCap {
+		return nil, fmt.Errorf("block range %d exceeds the cap of %d blocks", to-from+1, blockRangeCap)
+	}
+
+	var after, count uint64
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	results := []json.RawMessage{}
+	for height := from; height <= to; height++ {
+		traces, err := b.blockCallTraces(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range traces {
+			var ft flatTrace
+			if err := json.Unmarshal(trace, &ft); err != nil {
+				return nil, err
+			}
+			if !ft.matches(args.FromAddress, args.ToAddress) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			results = append(results, trace)
+			if count > 0 && uint64(len(results)) == count {
+				return results, nil
+			}
+		}
+	}
+	return results, nil
+}
+
+// blockCallTraces returns the flat call traces of all the eth txs in a block.
+func (b *Backend) blockCallTraces(height int64) ([]json.RawMessage, error) {
+	var bz json.RawMessage
+	if b.Indexer != nil {
+		var err error
+		if bz, err = b.Indexer.GetCallTracesByBlock(height); err != nil {
+			return nil, err
+		}
+	}
+
+	if bz == nil {
+		blk, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if blk == nil || blk.Block == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+		txResults, err := b.TraceBlock(rpctypes.BlockNumber(height), &evmtypes.TraceConfig{Tracer: flatCallTracerName}, blk)
+		if err != nil {
+			return nil, err
+		}
+
+		traces := []interface{}{}
+		for _, txResult := range txResults {
+			if txResult.Error != "" {
+				return nil, fmt.Errorf("failed to trace block %d: %s", height, txResult.Error)
+			}
+			txTraces, ok := txResult.Result.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("unexpected trace result type %T", txResult.Result)
+			}
+			traces = append(traces, txTraces...)
+		}
+		if bz, err = json.Marshal(traces); err != nil {
+			return nil, err
+		}
+
+		if b.Indexer != nil && b.Cfg.JSONRPC.EnableCallTraceIndex {
+			if err := b.Indexer.IndexCallTraces(height, bz); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var traces []json.RawMessage
+	if err := json.Unmarshal(bz, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// matches returns true if the sender of the trace is in fromAddresses and the
+// recipient is in toAddresses, an empty list matches any address.
+func (t *flatTrace) matches(fromAddresses, toAddresses []common.Address) bool {
+	from := t.Action.From
+	to := t.Action.To
+	switch {
+	case t.Action.SelfDestructed != nil:
+		from, to = t.Action.SelfDestructed, t.Action.RefundAddress
+	case to == nil && t.Result != nil:
+		// contract creation
+		to = t.Result.Address
+	}
+	return addressIn(from, fromAddresses) && addressIn(to, toAddresses)
+}
+
+func addressIn(address *common.Address, addresses []common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	return address != nil && slices.Contains(addresses, *address)
+}
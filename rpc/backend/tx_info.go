@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
@@ -164,7 +165,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	txData, err := ethMsg.GetTxData()
 	if err != nil {
 		b.Logger.Error("failed to unpack tx data", "error", err.Error())
 		return nil, err
@@ -190,19 +191,14 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
 	}
 
-	chainID, err := b.ChainID()
+	from, err := rpctypes.DefaultSenderCache.GetSender(ethMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	from, err := ethMsg.GetSenderLegacy(ethtypes.LatestSignerForChainID(chainID.ToInt()))
-	if err != nil {
-		return nil, err
-	}
-
-	// parse tx logs from events
+	// parse tx logs from the tx result
 	msgIndex := int(res.MsgIndex) // #nosec G115 -- checked for int overflow already
-	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
+	logs, err := rpctypes.TxLogsFromResult(blockRes.TxsResults[res.TxIndex], msgIndex)
 	if err != nil {
 		b.Logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
 	}
@@ -302,9 +298,9 @@ func (b *Backend) GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 		return nil, nil
 	}
 
-	// parse tx logs from events
+	// parse tx logs from the tx result
 	index := int(res.MsgIndex) // #nosec G701
-	return TxLogsFromEvents(resBlockResult.TxsResults[res.TxIndex].Events, index)
+	return rpctypes.TxLogsFromResult(resBlockResult.TxsResults[res.TxIndex], index)
 }
 
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
@@ -347,6 +343,226 @@ func (b *Backend) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNum
 	return b.GetTransactionByBlockAndIndex(block, idx)
 }
 
+// GetCosmosTxByEthHash returns the location of the cosmos tx that includes the
+// eth tx identified by the given hash, returns nil if the eth tx is not found.
+func (b *Backend) GetCosmosTxByEthHash(txHash common.Hash) (*rpctypes.CosmosTxResult, error) {
+	res, err := b.GetTxByEthHash(txHash)
+	if err != nil {
+		b.Logger.Debug("tx not found", "hash", txHash.Hex(), "error", err.Error())
+		return nil, nil
+	}
+
+	var cosmosTxHash []byte
+	if b.Indexer != nil {
+		cosmosTxHash, err = b.Indexer.GetCosmosTxHashByEthTxHash(txHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cosmosTxHash == nil {
+		// the txs indexed by older versions or restored from a snapshot have no
+		// cosmos tx hash
+		block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+		if err != nil {
+			return nil, err
+		}
+		if block == nil || block.Block == nil || int(res.TxIndex) >= len(block.Block.Txs) {
+			return nil, fmt.Errorf("cosmos tx of %s not found at block %d", txHash.Hex(), res.Height)
+		}
+		cosmosTxHash = block.Block.Txs[res.TxIndex].Hash()
+	}
+
+	return &rpctypes.CosmosTxResult{
+		Hash:        cosmosTxHash,
+		BlockNumber: hexutil.Uint64(res.Height), //#nosec G115 -- block heights are not negative
+		TxIndex:     hexutil.Uint64(res.TxIndex),
+		MsgIndex:    hexutil.Uint64(res.MsgIndex),
+	}, nil
+}
+
+// GetEthTxsByCosmosHash returns the eth txs included in the cosmos tx identified
+// by the given hash, in the order of their msgs.
+func (b *Backend) GetEthTxsByCosmosHash(hash cmtbytes.HexBytes) ([]*rpctypes.RPCTransaction, error) {
+	var txHashes []common.Hash
+	if b.Indexer != nil {
+		hashes, err := b.Indexer.GetEthTxHashesByCosmosTxHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		txHashes = hashes
+	}
+	if txHashes == nil {
+		// fallback to tendermint tx indexer
+		res, err := b.RPCClient.Tx(b.Ctx, hash, false)
+		if err != nil {
+			b.Logger.Debug("cosmos tx not found", "hash", hash.String(), "error", err.Error())
+			return nil, nil
+		}
+		tx, err := b.ClientCtx.TxConfig.TxDecoder()(res.Tx)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range tx.GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				txHashes = append(txHashes, common.HexToHash(ethMsg.Hash))
+			}
+		}
+	}
+
+	txs := make([]*rpctypes.RPCTransaction, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		tx, err := b.GetTransactionByHash(txHash)
+		if err != nil {
+			return nil, err
+		}
+		if tx != nil {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+// GetTransactionProof returns the merkle proof of the inclusion of the cosmos tx
+// that includes the eth tx identified by the given hash in its block.
+func (b *Backend) GetTransactionProof(txHash common.Hash) (*rpctypes.TransactionProof, error) {
+	res, err := b.GetCosmosTxByEthHash(txHash)
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.BlockNumber)) //#nosec G115 -- int overflow is not a concern here
+	if err != nil {
+		return nil, err
+	}
+	txIndex := int(res.TxIndex) //#nosec G115 -- int overflow is not a concern here
+	if block == nil || block.Block == nil || txIndex >= len(block.Block.Txs) {
+		return nil, fmt.Errorf("cosmos tx of %s not found at block %d", txHash.Hex(), res.BlockNumber)
+	}
+
+	return &rpctypes.TransactionProof{
+		Hash:         txHash,
+		CosmosTxHash: res.Hash,
+		BlockHash:    common.BytesToHash(block.Block.Hash()),
+		BlockNumber:  res.BlockNumber,
+		MsgIndex:     res.MsgIndex,
+		Proof:        block.Block.Txs.Proof(txIndex),
+	}, nil
+}
+
+// addressTxKinds are the names of the kinds of the txs of an address
+var addressTxKinds = []struct {
+	name string
+	kind types.AddressTxKind
+}{
+	{"sent", types.AddressTxSent},
+	{"received", types.AddressTxReceived},
+	{"internal", types.AddressTxInternal},
+}
+
+// GetAddressTransactions returns a page of the eth txs of an address of the
+// requested kinds, newest first, as indexed by the custom tx indexer. The
+// internal txs are the ones whose internal calls were found in the call traces
+// stored by trace_filter.
+func (b *Backend) GetAddressTransactions(args rpctypes.AddressTxsArgs) (*rpctypes.AddressTxsResult, error) {
+	if b.Indexer == nil {
+		return nil, errors.New("the txs of the addresses are only served by the custom tx indexer")
+	}
+
+	kind := types.AddressTxAll
+	if len(args.Kinds) > 0 {
+		kind = 0
+		for _, name := range args.Kinds {
+			k, err := parseAddressTxKind(name)
+			if err != nil {
+				return nil, err
+			}
+			kind |= k
+		}
+	}
+	var limit int
+	if args.Limit != nil {
+		limit = int(*args.Limit)
+	}
+	var cursor []byte
+	if len(args.Cursor) > 0 {
+		cursor = args.Cursor
+	}
+
+	txs, next, err := b.Indexer.GetAddressTxs(args.Address, kind, cursor, explorerPageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	res := &rpctypes.AddressTxsResult{
+		Transactions: make([]rpctypes.AddressTx, 0, len(txs)),
+		NextCursor:   next,
+	}
+	for _, tx := range txs {
+		addressTx := rpctypes.AddressTx{
+			Hash:             tx.Hash,
+			BlockNumber:      hexutil.Uint64(tx.Height),     //#nosec G115 -- the height is positive
+			TransactionIndex: hexutil.Uint64(tx.EthTxIndex), //#nosec G115 -- the index is positive
+			Kinds:            []string{},
+		}
+		for _, k := range addressTxKinds {
+			if tx.Kind&k.kind != 0 {
+				addressTx.Kinds = append(addressTx.Kinds, k.name)
+			}
+		}
+		res.Transactions = append(res.Transactions, addressTx)
+	}
+	return res, nil
+}
+
+// GetTokenTransfers returns the ERC20, ERC721 and ERC1155 transfers sent by or
+// to the given address, or of the token contract at the address, in the
+// requested block range, oldest first.
+func (b *Backend) GetTokenTransfers(args rpctypes.TokenTransfersArgs) ([]*types.TokenTransfer, error) {
+	if b.Indexer == nil {
+		return nil, errors.New("the token transfers are only served by the custom tx indexer")
+	}
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := int64(latest), int64(latest) //#nosec G115 -- checked for int overflow already
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = args.ToBlock.Int64()
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range, from %d is greater than to %d", from, to)
+	}
+	if blockRangeCap := int64(b.RPCBlockRangeCap()); blockRangeCap > 0 && to-from+1 > blockRangeCap {
+		return nil, fmt.Errorf("block range %d exceeds the cap of %d blocks", to-from+1, blockRangeCap)
+	}
+
+	logsCap := int(b.RPCLogsCap())
+	transfers, err := b.Indexer.GetTokenTransfers(args.Address, from, to, logsCap+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(transfers) > logsCap {
+		return nil, fmt.Errorf("query returned more than %d results", logsCap)
+	}
+	if transfers == nil {
+		transfers = []*types.TokenTransfer{}
+	}
+	return transfers, nil
+}
+
+// parseAddressTxKind returns the kind of the txs of an address of the given name
+func parseAddressTxKind(name string) (types.AddressTxKind, error) {
+	for _, k := range addressTxKinds {
+		if k.name == name {
+			return k.kind, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown tx kind %q, expected sent, received or internal", name)
+}
+
 // GetTxByEthHash uses `/tx_query` to find transaction by ethereum tx hash
 // TODO: Don't need to convert once hashing is fixed on Tendermint
 // https://github.com/cometbft/cometbft/issues/6539
@@ -403,7 +619,7 @@ func (b *Backend) QueryTendermintTxIndexer(query string, txGetter func(*rpctypes
 
 	var tx sdk.Tx
 	if txResult.TxResult.Code != 0 {
-		// it's only needed when the tx exceeds block gas limit
+		// it's only needed when the tx exceeds block gas limit or its batch failed
 		tx, err = b.ClientCtx.TxConfig.TxDecoder()(txResult.Tx)
 		if err != nil {
 			return nil, fmt.Errorf("invalid ethereum tx")
@@ -0,0 +1,168 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// defaultUndeployedGas is the gas limit assumed for the validation and the execution of
+// a user operation whose account isn't deployed yet, so that they can't be simulated.
+const defaultUndeployedGas = 100_000
+
+// SupportedEntryPoints returns the EntryPoint the user operations are bundled to.
+func (b *Backend) SupportedEntryPoints() ([]common.Address, error) {
+	if b.Cfg.JSONRPC.BundlerAccount == "" {
+		return nil, errors.New("the bundler endpoints are disabled on this node")
+	}
+	return []common.Address{common.HexToAddress(b.Cfg.JSONRPC.EntryPoint)}, nil
+}
+
+// SendUserOperation bundles the user operation into a handleOps transaction to the
+// EntryPoint, signed by the node's bundler key, and returns the user operation hash.
+func (b *Backend) SendUserOperation(op rpctypes.UserOperation, entryPoint common.Address) (common.Hash, error) {
+	bundler, err := b.checkEntryPoint(entryPoint)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if _, err := b.ClientCtx.Keyring.KeyByAddress(sdk.AccAddress(bundler.Bytes())); err != nil {
+		b.Logger.Error("failed to find bundler key in keyring", "address", bundler, "error", err.Error())
+		return common.Hash{}, fmt.Errorf("failed to find the bundler key in the node's keyring: %w", err)
+	}
+
+	data, err := rpctypes.EntryPointABI.Pack("handleOps", []rpctypes.PackedUserOperation{op.Pack()}, bundler)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	input := hexutil.Bytes(data)
+	args := evmtypes.TransactionArgs{
+		From:  &bundler,
+		To:    &entryPoint,
+		Input: &input,
+	}
+
+	// simulate the bundle so that the invalid user operations are rejected
+	// instead of being paid for by the bundler
+	if _, err := b.DoCall(args, rpctypes.EthPendingBlockNumber); err != nil {
+		return common.Hash{}, fmt.Errorf("user operation validation failed: %w", err)
+	}
+
+	if _, err := b.sendTransaction(args); err != nil {
+		return common.Hash{}, err
+	}
+
+	return op.Hash(entryPoint, b.EvmChainID), nil
+}
+
+// EstimateUserOperationGas estimates the gas limits of the user operation by
+// simulating its validation and execution separately.
+func (b *Backend) EstimateUserOperationGas(op rpctypes.UserOperation, entryPoint common.Address) (*rpctypes.UserOperationGasEstimate, error) {
+	bundler, err := b.checkEntryPoint(entryPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	blockNr := rpctypes.EthPendingBlockNumber
+	code, err := b.GetCode(op.Sender, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+	if err != nil {
+		return nil, err
+	}
+	deployed := len(code) > 0
+
+	var verificationGas uint64
+	if op.Factory != nil && !deployed {
+		factoryData := op.FactoryData
+		gas, err := b.EstimateGas(evmtypes.TransactionArgs{From: &entryPoint, To: op.Factory, Input: &factoryData}, &blockNr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate the account deployment: %w", err)
+		}
+		verificationGas += uint64(gas)
+	}
+
+	packed := op.Pack()
+	userOpHash := op.Hash(entryPoint, b.EvmChainID)
+
+	callGas := uint64(defaultUndeployedGas)
+	if deployed {
+		data, err := rpctypes.EntryPointABI.Pack("validateUserOp", packed, userOpHash, new(big.Int))
+		if err != nil {
+			return nil, err
+		}
+		gas, err := b.estimateFrom(entryPoint, op.Sender, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate the user operation validation: %w", err)
+		}
+		verificationGas += gas
+
+		gas, err = b.estimateFrom(entryPoint, op.Sender, op.CallData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate the user operation execution: %w", err)
+		}
+		callGas = gas
+	} else {
+		verificationGas += defaultUndeployedGas
+	}
+
+	estimate := &rpctypes.UserOperationGasEstimate{
+		VerificationGasLimit: hexutil.Uint64(verificationGas),
+		CallGasLimit:         hexutil.Uint64(callGas),
+	}
+
+	if op.Paymaster != nil {
+		data, err := rpctypes.EntryPointABI.Pack("validatePaymasterUserOp", packed, userOpHash, new(big.Int))
+		if err != nil {
+			return nil, err
+		}
+		gas, err := b.estimateFrom(entryPoint, *op.Paymaster, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate the paymaster validation: %w", err)
+		}
+		paymasterGas := hexutil.Uint64(gas)
+		estimate.PaymasterVerificationGasLimit = &paymasterGas
+	}
+
+	// the pre-verification gas covers the share of the bundle transaction
+	// intrinsic gas of the user operation
+	data, err := rpctypes.EntryPointABI.Pack("handleOps", []rpctypes.PackedUserOperation{packed}, bundler)
+	if err != nil {
+		return nil, err
+	}
+	preVerificationGas, err := core.IntrinsicGas(data, nil, nil, false, true, true, true)
+	if err != nil {
+		return nil, err
+	}
+	estimate.PreVerificationGas = hexutil.Uint64(preVerificationGas)
+
+	return estimate, nil
+}
+
+// checkEntryPoint returns the bundler address, or an error if the bundler
+// endpoints are disabled or the EntryPoint isn't supported.
+func (b *Backend) checkEntryPoint(entryPoint common.Address) (common.Address, error) {
+	if b.Cfg.JSONRPC.BundlerAccount == "" {
+		return common.Address{}, errors.New("the bundler endpoints are disabled on this node")
+	}
+	if supported := common.HexToAddress(b.Cfg.JSONRPC.EntryPoint); entryPoint != supported {
+		return common.Address{}, fmt.Errorf("unsupported entry point %s, expected %s", entryPoint, supported)
+	}
+	return common.HexToAddress(b.Cfg.JSONRPC.BundlerAccount), nil
+}
+
+// estimateFrom estimates the gas of a call from the given address on the pending state.
+func (b *Backend) estimateFrom(from, to common.Address, data []byte) (uint64, error) {
+	input := hexutil.Bytes(data)
+	blockNr := rpctypes.EthPendingBlockNumber
+	gas, err := b.EstimateGas(evmtypes.TransactionArgs{From: &from, To: &to, Input: &input}, &blockNr)
+	return uint64(gas), err
+}
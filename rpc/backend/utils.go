@@ -1,10 +1,11 @@
 package backend
 
 import (
-	"encoding/json"
+	"encoding/base64"
 	"fmt"
 	"math/big"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -46,13 +47,48 @@ func (s sortGasAndReward) Less(i, j int) bool {
 }
 
 // getAccountNonce returns the account nonce for the given account address.
-// If the pending value is true, it will iterate over the mempool (pending)
-// txs in order to compute and return the pending tx sequence.
-// Todo: include the ability to specify a blockNumber
+// If the pending value is true, the nonces of its pending txs in the mempool
+// are included to return the pending tx sequence. The nonces at a given height
+// and the pending nonces are cached by the nonce cache of the backend.
 func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height int64, logger log.Logger) (uint64, error) {
-	queryClient := authtypes.NewQueryClient(b.ClientCtx)
+	// the nonces of the latest height, i.e. 0, aren't cached as it changes
+	var (
+		nonce  uint64
+		cached bool
+	)
+	if height > 0 {
+		nonce, cached = b.Nonces.Nonce(accAddr, height)
+	}
+	if !cached {
+		var err error
+		nonce, err = b.queryAccountNonce(accAddr, height)
+		if err != nil {
+			return 0, err
+		}
+		if height > 0 {
+			b.Nonces.AddNonce(accAddr, height, nonce)
+		}
+	}
+
+	if !pending {
+		return nonce, nil
+	}
+
+	// the account query doesn't include the uncommitted transactions on the nonce
+	// so we need to add the ones of the mempool.
+	if err := b.Nonces.RefreshPending(height, b.pendingNonces); err != nil {
+		logger.Error("failed to fetch pending transactions", "error", err.Error())
+		return nonce, nil
+	}
+	return b.Nonces.PendingNonce(accAddr, nonce), nil
+}
+
+// queryAccountNonce queries the sequence of the account at the given height,
+// returns 0 if the account doesn't exist yet.
+func (b *Backend) queryAccountNonce(accAddr common.Address, height int64) (uint64, error) {
+	queryClient := authtypes.NewQueryClient(b.QueryContexts.Conn(b.ClientCtx))
 	adr := sdk.AccAddress(accAddr.Bytes()).String()
-	ctx := types.ContextWithHeight(height)
+	ctx := b.QueryContexts.Context(height)
 	res, err := queryClient.Account(ctx, &authtypes.QueryAccountRequest{Address: adr})
 	if err != nil {
 		st, ok := status.FromError(err)
@@ -66,23 +102,18 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	if err := b.ClientCtx.InterfaceRegistry.UnpackAny(res.Account, &acc); err != nil {
 		return 0, err
 	}
+	return acc.GetSequence(), nil
+}
 
-	nonce := acc.GetSequence()
-
-	if !pending {
-		return nonce, nil
-	}
-
-	// the account retriever doesn't include the uncommitted transactions on the nonce so we need to
-	// to manually add them.
+// pendingNonces returns the nonces of the eth txs in the mempool by sender.
+func (b *Backend) pendingNonces() (map[common.Address][]uint64, error) {
 	pendingTxs, err := b.PendingTransactions()
 	if err != nil {
-		logger.Error("failed to fetch pending transactions", "error", err.Error())
-		return nonce, nil
+		return nil, err
 	}
 
-	// add the uncommitted txs to the nonce counter
 	// only supports `MsgEthereumTx` style tx
+	nonces := make(map[common.Address][]uint64)
 	for _, tx := range pendingTxs {
 		for _, msg := range (*tx).GetMsgs() {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -91,17 +122,14 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 				break
 			}
 
-			sender, err := ethMsg.GetSenderLegacy(ethtypes.LatestSignerForChainID(b.EvmChainID))
+			sender, err := types.DefaultSenderCache.GetSender(ethMsg)
 			if err != nil {
 				continue
 			}
-			if sender == accAddr {
-				nonce++
-			}
+			nonces[sender] = append(nonces[sender], ethMsg.AsTransaction().Nonce())
 		}
 	}
-
-	return nonce, nil
+	return nonces, nil
 }
 
 func bigMax(x, y *big.Int) *big.Int {
@@ -207,7 +235,7 @@ func (b *Backend) ProcessBlock(
 		}
 		header.GasLimit = uint64(gasLimitUint64)
 		header.GasUsed = gasUsedBig.ToInt().Uint64()
-		ctx := types.ContextWithHeight(blockHeight)
+		ctx := b.QueryContexts.Context(blockHeight)
 		params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
 		if err != nil {
 			return err
@@ -290,58 +318,42 @@ func (b *Backend) ProcessBlock(
 	return nil
 }
 
-// AllTxLogsFromEvents parses all ethereum logs from cosmos events
-func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
-	allLogs := make([][]*ethtypes.Log, 0, 4)
-	for _, event := range events {
-		if event.Type != evmtypes.EventTypeTxLog {
-			continue
-		}
-
-		logs, err := ParseTxLogsFromEvent(event)
-		if err != nil {
-			return nil, err
-		}
-
-		allLogs = append(allLogs, logs)
-	}
-	return allLogs, nil
-}
-
-// TxLogsFromEvents parses ethereum logs from cosmos events for specific msg index
-func TxLogsFromEvents(events []abci.Event, msgIndex int) ([]*ethtypes.Log, error) {
-	for _, event := range events {
-		if event.Type != evmtypes.EventTypeTxLog {
-			continue
-		}
-
-		if msgIndex > 0 {
-			// not the eth tx we want
-			msgIndex--
-			continue
-		}
-
-		return ParseTxLogsFromEvent(event)
-	}
-	return nil, fmt.Errorf("eth tx logs not found for message index %d", msgIndex)
-}
-
-// ParseTxLogsFromEvent parse tx logs from one event
-func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
-	logs := make([]*evmtypes.Log, 0, len(event.Attributes))
+// ParseInternalTxFromEvent parses an evm call committed by a cosmos msg from the
+// event emitted by the evm module, the block fields are left empty.
+func ParseInternalTxFromEvent(event abci.Event) (*types.InternalTransaction, error) {
+	internalTx := &types.InternalTransaction{}
 	for _, attr := range event.Attributes {
-		if attr.Key != evmtypes.AttributeKeyTxLog {
-			continue
-		}
-
-		var txLog evmtypes.Log
-		if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
-			return nil, err
+		switch attr.Key {
+		case evmtypes.AttributeKeySender:
+			internalTx.From = common.HexToAddress(attr.Value)
+		case evmtypes.AttributeKeyRecipient:
+			to := common.HexToAddress(attr.Value)
+			internalTx.To = &to
+		case evmtypes.AttributeKeyContractAddress:
+			contract := common.HexToAddress(attr.Value)
+			internalTx.ContractAddress = &contract
+		case evmtypes.AttributeKeyTxGasUsed:
+			gasUsed, err := strconv.ParseUint(attr.Value, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			internalTx.GasUsed = hexutil.Uint64(gasUsed)
+		case evmtypes.AttributeKeyTxLogs:
+			bz, err := base64.StdEncoding.DecodeString(attr.Value)
+			if err != nil {
+				return nil, err
+			}
+			var logs evmtypes.TransactionLogs
+			if err := logs.Unmarshal(bz); err != nil {
+				return nil, err
+			}
+			internalTx.Logs = evmtypes.LogsToEthereum(logs.Logs)
 		}
-
-		logs = append(logs, &txLog)
 	}
-	return evmtypes.LogsToEthereum(logs), nil
+	if internalTx.Logs == nil {
+		internalTx.Logs = []*ethtypes.Log{}
+	}
+	return internalTx, nil
 }
 
 // ShouldIgnoreGasUsed returns true if the gasUsed in result should be ignored
@@ -354,7 +366,7 @@ func ShouldIgnoreGasUsed(res *abci.ExecTxResult) bool {
 func GetLogsFromBlockResults(blockRes *cmtrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
 	blockLogs := [][]*ethtypes.Log{}
 	for _, txResult := range blockRes.TxsResults {
-		logs, err := AllTxLogsFromEvents(txResult.Events)
+		logs, err := types.AllTxLogsFromResult(txResult)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,165 @@
+// Package contractmetadata verifies and stores the Solidity metadata of the
+// contracts, with their sources, submitted to the node. A metadata is accepted
+// only if its IPFS hash is the one the compiler appended to the code of the
+// contract, so that the explorers can show the verified sources of the
+// contracts of the chain without an external verification service.
+package contractmetadata
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// MaxMetadataSize is the max size of the metadata whose IPFS hash is computed
+// by the node, which is the size of the chunks of the IPFS files above which
+// they're split into several nodes.
+const MaxMetadataSize = 256 * 1024
+
+// ErrNoMetadataHash is returned for the codes without the IPFS hash of their
+// metadata, e.g. the codes compiled with the metadata hash disabled.
+var ErrNoMetadataHash = errors.New("code without an IPFS metadata hash")
+
+// ipfsKey is the CBOR encoding of the "ipfs" key of the map appended to the
+// code by the compiler, followed by the header of its 34 bytes value.
+var ipfsKey = []byte{0x64, 'i', 'p', 'f', 's', 0x58, 0x22}
+
+// metadata are the fields of the Solidity metadata checked or returned by the
+// node.
+type metadata struct {
+	Language string `json:"language"`
+	Compiler struct {
+		Version string `json:"version"`
+	} `json:"compiler"`
+	Settings struct {
+		CompilationTarget map[string]string `json:"compilationTarget"`
+	} `json:"settings"`
+	Sources map[string]struct {
+		Keccak256 string  `json:"keccak256"`
+		Content   *string `json:"content"`
+	} `json:"sources"`
+	Output struct {
+		ABI json.RawMessage `json:"abi"`
+	} `json:"output"`
+}
+
+// MetadataHash returns the IPFS multihash of the metadata of the code, which
+// the compiler appends to the code in a CBOR map followed by its length.
+func MetadataHash(code []byte) ([]byte, error) {
+	if len(code) < 2 {
+		return nil, ErrNoMetadataHash
+	}
+	size := int(binary.BigEndian.Uint16(code[len(code)-2:]))
+	if size+2 > len(code) {
+		return nil, ErrNoMetadataHash
+	}
+	cbor := code[len(code)-2-size : len(code)-2]
+
+	i := bytes.Index(cbor, ipfsKey)
+	if i < 0 || len(cbor) < i+len(ipfsKey)+34 {
+		return nil, ErrNoMetadataHash
+	}
+	start := i + len(ipfsKey)
+	return cbor[start : start+34], nil
+}
+
+// IPFSHash returns the IPFS multihash of a file of up to MaxMetadataSize bytes,
+// as computed by the compiler: the sha256 multihash of the protobuf encoding
+// of the file as a single UnixFS node.
+func IPFSHash(file []byte) ([]byte, error) {
+	if len(file) > MaxMetadataSize {
+		return nil, fmt.Errorf("file of %d bytes above the max size of %d bytes", len(file), MaxMetadataSize)
+	}
+
+	// the UnixFS data of type file, with the file and its size
+	unixfs := []byte{0x08, 0x02}
+	if len(file) > 0 {
+		unixfs = append(unixfs, 0x12)
+		unixfs = binary.AppendUvarint(unixfs, uint64(len(file)))
+		unixfs = append(unixfs, file...)
+	}
+	unixfs = append(unixfs, 0x18)
+	unixfs = binary.AppendUvarint(unixfs, uint64(len(file)))
+
+	// the DAG node with the UnixFS data and without links
+	node := binary.AppendUvarint([]byte{0x0a}, uint64(len(unixfs)))
+	node = append(node, unixfs...)
+
+	sum := sha256.Sum256(node)
+	return append([]byte{0x12, 0x20}, sum[:]...), nil
+}
+
+// Verify checks that the metadata is the one whose hash is appended to the
+// code, and that the sources match the hashes listed by the metadata. Every
+// listed source must be either embedded in the metadata or given. It returns
+// the contract metadata to store.
+func Verify(code []byte, rawMetadata string, sources map[string]string) (*rpctypes.ContractMetadata, error) {
+	hash, err := MetadataHash(code)
+	if err != nil {
+		return nil, err
+	}
+	metadataHash, err := IPFSHash([]byte(rawMetadata))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hash, metadataHash) {
+		return nil, errors.New("metadata hash doesn't match the hash appended to the code")
+	}
+
+	var m metadata
+	if err := json.Unmarshal([]byte(rawMetadata), &m); err != nil {
+		return nil, fmt.Errorf("invalid metadata: %w", err)
+	}
+	for path, source := range sources {
+		listed, ok := m.Sources[path]
+		if !ok {
+			return nil, fmt.Errorf("source %s not listed by the metadata", path)
+		}
+		if crypto.Keccak256Hash([]byte(source)) != common.HexToHash(listed.Keccak256) {
+			return nil, fmt.Errorf("hash of source %s doesn't match the metadata", path)
+		}
+	}
+	for path, listed := range m.Sources {
+		if _, ok := sources[path]; !ok && listed.Content == nil {
+			return nil, fmt.Errorf("missing source %s", path)
+		}
+	}
+
+	res := &rpctypes.ContractMetadata{
+		CodeHash: crypto.Keccak256Hash(code),
+		Compiler: m.Compiler.Version,
+		Language: m.Language,
+		Metadata: rawMetadata,
+		Sources:  sources,
+	}
+	// the compilation target has the single compiled contract
+	for _, name := range m.Settings.CompilationTarget {
+		res.ContractName = name
+	}
+	return res, nil
+}
+
+// ABI returns the ABI of the contract listed by its metadata.
+func ABI(rawMetadata string) (*abi.ABI, error) {
+	var m metadata
+	if err := json.Unmarshal([]byte(rawMetadata), &m); err != nil {
+		return nil, fmt.Errorf("invalid metadata: %w", err)
+	}
+	if len(m.Output.ABI) == 0 {
+		return nil, errors.New("metadata without an ABI")
+	}
+	contractABI, err := abi.JSON(bytes.NewReader(m.Output.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("invalid metadata ABI: %w", err)
+	}
+	return &contractABI, nil
+}
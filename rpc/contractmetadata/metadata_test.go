@@ -0,0 +1,147 @@
+package contractmetadata_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/rpc/contractmetadata"
+)
+
+const source = "// SPDX-License-Identifier: MIT\npragma solidity ^0.8.20;\ncontract Counter { uint256 public count; }\n"
+
+// metadataOf returns a metadata of the Counter contract listing the given
+// source hash.
+func metadataOf(sourceHash common.Hash) string {
+	return fmt.Sprintf(
+		`{"compiler":{"version":"0.8.20+commit.a1b79de6"},"language":"Solidity","output":{},`+
+			`"settings":{"compilationTarget":{"Counter.sol":"Counter"}},`+
+			`"sources":{"Counter.sol":{"keccak256":"%s","urls":[]}},"version":1}`,
+		sourceHash.Hex(),
+	)
+}
+
+// codeOf returns a runtime code followed by the CBOR map appended by the
+// compiler, with the IPFS hash of the given metadata.
+func codeOf(t *testing.T, metadata string) []byte {
+	t.Helper()
+
+	hash, err := contractmetadata.IPFSHash([]byte(metadata))
+	require.NoError(t, err)
+
+	code := common.FromHex("0x6080604052348015600e575f80fd5b50")
+	code = append(code, 0xa2, 0x64, 'i', 'p', 'f', 's', 0x58, 0x22)
+	code = append(code, hash...)
+	code = append(code, 0x64, 's', 'o', 'l', 'c', 0x43, 0x00, 0x08, 0x14)
+	return append(code, 0x00, 0x33)
+}
+
+func TestIPFSHash(t *testing.T) {
+	testCases := []struct {
+		file string
+		cid  string
+	}{
+		{"", "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH"},
+		{"hello world", "Qmf412jQZiuVUtdgnB36FXFX7xg5V6KEbSJ4dpQuhkLyfD"},
+		{"hello world\n", "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"},
+	}
+	for _, tc := range testCases {
+		hash, err := contractmetadata.IPFSHash([]byte(tc.file))
+		require.NoError(t, err)
+		require.Equal(t, tc.cid, base58.Encode(hash), "file %q", tc.file)
+	}
+
+	_, err := contractmetadata.IPFSHash(make([]byte, contractmetadata.MaxMetadataSize+1))
+	require.ErrorContains(t, err, "above the max size")
+}
+
+func TestMetadataHash(t *testing.T) {
+	metadata := metadataOf(crypto.Keccak256Hash([]byte(source)))
+	hash, err := contractmetadata.MetadataHash(codeOf(t, metadata))
+	require.NoError(t, err)
+	expected, err := contractmetadata.IPFSHash([]byte(metadata))
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(expected), hex.EncodeToString(hash))
+
+	for _, code := range [][]byte{
+		nil,
+		{0x00},
+		common.FromHex("0x6080604052"),
+		// a CBOR map of the swarm hash of the older compilers
+		common.FromHex("0xa165627a7a72305820" + common.Bytes2Hex(make([]byte, 32)) + "0029"),
+	} {
+		_, err := contractmetadata.MetadataHash(code)
+		require.ErrorIs(t, err, contractmetadata.ErrNoMetadataHash)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	metadata := metadataOf(crypto.Keccak256Hash([]byte(source)))
+	code := codeOf(t, metadata)
+
+	m, err := contractmetadata.Verify(code, metadata, map[string]string{"Counter.sol": source})
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash(code), m.CodeHash)
+	require.Equal(t, "Counter", m.ContractName)
+	require.Equal(t, "0.8.20+commit.a1b79de6", m.Compiler)
+	require.Equal(t, "Solidity", m.Language)
+	require.Equal(t, metadata, m.Metadata)
+
+	testCases := []struct {
+		name     string
+		metadata string
+		sources  map[string]string
+		errMsg   string
+	}{
+		{"other metadata", metadataOf(common.Hash{}), map[string]string{"Counter.sol": source}, "metadata hash doesn't match"},
+		{"reformatted metadata", " " + metadata, map[string]string{"Counter.sol": source}, "metadata hash doesn't match"},
+		{"missing source", metadata, nil, "missing source Counter.sol"},
+		{"modified source", metadata, map[string]string{"Counter.sol": source + " "}, "hash of source Counter.sol doesn't match"},
+		{"unlisted source", metadata, map[string]string{"Counter.sol": source, "Other.sol": ""}, "source Other.sol not listed"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := contractmetadata.Verify(code, tc.metadata, tc.sources)
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := contractmetadata.NewStore(dbm.NewMemDB())
+
+	metadata := metadataOf(crypto.Keccak256Hash([]byte(source)))
+	code := codeOf(t, metadata)
+	m, err := contractmetadata.Verify(code, metadata, map[string]string{"Counter.sol": source})
+	require.NoError(t, err)
+
+	stored, err := store.Get(m.CodeHash)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+
+	require.NoError(t, store.Set(m))
+	stored, err = store.Get(crypto.Keccak256Hash(code))
+	require.NoError(t, err)
+	require.Equal(t, m, stored)
+}
+
+func TestABI(t *testing.T) {
+	contractABI, err := contractmetadata.ABI(`{"output":{"abi":[{"anonymous":false,"inputs":[` +
+		`{"indexed":true,"name":"from","type":"address"},{"indexed":false,"name":"count","type":"uint256"}],` +
+		`"name":"Incremented","type":"event"}]}}`)
+	require.NoError(t, err)
+	require.Contains(t, contractABI.Events, "Incremented")
+
+	_, err = contractmetadata.ABI(metadataOf(common.Hash{}))
+	require.ErrorContains(t, err, "metadata without an ABI")
+	_, err = contractmetadata.ABI(`{"output":{"abi":[{"type":"event","inputs":[{"type":"foo"}]}]}}`)
+	require.Error(t, err)
+	_, err = contractmetadata.ABI("{")
+	require.ErrorContains(t, err, "invalid metadata")
+}
@@ -0,0 +1,55 @@
+package contractmetadata
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	dbm "github.com/cosmos/cosmos-db"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Store is the database of the contract metadata submitted to the node, keyed
+// by the hash of the code of the contracts, so that the contracts deployed
+// with the same code share their metadata.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore returns the store of the contract metadata of the database.
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// OpenDB opens the database of the contract metadata, using the same db backend
+// as the main app.
+func OpenDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmmetadata", backendType, dataDir)
+}
+
+// Get returns the metadata of the contracts of the code of the given hash, nil
+// if none was submitted.
+func (s *Store) Get(codeHash common.Hash) (*rpctypes.ContractMetadata, error) {
+	bz, err := s.db.Get(codeHash.Bytes())
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var m rpctypes.ContractMetadata
+	if err := json.Unmarshal(bz, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Set stores the metadata of the contracts of its code, replacing the metadata
+// previously submitted for the code, if any.
+func (s *Store) Set(m *rpctypes.ContractMetadata) error {
+	bz, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return s.db.SetSync(m.CodeHash.Bytes(), bz)
+}
@@ -0,0 +1,296 @@
+// Package eventsig decodes the events of the logs, with the ABI of their
+// contract or with a registry of the event signatures, indexed by their topic,
+// of the well-known contracts and of the precompiles, which the node operators
+// can extend with their own events.
+package eventsig
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// embeddedSignatures are the event declarations known by every node
+//
+//go:embed signatures.txt
+var embeddedSignatures string
+
+// Registry is a dictionary of events by the topic of their signature. The
+// events sharing a signature, e.g. the Transfer events of ERC20 and ERC721,
+// are told apart by their number of indexed params.
+type Registry struct {
+	events map[common.Hash][]abi.Event
+}
+
+// NewRegistry returns the registry of the embedded event signatures, extended
+// with the event declarations of the given file, if any, which take precedence.
+func NewRegistry(file string) (*Registry, error) {
+	r := &Registry{events: make(map[common.Hash][]abi.Event)}
+	if file != "" {
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read event signatures file: %w", err)
+		}
+		if err := r.add(string(bz)); err != nil {
+			return nil, fmt.Errorf("event signatures file %s: %w", file, err)
+		}
+	}
+	if err := r.add(embeddedSignatures); err != nil {
+		return nil, fmt.Errorf("embedded event signatures: %w", err)
+	}
+	return r, nil
+}
+
+// Event returns the registered event of the log.
+func (r *Registry) Event(log *ethtypes.Log) (abi.Event, bool) {
+	if len(log.Topics) == 0 {
+		return abi.Event{}, false
+	}
+	for _, event := range r.events[log.Topics[0]] {
+		if matches(event, log) {
+			return event, true
+		}
+	}
+	return abi.Event{}, false
+}
+
+// add registers the events declared one per line, the empty lines and the
+// comments starting with # being skipped. An event is ignored if one with the
+// same signature and number of indexed params is already registered.
+func (r *Registry) add(declarations string) error {
+	for i, line := range strings.Split(declarations, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		event, err := ParseEvent(line)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+		registered := r.events[event.ID]
+		if !containsIndexing(registered, indexedCount(event)) {
+			r.events[event.ID] = append(registered, event)
+		}
+	}
+	return nil
+}
+
+// ParseEvent parses a Solidity event declaration, e.g.
+// `event Transfer(address indexed from, address indexed to, uint256 value)`.
+// The names of the params are optional, and the tuples are declared with the
+// types of their components, e.g. `(string,uint256)[] amount`.
+func ParseEvent(declaration string) (abi.Event, error) {
+	decl := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(declaration), ";"))
+	decl = strings.TrimSpace(strings.TrimPrefix(decl, "event "))
+	open := strings.Index(decl, "(")
+	if open <= 0 || !strings.HasSuffix(decl, ")") {
+		return abi.Event{}, fmt.Errorf("invalid event declaration %q", declaration)
+	}
+	name := strings.TrimSpace(decl[:open])
+
+	params := splitParams(decl[open+1 : len(decl)-1])
+	types := make([]string, len(params))
+	names := make([]string, len(params))
+	indexed := make([]bool, len(params))
+	for i, param := range params {
+		fields := strings.Fields(param)
+		if len(fields) == 0 {
+			return abi.Event{}, fmt.Errorf("empty param in event declaration %q", declaration)
+		}
+		types[i], fields = fields[0], fields[1:]
+		if len(fields) > 0 && fields[0] == "indexed" {
+			indexed[i], fields = true, fields[1:]
+		}
+		switch len(fields) {
+		case 0:
+		case 1:
+			names[i] = fields[0]
+		default:
+			return abi.Event{}, fmt.Errorf("invalid param %q in event declaration %q", param, declaration)
+		}
+	}
+
+	selector, err := abi.ParseSelector(name + "(" + strings.Join(types, ",") + ")")
+	if err != nil {
+		return abi.Event{}, fmt.Errorf("invalid event declaration %q: %w", declaration, err)
+	}
+	inputs := make(abi.Arguments, len(selector.Inputs))
+	for i, input := range selector.Inputs {
+		typ, err := abi.NewType(input.Type, "", input.Components)
+		if err != nil {
+			return abi.Event{}, fmt.Errorf("invalid type %q in event declaration %q: %w", types[i], declaration, err)
+		}
+		inputs[i] = abi.Argument{Name: names[i], Type: typ, Indexed: indexed[i]}
+	}
+	return abi.NewEvent(name, name, false, inputs), nil
+}
+
+// ContractEvent returns the event of the log declared by the ABI of its
+// contract.
+func ContractEvent(contractABI *abi.ABI, log *ethtypes.Log) (abi.Event, bool) {
+	if contractABI == nil || len(log.Topics) == 0 {
+		return abi.Event{}, false
+	}
+	event, err := contractABI.EventByID(log.Topics[0])
+	if err != nil || !matches(*event, log) {
+		return abi.Event{}, false
+	}
+	return *event, true
+}
+
+// Decode decodes the params of the event of the log.
+func Decode(event abi.Event, log *ethtypes.Log) (*rpctypes.DecodedEvent, error) {
+	if !matches(event, log) {
+		return nil, errors.New("log doesn't match the event")
+	}
+	values, err := event.Inputs.NonIndexed().UnpackValues(log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unpack %s data: %w", event.Sig, err)
+	}
+
+	decoded := &rpctypes.DecodedEvent{
+		Name:      event.RawName,
+		Signature: event.Sig,
+		Params:    make([]rpctypes.DecodedParam, len(event.Inputs)),
+	}
+	topics := log.Topics[1:]
+	for i, input := range event.Inputs {
+		param := rpctypes.DecodedParam{Name: input.Name, Type: input.Type.String(), Indexed: input.Indexed}
+		if input.Indexed {
+			param.Value, err = decodeTopic(input.Type, topics[0])
+			if err != nil {
+				return nil, fmt.Errorf("unpack %s topic: %w", event.Sig, err)
+			}
+			topics = topics[1:]
+		} else {
+			param.Value = formatValue(values[0])
+			values = values[1:]
+		}
+		decoded.Params[i] = param
+	}
+	return decoded, nil
+}
+
+// matches returns whether the log is one of the event, with a topic for each
+// of its indexed params
+func matches(event abi.Event, log *ethtypes.Log) bool {
+	return !event.Anonymous && len(log.Topics) > 0 && log.Topics[0] == event.ID &&
+		len(log.Topics)-1 == indexedCount(event)
+}
+
+func indexedCount(event abi.Event) int {
+	return len(event.Inputs) - len(event.Inputs.NonIndexed())
+}
+
+func containsIndexing(events []abi.Event, indexed int) bool {
+	for _, event := range events {
+		if indexedCount(event) == indexed {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeTopic decodes an indexed param, the topic of the dynamic types and of
+// the tuples and arrays being the hash of their value
+func decodeTopic(typ abi.Type, topic common.Hash) (interface{}, error) {
+	switch typ.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return topic.Hex(), nil
+	}
+	values, err := abi.Arguments{{Type: typ}}.UnpackValues(topic.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return formatValue(values[0]), nil
+}
+
+// formatValue returns the JSON value of an unpacked param: the integers as
+// decimal strings, the addresses, hashes and bytes as hex strings, and the
+// tuples as objects keyed by the names of their components
+func formatValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Bytes(v)
+	case bool, string:
+		return v
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(bz), rv)
+			return hexutil.Bytes(bz)
+		}
+		return formatElems(rv)
+	case reflect.Slice:
+		return formatElems(rv)
+	case reflect.Struct:
+		fields := make(map[string]interface{}, rv.NumField())
+		for i := range rv.NumField() {
+			name := rv.Type().Field(i).Tag.Get("json")
+			if name == "" {
+				name = rv.Type().Field(i).Name
+			}
+			fields[name] = formatValue(rv.Field(i).Interface())
+		}
+		return fields
+	}
+	return value
+}
+
+func formatElems(rv reflect.Value) []interface{} {
+	elems := make([]interface{}, rv.Len())
+	for i := range elems {
+		elems[i] = formatValue(rv.Index(i).Interface())
+	}
+	return elems
+}
+
+// splitParams splits the params of an event declaration at the commas outside
+// of the tuples
+func splitParams(params string) []string {
+	if strings.TrimSpace(params) == "" {
+		return nil
+	}
+	var res []string
+	depth, start := 0, 0
+	for i, c := range params {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				res = append(res, params[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(res, params[start:])
+}
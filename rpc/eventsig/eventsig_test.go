@@ -0,0 +1,239 @@
+package eventsig_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/eventsig"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+var (
+	from = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	to   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+func transferTopic() common.Hash {
+	return crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+}
+
+func TestParseEvent(t *testing.T) {
+	testCases := []struct {
+		declaration string
+		sig         string
+		expPass     bool
+	}{
+		{"Transfer(address indexed from, address indexed to, uint256 value)", "Transfer(address,address,uint256)", true},
+		{"  event Transfer(address indexed, address indexed, uint256);  ", "Transfer(address,address,uint256)", true},
+		{"Deposit(address indexed depositor, uint64 proposalId, (string,uint256)[] amount)", "Deposit(address,uint64,(string,uint256)[])", true},
+		{"BeforeExecution()", "BeforeExecution()", true},
+		{"Transfer", "", false},
+		{"(address from)", "", false},
+		{"Transfer(address from to)", "", false},
+		{"Transfer(address from,)", "", false},
+		{"Transfer(uint value)", "", false},
+		{"Transfer(foo value)", "", false},
+	}
+	for _, tc := range testCases {
+		event, err := eventsig.ParseEvent(tc.declaration)
+		if !tc.expPass {
+			require.Error(t, err, tc.declaration)
+			continue
+		}
+		require.NoError(t, err, tc.declaration)
+		require.Equal(t, tc.sig, event.Sig)
+		require.Equal(t, crypto.Keccak256Hash([]byte(tc.sig)), event.ID)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry, err := eventsig.NewRegistry("")
+	require.NoError(t, err)
+
+	// the Transfer events of ERC20 and ERC721 are told apart by their indexed params
+	erc20 := &ethtypes.Log{Topics: []common.Hash{transferTopic(), common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())}}
+	event, ok := registry.Event(erc20)
+	require.True(t, ok)
+	require.Equal(t, "value", event.Inputs[2].Name)
+	require.False(t, event.Inputs[2].Indexed)
+
+	erc721 := &ethtypes.Log{Topics: append(erc20.Topics, common.BigToHash(big.NewInt(7)))}
+	event, ok = registry.Event(erc721)
+	require.True(t, ok)
+	require.Equal(t, "tokenId", event.Inputs[2].Name)
+	require.True(t, event.Inputs[2].Indexed)
+
+	_, ok = registry.Event(&ethtypes.Log{Topics: erc20.Topics[:1]})
+	require.False(t, ok)
+	_, ok = registry.Event(&ethtypes.Log{Topics: []common.Hash{crypto.Keccak256Hash([]byte("Ping(uint256)"))}})
+	require.False(t, ok)
+	_, ok = registry.Event(&ethtypes.Log{})
+	require.False(t, ok)
+
+	// the events of the file extend the embedded ones and take precedence
+	file := filepath.Join(t.TempDir(), "signatures.txt")
+	require.NoError(t, os.WriteFile(file, []byte("# custom events\n\nPing(uint256 count)\nTransfer(address indexed sender, address indexed receiver, uint256 amount)\n"), 0o600))
+	registry, err = eventsig.NewRegistry(file)
+	require.NoError(t, err)
+	_, ok = registry.Event(&ethtypes.Log{Topics: []common.Hash{crypto.Keccak256Hash([]byte("Ping(uint256)"))}})
+	require.True(t, ok)
+	event, ok = registry.Event(erc20)
+	require.True(t, ok)
+	require.Equal(t, "amount", event.Inputs[2].Name)
+
+	require.NoError(t, os.WriteFile(file, []byte("Ping(uint256 count)\nPong(\n"), 0o600))
+	_, err = eventsig.NewRegistry(file)
+	require.ErrorContains(t, err, "line 2")
+
+	_, err = eventsig.NewRegistry(filepath.Join(t.TempDir(), "missing.txt"))
+	require.Error(t, err)
+}
+
+func TestDecode(t *testing.T) {
+	registry, err := eventsig.NewRegistry("")
+	require.NoError(t, err)
+
+	// gov precompile Deposit event, with a tuple array
+	deposit, err := eventsig.ParseEvent("Deposit(address indexed depositor, uint64 proposalId, (string,uint256)[] amount)")
+	require.NoError(t, err)
+	depositData, err := deposit.Inputs.NonIndexed().Pack(uint64(3), []struct {
+		Name0 string
+		Name1 *big.Int
+	}{{"aatom", big.NewInt(100)}})
+	require.NoError(t, err)
+
+	// ics20 precompile IBCTransfer event, with an indexed string
+	ibcTransfer, err := eventsig.ParseEvent("IBCTransfer(address indexed sender, string indexed receiver, string sourcePort, string sourceChannel, string denom, uint256 amount, string memo)")
+	require.NoError(t, err)
+	ibcTransferData, err := ibcTransfer.Inputs.NonIndexed().Pack("transfer", "channel-0", "aatom", big.NewInt(5), "")
+	require.NoError(t, err)
+	receiverHash := crypto.Keccak256Hash([]byte("cosmos1receiver"))
+
+	testCases := []struct {
+		name   string
+		event  func() abi.Event
+		log    *ethtypes.Log
+		exp    *rpctypes.DecodedEvent
+		expErr string
+	}{
+		{
+			"ERC20 transfer",
+			func() abi.Event {
+				return mustEvent(t, registry, &ethtypes.Log{Topics: make([]common.Hash, 3)}, transferTopic())
+			},
+			&ethtypes.Log{
+				Topics: []common.Hash{transferTopic(), common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+				Data:   common.BigToHash(big.NewInt(1000)).Bytes(),
+			},
+			&rpctypes.DecodedEvent{
+				Name:      "Transfer",
+				Signature: "Transfer(address,address,uint256)",
+				Params: []rpctypes.DecodedParam{
+					{Name: "from", Type: "address", Indexed: true, Value: from.Hex()},
+					{Name: "to", Type: "address", Indexed: true, Value: to.Hex()},
+					{Name: "value", Type: "uint256", Value: "1000"},
+				},
+			},
+			"",
+		},
+		{
+			"ERC721 transfer",
+			func() abi.Event {
+				return mustEvent(t, registry, &ethtypes.Log{Topics: make([]common.Hash, 4)}, transferTopic())
+			},
+			&ethtypes.Log{
+				Topics: []common.Hash{transferTopic(), common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes()), common.BigToHash(big.NewInt(7))},
+			},
+			&rpctypes.DecodedEvent{
+				Name:      "Transfer",
+				Signature: "Transfer(address,address,uint256)",
+				Params: []rpctypes.DecodedParam{
+					{Name: "from", Type: "address", Indexed: true, Value: from.Hex()},
+					{Name: "to", Type: "address", Indexed: true, Value: to.Hex()},
+					{Name: "tokenId", Type: "uint256", Indexed: true, Value: "7"},
+				},
+			},
+			"",
+		},
+		{
+			"tuple array",
+			func() abi.Event { return deposit },
+			&ethtypes.Log{Topics: []common.Hash{deposit.ID, common.BytesToHash(from.Bytes())}, Data: depositData},
+			&rpctypes.DecodedEvent{
+				Name:      "Deposit",
+				Signature: "Deposit(address,uint64,(string,uint256)[])",
+				Params: []rpctypes.DecodedParam{
+					{Name: "depositor", Type: "address", Indexed: true, Value: from.Hex()},
+					{Name: "proposalId", Type: "uint64", Value: "3"},
+					{Name: "amount", Type: "(string,uint256)[]", Value: []interface{}{
+						map[string]interface{}{"name0": "aatom", "name1": "100"},
+					}},
+				},
+			},
+			"",
+		},
+		{
+			"indexed string",
+			func() abi.Event { return ibcTransfer },
+			&ethtypes.Log{Topics: []common.Hash{ibcTransfer.ID, common.BytesToHash(from.Bytes()), receiverHash}, Data: ibcTransferData},
+			&rpctypes.DecodedEvent{
+				Name:      "IBCTransfer",
+				Signature: "IBCTransfer(address,string,string,string,string,uint256,string)",
+				Params: []rpctypes.DecodedParam{
+					{Name: "sender", Type: "address", Indexed: true, Value: from.Hex()},
+					{Name: "receiver", Type: "string", Indexed: true, Value: receiverHash.Hex()},
+					{Name: "sourcePort", Type: "string", Value: "transfer"},
+					{Name: "sourceChannel", Type: "string", Value: "channel-0"},
+					{Name: "denom", Type: "string", Value: "aatom"},
+					{Name: "amount", Type: "uint256", Value: "5"},
+					{Name: "memo", Type: "string", Value: ""},
+				},
+			},
+			"",
+		},
+		{
+			"fail - indexed params mismatch",
+			func() abi.Event { return deposit },
+			&ethtypes.Log{Topics: []common.Hash{deposit.ID}, Data: depositData},
+			nil,
+			"doesn't match",
+		},
+		{
+			"fail - invalid data",
+			func() abi.Event { return deposit },
+			&ethtypes.Log{Topics: []common.Hash{deposit.ID, common.BytesToHash(from.Bytes())}, Data: hexutil.MustDecode("0x01")},
+			nil,
+			"unpack",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, err := eventsig.Decode(tc.event(), tc.log)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, decoded)
+		})
+	}
+}
+
+// mustEvent returns the registered event of a log with the topics of the given
+// one, the first one being set to topic.
+func mustEvent(t *testing.T, registry *eventsig.Registry, log *ethtypes.Log, topic common.Hash) abi.Event {
+	t.Helper()
+	log.Topics[0] = topic
+	event, ok := registry.Event(log)
+	require.True(t, ok)
+	return event
+}
@@ -0,0 +1,100 @@
+// Package admin implements the admin namespace, the runtime tunables of the
+// node which are adjusted without a restart: the garbage collector, the log
+// level, the tracing queries and the caches of the JSON-RPC server.
+//
+// The namespace can only be enabled with the API keys, and its methods are only
+// served to the API keys whose tier lists them explicitly, see
+// `json-rpc.api-tiers`.
+package admin
+
+import (
+	"errors"
+	"runtime/debug"
+	"runtime/metrics"
+
+	"github.com/rs/zerolog"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// gcPercentMetric is the runtime metric of the garbage collection target
+// percentage
+const gcPercentMetric = "/gc/gogc:percent"
+
+// API is the collection of the admin APIs.
+type API struct {
+	logger  log.Logger
+	backend backend.AdminBackend
+}
+
+// NewAPI creates a new API definition for the admin methods.
+func NewAPI(logger log.Logger, backend backend.AdminBackend) *API {
+	return &API{
+		logger:  logger.With("module", "admin"),
+		backend: backend,
+	}
+}
+
+// RuntimeSettings returns the current values of the runtime tunables.
+func (a *API) RuntimeSettings() *rpctypes.RuntimeSettings {
+	a.logger.Debug("admin_runtimeSettings")
+	sample := []metrics.Sample{{Name: gcPercentMetric}}
+	metrics.Read(sample)
+
+	return &rpctypes.RuntimeSettings{
+		GCPercent:   int(sample[0].Value.Uint64()), //nolint:gosec // G115 // the percentage won't exceed int
+		MemoryLimit: debug.SetMemoryLimit(-1),
+		LogLevel:    zerolog.GlobalLevel().String(),
+		Tracing:     a.backend.TracingEnabled(),
+		CacheSizes:  a.backend.CacheSizes(),
+	}
+}
+
+// SetGCPercent sets the garbage collection target percentage, and returns the
+// previous one. A negative percentage disables the garbage collector.
+func (a *API) SetGCPercent(v int) int {
+	a.logger.Info("admin_setGCPercent", "percent", v)
+	return debug.SetGCPercent(v)
+}
+
+// SetMemoryLimit sets the soft memory limit of the Go runtime, in bytes, and
+// returns the previous one. A negative limit doesn't change the limit.
+func (a *API) SetMemoryLimit(limit int64) int64 {
+	a.logger.Info("admin_setMemoryLimit", "limit", limit)
+	return debug.SetMemoryLimit(limit)
+}
+
+// SetLogLevel sets the minimum level of the logs of the node, e.g. "warn", and
+// returns the previous one. The logs below the level set with --log_level are
+// skipped regardless, so the level can be raised to quiet the node, and then
+// lowered back to the configured one.
+func (a *API) SetLogLevel(level string) (string, error) {
+	a.logger.Info("admin_setLogLevel", "level", level)
+	lvl, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return "", err
+	}
+	if lvl == zerolog.NoLevel {
+		return "", errors.New("empty log level")
+	}
+	prev := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(lvl)
+	return prev.String(), nil
+}
+
+// SetTracing enables or disables the debug_trace* and trace_* queries, which
+// re-execute the txs of the node, and returns the previous setting.
+func (a *API) SetTracing(enabled bool) bool {
+	a.logger.Info("admin_setTracing", "enabled", enabled)
+	return a.backend.SetTracingEnabled(enabled)
+}
+
+// SetCacheSize resizes a cache of the JSON-RPC server, "nonces", "senders" or
+// "query-contexts", dropping its entries, and returns its previous size.
+func (a *API) SetCacheSize(name string, size int) (int, error) {
+	a.logger.Info("admin_setCacheSize", "name", name, "size", size)
+	return a.backend.SetCacheSize(name, size)
+}
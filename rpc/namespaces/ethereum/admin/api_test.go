@@ -0,0 +1,90 @@
+package admin
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+func newAPI() *API {
+	return NewAPI(log.NewNopLogger(), &backend.Backend{
+		Nonces:        rpctypes.NewNonceCache(rpctypes.NonceCacheSize),
+		QueryContexts: rpctypes.NewQueryContextPool(rpctypes.QueryContextCacheSize, 0),
+	})
+}
+
+func TestRuntimeSettings(t *testing.T) {
+	api := newAPI()
+
+	prevPercent := api.SetGCPercent(150)
+	defer debug.SetGCPercent(prevPercent)
+	prevLimit := api.SetMemoryLimit(1 << 40)
+	defer debug.SetMemoryLimit(prevLimit)
+
+	settings := api.RuntimeSettings()
+	require.Equal(t, 150, settings.GCPercent)
+	require.Equal(t, int64(1<<40), settings.MemoryLimit)
+	require.Equal(t, zerolog.GlobalLevel().String(), settings.LogLevel)
+	require.True(t, settings.Tracing)
+	require.Equal(t, map[string]int{
+		backend.NonceCacheName:        rpctypes.NonceCacheSize,
+		backend.SenderCacheName:       rpctypes.DefaultSenderCache.Size(),
+		backend.QueryContextCacheName: rpctypes.QueryContextCacheSize,
+	}, settings.CacheSizes)
+
+	require.Equal(t, 150, api.SetGCPercent(prevPercent))
+	require.Equal(t, int64(1<<40), api.SetMemoryLimit(prevLimit))
+}
+
+func TestSetLogLevel(t *testing.T) {
+	api := newAPI()
+	prev := zerolog.GlobalLevel()
+	defer zerolog.SetGlobalLevel(prev)
+
+	res, err := api.SetLogLevel("warn")
+	require.NoError(t, err)
+	require.Equal(t, prev.String(), res)
+	require.Equal(t, zerolog.WarnLevel, zerolog.GlobalLevel())
+	require.Equal(t, "warn", api.RuntimeSettings().LogLevel)
+
+	_, err = api.SetLogLevel("verbose")
+	require.Error(t, err)
+	_, err = api.SetLogLevel("")
+	require.ErrorContains(t, err, "empty log level")
+	require.Equal(t, zerolog.WarnLevel, zerolog.GlobalLevel())
+}
+
+func TestSetTracing(t *testing.T) {
+	api := newAPI()
+	defer api.SetTracing(true)
+
+	require.True(t, api.SetTracing(false))
+	require.False(t, api.RuntimeSettings().Tracing)
+	require.False(t, api.SetTracing(true))
+	require.True(t, api.RuntimeSettings().Tracing)
+}
+
+func TestSetCacheSize(t *testing.T) {
+	api := newAPI()
+
+	prev, err := api.SetCacheSize(backend.NonceCacheName, 16)
+	require.NoError(t, err)
+	require.Equal(t, rpctypes.NonceCacheSize, prev)
+	prev, err = api.SetCacheSize(backend.QueryContextCacheName, 32)
+	require.NoError(t, err)
+	require.Equal(t, rpctypes.QueryContextCacheSize, prev)
+	require.Equal(t, 16, api.RuntimeSettings().CacheSizes[backend.NonceCacheName])
+	require.Equal(t, 32, api.RuntimeSettings().CacheSizes[backend.QueryContextCacheName])
+
+	_, err = api.SetCacheSize(backend.NonceCacheName, 0)
+	require.ErrorContains(t, err, "invalid cache size")
+	_, err = api.SetCacheSize("blocks", 16)
+	require.ErrorContains(t, err, "unknown cache")
+}
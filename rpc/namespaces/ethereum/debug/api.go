@@ -19,6 +19,7 @@ import (
 
 	"github.com/cosmos/evm/rpc/backend"
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -63,6 +64,45 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceTransaction(hash, config)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on top
+// of the provided block and returns them as a JSON object.
+func (a *API) TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *evmtypes.TraceConfig) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args, "block number or hash", blockNrOrHash)
+	return a.backend.TraceCall(args, blockNrOrHash, config)
+}
+
+// GetTxWitness returns the accounts and storage slots read and written during
+// the execution of the given transaction.
+func (a *API) GetTxWitness(hash common.Hash) (*cosmosevmtypes.TxWitness, error) {
+	a.logger.Debug("debug_getTxWitness", "hash", hash)
+	return a.backend.GetTxWitness(hash)
+}
+
+// GetModifiedAccountsByNumber returns the accounts modified by the eth txs of the
+// blocks after startNum up to endNum. If endNum is not given, it returns the
+// accounts modified in the block startNum.
+func (a *API) GetModifiedAccountsByNumber(startNum uint64, endNum *uint64) ([]common.Address, error) {
+	a.logger.Debug("debug_getModifiedAccountsByNumber", "start", startNum, "end", endNum)
+	return a.backend.GetModifiedAccountsByNumber(startNum, endNum)
+}
+
+// GetModifiedAccountsByHash returns the accounts modified by the eth txs of the
+// blocks after startHash up to endHash. If endHash is not given, it returns the
+// accounts modified in the block startHash.
+func (a *API) GetModifiedAccountsByHash(startHash common.Hash, endHash *common.Hash) ([]common.Address, error) {
+	a.logger.Debug("debug_getModifiedAccountsByHash", "start", startHash, "end", endHash)
+	return a.backend.GetModifiedAccountsByHash(startHash, endHash)
+}
+
+// StorageRangeAt returns the storage entries of a contract, starting from the
+// given key, at the state before the eth tx of the given index in the block is
+// executed.
+func (a *API) StorageRangeAt(blockHash common.Hash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "hash", blockHash, "index", txIndex, "address", contractAddress, "start", keyStart, "max", maxResult)
+	return a.backend.StorageRangeAt(blockHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
 func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
@@ -283,6 +323,13 @@ func (a *API) SetGCPercent(v int) int {
 	return debug.SetGCPercent(v)
 }
 
+// SetMemoryLimit sets the soft memory limit of the Go runtime, in bytes, and
+// returns the previous one. A negative limit doesn't change the limit.
+func (a *API) SetMemoryLimit(limit int64) int64 {
+	a.logger.Debug("debug_setMemoryLimit", "limit", limit)
+	return debug.SetMemoryLimit(limit)
+}
+
 // GetHeaderRlp retrieves the RLP encoded for of a single header.
 func (a *API) GetHeaderRlp(number uint64) (hexutil.Bytes, error) {
 	header, err := a.backend.HeaderByNumber(rpctypes.BlockNumber(number)) //#nosec G115 -- int overflow is not a concern here -- block number is not likely to exceed int64 max value
@@ -0,0 +1,172 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/version"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// ClientCode is the two letters client code returned by engine_getClientVersionV1.
+	ClientCode = "CE"
+	// ClientName is the client name returned by engine_getClientVersionV1.
+	ClientName = "cosmos-evm"
+)
+
+// errCometBFTPayloads is returned when a consensus client asks the node to build a
+// payload, as the blocks are proposed by the CometBFT validators.
+var errCometBFTPayloads = errors.New("the blocks are built by the CometBFT consensus, not through the engine API")
+
+// capabilities are the engine API methods served by the API.
+var capabilities = []string{
+	"engine_forkchoiceUpdatedV1",
+	"engine_forkchoiceUpdatedV2",
+	"engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV1",
+	"engine_getPayloadV2",
+	"engine_getPayloadV3",
+	"engine_newPayloadV1",
+	"engine_newPayloadV2",
+	"engine_newPayloadV3",
+	"engine_getClientVersionV1",
+}
+
+// API is a read-only facade of the engine_ prefixed set of APIs of the Ethereum
+// execution clients, so that the tooling health-checking the engine API can target
+// the node.
+//
+// The chain is driven by CometBFT instead of a consensus client: the blocks are final
+// once committed, so the forkchoice updates of a known head are always valid, no
+// payload is ever built and the new payloads are only checked against the committed
+// blocks.
+type API struct {
+	logger  log.Logger
+	backend backend.BlocksBackend
+}
+
+// NewAPI creates an instance of the engine API facade.
+func NewAPI(logger log.Logger, backend backend.BlocksBackend) *API {
+	return &API{
+		logger:  logger.With("api", "engine"),
+		backend: backend,
+	}
+}
+
+// ExchangeCapabilities returns the engine API methods supported by the node.
+func (api *API) ExchangeCapabilities(_ []string) []string {
+	api.logger.Debug("engine_exchangeCapabilities")
+	return capabilities
+}
+
+// GetClientVersionV1 returns the version of the node.
+func (api *API) GetClientVersionV1(_ engine.ClientVersionV1) []engine.ClientVersionV1 {
+	api.logger.Debug("engine_getClientVersionV1")
+	return []engine.ClientVersionV1{
+		{
+			Code:    ClientCode,
+			Name:    ClientName,
+			Version: version.AppVersion,
+			Commit:  version.GitCommit,
+		},
+	}
+}
+
+// ForkchoiceUpdatedV1 returns the status of the given forkchoice state.
+func (api *API) ForkchoiceUpdatedV1(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	api.logger.Debug("engine_forkchoiceUpdatedV1", "head", state.HeadBlockHash.Hex())
+	return api.forkchoiceUpdated(state, attrs)
+}
+
+// ForkchoiceUpdatedV2 returns the status of the given forkchoice state.
+func (api *API) ForkchoiceUpdatedV2(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	api.logger.Debug("engine_forkchoiceUpdatedV2", "head", state.HeadBlockHash.Hex())
+	return api.forkchoiceUpdated(state, attrs)
+}
+
+// ForkchoiceUpdatedV3 returns the status of the given forkchoice state.
+func (api *API) ForkchoiceUpdatedV3(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	api.logger.Debug("engine_forkchoiceUpdatedV3", "head", state.HeadBlockHash.Hex())
+	return api.forkchoiceUpdated(state, attrs)
+}
+
+// GetPayloadV1 always fails with the unknown payload error, as no payload is built.
+func (api *API) GetPayloadV1(id engine.PayloadID) (*engine.ExecutableData, error) {
+	api.logger.Debug("engine_getPayloadV1", "id", id.String())
+	return nil, engine.UnknownPayload
+}
+
+// GetPayloadV2 always fails with the unknown payload error, as no payload is built.
+func (api *API) GetPayloadV2(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	api.logger.Debug("engine_getPayloadV2", "id", id.String())
+	return nil, engine.UnknownPayload
+}
+
+// GetPayloadV3 always fails with the unknown payload error, as no payload is built.
+func (api *API) GetPayloadV3(id engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	api.logger.Debug("engine_getPayloadV3", "id", id.String())
+	return nil, engine.UnknownPayload
+}
+
+// NewPayloadV1 returns whether the given payload is a committed block.
+func (api *API) NewPayloadV1(params engine.ExecutableData) (engine.PayloadStatusV1, error) {
+	api.logger.Debug("engine_newPayloadV1", "hash", params.BlockHash.Hex())
+	return api.newPayload(params), nil
+}
+
+// NewPayloadV2 returns whether the given payload is a committed block.
+func (api *API) NewPayloadV2(params engine.ExecutableData) (engine.PayloadStatusV1, error) {
+	api.logger.Debug("engine_newPayloadV2", "hash", params.BlockHash.Hex())
+	return api.newPayload(params), nil
+}
+
+// NewPayloadV3 returns whether the given payload is a committed block.
+func (api *API) NewPayloadV3(params engine.ExecutableData, _ []common.Hash, _ *common.Hash) (engine.PayloadStatusV1, error) {
+	api.logger.Debug("engine_newPayloadV3", "hash", params.BlockHash.Hex())
+	return api.newPayload(params), nil
+}
+
+// forkchoiceUpdated returns a valid status if the head is a committed block, or a
+// syncing status otherwise. It fails if the payload attributes ask for a payload to
+// be built.
+func (api *API) forkchoiceUpdated(state engine.ForkchoiceStateV1, attrs *engine.PayloadAttributes) (engine.ForkChoiceResponse, error) {
+	if state.HeadBlockHash == (common.Hash{}) {
+		return engine.STATUS_INVALID, engine.InvalidForkChoiceState.With(errors.New("empty head block hash"))
+	}
+
+	if !api.isCommitted(state.HeadBlockHash) {
+		return engine.STATUS_SYNCING, nil
+	}
+
+	if attrs != nil {
+		return engine.STATUS_INVALID, engine.InvalidPayloadAttributes.With(errCometBFTPayloads)
+	}
+
+	head := state.HeadBlockHash
+	return engine.ForkChoiceResponse{
+		PayloadStatus: engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &head},
+	}, nil
+}
+
+// newPayload returns a valid status if the payload is a committed block, or a
+// syncing status otherwise, as the payloads can't be imported.
+func (api *API) newPayload(params engine.ExecutableData) engine.PayloadStatusV1 {
+	if !api.isCommitted(params.BlockHash) {
+		return engine.PayloadStatusV1{Status: engine.SYNCING}
+	}
+
+	hash := params.BlockHash
+	return engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash}
+}
+
+// isCommitted returns whether the block with the given hash has been committed,
+// which makes it final.
+func (api *API) isCommitted(hash common.Hash) bool {
+	resBlock, err := api.backend.TendermintBlockByHash(hash)
+	return err == nil && resBlock != nil
+}
@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+
+	"cosmossdk.io/log"
+)
+
+// newCommittedBackend returns a backend only knowing the committed block with
+// the given hash.
+func newCommittedBackend(t *testing.T, hash common.Hash) *mocks.BlocksBackend {
+	b := mocks.NewBlocksBackend(t)
+	b.On("TendermintBlockByHash", hash).Return(&tmrpctypes.ResultBlock{Block: &cmttypes.Block{}}, nil)
+	b.On("TendermintBlockByHash", mock.Anything).Return(nil, errors.New("block not found"))
+	return b
+}
+
+func TestForkchoiceUpdated(t *testing.T) {
+	committed := common.HexToHash("0x01")
+	api := NewAPI(log.NewNopLogger(), newCommittedBackend(t, committed))
+
+	testCases := []struct {
+		name      string
+		state     engine.ForkchoiceStateV1
+		attrs     *engine.PayloadAttributes
+		expStatus string
+		expErr    *engine.EngineAPIError
+	}{
+		{
+			"fail - empty head",
+			engine.ForkchoiceStateV1{},
+			nil,
+			engine.INVALID,
+			engine.InvalidForkChoiceState,
+		},
+		{
+			"pass - unknown head is syncing",
+			engine.ForkchoiceStateV1{HeadBlockHash: common.HexToHash("0x02")},
+			nil,
+			engine.SYNCING,
+			nil,
+		},
+		{
+			"pass - committed head is valid",
+			engine.ForkchoiceStateV1{HeadBlockHash: committed, SafeBlockHash: committed, FinalizedBlockHash: committed},
+			nil,
+			engine.VALID,
+			nil,
+		},
+		{
+			"fail - payload building",
+			engine.ForkchoiceStateV1{HeadBlockHash: committed},
+			&engine.PayloadAttributes{},
+			engine.INVALID,
+			engine.InvalidPayloadAttributes,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := api.ForkchoiceUpdatedV3(tc.state, tc.attrs)
+			if tc.expErr != nil {
+				var apiErr *engine.EngineAPIError
+				require.ErrorAs(t, err, &apiErr)
+				require.Equal(t, tc.expErr.ErrorCode(), apiErr.ErrorCode())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expStatus, res.PayloadStatus.Status)
+			require.Nil(t, res.PayloadID)
+			if tc.expStatus == engine.VALID {
+				require.Equal(t, tc.state.HeadBlockHash, *res.PayloadStatus.LatestValidHash)
+			}
+		})
+	}
+}
+
+func TestNewPayload(t *testing.T) {
+	committed := common.HexToHash("0x01")
+	api := NewAPI(log.NewNopLogger(), newCommittedBackend(t, committed))
+
+	res, err := api.NewPayloadV3(engine.ExecutableData{BlockHash: committed}, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, engine.VALID, res.Status)
+	require.Equal(t, committed, *res.LatestValidHash)
+
+	res, err = api.NewPayloadV2(engine.ExecutableData{BlockHash: common.HexToHash("0x02")})
+	require.NoError(t, err)
+	require.Equal(t, engine.SYNCING, res.Status)
+	require.Nil(t, res.LatestValidHash)
+
+	_, err = api.GetPayloadV3(engine.PayloadID{})
+	require.ErrorIs(t, err, engine.UnknownPayload)
+}
@@ -40,7 +40,7 @@ type EthereumAPI interface {
 	// it is a user or a smart contract.
 	GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error)
 	GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
-	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetTransactionReceipt(hash common.Hash, opts *rpctypes.LogsOptions) (map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	// eth_getBlockReceipts
@@ -54,6 +54,14 @@ type EthereumAPI interface {
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
 
+	// User Operations
+	//
+	// Allows ERC-4337 accounts to submit user operations, which are bundled by
+	// the node into transactions to the EntryPoint.
+	SendUserOperation(op rpctypes.UserOperation, entryPoint common.Address) (common.Hash, error)
+	EstimateUserOperationGas(op rpctypes.UserOperation, entryPoint common.Address) (*rpctypes.UserOperationGasEstimate, error)
+	SupportedEntryPoints() ([]common.Address, error)
+
 	// Account Information
 	//
 	// Returns information regarding an address's stored on-chain data.
@@ -97,10 +105,11 @@ type EthereumAPI interface {
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
+	SignTypedData_v4(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) //nolint:revive,stylecheck // method name of the eth_signTypedData_v4 endpoint
+	SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
 	FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	GetPendingTransactions() ([]*rpctypes.RPCTransaction, error)
-	// eth_signTransaction (on Ethereum.org)
 	// eth_getCompilers (on Ethereum.org)
 	// eth_compileSolidity (on Ethereum.org)
 	// eth_compileLLL (on Ethereum.org)
@@ -178,11 +187,19 @@ func (e *PublicAPI) GetTransactionCount(address common.Address, blockNrOrHash rp
 	return e.backend.GetTransactionCount(address, blockNum)
 }
 
-// GetTransactionReceipt returns the transaction receipt identified by hash.
-func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+// GetTransactionReceipt returns the transaction receipt identified by hash, with
+// the decoded events of its logs if the decoded option is set.
+func (e *PublicAPI) GetTransactionReceipt(hash common.Hash, opts *rpctypes.LogsOptions) (map[string]interface{}, error) {
 	hexTx := hash.Hex()
 	e.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
-	return e.backend.GetTransactionReceipt(hash)
+	receipt, err := e.backend.GetTransactionReceipt(hash)
+	if err != nil || receipt == nil || opts == nil || !opts.Decoded {
+		return receipt, err
+	}
+	if logs, ok := receipt["logs"].([]*ethtypes.Log); ok {
+		receipt["logs"] = e.backend.DecodeLogs(logs)
+	}
+	return receipt, nil
 }
 
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
@@ -422,12 +439,44 @@ func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, err
 	return e.backend.GetTransactionLogs(txHash)
 }
 
+// SendUserOperation bundles an ERC-4337 user operation into a transaction to the EntryPoint.
+func (e *PublicAPI) SendUserOperation(op rpctypes.UserOperation, entryPoint common.Address) (common.Hash, error) {
+	e.logger.Debug("eth_sendUserOperation", "sender", op.Sender.Hex(), "entry point", entryPoint.Hex())
+	return e.backend.SendUserOperation(op, entryPoint)
+}
+
+// EstimateUserOperationGas returns an estimate of the gas limits of an ERC-4337 user operation.
+func (e *PublicAPI) EstimateUserOperationGas(op rpctypes.UserOperation, entryPoint common.Address) (*rpctypes.UserOperationGasEstimate, error) {
+	e.logger.Debug("eth_estimateUserOperationGas", "sender", op.Sender.Hex(), "entry point", entryPoint.Hex())
+	return e.backend.EstimateUserOperationGas(op, entryPoint)
+}
+
+// SupportedEntryPoints returns the ERC-4337 EntryPoints supported by the node's bundler.
+func (e *PublicAPI) SupportedEntryPoints() ([]common.Address, error) {
+	e.logger.Debug("eth_supportedEntryPoints")
+	return e.backend.SupportedEntryPoints()
+}
+
 // SignTypedData signs EIP-712 conformant typed data
 func (e *PublicAPI) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_signTypedData", "address", address.Hex(), "data", typedData)
 	return e.backend.SignTypedData(address, typedData)
 }
 
+// SignTypedData_v4 signs EIP-712 conformant typed data, whose domain must be bound
+// to this chain, as expected by the wallets calling eth_signTypedData_v4.
+func (e *PublicAPI) SignTypedData_v4(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) { //nolint:revive,stylecheck // method name of the eth_signTypedData_v4 endpoint
+	e.logger.Debug("eth_signTypedData_v4", "address", address.Hex(), "data", typedData)
+	return e.backend.SignTypedData(address, typedData)
+}
+
+// SignTransaction signs the given transaction with the key of the sender in the
+// node's keyring, and returns it without broadcasting it.
+func (e *PublicAPI) SignTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error) {
+	e.logger.Debug("eth_signTransaction", "args", args.String())
+	return e.backend.SignTransaction(args)
+}
+
 // FillTransaction fills the defaults (nonce, gas, gasPrice or 1559 fields)
 // on a given unsigned transaction, and returns it to the caller for further
 // processing (signing + broadcast).
@@ -16,6 +16,7 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/evm/rpc/backend"
 	"github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -37,26 +38,11 @@ type FilterAPI interface {
 	GetFilterChanges(id rpc.ID) (interface{}, error)
 	GetFilterLogs(ctx context.Context, id rpc.ID) ([]*ethtypes.Log, error)
 	UninstallFilter(id rpc.ID) bool
-	GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error)
+	GetLogs(ctx context.Context, crit types.FilterCriteria) ([]*types.Log, error)
 }
 
 // Backend defines the methods requided by the PublicFilterAPI backend
-type Backend interface {
-	GetBlockByNumber(blockNum types.BlockNumber, fullTx bool) (map[string]interface{}, error)
-	HeaderByNumber(blockNum types.BlockNumber) (*ethtypes.Header, error)
-	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
-	TendermintBlockByHash(hash common.Hash) (*coretypes.ResultBlock, error)
-	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
-	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
-	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
-	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
-
-	BloomStatus() (uint64, uint64)
-
-	RPCFilterCap() int32
-	RPCLogsCap() int32
-	RPCBlockRangeCap() int32
-}
+type Backend = backend.FilterBackend
 
 // consider a filter inactive if it has not been polled for within deadline
 var deadline = 5 * time.Minute
@@ -506,14 +492,15 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 	return filterID, err
 }
 
-// GetLogs returns logs matching the given argument that are stored within the state.
+// GetLogs returns logs matching the given argument that are stored within the state,
+// with their decoded events if the decoded option is set.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
-func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
+func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit types.FilterCriteria) ([]*types.Log, error) {
 	var filter *Filter
 	if crit.BlockHash != nil {
 		// Block filter requested, construct a single-shot filter
-		filter = NewBlockFilter(api.logger, api.backend, crit)
+		filter = NewBlockFilter(api.logger, api.backend, crit.FilterCriteria)
 	} else {
 		// Convert the RPC block numbers into internal representations
 		begin := rpc.LatestBlockNumber.Int64()
@@ -539,7 +526,10 @@ func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCrit
 		return nil, err
 	}
 
-	return returnLogs(logs), err
+	if crit.Decoded {
+		return api.backend.DecodeLogs(returnLogs(logs)), nil
+	}
+	return types.NewLogs(returnLogs(logs)), nil
 }
 
 // UninstallFilter removes the filter with the given filter id.
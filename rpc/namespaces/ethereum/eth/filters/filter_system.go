@@ -191,6 +191,20 @@ func (es *EventSystem) subscribeLogs(crit filters.FilterCriteria) (*Subscription
 	return es.subscribe(sub)
 }
 
+// SubscribeEVMTxs subscribes to the events of the committed txs of the evm
+// module, which are the ones of the logs subscriptions.
+func (es *EventSystem) SubscribeEVMTxs() (*Subscription, pubsub.UnsubscribeFunc, error) {
+	sub := &Subscription{
+		id:        rpc.NewID(),
+		typ:       filters.LogsSubscription,
+		event:     evmEvents,
+		created:   time.Now().UTC(),
+		installed: make(chan struct{}, 1),
+		err:       make(chan error, 1),
+	}
+	return es.subscribe(sub)
+}
+
 // SubscribeNewHeads subscribes to new block headers events.
 func (es EventSystem) SubscribeNewHeads() (*Subscription, pubsub.UnsubscribeFunc, error) {
 	sub := &Subscription{
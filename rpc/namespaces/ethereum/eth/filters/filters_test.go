@@ -0,0 +1,45 @@
+package filters
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	"github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+func TestFilterLogsRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from, to int64
+		limit    int64
+		expErr   string
+	}{
+		{"fail - pending logs", rpc.PendingBlockNumber.Int64(), rpc.LatestBlockNumber.Int64(), -1, errPendingLogsUnsupported.Error()},
+		{"fail - from after the head", 11, 11, -1, errInvalidBlockRange.Error()},
+		{"fail - from after to", 5, 4, -1, errInvalidBlockRange.Error()},
+		{"fail - range above the block limit", 1, rpc.LatestBlockNumber.Int64(), 5, "maximum [from, to] blocks distance: 5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := mocks.NewFilterBackend(t)
+			b.On("HeaderByNumber", types.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(10)}, nil).Maybe()
+
+			filter := NewBlockFilter(log.NewNopLogger(), b, filters.FilterCriteria{
+				FromBlock: big.NewInt(tc.from),
+				ToBlock:   big.NewInt(tc.to),
+			})
+			_, err := filter.Logs(context.Background(), 100, tc.limit)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
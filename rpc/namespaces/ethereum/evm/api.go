@@ -0,0 +1,110 @@
+package evm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	"cosmossdk.io/log"
+)
+
+// API is the collection of the evm extension APIs, which aren't part of the
+// standard ethereum JSON-RPC.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the evm extension methods.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "evm"),
+		backend: backend,
+	}
+}
+
+// GetStorageSlots returns the values of the given storage slots, each read at
+// its own block, in a single call.
+func (a *API) GetStorageSlots(slots []rpctypes.StorageSlotArgs) ([]hexutil.Bytes, error) {
+	a.logger.Debug("evm_getStorageSlots", "slots", len(slots))
+	return a.backend.GetStorageSlots(slots)
+}
+
+// GetCosmosTxByEthHash returns the cosmos tx that includes the eth tx identified
+// by the given hash.
+func (a *API) GetCosmosTxByEthHash(hash common.Hash) (*rpctypes.CosmosTxResult, error) {
+	a.logger.Debug("evm_getCosmosTxByEthHash", "hash", hash.Hex())
+	return a.backend.GetCosmosTxByEthHash(hash)
+}
+
+// GetEthTxByCosmosHash returns the eth txs included in the cosmos tx identified
+// by the given hash.
+func (a *API) GetEthTxByCosmosHash(hash cmtbytes.HexBytes) ([]*rpctypes.RPCTransaction, error) {
+	a.logger.Debug("evm_getEthTxByCosmosHash", "hash", hash.String())
+	return a.backend.GetEthTxsByCosmosHash(hash)
+}
+
+// GetTransactionProof returns the merkle proof of the inclusion of the eth tx
+// identified by the given hash, which can be checked with the proof package
+// against the data hash of a trusted block header.
+func (a *API) GetTransactionProof(hash common.Hash) (*rpctypes.TransactionProof, error) {
+	a.logger.Debug("evm_getTransactionProof", "hash", hash.Hex())
+	return a.backend.GetTransactionProof(hash)
+}
+
+// GetTransactionsByAddress returns a page of the eth txs sent by, sent to or
+// with internal calls of the given address, newest first. The next page is
+// returned by passing the cursor returned with the page.
+func (a *API) GetTransactionsByAddress(args rpctypes.AddressTxsArgs) (*rpctypes.AddressTxsResult, error) {
+	a.logger.Debug("evm_getTransactionsByAddress", "address", args.Address.Hex(), "kinds", args.Kinds)
+	return a.backend.GetAddressTransactions(args)
+}
+
+// GetTokenTransfers returns the ERC20, ERC721 and ERC1155 transfers sent by or
+// to the given address, or of the token at the address, in the block range.
+func (a *API) GetTokenTransfers(args rpctypes.TokenTransfersArgs) ([]*cosmosevmtypes.TokenTransfer, error) {
+	a.logger.Debug("evm_getTokenTransfers", "address", args.Address.Hex(), "from", args.FromBlock, "to", args.ToBlock)
+	return a.backend.GetTokenTransfers(args)
+}
+
+// GetNFTsByOwner returns a page of the ERC721 and ERC1155 tokens held by the
+// given owner, with their balances and the URIs of their metadata. The next
+// page is returned by passing the cursor returned with the page.
+func (a *API) GetNFTsByOwner(args rpctypes.NFTsByOwnerArgs) (*rpctypes.NFTsByOwnerResult, error) {
+	a.logger.Debug("evm_getNFTsByOwner", "owner", args.Owner.Hex())
+	return a.backend.GetNFTsByOwner(args)
+}
+
+// GetInternalTransactions returns the evm calls committed by the cosmos msgs of
+// the given block, e.g. the erc20 mints of the coin conversions.
+func (a *API) GetInternalTransactions(blockNum rpctypes.BlockNumber) ([]*rpctypes.InternalTransaction, error) {
+	a.logger.Debug("evm_getInternalTransactions", "number", blockNum)
+	return a.backend.GetInternalTransactions(blockNum)
+}
+
+// PoolStats returns the priority distribution of the eth txs in the mempool,
+// which shows the tip needed for a tx to be ordered ahead of the pending ones.
+func (a *API) PoolStats() (*rpctypes.PoolStats, error) {
+	a.logger.Debug("evm_poolStats")
+	return a.backend.PoolStats()
+}
+
+// SubmitContractMetadata stores the Solidity metadata and the sources of the
+// contract deployed at the given address, once checked against the metadata
+// hash appended to its code by the compiler.
+func (a *API) SubmitContractMetadata(args rpctypes.ContractMetadataArgs) (*rpctypes.ContractMetadata, error) {
+	a.logger.Debug("evm_submitContractMetadata", "address", args.Address.Hex())
+	return a.backend.SubmitContractMetadata(args)
+}
+
+// GetContractMetadata returns the Solidity metadata and the sources submitted
+// for the code of the contract deployed at the given address.
+func (a *API) GetContractMetadata(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.ContractMetadata, error) {
+	a.logger.Debug("evm_getContractMetadata", "address", address.Hex(), "block number or hash", blockNrOrHash)
+	return a.backend.GetContractMetadata(address, blockNrOrHash)
+}
@@ -0,0 +1,63 @@
+// Package explorer implements the explorer namespace, the queries of the
+// dashboards of the small chains which don't run a dedicated explorer: the top
+// accounts, the txs of an address and the contract creations, served from the
+// secondary indexes of the custom tx indexer.
+package explorer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// API is the collection of the explorer APIs.
+type API struct {
+	logger  log.Logger
+	backend backend.ExplorerBackend
+}
+
+// NewAPI creates a new API definition for the explorer methods.
+func NewAPI(logger log.Logger, backend backend.ExplorerBackend) *API {
+	return &API{
+		logger:  logger.With("module", "explorer"),
+		backend: backend,
+	}
+}
+
+// GetTopAccounts returns the accounts with the largest balances of the evm coin,
+// largest first, 25 by default and at most 100.
+func (a *API) GetTopAccounts(count *hexutil.Uint) ([]rpctypes.AccountBalance, error) {
+	a.logger.Debug("explorer_getTopAccounts", "count", count)
+	return a.backend.GetTopAccounts(intOrZero(count))
+}
+
+// GetTransactionsByAddress returns a page of the eth txs of the given address,
+// newest first, 25 by default and at most 100. The next page is returned by
+// passing the cursor returned with the page.
+func (a *API) GetTransactionsByAddress(address common.Address, cursor *hexutil.Bytes, limit *hexutil.Uint) (*rpctypes.AddressTransactions, error) {
+	a.logger.Debug("explorer_getTransactionsByAddress", "address", address.Hex(), "limit", limit)
+	var c hexutil.Bytes
+	if cursor != nil {
+		c = *cursor
+	}
+	return a.backend.GetTransactionsByAddress(address, c, intOrZero(limit))
+}
+
+// GetContractCreation returns the eth tx which created the contract at the given
+// address, nil if it wasn't created by an eth tx.
+func (a *API) GetContractCreation(address common.Address) (*rpctypes.ContractCreation, error) {
+	a.logger.Debug("explorer_getContractCreation", "address", address.Hex())
+	return a.backend.GetContractCreation(address)
+}
+
+// intOrZero returns the value of an optional parameter, zero if it's not set.
+func intOrZero(v *hexutil.Uint) int {
+	if v == nil {
+		return 0
+	}
+	return int(*v)
+}
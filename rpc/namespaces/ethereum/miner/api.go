@@ -15,13 +15,13 @@ import (
 type API struct {
 	ctx     *server.Context
 	logger  log.Logger
-	backend backend.EVMBackend
+	backend backend.NodeBackend
 }
 
 // NewPrivateAPI creates an instance of the Miner API.
 func NewPrivateAPI(
 	ctx *server.Context,
-	backend backend.EVMBackend,
+	backend backend.NodeBackend,
 ) *API {
 	return &API{
 		ctx:     ctx,
@@ -0,0 +1,30 @@
+package trace
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// API is the collection of parity style trace APIs.
+type API struct {
+	logger  log.Logger
+	backend backend.TraceBackend
+}
+
+// NewAPI creates a new API definition for the trace methods.
+func NewAPI(logger log.Logger, backend backend.TraceBackend) *API {
+	return &API{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Filter returns the flat call traces matching the given filter.
+func (a *API) Filter(args rpctypes.TraceFilterArgs) ([]json.RawMessage, error) {
+	a.logger.Debug("trace_filter", "args", args)
+	return a.backend.TraceFilter(args)
+}
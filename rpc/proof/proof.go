@@ -0,0 +1,59 @@
+// Package proof verifies the eth tx inclusion proofs returned by
+// evm_getTransactionProof, so that the inclusion of a tx can be checked without
+// trusting the RPC node, given a block header obtained from a trusted source
+// such as a CometBFT light client.
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VerifyTransactionProof checks that the cosmos tx of the proof is included in
+// the block with the given data hash, which must come from a trusted header, and
+// that its msg at the proof msg index is the eth tx of the proof hash. The cosmos
+// tx is decoded with the given tx decoder.
+func VerifyTransactionProof(proof *rpctypes.TransactionProof, dataHash []byte, txDecoder sdk.TxDecoder) error {
+	if proof == nil {
+		return errors.New("empty proof")
+	}
+
+	if err := proof.Proof.Validate(dataHash); err != nil {
+		return fmt.Errorf("invalid inclusion proof: %w", err)
+	}
+
+	if !bytes.Equal(proof.Proof.Data.Hash(), proof.CosmosTxHash) {
+		return fmt.Errorf("proof of cosmos tx %X, expected %s", proof.Proof.Data.Hash(), proof.CosmosTxHash)
+	}
+
+	tx, err := txDecoder(proof.Proof.Data)
+	if err != nil {
+		return fmt.Errorf("failed to decode cosmos tx %s: %w", proof.CosmosTxHash, err)
+	}
+
+	msgs := tx.GetMsgs()
+	if uint64(proof.MsgIndex) >= uint64(len(msgs)) {
+		return fmt.Errorf("msg index %d out of range, cosmos tx has %d msgs", proof.MsgIndex, len(msgs))
+	}
+
+	ethMsg, ok := msgs[proof.MsgIndex].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return fmt.Errorf("msg %d is a %T, not an eth tx", proof.MsgIndex, msgs[proof.MsgIndex])
+	}
+
+	ethTx := ethMsg.AsTransaction()
+	if ethTx == nil {
+		return fmt.Errorf("invalid eth tx msg %d", proof.MsgIndex)
+	}
+	if ethTx.Hash() != proof.Hash {
+		return fmt.Errorf("proof of eth tx %s, expected %s", ethTx.Hash().Hex(), proof.Hash.Hex())
+	}
+
+	return nil
+}
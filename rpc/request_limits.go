@@ -0,0 +1,129 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+)
+
+// JSON-RPC error codes of the requests rejected before they're served.
+const (
+	errInvalidRequest = -32600
+	errMethodNotFound = -32601
+	errLimitExceeded  = -32005
+)
+
+// requestLimitsHandler rejects the JSON-RPC requests over HTTP whose body is
+// larger than the max size, before reading it further, or whose JSON values
+// are nested deeper than the max depth, before they're decoded.
+type requestLimitsHandler struct {
+	next        http.Handler
+	maxBodySize int
+	maxDepth    int
+}
+
+// NewRequestLimitsHandler wraps the JSON-RPC handler with the limits on the
+// size in bytes of the request bodies and on the depth of their JSON values,
+// unlimited if 0.
+func NewRequestLimitsHandler(next http.Handler, maxBodySize, maxDepth int) http.Handler {
+	return &requestLimitsHandler{
+		next:        next,
+		maxBodySize: maxBodySize,
+		maxDepth:    maxDepth,
+	}
+}
+
+func (h *requestLimitsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Body == nil || r.Body == http.NoBody {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	body := r.Body
+	if h.maxBodySize > 0 {
+		if r.ContentLength > int64(h.maxBodySize) {
+			writeHTTPError(w, http.StatusRequestEntityTooLarge, errInvalidRequest, "request body too large")
+			return
+		}
+		body = http.MaxBytesReader(w, r.Body, int64(h.maxBodySize))
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeHTTPError(w, http.StatusRequestEntityTooLarge, errInvalidRequest, "request body too large")
+			return
+		}
+		writeHTTPError(w, http.StatusBadRequest, errInvalidRequest, "failed to read request body")
+		return
+	}
+
+	if exceedsDepth(data, h.maxDepth) {
+		writeHTTPError(w, http.StatusBadRequest, errInvalidRequest, "request exceeds max JSON depth")
+		return
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(data))
+	r.ContentLength = int64(len(data))
+	h.next.ServeHTTP(w, r)
+}
+
+// writeHTTPError writes the JSON-RPC error of a request rejected before it's
+// served.
+func writeHTTPError(w http.ResponseWriter, status int, code int64, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(&ErrorResponseJSON{ // #nosec G703
+		Jsonrpc: "2.0",
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(code),
+			Message: msg,
+		},
+	})
+}
+
+// exceedsDepth returns true if the JSON arrays and objects of the data are
+// nested deeper than the max depth, e.g. a single request with its params is
+// at depth 2. It doesn't validate the data, which is left to the decoder, and
+// always returns false if the max depth is 0.
+func exceedsDepth(data []byte, maxDepth int) bool {
+	if maxDepth <= 0 {
+		return false
+	}
+
+	var (
+		depth    int
+		inString bool
+		escaped  bool
+	)
+	for _, c := range data {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '[', '{':
+			depth++
+			if depth > maxDepth {
+				return true
+			}
+		case ']', '}':
+			depth--
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package rpc
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type echoService struct{}
+
+func (echoService) Echo(v interface{}) interface{} {
+	return v
+}
+
+func newLimitedServer(t testing.TB, maxBodySize, maxDepth int) *httptest.Server {
+	t.Helper()
+
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("test", echoService{}))
+	t.Cleanup(rpcServer.Stop)
+
+	srv := httptest.NewServer(NewRequestLimitsHandler(rpcServer, maxBodySize, maxDepth))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRequestLimitsHandler(t *testing.T) {
+	srv := newLimitedServer(t, 128, 4)
+
+	testCases := []struct {
+		name      string
+		body      string
+		expStatus int
+		expError  string
+	}{
+		{
+			"pass - request within the limits",
+			`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":[{"a":["[[[["]}]}`,
+			http.StatusOK,
+			"",
+		},
+		{
+			"pass - batch within the limits",
+			`[{"jsonrpc":"2.0","id":1,"method":"test_echo","params":[[1]]}]`,
+			http.StatusOK,
+			"",
+		},
+		{
+			"fail - request too large",
+			`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["` + strings.Repeat("a", 128) + `"]}`,
+			http.StatusRequestEntityTooLarge,
+			"request body too large",
+		},
+		{
+			"fail - request too deep",
+			`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":[{"a":[[1]]}]}`,
+			http.StatusBadRequest,
+			"request exceeds max JSON depth",
+		},
+		{
+			"fail - malformed request",
+			`{"jsonrpc":"2.0","id":1,"method":`,
+			http.StatusOK,
+			"parse error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := http.Post(srv.URL, "application/json", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			defer res.Body.Close()
+			require.Equal(t, tc.expStatus, res.StatusCode)
+
+			var resp struct {
+				Error *struct {
+					Code    int    `json:"code"`
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			if strings.HasPrefix(tc.body, "[") {
+				return
+			}
+			require.NoError(t, json.Unmarshal(body, &resp))
+			if tc.expError == "" {
+				require.Nil(t, resp.Error)
+				return
+			}
+			require.NotNil(t, resp.Error)
+			require.Contains(t, resp.Error.Message, tc.expError)
+		})
+	}
+}
+
+// jsonDepth returns the max nesting depth of the arrays and objects of valid
+// JSON data.
+func jsonDepth(t *testing.T, data []byte) int {
+	t.Helper()
+
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	var depth, maxDepth int
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			return maxDepth
+		}
+		require.NoError(t, err)
+		switch token {
+		case json.Delim('['), json.Delim('{'):
+			depth++
+			maxDepth = max(maxDepth, depth)
+		case json.Delim(']'), json.Delim('}'):
+			depth--
+		}
+	}
+}
+
+func FuzzExceedsDepth(f *testing.F) {
+	f.Add([]byte(`{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0x1"},"latest"]}`), 2)
+	f.Add([]byte(`[{"a":"[{\"}"},[[]]]`), 3)
+	f.Add([]byte(`[[[[[[`), 4)
+	f.Add([]byte(`"\\"`), 1)
+
+	f.Fuzz(func(t *testing.T, data []byte, maxDepth int) {
+		exceeds := exceedsDepth(data, maxDepth)
+		if maxDepth <= 0 {
+			require.False(t, exceeds)
+			return
+		}
+		// the depth of the invalid data is left to the decoder
+		if !json.Valid(data) {
+			return
+		}
+		require.Equal(t, jsonDepth(t, data) > maxDepth, exceeds)
+	})
+}
+
+func FuzzRequestLimitsHandler(f *testing.F) {
+	f.Add(`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":[1]}`)
+	f.Add(`[{"jsonrpc":"2.0","id":1,"method":"test_echo","params":[[[[1]]]]}]`)
+	f.Add(`{"jsonrpc":"2.0","id":"1","method":"test_echo","params":{"a":1}}`)
+	f.Add(`{"jsonrpc":"2.0"`)
+
+	srv := newLimitedServer(f, 256, 4)
+	f.Fuzz(func(t *testing.T, body string) {
+		res, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		// the requests are either rejected by the limits or answered by the
+		// server, which never fails on malformed requests
+		switch {
+		case len(body) > 256:
+			require.Equal(t, http.StatusRequestEntityTooLarge, res.StatusCode)
+		case exceedsDepth([]byte(body), 4):
+			require.Equal(t, http.StatusBadRequest, res.StatusCode)
+		default:
+			require.Equal(t, http.StatusOK, res.StatusCode)
+		}
+	})
+}
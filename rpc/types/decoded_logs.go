@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/json"
+	"slices"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+)
+
+// DecodedEvent is the event of a log decoded with the ABI of its contract or
+// with the event signature registry of the node.
+type DecodedEvent struct {
+	Name      string         `json:"name"`
+	Signature string         `json:"signature"`
+	Params    []DecodedParam `json:"params"`
+}
+
+// DecodedParam is a param of a decoded event. The value of an indexed param of
+// a dynamic type, e.g. a string, is the hash of the value stored in the topic.
+type DecodedParam struct {
+	Name    string      `json:"name"`
+	Type    string      `json:"type"`
+	Indexed bool        `json:"indexed"`
+	Value   interface{} `json:"value"`
+}
+
+// Log is an eth log with its decoded event, nil if the log wasn't decoded or
+// its event is unknown.
+type Log struct {
+	*ethtypes.Log
+	Decoded *DecodedEvent
+}
+
+// NewLogs returns the logs without their decoded events.
+func NewLogs(logs []*ethtypes.Log) []*Log {
+	res := make([]*Log, len(logs))
+	for i, log := range logs {
+		res[i] = &Log{Log: log}
+	}
+	return res
+}
+
+// MarshalJSON encodes the log as geth does, with the decoded event appended
+// under the "decoded" field if it's set.
+func (l Log) MarshalJSON() ([]byte, error) {
+	bz, err := json.Marshal(l.Log)
+	if err != nil || l.Decoded == nil {
+		return bz, err
+	}
+	decoded, err := json.Marshal(l.Decoded)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Concat(bz[:len(bz)-1], []byte(`,"decoded":`), decoded, []byte("}")), nil
+}
+
+// FilterCriteria are the criteria of eth_getLogs, with the option to decode the
+// events of the logs.
+type FilterCriteria struct {
+	filters.FilterCriteria
+	Decoded bool
+}
+
+// UnmarshalJSON decodes the criteria as geth does, and the "decoded" option.
+func (c *FilterCriteria) UnmarshalJSON(data []byte) error {
+	if err := c.FilterCriteria.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	var opts LogsOptions
+	if err := json.Unmarshal(data, &opts); err != nil {
+		return err
+	}
+	c.Decoded = opts.Decoded
+	return nil
+}
+
+// LogsOptions are the options of the logs returned by eth_getTransactionReceipt.
+type LogsOptions struct {
+	Decoded bool `json:"decoded"`
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ErrCodeDefault is the JSON-RPC error code geth returns for the errors of the
+// tx submissions rejected by its tx pool.
+const ErrCodeDefault = -32000
+
+var (
+	// invalidNonceRegexp matches the nonce error of the ante handler, which
+	// has the tx nonce and the account nonce.
+	invalidNonceRegexp = regexp.MustCompile(`invalid nonce; got (\d+), expected (\d+)`)
+
+	// replacementRuleMsg is the error of the priority nonce mempool when a tx
+	// doesn't pay enough to replace the tx of the sender with the same nonce.
+	replacementRuleMsg = "tx doesn't fit the replacement rule"
+)
+
+// TxError is the error of a rejected tx submission, carrying the JSON-RPC code
+// and the message geth returns for the same failure. Clients match the geth
+// messages, e.g. "nonce too low" or "already known", so they don't handle the
+// errors of the ante handler and the mempool as they are.
+type TxError struct {
+	err error
+}
+
+// Error returns the geth error message
+func (e *TxError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the geth error, e.g. core.ErrNonceTooLow
+func (e *TxError) Unwrap() error {
+	return e.err
+}
+
+// ErrorCode returns the JSON-RPC error code
+func (e *TxError) ErrorCode() int {
+	return ErrCodeDefault
+}
+
+// NewTxError converts the error of the submission of a tx of the sender into
+// the error geth returns for the same failure. The error is returned as is if
+// geth has no error for it.
+func NewTxError(err error, sender common.Address) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, errortypes.ErrInvalidSequence):
+		matches := invalidNonceRegexp.FindStringSubmatch(err.Error())
+		if matches == nil {
+			return err
+		}
+		txNonce, errTx := strconv.ParseUint(matches[1], 10, 64)
+		nonce, errState := strconv.ParseUint(matches[2], 10, 64)
+		if errTx != nil || errState != nil {
+			return err
+		}
+		nonceErr := core.ErrNonceTooLow
+		if txNonce > nonce {
+			nonceErr = core.ErrNonceTooHigh
+		}
+		return &TxError{fmt.Errorf("%w: address %s, tx: %d state: %d", nonceErr, sender, txNonce, nonce)}
+	case errors.Is(err, errortypes.ErrTxInMempoolCache):
+		return &TxError{txpool.ErrAlreadyKnown}
+	case errors.Is(err, errortypes.ErrInsufficientFunds):
+		return &TxError{fmt.Errorf("%w: address %s", core.ErrInsufficientFunds, sender)}
+	case errors.Is(err, errortypes.ErrInsufficientFee):
+		return &TxError{txpool.ErrUnderpriced}
+	case strings.Contains(err.Error(), replacementRuleMsg):
+		return &TxError{txpool.ErrReplaceUnderpriced}
+	default:
+		return err
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestNewTxError(t *testing.T) {
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000000")
+
+	testCases := []struct {
+		name   string
+		err    error
+		expErr error
+		expMsg string
+	}{
+		{
+			name:   "nonce too low",
+			err:    errorsmod.ABCIError(errortypes.RootCodespace, errortypes.ErrInvalidSequence.ABCICode(), "invalid nonce; got 1, expected 2"),
+			expErr: core.ErrNonceTooLow,
+			expMsg: "nonce too low: address 0x1000000000000000000000000000000000000000, tx: 1 state: 2",
+		},
+		{
+			name:   "nonce too high",
+			err:    errorsmod.Wrap(errortypes.ErrInvalidSequence, "invalid nonce; got 3, expected 2"),
+			expErr: core.ErrNonceTooHigh,
+			expMsg: "nonce too high: address 0x1000000000000000000000000000000000000000, tx: 3 state: 2",
+		},
+		{
+			name:   "already known",
+			err:    errorsmod.ABCIError(errortypes.RootCodespace, errortypes.ErrTxInMempoolCache.ABCICode(), ""),
+			expErr: txpool.ErrAlreadyKnown,
+			expMsg: "already known",
+		},
+		{
+			name:   "insufficient funds",
+			err:    errorsmod.Wrap(errortypes.ErrInsufficientFunds, "failed to deduct fees"),
+			expErr: core.ErrInsufficientFunds,
+			expMsg: "insufficient funds for gas * price + value: address 0x1000000000000000000000000000000000000000",
+		},
+		{
+			name:   "underpriced",
+			err:    errorsmod.Wrap(errortypes.ErrInsufficientFee, "gas prices too low"),
+			expErr: txpool.ErrUnderpriced,
+			expMsg: "transaction underpriced",
+		},
+		{
+			name:   "replacement underpriced",
+			err:    errorsmod.ABCIError(errorsmod.UndefinedCodespace, 1, "tx doesn't fit the replacement rule, oldPriority: 2, newPriority: 1"),
+			expErr: txpool.ErrReplaceUnderpriced,
+			expMsg: "replacement transaction underpriced",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewTxError(tc.err, sender)
+			require.ErrorIs(t, err, tc.expErr)
+			require.EqualError(t, err, tc.expMsg)
+
+			var txErr *TxError
+			require.ErrorAs(t, err, &txErr)
+			require.Equal(t, ErrCodeDefault, txErr.ErrorCode())
+		})
+	}
+
+	// errors geth has no error for are returned as is
+	err := errors.New("unknown error")
+	require.Equal(t, err, NewTxError(err, sender))
+	require.NoError(t, NewTxError(nil, sender))
+}
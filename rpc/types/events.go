@@ -1,10 +1,12 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -46,6 +48,10 @@ const (
 	// ...
 	// ```
 	// If the transaction exceeds block gas limit, it only emits the first part.
+	// The tx_log events are no longer emitted by the newer versions, the logs
+	// are decoded from the msg responses in the tx result data instead.
+	// In the compact events mode only the first part is emitted, and the gas
+	// used and the status are read from the msg responses.
 	eventFormat2
 )
 
@@ -121,19 +127,42 @@ func ParseTxResult(result *abci.ExecTxResult, tx sdk.Tx) (*ParsedTxs, error) {
 		}
 	}
 
+	// in the compact events mode only the first part of format 2 is emitted,
+	// fill the gas used and the status with the msg responses
+	if format == eventFormat2 && eventIndex == -1 && result.Code == 0 {
+		if err := p.fillFromResponses(result.Data); err != nil {
+			return nil, err
+		}
+	}
+
 	// some old versions miss some events, fill it with tx result
 	gasUsed := uint64(result.GasUsed) // #nosec G115
 	if len(p.Txs) == 1 {
 		p.Txs[0].GasUsed = gasUsed
 	}
 
-	// this could only happen if tx exceeds block gas limit
+	// the eth msgs of a mixed tx, combining eth and cosmos msgs, aren't
+	// necessarily its first msgs, map the txs to the index of their msg
+	if tx != nil {
+		ethMsgIndex := 0
+		for i, msg := range tx.GetMsgs() {
+			if _, ok := msg.(*evmtypes.MsgEthereumTx); !ok {
+				continue
+			}
+			if ethMsgIndex < len(p.Txs) {
+				p.Txs[ethMsgIndex].MsgIndex = i
+			}
+			ethMsgIndex++
+		}
+	}
+
+	// this could only happen if tx exceeds block gas limit or a tx of the batch failed
 	if result.Code != 0 && tx != nil {
 		for i := 0; i < len(p.Txs); i++ {
 			p.Txs[i].Failed = true
 
 			// replace gasUsed with gasLimit because that's what's actually deducted.
-			gasLimit := tx.GetMsgs()[i].(*evmtypes.MsgEthereumTx).GetGas()
+			gasLimit := tx.GetMsgs()[p.Txs[i].MsgIndex].(*evmtypes.MsgEthereumTx).GetGas()
 			p.Txs[i].GasUsed = gasLimit
 		}
 	}
@@ -193,6 +222,24 @@ func (p *ParsedTxs) updateTx(eventIndex int, attrs []abci.EventAttribute) error
 	return nil
 }
 
+// fillFromResponses fills the gas used and the status of the txs with the msg
+// responses of the tx result data, called during parsing.
+func (p *ParsedTxs) fillFromResponses(data []byte) error {
+	responses, err := evmtypes.DecodeTxResponses(data)
+	if err != nil {
+		return err
+	}
+	if len(responses) != len(p.Txs) {
+		return fmt.Errorf("eth tx responses don't match the eth tx events: %d responses, %d txs", len(responses), len(p.Txs))
+	}
+
+	for i, res := range responses {
+		p.Txs[i].GasUsed = res.GasUsed
+		p.Txs[i].Failed = res.Failed()
+	}
+	return nil
+}
+
 // GetTxByHash find ParsedTx by tx hash, returns nil if not exists.
 func (p *ParsedTxs) GetTxByHash(hash common.Hash) *ParsedTx {
 	if idx, ok := p.TxHashes[hash]; ok {
@@ -203,10 +250,12 @@ func (p *ParsedTxs) GetTxByHash(hash common.Hash) *ParsedTx {
 
 // GetTxByMsgIndex returns ParsedTx by msg index
 func (p *ParsedTxs) GetTxByMsgIndex(i int) *ParsedTx {
-	if i < 0 || i >= len(p.Txs) {
-		return nil
+	for j := range p.Txs {
+		if p.Txs[j].MsgIndex == i {
+			return &p.Txs[j]
+		}
 	}
-	return &p.Txs[i]
+	return nil
 }
 
 // GetTxByTxIndex returns ParsedTx by tx index
@@ -215,20 +264,46 @@ func (p *ParsedTxs) GetTxByTxIndex(txIndex int) *ParsedTx {
 		return nil
 	}
 	// assuming the `EthTxIndex` increase continuously,
-	// convert TxIndex to MsgIndex by subtract the begin TxIndex.
-	msgIndex := txIndex - int(p.Txs[0].EthTxIndex)
-	// GetTxByMsgIndex will check the bound
-	return p.GetTxByMsgIndex(msgIndex)
+	// convert TxIndex to the index of the tx by subtract the begin TxIndex.
+	i := txIndex - int(p.Txs[0].EthTxIndex)
+	if i < 0 || i >= len(p.Txs) {
+		return nil
+	}
+	return &p.Txs[i]
 }
 
 // AccumulativeGasUsed calculates the accumulated gas used within the batch of txs
 func (p *ParsedTxs) AccumulativeGasUsed(msgIndex int) (result uint64) {
-	for i := 0; i <= msgIndex; i++ {
-		result += p.Txs[i].GasUsed
+	for _, tx := range p.Txs {
+		if tx.MsgIndex <= msgIndex {
+			result += tx.GasUsed
+		}
 	}
 	return result
 }
 
+// ParseModifiedAccounts parses the accounts modified by the eth txs of a tx
+// result from the events emitted by the evm module, when it records them.
+func ParseModifiedAccounts(result *abci.ExecTxResult) ([]common.Address, error) {
+	var accounts []common.Address
+	for _, event := range result.Events {
+		if event.Type != evmtypes.EventTypeModifiedAccounts {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyModifiedAccounts {
+				continue
+			}
+			var txAccounts []common.Address
+			if err := json.Unmarshal([]byte(attr.Value), &txAccounts); err != nil {
+				return nil, err
+			}
+			accounts = append(accounts, txAccounts...)
+		}
+	}
+	return accounts, nil
+}
+
 // fillTxAttribute parse attributes by name, less efficient than hardcode the index, but more stable against event
 // format changes.
 func fillTxAttribute(tx *ParsedTx, key string, value string) error {
@@ -261,3 +336,97 @@ func fillTxAttributes(tx *ParsedTx, attrs []abci.EventAttribute) error {
 	}
 	return nil
 }
+
+// AllTxLogsFromResult returns the ethereum logs of all the eth msgs of the tx
+// result, decoded from the msg responses in the result data. The tx_log events
+// are parsed for the results of the txs without eth msg responses, which were
+// executed before the logs were no longer emitted as events.
+func AllTxLogsFromResult(result *abci.ExecTxResult) ([][]*ethtypes.Log, error) {
+	responses, err := evmtypes.DecodeTxResponses(result.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(responses) == 0 {
+		return AllTxLogsFromEvents(result.Events)
+	}
+
+	allLogs := make([][]*ethtypes.Log, 0, len(responses))
+	for _, res := range responses {
+		allLogs = append(allLogs, evmtypes.LogsToEthereum(res.Logs))
+	}
+	return allLogs, nil
+}
+
+// TxLogsFromResult returns the ethereum logs of the eth msg of the tx result for
+// specific msg index
+func TxLogsFromResult(result *abci.ExecTxResult, msgIndex int) ([]*ethtypes.Log, error) {
+	allLogs, err := AllTxLogsFromResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	// a mixed tx, combining eth and cosmos msgs, has a single eth msg, which
+	// isn't necessarily its first msg
+	if len(allLogs) == 1 && msgIndex > 0 {
+		return allLogs[0], nil
+	}
+	if msgIndex < 0 || msgIndex >= len(allLogs) {
+		return nil, fmt.Errorf("eth tx logs not found for message index %d", msgIndex)
+	}
+	return allLogs[msgIndex], nil
+}
+
+// AllTxLogsFromEvents parses all ethereum logs from cosmos events
+func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
+	allLogs := make([][]*ethtypes.Log, 0, 4)
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		logs, err := ParseTxLogsFromEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		allLogs = append(allLogs, logs)
+	}
+	return allLogs, nil
+}
+
+// TxLogsFromEvents parses ethereum logs from cosmos events for specific msg index
+func TxLogsFromEvents(events []abci.Event, msgIndex int) ([]*ethtypes.Log, error) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		if msgIndex > 0 {
+			// not the eth tx we want
+			msgIndex--
+			continue
+		}
+
+		return ParseTxLogsFromEvent(event)
+	}
+	return nil, fmt.Errorf("eth tx logs not found for message index %d", msgIndex)
+}
+
+// ParseTxLogsFromEvent parse tx logs from one event
+func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
+	logs := make([]*evmtypes.Log, 0, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		if attr.Key != evmtypes.AttributeKeyTxLog {
+			continue
+		}
+
+		var txLog evmtypes.Log
+		if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, &txLog)
+	}
+	return evmtypes.LogsToEthereum(logs), nil
+}
@@ -10,6 +10,10 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParseTxResult(t *testing.T) {
@@ -17,6 +21,15 @@ func TestParseTxResult(t *testing.T) {
 	txHash := common.BigToHash(big.NewInt(1))
 	txHash2 := common.BigToHash(big.NewInt(2))
 
+	// msg responses of the tx result data in the compact events mode
+	compactData, err := proto.Marshal(&sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{
+			codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{Hash: txHash.Hex(), GasUsed: 21000}),
+			codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{Hash: txHash2.Hex(), GasUsed: 30000, VmError: "execution reverted"}),
+		},
+	})
+	require.NoError(t, err)
+
 	testCases := []struct {
 		name     string
 		response abci.ExecTxResult
@@ -167,6 +180,56 @@ func TestParseTxResult(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"compact events",
+			abci.ExecTxResult{
+				GasUsed: 51000,
+				Data:    compactData,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "10"},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash2.Hex()},
+						{Key: "txIndex", Value: "11"},
+					}},
+					{Type: "message", Attributes: []abci.EventAttribute{
+						{Key: "module", Value: "evm"},
+						{Key: "sender", Value: address},
+					}},
+				},
+			},
+			[]*ParsedTx{
+				{
+					MsgIndex:   0,
+					Hash:       txHash,
+					EthTxIndex: 10,
+					GasUsed:    21000,
+					Failed:     false,
+				},
+				{
+					MsgIndex:   1,
+					Hash:       txHash2,
+					EthTxIndex: 11,
+					GasUsed:    30000,
+					Failed:     true,
+				},
+			},
+		},
+		{
+			"compact events, msg responses don't match",
+			abci.ExecTxResult{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "10"},
+					}},
+				},
+			},
+			nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -190,3 +253,33 @@ func TestParseTxResult(t *testing.T) {
 		})
 	}
 }
+
+func TestParseModifiedAccounts(t *testing.T) {
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr2 := common.BigToAddress(big.NewInt(2))
+
+	event1, err := evmtypes.NewModifiedAccountsEvent(common.BigToHash(big.NewInt(1)), []common.Address{addr2, addr1})
+	require.NoError(t, err)
+	event2, err := evmtypes.NewModifiedAccountsEvent(common.BigToHash(big.NewInt(2)), []common.Address{addr2})
+	require.NoError(t, err)
+
+	// the accounts of the eth txs are concatenated, the other events are ignored
+	accounts, err := ParseModifiedAccounts(&abci.ExecTxResult{Events: []abci.Event{
+		abci.Event(event1),
+		{Type: evmtypes.EventTypeEthereumTx},
+		abci.Event(event2),
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{addr1, addr2, addr2}, accounts)
+
+	accounts, err = ParseModifiedAccounts(&abci.ExecTxResult{})
+	require.NoError(t, err)
+	require.Nil(t, accounts)
+
+	_, err = ParseModifiedAccounts(&abci.ExecTxResult{Events: []abci.Event{
+		{Type: evmtypes.EventTypeModifiedAccounts, Attributes: []abci.EventAttribute{
+			{Key: evmtypes.AttributeKeyModifiedAccounts, Value: "["},
+		}},
+	}})
+	require.Error(t, err)
+}
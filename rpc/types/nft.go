@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// nftMetadataABI is the ABI of the metadata methods of the ERC721 and ERC1155
+// tokens.
+const nftMetadataABI = `[
+	{"type":"function","name":"tokenURI","stateMutability":"view","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"uri","stateMutability":"view","inputs":[{"name":"id","type":"uint256"}],"outputs":[{"name":"","type":"string"}]}
+]`
+
+// NFTMetadataABI is the parsed ABI of the metadata methods of the ERC721 and
+// ERC1155 tokens.
+var NFTMetadataABI abi.ABI
+
+func init() {
+	var err error
+	NFTMetadataABI, err = abi.JSON(strings.NewReader(nftMetadataABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// NFTsByOwnerArgs represents the arguments of an evm_getNFTsByOwner query.
+// Cursor is the NextCursor of the previous page, an empty cursor starts from
+// the first token.
+type NFTsByOwnerArgs struct {
+	Owner  common.Address `json:"owner"`
+	Cursor hexutil.Bytes  `json:"cursor"`
+	Limit  *hexutil.Uint  `json:"limit"`
+}
+
+// NFTsByOwnerResult is a page of the NFTs of an owner, ordered by token contract
+// and token id. NextCursor is nil if the page has the last NFT of the owner.
+type NFTsByOwnerResult struct {
+	NFTs       []NFT         `json:"nfts"`
+	NextCursor hexutil.Bytes `json:"nextCursor"`
+}
+
+// NFT is an ERC721 or ERC1155 token held by an owner, with its balance and the
+// URI of its metadata, returned by the tokenURI method of ERC721 or the uri
+// method of ERC1155 at the latest block. TokenURI is omitted if the token
+// doesn't implement the metadata extension.
+type NFT struct {
+	Standard string         `json:"standard"`
+	Token    common.Address `json:"token"`
+	TokenID  *hexutil.Big   `json:"tokenId"`
+	Balance  *hexutil.Big   `json:"balance"`
+	TokenURI *string        `json:"tokenURI,omitempty"`
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// NonceCacheSize is the number of account nonces kept by the NonceCache of
+	// the json-rpc backend
+	NonceCacheSize = 8192
+
+	// PendingNoncesRefreshInterval is the max age of the pending nonces of the
+	// NonceCache before they're refreshed from the mempool
+	PendingNoncesRefreshInterval = time.Second
+)
+
+var (
+	nonceCacheHitCounter      = metrics.NewRegisteredCounter("rpc/noncecache/hits", nil)
+	nonceCacheMissCounter     = metrics.NewRegisteredCounter("rpc/noncecache/misses", nil)
+	pendingNoncesRefreshTimer = metrics.NewRegisteredTimer("rpc/noncecache/pending/refresh", nil)
+)
+
+// NonceCache caches the nonces of the accounts by height, and keeps an overlay
+// of the nonces of the pending txs by sender, so that the nonce of an account,
+// pending or not, isn't queried and the mempool isn't scanned on every
+// eth_getTransactionCount call.
+//
+// The nonces at a height never change, so the cached ones are only evicted
+// when the cache is full. The pending nonces are refreshed from the mempool
+// once a block is committed, as its txs are removed from the mempool, or once
+// they're older than PendingNoncesRefreshInterval, and the txs sent through
+// the backend are added to them meanwhile.
+type NonceCache struct {
+	nonces *resizableLRU[nonceKey, uint64]
+
+	mtx sync.Mutex
+	// pending are the nonces of the pending txs by sender
+	pending map[common.Address]map[uint64]struct{}
+	// height is the latest height when the pending nonces were refreshed
+	height int64
+	// refreshed is the time the pending nonces were refreshed
+	refreshed time.Time
+}
+
+type nonceKey struct {
+	address common.Address
+	height  int64
+}
+
+// NewNonceCache creates a nonce cache keeping the nonces of up to size
+// accounts and heights.
+func NewNonceCache(size int) *NonceCache {
+	return &NonceCache{
+		nonces:  newResizableLRU[nonceKey, uint64](size),
+		pending: make(map[common.Address]map[uint64]struct{}),
+	}
+}
+
+// Size returns the number of nonces kept by the cache.
+func (c *NonceCache) Size() int {
+	return c.nonces.Size()
+}
+
+// Resize drops the cached nonces and keeps up to size of them from now on, the
+// pending nonces being kept. It returns the previous size.
+func (c *NonceCache) Resize(size int) int {
+	return c.nonces.Resize(size)
+}
+
+// Nonce returns the nonce of the account at the height, if it's cached.
+func (c *NonceCache) Nonce(address common.Address, height int64) (uint64, bool) {
+	nonce, ok := c.nonces.Get(nonceKey{address: address, height: height})
+	if ok {
+		nonceCacheHitCounter.Inc(1)
+	} else {
+		nonceCacheMissCounter.Inc(1)
+	}
+	return nonce, ok
+}
+
+// Contains returns true if the nonce of the account at the height is cached.
+func (c *NonceCache) Contains(address common.Address, height int64) bool {
+	return c.nonces.Contains(nonceKey{address: address, height: height})
+}
+
+// AddNonce records the nonce of the account at the height.
+func (c *NonceCache) AddNonce(address common.Address, height int64, nonce uint64) {
+	c.nonces.Add(nonceKey{address: address, height: height}, nonce)
+}
+
+// PendingNonce returns the nonce of the next tx of the account given its nonce
+// at the latest height, i.e. the nonce following the ones of its pending txs
+// which are consecutive from it. The pending txs whose nonce is below it were
+// committed, and the ones after a gap can't be executed yet.
+func (c *NonceCache) PendingNonce(address common.Address, nonce uint64) uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	nonces := c.pending[address]
+	for {
+		if _, ok := nonces[nonce]; !ok {
+			return nonce
+		}
+		nonce++
+	}
+}
+
+// AddPending records the nonce of a pending tx of the sender, e.g. of a tx
+// sent through the backend, until the next refresh of the pending nonces.
+func (c *NonceCache) AddPending(sender common.Address, nonce uint64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.addPending(sender, nonce)
+}
+
+// RefreshPending replaces the pending nonces by the ones of the txs in the
+// mempool, returned by the given function, if they were refreshed before the
+// given latest height or for too long. The callers wait for the refresh in
+// progress, so the mempool is scanned once for all of them.
+func (c *NonceCache) RefreshPending(height int64, mempoolNonces func() (map[common.Address][]uint64, error)) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if height <= c.height && time.Since(c.refreshed) < PendingNoncesRefreshInterval {
+		return nil
+	}
+
+	start := time.Now()
+	nonces, err := mempoolNonces()
+	if err != nil {
+		return err
+	}
+	pendingNoncesRefreshTimer.UpdateSince(start)
+
+	c.pending = make(map[common.Address]map[uint64]struct{}, len(nonces))
+	for sender, senderNonces := range nonces {
+		for _, nonce := range senderNonces {
+			c.addPending(sender, nonce)
+		}
+	}
+	c.height = max(c.height, height)
+	c.refreshed = time.Now()
+	return nil
+}
+
+func (c *NonceCache) addPending(sender common.Address, nonce uint64) {
+	nonces, ok := c.pending[sender]
+	if !ok {
+		nonces = make(map[uint64]struct{})
+		c.pending[sender] = nonces
+	}
+	nonces[nonce] = struct{}{}
+}
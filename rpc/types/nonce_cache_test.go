@@ -0,0 +1,66 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceCacheNonce(t *testing.T) {
+	cache := NewNonceCache(2)
+	addr := common.HexToAddress("0x1")
+
+	_, ok := cache.Nonce(addr, 10)
+	require.False(t, ok)
+	cache.AddNonce(addr, 10, 5)
+	require.True(t, cache.Contains(addr, 10))
+	nonce, ok := cache.Nonce(addr, 10)
+	require.True(t, ok)
+	require.Equal(t, uint64(5), nonce)
+
+	// the nonces are cached by height
+	require.False(t, cache.Contains(addr, 11))
+	cache.AddNonce(addr, 11, 6)
+	cache.AddNonce(addr, 12, 7)
+	require.False(t, cache.Contains(addr, 10), "the least recently used nonce should be evicted")
+}
+
+func TestNonceCachePending(t *testing.T) {
+	cache := NewNonceCache(1)
+	addr := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+
+	var scans int
+	mempool := map[common.Address][]uint64{addr: {4, 5, 6, 8}, other: {0}}
+	mempoolNonces := func() (map[common.Address][]uint64, error) {
+		scans++
+		return mempool, nil
+	}
+
+	// the pending nonce follows the consecutive nonces of the pending txs
+	require.NoError(t, cache.RefreshPending(10, mempoolNonces))
+	require.Equal(t, uint64(7), cache.PendingNonce(addr, 4))
+	require.Equal(t, uint64(3), cache.PendingNonce(addr, 3))
+	require.Equal(t, uint64(1), cache.PendingNonce(other, 0))
+
+	// the txs sent are pending until the next refresh, which only happens once
+	// a block is committed or the pending nonces are too old
+	cache.AddPending(addr, 7)
+	require.Equal(t, uint64(9), cache.PendingNonce(addr, 4))
+	require.NoError(t, cache.RefreshPending(10, mempoolNonces))
+	require.Equal(t, 1, scans)
+
+	mempool = map[common.Address][]uint64{addr: {7}}
+	require.NoError(t, cache.RefreshPending(11, mempoolNonces))
+	require.Equal(t, 2, scans)
+	require.Equal(t, uint64(8), cache.PendingNonce(addr, 7))
+	require.Equal(t, uint64(0), cache.PendingNonce(other, 0))
+
+	// the pending nonces are kept if the mempool can't be scanned
+	require.Error(t, cache.RefreshPending(12, func() (map[common.Address][]uint64, error) {
+		return nil, errors.New("mempool unavailable")
+	}))
+	require.Equal(t, uint64(8), cache.PendingNonce(addr, 7))
+}
@@ -8,6 +8,7 @@ import (
 
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -23,12 +24,13 @@ type QueryClient struct {
 	FeeMarket feemarkettypes.QueryClient
 }
 
-// NewQueryClient creates a new gRPC query client
-func NewQueryClient(clientCtx client.Context) *QueryClient {
+// NewQueryClient creates a new gRPC query client over the connection, e.g. the
+// client context
+func NewQueryClient(conn gogogrpc.ClientConn) *QueryClient {
 	return &QueryClient{
-		ServiceClient: tx.NewServiceClient(clientCtx),
-		QueryClient:   evmtypes.NewQueryClient(clientCtx),
-		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		ServiceClient: tx.NewServiceClient(conn),
+		QueryClient:   evmtypes.NewQueryClient(conn),
+		FeeMarket:     feemarkettypes.NewQueryClient(conn),
 	}
 }
 
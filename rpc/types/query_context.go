@@ -0,0 +1,101 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"google.golang.org/grpc"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+)
+
+// QueryContextCacheSize is the number of heights whose query context is kept
+// by the QueryContextPool of the json-rpc backend
+const QueryContextCacheSize = 1024
+
+var (
+	queryContextHitCounter  = metrics.NewRegisteredCounter("rpc/querycontext/hits", nil)
+	queryContextMissCounter = metrics.NewRegisteredCounter("rpc/querycontext/misses", nil)
+	queryInFlightGauge      = metrics.NewRegisteredGauge("rpc/query/inflight", nil)
+	queryWaitTimer          = metrics.NewRegisteredTimer("rpc/query/wait", nil)
+)
+
+// QueryContextPool keeps the gRPC query contexts keyed by height, so that the
+// context of a height is created once instead of once per query, and bounds
+// the number of queries run concurrently through the connections it wraps.
+type QueryContextPool struct {
+	contexts *resizableLRU[int64, context.Context]
+	// slots is nil if the number of concurrent queries isn't bounded
+	slots chan struct{}
+}
+
+// NewQueryContextPool creates a pool keeping the query contexts of up to size
+// heights, and running up to maxConcurrent queries at once, 0 is no limit.
+func NewQueryContextPool(size, maxConcurrent int) *QueryContextPool {
+	p := &QueryContextPool{contexts: newResizableLRU[int64, context.Context](size)}
+	if maxConcurrent > 0 {
+		p.slots = make(chan struct{}, maxConcurrent)
+	}
+	return p
+}
+
+// Context returns the query context of the height, as ContextWithHeight does.
+// The returned context is shared and must not be canceled.
+func (p *QueryContextPool) Context(height int64) context.Context {
+	if ctx, ok := p.contexts.Get(height); ok {
+		queryContextHitCounter.Inc(1)
+		return ctx
+	}
+
+	queryContextMissCounter.Inc(1)
+	ctx := ContextWithHeight(height)
+	p.contexts.Add(height, ctx)
+	return ctx
+}
+
+// Size returns the number of heights whose query context is kept by the pool.
+func (p *QueryContextPool) Size() int {
+	return p.contexts.Size()
+}
+
+// Resize drops the kept query contexts and keeps the ones of up to size heights
+// from now on. It returns the previous size.
+func (p *QueryContextPool) Resize(size int) int {
+	return p.contexts.Resize(size)
+}
+
+// Conn wraps the gRPC connection so that the queries run through it wait for
+// a slot of the pool once the max concurrent queries are running.
+func (p *QueryContextPool) Conn(conn gogogrpc.ClientConn) gogogrpc.ClientConn {
+	if p.slots == nil {
+		return conn
+	}
+	return &limitedConn{ClientConn: conn, slots: p.slots}
+}
+
+// limitedConn is a gRPC connection running the queries in the slots of a pool.
+// The streams aren't limited.
+type limitedConn struct {
+	gogogrpc.ClientConn
+	slots chan struct{}
+}
+
+// Invoke runs the query once a slot is available, or returns the error of the
+// context if it's done first.
+func (c *limitedConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	start := time.Now()
+	select {
+	case c.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	queryWaitTimer.UpdateSince(start)
+
+	queryInFlightGauge.Inc(1)
+	defer func() {
+		queryInFlightGauge.Dec(1)
+		<-c.slots
+	}()
+	return c.ClientConn.Invoke(ctx, method, args, reply, opts...)
+}
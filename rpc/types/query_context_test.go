@@ -0,0 +1,90 @@
+package types
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+)
+
+func TestQueryContextPoolContext(t *testing.T) {
+	pool := NewQueryContextPool(2, 0)
+
+	ctx := pool.Context(10)
+	require.Equal(t, ContextWithHeight(10), ctx)
+	require.True(t, ctx == pool.Context(10), "the context of a height should be reused")
+	require.Equal(t, ContextWithHeight(0), pool.Context(0))
+
+	// the least recently used height is evicted
+	pool.Context(11)
+	require.False(t, ctx == pool.Context(10))
+}
+
+// blockingConn counts the queries running at once until they're released
+type blockingConn struct {
+	gogogrpc.ClientConn
+	running, maxRunning atomic.Int32
+	release             chan struct{}
+}
+
+func (c *blockingConn) Invoke(context.Context, string, interface{}, interface{}, ...grpc.CallOption) error {
+	running := c.running.Add(1)
+	for {
+		maxRunning := c.maxRunning.Load()
+		if running <= maxRunning || c.maxRunning.CompareAndSwap(maxRunning, running) {
+			break
+		}
+	}
+	<-c.release
+	c.running.Add(-1)
+	return nil
+}
+
+func TestQueryContextPoolConn(t *testing.T) {
+	conn := &blockingConn{release: make(chan struct{})}
+	require.True(t, NewQueryContextPool(1, 0).Conn(conn) == gogogrpc.ClientConn(conn), "an unlimited pool shouldn't wrap the connection")
+
+	limited := NewQueryContextPool(1, 2).Conn(conn)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, limited.Invoke(context.Background(), "/query", nil, nil))
+		}()
+	}
+
+	// a canceled query doesn't wait for a slot
+	require.Eventually(t, func() bool { return conn.running.Load() == 2 }, time.Second, time.Millisecond)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, limited.Invoke(canceled, "/query", nil, nil), context.Canceled)
+
+	for i := 0; i < 5; i++ {
+		conn.release <- struct{}{}
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), conn.maxRunning.Load())
+}
+
+func TestQueryContextPoolResize(t *testing.T) {
+	pool := NewQueryContextPool(2, 0)
+	ctx := pool.Context(10)
+	require.Equal(t, 2, pool.Size())
+
+	// the contexts are dropped on resize
+	require.Equal(t, 2, pool.Resize(1))
+	require.Equal(t, 1, pool.Size())
+	require.False(t, ctx == pool.Context(10))
+
+	ctx = pool.Context(10)
+	pool.Context(11)
+	require.False(t, ctx == pool.Context(10), "the pool should keep a single height")
+}
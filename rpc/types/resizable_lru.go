@@ -0,0 +1,53 @@
+package types
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+)
+
+// resizableLRU is an LRU cache whose capacity can be changed while the node is
+// running, by the admin namespace. The cached entries are dropped on resize.
+type resizableLRU[K comparable, V any] struct {
+	mtx   sync.RWMutex
+	cache *lru.Cache[K, V]
+	size  int
+}
+
+func newResizableLRU[K comparable, V any](size int) *resizableLRU[K, V] {
+	return &resizableLRU[K, V]{cache: lru.NewCache[K, V](size), size: size}
+}
+
+func (c *resizableLRU[K, V]) current() *lru.Cache[K, V] {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.cache
+}
+
+func (c *resizableLRU[K, V]) Get(key K) (V, bool) {
+	return c.current().Get(key)
+}
+
+func (c *resizableLRU[K, V]) Contains(key K) bool {
+	return c.current().Contains(key)
+}
+
+func (c *resizableLRU[K, V]) Add(key K, value V) {
+	c.current().Add(key, value)
+}
+
+func (c *resizableLRU[K, V]) Size() int {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.size
+}
+
+// Resize replaces the cache by an empty one of the given capacity, and returns
+// the previous capacity.
+func (c *resizableLRU[K, V]) Resize(size int) int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	prev := c.size
+	c.cache, c.size = lru.NewCache[K, V](size), size
+	return prev
+}
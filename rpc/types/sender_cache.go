@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// SenderCacheSize is the number of senders kept by the DefaultSenderCache
+const SenderCacheSize = 8192
+
+// DefaultSenderCache is the sender cache shared by the json-rpc backend and the indexer
+var DefaultSenderCache = NewSenderCache(SenderCacheSize)
+
+// SenderCache caches the senders of eth txs keyed by tx hash, so that the
+// signature of a tx without From field is recovered once, instead of every
+// time the tx is formatted.
+type SenderCache struct {
+	senders *resizableLRU[common.Hash, common.Address]
+}
+
+// NewSenderCache creates a sender cache keeping up to size senders
+func NewSenderCache(size int) *SenderCache {
+	return &SenderCache{senders: newResizableLRU[common.Hash, common.Address](size)}
+}
+
+// Size returns the number of senders kept by the cache.
+func (c *SenderCache) Size() int {
+	return c.senders.Size()
+}
+
+// Resize drops the cached senders and keeps up to size of them from now on. It
+// returns the previous size.
+func (c *SenderCache) Resize(size int) int {
+	return c.senders.Resize(size)
+}
+
+// Add records the sender of a tx
+func (c *SenderCache) Add(txHash common.Hash, sender common.Address) {
+	c.senders.Add(txHash, sender)
+}
+
+// GetSender returns the sender of the msg, and sets it as the From field of
+// the msg. It's only recovered from the signature if the msg has no From field
+// and the sender isn't cached.
+func (c *SenderCache) GetSender(msg *evmtypes.MsgEthereumTx) (common.Address, error) {
+	if len(msg.From) > 0 {
+		return msg.GetSender(), nil
+	}
+
+	tx := msg.AsTransaction()
+	if sender, ok := c.senders.Get(tx.Hash()); ok {
+		msg.From = sender.Bytes()
+		return sender, nil
+	}
+
+	sender, err := msg.GetSenderLegacy(evmtypes.TxSigner(tx))
+	if err != nil {
+		return common.Address{}, err
+	}
+	c.senders.Add(tx.Hash(), sender)
+	return sender, nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestSenderCache(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(9001))
+	tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(9001),
+		Gas:       21000,
+		GasFeeCap: big.NewInt(1),
+		GasTipCap: big.NewInt(1),
+	})
+	require.NoError(t, err)
+	newMsg := func() *evmtypes.MsgEthereumTx {
+		msg := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(tx))
+		return msg
+	}
+
+	cache := NewSenderCache(2)
+
+	// the From field is used as is
+	msg := newMsg()
+	msg.From = common.BigToAddress(big.NewInt(1)).Bytes()
+	sender, err := cache.GetSender(msg)
+	require.NoError(t, err)
+	require.Equal(t, common.BigToAddress(big.NewInt(1)), sender)
+
+	// the sender is recovered once and cached
+	msg = newMsg()
+	sender, err = cache.GetSender(msg)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+	require.Equal(t, from.Bytes(), msg.From)
+	cached, ok := cache.senders.Get(tx.Hash())
+	require.True(t, ok)
+	require.Equal(t, from, cached)
+
+	// a cached sender is returned without recovery
+	other := common.BigToAddress(big.NewInt(2))
+	cache.Add(tx.Hash(), other)
+	msg = newMsg()
+	sender, err = cache.GetSender(msg)
+	require.NoError(t, err)
+	require.Equal(t, other, sender)
+	require.Equal(t, other.Bytes(), msg.From)
+}
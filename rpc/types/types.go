@@ -1,11 +1,17 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -87,3 +93,218 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// TraceFilterArgs represents the arguments of a trace_filter query. The traces
+// match if their sender is in FromAddress and their recipient is in ToAddress,
+// an empty list matches any address.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// CosmosTxResult locates the cosmos tx that includes an eth tx, TxIndex is the
+// index of the cosmos tx in its block and MsgIndex the index of the eth tx msg.
+type CosmosTxResult struct {
+	Hash        cmtbytes.HexBytes `json:"hash"`
+	BlockNumber hexutil.Uint64    `json:"blockNumber"`
+	TxIndex     hexutil.Uint64    `json:"txIndex"`
+	MsgIndex    hexutil.Uint64    `json:"msgIndex"`
+}
+
+// TransactionProof proves the inclusion of an eth tx in a block. Proof is the
+// merkle proof of the cosmos tx including the eth tx against the data hash of the
+// block header, and MsgIndex the index of the eth tx msg in the cosmos tx.
+type TransactionProof struct {
+	Hash         common.Hash       `json:"hash"`
+	CosmosTxHash cmtbytes.HexBytes `json:"cosmosTxHash"`
+	BlockHash    common.Hash       `json:"blockHash"`
+	BlockNumber  hexutil.Uint64    `json:"blockNumber"`
+	MsgIndex     hexutil.Uint64    `json:"msgIndex"`
+	Proof        cmttypes.TxProof  `json:"proof"`
+}
+
+// InternalTransaction is an evm call committed by a cosmos msg instead of an
+// eth tx, e.g. the erc20 mint of a coin conversion. TxIndex is the index of the
+// cosmos tx in its block and To is nil for the contract creations.
+type InternalTransaction struct {
+	CosmosTxHash    cmtbytes.HexBytes `json:"cosmosTxHash"`
+	BlockHash       common.Hash       `json:"blockHash"`
+	BlockNumber     hexutil.Uint64    `json:"blockNumber"`
+	TxIndex         hexutil.Uint64    `json:"txIndex"`
+	From            common.Address    `json:"from"`
+	To              *common.Address   `json:"to"`
+	ContractAddress *common.Address   `json:"contractAddress"`
+	GasUsed         hexutil.Uint64    `json:"gasUsed"`
+	Logs            []*ethtypes.Log   `json:"logs"`
+}
+
+// PoolStats is the priority distribution of the eth txs in the mempool, as
+// computed by the ante handler against the base fee of the latest block and the
+// priority bounds of the node. The min, median and max are zero if Pending is.
+type PoolStats struct {
+	Pending         hexutil.Uint64 `json:"pending"`
+	BaseFee         *hexutil.Big   `json:"baseFee"`
+	MinPriority     int64          `json:"minPriority"`
+	MedianPriority  int64          `json:"medianPriority"`
+	MaxPriority     int64          `json:"maxPriority"`
+	PriorityFloor   int64          `json:"priorityFloor"`
+	PriorityCeiling int64          `json:"priorityCeiling"`
+}
+
+// ContractMetadataArgs are the Solidity metadata of the contract deployed at
+// Address, as output by the compiler, submitted with evm_submitContractMetadata.
+// Sources are the contents of the sources listed by the metadata, by path,
+// which must include the ones not embedded in the metadata.
+type ContractMetadataArgs struct {
+	Address  common.Address    `json:"address"`
+	Metadata string            `json:"metadata"`
+	Sources  map[string]string `json:"sources"`
+}
+
+// ContractMetadata is the Solidity metadata of the contracts of a code, stored
+// by the node once checked against the metadata hash appended to the code, with
+// the sources of the contract and the fields of the metadata read by the
+// explorers.
+type ContractMetadata struct {
+	CodeHash     common.Hash       `json:"codeHash"`
+	ContractName string            `json:"contractName"`
+	Compiler     string            `json:"compiler"`
+	Language     string            `json:"language"`
+	Metadata     string            `json:"metadata"`
+	Sources      map[string]string `json:"sources"`
+}
+
+// AccountBalance is the balance of an account, as ranked by explorer_getTopAccounts.
+type AccountBalance struct {
+	Address common.Address `json:"address"`
+	Balance *hexutil.Big   `json:"balance"`
+}
+
+// AddressTransactions is a page of the eth txs of an address, newest first.
+// NextCursor is nil if the page has the oldest tx of the address.
+type AddressTransactions struct {
+	Transactions []*RPCTransaction `json:"transactions"`
+	NextCursor   hexutil.Bytes     `json:"nextCursor"`
+}
+
+// ContractCreation is the eth tx which created a contract.
+type ContractCreation struct {
+	Address     common.Address `json:"address"`
+	Creator     common.Address `json:"creator"`
+	TxHash      common.Hash    `json:"txHash"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+}
+
+// AddressTxsArgs represents the arguments of an evm_getTransactionsByAddress
+// query. Kinds filters the txs by the relations of the address with them, any
+// of "sent", "received" and "internal", an empty list matching any kind. Cursor
+// is the NextCursor of the previous page, an empty cursor starts from the
+// latest tx.
+type AddressTxsArgs struct {
+	Address common.Address `json:"address"`
+	Kinds   []string       `json:"kinds"`
+	Cursor  hexutil.Bytes  `json:"cursor"`
+	Limit   *hexutil.Uint  `json:"limit"`
+}
+
+// AddressTxsResult is a page of the eth txs of an address, newest first.
+// NextCursor is nil if the page has the oldest tx of the address.
+type AddressTxsResult struct {
+	Transactions []AddressTx   `json:"transactions"`
+	NextCursor   hexutil.Bytes `json:"nextCursor"`
+}
+
+// AddressTx is an eth tx of an address, with the relations of the address with
+// the tx: "sent", "received" for the txs sent to the address or creating it,
+// and "internal" for the txs whose internal calls are sent by or to the address.
+type AddressTx struct {
+	Hash             common.Hash    `json:"hash"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	Kinds            []string       `json:"kinds"`
+}
+
+// TokenTransfersArgs represents the arguments of an evm_getTokenTransfers query,
+// the blocks default to the latest one.
+type TokenTransfersArgs struct {
+	Address   common.Address `json:"address"`
+	FromBlock *BlockNumber   `json:"fromBlock"`
+	ToBlock   *BlockNumber   `json:"toBlock"`
+}
+
+// StorageSlotArgs represents a storage slot read by an evm_getStorageSlots
+// query, the slot is read at the latest block if BlockTag is empty.
+type StorageSlotArgs struct {
+	Address  common.Address    `json:"address"`
+	Slot     string            `json:"slot"`
+	BlockTag BlockNumberOrHash `json:"blockTag"`
+}
+
+// StorageRangeResult is the result of a debug_storageRangeAt query. It has the
+// format geth returns, the storage entries are keyed by the hash of their key.
+type StorageRangeResult struct {
+	Storage StorageMap `json:"storage"`
+	// NextKey is nil if Storage includes the last storage entry of the contract
+	NextKey *common.Hash `json:"nextKey"`
+}
+
+// StorageMap maps the hash of the storage keys to the storage entries
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry is a storage key value pair of a contract
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// AccountChange is the state of an account modified by the eth txs of a block,
+// as notified by an evm_subscribe accountChanges subscription.
+type AccountChange struct {
+	Address     common.Address `json:"address"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	Balance     *hexutil.Big   `json:"balance"`
+	Nonce       hexutil.Uint64 `json:"nonce"`
+	CodeHash    common.Hash    `json:"codeHash"`
+}
+
+// RuntimeSettings are the runtime tunables of the node, as returned by
+// admin_runtimeSettings.
+type RuntimeSettings struct {
+	GCPercent   int            `json:"gcPercent"`
+	MemoryLimit int64          `json:"memoryLimit"`
+	LogLevel    string         `json:"logLevel"`
+	Tracing     bool           `json:"tracing"`
+	CacheSizes  map[string]int `json:"cacheSizes"`
+}
+
+// FinalizedResult annotates the JSON object of a subscription payload, e.g. a
+// header or a log, with `"finalized": true`.
+//
+// CometBFT has instant finality: a block is final once it's committed, so the
+// blocks, and the logs of their txs, which are notified are never reorged. The
+// annotation lets the Ethereum tooling, which can't assume it, skip the
+// handling of the reorgs, e.g. waiting for confirmations or the removed logs.
+type FinalizedResult struct {
+	Result interface{}
+}
+
+// MarshalJSON appends the finalized field to the JSON object of the result.
+func (r FinalizedResult) MarshalJSON() ([]byte, error) {
+	bz, err := json.Marshal(r.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	bz = bytes.TrimSpace(bz)
+	if len(bz) < 2 || bz[0] != '{' || bz[len(bz)-1] != '}' {
+		return nil, fmt.Errorf("cannot annotate a non object result: %s", bz)
+	}
+	if len(bytes.TrimSpace(bz[1:len(bz)-1])) == 0 {
+		return []byte(`{"finalized":true}`), nil
+	}
+	return append(bz[:len(bz)-1:len(bz)-1], `,"finalized":true}`...), nil
+}
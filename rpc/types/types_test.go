@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalizedResult(t *testing.T) {
+	header := &ethtypes.Header{Number: big.NewInt(10), Difficulty: big.NewInt(0)}
+	bz, err := json.Marshal(FinalizedResult{Result: header})
+	require.NoError(t, err)
+
+	// the fields of the result are kept
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, true, res["finalized"])
+	require.Equal(t, "0xa", res["number"])
+	require.Equal(t, header.Hash().Hex(), res["hash"])
+
+	bz, err = json.Marshal(FinalizedResult{Result: &ethtypes.Log{Address: common.HexToAddress("0x1")}})
+	require.NoError(t, err)
+	res = nil
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, true, res["finalized"])
+	require.Equal(t, false, res["removed"])
+
+	bz, err = json.Marshal(FinalizedResult{Result: struct{}{}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"finalized":true}`, string(bz))
+
+	_, err = json.Marshal(FinalizedResult{Result: common.HexToHash("0x1")})
+	require.Error(t, err)
+}
+
+func TestDecodedLogs(t *testing.T) {
+	log := &ethtypes.Log{Address: common.HexToAddress("0x1"), Topics: []common.Hash{{1}}}
+	bz, err := json.Marshal(NewLogs([]*ethtypes.Log{log}))
+	require.NoError(t, err)
+	expected, err := json.Marshal([]*ethtypes.Log{log})
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(bz))
+
+	// the decoded event is appended to the fields of the log
+	bz, err = json.Marshal(&Log{Log: log, Decoded: &DecodedEvent{
+		Name:      "Ping",
+		Signature: "Ping(uint256)",
+		Params:    []DecodedParam{{Name: "count", Type: "uint256", Value: "1"}},
+	}})
+	require.NoError(t, err)
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, log.Address.Hex(), res["address"])
+	require.Equal(t, map[string]interface{}{
+		"name":      "Ping",
+		"signature": "Ping(uint256)",
+		"params":    []interface{}{map[string]interface{}{"name": "count", "type": "uint256", "indexed": false, "value": "1"}},
+	}, res["decoded"])
+
+	var crit FilterCriteria
+	require.NoError(t, json.Unmarshal([]byte(`{"address":"0x0000000000000000000000000000000000000001","fromBlock":"0x1","decoded":true}`), &crit))
+	require.True(t, crit.Decoded)
+	require.Equal(t, []common.Address{log.Address}, crit.Addresses)
+	require.Equal(t, int64(1), crit.FromBlock.Int64())
+
+	crit = FilterCriteria{}
+	require.NoError(t, json.Unmarshal([]byte(`{"toBlock":"latest"}`), &crit))
+	require.False(t, crit.Decoded)
+	require.Error(t, json.Unmarshal([]byte(`{"decoded":"yes"}`), &crit))
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// entryPointABI is the ABI of the EntryPoint and account methods used by the bundler.
+const entryPointABI = `[
+	{"type":"function","name":"handleOps","stateMutability":"nonpayable","outputs":[],"inputs":[
+		{"name":"ops","type":"tuple[]","components":[
+			{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},
+			{"name":"callData","type":"bytes"},{"name":"accountGasLimits","type":"bytes32"},{"name":"preVerificationGas","type":"uint256"},
+			{"name":"gasFees","type":"bytes32"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]},
+		{"name":"beneficiary","type":"address"}]},
+	{"type":"function","name":"validateUserOp","stateMutability":"nonpayable","outputs":[{"name":"validationData","type":"uint256"}],"inputs":[
+		{"name":"userOp","type":"tuple","components":[
+			{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},
+			{"name":"callData","type":"bytes"},{"name":"accountGasLimits","type":"bytes32"},{"name":"preVerificationGas","type":"uint256"},
+			{"name":"gasFees","type":"bytes32"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]},
+		{"name":"userOpHash","type":"bytes32"},{"name":"missingAccountFunds","type":"uint256"}]},
+	{"type":"function","name":"validatePaymasterUserOp","stateMutability":"nonpayable","outputs":[{"name":"context","type":"bytes"},{"name":"validationData","type":"uint256"}],"inputs":[
+		{"name":"userOp","type":"tuple","components":[
+			{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},
+			{"name":"callData","type":"bytes"},{"name":"accountGasLimits","type":"bytes32"},{"name":"preVerificationGas","type":"uint256"},
+			{"name":"gasFees","type":"bytes32"},{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]},
+		{"name":"userOpHash","type":"bytes32"},{"name":"maxCost","type":"uint256"}]}
+]`
+
+// EntryPointABI is the parsed ABI of the EntryPoint and account methods used by the bundler.
+var EntryPointABI abi.ABI
+
+func init() {
+	var err error
+	EntryPointABI, err = abi.JSON(strings.NewReader(entryPointABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// UserOperation is an ERC-4337 v0.7 user operation, in the format of the bundler
+// JSON-RPC methods.
+type UserOperation struct {
+	Sender                        common.Address  `json:"sender"`
+	Nonce                         *hexutil.Big    `json:"nonce"`
+	Factory                       *common.Address `json:"factory,omitempty"`
+	FactoryData                   hexutil.Bytes   `json:"factoryData,omitempty"`
+	CallData                      hexutil.Bytes   `json:"callData"`
+	CallGasLimit                  *hexutil.Big    `json:"callGasLimit"`
+	VerificationGasLimit          *hexutil.Big    `json:"verificationGasLimit"`
+	PreVerificationGas            *hexutil.Big    `json:"preVerificationGas"`
+	MaxFeePerGas                  *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+	Signature                     hexutil.Bytes   `json:"signature"`
+}
+
+// PackedUserOperation is the PackedUserOperation struct of the v0.7 EntryPoint.
+type PackedUserOperation struct {
+	Sender             common.Address `abi:"sender"`
+	Nonce              *big.Int       `abi:"nonce"`
+	InitCode           []byte         `abi:"initCode"`
+	CallData           []byte         `abi:"callData"`
+	AccountGasLimits   [32]byte       `abi:"accountGasLimits"`
+	PreVerificationGas *big.Int       `abi:"preVerificationGas"`
+	GasFees            [32]byte       `abi:"gasFees"`
+	PaymasterAndData   []byte         `abi:"paymasterAndData"`
+	Signature          []byte         `abi:"signature"`
+}
+
+// UserOperationGasEstimate is the result of eth_estimateUserOperationGas.
+type UserOperationGasEstimate struct {
+	PreVerificationGas            hexutil.Uint64  `json:"preVerificationGas"`
+	VerificationGasLimit          hexutil.Uint64  `json:"verificationGasLimit"`
+	CallGasLimit                  hexutil.Uint64  `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *hexutil.Uint64 `json:"paymasterVerificationGasLimit,omitempty"`
+}
+
+// Pack returns the user operation in the packed format of the EntryPoint.
+func (op UserOperation) Pack() PackedUserOperation {
+	packed := PackedUserOperation{
+		Sender:             op.Sender,
+		Nonce:              bigOrZero(op.Nonce),
+		CallData:           op.CallData,
+		AccountGasLimits:   packUint128s(op.VerificationGasLimit, op.CallGasLimit),
+		PreVerificationGas: bigOrZero(op.PreVerificationGas),
+		GasFees:            packUint128s(op.MaxPriorityFeePerGas, op.MaxFeePerGas),
+		Signature:          op.Signature,
+	}
+	if packed.CallData == nil {
+		packed.CallData = []byte{}
+	}
+	if packed.Signature == nil {
+		packed.Signature = []byte{}
+	}
+
+	packed.InitCode = []byte{}
+	if op.Factory != nil {
+		packed.InitCode = append(op.Factory.Bytes(), op.FactoryData...)
+	}
+
+	packed.PaymasterAndData = []byte{}
+	if op.Paymaster != nil {
+		limits := packUint128s(op.PaymasterVerificationGasLimit, op.PaymasterPostOpGasLimit)
+		packed.PaymasterAndData = append(op.Paymaster.Bytes(), limits[:]...)
+		packed.PaymasterAndData = append(packed.PaymasterAndData, op.PaymasterData...)
+	}
+	return packed
+}
+
+// Hash returns the hash of the user operation signed by the account, which
+// commits to the given EntryPoint and chain id.
+func (op UserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	packed := op.Pack()
+	inner := crypto.Keccak256(
+		common.LeftPadBytes(packed.Sender.Bytes(), 32),
+		common.BigToHash(packed.Nonce).Bytes(),
+		crypto.Keccak256(packed.InitCode),
+		crypto.Keccak256(packed.CallData),
+		packed.AccountGasLimits[:],
+		common.BigToHash(packed.PreVerificationGas).Bytes(),
+		packed.GasFees[:],
+		crypto.Keccak256(packed.PaymasterAndData),
+	)
+	return crypto.Keccak256Hash(
+		inner,
+		common.LeftPadBytes(entryPoint.Bytes(), 32),
+		common.BigToHash(chainID).Bytes(),
+	)
+}
+
+// packUint128s packs the two values in the high and low 128 bits of a word.
+func packUint128s(high, low *hexutil.Big) [32]byte {
+	var word [32]byte
+	bigOrZero(high).FillBytes(word[:16])
+	bigOrZero(low).FillBytes(word[16:])
+	return word
+}
+
+func bigOrZero(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserOperationPack(t *testing.T) {
+	factory := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	paymaster := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	op := UserOperation{
+		Sender:                        common.HexToAddress("0x3000000000000000000000000000000000000003"),
+		Nonce:                         (*hexutil.Big)(big.NewInt(7)),
+		Factory:                       &factory,
+		FactoryData:                   hexutil.Bytes{0xaa},
+		CallData:                      hexutil.Bytes{0xbb},
+		CallGasLimit:                  (*hexutil.Big)(big.NewInt(2)),
+		VerificationGasLimit:          (*hexutil.Big)(big.NewInt(1)),
+		PreVerificationGas:            (*hexutil.Big)(big.NewInt(3)),
+		MaxFeePerGas:                  (*hexutil.Big)(big.NewInt(5)),
+		MaxPriorityFeePerGas:          (*hexutil.Big)(big.NewInt(4)),
+		Paymaster:                     &paymaster,
+		PaymasterVerificationGasLimit: (*hexutil.Big)(big.NewInt(6)),
+		PaymasterPostOpGasLimit:       (*hexutil.Big)(big.NewInt(8)),
+		PaymasterData:                 hexutil.Bytes{0xcc},
+	}
+
+	packed := op.Pack()
+	require.Equal(t, append(factory.Bytes(), 0xaa), packed.InitCode)
+	require.Equal(t, byte(1), packed.AccountGasLimits[15])
+	require.Equal(t, byte(2), packed.AccountGasLimits[31])
+	require.Equal(t, byte(4), packed.GasFees[15])
+	require.Equal(t, byte(5), packed.GasFees[31])
+	require.Len(t, packed.PaymasterAndData, 20+32+1)
+	require.Equal(t, paymaster.Bytes(), packed.PaymasterAndData[:20])
+	require.Equal(t, byte(6), packed.PaymasterAndData[35])
+	require.Equal(t, byte(8), packed.PaymasterAndData[51])
+	require.Equal(t, byte(0xcc), packed.PaymasterAndData[52])
+	require.Equal(t, []byte{}, packed.Signature)
+
+	// the packed user operation encodes as the handleOps argument
+	_, err := EntryPointABI.Pack("handleOps", []PackedUserOperation{packed}, common.Address{})
+	require.NoError(t, err)
+
+	empty := UserOperation{Sender: op.Sender}.Pack()
+	require.Equal(t, []byte{}, empty.InitCode)
+	require.Equal(t, []byte{}, empty.PaymasterAndData)
+	require.Equal(t, [32]byte{}, empty.AccountGasLimits)
+}
+
+func TestUserOperationHash(t *testing.T) {
+	op := UserOperation{
+		Sender:               common.HexToAddress("0x3000000000000000000000000000000000000003"),
+		Nonce:                (*hexutil.Big)(big.NewInt(1)),
+		CallData:             hexutil.Bytes{0x01, 0x02},
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100_000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(200_000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(10)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+		Signature:            hexutil.Bytes{0xff},
+	}
+	entryPoint := common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+	chainID := big.NewInt(262144)
+
+	mustType := func(name string) abi.Type {
+		typ, err := abi.NewType(name, "", nil)
+		require.NoError(t, err)
+		return typ
+	}
+	packed := op.Pack()
+	inner, err := abi.Arguments{
+		{Type: mustType("address")}, {Type: mustType("uint256")}, {Type: mustType("bytes32")},
+		{Type: mustType("bytes32")}, {Type: mustType("bytes32")}, {Type: mustType("uint256")},
+		{Type: mustType("bytes32")}, {Type: mustType("bytes32")},
+	}.Pack(
+		packed.Sender, packed.Nonce, crypto.Keccak256Hash(packed.InitCode), crypto.Keccak256Hash(packed.CallData),
+		packed.AccountGasLimits, packed.PreVerificationGas, packed.GasFees, crypto.Keccak256Hash(packed.PaymasterAndData),
+	)
+	require.NoError(t, err)
+	outer, err := abi.Arguments{
+		{Type: mustType("bytes32")}, {Type: mustType("address")}, {Type: mustType("uint256")},
+	}.Pack(crypto.Keccak256Hash(inner), entryPoint, chainID)
+	require.NoError(t, err)
+
+	require.Equal(t, crypto.Keccak256Hash(outer), op.Hash(entryPoint, chainID))
+
+	// the signature isn't part of the hash
+	op.Signature = hexutil.Bytes{0x00}
+	require.Equal(t, crypto.Keccak256Hash(outer), op.Hash(entryPoint, chainID))
+	require.NotEqual(t, op.Hash(entryPoint, chainID), op.Hash(entryPoint, big.NewInt(1)))
+}
@@ -2,9 +2,9 @@ package types
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
-	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -13,6 +13,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -24,15 +25,6 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// ExceedBlockGasLimitError defines the error message when tx execution exceeds the block gas limit.
-// The tx fee is deducted in ante handler, so it shouldn't be ignored in JSON-RPC API.
-const ExceedBlockGasLimitError = "out of gas in location: block gas meter; gasWanted:"
-
-// StateDBCommitError defines the error message when commit after executing EVM transaction, for example
-// transfer native token to a distribution module account 0x93354845030274cD4bf1686Abd60AB28EC52e1a7 using an evm type transaction
-// note: the transfer amount cannot be set to 0, otherwise this problem will not be triggered
-const StateDBCommitError = "failed to commit stateDB"
-
 // RawTxToEthTx returns a evm MsgEthereum transaction from raw tx bytes.
 func RawTxToEthTx(clientCtx client.Context, txBz cmttypes.Tx) ([]*evmtypes.MsgEthereumTx, error) {
 	tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
@@ -75,7 +67,7 @@ func EthHeaderFromTendermint(header cmttypes.Header, bloom ethtypes.Bloom, baseF
 		GasUsed:     0,
 		Time:        time,
 		Extra:       []byte{},
-		MixDigest:   common.Hash{},
+		MixDigest:   evmtypes.PrevRandao(header.Hash()),
 		Nonce:       ethtypes.BlockNonce{},
 		BaseFee:     baseFee,
 	}
@@ -104,6 +96,26 @@ func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Cont
 	return gasLimit, nil
 }
 
+// HighestPeerBlock returns the height of the highest block committed by the
+// peers, given their consensus state as returned by the CometBFT
+// dump_consensus_state endpoint. A peer is at the height following its latest
+// block. It returns 0 if the heights of the peers are unknown.
+func HighestPeerBlock(peers []cmtrpctypes.PeerStateInfo) int64 {
+	var highest int64
+	for _, peer := range peers {
+		var state struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &state); err != nil {
+			continue
+		}
+		highest = max(highest, state.RoundState.Height-1)
+	}
+	return highest
+}
+
 // FormatBlock creates an ethereum block from a tendermint header and ethereum-formatted
 // transactions.
 func FormatBlock(
@@ -127,7 +139,7 @@ func FormatBlock(
 		"logsBloom":        bloom,
 		"stateRoot":        hexutil.Bytes(header.AppHash),
 		"miner":            validatorAddr,
-		"mixHash":          common.Hash{},
+		"mixHash":          evmtypes.PrevRandao(header.Hash()),
 		"difficulty":       (*hexutil.Big)(big.NewInt(0)),
 		"extraData":        "0x",
 		"size":             hexutil.Uint64(size),     //nolint:gosec // G115 // size won't exceed uint64
@@ -172,17 +184,7 @@ func NewRPCTransaction(
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
 	tx := msg.AsTransaction()
-	// Determine the signer. For replay-protected transactions, use the most permissive
-	// signer, because we assume that signers are backwards-compatible with old
-	// transactions. For non-protected transactions, the frontier signer is used
-	// because the latest signer will reject the unprotected transactions.
-	var signer ethtypes.Signer
-	if tx.Protected() {
-		signer = ethtypes.LatestSignerForChainID(tx.ChainId())
-	} else {
-		signer = ethtypes.FrontierSigner{}
-	}
-	from, err := msg.GetSenderLegacy(signer)
+	from, err := DefaultSenderCache.GetSender(msg)
 	if err != nil {
 		return nil, err
 	}
@@ -275,18 +277,33 @@ func CheckTxFee(gasPrice *big.Int, gas uint64, minCap float64) error {
 	return nil
 }
 
-// TxExceedBlockGasLimit returns true if the tx exceeds block gas limit.
+// TxExceedBlockGasLimit returns true if the tx exceeds block gas limit. The
+// txs of the blocks before the keeper failed them with ErrBlockGasLimitExceeded
+// failed with the out of gas error of the block gas meter, which eth txs can't
+// fail with otherwise because their tx gas meter has no limit.
 func TxExceedBlockGasLimit(res *abci.ExecTxResult) bool {
-	return strings.Contains(res.Log, ExceedBlockGasLimitError)
+	return isABCIError(res, evmtypes.ErrBlockGasLimitExceeded) || isABCIError(res, errortypes.ErrOutOfGas)
 }
 
 // TxStateDBCommitError returns true if the evm tx commit error.
 func TxStateDBCommitError(res *abci.ExecTxResult) bool {
-	return strings.Contains(res.Log, StateDBCommitError)
+	return isABCIError(res, evmtypes.ErrStateDBCommit)
+}
+
+// TxBatchFailed returns true if an eth tx of a batch failed, reverting the
+// whole batch. All the txs of the batch failed then.
+func TxBatchFailed(res *abci.ExecTxResult) bool {
+	return isABCIError(res, evmtypes.ErrBatchedTxFailed)
+}
+
+// isABCIError returns true if the tx result has the codespace and code of the registered error
+func isABCIError(res *abci.ExecTxResult, err *errorsmod.Error) bool {
+	return res.Codespace == err.Codespace() && res.Code == err.ABCICode()
 }
 
 // TxSucessOrExpectedFailure returns true if the transaction was successful
-// or if it failed with an ExceedBlockGasLimit error or TxStateDBCommitError error
+// or if it failed with an ExceedBlockGasLimit error, a TxStateDBCommitError error
+// or a failed batch
 func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
-	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
+	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res) || TxBatchFailed(res)
 }
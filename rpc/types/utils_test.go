@@ -0,0 +1,42 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+func TestMixHash(t *testing.T) {
+	header := cmttypes.Header{
+		ChainID: "cosmos_262144-1",
+		Height:  10,
+		Time:    time.Unix(1700000000, 0),
+		AppHash: []byte("app hash"),
+	}
+	expMixHash := crypto.Keccak256Hash(header.Hash())
+
+	ethHeader := EthHeaderFromTendermint(header, ethtypes.Bloom{}, big.NewInt(1))
+	require.Equal(t, expMixHash, ethHeader.MixDigest)
+
+	block := FormatBlock(header, 0, 0, big.NewInt(0), nil, ethtypes.Bloom{}, common.Address{}, nil)
+	require.Equal(t, expMixHash, block["mixHash"])
+}
+
+func TestHighestPeerBlock(t *testing.T) {
+	require.Equal(t, int64(0), HighestPeerBlock(nil))
+
+	peers := []cmtrpctypes.PeerStateInfo{
+		{NodeAddress: "a", PeerState: []byte(`{"round_state":{"height":"8","round":0}}`)},
+		{NodeAddress: "b", PeerState: []byte(`{"round_state":{"height":"11","round":1}}`)},
+		{NodeAddress: "c", PeerState: []byte(`invalid`)},
+	}
+	require.Equal(t, int64(10), HighestPeerBlock(peers))
+}
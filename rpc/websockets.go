@@ -10,10 +10,13 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/gorilla/mux"
@@ -34,6 +37,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 )
 
+var (
+	wsConnectionsGauge             = metrics.NewRegisteredGauge("rpc/ws/connections", nil)
+	wsConnectionsRejectedCounter   = metrics.NewRegisteredCounter("rpc/ws/connections/rejected", nil)
+	wsSubscriptionsGauge           = metrics.NewRegisteredGauge("rpc/ws/subscriptions", nil)
+	wsSubscriptionsRejectedCounter = metrics.NewRegisteredCounter("rpc/ws/subscriptions/rejected", nil)
+	wsSlowConsumersCounter         = metrics.NewRegisteredCounter("rpc/ws/slowconsumers", nil)
+)
+
+// errSlowConsumer is returned when a notification can't be buffered as the
+// consumer of the subscription doesn't keep up with its notifications.
+var errSlowConsumer = errors.New("subscription notifications buffer is full, closing slow consumer")
+
 type WebsocketsServer interface {
 	Start()
 }
@@ -73,6 +88,22 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+
+	// maxRequestSize is the max size of the messages read
+	maxRequestSize int
+	// maxRequestDepth is the max depth of the JSON values of the requests,
+	// unlimited if 0
+	maxRequestDepth int
+	// maxConnections is the max number of connections, unlimited if 0
+	maxConnections int
+	// maxSubscriptions is the max number of subscriptions per connection,
+	// unlimited if 0
+	maxSubscriptions int
+	// subscriptionBuffer is the max number of notifications of a subscription
+	// waiting to be written
+	subscriptionBuffer int
+	// connections is the number of open connections
+	connections atomic.Int64
 }
 
 func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
@@ -84,12 +115,20 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
 		logger:   logger,
+
+		maxRequestSize:     cfg.JSONRPC.MaxRequestBodySize,
+		maxRequestDepth:    cfg.JSONRPC.MaxRequestDepth,
+		maxConnections:     cfg.JSONRPC.WSMaxConnections,
+		maxSubscriptions:   cfg.JSONRPC.WSMaxSubscriptions,
+		subscriptionBuffer: cfg.JSONRPC.WSSubscriptionBuffer,
 	}
 }
 
 func (s *websocketsServer) Start() {
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
+	// the API key of the calls can be the path of the URL
+	ws.Handle("/{key}", s)
 
 	go func() {
 		var err error
@@ -112,6 +151,16 @@ func (s *websocketsServer) Start() {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// the connection is counted before the upgrade, so that the limit can't be
+	// exceeded by concurrent upgrades
+	connections := s.connections.Add(1)
+	defer s.connections.Add(-1)
+	if s.maxConnections > 0 && connections > int64(s.maxConnections) {
+		wsConnectionsRejectedCounter.Inc(1)
+		http.Error(w, "too many websocket connections", http.StatusServiceUnavailable)
+		return
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(_ *http.Request) bool {
 			return true
@@ -124,10 +173,17 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
+	wsConnectionsGauge.Inc(1)
+	defer wsConnectionsGauge.Dec(1)
+
+	// the connection is closed once a larger message is read
+	if s.maxRequestSize > 0 {
+		conn.SetReadLimit(int64(s.maxRequestSize))
+	}
+
+	wsConn := newWSConn(conn, s.subscriptionBuffer)
+	wsConn.apiKey = requestAPIKey(r)
+	s.readLoop(wsConn)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -146,6 +202,25 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+
+	// apiKey is the API key of the calls forwarded to the JSON-RPC server
+	apiKey string
+	// buffer is the max number of notifications of a subscription waiting to
+	// be written
+	buffer int
+	// queuesMtx protects queues
+	queuesMtx sync.Mutex
+	// queues are the notifications waiting to be written by subscription
+	queues map[rpc.ID]chan interface{}
+}
+
+func newWSConn(conn *websocket.Conn, buffer int) *wsConn {
+	return &wsConn{
+		mux:    new(sync.Mutex),
+		conn:   conn,
+		buffer: buffer,
+		queues: make(map[rpc.ID]chan interface{}),
+	}
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -155,6 +230,62 @@ func (w *wsConn) WriteJSON(v interface{}) error {
 	return w.conn.WriteJSON(v)
 }
 
+// addSubscription starts writing the notifications of the subscription, which
+// are buffered so that a subscription isn't blocked by its consumer.
+func (w *wsConn) addSubscription(subID rpc.ID) {
+	queue := make(chan interface{}, w.buffer)
+
+	w.queuesMtx.Lock()
+	w.queues[subID] = queue
+	w.queuesMtx.Unlock()
+	wsSubscriptionsGauge.Inc(1)
+
+	go func() {
+		for v := range queue {
+			if err := w.WriteJSON(v); err != nil {
+				// the remaining notifications are dropped once the connection is
+				// closed, until the subscription is removed by the read loop
+				_ = w.Close() // #nosec G703
+			}
+		}
+	}()
+}
+
+// removeSubscription stops writing the notifications of the subscription, the
+// ones buffered being written first.
+func (w *wsConn) removeSubscription(subID rpc.ID) {
+	w.queuesMtx.Lock()
+	defer w.queuesMtx.Unlock()
+
+	queue, ok := w.queues[subID]
+	if !ok {
+		return
+	}
+	delete(w.queues, subID)
+	close(queue)
+	wsSubscriptionsGauge.Dec(1)
+}
+
+// Notify buffers the notification of the subscription to be written, and
+// returns errSlowConsumer if the buffer is full. The notifications of a removed
+// subscription are dropped.
+func (w *wsConn) Notify(subID rpc.ID, v interface{}) error {
+	w.queuesMtx.Lock()
+	defer w.queuesMtx.Unlock()
+
+	queue, ok := w.queues[subID]
+	if !ok {
+		return nil
+	}
+	select {
+	case queue <- v:
+		return nil
+	default:
+		wsSlowConsumersCounter.Inc(1)
+		return errSlowConsumer
+	}
+}
+
 func (w *wsConn) Close() error {
 	w.mux.Lock()
 	defer w.mux.Unlock()
@@ -174,8 +305,9 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 	defer func() {
 		// cancel all subscriptions when connection closed
 		// #nosec G705
-		for _, unsubFn := range subscriptions {
+		for subID, unsubFn := range subscriptions {
 			unsubFn()
+			wsConn.removeSubscription(subID)
 		}
 	}()
 
@@ -187,6 +319,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		if exceedsDepth(mb, s.maxRequestDepth) {
+			s.sendErrResponse(wsConn, "request exceeds max JSON depth")
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -235,9 +372,17 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				continue
 			}
 
+			if s.maxSubscriptions > 0 && len(subscriptions) >= s.maxSubscriptions {
+				wsSubscriptionsRejectedCounter.Inc(1)
+				s.sendErrResponse(wsConn, "too many subscriptions")
+				continue
+			}
+
 			subID := rpc.NewID()
+			wsConn.addSubscription(subID)
 			unsubFn, err := s.api.subscribe(wsConn, subID, params)
 			if err != nil {
+				wsConn.removeSubscription(subID)
 				s.sendErrResponse(wsConn, err.Error())
 				continue
 			}
@@ -252,7 +397,38 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			if err := wsConn.WriteJSON(res); err != nil {
 				break
 			}
-		case "eth_unsubscribe":
+		case "evm_subscribe":
+			params, ok := s.getParamsAndCheckValid(msg, wsConn)
+			if !ok {
+				continue
+			}
+
+			if s.maxSubscriptions > 0 && len(subscriptions) >= s.maxSubscriptions {
+				wsSubscriptionsRejectedCounter.Inc(1)
+				s.sendErrResponse(wsConn, "too many subscriptions")
+				continue
+			}
+
+			subID := rpc.NewID()
+			wsConn.addSubscription(subID)
+			unsubFn, err := s.api.subscribeEVM(wsConn, subID, params)
+			if err != nil {
+				wsConn.removeSubscription(subID)
+				s.sendErrResponse(wsConn, err.Error())
+				continue
+			}
+			subscriptions[subID] = unsubFn
+
+			res := &SubscriptionResponseJSON{
+				Jsonrpc: "2.0",
+				ID:      connID,
+				Result:  subID,
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				break
+			}
+		case "eth_unsubscribe", "evm_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
@@ -269,6 +445,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			if ok {
 				delete(subscriptions, subID)
 				unsubFn()
+				wsConn.removeSubscription(subID)
 			}
 
 			res := &SubscriptionResponseJSON{
@@ -314,6 +491,9 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if wsConn.apiKey != "" {
+		req.Header.Set(APIKeyHeader, wsConn.apiKey)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -410,11 +590,11 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					Method:  "eth_subscription",
 					Params: &SubscriptionResult{
 						Subscription: subID,
-						Result:       header,
+						Result:       types.FinalizedResult{Result: header},
 					},
 				}
 
-				err = wsConn.WriteJSON(res)
+				err = wsConn.Notify(subID, res)
 				if err != nil {
 					api.logger.Error("error writing header, will drop peer", "error", err.Error())
 
@@ -592,11 +772,11 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethLog,
+							Result:       types.FinalizedResult{Result: ethLog},
 						},
 					}
 
-					err = wsConn.WriteJSON(res)
+					err = wsConn.Notify(subID, res)
 					if err != nil {
 						try(func() {
 							if err != websocket.ErrCloseSent {
@@ -652,7 +832,7 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 						},
 					}
 
-					err = wsConn.WriteJSON(res)
+					err = wsConn.Notify(subID, res)
 					if err != nil {
 						api.logger.Debug("error writing header, will drop peer", "error", err.Error())
 
@@ -675,6 +855,137 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
+// subscribeEVM creates the subscriptions of the evm_ prefixed namespace, which
+// aren't part of the Web3 JSON-RPC spec.
+func (api *pubSubAPI) subscribeEVM(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+	method, ok := params[0].(string)
+	if !ok {
+		return nil, errors.New("invalid parameters")
+	}
+
+	switch method {
+	case "accountChanges":
+		if len(params) < 2 {
+			return nil, errors.New("missing the addresses to watch")
+		}
+		return api.subscribeAccountChanges(wsConn, subID, params[1])
+	default:
+		return nil, errors.Errorf("unsupported method %s", method)
+	}
+}
+
+// subscribeAccountChanges notifies the balance, nonce and code hash of the
+// watched addresses once a block modifies them, according to the accounts
+// modified by its eth txs, which are only emitted if the node records them
+// with the evm.record-modified-accounts option. The changes of the accounts by
+// cosmos txs, e.g. bank transfers, aren't notified.
+func (api *pubSubAPI) subscribeAccountChanges(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+	params, ok := extra.(map[string]interface{})
+	if !ok {
+		api.logger.Debug("invalid criteria", "type", fmt.Sprintf("%T", extra))
+		return nil, errors.New("invalid criteria")
+	}
+	addresses, ok := params["addresses"].([]interface{})
+	if !ok || len(addresses) == 0 {
+		return nil, errors.New("invalid addresses; must be a non-empty array of addresses")
+	}
+	watched := make(map[common.Address]bool, len(addresses))
+	for _, addr := range addresses {
+		address, ok := addr.(string)
+		if !ok || !common.IsHexAddress(address) {
+			api.logger.Debug("invalid address", "type", fmt.Sprintf("%T", addr))
+			return nil, errors.Errorf("invalid address: %v", addr)
+		}
+		watched[common.HexToAddress(address)] = true
+	}
+
+	sub, unsubFn, err := api.events.SubscribeEVMTxs()
+	if err != nil {
+		api.logger.Error("failed to subscribe account changes", "error", err.Error())
+		return nil, err
+	}
+
+	queryClient := evmtypes.NewQueryClient(api.clientCtx)
+
+	go func() {
+		// the height an account was last notified at, the txs of a block modifying
+		// the same account are notified once
+		notified := make(map[common.Address]int64, len(watched))
+
+		ch := sub.Event()
+		errCh := sub.Err()
+		for {
+			select {
+			case event, ok := <-ch:
+				if !ok {
+					return
+				}
+
+				dataTx, ok := event.Data.(cmttypes.EventDataTx)
+				if !ok {
+					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", event.Data))
+					continue
+				}
+
+				accounts, err := types.ParseModifiedAccounts(&dataTx.Result)
+				if err != nil {
+					api.logger.Error("failed to parse modified accounts", "error", err.Error())
+					continue
+				}
+
+				for _, address := range accounts {
+					if !watched[address] || notified[address] == dataTx.Height {
+						continue
+					}
+					notified[address] = dataTx.Height
+
+					// the state of the block is queried, which includes the changes of
+					// its later txs as well
+					res, err := queryClient.Account(types.ContextWithHeight(dataTx.Height), &evmtypes.QueryAccountRequest{Address: address.Hex()})
+					if err != nil {
+						api.logger.Error("failed to query account", "address", address, "height", dataTx.Height, "error", err.Error())
+						continue
+					}
+					balance, ok := new(big.Int).SetString(res.Balance, 10)
+					if !ok {
+						api.logger.Error("invalid account balance", "address", address, "balance", res.Balance)
+						continue
+					}
+
+					err = wsConn.Notify(subID, &SubscriptionNotification{
+						Jsonrpc: "2.0",
+						Method:  "evm_subscription",
+						Params: &SubscriptionResult{
+							Subscription: subID,
+							Result: &types.AccountChange{
+								Address:     address,
+								BlockNumber: hexutil.Uint64(dataTx.Height), //#nosec G115 -- int overflow is not a concern here
+								Balance:     (*hexutil.Big)(balance),
+								Nonce:       hexutil.Uint64(res.Nonce),
+								CodeHash:    common.HexToHash(res.CodeHash),
+							},
+						},
+					})
+					if err != nil {
+						try(func() {
+							if err != websocket.ErrCloseSent {
+								_ = wsConn.Close() // #nosec G703
+							}
+						}, api.logger, "closing websocket peer sub")
+					}
+				}
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping AccountChanges WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
+
+	return unsubFn, nil
+}
+
 func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
 	return nil, errors.New("syncing subscription is not implemented")
 }
@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func wsURL(srv *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func TestWebsocketsServerMaxConnections(t *testing.T) {
+	s := &websocketsServer{logger: log.NewNopLogger(), maxConnections: 1, subscriptionBuffer: 1}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL(srv), nil)
+	require.NoError(t, err)
+
+	_, res, err := websocket.DefaultDialer.Dial(wsURL(srv), nil)
+	require.Error(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	res.Body.Close()
+
+	// the connection is released once closed
+	require.NoError(t, conn.Close())
+	require.Eventually(t, func() bool {
+		return s.connections.Load() == 0
+	}, 5*time.Second, time.Millisecond)
+	conn, _, err = websocket.DefaultDialer.Dial(wsURL(srv), nil)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+}
+
+func TestWSConnNotify(t *testing.T) {
+	conns := make(chan *websocket.Conn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		require.NoError(t, err)
+		conns <- conn
+	}))
+	defer srv.Close()
+
+	client, _, err := websocket.DefaultDialer.Dial(wsURL(srv), nil)
+	require.NoError(t, err)
+	defer client.Close()
+
+	wsConn := newWSConn(<-conns, 1)
+	defer wsConn.Close()
+	subID := rpc.NewID()
+
+	// the notifications of an unknown subscription are dropped
+	require.NoError(t, wsConn.Notify(subID, "dropped"))
+
+	// the notifications are buffered while the connection is blocked, up to
+	// the buffer size
+	wsConn.addSubscription(subID)
+	wsConn.mux.Lock()
+	var notified int
+	for ; notified < 3; notified++ {
+		if err = wsConn.Notify(subID, notified); err != nil {
+			break
+		}
+	}
+	require.ErrorIs(t, err, errSlowConsumer)
+	wsConn.mux.Unlock()
+
+	// the buffered notifications are written in order
+	for i := range notified {
+		var v int
+		require.NoError(t, client.ReadJSON(&v))
+		require.Equal(t, i, v)
+	}
+	wsConn.removeSubscription(subID)
+	require.NoError(t, wsConn.Notify(subID, "dropped"))
+}
@@ -4,8 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"slices"
+	"strconv"
+	stdstrings "strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 
 	"github.com/cometbft/cometbft/libs/strings"
@@ -13,6 +17,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -57,6 +62,33 @@ const (
 	// DefaultEnablePreimageRecording is the default value for EnablePreimageRecording
 	DefaultEnablePreimageRecording = false
 
+	// DefaultRecordWitness is the default value for RecordWitness
+	DefaultRecordWitness = false
+
+	// DefaultRecordModifiedAccounts is the default value for RecordModifiedAccounts
+	DefaultRecordModifiedAccounts = false
+
+	// DefaultCompactEvents is the default value for CompactEvents
+	DefaultCompactEvents = false
+
+	// DefaultSimulateCheckTx is the default value for SimulateCheckTx
+	DefaultSimulateCheckTx = false
+
+	// DefaultStrictGasAccounting is the default value for StrictGasAccounting
+	DefaultStrictGasAccounting = false
+
+	// DefaultVersionedQueries is the default value for VersionedQueries
+	DefaultVersionedQueries = false
+
+	// DefaultParallelExecution is the default value for ParallelExecution
+	DefaultParallelExecution = false
+
+	// DefaultPriorityFloor is the default value for PriorityFloor
+	DefaultPriorityFloor = 0
+
+	// DefaultPriorityCeiling is the default value for PriorityCeiling, no ceiling
+	DefaultPriorityCeiling = 0
+
 	// DefaultFixRevertGasRefundHeight is the default height at which to overwrite gas refund
 	DefaultFixRevertGasRefundHeight = 0
 
@@ -87,6 +119,15 @@ const (
 	// DefaultEVMTimeout is the default timeout for eth_call
 	DefaultEVMTimeout = 5 * time.Second
 
+	// DefaultTraceTimeoutCap is the default cap on the timeout that can be requested by debug_trace* calls
+	DefaultTraceTimeoutCap = 30 * time.Second
+
+	// DefaultTracerSizeCap is the default cap on the size in bytes of the JavaScript tracers
+	DefaultTracerSizeCap = 64 * 1024
+
+	// DefaultTracerResultSizeCap is the default cap on the size in bytes of the results of the JavaScript tracers
+	DefaultTracerResultSizeCap = 16 * 1024 * 1024
+
 	// DefaultTxFeeCap is the default tx-fee cap for sending a transaction
 	DefaultTxFeeCap float64 = 1.0
 
@@ -102,6 +143,31 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// DefaultMaxConcurrentQueries represents the amount of concurrent gRPC queries of the JSON-RPC (unlimited = 0)
+	DefaultMaxConcurrentQueries = 0
+
+	// DefaultMaxRequestBodySize is the default max size in bytes of the JSON-RPC requests
+	DefaultMaxRequestBodySize = 5 * 1024 * 1024
+
+	// DefaultMaxRequestDepth is the default max nesting depth of the JSON values of the JSON-RPC requests
+	DefaultMaxRequestDepth = 32
+
+	// DefaultWSMaxConnections represents the amount of concurrent WebSocket connections (unlimited = 0)
+	DefaultWSMaxConnections = 0
+
+	// DefaultWSMaxSubscriptions represents the amount of subscriptions per WebSocket connection (unlimited = 0)
+	DefaultWSMaxSubscriptions = 0
+
+	// DefaultWSSubscriptionBuffer is the default number of notifications buffered per WebSocket subscription
+	DefaultWSSubscriptionBuffer = 10000
+
+	// DefaultEntryPoint is the default ERC-4337 EntryPoint (v0.7) of the bundler endpoints
+	DefaultEntryPoint = "0x0000000071727De22E5E9d8BAf0edAc6f37da032"
+
+	// DefaultIndexerSnapshotBlocks is the default number of blocks of indexed txs included
+	// in state-sync snapshots (disabled = 0)
+	DefaultIndexerSnapshotBlocks = 0
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 )
@@ -127,6 +193,34 @@ type EVMConfig struct {
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
 	// Enables tracking of SHA3 preimages in the VM
 	EnablePreimageRecording bool `mapstructure:"cache-preimage"`
+	// RecordWitness enables recording the accounts and storage slots read and written by
+	// each eth tx, so that they can be stored by the indexer.
+	RecordWitness bool `mapstructure:"record-witness"`
+	// RecordModifiedAccounts enables recording the accounts modified by each eth tx,
+	// so that the modified accounts of each block can be stored by the indexer.
+	RecordModifiedAccounts bool `mapstructure:"record-modified-accounts"`
+	// CompactEvents disables emitting the eth tx events whose attributes are
+	// already in the msg responses of the tx results, to reduce the size of the
+	// stored block results. It requires the custom tx indexer to be enabled.
+	CompactEvents bool `mapstructure:"compact-events"`
+	// SimulateCheckTx enables executing the eth txs against a cache of the check
+	// state during CheckTx, to reject the ones whose execution fails before they
+	// take space in a block.
+	SimulateCheckTx bool `mapstructure:"simulate-check-tx"`
+	// StrictGasAccounting halts the node when the gas used by the eth txs of a
+	// block exceeds the gas consumed by the block gas meter.
+	StrictGasAccounting bool `mapstructure:"strict-gas-accounting"`
+	// VersionedQueries serves the queries from the committed versions of the
+	// IAVL stores, loading and branching only the stores each query reads.
+	VersionedQueries bool `mapstructure:"versioned-queries"`
+	// ParallelExecution executes the eth txs of a block optimistically in
+	// parallel before the block, re-executing them in order on conflicts.
+	ParallelExecution bool `mapstructure:"parallel-execution"`
+	// PriorityFloor is the minimum mempool priority of the eth txs.
+	PriorityFloor int64 `mapstructure:"priority-floor"`
+	// PriorityCeiling is the maximum mempool priority of the eth txs, 0 is no
+	// ceiling.
+	PriorityCeiling int64 `mapstructure:"priority-ceiling"`
 	// EVMChainID defines the EIP-155 replay-protection chain ID.
 	EVMChainID uint64 `mapstructure:"evm-chain-id"`
 }
@@ -143,8 +237,17 @@ type JSONRPCConfig struct {
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// AllowInsecureUnlock toggles if account unlocking is enabled when account-related RPCs are exposed by http.
 	AllowInsecureUnlock bool `mapstructure:"allow-insecure-unlock"`
+	// RequireUnlock requires the keyring accounts to be unlocked with personal_unlockAccount
+	// before the node signs with them.
+	RequireUnlock bool `mapstructure:"require-unlock"`
 	// EVMTimeout is the global timeout for eth-call.
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
+	// TraceTimeoutCap is the global cap on the timeout requested by debug_trace* calls.
+	TraceTimeoutCap time.Duration `mapstructure:"trace-timeout-cap"`
+	// TracerSizeCap is the global cap on the size in bytes of the JavaScript tracers supplied by users.
+	TracerSizeCap int `mapstructure:"tracer-size-cap"`
+	// TracerResultSizeCap is the global cap on the size in bytes of the results of the JavaScript tracers.
+	TracerResultSizeCap int `mapstructure:"tracer-result-size-cap"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
 	// FilterCap is the global cap for total number of filters that can be created.
@@ -167,8 +270,51 @@ type JSONRPCConfig struct {
 	// MaxOpenConnections sets the maximum number of simultaneous connections
 	// for the server listener.
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
+	// MaxConcurrentQueries sets the maximum number of gRPC queries run at once by the
+	// JSON-RPC server, the others wait for one of them to complete.
+	MaxConcurrentQueries int `mapstructure:"max-concurrent-queries"`
+	// MaxRequestBodySize sets the maximum size in bytes of the JSON-RPC requests, over HTTP
+	// or WebSocket.
+	MaxRequestBodySize int `mapstructure:"max-request-body-size"`
+	// MaxRequestDepth sets the maximum nesting depth of the JSON arrays and objects of the
+	// JSON-RPC requests, including the request itself and its params (unlimited = 0).
+	MaxRequestDepth int `mapstructure:"max-request-depth"`
+	// WSMaxConnections sets the maximum number of simultaneous WebSocket connections.
+	WSMaxConnections int `mapstructure:"ws-max-connections"`
+	// WSMaxSubscriptions sets the maximum number of subscriptions of a WebSocket connection.
+	WSMaxSubscriptions int `mapstructure:"ws-max-subscriptions"`
+	// WSSubscriptionBuffer sets the maximum number of notifications of a WebSocket subscription
+	// waiting to be written, the connection of a slower consumer being closed.
+	WSSubscriptionBuffer int `mapstructure:"ws-subscription-buffer"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerSnapshotBlocks defines the number of most recent blocks whose indexed txs are
+	// included in the state-sync snapshots taken by the node.
+	IndexerSnapshotBlocks uint64 `mapstructure:"indexer-snapshot-blocks"`
+	// EnableCallTraceIndex defines if the flat call traces computed by trace_filter are
+	// stored in the custom indexer, so that each block is only re-executed once. The
+	// internal calls of the stored traces are indexed as the internal txs of their
+	// addresses.
+	EnableCallTraceIndex bool `mapstructure:"enable-call-trace-index"`
+	// ValidatorCoinbases maps the validator consensus addresses to the EVM address returned
+	// by eth_coinbase and as the block miner, in the "<consensus address>=<evm address>" format.
+	ValidatorCoinbases []string `mapstructure:"validator-coinbases"`
+	// APITiers defines the rate and method policies of the tiers of the API keys, in the
+	// "<tier>:<requests per second>:<method>|<method>..." format.
+	APITiers []string `mapstructure:"api-tiers"`
+	// APIKeys maps the API keys of the JSON-RPC requests to their tier, in the "<key>=<tier>" format.
+	APIKeys []string `mapstructure:"api-keys"`
+	// BundlerAccount is the address of the node's keyring key that signs the EVM transactions
+	// bundling the ERC-4337 user operations. The bundler endpoints are disabled if empty.
+	BundlerAccount string `mapstructure:"bundler-account"`
+	// EntryPoint is the address of the ERC-4337 EntryPoint the user operations are bundled to.
+	EntryPoint string `mapstructure:"entry-point"`
+	// EnableContractMetadata defines if the Solidity metadata and sources of the contracts can be
+	// submitted to the node, which stores them and serves them with evm_getContractMetadata.
+	EnableContractMetadata bool `mapstructure:"enable-contract-metadata"`
+	// EventSignaturesFile is the path of a file of Solidity event declarations, one per line, which
+	// extends the event signatures decoding the logs of eth_getLogs and eth_getTransactionReceipt.
+	EventSignaturesFile string `mapstructure:"event-signatures-file"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -190,6 +336,15 @@ func DefaultEVMConfig() *EVMConfig {
 		MaxTxGasWanted:          DefaultMaxTxGasWanted,
 		EVMChainID:              DefaultEVMChainID,
 		EnablePreimageRecording: DefaultEnablePreimageRecording,
+		RecordWitness:           DefaultRecordWitness,
+		RecordModifiedAccounts:  DefaultRecordModifiedAccounts,
+		CompactEvents:           DefaultCompactEvents,
+		SimulateCheckTx:         DefaultSimulateCheckTx,
+		StrictGasAccounting:     DefaultStrictGasAccounting,
+		VersionedQueries:        DefaultVersionedQueries,
+		ParallelExecution:       DefaultParallelExecution,
+		PriorityFloor:           DefaultPriorityFloor,
+		PriorityCeiling:         DefaultPriorityCeiling,
 	}
 }
 
@@ -199,6 +354,18 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.EVMChainID == 0 {
+		return errors.New("evm chain id cannot be 0")
+	}
+
+	if c.PriorityFloor < 0 || c.PriorityCeiling < 0 {
+		return errors.New("priority floor and ceiling cannot be negative")
+	}
+
+	if c.PriorityCeiling != 0 && c.PriorityCeiling < c.PriorityFloor {
+		return fmt.Errorf("priority ceiling %d cannot be lower than the priority floor %d", c.PriorityCeiling, c.PriorityFloor)
+	}
+
 	return nil
 }
 
@@ -209,7 +376,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "evm", "engine", "explorer", "admin"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -221,7 +388,11 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		WsAddress:                DefaultJSONRPCWsAddress,
 		GasCap:                   DefaultGasCap,
 		AllowInsecureUnlock:      DefaultJSONRPCAllowInsecureUnlock,
+		RequireUnlock:            false,
 		EVMTimeout:               DefaultEVMTimeout,
+		TraceTimeoutCap:          DefaultTraceTimeoutCap,
+		TracerSizeCap:            DefaultTracerSizeCap,
+		TracerResultSizeCap:      DefaultTracerResultSizeCap,
 		TxFeeCap:                 DefaultTxFeeCap,
 		FilterCap:                DefaultFilterCap,
 		FeeHistoryCap:            DefaultFeeHistoryCap,
@@ -231,7 +402,22 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
+		MaxConcurrentQueries:     DefaultMaxConcurrentQueries,
+		MaxRequestBodySize:       DefaultMaxRequestBodySize,
+		MaxRequestDepth:          DefaultMaxRequestDepth,
+		WSMaxConnections:         DefaultWSMaxConnections,
+		WSMaxSubscriptions:       DefaultWSMaxSubscriptions,
+		WSSubscriptionBuffer:     DefaultWSSubscriptionBuffer,
 		EnableIndexer:            false,
+		IndexerSnapshotBlocks:    DefaultIndexerSnapshotBlocks,
+		EnableCallTraceIndex:     false,
+		ValidatorCoinbases:       []string{},
+		APITiers:                 []string{},
+		APIKeys:                  []string{},
+		BundlerAccount:           "",
+		EntryPoint:               DefaultEntryPoint,
+		EnableContractMetadata:   false,
+		EventSignaturesFile:      "",
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -259,6 +445,18 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC EVM timeout duration cannot be negative")
 	}
 
+	if c.TraceTimeoutCap < 0 {
+		return errors.New("JSON-RPC trace timeout cap cannot be negative")
+	}
+
+	if c.TracerSizeCap < 0 {
+		return errors.New("JSON-RPC tracer size cap cannot be negative")
+	}
+
+	if c.TracerResultSizeCap < 0 {
+		return errors.New("JSON-RPC tracer result size cap cannot be negative")
+	}
+
 	if c.LogsCap < 0 {
 		return errors.New("JSON-RPC logs cap cannot be negative")
 	}
@@ -275,6 +473,30 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.MaxConcurrentQueries < 0 {
+		return errors.New("JSON-RPC max concurrent queries cannot be negative")
+	}
+
+	if c.MaxRequestBodySize <= 0 {
+		return errors.New("JSON-RPC max request body size cannot be negative or 0")
+	}
+
+	if c.MaxRequestDepth < 0 {
+		return errors.New("JSON-RPC max request depth cannot be negative")
+	}
+
+	if c.WSMaxConnections < 0 {
+		return errors.New("JSON-RPC WebSocket max connections cannot be negative")
+	}
+
+	if c.WSMaxSubscriptions < 0 {
+		return errors.New("JSON-RPC WebSocket max subscriptions cannot be negative")
+	}
+
+	if c.WSSubscriptionBuffer <= 0 {
+		return errors.New("JSON-RPC WebSocket subscription buffer cannot be negative or 0")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -285,9 +507,162 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	if seenAPIs["admin"] && len(c.APITiers) == 0 {
+		return errors.New("the admin namespace can only be enabled with the API keys")
+	}
+
+	if _, err := c.ParseValidatorCoinbases(); err != nil {
+		return err
+	}
+
+	if _, _, err := c.ParseAPIKeys(); err != nil {
+		return err
+	}
+
+	if c.BundlerAccount != "" && !common.IsHexAddress(c.BundlerAccount) {
+		return fmt.Errorf("invalid bundler account '%s'", c.BundlerAccount)
+	}
+
+	if c.BundlerAccount != "" && !common.IsHexAddress(c.EntryPoint) {
+		return fmt.Errorf("invalid entry point '%s'", c.EntryPoint)
+	}
+
 	return nil
 }
 
+// ParseValidatorCoinbases returns the EVM coinbase addresses of the ValidatorCoinbases,
+// keyed by the validator consensus address bytes.
+func (c JSONRPCConfig) ParseValidatorCoinbases() (map[string]common.Address, error) {
+	coinbases := make(map[string]common.Address, len(c.ValidatorCoinbases))
+	for _, entry := range c.ValidatorCoinbases {
+		parts := strings.SplitAndTrimEmpty(entry, "=", " ")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid validator coinbase '%s', expected <consensus address>=<evm address>", entry)
+		}
+
+		consAddr, evmAddr := parts[0], parts[1]
+		_, consAddrBz, err := bech32.DecodeAndConvert(consAddr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator consensus address '%s': %w", consAddr, err)
+		}
+
+		if !common.IsHexAddress(evmAddr) {
+			return nil, fmt.Errorf("invalid validator coinbase address '%s'", evmAddr)
+		}
+
+		if _, ok := coinbases[string(consAddrBz)]; ok {
+			return nil, fmt.Errorf("repeated validator coinbase for '%s'", consAddr)
+		}
+
+		coinbases[string(consAddrBz)] = common.HexToAddress(evmAddr)
+	}
+
+	return coinbases, nil
+}
+
+// APITier is the rate and method policy of the JSON-RPC requests of the API keys of a tier.
+type APITier struct {
+	Name string
+	// RequestsPerSecond is the rate of the calls of an API key, unlimited if 0
+	RequestsPerSecond float64
+	// Methods are the methods allowed, e.g. "eth_call", or the namespaces allowed, e.g. "eth_*",
+	// all of them being allowed with "*"
+	Methods []string
+}
+
+// Allows returns true if the method is allowed in the tier. The admin methods are only allowed
+// by name or by the admin_* namespace, not by the other wildcards.
+func (t APITier) Allows(method string) bool {
+	admin := isAdminMethod(method)
+	for _, allowed := range t.Methods {
+		if allowed == method || (admin && allowed == AdminMethodPrefix+"*") {
+			return true
+		}
+		if admin {
+			continue
+		}
+		if allowed == "*" {
+			return true
+		}
+		if prefix, ok := stdstrings.CutSuffix(allowed, "*"); ok && stdstrings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultAPITier is the tier of the JSON-RPC requests without an API key. They're rejected if
+// no tier has this name.
+const DefaultAPITier = "default"
+
+// AdminMethodPrefix is the prefix of the methods of the admin namespace, which are only served to
+// the API keys.
+const AdminMethodPrefix = "admin_"
+
+// ParseAPIKeys returns the tiers by name and the tiers of the API keys by key. The API keys are
+// disabled if no tier is defined.
+func (c JSONRPCConfig) ParseAPIKeys() (map[string]APITier, map[string]APITier, error) {
+	tiers := make(map[string]APITier, len(c.APITiers))
+	for _, entry := range c.APITiers {
+		parts := stdstrings.Split(entry, ":")
+		if len(parts) != 3 {
+			return nil, nil, fmt.Errorf("invalid API tier '%s', expected <tier>:<requests per second>:<method>|<method>...", entry)
+		}
+
+		name := stdstrings.TrimSpace(parts[0])
+		if name == "" {
+			return nil, nil, fmt.Errorf("invalid API tier '%s', the name is empty", entry)
+		}
+		if _, ok := tiers[name]; ok {
+			return nil, nil, fmt.Errorf("repeated API tier '%s'", name)
+		}
+
+		rate, err := strconv.ParseFloat(stdstrings.TrimSpace(parts[1]), 64)
+		if err != nil || rate < 0 {
+			return nil, nil, fmt.Errorf("invalid requests per second of the API tier '%s'", name)
+		}
+
+		tiers[name] = APITier{
+			Name:              name,
+			RequestsPerSecond: rate,
+			Methods:           strings.SplitAndTrimEmpty(parts[2], "|", " "),
+		}
+		if name == DefaultAPITier && slices.ContainsFunc(tiers[name].Methods, isAdminMethod) {
+			return nil, nil, fmt.Errorf("the %s API tier of the requests without an API key cannot allow the admin methods", DefaultAPITier)
+		}
+	}
+
+	if len(tiers) == 0 && len(c.APIKeys) > 0 {
+		return nil, nil, errors.New("API keys cannot be defined without API tiers")
+	}
+
+	keys := make(map[string]APITier, len(c.APIKeys))
+	for _, entry := range c.APIKeys {
+		parts := strings.SplitAndTrimEmpty(entry, "=", " ")
+		if len(parts) != 2 {
+			return nil, nil, errors.New("invalid API key entry, expected <key>=<tier>")
+		}
+
+		key, name := parts[0], parts[1]
+		tier, ok := tiers[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown API tier '%s'", name)
+		}
+		if _, ok := keys[key]; ok {
+			return nil, nil, errors.New("repeated API key")
+		}
+		keys[key] = tier
+	}
+
+	return tiers, keys, nil
+}
+
+// isAdminMethod returns true if the method or the methods pattern of an API tier is of the admin
+// namespace.
+func isAdminMethod(method string) bool {
+	return stdstrings.HasPrefix(method, AdminMethodPrefix)
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -348,6 +723,14 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid json-rpc config value: %s", err.Error())
 	}
 
+	if c.EVM.CompactEvents && !c.JSONRPC.EnableIndexer {
+		return errorsmod.Wrap(errortypes.ErrAppConfig, "compact evm events require the json-rpc indexer to be enabled")
+	}
+
+	if c.JSONRPC.IndexerSnapshotBlocks > 0 && !c.JSONRPC.EnableIndexer {
+		return errorsmod.Wrap(errortypes.ErrAppConfig, "indexer snapshot blocks require the json-rpc indexer to be enabled")
+	}
+
 	if err := c.TLS.Validate(); err != nil {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid tls config value: %s", err.Error())
 	}
@@ -69,3 +69,166 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestEVMConfigValidate(t *testing.T) {
+	cfg := serverconfig.DefaultEVMConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.EVMChainID = 0
+	require.ErrorContains(t, cfg.Validate(), "evm chain id cannot be 0")
+}
+
+func TestEVMConfigValidatePriorityBounds(t *testing.T) {
+	cfg := serverconfig.DefaultEVMConfig()
+	cfg.PriorityFloor = 10
+	require.NoError(t, cfg.Validate())
+
+	cfg.PriorityCeiling = 100
+	require.NoError(t, cfg.Validate())
+
+	cfg.PriorityCeiling = 5
+	require.ErrorContains(t, cfg.Validate(), "priority ceiling 5 cannot be lower than the priority floor 10")
+
+	cfg.PriorityFloor = -1
+	require.ErrorContains(t, cfg.Validate(), "priority floor and ceiling cannot be negative")
+}
+
+func TestConfigValidateBasicCompactEvents(t *testing.T) {
+	cfg := serverconfig.DefaultConfig()
+	cfg.MinGasPrices = "0aatom"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.EVM.CompactEvents = true
+	require.ErrorContains(t, cfg.ValidateBasic(), "compact evm events require the json-rpc indexer to be enabled")
+
+	cfg.JSONRPC.EnableIndexer = true
+	require.NoError(t, cfg.ValidateBasic())
+}
+
+func TestConfigValidateBasicIndexerSnapshotBlocks(t *testing.T) {
+	cfg := serverconfig.DefaultConfig()
+	cfg.MinGasPrices = "0aatom"
+	cfg.JSONRPC.IndexerSnapshotBlocks = 100
+	require.ErrorContains(t, cfg.ValidateBasic(), "indexer snapshot blocks require the json-rpc indexer to be enabled")
+
+	cfg.JSONRPC.EnableIndexer = true
+	require.NoError(t, cfg.ValidateBasic())
+}
+
+func TestJSONRPCConfigValidatorCoinbases(t *testing.T) {
+	consAddr := "cosmosvalcons1xqcnyve5x5mrwwpexqcnyve5x5mrwwpeenv7ml"
+	evmAddr := "0x5C985E89DDe482eFE97ea9f1950aD149Eb73829B"
+
+	cfg := serverconfig.DefaultJSONRPCConfig()
+	cfg.ValidatorCoinbases = []string{consAddr + "=" + evmAddr}
+	require.NoError(t, cfg.Validate())
+
+	coinbases, err := cfg.ParseValidatorCoinbases()
+	require.NoError(t, err)
+	require.Len(t, coinbases, 1)
+	for _, coinbase := range coinbases {
+		require.Equal(t, evmAddr, coinbase.Hex())
+	}
+
+	cfg.ValidatorCoinbases = []string{consAddr}
+	require.ErrorContains(t, cfg.Validate(), "invalid validator coinbase")
+
+	cfg.ValidatorCoinbases = []string{"invalid=" + evmAddr}
+	require.ErrorContains(t, cfg.Validate(), "invalid validator consensus address")
+
+	cfg.ValidatorCoinbases = []string{consAddr + "=0x1234"}
+	require.ErrorContains(t, cfg.Validate(), "invalid validator coinbase address")
+
+	cfg.ValidatorCoinbases = []string{consAddr + "=" + evmAddr, consAddr + "=" + evmAddr}
+	require.ErrorContains(t, cfg.Validate(), "repeated validator coinbase")
+}
+
+func TestJSONRPCConfigBundler(t *testing.T) {
+	cfg := serverconfig.DefaultJSONRPCConfig()
+	require.Empty(t, cfg.BundlerAccount)
+	require.Equal(t, serverconfig.DefaultEntryPoint, cfg.EntryPoint)
+
+	cfg.BundlerAccount = "0x5C985E89DDe482eFE97ea9f1950aD149Eb73829B"
+	require.NoError(t, cfg.Validate())
+
+	cfg.EntryPoint = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid entry point")
+
+	cfg.BundlerAccount = "0x1234"
+	require.ErrorContains(t, cfg.Validate(), "invalid bundler account")
+}
+
+func TestJSONRPCConfigAPIKeys(t *testing.T) {
+	cfg := serverconfig.DefaultJSONRPCConfig()
+	tiers, keys, err := cfg.ParseAPIKeys()
+	require.NoError(t, err)
+	require.Empty(t, tiers)
+	require.Empty(t, keys)
+
+	cfg.APITiers = []string{"default:1:eth_chainId", "free:10:eth_*|net_version", "pro:0:*"}
+	cfg.APIKeys = []string{"key1=free", "key2=pro"}
+	require.NoError(t, cfg.Validate())
+
+	tiers, keys, err = cfg.ParseAPIKeys()
+	require.NoError(t, err)
+	require.Len(t, tiers, 3)
+	require.Equal(t, float64(1), tiers[serverconfig.DefaultAPITier].RequestsPerSecond)
+	require.Equal(t, "free", keys["key1"].Name)
+	require.Equal(t, float64(0), keys["key2"].RequestsPerSecond)
+
+	free := keys["key1"]
+	require.True(t, free.Allows("eth_call"))
+	require.True(t, free.Allows("net_version"))
+	require.False(t, free.Allows("net_listening"))
+	require.False(t, free.Allows("debug_traceTransaction"))
+	require.True(t, keys["key2"].Allows("debug_traceTransaction"))
+
+	cfg.APIKeys = []string{"key1=unknown"}
+	require.ErrorContains(t, cfg.Validate(), "unknown API tier")
+
+	cfg.APIKeys = []string{"key1=free", "key1=pro"}
+	require.ErrorContains(t, cfg.Validate(), "repeated API key")
+
+	cfg.APIKeys = nil
+	cfg.APITiers = []string{"free:10"}
+	require.ErrorContains(t, cfg.Validate(), "invalid API tier")
+
+	cfg.APITiers = []string{"free:-1:*"}
+	require.ErrorContains(t, cfg.Validate(), "invalid requests per second")
+
+	cfg.APITiers = []string{"free:1:*", "free:2:*"}
+	require.ErrorContains(t, cfg.Validate(), "repeated API tier")
+
+	cfg.APITiers = nil
+	cfg.APIKeys = []string{"key1=free"}
+	require.ErrorContains(t, cfg.Validate(), "API keys cannot be defined without API tiers")
+}
+
+func TestJSONRPCConfigAdminNamespace(t *testing.T) {
+	cfg := serverconfig.DefaultJSONRPCConfig()
+	cfg.API = append(cfg.API, "admin")
+	require.ErrorContains(t, cfg.Validate(), "admin namespace can only be enabled with the API keys")
+
+	cfg.APITiers = []string{"default:1:*", "pro:0:*", "ops:0:admin_*", "gc:0:admin_setGCPercent|eth_*"}
+	cfg.APIKeys = []string{"key1=pro", "key2=ops", "key3=gc"}
+	require.NoError(t, cfg.Validate())
+
+	tiers, keys, err := cfg.ParseAPIKeys()
+	require.NoError(t, err)
+	// the admin methods aren't allowed by the other wildcards
+	require.False(t, tiers[serverconfig.DefaultAPITier].Allows("admin_setGCPercent"))
+	require.False(t, keys["key1"].Allows("admin_setGCPercent"))
+	require.True(t, keys["key1"].Allows("eth_call"))
+	require.True(t, keys["key2"].Allows("admin_setGCPercent"))
+	require.True(t, keys["key2"].Allows("admin_setCacheSize"))
+	require.False(t, keys["key2"].Allows("eth_call"))
+	require.True(t, keys["key3"].Allows("admin_setGCPercent"))
+	require.False(t, keys["key3"].Allows("admin_setCacheSize"))
+	require.True(t, keys["key3"].Allows("eth_call"))
+
+	cfg.APITiers = []string{"default:1:admin_*"}
+	cfg.APIKeys = nil
+	require.ErrorContains(t, cfg.Validate(), "cannot allow the admin methods")
+	cfg.APITiers = []string{"default:1:admin_setLogLevel"}
+	require.ErrorContains(t, cfg.Validate(), "cannot allow the admin methods")
+}
@@ -19,6 +19,44 @@ max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 # EnablePreimageRecording enables tracking of SHA3 preimages in the VM
 cache-preimage = {{ .EVM.EnablePreimageRecording }}
 
+# RecordWitness enables recording the accounts and storage slots read and written by each
+# ethereum transaction, which are stored by the custom indexer and served by debug_getTxWitness.
+record-witness = {{ .EVM.RecordWitness }}
+
+# RecordModifiedAccounts enables recording the accounts modified by each ethereum transaction, which
+# are stored per block by the custom indexer and served by debug_getModifiedAccountsByNumber/ByHash.
+record-modified-accounts = {{ .EVM.RecordModifiedAccounts }}
+
+# CompactEvents disables emitting the ethereum_tx events of the executed transactions, whose gas used
+# and status are already in the transaction results, to reduce the size of the stored block results.
+# The JSON-RPC reads them from the custom indexer, which has to be enabled.
+compact-events = {{ .EVM.CompactEvents }}
+
+# SimulateCheckTx executes the ethereum transactions against the check state during CheckTx, to reject
+# the ones whose execution fails, e.g. reverted token transfers, before they take space in a block.
+# It isn't done when the mempool is rechecked after a block.
+simulate-check-tx = {{ .EVM.SimulateCheckTx }}
+
+# StrictGasAccounting halts the node at the end of a block in which the gas used by the ethereum
+# transactions, according to their receipts, exceeds the gas consumed by the block gas meter.
+# The mismatch is logged and counted in the metrics in any case.
+strict-gas-accounting = {{ .EVM.StrictGasAccounting }}
+
+# VersionedQueries serves the queries, e.g. the historical eth_call, from the committed versions of the
+# IAVL stores, loading and branching only the stores each query reads instead of the whole state, so that
+# they don't contend with the block execution.
+versioned-queries = {{ .EVM.VersionedQueries }}
+
+# ParallelExecution is the experimental mode executing the ethereum transactions of a block optimistically
+# in parallel once the block begins. The execution of a transaction is applied in the block order only if
+# the state it read is unchanged, otherwise the transaction is executed again, so the results are the same.
+parallel-execution = {{ .EVM.ParallelExecution }}
+
+# PriorityFloor and PriorityCeiling bound the mempool priority of the ethereum transactions, which is
+# derived from the effective tip paid after the base fee. A zero ceiling is no ceiling.
+priority-floor = {{ .EVM.PriorityFloor }}
+priority-ceiling = {{ .EVM.PriorityCeiling }}
+
 # EVMChainID is the EIP-155 compatible replay protection chain ID. This is separate from the Cosmos chain ID.
 evm-chain-id = {{ .EVM.EVMChainID }}
 
@@ -47,9 +85,23 @@ gas-cap = {{ .JSONRPC.GasCap }}
 # Allow insecure account unlocking when account-related RPCs are exposed by http
 allow-insecure-unlock = {{ .JSONRPC.AllowInsecureUnlock }}
 
+# RequireUnlock requires the keyring accounts to be unlocked with personal_unlockAccount, for the
+# given duration, before the node signs messages and transactions with them.
+require-unlock = {{ .JSONRPC.RequireUnlock }}
+
 # EVMTimeout is the global timeout for eth_call. Default: 5s.
 evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 
+# TraceTimeoutCap is the maximum timeout that can be requested by debug_trace* calls (0=infinite). Default: 30s.
+trace-timeout-cap = "{{ .JSONRPC.TraceTimeoutCap }}"
+
+# TracerSizeCap is the maximum size in bytes of the JavaScript tracers supplied to debug_trace* calls (0=infinite).
+tracer-size-cap = {{ .JSONRPC.TracerSizeCap }}
+
+# TracerResultSizeCap is the maximum size in bytes of the result of a JavaScript tracer, the trace fails above it (0=infinite).
+# The memory held by a JavaScript tracer while it runs isn't capped, only its run time is, by the timeout cap. Default: 16MiB.
+tracer-result-size-cap = {{ .JSONRPC.TracerResultSizeCap }}
+
 # TxFeeCap is the global tx-fee cap for send transaction. Default: 1eth.
 txfee-cap = {{ .JSONRPC.TxFeeCap }}
 
@@ -79,9 +131,72 @@ allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
 # for the server listener.
 max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 
+# MaxConcurrentQueries sets the maximum number of gRPC queries run at once by the JSON-RPC server,
+# the others wait for one of them to complete (0 = unlimited).
+max-concurrent-queries = {{ .JSONRPC.MaxConcurrentQueries }}
+
+# MaxRequestBodySize sets the maximum size in bytes of the JSON-RPC requests, over HTTP or WebSocket.
+max-request-body-size = {{ .JSONRPC.MaxRequestBodySize }}
+
+# MaxRequestDepth sets the maximum nesting depth of the JSON arrays and objects of the JSON-RPC requests,
+# including the request itself and its params (0 = unlimited).
+max-request-depth = {{ .JSONRPC.MaxRequestDepth }}
+
+# WSMaxConnections sets the maximum number of simultaneous WebSocket connections (0 = unlimited).
+ws-max-connections = {{ .JSONRPC.WSMaxConnections }}
+
+# WSMaxSubscriptions sets the maximum number of subscriptions of a WebSocket connection (0 = unlimited).
+ws-max-subscriptions = {{ .JSONRPC.WSMaxSubscriptions }}
+
+# WSSubscriptionBuffer sets the maximum number of notifications of a WebSocket subscription waiting
+# to be written, the connection of a consumer falling further behind being closed.
+ws-subscription-buffer = {{ .JSONRPC.WSSubscriptionBuffer }}
+
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# IndexerSnapshotBlocks defines the number of most recent blocks whose indexed transactions are
+# included in the state-sync snapshots, so that nodes joining via state sync can serve them (disabled = 0).
+# It requires the indexer, and only the blocks indexed without gap up to the snapshot height are included.
+indexer-snapshot-blocks = {{ .JSONRPC.IndexerSnapshotBlocks }}
+
+# EnableCallTraceIndex stores the flat call traces computed by trace_filter in the custom indexer,
+# so that the blocks are not re-executed on subsequent requests, and indexes their internal calls,
+# served as the internal txs of the addresses by evm_getTransactionsByAddress. Requires enable-indexer.
+enable-call-trace-index = {{ .JSONRPC.EnableCallTraceIndex }}
+
+# ValidatorCoinbases maps validator consensus addresses to the EVM address returned by eth_coinbase
+# and reported as the block miner, as comma separated "<consensus address>=<evm address>" entries.
+validator-coinbases = "{{range $index, $elmt := .JSONRPC.ValidatorCoinbases}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# APITiers defines the tiers of the API keys of the JSON-RPC requests over HTTP, as comma separated
+# "<tier>:<requests per second>:<method>|<method>..." entries, e.g. "free:10:eth_*|net_version" or
+# "pro:0:*" (0 = unlimited). The requests without an API key use the "default" tier, and are rejected if
+# it's not defined. The API keys are disabled if no tier is defined. The methods of the admin namespace
+# are only allowed by the tiers listing them, or "admin_*", explicitly, which the "default" tier can't.
+api-tiers = "{{range $index, $elmt := .JSONRPC.APITiers}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# APIKeys maps the API keys to their tier, as comma separated "<key>=<tier>" entries. The API key of a
+# request is read from the X-API-Key header, or from the URL path, e.g. http://localhost:8545/<key>.
+api-keys = "{{range $index, $elmt := .JSONRPC.APIKeys}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# BundlerAccount is the address of the key in the node's keyring that signs the transactions bundling
+# the ERC-4337 user operations of eth_sendUserOperation. The bundler endpoints are disabled if empty.
+bundler-account = "{{ .JSONRPC.BundlerAccount }}"
+
+# EntryPoint is the address of the ERC-4337 EntryPoint contract the user operations are bundled to.
+entry-point = "{{ .JSONRPC.EntryPoint }}"
+
+# EnableContractMetadata enables the submission of the Solidity metadata and sources of the contracts
+# with evm_submitContractMetadata, which are stored by the node once checked against the metadata hash
+# of the contract code, and served by evm_getContractMetadata.
+enable-contract-metadata = {{ .JSONRPC.EnableContractMetadata }}
+
+# EventSignaturesFile is the path of a file of Solidity event declarations, one per line, e.g.
+# "Transfer(address indexed from, address indexed to, uint256 value)", which extends the event signatures
+# known by the node to decode the logs of eth_getLogs and eth_getTransactionReceipt with the decoded option.
+event-signatures-file = "{{ .JSONRPC.EventSignaturesFile }}"
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -36,22 +36,50 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable              = "json-rpc.enable"
-	JSONRPCAPI                 = "json-rpc.api"
-	JSONRPCAddress             = "json-rpc.address"
-	JSONWsAddress              = "json-rpc.ws-address"
-	JSONRPCGasCap              = "json-rpc.gas-cap"
-	JSONRPCAllowInsecureUnlock = "json-rpc.allow-insecure-unlock"
-	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
-	JSONRPCFilterCap           = "json-rpc.filter-cap"
-	JSONRPCLogsCap             = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCEnable               = "json-rpc.enable"
+	JSONRPCAPI                  = "json-rpc.api"
+	JSONRPCAddress              = "json-rpc.address"
+	JSONWsAddress               = "json-rpc.ws-address"
+	JSONRPCGasCap               = "json-rpc.gas-cap"
+	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
+	JSONRPCRequireUnlock        = "json-rpc.require-unlock"
+	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
+	JSONRPCTraceTimeoutCap      = "json-rpc.trace-timeout-cap"
+	JSONRPCTracerSizeCap        = "json-rpc.tracer-size-cap"
+	JSONRPCTracerResultSizeCap  = "json-rpc.tracer-result-size-cap"
+	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
+	JSONRPCFilterCap            = "json-rpc.filter-cap"
+	JSONRPCLogsCap              = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
+	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
+	JSONRPCMaxConcurrentQueries = "json-rpc.max-concurrent-queries"
+	JSONRPCMaxRequestBodySize   = "json-rpc.max-request-body-size"
+	JSONRPCMaxRequestDepth      = "json-rpc.max-request-depth"
+	JSONRPCWSMaxConnections     = "json-rpc.ws-max-connections"
+	JSONRPCWSMaxSubscriptions   = "json-rpc.ws-max-subscriptions"
+	JSONRPCWSSubscriptionBuffer = "json-rpc.ws-subscription-buffer"
+	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
+	// JSONRPCIndexerSnapshotBlocks defines the number of blocks of indexed txs included in state-sync snapshots
+	JSONRPCIndexerSnapshotBlocks = "json-rpc.indexer-snapshot-blocks"
+	// JSONRPCEnableCallTraceIndex enables storing the flat call traces served by trace_filter in the indexer
+	JSONRPCEnableCallTraceIndex = "json-rpc.enable-call-trace-index"
+	// JSONRPCValidatorCoinbases defines the EVM coinbase addresses of the validator consensus addresses
+	JSONRPCValidatorCoinbases = "json-rpc.validator-coinbases"
+	// JSONRPCAPITiers defines the rate and method policies of the tiers of the API keys
+	JSONRPCAPITiers = "json-rpc.api-tiers"
+	// JSONRPCAPIKeys defines the tiers of the API keys of the JSON-RPC requests
+	JSONRPCAPIKeys = "json-rpc.api-keys"
+	// JSONRPCBundlerAccount defines the keyring key that signs the bundled ERC-4337 user operations
+	JSONRPCBundlerAccount = "json-rpc.bundler-account"
+	// JSONRPCEntryPoint defines the ERC-4337 EntryPoint the user operations are bundled to
+	JSONRPCEntryPoint = "json-rpc.entry-point"
+	// JSONRPCEnableContractMetadata enables the storage of the contract metadata submitted to the node
+	JSONRPCEnableContractMetadata = "json-rpc.enable-contract-metadata"
+	// JSONRPCEventSignaturesFile defines the file of the event declarations decoding the logs
+	JSONRPCEventSignaturesFile = "json-rpc.event-signatures-file"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -64,6 +92,15 @@ const (
 	EVMTracer                  = "evm.tracer"
 	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
 	EVMEnablePreimageRecording = "evm.cache-preimage"
+	EVMRecordWitness           = "evm.record-witness"
+	EVMRecordModifiedAccounts  = "evm.record-modified-accounts"
+	EVMCompactEvents           = "evm.compact-events"
+	EVMSimulateCheckTx         = "evm.simulate-check-tx"
+	EVMStrictGasAccounting     = "evm.strict-gas-accounting"
+	EVMVersionedQueries        = "evm.versioned-queries"
+	EVMParallelExecution       = "evm.parallel-execution"
+	EVMPriorityFloor           = "evm.priority-floor"
+	EVMPriorityCeiling         = "evm.priority-ceiling"
 	EVMChainID                 = "evm.evm-chain-id"
 )
 
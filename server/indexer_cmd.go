@@ -8,6 +8,7 @@ import (
 	cmtconfig "github.com/cometbft/cometbft/config"
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/indexer"
 
@@ -29,33 +30,21 @@ func NewIndexTxCmd() *cobra.Command {
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			serverCtx := server.GetServerContextFromCmd(cmd)
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-
 			direction := args[0]
 			if direction != "backward" && direction != "forward" {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
-			cfg := serverCtx.Config
-			home := cfg.RootDir
-			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			idxer, err := openIndexer(cmd)
 			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
-			// open local tendermint db, because the local rpc won't be available.
-			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+			cfg := server.GetServerContextFromCmd(cmd).Config
+			blockStore, err := openBlockStore(cfg)
 			if err != nil {
 				return err
 			}
-			blockStore := cmtstore.NewBlockStore(tmdb)
 
 			stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
 			if err != nil {
@@ -96,6 +85,10 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+				// the balances of the NFT owners were updated newest first
+				if _, err := idxer.RebuildNFTBalances(); err != nil {
+					return err
+				}
 			case "forward":
 				latest, err := idxer.LastIndexedBlock()
 				if err != nil {
@@ -117,5 +110,90 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.AddCommand(newBackfillSendersCmd(), newRebuildNFTBalancesCmd())
 	return cmd
 }
+
+// newBackfillSendersCmd creates the command storing the senders of the eth txs
+// indexed by older versions without them.
+func newBackfillSendersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "senders",
+		Short: "Backfill the senders of the indexed eth txs",
+		Long: `Store the senders of the eth txs indexed by older versions without them, so that they're not recovered from the signatures on every query.
+The indexer also backfills them once when the node starts, the command does it offline.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			idxer, err := openIndexer(cmd)
+			if err != nil {
+				return err
+			}
+			blockStore, err := openBlockStore(server.GetServerContextFromCmd(cmd).Config)
+			if err != nil {
+				return err
+			}
+
+			updated, err := idxer.BackfillSenders(func(height int64) (*cmttypes.Block, error) {
+				return blockStore.LoadBlock(height), nil
+			})
+			if err != nil {
+				return err
+			}
+			cmd.Printf("backfilled the senders of %d txs\n", updated)
+			return nil
+		},
+	}
+}
+
+// newRebuildNFTBalancesCmd creates the command rebuilding the balances of the
+// NFT owners from the indexed token transfers.
+func newRebuildNFTBalancesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "nft-balances",
+		Short: "Rebuild the balances of the NFT owners",
+		Long: `Rebuild the balances of the NFT owners from the indexed token transfers, e.g. after they were indexed by older versions without the balances.
+It's done at the end of the backward indexing already.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			idxer, err := openIndexer(cmd)
+			if err != nil {
+				return err
+			}
+
+			replayed, err := idxer.RebuildNFTBalances()
+			if err != nil {
+				return err
+			}
+			cmd.Printf("rebuilt the nft balances from %d token transfers\n", replayed)
+			return nil
+		},
+	}
+}
+
+// openIndexer opens the evm indexer db of the node of the command.
+func openIndexer(cmd *cobra.Command) (*indexer.KVIndexer, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := serverCtx.Logger
+	idxDB, err := OpenIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return nil, err
+	}
+	return indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx), nil
+}
+
+// openBlockStore opens the local block store of the node, because the local rpc
+// won't be available.
+func openBlockStore(cfg *cmtconfig.Config) (*cmtstore.BlockStore, error) {
+	tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	return cmtstore.NewBlockStore(tmdb), nil
+}
@@ -73,6 +73,38 @@ func (eis *EVMIndexerService) OnStart() error {
 		}
 	}()
 
+	// store the senders of the txs indexed by older versions, the blocks below
+	// the earliest one were pruned
+	earliestBlock := status.SyncInfo.EarliestBlockHeight
+	go func() {
+		updated, err := eis.txIdxr.BackfillSenders(func(height int64) (*types.Block, error) {
+			if height < earliestBlock {
+				return nil, nil
+			}
+			block, err := eis.client.Block(ctx, &height)
+			if err != nil {
+				return nil, err
+			}
+			return block.Block, nil
+		})
+		if err != nil {
+			eis.Logger.Error("failed to backfill the senders of the indexed txs", "err", err)
+			return
+		}
+		if updated > 0 {
+			eis.Logger.Info("backfilled the senders of the indexed txs", "txs", updated)
+		}
+	}()
+
+	// rebuild the balances of the NFT owners from the token transfers indexed by
+	// older versions, before the new blocks update them
+	replayed, err := eis.txIdxr.BackfillNFTBalances()
+	if err != nil {
+		eis.Logger.Error("failed to backfill the balances of the NFT owners", "err", err)
+	} else if replayed > 0 {
+		eis.Logger.Info("backfilled the balances of the NFT owners", "transfers", replayed)
+	}
+
 	lastBlock, err := eis.txIdxr.LastIndexedBlock()
 	if err != nil {
 		return err
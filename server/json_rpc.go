@@ -33,6 +33,7 @@ func StartJSONRPC(ctx *server.Context,
 	slog.SetDefault(slog.New(handler))
 
 	rpcServer := ethrpc.NewServer()
+	rpcServer.SetHTTPBodyLimit(config.JSONRPC.MaxRequestBodySize)
 
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
@@ -50,8 +51,22 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	apiTiers, apiKeys, err := config.JSONRPC.ParseAPIKeys()
+	if err != nil {
+		return nil, nil, err
+	}
+	rpcHandler := rpc.NewRequestLimitsHandler(
+		rpc.NewAPIKeysHandler(rpcServer, apiTiers, apiKeys),
+		config.JSONRPC.MaxRequestBodySize,
+		config.JSONRPC.MaxRequestDepth,
+	)
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcHandler).Methods("POST")
+	// the API key can be the path of the URL
+	if len(apiTiers) > 0 {
+		r.Handle("/{key}", rpcHandler).Methods("POST")
+	}
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
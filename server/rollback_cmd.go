@@ -0,0 +1,89 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+
+	"github.com/cosmos/evm/indexer"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// NewRollbackCmd creates a command to rollback CometBFT, the multistore and the
+// EVM indexer state by one height.
+func NewRollbackCmd(opts StartOptions) *cobra.Command {
+	var removeBlock bool
+
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "rollback Cosmos SDK, CometBFT and EVM indexer state by one height",
+		Long: `
+A state rollback is performed to recover from an incorrect application state transition,
+when CometBFT has persisted an incorrect app hash and is thus unable to make
+progress. Rollback overwrites a state at height n with the state at height n - 1.
+The application also rolls back to height n - 1. No blocks are removed, so upon
+restarting CometBFT the transactions in block n will be re-executed against the
+application.
+
+The EVM indexer (if present) is rewound to the same height, so that it never references
+transactions from heights that were rolled back. It's rewound before the CometBFT state
+and the multistore, so a failure leaves it behind the consensus state, from where it
+catches up on the next start, and never ahead of it.
+
+The JSON-RPC server keeps no log index (e.g. the FilterMaps of geth), the logs are read
+from the block results, so there's no log index to rewind.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cfg := serverCtx.Config
+			home := cfg.RootDir
+
+			db, err := opts.DBOpener(serverCtx.Viper, home, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			// open the indexer db before touching any state, so a failure
+			// doesn't leave the indexer ahead of the consensus state
+			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer idxDB.Close()
+
+			app := opts.AppCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			// rewind the evm indexer to the height the rollback targets first,
+			// the rollbacks of the CometBFT state and the multistore can't be undone
+			idxer := indexer.NewKVIndexer(idxDB, serverCtx.Logger.With("module", "evmindex"), clientCtx)
+			if err := idxer.Rollback(app.CommitMultiStore().LatestVersion() - 1); err != nil {
+				return fmt.Errorf("failed to rollback evm indexer: %w", err)
+			}
+			// rollback CometBFT state
+			height, hash, err := cmtcmd.RollbackState(cfg, removeBlock)
+			if err != nil {
+				return fmt.Errorf("failed to rollback CometBFT state: %w", err)
+			}
+			// rollback the multistore
+			if err := app.CommitMultiStore().RollbackToVersion(height); err != nil {
+				return fmt.Errorf("failed to rollback to version: %w", err)
+			}
+			// the rolled back height is the one the indexer was rewound to, unless
+			// CometBFT was behind the multistore, rewinding again is a no-op otherwise
+			if err := idxer.Rollback(height); err != nil {
+				return fmt.Errorf("failed to rollback evm indexer: %w", err)
+			}
+
+			fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, opts.DefaultNodeHome, "The application home directory")
+	cmd.Flags().BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	return cmd
+}
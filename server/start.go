@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
 	"net/http"
 	"os"
@@ -34,9 +35,11 @@ import (
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -184,20 +187,48 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aatom (0=infinite)")                         //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, cosmosevmserverconfig.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCRequireUnlock, false, "Require the keyring accounts to be unlocked with personal_unlockAccount before signing with them")
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, cosmosevmserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCTraceTimeoutCap, cosmosevmserverconfig.DefaultTraceTimeoutCap, "Sets a cap on the timeout requested by debug_trace* calls (0=infinite)")
+	cmd.Flags().Int(srvflags.JSONRPCTracerSizeCap, cosmosevmserverconfig.DefaultTracerSizeCap, "Sets a cap on the size in bytes of the JavaScript tracers (0=infinite)")
+	cmd.Flags().Int(srvflags.JSONRPCTracerResultSizeCap, cosmosevmserverconfig.DefaultTracerResultSizeCap, "Sets a cap on the size in bytes of the results of the JavaScript tracers (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, cosmosevmserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, cosmosevmserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, cosmosevmserverconfig.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCMaxConcurrentQueries, cosmosevmserverconfig.DefaultMaxConcurrentQueries, "Sets the maximum number of gRPC queries run at once by the JSON-RPC server (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCMaxRequestBodySize, cosmosevmserverconfig.DefaultMaxRequestBodySize, "Sets the maximum size in bytes of the JSON-RPC requests")
+	cmd.Flags().Int(srvflags.JSONRPCMaxRequestDepth, cosmosevmserverconfig.DefaultMaxRequestDepth, "Sets the maximum nesting depth of the JSON values of the JSON-RPC requests (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCWSMaxConnections, cosmosevmserverconfig.DefaultWSMaxConnections, "Sets the maximum number of simultaneous WebSocket connections (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCWSMaxSubscriptions, cosmosevmserverconfig.DefaultWSMaxSubscriptions, "Sets the maximum number of subscriptions of a WebSocket connection (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCWSSubscriptionBuffer, cosmosevmserverconfig.DefaultWSSubscriptionBuffer, "Sets the maximum number of notifications of a WebSocket subscription waiting to be written before its connection is closed") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Uint64(srvflags.JSONRPCIndexerSnapshotBlocks, cosmosevmserverconfig.DefaultIndexerSnapshotBlocks, "Sets the number of most recent blocks of indexed txs included in state-sync snapshots (0=disabled)") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCEnableCallTraceIndex, false, "Store the flat call traces served by trace_filter in the custom tx indexer")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAPITiers, []string{}, "Defines the rate and method policies of the tiers of the API keys (<tier>:<requests per second>:<method>|<method>...)") //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCAPIKeys, []string{}, "Maps the API keys of the JSON-RPC requests to their tier (<key>=<tier>)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCValidatorCoinbases, []string{}, "Maps validator consensus addresses to the EVM address returned by eth_coinbase and as the block miner (<consensus address>=<evm address>)") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCBundlerAccount, "", "Sets the address of the keyring key that signs the bundled ERC-4337 user operations (empty=disabled)")
+	cmd.Flags().String(srvflags.JSONRPCEntryPoint, cosmosevmserverconfig.DefaultEntryPoint, "Sets the ERC-4337 EntryPoint the user operations are bundled to")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableContractMetadata, false, "Enable the submission and the storage of the Solidity metadata and sources of the contracts")
+	cmd.Flags().String(srvflags.JSONRPCEventSignaturesFile, "", "Sets the file of the Solidity event declarations extending the event signatures decoding the logs")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
 	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM (not implemented yet)")                      //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMRecordWitness, cosmosevmserverconfig.DefaultRecordWitness, "Enables recording the accounts and storage slots accessed by each eth tx for the custom tx indexer")
+	cmd.Flags().Bool(srvflags.EVMRecordModifiedAccounts, cosmosevmserverconfig.DefaultRecordModifiedAccounts, "Enables recording the accounts modified by each eth tx for the custom tx indexer")
+	cmd.Flags().Bool(srvflags.EVMCompactEvents, cosmosevmserverconfig.DefaultCompactEvents, "Disables the eth tx events already covered by the tx results, requires the custom tx indexer")
+	cmd.Flags().Bool(srvflags.EVMSimulateCheckTx, cosmosevmserverconfig.DefaultSimulateCheckTx, "Executes the eth txs during CheckTx to reject the ones whose execution fails")
+	cmd.Flags().Bool(srvflags.EVMStrictGasAccounting, cosmosevmserverconfig.DefaultStrictGasAccounting, "Halts the node when the gas used by the eth txs of a block exceeds the block gas consumption")
+	cmd.Flags().Bool(srvflags.EVMVersionedQueries, cosmosevmserverconfig.DefaultVersionedQueries, "Serves the queries from the committed store versions, branching only the stores each query reads")
+	cmd.Flags().Bool(srvflags.EVMParallelExecution, cosmosevmserverconfig.DefaultParallelExecution, "Executes the eth txs of a block optimistically in parallel, re-executing the conflicting ones in order (experimental)")
+	cmd.Flags().Int64(srvflags.EVMPriorityFloor, cosmosevmserverconfig.DefaultPriorityFloor, "The minimum mempool priority of the eth txs")
+	cmd.Flags().Int64(srvflags.EVMPriorityCeiling, cosmosevmserverconfig.DefaultPriorityCeiling, "The maximum mempool priority of the eth txs, 0 is no ceiling")
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
@@ -355,6 +386,7 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var (
 		tmNode   *node.Node
+		idxDB    dbm.DB
 		gRPCOnly = svrCtx.Viper.GetBool(srvflags.GRPCOnly)
 	)
 
@@ -365,6 +397,20 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	} else {
 		logger.Info("starting node with ABCI CometBFT in-process")
 
+		// the indexer db is opened before starting the node, so that the indexed txs can
+		// be restored from a state-sync snapshot
+		if config.JSONRPC.EnableIndexer {
+			idxDB, err = OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+		}
+		if err := registerIndexerSnapshotter(app, idxDB, config.JSONRPC.IndexerSnapshotBlocks); err != nil {
+			logger.Error("failed to register evm indexer snapshot extension", "error", err.Error())
+			return err
+		}
+
 		cmtApp := server.NewCometABCIWrapper(app)
 		tmNode, err = node.NewNode(
 			cfg,
@@ -417,12 +463,6 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer cosmosevmtypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
-		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
-			return err
-		}
-
 		idxLogger := svrCtx.Logger.With("indexer", "evm")
 		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
@@ -510,6 +550,19 @@ func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	)
 }
 
+// registerIndexerSnapshotter registers the evm indexer state-sync snapshot extension
+// to the app snapshot manager, if any. The snapshots wait for the indexer to index
+// their height as long as for a new block.
+func registerIndexerSnapshotter(app types.Application, idxDB dbm.DB, blocks uint64) error {
+	snapshotApp, ok := app.(interface {
+		SnapshotManager() *snapshots.Manager
+	})
+	if !ok || snapshotApp.SnapshotManager() == nil {
+		return nil
+	}
+	return snapshotApp.SnapshotManager().RegisterExtensions(indexer.NewSnapshotter(idxDB, blocks, NewBlockWaitTimeout))
+}
+
 func startTelemetry(cfg cosmosevmserverconfig.Config) (*telemetry.Metrics, error) {
 	if !cfg.Telemetry.Enabled {
 		return nil, nil
@@ -644,6 +697,13 @@ func startJSONRPCServer(
 		return
 	}
 
+	// the json-rpc must serve the same chain id the txs are signed and verified with
+	if chainID := evmtypes.GetEthChainConfig().ChainID; chainID.Cmp(new(big.Int).SetUint64(config.EVM.EVMChainID)) != 0 {
+		return ctx, httpSrv, httpSrvDone, fmt.Errorf(
+			"evm chain id %d of the app config doesn't match the chain id %s of the evm chain config", config.EVM.EVMChainID, chainID,
+		)
+	}
+
 	genDoc, err := genDocProvider()
 	if err != nil {
 		return ctx, httpSrv, httpSrvDone, err
@@ -83,7 +83,7 @@ func (s *EvmAnteTestSuite) CreateTxBuilder(privKey cryptotypes.PrivKey, txArgs e
 	err = builder.SetMsgs(&signedMsg)
 	s.Require().NoError(err)
 
-	txData, err := evmtypes.UnpackTxData(signedMsg.Data)
+	txData, err := signedMsg.GetTxData()
 	s.Require().NoError(err)
 
 	fees := sdk.NewCoins(sdk.NewCoin(s.GetNetwork().GetBaseDenom(), sdkmath.NewIntFromBigInt(txData.Fee())))
@@ -1264,14 +1264,15 @@ func (s *EvmAnteTestSuite) TestEthSigVerificationDecorator() {
 	s.Require().NoError(err)
 
 	testCases := []struct {
-		name                string
-		tx                  sdk.Tx
-		allowUnprotectedTxs bool
-		reCheckTx           bool
-		expPass             bool
+		name                 string
+		tx                   sdk.Tx
+		allowUnprotectedTxs  bool
+		unprotectedAllowlist []string
+		reCheckTx            bool
+		expPass              bool
 	}{
-		{"ReCheckTx", &utiltx.InvalidTx{}, false, true, false},
-		{"invalid transaction type", &utiltx.InvalidTx{}, false, false, false},
+		{"ReCheckTx", &utiltx.InvalidTx{}, false, nil, true, false},
+		{"invalid transaction type", &utiltx.InvalidTx{}, false, nil, false, false},
 		{
 			"invalid sender",
 			evmtypes.NewTx(&evmtypes.EvmTxArgs{
@@ -1282,18 +1283,22 @@ func (s *EvmAnteTestSuite) TestEthSigVerificationDecorator() {
 				GasPrice: big.NewInt(1),
 			}),
 			true,
+			nil,
 			false,
 			false,
 		},
-		{"successful signature verification", signedTx, false, false, true},
-		{"invalid, reject unprotected txs", unprotectedTx, false, false, false},
-		{"successful, allow unprotected txs", unprotectedTx, true, false, true},
+		{"successful signature verification", signedTx, false, nil, false, true},
+		{"invalid, reject unprotected txs", unprotectedTx, false, nil, false, false},
+		{"successful, allow unprotected txs", unprotectedTx, true, nil, false, true},
+		{"invalid, reject unprotected txs of sender not in allowlist", unprotectedTx, false, []string{utiltx.GenerateAddress().Hex()}, false, false},
+		{"successful, allow unprotected txs of sender in allowlist", unprotectedTx, false, []string{addr.Hex()}, false, true},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			s.WithEvmParamsOptions(func(params *evmtypes.Params) {
 				params.AllowUnprotectedTxs = tc.allowUnprotectedTxs
+				params.UnprotectedTxsAllowlist = tc.unprotectedAllowlist
 			})
 			s.SetupTest()
 			dec := ethante.NewEthSigVerificationDecorator(s.GetNetwork().App.GetEVMKeeper())
@@ -1336,7 +1341,7 @@ func (s *EvmAnteTestSuite) TestSignatures() {
 	msg := tx.GetMsgs()[0]
 	msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 	s.Require().True(ok)
-	txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+	txData, err := msgEthTx.GetTxData()
 	s.Require().NoError(err)
 
 	msgV, msgR, msgS := txData.GetRawSignatureValues()
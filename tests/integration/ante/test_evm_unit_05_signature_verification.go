@@ -0,0 +1,81 @@
+package ante
+
+import (
+	"math/big"
+	"time"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/evm/ante/evm"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *EvmUnitAnteTestSuite) TestSignatureVerificationUnprotectedTxCounter() {
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+	)
+
+	addr, privKey := utiltx.NewAddrKey()
+	unprotectedTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		Nonce:    1,
+		Amount:   big.NewInt(10),
+		GasLimit: 1000,
+		GasPrice: big.NewInt(1),
+	})
+	unprotectedTx.From = addr.Bytes()
+	s.Require().NoError(unprotectedTx.Sign(ethtypes.HomesteadSigner{}, utiltx.NewSigner(privKey)))
+
+	evmParams := unitNetwork.App.GetEVMKeeper().GetParams(unitNetwork.GetContext())
+	evmParams.AllowUnprotectedTxs = true
+	signer := ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID)
+
+	// record the metrics of the signature verification
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	s.Require().NoError(err)
+	defer func() { _, _ = metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) }()
+	telemetry.EnableTelemetry()
+
+	counted := func() int {
+		count := 0
+		for _, interval := range sink.Data() {
+			count += interval.Counters["tx.ante.unprotected_tx.total;allowed=true"].Count
+		}
+		return count
+	}
+
+	// the same submission is checked, rechecked, simulated and executed in a
+	// block, it's only counted once by its check
+	ctx := unitNetwork.GetContext()
+	testCases := []struct {
+		name     string
+		ctx      sdktypes.Context
+		expCount int
+	}{
+		{"check tx", ctx.WithIsCheckTx(true).WithExecMode(sdktypes.ExecModeCheck), 1},
+		{"recheck tx", ctx.WithIsCheckTx(true).WithIsReCheckTx(true).WithExecMode(sdktypes.ExecModeReCheck), 1},
+		{"simulation", ctx.WithIsCheckTx(true).WithExecMode(sdktypes.ExecModeSimulate), 1},
+		{"finalize block", ctx.WithIsCheckTx(false).WithExecMode(sdktypes.ExecModeFinalize), 1},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().NoError(evm.SignatureVerification(tc.ctx, unprotectedTx, signer, evmParams))
+			s.Require().Equal(tc.expCount, counted())
+		})
+	}
+}
@@ -3,6 +3,8 @@ package ante
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	evmante "github.com/cosmos/evm/ante/evm"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	commonfactory "github.com/cosmos/evm/testutil/integration/base/factory"
@@ -10,9 +12,11 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -216,3 +220,114 @@ func (s *EvmUnitAnteTestSuite) TestConsumeGasAndEmitEvent() {
 		})
 	}
 }
+
+func (s *EvmUnitAnteTestSuite) TestConsumeSponsoredFeesAndEmitEvent() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	sponsor := keyring.GetKey(0).AccAddr
+
+	testCases := []struct {
+		name          string
+		expectedError string
+		grantSender   bool
+		grantContract bool
+		callContract  bool
+	}{
+		{
+			name:         "success: the sponsor granted an allowance to the sender",
+			grantSender:  true,
+			callContract: true,
+		},
+		{
+			name:          "success: the sponsor granted an allowance to the called contract",
+			grantContract: true,
+			callContract:  true,
+		},
+		{
+			name:          "fail: the sponsor granted an allowance to a contract that isn't called",
+			expectedError: "does not allow to pay fees",
+			grantContract: true,
+		},
+		{
+			name:          "fail: the sponsor didn't grant an allowance",
+			expectedError: "does not allow to pay fees",
+			callContract:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(s.EthTxType), s.ChainID, tc.name), func() {
+			ctx := unitNetwork.GetContext()
+			bankKeeper := unitNetwork.App.GetBankKeeper()
+			feegrantKeeper := unitNetwork.App.GetFeeGrantKeeper()
+
+			sender := sdktypes.AccAddress(utiltx.GenerateAddress().Bytes())
+			contract := utiltx.GenerateAddress()
+			to := &common.Address{}
+			if tc.callContract {
+				to = &contract
+			}
+
+			// the app feegrant keeper has no bank keeper to create the grantee accounts
+			accountKeeper := unitNetwork.App.GetAccountKeeper()
+			for _, addr := range []sdktypes.AccAddress{sender, contract.Bytes()} {
+				accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, addr))
+			}
+
+			grantee := sender
+			if tc.grantSender {
+				s.Require().NoError(feegrantKeeper.GrantAllowance(ctx, sponsor, sender, &feegrant.BasicAllowance{}))
+			}
+			if tc.grantContract {
+				grantee = contract.Bytes()
+				s.Require().NoError(feegrantKeeper.GrantAllowance(ctx, sponsor, grantee, &feegrant.BasicAllowance{}))
+			}
+
+			feesAmt := sdkmath.NewInt(1000).Mul(evmtypes.GetEVMCoinDecimals().ConversionFactor())
+			fees := sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetBaseDenom(), feesAmt))
+			prevBalance := bankKeeper.GetBalance(ctx, sponsor, unitNetwork.GetBaseDenom())
+
+			// Function under test
+			err := evmante.ConsumeSponsoredFeesAndEmitEvent(
+				ctx,
+				unitNetwork.App.GetEVMKeeper(),
+				feegrantKeeper,
+				fees,
+				sponsor,
+				sender,
+				to,
+				nil,
+			)
+
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+			} else {
+				s.Require().NoError(err)
+
+				// Check the fees are deducted from the sponsor
+				balance := bankKeeper.GetBalance(ctx, sponsor, unitNetwork.GetBaseDenom())
+				s.Require().Equal(prevBalance.Amount.Sub(feesAmt), balance.Amount)
+
+				expectedEvent := sdktypes.NewEvent(
+					evmtypes.EventTypeFeeSponsorship,
+					sdktypes.NewAttribute(evmtypes.AttributeKeySponsor, common.BytesToAddress(sponsor).Hex()),
+					sdktypes.NewAttribute(evmtypes.AttributeKeySender, common.BytesToAddress(sender).Hex()),
+					sdktypes.NewAttribute(evmtypes.AttributeKeyGrantee, common.BytesToAddress(grantee).Hex()),
+					sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
+				)
+				s.Require().Contains(ctx.EventManager().Events(), expectedEvent)
+			}
+
+			// Reset the context
+			err = unitNetwork.NextBlock()
+			s.Require().NoError(err)
+		})
+	}
+}
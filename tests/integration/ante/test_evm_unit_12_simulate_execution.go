@@ -0,0 +1,108 @@
+package ante
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmante "github.com/cosmos/evm/ante/evm"
+	"github.com/cosmos/evm/testutil"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func (s *EvmUnitAnteTestSuite) TestSimulateExecution() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	senderKey := keyring.GetKey(0)
+
+	// a contract creation whose init code is the INVALID opcode
+	failingTx := func(txArgs *evmtypes.EvmTxArgs) {
+		txArgs.To = nil
+		txArgs.Input = []byte{byte(vm.INVALID)}
+		txArgs.GasLimit = 100_000
+	}
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		enabled       bool
+		reCheckTx     bool
+		malleate      func(txArgs *evmtypes.EvmTxArgs)
+	}{
+		{
+			name:          "success: the execution succeeds",
+			expectedError: nil,
+			enabled:       true,
+			malleate:      func(*evmtypes.EvmTxArgs) {},
+		},
+		{
+			name:          "fail: the execution fails",
+			expectedError: evmtypes.ErrVMExecution,
+			enabled:       true,
+			malleate:      failingTx,
+		},
+		{
+			name:          "success: the execution fails but the simulation is disabled",
+			expectedError: nil,
+			enabled:       false,
+			malleate:      failingTx,
+		},
+		{
+			name:          "success: the execution fails but the tx is rechecked",
+			expectedError: nil,
+			enabled:       true,
+			reCheckTx:     true,
+			malleate:      failingTx,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(s.EthTxType), s.ChainID, tc.name), func() {
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, s.EthTxType)
+			s.Require().NoError(err)
+			recipient := utiltx.GenerateAddress()
+			txArgs.To = &recipient
+			txArgs.Amount = big.NewInt(100)
+
+			tc.malleate(&txArgs)
+
+			tx, err := txFactory.GenerateSignedEthTx(senderKey.Priv, txArgs)
+			s.Require().NoError(err)
+
+			dec := evmante.NewEVMMonoDecorator(
+				unitNetwork.App.GetAccountKeeper(),
+				unitNetwork.App.GetFeeMarketKeeper(),
+				unitNetwork.App.GetEVMKeeper(),
+				0,
+			).WithCheckTxSimulation(tc.enabled)
+
+			ctx := unitNetwork.GetContext().WithIsCheckTx(true).WithIsReCheckTx(tc.reCheckTx)
+
+			// Function under test
+			_, err = dec.AnteHandle(ctx, tx, false, testutil.NoOpNextFn)
+
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
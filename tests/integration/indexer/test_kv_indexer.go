@@ -1,11 +1,19 @@
 package indexer
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"math/big"
+	"strconv"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -17,11 +25,16 @@ import (
 	"github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
@@ -60,6 +73,22 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 	txBz2, err := clientCtx.TxConfig.TxEncoder()(tmTx2)
 	require.NoError(t, err)
 
+	witness := &cosmosevmtypes.TxWitness{
+		Reads:  ethtypes.AccessList{{Address: from, StorageKeys: []common.Hash{}}, {Address: to, StorageKeys: []common.Hash{}}},
+		Writes: ethtypes.AccessList{{Address: from, StorageKeys: []common.Hash{}}, {Address: to, StorageKeys: []common.Hash{}}},
+	}
+	sdkWitnessEvent, err := types.NewTxWitnessEvent(txHash, witness)
+	require.NoError(t, err)
+	witnessEvent := abci.Event(sdkWitnessEvent)
+
+	modifiedAccounts := []common.Address{to, from}
+	if from.Cmp(to) < 0 {
+		modifiedAccounts = []common.Address{from, to}
+	}
+	sdkModifiedAccountsEvent, err := types.NewModifiedAccountsEvent(txHash, []common.Address{to, from, to})
+	require.NoError(t, err)
+	modifiedAccountsEvent := abci.Event(sdkModifiedAccountsEvent)
+
 	testCases := []struct {
 		name        string
 		block       *cmttypes.Block
@@ -81,6 +110,8 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 							{Key: "txHash", Value: ""},
 							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
 						}},
+						witnessEvent,
+						modifiedAccountsEvent,
 					},
 				},
 			},
@@ -103,6 +134,8 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 							{Key: "txHash", Value: "14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57"},
 							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
 						}},
+						witnessEvent,
+						modifiedAccountsEvent,
 					},
 				},
 			},
@@ -113,9 +146,46 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 			&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
 			[]*abci.ExecTxResult{
 				{
-					Code:   11,
-					Log:    "out of gas in location: block gas meter; gasWanted: 21000",
-					Events: []abci.Event{},
+					Codespace: types.ModuleName,
+					Code:      types.ErrBlockGasLimitExceeded.ABCICode(),
+					Events:    []abci.Event{},
+				},
+			},
+			true,
+		},
+		{
+			"success, exceed block gas limit with the out of gas error of the block gas meter",
+			&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+			[]*abci.ExecTxResult{
+				{
+					Codespace: errortypes.RootCodespace,
+					Code:      errortypes.ErrOutOfGas.ABCICode(),
+					Log:       "out of gas in location: block gas meter; gasWanted: 21000",
+					Events:    []abci.Event{},
+				},
+			},
+			true,
+		},
+		{
+			"success, failed to commit stateDB",
+			&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+			[]*abci.ExecTxResult{
+				{
+					Codespace: types.ModuleName,
+					Code:      types.ErrStateDBCommit.ABCICode(),
+					Events:    []abci.Event{},
+				},
+			},
+			true,
+		},
+		{
+			"success, failed batch",
+			&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+			[]*abci.ExecTxResult{
+				{
+					Codespace: types.ModuleName,
+					Code:      types.ErrBatchedTxFailed.ABCICode(),
+					Events:    []abci.Event{},
 				},
 			},
 			true,
@@ -183,10 +253,676 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 				res1, err := idxer.GetByTxHash(txHash)
 				require.NoError(t, err)
 				require.NotNil(t, res1)
+				require.Equal(t, from.Bytes(), res1.Sender)
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				// the eth tx and the cosmos tx including it are cross referenced
+				cosmosTxHash, err := idxer.GetCosmosTxHashByEthTxHash(txHash)
+				require.NoError(t, err)
+				require.Equal(t, cmttypes.Tx(txBz).Hash(), cosmosTxHash)
+				ethTxHashes, err := idxer.GetEthTxHashesByCosmosTxHash(cmttypes.Tx(txBz).Hash())
+				require.NoError(t, err)
+				require.Equal(t, []common.Hash{txHash}, ethTxHashes)
+
+				// the tx is indexed by its sender and recipient
+				for _, address := range []common.Address{from, to} {
+					hashes, cursor, err := addressTxHashes(idxer, address, cosmosevmtypes.AddressTxAll, nil, 10)
+					require.NoError(t, err)
+					require.Equal(t, []common.Hash{txHash}, hashes)
+					require.Nil(t, cursor)
+				}
+
+				// the senders of the txs indexed by older versions are backfilled
+				// from their blocks once
+				legacy := *res1
+				legacy.Sender = nil
+				require.NoError(t, db.Set(indexer.TxHashKey(txHash), clientCtx.Codec.MustMarshal(&legacy)))
+				updated, err := idxer.BackfillSenders(func(height int64) (*cmttypes.Block, error) {
+					require.Equal(t, tc.block.Height, height)
+					return tc.block, nil
+				})
+				require.NoError(t, err)
+				require.Equal(t, 1, updated)
+				backfilled, err := idxer.GetByTxHash(txHash)
+				require.NoError(t, err)
+				require.Equal(t, res1, backfilled)
+				updated, err = idxer.BackfillSenders(nil)
+				require.NoError(t, err)
+				require.Zero(t, updated)
+
+				if tc.blockResult[0].Code == abci.CodeTypeOK {
+					res, err := idxer.GetWitnessByTxHash(txHash)
+					require.NoError(t, err)
+					require.Equal(t, witness, res)
+
+					accounts, err := idxer.GetModifiedAccountsByBlock(tc.block.Height)
+					require.NoError(t, err)
+					require.Equal(t, modifiedAccounts, accounts)
+				}
+
+				// the call traces of the block are stored along the txs
+				traces, err := idxer.GetCallTracesByBlock(tc.block.Height)
+				require.NoError(t, err)
+				require.Nil(t, traces)
+				require.NoError(t, idxer.IndexCallTraces(tc.block.Height, json.RawMessage("[]")))
+				traces, err = idxer.GetCallTracesByBlock(tc.block.Height)
+				require.NoError(t, err)
+				require.Equal(t, json.RawMessage("[]"), traces)
+
+				// the indexer entries survive a snapshot round trip
+				var payloads [][]byte
+				require.NoError(t, indexer.NewSnapshotter(db, 1, 0).SnapshotExtension(uint64(tc.block.Height), func(payload []byte) error { //nolint:gosec // G115
+					payloads = append(payloads, payload)
+					return nil
+				}))
+				restore := func(db dbm.DB, payloads [][]byte) error {
+					return indexer.NewSnapshotter(db, 1, 0).RestoreExtension(uint64(tc.block.Height), indexer.SnapshotFormat, func() ([]byte, error) { //nolint:gosec // G115
+						if len(payloads) == 0 {
+							return nil, io.EOF
+						}
+						payload := payloads[0]
+						payloads = payloads[1:]
+						return payload, nil
+					})
+				}
+				restoredDB := dbm.NewMemDB()
+				require.NoError(t, restore(restoredDB, payloads))
+				restoredIdxer := indexer.NewKVIndexer(restoredDB, log.NewNopLogger(), clientCtx)
+				res3, err := restoredIdxer.GetByTxHash(txHash)
+				require.NoError(t, err)
+				require.Equal(t, res1, res3)
+				restoredTraces, err := restoredIdxer.GetCallTracesByBlock(tc.block.Height)
+				require.NoError(t, err)
+				require.Equal(t, traces, restoredTraces)
+				restoredCosmosTxHash, err := restoredIdxer.GetCosmosTxHashByEthTxHash(txHash)
+				require.NoError(t, err)
+				require.Equal(t, cosmosTxHash, restoredCosmosTxHash)
+				restoredEthTxHashes, err := restoredIdxer.GetEthTxHashesByCosmosTxHash(cosmosTxHash)
+				require.NoError(t, err)
+				require.Equal(t, ethTxHashes, restoredEthTxHashes)
+				restoredHashes, _, err := addressTxHashes(restoredIdxer, to, cosmosevmtypes.AddressTxAll, nil, 10)
+				require.NoError(t, err)
+				require.Equal(t, []common.Hash{txHash}, restoredHashes)
+				if tc.blockResult[0].Code == abci.CodeTypeOK {
+					res, err := restoredIdxer.GetWitnessByTxHash(txHash)
+					require.NoError(t, err)
+					require.Equal(t, witness, res)
+					accounts, err := restoredIdxer.GetModifiedAccountsByBlock(tc.block.Height)
+					require.NoError(t, err)
+					require.Equal(t, modifiedAccounts, accounts)
+				}
+
+				// the covered range is recorded as the indexed range
+				first, last, err = indexer.LoadIndexedRange(restoredDB)
+				require.NoError(t, err)
+				require.Equal(t, tc.block.Height, first)
+				require.Equal(t, tc.block.Height, last)
+
+				// the invalid entries are rejected
+				header := payloads[0]
+				for _, payload := range [][]byte{
+					{},
+					append([]byte{1, 9}, txHash.Bytes()...),
+					append(append([]byte{33}, indexer.TxHashKey(txHash)...), 0xff),
+					append(append([]byte{9}, indexer.CallTraceKey(tc.block.Height)...), '{'),
+					// the entries of the blocks out of the covered range
+					append(append([]byte{9}, indexer.CallTraceKey(tc.block.Height+1)...), "[]"...),
+				} {
+					require.Error(t, restore(dbm.NewMemDB(), [][]byte{header, payload}))
+				}
+				// the headers which are invalid or don't end at the snapshot height
+				// are rejected
+				for _, payload := range [][]byte{
+					{},
+					header[:8],
+					append(sdk.Uint64ToBigEndian(uint64(tc.block.Height)), sdk.Uint64ToBigEndian(uint64(tc.block.Height+1))...), //nolint:gosec // G115
+				} {
+					require.Error(t, restore(dbm.NewMemDB(), [][]byte{payload}))
+				}
+
+				// the snapshots of the heights the indexer hasn't indexed yet
+				// include no payload
+				require.NoError(t, indexer.NewSnapshotter(db, 1, 0).SnapshotExtension(uint64(tc.block.Height+1), func([]byte) error { //nolint:gosec // G115
+					return errors.New("unexpected payload")
+				}))
+
+				// rolling back to the same height keeps the indexed txs
+				require.NoError(t, idxer.Rollback(tc.block.Height))
+				last, err = idxer.LastIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, tc.block.Height, last)
+
+				// rolling back below the indexed height removes them, rolling
+				// back again is a no-op
+				require.NoError(t, idxer.Rollback(tc.block.Height-1))
+				require.NoError(t, idxer.Rollback(tc.block.Height-1))
+				last, err = idxer.LastIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, int64(-1), last)
+				_, err = idxer.GetByTxHash(txHash)
+				require.Error(t, err)
+				_, err = idxer.GetWitnessByTxHash(txHash)
+				require.Error(t, err)
+				traces, err = idxer.GetCallTracesByBlock(tc.block.Height)
+				require.NoError(t, err)
+				require.Nil(t, traces)
+				accounts, err := idxer.GetModifiedAccountsByBlock(tc.block.Height)
+				require.NoError(t, err)
+				require.Nil(t, accounts)
+				cosmosTxHash, err = idxer.GetCosmosTxHashByEthTxHash(txHash)
+				require.NoError(t, err)
+				require.Nil(t, cosmosTxHash)
+				ethTxHashes, err = idxer.GetEthTxHashesByCosmosTxHash(cmttypes.Tx(txBz).Hash())
+				require.NoError(t, err)
+				require.Nil(t, ethTxHashes)
+				hashes, _, err := addressTxHashes(idxer, from, cosmosevmtypes.AddressTxAll, nil, 10)
+				require.NoError(t, err)
+				require.Nil(t, hashes)
 			}
 		})
 	}
 }
+
+func TestKVIndexerAddressTxs(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// buildTx returns a signed cosmos tx of an eth tx of the sender, with the
+	// result of its successful execution at the given eth tx index
+	buildTx := func(nonce uint64, to *common.Address, ethTxIndex int) (cmttypes.Tx, common.Hash, *abci.ExecTxResult) {
+		tx := types.NewTx(&types.EvmTxArgs{Nonce: nonce, To: to, Amount: big.NewInt(1000), GasLimit: 100000})
+		tx.From = from.Bytes()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+		txHash := tx.AsTransaction().Hash()
+		return txBz, txHash, &abci.ExecTxResult{
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: strconv.Itoa(ethTxIndex)},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		}
+	}
+
+	to := common.BigToAddress(big.NewInt(1))
+	contract := crypto.CreateAddress(from, 0)
+	tx0, hash0, res0 := buildTx(0, nil, 0)
+	tx1, hash1, res1 := buildTx(1, &to, 0)
+	tx2, hash2, res2 := buildTx(2, &from, 1)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+	block1 := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{tx0}}}
+	require.NoError(t, idxer.IndexBlock(block1, []*abci.ExecTxResult{res0}))
+	block2 := &cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: cmttypes.Data{Txs: []cmttypes.Tx{tx1, tx2}}}
+	require.NoError(t, idxer.IndexBlock(block2, []*abci.ExecTxResult{res1, res2}))
+
+	// the txs of the sender are paginated newest first, the self transfer is
+	// indexed once
+	hashes, cursor, err := addressTxHashes(idxer, from, cosmosevmtypes.AddressTxAll, nil, 2)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash2, hash1}, hashes)
+	require.NotNil(t, cursor)
+	hashes, cursor, err = addressTxHashes(idxer, from, cosmosevmtypes.AddressTxAll, cursor, 2)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash0}, hashes)
+	require.Nil(t, cursor)
+	_, _, err = addressTxHashes(idxer, from, cosmosevmtypes.AddressTxAll, []byte{1}, 2)
+	require.Error(t, err)
+
+	hashes, _, err = addressTxHashes(idxer, to, cosmosevmtypes.AddressTxAll, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash1}, hashes)
+
+	// the contract is indexed with its creation tx
+	hashes, _, err = addressTxHashes(idxer, contract, cosmosevmtypes.AddressTxAll, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash0}, hashes)
+	creation, err := idxer.GetContractCreation(contract)
+	require.NoError(t, err)
+	require.Equal(t, &hash0, creation)
+	creation, err = idxer.GetContractCreation(to)
+	require.NoError(t, err)
+	require.Nil(t, creation)
+
+	// the txs are filtered by kind
+	hashes, _, err = addressTxHashes(idxer, from, cosmosevmtypes.AddressTxReceived, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash2}, hashes)
+	txs, _, err := idxer.GetAddressTxs(from, cosmosevmtypes.AddressTxAll, nil, 1)
+	require.NoError(t, err)
+	require.Equal(t, []cosmosevmtypes.AddressTx{{
+		Hash:       hash2,
+		Height:     2,
+		EthTxIndex: 1,
+		Kind:       cosmosevmtypes.AddressTxSent | cosmosevmtypes.AddressTxReceived,
+	}}, txs)
+
+	// the internal calls of the stored call traces are indexed, the top level
+	// calls and the traces of the txs which aren't indexed are skipped
+	other := common.BigToAddress(big.NewInt(2))
+	created := common.BigToAddress(big.NewInt(3))
+	traces := fmt.Sprintf(`[
+		{"action":{"from":"%[1]s","to":"%[2]s"},"traceAddress":[],"transactionHash":"%[5]s","type":"call"},
+		{"action":{"from":"%[2]s","to":"%[3]s"},"traceAddress":[0],"transactionHash":"%[5]s","type":"call"},
+		{"action":{"from":"%[2]s"},"result":{"address":"%[4]s"},"traceAddress":[1],"transactionHash":"%[5]s","type":"create"},
+		{"action":{"from":"%[2]s","to":"%[3]s"},"traceAddress":[0],"transactionHash":"%[6]s","type":"call"}
+	]`, from.Hex(), to.Hex(), other.Hex(), created.Hex(), hash1.Hex(), common.Hash{}.Hex())
+	require.NoError(t, idxer.IndexCallTraces(2, json.RawMessage(traces)))
+	hashes, _, err = addressTxHashes(idxer, other, cosmosevmtypes.AddressTxInternal, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash1}, hashes)
+	hashes, _, err = addressTxHashes(idxer, from, cosmosevmtypes.AddressTxInternal, nil, 10)
+	require.NoError(t, err)
+	require.Nil(t, hashes)
+	txs, _, err = idxer.GetAddressTxs(to, cosmosevmtypes.AddressTxAll, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, cosmosevmtypes.AddressTxReceived|cosmosevmtypes.AddressTxInternal, txs[0].Kind)
+	creation, err = idxer.GetContractCreation(created)
+	require.NoError(t, err)
+	require.Equal(t, &hash1, creation)
+
+	// the entries survive a snapshot round trip
+	var payloads [][]byte
+	require.NoError(t, indexer.NewSnapshotter(db, 2, 0).SnapshotExtension(2, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+	restoredDB := dbm.NewMemDB()
+	require.NoError(t, indexer.NewSnapshotter(restoredDB, 2, 0).RestoreExtension(2, indexer.SnapshotFormat, func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}))
+	restoredIdxer := indexer.NewKVIndexer(restoredDB, log.NewNopLogger(), clientCtx)
+	hashes, _, err = addressTxHashes(restoredIdxer, from, cosmosevmtypes.AddressTxAll, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash2, hash1, hash0}, hashes)
+	creation, err = restoredIdxer.GetContractCreation(contract)
+	require.NoError(t, err)
+	require.Equal(t, &hash0, creation)
+	hashes, _, err = addressTxHashes(restoredIdxer, other, cosmosevmtypes.AddressTxInternal, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash1}, hashes)
+
+	// the rolled back txs are removed along their contract creations
+	require.NoError(t, idxer.Rollback(1))
+	hashes, _, err = addressTxHashes(idxer, from, cosmosevmtypes.AddressTxAll, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash0}, hashes)
+	hashes, _, err = addressTxHashes(idxer, to, cosmosevmtypes.AddressTxAll, nil, 10)
+	require.NoError(t, err)
+	require.Nil(t, hashes)
+	hashes, _, err = addressTxHashes(idxer, other, cosmosevmtypes.AddressTxAll, nil, 10)
+	require.NoError(t, err)
+	require.Nil(t, hashes)
+	creation, err = idxer.GetContractCreation(created)
+	require.NoError(t, err)
+	require.Nil(t, creation)
+	require.NoError(t, idxer.Rollback(0))
+	hashes, _, err = addressTxHashes(idxer, from, cosmosevmtypes.AddressTxAll, nil, 10)
+	require.NoError(t, err)
+	require.Nil(t, hashes)
+	creation, err = idxer.GetContractCreation(contract)
+	require.NoError(t, err)
+	require.Nil(t, creation)
+}
+
+// buildLogsTx returns a signed cosmos tx of an eth tx of the key, with the
+// result of its successful execution emitting the given logs
+func buildLogsTx(
+	t *testing.T,
+	clientCtx client.Context,
+	priv *ethsecp256k1.PrivKey,
+	nonce uint64,
+	logs []*ethtypes.Log,
+) (cmttypes.Tx, common.Hash, *abci.ExecTxResult) {
+	t.Helper()
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{Nonce: nonce, To: &to, GasLimit: 100000})
+	tx.From = priv.PubKey().Address().Bytes()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), utiltx.NewSigner(priv)))
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+	data, err := proto.Marshal(&sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(&types.MsgEthereumTxResponse{Logs: types.NewLogsFromEth(logs)})},
+	})
+	require.NoError(t, err)
+	txHash := tx.AsTransaction().Hash()
+	return txBz, txHash, &abci.ExecTxResult{
+		Data: data,
+		Events: []abci.Event{
+			{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "txGasUsed", Value: "21000"},
+			}},
+		},
+	}
+}
+
+// addressTxHashes returns the hashes of a page of the txs of an address of the
+// given kinds, and the cursor of the next page
+func addressTxHashes(
+	idxer *indexer.KVIndexer,
+	address common.Address,
+	kind cosmosevmtypes.AddressTxKind,
+	cursor []byte,
+	limit int,
+) ([]common.Hash, []byte, error) {
+	txs, next, err := idxer.GetAddressTxs(address, kind, cursor, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hashes []common.Hash
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash)
+	}
+	return hashes, next, nil
+}
+
+func TestKVIndexerTokenTransfers(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	buildTx := func(nonce uint64, logs []*ethtypes.Log) (cmttypes.Tx, common.Hash, *abci.ExecTxResult) {
+		return buildLogsTx(t, clientCtx, priv, nonce, logs)
+	}
+
+	transferTopic := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	approvalTopic := crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+	transferSingleTopic := crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	transferBatchTopic := crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+	uint256Array, err := abi.NewType("uint256[]", "", nil)
+	require.NoError(t, err)
+	batchData, err := abi.Arguments{{Type: uint256Array}, {Type: uint256Array}}.Pack(
+		[]*big.Int{big.NewInt(7), big.NewInt(8)},
+		[]*big.Int{big.NewInt(70), big.NewInt(80)},
+	)
+	require.NoError(t, err)
+
+	erc20 := common.BigToAddress(big.NewInt(20))
+	erc721 := common.BigToAddress(big.NewInt(721))
+	erc1155 := common.BigToAddress(big.NewInt(1155))
+	holder := common.BigToAddress(big.NewInt(100))
+	recipient := common.BigToAddress(big.NewInt(101))
+	zero := common.Hash{}
+	word := func(v int64) []byte { return common.BigToHash(big.NewInt(v)).Bytes() }
+
+	tx0, hash0, res0 := buildTx(0, []*ethtypes.Log{
+		{Address: erc20, Topics: []common.Hash{transferTopic, zero, common.BytesToHash(holder.Bytes())}, Data: word(1000), Index: 0},
+		{Address: erc721, Topics: []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(holder.Bytes()), common.BigToHash(big.NewInt(5))}, Index: 1},
+		{Address: erc20, Topics: []common.Hash{approvalTopic, common.BytesToHash(holder.Bytes()), common.BytesToHash(from.Bytes())}, Data: word(1), Index: 2},
+		{Address: erc1155, Topics: []common.Hash{transferSingleTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(from.Bytes()), common.BytesToHash(holder.Bytes())}, Data: append(word(6), word(60)...), Index: 3},
+		{Address: erc1155, Topics: []common.Hash{transferBatchTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(from.Bytes()), common.BytesToHash(holder.Bytes())}, Data: batchData, Index: 4},
+	})
+	tx1, hash1, res1 := buildTx(1, []*ethtypes.Log{
+		{Address: erc20, Topics: []common.Hash{transferTopic, common.BytesToHash(holder.Bytes()), common.BytesToHash(recipient.Bytes())}, Data: word(400), Index: 0},
+	})
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+	block1 := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{tx0}}}
+	require.NoError(t, idxer.IndexBlock(block1, []*abci.ExecTxResult{res0}))
+	block2 := &cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: cmttypes.Data{Txs: []cmttypes.Tx{tx1}}}
+	require.NoError(t, idxer.IndexBlock(block2, []*abci.ExecTxResult{res1}))
+
+	// the transfers of the holder are decoded oldest first, the batch transfer
+	// is split by token id and the approval is skipped
+	transfers, err := idxer.GetTokenTransfers(holder, 1, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 6)
+	operator := from
+	require.Equal(t, &cosmosevmtypes.TokenTransfer{
+		Standard:        cosmosevmtypes.TokenStandardERC20,
+		Token:           erc20,
+		To:              holder,
+		Value:           (*hexutil.Big)(big.NewInt(1000)),
+		TransactionHash: hash0,
+		BlockNumber:     1,
+	}, transfers[0])
+	require.Equal(t, &cosmosevmtypes.TokenTransfer{
+		Standard:        cosmosevmtypes.TokenStandardERC721,
+		Token:           erc721,
+		From:            from,
+		To:              holder,
+		TokenID:         (*hexutil.Big)(big.NewInt(5)),
+		Value:           (*hexutil.Big)(big.NewInt(1)),
+		TransactionHash: hash0,
+		BlockNumber:     1,
+		LogIndex:        1,
+	}, transfers[1])
+	require.Equal(t, &cosmosevmtypes.TokenTransfer{
+		Standard:        cosmosevmtypes.TokenStandardERC1155,
+		Token:           erc1155,
+		Operator:        &operator,
+		From:            from,
+		To:              holder,
+		TokenID:         (*hexutil.Big)(big.NewInt(6)),
+		Value:           (*hexutil.Big)(big.NewInt(60)),
+		TransactionHash: hash0,
+		BlockNumber:     1,
+		LogIndex:        3,
+	}, transfers[2])
+	require.Equal(t, big.NewInt(7), transfers[3].TokenID.ToInt())
+	require.Equal(t, big.NewInt(70), transfers[3].Value.ToInt())
+	require.Equal(t, big.NewInt(8), transfers[4].TokenID.ToInt())
+	require.Equal(t, big.NewInt(80), transfers[4].Value.ToInt())
+	require.Equal(t, recipient, transfers[5].To)
+	require.Equal(t, hash1, transfers[5].TransactionHash)
+
+	// the transfers are filtered by block range and capped by the limit
+	transfers, err = idxer.GetTokenTransfers(holder, 2, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	require.Equal(t, hash1, transfers[0].TransactionHash)
+	transfers, err = idxer.GetTokenTransfers(holder, 1, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+
+	// the transfers are indexed by token contract, and not by the zero address
+	// of the mints
+	transfers, err = idxer.GetTokenTransfers(erc20, 1, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	transfers, err = idxer.GetTokenTransfers(common.Address{}, 1, 2, 10)
+	require.NoError(t, err)
+	require.Empty(t, transfers)
+
+	// the transfers survive a snapshot round trip
+	var payloads [][]byte
+	require.NoError(t, indexer.NewSnapshotter(db, 2, 0).SnapshotExtension(2, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+	restoredDB := dbm.NewMemDB()
+	require.NoError(t, indexer.NewSnapshotter(restoredDB, 2, 0).RestoreExtension(2, indexer.SnapshotFormat, func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}))
+	restoredIdxer := indexer.NewKVIndexer(restoredDB, log.NewNopLogger(), clientCtx)
+	transfers, err = restoredIdxer.GetTokenTransfers(holder, 1, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 6)
+	transfers, err = restoredIdxer.GetTokenTransfers(recipient, 1, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+
+	// the rolled back transfers are removed from all their addresses
+	require.NoError(t, idxer.Rollback(1))
+	transfers, err = idxer.GetTokenTransfers(holder, 1, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 5)
+	transfers, err = idxer.GetTokenTransfers(recipient, 1, 2, 10)
+	require.NoError(t, err)
+	require.Empty(t, transfers)
+	transfers, err = idxer.GetTokenTransfers(erc20, 1, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+}
+
+func TestKVIndexerNFTBalances(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	operator := common.BytesToHash(priv.PubKey().Address().Bytes())
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	transferTopic := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	transferSingleTopic := crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	transferBatchTopic := crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+	uint256Array, err := abi.NewType("uint256[]", "", nil)
+	require.NoError(t, err)
+	batchData, err := abi.Arguments{{Type: uint256Array}, {Type: uint256Array}}.Pack(
+		[]*big.Int{big.NewInt(7), big.NewInt(8)},
+		[]*big.Int{big.NewInt(70), big.NewInt(80)},
+	)
+	require.NoError(t, err)
+
+	erc20 := common.BigToAddress(big.NewInt(20))
+	erc721 := common.BigToAddress(big.NewInt(721))
+	erc1155 := common.BigToAddress(big.NewInt(1155))
+	holder := common.BytesToHash(common.BigToAddress(big.NewInt(100)).Bytes())
+	recipient := common.BytesToHash(common.BigToAddress(big.NewInt(101)).Bytes())
+	zero := common.Hash{}
+	word := func(v int64) []byte { return common.BigToHash(big.NewInt(v)).Bytes() }
+
+	// the tokens are minted to the holder, then some are sent to the recipient
+	tx0, _, res0 := buildLogsTx(t, clientCtx, priv, 0, []*ethtypes.Log{
+		{Address: erc721, Topics: []common.Hash{transferTopic, zero, holder, common.BigToHash(big.NewInt(5))}, Index: 0},
+		{Address: erc1155, Topics: []common.Hash{transferSingleTopic, operator, zero, holder}, Data: append(word(6), word(60)...), Index: 1},
+		{Address: erc1155, Topics: []common.Hash{transferBatchTopic, operator, zero, holder}, Data: batchData, Index: 2},
+	})
+	tx1, _, res1 := buildLogsTx(t, clientCtx, priv, 1, []*ethtypes.Log{
+		{Address: erc721, Topics: []common.Hash{transferTopic, holder, recipient, common.BigToHash(big.NewInt(5))}, Index: 0},
+		{Address: erc1155, Topics: []common.Hash{transferSingleTopic, operator, holder, recipient}, Data: append(word(6), word(20)...), Index: 1},
+		{Address: erc1155, Topics: []common.Hash{transferBatchTopic, operator, holder, zero}, Data: batchData, Index: 2},
+		{Address: erc20, Topics: []common.Hash{transferTopic, holder, recipient}, Data: word(1000), Index: 3},
+	})
+	block1 := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{tx0}}}
+	block2 := &cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: cmttypes.Data{Txs: []cmttypes.Tx{tx1}}}
+
+	nft := func(standard string, token common.Address, tokenID, balance int64) *cosmosevmtypes.NFT {
+		return &cosmosevmtypes.NFT{
+			Standard: standard,
+			Token:    token,
+			TokenID:  (*hexutil.Big)(big.NewInt(tokenID)),
+			Balance:  (*hexutil.Big)(big.NewInt(balance)),
+		}
+	}
+	// nfts returns all the NFTs of an owner
+	nfts := func(idxer *indexer.KVIndexer, owner common.Hash) []*cosmosevmtypes.NFT {
+		nfts, next, err := idxer.GetNFTsByOwner(common.BytesToAddress(owner.Bytes()), nil, 10)
+		require.NoError(t, err)
+		require.Nil(t, next)
+		return nfts
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(block1, []*abci.ExecTxResult{res0}))
+	require.Equal(t, []*cosmosevmtypes.NFT{
+		nft(cosmosevmtypes.TokenStandardERC721, erc721, 5, 1),
+		nft(cosmosevmtypes.TokenStandardERC1155, erc1155, 6, 60),
+		nft(cosmosevmtypes.TokenStandardERC1155, erc1155, 7, 70),
+		nft(cosmosevmtypes.TokenStandardERC1155, erc1155, 8, 80),
+	}, nfts(idxer, holder))
+
+	// the sent tokens move to the recipient, the burnt ones are removed and the
+	// ERC20 transfers are skipped
+	require.NoError(t, idxer.IndexBlock(block2, []*abci.ExecTxResult{res1}))
+	require.Equal(t, []*cosmosevmtypes.NFT{
+		nft(cosmosevmtypes.TokenStandardERC1155, erc1155, 6, 40),
+	}, nfts(idxer, holder))
+	require.Equal(t, []*cosmosevmtypes.NFT{
+		nft(cosmosevmtypes.TokenStandardERC721, erc721, 5, 1),
+		nft(cosmosevmtypes.TokenStandardERC1155, erc1155, 6, 20),
+	}, nfts(idxer, recipient))
+	require.Empty(t, nfts(idxer, zero))
+
+	// the NFTs are paginated
+	page, cursor, err := idxer.GetNFTsByOwner(common.BytesToAddress(recipient.Bytes()), nil, 1)
+	require.NoError(t, err)
+	require.Equal(t, []*cosmosevmtypes.NFT{nft(cosmosevmtypes.TokenStandardERC721, erc721, 5, 1)}, page)
+	require.NotNil(t, cursor)
+	page, cursor, err = idxer.GetNFTsByOwner(common.BytesToAddress(recipient.Bytes()), cursor, 1)
+	require.NoError(t, err)
+	require.Equal(t, []*cosmosevmtypes.NFT{nft(cosmosevmtypes.TokenStandardERC1155, erc1155, 6, 20)}, page)
+	require.Nil(t, cursor)
+	_, _, err = idxer.GetNFTsByOwner(common.BytesToAddress(recipient.Bytes()), []byte{1}, 1)
+	require.Error(t, err)
+
+	// the balances are rebuilt once, in the order of the transfers, after the
+	// blocks were indexed backward
+	backwardDB := dbm.NewMemDB()
+	backwardIdxer := indexer.NewKVIndexer(backwardDB, log.NewNopLogger(), clientCtx)
+	require.NoError(t, backwardIdxer.IndexBlock(block2, []*abci.ExecTxResult{res1}))
+	require.NoError(t, backwardIdxer.IndexBlock(block1, []*abci.ExecTxResult{res0}))
+	replayed, err := backwardIdxer.BackfillNFTBalances()
+	require.NoError(t, err)
+	require.Equal(t, 9, replayed)
+	require.Equal(t, nfts(idxer, holder), nfts(backwardIdxer, holder))
+	require.Equal(t, nfts(idxer, recipient), nfts(backwardIdxer, recipient))
+	replayed, err = backwardIdxer.BackfillNFTBalances()
+	require.NoError(t, err)
+	require.Zero(t, replayed)
+
+	// the balances are rebuilt from the transfers of the snapshot blocks
+	var payloads [][]byte
+	require.NoError(t, indexer.NewSnapshotter(db, 1, 0).SnapshotExtension(2, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+	restoredDB := dbm.NewMemDB()
+	require.NoError(t, indexer.NewSnapshotter(restoredDB, 1, 0).RestoreExtension(2, indexer.SnapshotFormat, func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}))
+	restoredIdxer := indexer.NewKVIndexer(restoredDB, log.NewNopLogger(), clientCtx)
+	require.Empty(t, nfts(restoredIdxer, holder))
+	require.Equal(t, nfts(idxer, recipient), nfts(restoredIdxer, recipient))
+
+	// the rolled back transfers are reverted
+	require.NoError(t, idxer.Rollback(1))
+	require.Equal(t, []*cosmosevmtypes.NFT{
+		nft(cosmosevmtypes.TokenStandardERC721, erc721, 5, 1),
+		nft(cosmosevmtypes.TokenStandardERC1155, erc1155, 6, 60),
+		nft(cosmosevmtypes.TokenStandardERC1155, erc1155, 7, 70),
+		nft(cosmosevmtypes.TokenStandardERC1155, erc1155, 8, 80),
+	}, nfts(idxer, holder))
+	require.Empty(t, nfts(idxer, recipient))
+}
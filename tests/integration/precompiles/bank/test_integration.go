@@ -13,8 +13,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	bank2 "github.com/cosmos/evm/precompiles/bank"
-	"github.com/cosmos/evm/precompiles/bank/testdata"
 	"github.com/cosmos/evm/precompiles/testutil"
+	testcontracts "github.com/cosmos/evm/testutil/contracts"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
@@ -131,8 +131,7 @@ func TestIntegrationSuite(t *testing.T, create network.CreateEvmApp, options ...
 			sender = is.keyring.GetKey(0)
 			amount = big.NewInt(1e18)
 
-			bankCallerContract, err = testdata.LoadBankCallerContract()
-			Expect(err).ToNot(HaveOccurred(), "failed to load BankCaller contract")
+			bankCallerContract = testcontracts.BankCallerContract
 
 			bankCallerContractAddr, err = is.factory.DeployContract(
 				sender.Priv,
@@ -247,6 +247,92 @@ func (s *TestSuite) TestGetStorageAt() {
 	}
 }
 
+func (s *TestSuite) TestGetStorageSlots() {
+	blockNr1 := rpctypes.NewBlockNumber(big.NewInt(1))
+	blockNr2 := rpctypes.NewBlockNumber(big.NewInt(2))
+	addr1 := utiltx.GenerateAddress()
+	addr2 := utiltx.GenerateAddress()
+	value1 := common.BytesToHash([]byte("value1"))
+	value2 := common.BytesToHash([]byte("value2"))
+	value3 := common.BytesToHash([]byte("value3"))
+
+	testCases := []struct {
+		name         string
+		slots        []rpctypes.StorageSlotArgs
+		registerMock func()
+		expPass      bool
+		expValues    []hexutil.Bytes
+	}{
+		{
+			"pass - no slots",
+			nil,
+			func() {},
+			true,
+			[]hexutil.Bytes{},
+		},
+		{
+			"pass - one query per block",
+			[]rpctypes.StorageSlotArgs{
+				{Address: addr1, Slot: "0x0", BlockTag: rpctypes.BlockNumberOrHash{BlockNumber: &blockNr1}},
+				{Address: addr2, Slot: "0x1", BlockTag: rpctypes.BlockNumberOrHash{BlockNumber: &blockNr2}},
+				{Address: addr2, Slot: "0x2", BlockTag: rpctypes.BlockNumberOrHash{BlockNumber: &blockNr1}},
+			},
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageSlots(QueryClient, 1, []*evmtypes.QueryStorageRequest{
+					{Address: addr1.String(), Key: "0x0"},
+					{Address: addr2.String(), Key: "0x2"},
+				}, []string{value1.Hex(), value3.Hex()})
+				RegisterStorageSlots(QueryClient, 2, []*evmtypes.QueryStorageRequest{
+					{Address: addr2.String(), Key: "0x1"},
+				}, []string{value2.Hex()})
+			},
+			true,
+			[]hexutil.Bytes{value1.Bytes(), value2.Bytes(), value3.Bytes()},
+		},
+		{
+			"fail - query client errors on getting StorageSlots",
+			[]rpctypes.StorageSlotArgs{
+				{Address: addr1, Slot: "0x0", BlockTag: rpctypes.BlockNumberOrHash{BlockNumber: &blockNr1}},
+			},
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageSlotsError(QueryClient, 1)
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - missing storage values",
+			[]rpctypes.StorageSlotArgs{
+				{Address: addr1, Slot: "0x0", BlockTag: rpctypes.BlockNumberOrHash{BlockNumber: &blockNr1}},
+			},
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageSlots(QueryClient, 1, []*evmtypes.QueryStorageRequest{
+					{Address: addr1.String(), Key: "0x0"},
+				}, nil)
+			},
+			false,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest()
+			tc.registerMock()
+
+			values, err := s.backend.GetStorageSlots(tc.slots)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expValues, values)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
@@ -113,6 +113,8 @@ func (s *TestSuite) buildEthereumTx() (*evmtypes.MsgEthereumTx, []byte) {
 		Amount:   big.NewInt(0),
 		GasLimit: 100000,
 		GasPrice: big.NewInt(1),
+		// the input of a decoded tx is empty instead of nil
+		Input: []byte{},
 	}
 	msgEthereumTx := evmtypes.NewTx(&ethTxParams)
 
@@ -137,6 +139,8 @@ func (s *TestSuite) buildEthereumTxWithChainID(eip155ChainID *big.Int) *evmtypes
 		Amount:   big.NewInt(0),
 		GasLimit: 100000,
 		GasPrice: big.NewInt(1),
+		// the input of a decoded tx is empty instead of nil
+		Input: []byte{},
 	}
 	msgEthereumTx := evmtypes.NewTx(&ethTxParams)
 
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/base64"
 	"fmt"
 	"math/big"
 
@@ -943,6 +944,7 @@ func (s *TestSuite) TestGetEthBlockFromTendermint() {
 			func(_ math.Int, validator sdk.AccAddress, height int64) {
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(QueryClient)
+				RegisterBaseFeeAtError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), height)
 				RegisterValidatorAccount(QueryClient, validator)
 
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
@@ -1165,8 +1167,8 @@ func (s *TestSuite) TestEthMsgsFromTendermintBlock() {
 			&cmtrpctypes.ResultBlockResults{
 				TxsResults: []*types.ExecTxResult{
 					{
-						Code: 1,
-						Log:  ethrpc.ExceedBlockGasLimitError,
+						Codespace: evmtypes.ModuleName,
+						Code:      evmtypes.ErrBlockGasLimitExceeded.ABCICode(),
 					},
 				},
 			},
@@ -1181,7 +1183,6 @@ func (s *TestSuite) TestEthMsgsFromTendermintBlock() {
 				TxsResults: []*types.ExecTxResult{
 					{
 						Code: 0,
-						Log:  ethrpc.ExceedBlockGasLimitError,
 					},
 				},
 			},
@@ -1193,7 +1194,10 @@ func (s *TestSuite) TestEthMsgsFromTendermintBlock() {
 			s.SetupTest() // reset test and queries
 
 			msgs := s.backend.EthMsgsFromTendermintBlock(tc.resBlock, tc.blockRes)
-			s.Require().Equal(tc.expMsgs, msgs)
+			s.Require().Len(msgs, len(tc.expMsgs))
+			for i, msg := range msgs {
+				s.Require().True(protoEqual(tc.expMsgs[i], msg))
+			}
 		})
 	}
 }
@@ -1258,6 +1262,7 @@ func (s *TestSuite) TestHeaderByNumber() {
 				s.Require().NoError(err)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(QueryClient)
+				RegisterBaseFeeAtError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), height)
 			},
 			true,
 		},
@@ -1369,6 +1374,7 @@ func (s *TestSuite) TestHeaderByHash() {
 				s.Require().NoError(err)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(QueryClient)
+				RegisterBaseFeeAtError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), height)
 			},
 			true,
 		},
@@ -1630,3 +1636,107 @@ func (s *TestSuite) TestEthBlockFromTendermintBlock() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetInternalTransactions() {
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+	topic := common.BytesToHash([]byte("topic"))
+	txLogs := evmtypes.TransactionLogs{Logs: []*evmtypes.Log{{Address: to.Hex(), Topics: []string{topic.Hex()}, Data: []byte{0x1}}}}
+	txLogsBz, err := txLogs.Marshal()
+	s.Require().NoError(err)
+	internalTxEvent := types.Event{
+		Type: evmtypes.EventTypeInternalTx,
+		Attributes: []types.EventAttribute{
+			{Key: evmtypes.AttributeKeySender, Value: from.Hex()},
+			{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+			{Key: evmtypes.AttributeKeyRecipient, Value: to.Hex()},
+			{Key: evmtypes.AttributeKeyTxLogs, Value: base64.StdEncoding.EncodeToString(txLogsBz)},
+		},
+	}
+	txBz := []byte("cosmos tx")
+
+	var resBlock *cmtrpctypes.ResultBlock
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    func() []*ethrpc.InternalTransaction
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - block results not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+				RegisterBlockResultsError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - block without internal txs",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+			},
+			func() []*ethrpc.InternalTransaction { return []*ethrpc.InternalTransaction{} },
+			true,
+		},
+		{
+			"pass - block with an internal tx",
+			func() {
+				var err error
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				resBlock, err = RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResultsWithEvents(client, 1, []types.Event{internalTxEvent})
+				s.Require().NoError(err)
+			},
+			func() []*ethrpc.InternalTransaction {
+				blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+				return []*ethrpc.InternalTransaction{{
+					CosmosTxHash: cmttypes.Tx(txBz).Hash(),
+					BlockHash:    blockHash,
+					BlockNumber:  1,
+					From:         from,
+					To:           &to,
+					GasUsed:      21000,
+					Logs: []*ethtypes.Log{{
+						Address:     to,
+						Topics:      []common.Hash{topic},
+						Data:        []byte{0x1},
+						BlockNumber: 1,
+						BlockHash:   blockHash,
+					}},
+				}}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			internalTxs, err := s.backend.GetInternalTransactions(ethrpc.BlockNumber(1))
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult(), internalTxs)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
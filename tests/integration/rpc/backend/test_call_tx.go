@@ -76,6 +76,7 @@ func (s *TestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFeeDisabled(QueryClient)
+				RegisterBaseFeeAtError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:   &txNonce,
@@ -121,6 +122,7 @@ func (s *TestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFeeDisabled(QueryClient)
+				RegisterBaseFeeAtError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -4,19 +4,19 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"google.golang.org/grpc/metadata"
 
-	"github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	rpc "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -39,80 +39,20 @@ func (s *TestSuite) TestBaseFee() {
 			func() {
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(QueryClient)
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterBaseFeeAtError(feeMarketClient, 1)
 			},
 			nil,
 			false,
 		},
 		{
-			"fail - grpc BaseFee error - with non feemarket block event",
-			&tmrpctypes.ResultBlockResults{
-				Height: 1,
-				FinalizeBlockEvents: []types.Event{
-					{
-						Type: evmtypes.EventTypeBlockBloom,
-					},
-				},
-			},
-			func() {
-				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
-				RegisterBaseFeeError(QueryClient)
-			},
-			nil,
-			false,
-		},
-		{
-			"fail - grpc BaseFee error - with feemarket block event",
-			&tmrpctypes.ResultBlockResults{
-				Height: 1,
-				FinalizeBlockEvents: []types.Event{
-					{
-						Type: evmtypes.EventTypeFeeMarket,
-					},
-				},
-			},
-			func() {
-				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
-				RegisterBaseFeeError(QueryClient)
-			},
-			nil,
-			false,
-		},
-		{
-			"fail - grpc BaseFee error - with feemarket block event with wrong attribute value",
-			&tmrpctypes.ResultBlockResults{
-				Height: 1,
-				FinalizeBlockEvents: []types.Event{
-					{
-						Type: evmtypes.EventTypeFeeMarket,
-						Attributes: []types.EventAttribute{
-							{Value: "/1"},
-						},
-					},
-				},
-			},
-			func() {
-				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
-				RegisterBaseFeeError(QueryClient)
-			},
-			nil,
-			false,
-		},
-		{
-			"fail - grpc baseFee error - with feemarket block event with baseFee attribute value",
-			&tmrpctypes.ResultBlockResults{
-				Height: 1,
-				FinalizeBlockEvents: []types.Event{
-					{
-						Type: evmtypes.EventTypeFeeMarket,
-						Attributes: []types.EventAttribute{
-							{Value: baseFee.String()},
-						},
-					},
-				},
-			},
+			"pass - grpc BaseFee error - base fee from the base fee history",
+			&tmrpctypes.ResultBlockResults{Height: 1},
 			func() {
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeError(QueryClient)
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterBaseFeeAt(feeMarketClient, 1, sdkmath.LegacyNewDecFromInt(baseFee))
 			},
 			baseFee.BigInt(),
 			true,
@@ -123,6 +63,8 @@ func (s *TestSuite) TestBaseFee() {
 			func() {
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFeeDisabled(QueryClient)
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterBaseFeeAtError(feeMarketClient, 1)
 			},
 			nil,
 			true,
@@ -230,6 +172,18 @@ func (s *TestSuite) TestGetCoinbase() {
 			validatorAcc,
 			true,
 		},
+		{
+			"pass - Gets configured validator coinbase",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterStatus(client)
+				s.backend.ValidatorCoinbases = map[string]common.Address{
+					string(sdk.ConsAddress(nil)): common.BytesToAddress(validatorAcc),
+				}
+			},
+			validatorAcc,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -433,6 +387,7 @@ func (s *TestSuite) TestFeeHistory() {
 				RegisterConsensusParams(client, 1)
 				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterFeeMarketParams(fQueryClient, 1)
+				RegisterBaseFeeAtError(fQueryClient, 1)
 			},
 			1,
 			1,
@@ -605,3 +560,87 @@ func (s *TestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (s *TestSuite) TestPoolStats() {
+	_, bz := s.buildEthereumTx()
+	baseFee := sdkmath.NewInt(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		floor        int64
+		expStats     *rpc.PoolStats
+		expPass      bool
+	}{
+		{
+			"fail - pending transactions error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			0,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() {
+				var header metadata.MD
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterUnconfirmedTxs(client, nil, nil)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+				RegisterBaseFee(queryClient, baseFee)
+			},
+			0,
+			&rpc.PoolStats{BaseFee: (*hexutil.Big)(baseFee.BigInt())},
+			true,
+		},
+		{
+			"pass - priority clamped to the floor",
+			func() {
+				var header metadata.MD
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterUnconfirmedTxs(client, nil, cmttypes.Txs{bz})
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+				RegisterBaseFee(queryClient, baseFee)
+			},
+			5,
+			&rpc.PoolStats{
+				Pending:        1,
+				BaseFee:        (*hexutil.Big)(baseFee.BigInt()),
+				MinPriority:    5,
+				MedianPriority: 5,
+				MaxPriority:    5,
+				PriorityFloor:  5,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			s.backend.Cfg.EVM.PriorityFloor = tc.floor
+			tc.registerMock()
+
+			stats, err := s.backend.PoolStats()
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expStats, stats)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
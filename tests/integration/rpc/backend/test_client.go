@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -19,9 +20,11 @@ import (
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	rpc "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -50,6 +53,17 @@ func RegisterTxSearchError(client *mocks.Client, query string) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Tx
+func RegisterTx(client *mocks.Client, hash []byte, txBz []byte) {
+	client.On("Tx", rpc.ContextWithHeight(1), hash, false).
+		Return(&cmtrpctypes.ResultTx{Hash: hash, Height: 1, Tx: txBz}, nil)
+}
+
+func RegisterTxError(client *mocks.Client, hash []byte) {
+	client.On("Tx", rpc.ContextWithHeight(1), hash, false).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Broadcast Tx
 func RegisterBroadcastTx(client *mocks.Client, tx types.Tx) {
 	client.On("BroadcastTxSync", context.Background(), tx).
@@ -90,6 +104,21 @@ func RegisterStatusError(client *mocks.Client) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// DumpConsensusState
+func RegisterDumpConsensusState(client *mocks.Client, peerHeights ...int64) {
+	peers := make([]cmtrpctypes.PeerStateInfo, len(peerHeights))
+	for i, height := range peerHeights {
+		peers[i].PeerState = []byte(fmt.Sprintf(`{"round_state":{"height":"%d"}}`, height))
+	}
+	client.On("DumpConsensusState", rpc.ContextWithHeight(1)).
+		Return(&cmtrpctypes.ResultDumpConsensusState{Peers: peers}, nil)
+}
+
+func RegisterDumpConsensusStateError(client *mocks.Client) {
+	client.On("DumpConsensusState", rpc.ContextWithHeight(1)).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Block
 func RegisterBlockMultipleTxs(
 	client *mocks.Client,
@@ -209,6 +238,33 @@ func RegisterBlockResultsWithEventLog(client *mocks.Client, height int64) (*cmtr
 	return res, nil
 }
 
+func RegisterBlockResultsWithTxResponse(client *mocks.Client, height int64, logs []*evmtypes.Log) (*cmtrpctypes.ResultBlockResults, error) {
+	data, err := proto.Marshal(&sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{Logs: logs})},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &cmtrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: []*abci.ExecTxResult{{Code: 0, GasUsed: 0, Data: data}},
+	}
+	client.On("BlockResults", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(res, nil)
+	return res, nil
+}
+
+func RegisterBlockResultsWithEvents(client *mocks.Client, height int64, events []abci.Event) (*cmtrpctypes.ResultBlockResults, error) {
+	res := &cmtrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: []*abci.ExecTxResult{{Code: 0, GasUsed: 0, Events: events}},
+	}
+	client.On("BlockResults", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(res, nil)
+	return res, nil
+}
+
 func RegisterBlockResults(
 	client *mocks.Client,
 	height int64,
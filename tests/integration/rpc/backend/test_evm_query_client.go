@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -35,30 +36,48 @@ import (
 // To use a mock method it has to be registered in a given test.
 var _ evmtypes.QueryClient = &mocks.EVMQueryClient{}
 
+// matchRequest matches the requests by their proto encoding, as the txs
+// decoded from equal msgs carry different caches and don't compare equal
+func matchRequest[T interface{ Marshal() ([]byte, error) }](exp T) interface{} {
+	return mock.MatchedBy(func(req T) bool {
+		return protoEqual(exp, req)
+	})
+}
+
+// protoEqual returns true if the proto encodings of a and b are equal
+func protoEqual[T interface{ Marshal() ([]byte, error) }](a, b T) bool {
+	aBz, err := a.Marshal()
+	if err != nil {
+		return false
+	}
+	bBz, err := b.Marshal()
+	return err == nil && bytes.Equal(aBz, bBz)
+}
+
 // TraceTransaction
 func RegisterTraceTransactionWithPredecessors(queryClient *mocks.EVMQueryClient, msgEthTx *evmtypes.MsgEthereumTx, predecessors []*evmtypes.MsgEthereumTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
 	queryClient.On("TraceTx", rpc.ContextWithHeight(1),
-		&evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, Predecessors: predecessors, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1}). //nolint:gosec // G115
+		matchRequest(&evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, Predecessors: predecessors, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1})). //nolint:gosec // G115
 		Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
 }
 
 func RegisterTraceTransaction(queryClient *mocks.EVMQueryClient, msgEthTx *evmtypes.MsgEthereumTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
-	queryClient.On("TraceTx", rpc.ContextWithHeight(1), &evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1}). //nolint:gosec // G115
-																									Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
+	queryClient.On("TraceTx", rpc.ContextWithHeight(1), matchRequest(&evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1})). //nolint:gosec // G115
+																										Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
 }
 
 func RegisterTraceTransactionError(queryClient *mocks.EVMQueryClient, msgEthTx *evmtypes.MsgEthereumTx) {
-	queryClient.On("TraceTx", rpc.ContextWithHeight(1), &evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: int64(constants.ExampleChainID.EVMChainID)}). //nolint:gosec // G115
-																						Return(nil, errortypes.ErrInvalidRequest)
+	queryClient.On("TraceTx", rpc.ContextWithHeight(1), matchRequest(&evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: int64(constants.ExampleChainID.EVMChainID)})). //nolint:gosec // G115
+																								Return(nil, errortypes.ErrInvalidRequest)
 }
 
 // TraceBlock
 func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
 	queryClient.On("TraceBlock", rpc.ContextWithHeight(1),
-		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, TraceConfig: &evmtypes.TraceConfig{}, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1}). //nolint:gosec // G115
+		matchRequest(&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, TraceConfig: &evmtypes.TraceConfig{}, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1})). //nolint:gosec // G115
 		Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
 }
 
@@ -67,6 +86,13 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// StorageRange
+func RegisterStorageRange(queryClient *mocks.EVMQueryClient, addr common.Address, predecessors []*evmtypes.MsgEthereumTx, res *evmtypes.QueryStorageRangeResponse) {
+	queryClient.On("StorageRange", rpc.ContextWithHeight(1),
+		matchRequest(&evmtypes.QueryStorageRangeRequest{Address: addr.Hex(), KeyStart: common.Hash{}.Hex(), MaxResult: 2, Predecessors: predecessors, BlockNumber: 1, ChainId: int64(constants.ExampleChainID.EVMChainID), BlockMaxGas: -1})). //nolint:gosec // G115
+		Return(res, nil)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -247,6 +273,16 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageSlots(queryClient *mocks.EVMQueryClient, height int64, slots []*evmtypes.QueryStorageRequest, values []string) {
+	queryClient.On("StorageSlots", rpc.ContextWithHeight(height), &evmtypes.QueryStorageSlotsRequest{Slots: slots}).
+		Return(&evmtypes.QueryStorageSlotsResponse{Values: values}, nil)
+}
+
+func RegisterStorageSlotsError(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("StorageSlots", rpc.ContextWithHeight(height), mock.Anything).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
@@ -5,6 +5,8 @@ import (
 	rpc "github.com/cosmos/evm/rpc/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 
+	"cosmossdk.io/math"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -20,3 +22,15 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// BaseFeeAt
+func RegisterBaseFeeAt(feeMarketClient *mocks.FeeMarketQueryClient, height int64, baseFee math.LegacyDec) {
+	feeMarketClient.On("BaseFeeAt", rpc.ContextWithHeight(1), &feemarkettypes.QueryBaseFeeAtRequest{Height: height}).
+		Return(&feemarkettypes.QueryBaseFeeAtResponse{BaseFee: baseFee}, nil)
+}
+
+// Base fee not in the base fee history
+func RegisterBaseFeeAtError(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
+	feeMarketClient.On("BaseFeeAt", rpc.ContextWithHeight(1), &feemarkettypes.QueryBaseFeeAtRequest{Height: height}).
+		Return(nil, sdkerrors.ErrNotFound)
+}
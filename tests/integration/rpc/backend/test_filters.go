@@ -2,13 +2,17 @@ package backend
 
 import (
 	"encoding/json"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/rpc/backend/mocks"
+	"github.com/cosmos/evm/rpc/contractmetadata"
 	ethrpc "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
@@ -23,6 +27,13 @@ func (s *TestSuite) TestGetLogs() {
 
 	logs = append(logs, &log)
 
+	txLogs := []*evmtypes.Log{{
+		Address:     common.HexToAddress("0x1000000000000000000000000000000000000000").Hex(),
+		Topics:      []string{common.BytesToHash([]byte("topic")).Hex()},
+		Data:        []byte{1, 2, 3},
+		BlockNumber: 1,
+	}}
+
 	testCases := []struct {
 		name         string
 		registerMock func(hash common.Hash)
@@ -75,6 +86,19 @@ func (s *TestSuite) TestGetLogs() {
 			[][]*ethtypes.Log{evmtypes.LogsToEthereum(logs)},
 			true,
 		},
+		{
+			"success - getting logs from the tx response",
+			func(hash common.Hash) {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockByHash(client, hash, bz)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResultsWithTxResponse(client, ethrpc.BlockNumber(1).Int64(), txLogs)
+				s.Require().NoError(err)
+			},
+			common.BytesToHash(block.Hash()),
+			[][]*ethtypes.Log{evmtypes.LogsToEthereum(txLogs)},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -122,3 +146,77 @@ func (s *TestSuite) TestBloomStatus() {
 		})
 	}
 }
+
+func (s *TestSuite) TestDecodeLogs() {
+	token := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	from := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	to := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	transferLog := &ethtypes.Log{
+		Address:     token,
+		Topics:      []common.Hash{crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:        common.BigToHash(big.NewInt(1000)).Bytes(),
+		BlockNumber: 1,
+	}
+	unknownLog := &ethtypes.Log{Address: token, Topics: []common.Hash{crypto.Keccak256Hash([]byte("Ping()"))}, BlockNumber: 1}
+	anonymousLog := &ethtypes.Log{Address: token, Data: []byte{1}, BlockNumber: 1}
+
+	// the metadata of the token contract, naming the params of its Transfer event
+	code := []byte{0x60, 0x80}
+	metadata := &ethrpc.ContractMetadata{
+		CodeHash: crypto.Keccak256Hash(code),
+		Metadata: `{"output":{"abi":[{"anonymous":false,"inputs":[` +
+			`{"indexed":true,"name":"src","type":"address"},{"indexed":true,"name":"dst","type":"address"},` +
+			`{"indexed":false,"name":"wad","type":"uint256"}],"name":"Transfer","type":"event"}]}}`,
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expNames     []string
+	}{
+		{
+			"pass - decoded with the event signatures",
+			func() {},
+			[]string{"from", "to", "value"},
+		},
+		{
+			"pass - decoded with the ABI of the contract metadata",
+			func() {
+				s.backend.ContractMetadata = contractmetadata.NewStore(dbm.NewMemDB())
+				s.Require().NoError(s.backend.ContractMetadata.Set(metadata))
+				RegisterCode(s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient), token, code)
+			},
+			[]string{"src", "dst", "wad"},
+		},
+		{
+			"pass - decoded with the event signatures without the contract metadata",
+			func() {
+				s.backend.ContractMetadata = contractmetadata.NewStore(dbm.NewMemDB())
+				RegisterCode(s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient), token, code)
+			},
+			[]string{"from", "to", "value"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			tc.registerMock()
+			logs := s.backend.DecodeLogs([]*ethtypes.Log{transferLog, unknownLog, anonymousLog})
+
+			s.Require().Len(logs, 3)
+			s.Require().Equal(transferLog, logs[0].Log)
+			s.Require().Equal("Transfer", logs[0].Decoded.Name)
+			s.Require().Len(logs[0].Decoded.Params, 3)
+			for i, name := range tc.expNames {
+				s.Require().Equal(name, logs[0].Decoded.Params[i].Name)
+			}
+			s.Require().Equal(from.Hex(), logs[0].Decoded.Params[0].Value)
+			s.Require().Equal(to.Hex(), logs[0].Decoded.Params[1].Value)
+			s.Require().Equal("1000", logs[0].Decoded.Params[2].Value)
+			s.Require().Nil(logs[1].Decoded)
+			s.Require().Nil(logs[2].Decoded)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package backend
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/log"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *TestSuite) TestGetNFTsByOwner() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+	token := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	mintLog := &ethtypes.Log{
+		Address: token,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			{},
+			common.BytesToHash(owner.Bytes()),
+			common.BigToHash(big.NewInt(5)),
+		},
+	}
+	data, err := proto.Marshal(&sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{
+			Logs: evmtypes.NewLogsFromEth([]*ethtypes.Log{mintLog}),
+		})},
+	})
+	s.Require().NoError(err)
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Data: data,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+	uri := "ipfs://token/5"
+	ret, err := rpctypes.NFTMetadataABI.Methods["tokenURI"].Outputs.Pack(uri)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		args         rpctypes.NFTsByOwnerArgs
+		expResult    *rpctypes.NFTsByOwnerResult
+		expPass      bool
+	}{
+		{
+			"pass - NFTs of the owner with their metadata",
+			func() {
+				var header metadata.MD
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(QueryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				QueryClient.On("EthCall", mock.Anything, mock.Anything).Return(&evmtypes.MsgEthereumTxResponse{Ret: ret}, nil)
+			},
+			rpctypes.NFTsByOwnerArgs{Owner: owner},
+			&rpctypes.NFTsByOwnerResult{NFTs: []rpctypes.NFT{{
+				Standard: cosmosevmtypes.TokenStandardERC721,
+				Token:    token,
+				TokenID:  (*hexutil.Big)(big.NewInt(5)),
+				Balance:  (*hexutil.Big)(big.NewInt(1)),
+				TokenURI: &uri,
+			}}},
+			true,
+		},
+		{
+			"pass - NFTs of the owner without the metadata extension",
+			func() {
+				var header metadata.MD
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(QueryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				QueryClient.On("EthCall", mock.Anything, mock.Anything).Return(nil, errortypes.ErrInvalidRequest)
+			},
+			rpctypes.NFTsByOwnerArgs{Owner: owner},
+			&rpctypes.NFTsByOwnerResult{NFTs: []rpctypes.NFT{{
+				Standard: cosmosevmtypes.TokenStandardERC721,
+				Token:    token,
+				TokenID:  (*hexutil.Big)(big.NewInt(5)),
+				Balance:  (*hexutil.Big)(big.NewInt(1)),
+			}}},
+			true,
+		},
+		{
+			"pass - no NFTs of the address",
+			func() {},
+			rpctypes.NFTsByOwnerArgs{Owner: token},
+			&rpctypes.NFTsByOwnerResult{NFTs: []rpctypes.NFT{}},
+			true,
+		},
+		{
+			"fail - invalid cursor",
+			func() {},
+			rpctypes.NFTsByOwnerArgs{Owner: owner, Cursor: []byte{1}},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			tc.registerMock()
+			s.backend.Indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx)
+			s.Require().NoError(s.backend.Indexer.IndexBlock(block, responseDeliver))
+
+			res, err := s.backend.GetNFTsByOwner(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, res)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
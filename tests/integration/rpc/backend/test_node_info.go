@@ -198,10 +198,34 @@ func (s *TestSuite) TestSyncing() {
 				RegisterStatus(client)
 				status, _ := client.Status(s.backend.Ctx)
 				status.SyncInfo.CatchingUp = true
+				status.SyncInfo.EarliestBlockHeight = 1
+				status.SyncInfo.LatestBlockHeight = 5
+				RegisterDumpConsensusState(client, 8, 11)
 			},
 			map[string]interface{}{
-				"startingBlock": hexutil.Uint64(0),
-				"currentBlock":  hexutil.Uint64(0),
+				"startingBlock":  hexutil.Uint64(1),
+				"currentBlock":   hexutil.Uint64(5),
+				"highestBlock":   hexutil.Uint64(10),
+				"safeBlock":      hexutil.Uint64(5),
+				"finalizedBlock": hexutil.Uint64(5),
+			},
+			true,
+		},
+		{
+			"pass - Node is state syncing and the peers are unknown",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterStatus(client)
+				status, _ := client.Status(s.backend.Ctx)
+				status.SyncInfo.CatchingUp = true
+				RegisterDumpConsensusStateError(client)
+			},
+			map[string]interface{}{
+				"startingBlock":  hexutil.Uint64(0),
+				"currentBlock":   hexutil.Uint64(0),
+				"highestBlock":   hexutil.Uint64(0),
+				"safeBlock":      hexutil.Uint64(0),
+				"finalizedBlock": hexutil.Uint64(0),
 			},
 			true,
 		},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	goethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -218,7 +219,39 @@ func (s *TestSuite) TestSignTypedData() {
 			apitypes.TypedData{},
 			false,
 		},
-		// TODO: Generate a TypedData msg
+		{
+			"fail - domain without chain id",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := s.backend.ClientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				s.Require().NoError(err)
+			},
+			from,
+			mailTypedData(nil),
+			false,
+		},
+		{
+			"fail - domain of another chain",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := s.backend.ClientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				s.Require().NoError(err)
+			},
+			from,
+			mailTypedData(gethmath.NewHexOrDecimal256(1)),
+			false,
+		},
+		{
+			"pass - sign typed data of this chain",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := s.backend.ClientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				s.Require().NoError(err)
+			},
+			from,
+			mailTypedData((*gethmath.HexOrDecimal256)(s.backend.EvmChainID)),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -241,6 +274,176 @@ func (s *TestSuite) TestSignTypedData() {
 	}
 }
 
+func (s *TestSuite) TestUnlockAccount() {
+	from, priv := utiltx.NewAddrKey()
+	zero := uint64(0)
+	testCases := []struct {
+		name         string
+		registerMock func()
+		duration     *uint64
+		expPass      bool
+	}{
+		{
+			"fail - can't find key in Keyring",
+			func() {},
+			nil,
+			false,
+		},
+		{
+			"fail - insecure unlock not allowed",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := s.backend.ClientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				s.Require().NoError(err)
+				s.backend.Cfg.JSONRPC.AllowInsecureUnlock = false
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - unlock for the default duration",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := s.backend.ClientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				s.Require().NoError(err)
+			},
+			nil,
+			true,
+		},
+		{
+			"pass - unlock until locked",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := s.backend.ClientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				s.Require().NoError(err)
+			},
+			&zero,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			s.backend.Cfg.JSONRPC.RequireUnlock = true
+			tc.registerMock()
+
+			ok, err := s.backend.UnlockAccount(from, tc.duration)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().True(ok)
+				_, err = s.backend.Sign(from, nil)
+				s.Require().NoError(err)
+				s.Require().True(s.backend.LockAccount(from))
+			} else {
+				s.Require().Error(err)
+				s.Require().False(ok)
+			}
+
+			_, err = s.backend.Sign(from, nil)
+			s.Require().Error(err)
+			s.Require().False(s.backend.LockAccount(from))
+		})
+	}
+}
+
+func (s *TestSuite) TestSignTransaction() {
+	gasPrice := new(hexutil.Big)
+	gas := hexutil.Uint64(1)
+	toAddr := utiltx.GenerateAddress()
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	nonce := hexutil.Uint64(1)
+	baseFee := math.NewInt(1)
+	callArgsDefault := evmtypes.TransactionArgs{
+		From:     &from,
+		To:       &toAddr,
+		GasPrice: gasPrice,
+		Gas:      &gas,
+		Nonce:    &nonce,
+	}
+
+	testCases := []struct {
+		name          string
+		registerMock  func()
+		requireUnlock bool
+		expPass       bool
+	}{
+		{
+			"fail - can't find key in Keyring",
+			func() {},
+			false,
+			false,
+		},
+		{
+			"fail - account is locked",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := s.backend.ClientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				s.Require().NoError(err)
+			},
+			true,
+			false,
+		},
+		{
+			"pass - sign the transaction",
+			func() {
+				broadcastTx(s, priv, baseFee, callArgsDefault)
+			},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			s.backend.Cfg.JSONRPC.RequireUnlock = tc.requireUnlock
+			tc.registerMock()
+
+			res, err := s.backend.SignTransaction(callArgsDefault)
+			if tc.expPass {
+				s.Require().NoError(err)
+				tx := new(ethtypes.Transaction)
+				s.Require().NoError(tx.UnmarshalBinary(res.Raw))
+				s.Require().Equal(tx.Hash(), res.Tx.Hash())
+				sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+				s.Require().NoError(err)
+				s.Require().Equal(from, sender)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+// mailTypedData returns the EIP-712 example typed data with the given domain chain id.
+func mailTypedData(chainID *gethmath.HexOrDecimal256) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "address"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Ether Mail",
+			ChainId:           chainID,
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":     "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			"contents": "Hello, Bob!",
+		},
+	}
+}
+
 func broadcastTx(suite *TestSuite, priv *ethsecp256k1.PrivKey, baseFee math.Int, callArgsDefault evmtypes.TransactionArgs) (client *mocks.Client, txBytes []byte) {
 	var header metadata.MD
 	QueryClient := suite.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
@@ -1,10 +1,16 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/metadata"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -14,6 +20,7 @@ import (
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/log"
@@ -233,6 +240,36 @@ func (s *TestSuite) TestTraceBlock() {
 			&evmtypes.TraceConfig{},
 			true,
 		},
+		{
+			"fail - timeout exceeds the cap",
+			func() {
+				s.backend.Cfg.JSONRPC.TraceTimeoutCap = time.Second
+			},
+			[]*evmtypes.TxTraceResult{},
+			&resBlockEmpty,
+			&evmtypes.TraceConfig{Timeout: "1m"},
+			false,
+		},
+		{
+			"fail - javascript tracer exceeds the size cap",
+			func() {
+				s.backend.Cfg.JSONRPC.TracerSizeCap = 16
+			},
+			[]*evmtypes.TxTraceResult{},
+			&resBlockEmpty,
+			&evmtypes.TraceConfig{Tracer: "{step: function() {}, fault: function() {}, result: function() {}}"},
+			false,
+		},
+		{
+			"pass - native tracer is not subject to the size cap",
+			func() {
+				s.backend.Cfg.JSONRPC.TracerSizeCap = 1
+			},
+			[]*evmtypes.TxTraceResult{},
+			&resBlockEmpty,
+			&evmtypes.TraceConfig{Tracer: "callTracer"},
+			true,
+		},
 		{
 			"fail - cannot unmarshal data",
 			func() {
@@ -264,3 +301,315 @@ func (s *TestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetModifiedAccounts() {
+	msgEthTx, _ := s.buildEthereumTx()
+	bz := s.signAndEncodeEthTx(msgEthTx)
+	account1 := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	account2 := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	txHash := common.HexToHash(msgEthTx.Hash)
+
+	modifiedAccountsEvent, err := evmtypes.NewModifiedAccountsEvent(txHash, []common.Address{account2, account1})
+	s.Require().NoError(err)
+	block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{bz}}}
+	blockResults := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+				abci.Event(modifiedAccountsEvent),
+			},
+		},
+	}
+
+	zero, one, two := uint64(0), uint64(1), uint64(2)
+	registerLatestBlock := func() {
+		var header metadata.MD
+		QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+		RegisterParams(QueryClient, &header, 1)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		getAccounts  func() ([]common.Address, error)
+		expAccounts  []common.Address
+		expPass      bool
+	}{
+		{
+			"pass - accounts modified in a block",
+			registerLatestBlock,
+			func() ([]common.Address, error) { return s.backend.GetModifiedAccountsByNumber(one, nil) },
+			[]common.Address{account1, account2},
+			true,
+		},
+		{
+			"pass - accounts modified in a block range",
+			registerLatestBlock,
+			func() ([]common.Address, error) { return s.backend.GetModifiedAccountsByNumber(zero, &one) },
+			[]common.Address{account1, account2},
+			true,
+		},
+		{
+			"pass - accounts modified in a block by hash",
+			func() {
+				registerLatestBlock()
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				s.Require().NoError(err)
+			},
+			func() ([]common.Address, error) { return s.backend.GetModifiedAccountsByHash(common.Hash{}, nil) },
+			[]common.Address{account1, account2},
+			true,
+		},
+		{
+			"fail - genesis block has no parent",
+			func() {},
+			func() ([]common.Address, error) { return s.backend.GetModifiedAccountsByNumber(zero, nil) },
+			nil,
+			false,
+		},
+		{
+			"fail - start block is not lower than end block",
+			func() {},
+			func() ([]common.Address, error) { return s.backend.GetModifiedAccountsByNumber(one, &one) },
+			nil,
+			false,
+		},
+		{
+			"fail - end block is greater than the latest block",
+			registerLatestBlock,
+			func() ([]common.Address, error) { return s.backend.GetModifiedAccountsByNumber(one, &two) },
+			nil,
+			false,
+		},
+		{
+			"fail - indexer is disabled",
+			func() {
+				s.backend.Indexer = nil
+			},
+			func() ([]common.Address, error) { return s.backend.GetModifiedAccountsByNumber(one, nil) },
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			s.backend.Indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx)
+			s.Require().NoError(s.backend.Indexer.IndexBlock(block, blockResults))
+			tc.registerMock()
+
+			accounts, err := tc.getAccounts()
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expAccounts, accounts)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestStorageRangeAt() {
+	msgEthTx, bz := s.buildEthereumTx()
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	key1, key2, key3 := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2)), common.BigToHash(big.NewInt(3))
+	value := common.BigToHash(big.NewInt(100))
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		txIndex      int
+		keyStart     hexutil.Bytes
+		expResult    rpctypes.StorageRangeResult
+		expPass      bool
+	}{
+		{
+			"fail - key start longer than a hash",
+			func() {},
+			0,
+			make(hexutil.Bytes, common.HashLength+1),
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"fail - block not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockByHashError(client, common.Hash{}, bz)
+			},
+			0,
+			nil,
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"fail - tx index out of range",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+			},
+			2,
+			nil,
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"pass - storage after the predecessors",
+			func() {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterStorageRange(QueryClient, addr, []*evmtypes.MsgEthereumTx{msgEthTx}, &evmtypes.QueryStorageRangeResponse{
+					Storage: evmtypes.Storage{evmtypes.NewState(key1, value), evmtypes.NewState(key2, value)},
+					NextKey: key3.Hex(),
+				})
+			},
+			1,
+			nil,
+			rpctypes.StorageRangeResult{
+				Storage: rpctypes.StorageMap{
+					ethcrypto.Keccak256Hash(key1.Bytes()): {Key: &key1, Value: value},
+					ethcrypto.Keccak256Hash(key2.Bytes()): {Key: &key2, Value: value},
+				},
+				NextKey: &key3,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := s.backend.StorageRangeAt(common.Hash{}, tc.txIndex, addr, tc.keyStart, 2)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, res)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestTraceFilter() {
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	created := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	callTrace := fmt.Sprintf(`{"action":{"callType":"call","from":"%s","to":"%s"},"type":"call"}`, sender.Hex(), contract.Hex())
+	createTrace := fmt.Sprintf(`{"action":{"from":"%s"},"result":{"address":"%s"},"type":"create"}`, contract.Hex(), created.Hex())
+	traces := "[" + callTrace + "," + createTrace + "]"
+
+	block1, block5 := rpctypes.BlockNumber(1), rpctypes.BlockNumber(5)
+	one := uint64(1)
+
+	testCases := []struct {
+		name      string
+		args      rpctypes.TraceFilterArgs
+		expTraces []string
+		expPass   bool
+	}{
+		{
+			"pass - all the traces of the latest block",
+			rpctypes.TraceFilterArgs{},
+			[]string{callTrace, createTrace},
+			true,
+		},
+		{
+			"pass - filter by sender",
+			rpctypes.TraceFilterArgs{FromBlock: &block1, ToBlock: &block1, FromAddress: []common.Address{contract}},
+			[]string{createTrace},
+			true,
+		},
+		{
+			"pass - filter by created contract",
+			rpctypes.TraceFilterArgs{ToAddress: []common.Address{created}},
+			[]string{createTrace},
+			true,
+		},
+		{
+			"pass - no matching traces",
+			rpctypes.TraceFilterArgs{FromAddress: []common.Address{created}},
+			[]string{},
+			true,
+		},
+		{
+			"pass - after and count",
+			rpctypes.TraceFilterArgs{After: &one, Count: &one},
+			[]string{createTrace},
+			true,
+		},
+		{
+			"fail - from block is greater than to block",
+			rpctypes.TraceFilterArgs{FromBlock: &block5, ToBlock: &block1},
+			nil,
+			false,
+		},
+		{
+			"fail - to block is greater than the latest block",
+			rpctypes.TraceFilterArgs{ToBlock: &block5},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			var header metadata.MD
+			QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterParams(QueryClient, &header, 1)
+			s.backend.Indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx)
+			s.Require().NoError(s.backend.Indexer.IndexCallTraces(1, json.RawMessage(traces)))
+
+			res, err := s.backend.TraceFilter(tc.args)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Len(res, len(tc.expTraces))
+				for i, trace := range tc.expTraces {
+					s.Require().JSONEq(trace, string(res[i]))
+				}
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestTracingDisabled() {
+	s.Require().True(s.backend.SetTracingEnabled(false))
+	defer s.backend.SetTracingEnabled(true)
+
+	_, err := s.backend.TraceTransaction(common.Hash{}, nil)
+	s.Require().ErrorContains(err, "tracing is disabled")
+	_, err = s.backend.TraceBlock(1, nil, &tmrpctypes.ResultBlock{})
+	s.Require().ErrorContains(err, "tracing is disabled")
+	_, err = s.backend.TraceCall(evmtypes.TransactionArgs{}, rpctypes.BlockNumberOrHash{}, nil)
+	s.Require().ErrorContains(err, "tracing is disabled")
+	_, err = s.backend.TraceFilter(rpctypes.TraceFilterArgs{})
+	s.Require().ErrorContains(err, "tracing is disabled")
+
+	// the tracing is shared by the backends of all the namespaces
+	s.Require().False(s.backend.SetTracingEnabled(true))
+	s.Require().True(s.backend.TracingEnabled())
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"google.golang.org/grpc/metadata"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -15,12 +17,17 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/rpc/backend/mocks"
+	"github.com/cosmos/evm/rpc/proof"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *TestSuite) TestGetTransactionByHash() {
@@ -86,6 +93,7 @@ func (s *TestSuite) TestGetTransactionByHash() {
 				_, err = RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFeeError(QueryClient)
+				RegisterBaseFeeAtError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1)
 			},
 			msgEthereumTx,
 			rpcTransaction,
@@ -130,6 +138,436 @@ func (s *TestSuite) TestGetTransactionByHash() {
 	}
 }
 
+func (s *TestSuite) TestGetCosmosTxByEthHash() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func(db dbm.DB)
+		txHash       common.Hash
+		expResult    *rpctypes.CosmosTxResult
+	}{
+		{
+			"pass - cosmos tx of an indexed eth tx",
+			func(dbm.DB) {},
+			txHash,
+			&rpctypes.CosmosTxResult{Hash: types.Tx(txBz).Hash(), BlockNumber: 1},
+		},
+		{
+			"pass - cosmos tx of an eth tx indexed without its hash",
+			func(db dbm.DB) {
+				s.Require().NoError(db.Delete(indexer.EthToCosmosHashKey(txHash)))
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+			},
+			txHash,
+			&rpctypes.CosmosTxResult{Hash: types.Tx(txBz).Hash(), BlockNumber: 1},
+		},
+		{
+			"pass - eth tx not found",
+			func(dbm.DB) {},
+			common.HexToHash("0x1"),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			db := dbm.NewMemDB()
+			s.backend.Indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), s.backend.ClientCtx)
+			err := s.backend.Indexer.IndexBlock(block, responseDeliver)
+			s.Require().NoError(err)
+			tc.registerMock(db)
+
+			res, err := s.backend.GetCosmosTxByEthHash(tc.txHash)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expResult, res)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetAddressTransactions() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+	sender := common.BytesToAddress(msgEthereumTx.From)
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		args      rpctypes.AddressTxsArgs
+		expResult *rpctypes.AddressTxsResult
+		expPass   bool
+	}{
+		{
+			"pass - txs of the sender",
+			rpctypes.AddressTxsArgs{Address: sender},
+			&rpctypes.AddressTxsResult{Transactions: []rpctypes.AddressTx{
+				{Hash: txHash, BlockNumber: 1, Kinds: []string{"sent"}},
+			}},
+			true,
+		},
+		{
+			"pass - txs received by the recipient",
+			rpctypes.AddressTxsArgs{Address: common.Address{}, Kinds: []string{"received"}},
+			&rpctypes.AddressTxsResult{Transactions: []rpctypes.AddressTx{
+				{Hash: txHash, BlockNumber: 1, Kinds: []string{"received"}},
+			}},
+			true,
+		},
+		{
+			"pass - no txs received by the sender",
+			rpctypes.AddressTxsArgs{Address: sender, Kinds: []string{"received", "internal"}},
+			&rpctypes.AddressTxsResult{Transactions: []rpctypes.AddressTx{}},
+			true,
+		},
+		{
+			"fail - unknown kind",
+			rpctypes.AddressTxsArgs{Address: sender, Kinds: []string{"created"}},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid cursor",
+			rpctypes.AddressTxsArgs{Address: sender, Cursor: []byte{1}},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			s.backend.Indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx)
+			s.Require().NoError(s.backend.Indexer.IndexBlock(block, responseDeliver))
+
+			res, err := s.backend.GetAddressTransactions(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResult, res)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGetTokenTransfers() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+	token := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	holder := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	recipient := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	transferLog := &ethtypes.Log{
+		Address: token,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			common.BytesToHash(holder.Bytes()),
+			common.BytesToHash(recipient.Bytes()),
+		},
+		Data: common.BigToHash(big.NewInt(1000)).Bytes(),
+	}
+	data, err := proto.Marshal(&sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{
+			Logs: evmtypes.NewLogsFromEth([]*ethtypes.Log{transferLog}),
+		})},
+	})
+	s.Require().NoError(err)
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Data: data,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+	transfer := &cosmosevmtypes.TokenTransfer{
+		Standard:        cosmosevmtypes.TokenStandardERC20,
+		Token:           token,
+		From:            holder,
+		To:              recipient,
+		Value:           (*hexutil.Big)(big.NewInt(1000)),
+		TransactionHash: txHash,
+		BlockNumber:     1,
+	}
+
+	block1, block5 := rpctypes.BlockNumber(1), rpctypes.BlockNumber(5)
+
+	testCases := []struct {
+		name         string
+		args         rpctypes.TokenTransfersArgs
+		expTransfers []*cosmosevmtypes.TokenTransfer
+		expPass      bool
+	}{
+		{
+			"pass - transfers of the sender in the latest block",
+			rpctypes.TokenTransfersArgs{Address: holder},
+			[]*cosmosevmtypes.TokenTransfer{transfer},
+			true,
+		},
+		{
+			"pass - transfers of the token in the block range",
+			rpctypes.TokenTransfersArgs{Address: token, FromBlock: &block1, ToBlock: &block1},
+			[]*cosmosevmtypes.TokenTransfer{transfer},
+			true,
+		},
+		{
+			"pass - no transfers of the address",
+			rpctypes.TokenTransfersArgs{Address: common.Address{}},
+			[]*cosmosevmtypes.TokenTransfer{},
+			true,
+		},
+		{
+			"fail - from block is greater than to block",
+			rpctypes.TokenTransfersArgs{Address: holder, FromBlock: &block5, ToBlock: &block1},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			var header metadata.MD
+			QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterParams(QueryClient, &header, 1)
+			s.backend.Indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx)
+			s.Require().NoError(s.backend.Indexer.IndexBlock(block, responseDeliver))
+
+			res, err := s.backend.GetTokenTransfers(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expTransfers, res)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGetTransactionProof() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+	block := types.MakeBlock(1, []types.Tx{txBz}, nil, nil)
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		txHash       common.Hash
+		expPass      bool
+		expProof     bool
+	}{
+		{
+			"pass - proof of an indexed eth tx",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+			},
+			txHash,
+			true,
+			true,
+		},
+		{
+			"pass - eth tx not found",
+			func() {},
+			common.HexToHash("0x1"),
+			true,
+			false,
+		},
+		{
+			"fail - block not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			txHash,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			s.backend.Indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx)
+			err := s.backend.Indexer.IndexBlock(block, responseDeliver)
+			s.Require().NoError(err)
+			tc.registerMock()
+
+			res, err := s.backend.GetTransactionProof(tc.txHash)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			if !tc.expProof {
+				s.Require().Nil(res)
+				return
+			}
+
+			s.Require().Equal(txHash, res.Hash)
+			s.Require().Equal(types.Tx(txBz).Hash(), []byte(res.CosmosTxHash))
+			s.Require().Equal(hexutil.Uint64(1), res.BlockNumber)
+
+			txDecoder := s.backend.ClientCtx.TxConfig.TxDecoder()
+			s.Require().NoError(proof.VerifyTransactionProof(res, block.DataHash, txDecoder))
+
+			// the proof doesn't verify against another block, nor for another eth tx
+			s.Require().Error(proof.VerifyTransactionProof(res, common.HexToHash("0x1").Bytes(), txDecoder))
+			res.Hash = common.HexToHash("0x1")
+			s.Require().Error(proof.VerifyTransactionProof(res, block.DataHash, txDecoder))
+		})
+	}
+}
+
+func (s *TestSuite) TestGetEthTxsByCosmosHash() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+	cosmosTxHash := types.Tx(txBz).Hash()
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	rpcTransaction, _ := rpctypes.NewRPCTransaction(msgEthereumTx, common.Hash{}, 0, 0, big.NewInt(1), s.backend.EvmChainID)
+	registerTxMocks := func() {
+		client := s.backend.ClientCtx.Client.(*mocks.Client)
+		QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+		_, err := RegisterBlock(client, 1, txBz)
+		s.Require().NoError(err)
+		_, err = RegisterBlockResults(client, 1)
+		s.Require().NoError(err)
+		RegisterBaseFee(QueryClient, math.NewInt(1))
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func(db dbm.DB)
+		hash         []byte
+		expRPCTxs    []*rpctypes.RPCTransaction
+	}{
+		{
+			"pass - eth txs of an indexed cosmos tx",
+			func(dbm.DB) {
+				registerTxMocks()
+			},
+			cosmosTxHash,
+			[]*rpctypes.RPCTransaction{rpcTransaction},
+		},
+		{
+			"pass - eth txs of a cosmos tx indexed without its hash",
+			func(db dbm.DB) {
+				s.Require().NoError(db.Delete(indexer.CosmosTxHashKey(cosmosTxHash, 0)))
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterTx(client, cosmosTxHash, txBz)
+				registerTxMocks()
+			},
+			cosmosTxHash,
+			[]*rpctypes.RPCTransaction{rpcTransaction},
+		},
+		{
+			"pass - cosmos tx not found",
+			func(dbm.DB) {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterTxError(client, []byte{0x1})
+			},
+			[]byte{0x1},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			db := dbm.NewMemDB()
+			s.backend.Indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), s.backend.ClientCtx)
+			err := s.backend.Indexer.IndexBlock(block, responseDeliver)
+			s.Require().NoError(err)
+			tc.registerMock(db)
+
+			rpcTxs, err := s.backend.GetEthTxsByCosmosHash(tc.hash)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expRPCTxs, rpcTxs)
+		})
+	}
+}
+
 func (s *TestSuite) TestGetTransactionsByHashPending() {
 	msgEthereumTx, bz := s.buildEthereumTx()
 	rpcTransaction, _ := rpctypes.NewRPCTransaction(msgEthereumTx, common.Hash{}, 0, 0, big.NewInt(1), s.backend.EvmChainID)
@@ -339,6 +777,7 @@ func (s *TestSuite) TestGetTransactionByBlockAndIndex() {
 				_, err := RegisterBlockResults(client, 1)
 				s.Require().NoError(err)
 				RegisterBaseFeeError(QueryClient)
+				RegisterBaseFeeAtError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient), 1)
 			},
 			&tmrpctypes.ResultBlock{Block: defaultBlock},
 			0,
@@ -563,10 +1002,7 @@ func (s *TestSuite) TestGetTransactionReceipt() {
 		{
 			"fail - Receipts do not match",
 			func() {
-				var header metadata.MD
-				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
-				RegisterParams(QueryClient, &header, 1)
 				_, err := RegisterBlock(client, 1, txBz)
 				s.Require().NoError(err)
 				_, err = RegisterBlockResults(client, 1)
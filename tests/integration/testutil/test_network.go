@@ -0,0 +1,80 @@
+//go:build test
+
+package testutil
+
+import (
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/evm/testutil/integration"
+	grpchandler "github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func (s *TestSuite) TestWithValidatorPowers() {
+	options := []network.ConfigOption{
+		network.WithValidatorPowers(1, 2, 3),
+	}
+	options = append(options, s.options...)
+	nw := network.New(s.create, options...)
+
+	// the validators are sorted by power, and bond the tokens of their power
+	validators := nw.GetValidators()
+	s.Require().Len(validators, 3)
+	bondedAmount := network.GetInitialBondedAmount(nw.GetBaseDecimal())
+	for i, power := range []int64{3, 2, 1} {
+		s.Equal(bondedAmount.MulRaw(power).String(), validators[i].Tokens.String())
+	}
+
+	// the proposer rotates in proportion to the validator powers
+	proposals := make(map[string]int)
+	for range 12 {
+		s.Require().NoError(nw.NextBlock())
+		proposals[string(nw.GetContext().BlockHeader().ProposerAddress)]++
+	}
+	s.Require().Len(proposals, 3)
+	counts := make(map[int]int)
+	for _, count := range proposals {
+		counts[count]++
+	}
+	s.Equal(map[int]int{2: 1, 4: 1, 6: 1}, counts)
+}
+
+func (s *TestSuite) TestWithConsensusParams() {
+	consensusParams := *integration.DefaultConsensusParams
+	consensusParams.Block = &tmproto.BlockParams{
+		MaxBytes: 200000,
+		MaxGas:   1_000_000,
+	}
+
+	options := []network.ConfigOption{
+		network.WithConsensusParams(&consensusParams),
+	}
+	options = append(options, s.options...)
+	nw := network.New(s.create, options...)
+
+	// the block gas limit is kept on the next blocks
+	for range 2 {
+		s.Equal(int64(1_000_000), nw.GetContext().ConsensusParams().Block.MaxGas)
+		s.Equal(uint64(1_000_000), nw.GetContext().BlockGasMeter().Limit())
+		s.Require().NoError(nw.NextBlockAfter(time.Second))
+	}
+}
+
+func (s *TestSuite) TestWithEVMParams() {
+	params := evmtypes.DefaultParams()
+	params.AllowUnprotectedTxs = true
+
+	options := []network.ConfigOption{
+		network.WithEVMParams(params),
+	}
+	options = append(options, s.options...)
+	nw := network.New(s.create, options...)
+	handler := grpchandler.NewIntegrationHandler(nw)
+
+	res, err := handler.GetEvmParams()
+	s.Require().NoError(err)
+	s.True(res.Params.AllowUnprotectedTxs)
+}
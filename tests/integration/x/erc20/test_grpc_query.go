@@ -137,6 +137,32 @@ func (s *KeeperTestSuite) TestTokenPair() {
 			},
 			true,
 		},
+		{
+			"token pair found - bech32 address",
+			func() {
+				addr := utiltx.GenerateAddress()
+				pair := types.NewTokenPair(addr, "coin", types.OWNER_MODULE)
+				s.network.App.GetErc20Keeper().SetToken(ctx, pair)
+				req = &types.QueryTokenPairRequest{
+					Token: sdk.AccAddress(addr.Bytes()).String(),
+				}
+				expRes = &types.QueryTokenPairResponse{TokenPair: pair}
+			},
+			true,
+		},
+		{
+			"token pair found - denom",
+			func() {
+				addr := utiltx.GenerateAddress()
+				pair := types.NewTokenPair(addr, "coin", types.OWNER_MODULE)
+				s.network.App.GetErc20Keeper().SetToken(ctx, pair)
+				req = &types.QueryTokenPairRequest{
+					Token: pair.Denom,
+				}
+				expRes = &types.QueryTokenPairResponse{TokenPair: pair}
+			},
+			true,
+		},
 		{
 			"token pair not found - with erc20 existent",
 			func() {
@@ -1,6 +1,8 @@
 package feemarket
 
 import (
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/x/feemarket/types"
 
@@ -98,6 +100,57 @@ func (s *KeeperTestSuite) TestQueryBaseFee() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryBaseFeeAt() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	testCases := []struct {
+		name       string
+		height     int64
+		expBaseFee sdkmath.LegacyDec
+		expPass    bool
+	}{
+		{
+			"pass - height in the base fee history",
+			100,
+			sdkmath.LegacyNewDec(1),
+			true,
+		},
+		{
+			"fail - height not in the base fee history",
+			101,
+			sdkmath.LegacyDec{},
+			false,
+		},
+		{
+			"fail - non-positive height",
+			0,
+			sdkmath.LegacyDec{},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			qc := nw.GetFeeMarketClient()
+
+			nw.App.GetFeeMarketKeeper().SetBaseFeeAt(ctx, 100, sdkmath.LegacyNewDec(1))
+
+			res, err := qc.BaseFeeAt(ctx.Context(), &types.QueryBaseFeeAtRequest{Height: tc.height})
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expBaseFee, res.BaseFee)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryBlockGas() {
 	var (
 		nw  *network.UnitTestNetwork
@@ -132,3 +185,89 @@ func (s *KeeperTestSuite) TestQueryBlockGas() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQuerySimulateBaseFee() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	testCases := []struct {
+		name         string
+		noBaseFee    bool
+		utilizations []sdkmath.LegacyDec
+		expBaseFees  []sdkmath.LegacyDec
+		expPass      bool
+	}{
+		{
+			"pass - no utilizations",
+			false,
+			nil,
+			[]sdkmath.LegacyDec{},
+			true,
+		},
+		{
+			"pass - base fee follows the utilizations",
+			false,
+			[]sdkmath.LegacyDec{
+				sdkmath.LegacyNewDecWithPrec(5, 1),
+				sdkmath.LegacyOneDec(),
+				sdkmath.LegacyZeroDec(),
+			},
+			[]sdkmath.LegacyDec{
+				sdkmath.LegacyNewDec(1_000_000_000),
+				sdkmath.LegacyNewDec(1_125_000_000),
+				sdkmath.LegacyNewDec(984_375_000),
+			},
+			true,
+		},
+		{
+			"fail - utilization above 1",
+			false,
+			[]sdkmath.LegacyDec{sdkmath.LegacyNewDecWithPrec(15, 1)},
+			nil,
+			false,
+		},
+		{
+			"fail - negative utilization",
+			false,
+			[]sdkmath.LegacyDec{sdkmath.LegacyNewDec(-1)},
+			nil,
+			false,
+		},
+		{
+			"fail - base fee disabled",
+			true,
+			[]sdkmath.LegacyDec{sdkmath.LegacyOneDec()},
+			nil,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+
+			params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+			params.NoBaseFee = tc.noBaseFee
+			params.BaseFee = sdkmath.LegacyNewDec(1_000_000_000)
+			params.MinGasPrice = sdkmath.LegacyZeroDec()
+			s.Require().NoError(nw.App.GetFeeMarketKeeper().SetParams(ctx, params))
+
+			ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+				Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10},
+			})
+
+			res, err := nw.App.GetFeeMarketKeeper().SimulateBaseFee(ctx, &types.QuerySimulateBaseFeeRequest{
+				Utilizations: tc.utilizations,
+			})
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expBaseFees, res.BaseFees)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -72,3 +72,54 @@ func (s *KeeperTestSuite) TestSetGetGasFee() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestBaseFeeHistory() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	testCases := []struct {
+		name       string
+		history    uint64
+		expHeights []int64
+	}{
+		{
+			"history longer than the chain",
+			10,
+			[]int64{1, 2, 3, 4, 5},
+		},
+		{
+			"history pruned to the last heights",
+			2,
+			[]int64{4, 5},
+		},
+		{
+			"history disabled",
+			0,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			k := nw.App.GetFeeMarketKeeper()
+
+			for height := int64(1); height <= 5; height++ {
+				k.SetBaseFeeAt(ctx, height, math.LegacyNewDec(height))
+			}
+			k.PruneBaseFeeHistory(ctx, 5, tc.history)
+
+			var heights []int64
+			for height := int64(1); height <= 5; height++ {
+				baseFee, found := k.GetBaseFeeAt(ctx, height)
+				if found {
+					s.Require().Equal(math.LegacyNewDec(height), baseFee)
+					heights = append(heights, height)
+				}
+			}
+			s.Require().Equal(tc.expHeights, heights)
+		})
+	}
+}
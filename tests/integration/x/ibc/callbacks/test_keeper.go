@@ -25,6 +25,15 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 		transferData transfertypes.FungibleTokenPacketData
 		packet       channeltypes.Packet
 	)
+	setAllowlist := func(contracts ...common.Address) {
+		evmKeeper := s.network.App.GetEVMKeeper()
+		params := evmKeeper.GetParams(ctx)
+		params.IBCCallbackContractsAllowlist = nil
+		for _, contract := range contracts {
+			params.IBCCallbackContractsAllowlist = append(params.IBCCallbackContractsAllowlist, contract.Hex())
+		}
+		s.Require().NoError(evmKeeper.SetParams(ctx, params))
+	}
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -35,6 +44,20 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 			func() {},
 			types.ErrContractHasNoCode,
 		},
+		{
+			"contract is not allowed",
+			func() {
+				setAllowlist(common.HexToAddress("0x1"))
+			},
+			types.ErrContractNotAllowed,
+		},
+		{
+			"allowed contract code does not exist",
+			func() {
+				setAllowlist(contract)
+			},
+			types.ErrContractHasNoCode,
+		},
 		{
 			"packet data is not transfer",
 			func() {
@@ -32,7 +32,30 @@ type KeeperTestSuite struct {
 
 	EnableFeemarket  bool
 	EnableLondonHF   bool
+	EnableCancunHF   bool
 	MintFeeCollector bool
+
+	// checkpoints are the states set up by SetupTestFromCheckpoint
+	checkpoints map[checkpointKey]suiteCheckpoint
+}
+
+// checkpointKey identifies a checkpoint by the name of its setup and the flags
+// of the suite it was set up with.
+type checkpointKey struct {
+	name             string
+	enableFeemarket  bool
+	enableLondonHF   bool
+	enableCancunHF   bool
+	mintFeeCollector bool
+}
+
+// suiteCheckpoint is the network of the suite checkpointed after a setup.
+type suiteCheckpoint struct {
+	network    *network.UnitTestNetwork
+	handler    grpc.Handler
+	keyring    keyring.Keyring
+	factory    factory.TxFactory
+	checkpoint network.Checkpoint
 }
 
 func NewKeeperTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *KeeperTestSuite {
@@ -41,6 +64,7 @@ func NewKeeperTestSuite(create network.CreateEvmApp, options ...network.ConfigOp
 		Options:         options,
 		EnableFeemarket: false,
 		EnableLondonHF:  true,
+		EnableCancunHF:  true,
 	}
 }
 
@@ -88,6 +112,47 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.Handler = gh
 	s.Keyring = keys
 
+	s.configureEVM()
+}
+
+// SetupTestFromCheckpoint sets up the test as SetupTest followed by setup, which
+// runs only once per name and suite flags: its resulting state is checkpointed
+// and restored by the next calls, saving the time of expensive setups like
+// contract deployments or the funding of many accounts.
+func (s *KeeperTestSuite) SetupTestFromCheckpoint(name string, setup func()) {
+	key := checkpointKey{
+		name:             name,
+		enableFeemarket:  s.EnableFeemarket,
+		enableLondonHF:   s.EnableLondonHF,
+		enableCancunHF:   s.EnableCancunHF,
+		mintFeeCollector: s.MintFeeCollector,
+	}
+	if cp, found := s.checkpoints[key]; found {
+		s.Network, s.Handler, s.Keyring, s.Factory = cp.network, cp.handler, cp.keyring, cp.factory
+		s.Require().NoError(s.Network.Restore(cp.checkpoint))
+		s.configureEVM()
+		return
+	}
+
+	s.SetupTest()
+	setup()
+	checkpoint, err := s.Network.Checkpoint()
+	s.Require().NoError(err)
+
+	if s.checkpoints == nil {
+		s.checkpoints = make(map[checkpointKey]suiteCheckpoint)
+	}
+	s.checkpoints[key] = suiteCheckpoint{
+		network:    s.Network,
+		handler:    s.Handler,
+		keyring:    s.Keyring,
+		factory:    s.Factory,
+		checkpoint: checkpoint,
+	}
+}
+
+// configureEVM sets the EVM configuration of the suite flags.
+func (s *KeeperTestSuite) configureEVM() {
 	chainConfig := evmtypes.DefaultChainConfig(s.Network.GetEIP155ChainID().Uint64())
 	if !s.EnableLondonHF {
 		maxInt := sdkmath.NewInt(math.MaxInt64)
@@ -99,6 +164,11 @@ func (s *KeeperTestSuite) SetupTest() {
 		chainConfig.CancunTime = &maxInt
 		chainConfig.PragueTime = &maxInt
 	}
+	if !s.EnableCancunHF {
+		maxInt := sdkmath.NewInt(math.MaxInt64)
+		chainConfig.CancunTime = &maxInt
+		chainConfig.PragueTime = &maxInt
+	}
 	// get the denom and decimals set on chain initialization
 	// because we'll need to set them again when resetting the chain config
 	denom := evmtypes.GetEVMCoinDenom()
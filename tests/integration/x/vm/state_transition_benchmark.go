@@ -5,7 +5,6 @@ import (
 	"math/big"
 	"testing"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
@@ -13,141 +12,47 @@ import (
 	utiltx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
-var templateAccessListTx = &ethtypes.AccessListTx{
-	GasPrice: big.NewInt(1),
-	Gas:      21000,
-	To:       &common.Address{},
-	Value:    big.NewInt(0),
-	Data:     []byte{},
-}
-
-var templateLegacyTx = &ethtypes.LegacyTx{
-	GasPrice: big.NewInt(1),
-	Gas:      21000,
-	To:       &common.Address{},
-	Value:    big.NewInt(0),
-	Data:     []byte{},
-}
-
-var templateDynamicFeeTx = &ethtypes.DynamicFeeTx{
-	GasFeeCap: big.NewInt(10),
-	GasTipCap: big.NewInt(2),
-	Gas:       21000,
-	To:        &common.Address{},
-	Value:     big.NewInt(0),
-	Data:      []byte{},
-}
-
-func newSignedEthTx(
-	txData ethtypes.TxData,
-	nonce uint64,
-	addr sdk.Address,
-	krSigner keyring.Signer,
-	ethSigner ethtypes.Signer,
-) (*evmtypes.MsgEthereumTx, error) {
-	var ethTx *ethtypes.Transaction
-	switch txData := txData.(type) {
-	case *ethtypes.AccessListTx:
-		txData.Nonce = nonce
-		ethTx = ethtypes.NewTx(txData)
-	case *ethtypes.LegacyTx:
-		txData.Nonce = nonce
-		ethTx = ethtypes.NewTx(txData)
-	case *ethtypes.DynamicFeeTx:
-		txData.Nonce = nonce
-		ethTx = ethtypes.NewTx(txData)
-	default:
-		return nil, errors.New("unknown transaction type")
-	}
-
-	sig, _, err := krSigner.SignByAddress(addr, ethTx.Hash().Bytes(), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
-	if err != nil {
-		return nil, err
-	}
-
-	ethTx, err = ethTx.WithSignature(ethSigner, sig)
-	if err != nil {
-		return nil, err
-	}
-
-	var msg evmtypes.MsgEthereumTx
-	if err := msg.FromEthereumTx(ethTx); err != nil {
-		return nil, err
-	}
-	return &msg, nil
-}
-
+// newEthMsgTx returns the signed tx of the given type, with the base fee of
+// its message.
 func newEthMsgTx(
 	nonce uint64,
-	address common.Address,
-	krSigner keyring.Signer,
-	ethSigner ethtypes.Signer,
+	priv cryptotypes.PrivKey,
 	txType byte,
 	data []byte,
 	accessList ethtypes.AccessList,
 ) (*evmtypes.MsgEthereumTx, *big.Int, error) {
-	var (
-		ethTx   *ethtypes.Transaction
-		baseFee *big.Int
-	)
+	var baseFee *big.Int
+	builder := utiltx.NewEthTxBuilder(priv).
+		WithType(txType).
+		WithNonce(nonce).
+		WithData(data)
 	switch txType {
 	case ethtypes.LegacyTxType:
-		templateLegacyTx.Nonce = nonce
-		if data != nil {
-			templateLegacyTx.Data = data
-		}
-		ethTx = ethtypes.NewTx(templateLegacyTx)
+		builder.WithGasPrice(big.NewInt(1))
 	case ethtypes.AccessListTxType:
-		templateAccessListTx.Nonce = nonce
-		if data != nil {
-			templateAccessListTx.Data = data
-		} else {
-			templateAccessListTx.Data = []byte{}
-		}
-
-		templateAccessListTx.AccessList = accessList
-		ethTx = ethtypes.NewTx(templateAccessListTx)
+		builder.WithGasPrice(big.NewInt(1)).WithAccessList(accessList)
 	case ethtypes.DynamicFeeTxType:
-		templateDynamicFeeTx.Nonce = nonce
-
-		if data != nil {
-			templateAccessListTx.Data = data
-		} else {
-			templateAccessListTx.Data = []byte{}
-		}
-		templateAccessListTx.AccessList = accessList
-		ethTx = ethtypes.NewTx(templateDynamicFeeTx)
+		builder.WithFeeCap(big.NewInt(10), big.NewInt(2)).WithAccessList(accessList)
 		baseFee = big.NewInt(3)
 	default:
 		return nil, baseFee, errors.New("unsupported tx type")
 	}
 
-	msg := &evmtypes.MsgEthereumTx{}
-	err := msg.FromEthereumTx(ethTx)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	msg.From = address.Bytes()
-
-	return msg, baseFee, msg.Sign(ethSigner, krSigner)
+	msg, err := builder.Msg()
+	return msg, baseFee, err
 }
 
 func newNativeMessage(
 	nonce uint64,
-	address common.Address,
-	krSigner keyring.Signer,
-	ethSigner ethtypes.Signer,
+	priv cryptotypes.PrivKey,
 	txType byte,
 	data []byte,
 	accessList ethtypes.AccessList,
 ) (*core.Message, error) {
-	msg, baseFee, err := newEthMsgTx(nonce, address, krSigner, ethSigner, txType, data, accessList)
+	msg, baseFee, err := newEthMsgTx(nonce, priv, txType, data, accessList)
 	if err != nil {
 		return nil, err
 	}
@@ -161,22 +66,20 @@ func newNativeMessage(
 }
 
 func BenchmarkApplyTransaction(b *testing.B) { //nolint:dupl
-	suite := KeeperTestSuite{EnableLondonHF: true}
+	suite := KeeperTestSuite{EnableLondonHF: true, EnableCancunHF: true}
 	suite.SetupTest()
 
-	ethSigner := ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID)
-
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		addr := suite.Keyring.GetAddr(0)
-		krSigner := utiltx.NewSigner(suite.Keyring.GetPrivKey(0))
-		tx, err := newSignedEthTx(templateAccessListTx,
+		tx, _, err := newEthMsgTx(
 			suite.Network.App.GetEVMKeeper().GetNonce(suite.Network.GetContext(), addr),
-			sdk.AccAddress(addr.Bytes()),
-			krSigner,
-			ethSigner,
+			suite.Keyring.GetPrivKey(0),
+			ethtypes.AccessListTxType,
+			nil,
+			nil,
 		)
 		require.NoError(b, err)
 
@@ -190,22 +93,20 @@ func BenchmarkApplyTransaction(b *testing.B) { //nolint:dupl
 }
 
 func BenchmarkApplyTransactionWithLegacyTx(b *testing.B) { //nolint:dupl
-	suite := KeeperTestSuite{EnableLondonHF: true}
+	suite := KeeperTestSuite{EnableLondonHF: true, EnableCancunHF: true}
 	suite.SetupTest()
 
-	ethSigner := ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID)
-
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		addr := suite.Keyring.GetAddr(0)
-		krSigner := utiltx.NewSigner(suite.Keyring.GetPrivKey(0))
-		tx, err := newSignedEthTx(templateLegacyTx,
+		tx, _, err := newEthMsgTx(
 			suite.Network.App.GetEVMKeeper().GetNonce(suite.Network.GetContext(), addr),
-			sdk.AccAddress(addr.Bytes()),
-			krSigner,
-			ethSigner,
+			suite.Keyring.GetPrivKey(0),
+			ethtypes.LegacyTxType,
+			nil,
+			nil,
 		)
 		require.NoError(b, err)
 
@@ -219,22 +120,20 @@ func BenchmarkApplyTransactionWithLegacyTx(b *testing.B) { //nolint:dupl
 }
 
 func BenchmarkApplyTransactionWithDynamicFeeTx(b *testing.B) {
-	suite := KeeperTestSuite{EnableFeemarket: true, EnableLondonHF: true}
+	suite := KeeperTestSuite{EnableFeemarket: true, EnableLondonHF: true, EnableCancunHF: true}
 	suite.SetupTest()
 
-	ethSigner := ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID)
-
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		addr := suite.Keyring.GetAddr(0)
-		krSigner := utiltx.NewSigner(suite.Keyring.GetPrivKey(0))
-		tx, err := newSignedEthTx(templateDynamicFeeTx,
+		tx, _, err := newEthMsgTx(
 			suite.Network.App.GetEVMKeeper().GetNonce(suite.Network.GetContext(), addr),
-			sdk.AccAddress(addr.Bytes()),
-			krSigner,
-			ethSigner,
+			suite.Keyring.GetPrivKey(0),
+			ethtypes.DynamicFeeTxType,
+			nil,
+			nil,
 		)
 		require.NoError(b, err)
 
@@ -248,23 +147,17 @@ func BenchmarkApplyTransactionWithDynamicFeeTx(b *testing.B) {
 }
 
 func BenchmarkApplyMessage(b *testing.B) {
-	suite := KeeperTestSuite{EnableLondonHF: true}
+	suite := KeeperTestSuite{EnableLondonHF: true, EnableCancunHF: true}
 	suite.SetupTest()
 
-	ethCfg := evmtypes.GetEthChainConfig()
-	signer := ethtypes.LatestSignerForChainID(ethCfg.ChainID)
-
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		addr := suite.Keyring.GetAddr(0)
-		krSigner := utiltx.NewSigner(suite.Keyring.GetPrivKey(0))
 		m, err := newNativeMessage(
 			suite.Network.App.GetEVMKeeper().GetNonce(suite.Network.GetContext(), addr),
-			addr,
-			krSigner,
-			signer,
+			suite.Keyring.GetPrivKey(0),
 			ethtypes.AccessListTxType,
 			nil,
 			nil,
@@ -281,23 +174,17 @@ func BenchmarkApplyMessage(b *testing.B) {
 }
 
 func BenchmarkApplyMessageWithLegacyTx(b *testing.B) {
-	suite := KeeperTestSuite{EnableLondonHF: true}
+	suite := KeeperTestSuite{EnableLondonHF: true, EnableCancunHF: true}
 	suite.SetupTest()
 
-	ethCfg := evmtypes.GetEthChainConfig()
-	signer := ethtypes.LatestSignerForChainID(ethCfg.ChainID)
-
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		addr := suite.Keyring.GetAddr(0)
-		krSigner := utiltx.NewSigner(suite.Keyring.GetPrivKey(0))
 		m, err := newNativeMessage(
 			suite.Network.App.GetEVMKeeper().GetNonce(suite.Network.GetContext(), addr),
-			addr,
-			krSigner,
-			signer,
+			suite.Keyring.GetPrivKey(0),
 			ethtypes.AccessListTxType,
 			nil,
 			nil,
@@ -314,23 +201,17 @@ func BenchmarkApplyMessageWithLegacyTx(b *testing.B) {
 }
 
 func BenchmarkApplyMessageWithDynamicFeeTx(b *testing.B) {
-	suite := KeeperTestSuite{EnableFeemarket: true, EnableLondonHF: true}
+	suite := KeeperTestSuite{EnableFeemarket: true, EnableLondonHF: true, EnableCancunHF: true}
 	suite.SetupTest()
 
-	ethCfg := evmtypes.GetEthChainConfig()
-	signer := ethtypes.LatestSignerForChainID(ethCfg.ChainID)
-
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		b.StopTimer()
 		addr := suite.Keyring.GetAddr(0)
-		krSigner := utiltx.NewSigner(suite.Keyring.GetPrivKey(0))
 		m, err := newNativeMessage(
 			suite.Network.App.GetEVMKeeper().GetNonce(suite.Network.GetContext(), addr),
-			addr,
-			krSigner,
-			signer,
+			suite.Keyring.GetPrivKey(0),
 			ethtypes.DynamicFeeTxType,
 			nil,
 			nil,
@@ -1,9 +1,22 @@
 package vm
 
 import (
+	"math/big"
+	"runtime"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestEndBlock() {
@@ -24,3 +37,292 @@ func (s *KeeperTestSuite) TestEndBlock() {
 	s.Require().Equal(1, len(postEventManager.Events()))
 	s.Require().Equal(evmtypes.EventTypeBlockBloom, postEventManager.Events()[0].Type)
 }
+
+func (s *KeeperTestSuite) TestBeginBlockHistoryStorage() {
+	s.SetupTest()
+	s.Require().NoError(s.Network.NextBlock())
+
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	s.Require().Equal(params.HistoryStorageCode, evmKeeper.GetCode(ctx, evmKeeper.GetCodeHash(ctx, params.HistoryStorageAddress)))
+
+	// the history contract serves the hash of the parent block
+	parentHeight := ctx.BlockHeight() - 1
+	parentHash := evmKeeper.GetState(ctx, params.HistoryStorageAddress, common.BigToHash(big.NewInt(parentHeight)))
+	s.Require().NotEqual(common.Hash{}, parentHash)
+
+	historyAddr := params.HistoryStorageAddress
+	res, err := evmKeeper.CallEVMWithData(ctx, s.Keyring.GetAddr(0), &historyAddr, common.BigToHash(big.NewInt(parentHeight)).Bytes(), false, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(parentHash.Bytes(), res.Ret)
+
+	// the current block isn't served yet
+	_, err = evmKeeper.CallEVMWithData(ctx, s.Keyring.GetAddr(0), &historyAddr, common.BigToHash(big.NewInt(ctx.BlockHeight())).Bytes(), false, nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestBeginBlockBlockHashes() {
+	s.SetupTest()
+
+	// the CometBFT hash of each block is the hash of its FinalizeBlock request,
+	// which is the hash of the block returned by eth_getBlockByNumber
+	hashes := make(map[uint64][]byte)
+	for i := 0; i < 3; i++ {
+		// the integration network uses the last app hash as the block hash
+		hash := s.Network.App.LastCommitID().Hash
+		s.Require().NoError(s.Network.NextBlock())
+		hashes[uint64(s.Network.GetContext().BlockHeight())] = hash //nolint:gosec // G115
+	}
+	s.Require().NoError(s.Network.NextBlock())
+
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	for height, hash := range hashes {
+		s.Require().Equal(hash, evmKeeper.GetBlockHash(ctx, height))
+		s.Require().Equal(common.BytesToHash(hash), evmKeeper.GetHashFn(ctx)(height))
+	}
+
+	// the slots of the ring buffer only serve the blocks they store
+	for height := range hashes {
+		s.Require().Nil(evmKeeper.GetBlockHash(ctx, height+256))
+	}
+}
+
+func (s *KeeperTestSuite) TestBlockGasInvariant() {
+	testCases := []struct {
+		name          string
+		evmGasUsed    uint64
+		blockGasUsed  uint64
+		strict        bool
+		expPass       bool
+		expEndBlockOK bool
+	}{
+		{
+			"success - the eth txs used the block gas",
+			21000,
+			21000,
+			true,
+			true,
+			true,
+		},
+		{
+			"success - the block gas also accounts cosmos txs",
+			21000,
+			50000,
+			true,
+			true,
+			true,
+		},
+		{
+			"fail - mismatch logged",
+			50000,
+			21000,
+			false,
+			false,
+			true,
+		},
+		{
+			"fail - mismatch halts in strict mode",
+			50000,
+			21000,
+			true,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			evmKeeper := s.Network.App.GetEVMKeeper()
+			evmKeeper.SetStrictGasAccounting(tc.strict)
+			defer evmKeeper.SetStrictGasAccounting(false)
+
+			blockGasMeter := storetypes.NewGasMeter(1_000_000)
+			blockGasMeter.ConsumeGas(tc.blockGasUsed, "test")
+			ctx := s.Network.GetContext().WithBlockGasMeter(blockGasMeter)
+			evmKeeper.AddTransientBlockGasUsed(ctx, tc.evmGasUsed)
+
+			evmGasUsed, blockGasUsed, err := evmKeeper.BlockGasInvariant(ctx)
+			s.Require().Equal(tc.evmGasUsed, evmGasUsed)
+			s.Require().Equal(tc.blockGasUsed, blockGasUsed)
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, evmtypes.ErrInvalidGasUsed)
+			}
+
+			if tc.expEndBlockOK {
+				s.Require().NotPanics(func() { _ = evmKeeper.EndBlock(ctx) })
+			} else {
+				s.Require().Panics(func() { _ = evmKeeper.EndBlock(ctx) })
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBlockGasInvariantWithTxs() {
+	s.SetupTest()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	evmKeeper.SetStrictGasAccounting(true)
+	defer evmKeeper.SetStrictGasAccounting(false)
+
+	recipient := s.Keyring.GetAddr(1)
+	tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), evmtypes.EvmTxArgs{
+		To:     &recipient,
+		Amount: big.NewInt(1000),
+	})
+	s.Require().NoError(err)
+	txBytes, err := s.Network.GetEncodingConfig().TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	// the block doesn't halt the node in the strict mode
+	blockRes, err := s.Network.NextBlockWithTxs(txBytes)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), blockRes.TxResults[0].Code, blockRes.TxResults[0].Log)
+}
+
+func (s *KeeperTestSuite) TestSweepEmptyAccounts() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	accountKeeper := s.Network.App.GetAccountKeeper()
+
+	empty := make([]common.Address, 3)
+	for i := range empty {
+		empty[i] = utiltx.GenerateAddress()
+		accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, empty[i].Bytes()))
+	}
+	funded := s.Keyring.GetAddr(0)
+
+	// a batch scans a single account at most
+	removed := evmKeeper.SweepEmptyAccounts(ctx, 1)
+	s.Require().LessOrEqual(len(removed), 1)
+
+	// the sweep resumes from the cursor until it has covered all the accounts
+	numAccounts := 0
+	accountKeeper.IterateAccounts(ctx, func(sdk.AccountI) bool {
+		numAccounts++
+		return false
+	})
+	for i := 0; i < numAccounts; i++ {
+		removed = append(removed, evmKeeper.SweepEmptyAccounts(ctx, 1)...)
+	}
+	s.Require().ElementsMatch(empty, removed)
+
+	for _, addr := range empty {
+		s.Require().False(accountKeeper.HasAccount(ctx, addr.Bytes()))
+	}
+	s.Require().True(accountKeeper.HasAccount(ctx, funded.Bytes()))
+	// the validator operators have no balance, their whole stake is bonded
+	for _, validator := range s.Network.GetValidators() {
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		s.Require().NoError(err)
+		s.Require().True(accountKeeper.HasAccount(ctx, sdk.AccAddress(valAddr)))
+	}
+	s.Require().Empty(evmKeeper.SweepEmptyAccounts(ctx, evmtypes.MaxEmptyAccountSweepBatch))
+}
+
+func (s *KeeperTestSuite) TestEndBlockSweepEmptyAccounts() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	accountKeeper := s.Network.App.GetAccountKeeper()
+
+	addr := utiltx.GenerateAddress()
+	accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, addr.Bytes()))
+
+	// the sweep is disabled by default
+	s.Require().NoError(evmKeeper.EndBlock(ctx))
+	s.Require().True(accountKeeper.HasAccount(ctx, addr.Bytes()))
+
+	params := evmKeeper.GetParams(ctx)
+	params.EmptyAccountSweepBatch = evmtypes.MaxEmptyAccountSweepBatch
+	s.Require().NoError(evmKeeper.SetParams(ctx, params))
+
+	s.Require().NoError(evmKeeper.EndBlock(ctx))
+	s.Require().False(accountKeeper.HasAccount(ctx, addr.Bytes()))
+}
+
+func (s *KeeperTestSuite) TestParallelExecution() {
+	s.SetupTest()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	txConfig := s.Network.GetEncodingConfig().TxConfig
+	evmKeeper.SetParallelExecution(txConfig.TxDecoder(), s.Network.App.GetAnteHandler())
+	defer evmKeeper.SetParallelExecution(nil, nil)
+
+	// the first transfer doesn't conflict with the txs before it, its optimistic
+	// execution is applied, while the second one conflicts with the first one,
+	// it's executed again
+	recipient := utiltx.GenerateAddress()
+	txs := make([][]byte, 0, 2)
+	for i, amount := range []int64{1000, 2000} {
+		tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(i), evmtypes.EvmTxArgs{
+			To:     &recipient,
+			Amount: big.NewInt(amount),
+		})
+		s.Require().NoError(err)
+		txBytes, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		txs = append(txs, txBytes)
+	}
+
+	blockRes, err := s.Network.NextBlockWithTxs(txs...)
+	s.Require().NoError(err)
+	for _, txRes := range blockRes.TxResults {
+		s.Require().Equal(uint32(0), txRes.Code, txRes.Log)
+	}
+	s.Require().Equal(uint64(3000), evmKeeper.GetBalance(s.Network.GetContext(), recipient).Uint64())
+
+	applied, conflicts := evmKeeper.ParallelExecutionStats()
+	s.Require().Equal(uint64(1), applied)
+	s.Require().Equal(uint64(1), conflicts)
+}
+
+func (s *KeeperTestSuite) TestExecuteParallelConcurrently() {
+	// the txs of many senders are executed optimistically by concurrent
+	// goroutines, it's meant to be run with the race detector
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	keyring := testkeyring.New(8)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	txFactory := factory.New(unitNetwork, grpc.NewIntegrationHandler(unitNetwork))
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+	txConfig := unitNetwork.GetEncodingConfig().TxConfig
+	// the ante handler consumes gas before it sets the gas meter of the tx
+	anteHandler := unitNetwork.App.GetAnteHandler()
+	evmKeeper.SetParallelExecution(txConfig.TxDecoder(), func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		ctx.GasMeter().ConsumeGas(params.TxGas, "ante")
+		return anteHandler(ctx, tx, simulate)
+	})
+	defer evmKeeper.SetParallelExecution(nil, nil)
+
+	recipients := make([]common.Address, len(keyring.GetKeys()))
+	txs := make([][]byte, 0, len(recipients))
+	for i := range recipients {
+		recipients[i] = utiltx.GenerateAddress()
+		tx, err := txFactory.GenerateSignedEthTx(keyring.GetPrivKey(i), evmtypes.EvmTxArgs{
+			To:     &recipients[i],
+			Amount: big.NewInt(1000),
+		})
+		s.Require().NoError(err)
+		txBytes, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		txs = append(txs, txBytes)
+	}
+
+	blockRes, err := unitNetwork.NextBlockWithTxs(txs...)
+	s.Require().NoError(err)
+	for _, txRes := range blockRes.TxResults {
+		s.Require().Equal(uint32(0), txRes.Code, txRes.Log)
+	}
+	for _, recipient := range recipients {
+		s.Require().Equal(uint64(1000), evmKeeper.GetBalance(unitNetwork.GetContext(), recipient).Uint64())
+	}
+
+	// every tx is either applied from its optimistic execution or executed again
+	applied, conflicts := evmKeeper.ParallelExecutionStats()
+	s.Require().Equal(uint64(len(txs)), applied+conflicts)
+}
@@ -73,7 +73,7 @@ func DoBenchmark(b *testing.B, txBuilder TxBuilder) {
 		ctx, _ := suite.Network.GetContext().CacheContext()
 
 		// deduct fee first
-		txData, err := types.UnpackTxData(msg.Data)
+		txData, err := msg.GetTxData()
 		require.NoError(b, err)
 
 		fees := sdk.Coins{sdk.NewCoin(suite.EvmDenom(), sdkmath.NewIntFromBigInt(txData.Fee()))}
@@ -202,7 +202,7 @@ func BenchmarkMessageCall(b *testing.B) {
 		ctx, _ := suite.Network.GetContext().CacheContext()
 
 		// deduct fee first
-		txData, err := types.UnpackTxData(msg.Data)
+		txData, err := msg.GetTxData()
 		require.NoError(b, err)
 
 		fees := sdk.Coins{sdk.NewCoin(suite.EvmDenom(), sdkmath.NewIntFromBigInt(txData.Fee()))}
@@ -2,8 +2,16 @@ package vm
 
 import (
 	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	"github.com/cosmos/evm/contracts"
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -148,3 +156,132 @@ func (s *KeeperTestSuite) TestCallEVMWithData() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCallEVMWithDataInternalTxEvent() {
+	s.SetupTest() // reset
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+	internalTxEvents := func() []abci.Event {
+		var events []abci.Event
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if event.Type == evmtypes.EventTypeInternalTx {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+	attribute := func(event abci.Event, key string) []string {
+		var values []string
+		for _, attr := range event.Attributes {
+			if attr.Key == key {
+				values = append(values, attr.Value)
+			}
+		}
+		return values
+	}
+
+	// the calls without commit are not internal txs
+	wcosmosEVMContract := common.HexToAddress(testconstants.WEVMOSContractMainnet)
+	data, err := erc20.Pack("balanceOf", utiltx.GenerateAddress())
+	s.Require().NoError(err)
+	_, err = evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, &wcosmosEVMContract, data, false, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(internalTxEvents())
+
+	// the contract creations emit the address of the created contract
+	nonce, err := s.Network.App.GetAccountKeeper().GetSequence(ctx, types.ModuleAddress.Bytes())
+	s.Require().NoError(err)
+	ctorArgs, err := erc20.Pack("", "test", "test", uint8(18))
+	s.Require().NoError(err)
+	data = append(contracts.ERC20MinterBurnerDecimalsContract.Bin, ctorArgs...) //nolint:gocritic
+	res, err := evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, nil, data, true, nil)
+	s.Require().NoError(err)
+
+	contract := crypto.CreateAddress(types.ModuleAddress, nonce)
+	events := internalTxEvents()
+	s.Require().Len(events, 1)
+	s.Require().Equal([]string{types.ModuleAddress.Hex()}, attribute(events[0], evmtypes.AttributeKeySender))
+	s.Require().Equal([]string{contract.Hex()}, attribute(events[0], evmtypes.AttributeKeyContractAddress))
+	s.Require().Equal([]string{strconv.FormatUint(res.GasUsed, 10)}, attribute(events[0], evmtypes.AttributeKeyTxGasUsed))
+	s.Require().Empty(attribute(events[0], evmtypes.AttributeKeyRecipient))
+
+	// the calls emit their recipient and their logs
+	data, err = erc20.Pack("mint", utiltx.GenerateAddress(), big.NewInt(100))
+	s.Require().NoError(err)
+	res, err = evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, &contract, data, true, nil)
+	s.Require().NoError(err)
+	s.Require().Len(res.Logs, 1)
+
+	events = internalTxEvents()
+	s.Require().Len(events, 2)
+	s.Require().Equal([]string{contract.Hex()}, attribute(events[1], evmtypes.AttributeKeyRecipient))
+	s.Require().Empty(attribute(events[1], evmtypes.AttributeKeyTxLog))
+	s.Require().Len(attribute(events[1], evmtypes.AttributeKeyTxLogs), 1)
+}
+
+func (s *KeeperTestSuite) TestSingletonFactoryPreinstall() {
+	s.SetupTest()
+
+	var factory evmtypes.Preinstall
+	for _, preinstall := range evmtypes.DefaultPreinstalls {
+		if preinstall.Name == "EIP-2470 singleton factory" {
+			factory = preinstall
+		}
+	}
+	s.Require().NotEmpty(factory.Address)
+
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	factoryAddr := common.HexToAddress(factory.Address)
+	if len(evmKeeper.GetCode(ctx, evmKeeper.GetCodeHash(ctx, factoryAddr))) == 0 {
+		s.Require().NoError(evmKeeper.AddPreinstalls(ctx, []evmtypes.Preinstall{factory}))
+	}
+
+	factoryABI, err := abi.JSON(strings.NewReader(`[{"inputs":[{"name":"_initCode","type":"bytes"},{"name":"_salt","type":"bytes32"}],"name":"deploy","outputs":[{"name":"createdContract","type":"address"}],"stateMutability":"nonpayable","type":"function"}]`))
+	s.Require().NoError(err)
+
+	// init code that deploys the runtime code 0x2a
+	initCode := common.FromHex("0x602a60005360016000f3")
+	salt := common.HexToHash("0x01")
+	data, err := factoryABI.Pack("deploy", initCode, salt)
+	s.Require().NoError(err)
+
+	res, err := evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, &factoryAddr, data, true, nil)
+	s.Require().NoError(err)
+	s.Require().False(res.Failed(), res.VmError)
+
+	expAddr := crypto.CreateAddress2(factoryAddr, salt, crypto.Keccak256(initCode))
+	s.Require().Equal(expAddr, common.BytesToAddress(res.Ret))
+	s.Require().Equal([]byte{0x2a}, evmKeeper.GetCode(ctx, evmKeeper.GetCodeHash(ctx, expAddr)))
+
+	// unknown selectors are rejected
+	_, err = evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, &factoryAddr, []byte{0x01, 0x02, 0x03, 0x04}, false, nil)
+	s.Require().ErrorContains(err, evmtypes.ErrVMExecution.Error())
+}
+
+func (s *KeeperTestSuite) TestPrevRandao() {
+	s.SetupTest()
+
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+
+	// init code of a contract returning PREVRANDAO
+	initCode := common.FromHex("0x684460005260206000f360005260096017f3")
+	contractAddr := crypto.CreateAddress(types.ModuleAddress, evmKeeper.GetNonce(ctx, types.ModuleAddress))
+	_, err := evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, nil, initCode, true, nil)
+	s.Require().NoError(err)
+
+	// PREVRANDAO is derived from the CometBFT hash of the block
+	blockHash := tmhash.Sum([]byte("block"))
+	res, err := evmKeeper.CallEVMWithData(ctx.WithHeaderHash(blockHash), types.ModuleAddress, &contractAddr, nil, false, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(evmtypes.PrevRandao(blockHash).Bytes(), res.Ret)
+
+	// the query contexts read the block hash from the store
+	s.Require().NotEmpty(evmKeeper.GetBlockHash(ctx, uint64(ctx.BlockHeight()))) //nolint:gosec // G115
+	res, err = evmKeeper.CallEVMWithData(ctx.WithHeaderHash(nil), types.ModuleAddress, &contractAddr, nil, false, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(evmtypes.PrevRandao(evmKeeper.GetBlockHash(ctx, uint64(ctx.BlockHeight()))).Bytes(), res.Ret) //nolint:gosec // G115
+}
@@ -0,0 +1,53 @@
+package vm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func (s *KeeperTestSuite) TestSetupTestFromCheckpoint() {
+	var (
+		setups       int
+		contractAddr common.Address
+		height       int64
+	)
+	funded := utiltx.GenerateAddress()
+	setup := func() {
+		setups++
+		contractAddr = s.DeployTestContract(s.T(), s.Network.GetContext(), s.Keyring.GetAddr(0), big.NewInt(100))
+		s.Require().NoError(s.Network.App.GetEVMKeeper().SetBalance(s.Network.GetContext(), funded, uint256.NewInt(100)))
+	}
+
+	for i := 0; i < 3; i++ {
+		s.SetupTestFromCheckpoint("checkpoint", setup)
+		s.Require().Equal(1, setups, "expected the setup to run once")
+		if i == 0 {
+			height = s.Network.GetContext().BlockHeight()
+		}
+		s.Require().Equal(height, s.Network.GetContext().BlockHeight())
+
+		// the state of the setup is restored
+		evmKeeper := s.Network.App.GetEVMKeeper()
+		ctx := s.Network.GetContext()
+		s.Require().NotEmpty(evmKeeper.GetCode(ctx, evmKeeper.GetCodeHash(ctx, contractAddr)))
+		s.Require().Equal(uint256.NewInt(100), evmKeeper.GetBalance(ctx, funded))
+
+		// the changes of the test, committed or not, are discarded by the next restore
+		s.Require().NoError(evmKeeper.DeleteAccount(ctx, contractAddr))
+		s.Require().NoError(evmKeeper.SetBalance(ctx, funded, uint256.NewInt(1)))
+		_, err := s.Factory.ExecuteEthTx(s.Keyring.GetPrivKey(1), evmtypes.EvmTxArgs{To: &funded, Amount: big.NewInt(1)})
+		s.Require().NoError(err)
+		s.Require().NoError(s.Network.NextBlock())
+	}
+
+	// the flags of the suite are part of the checkpoint
+	s.EnableFeemarket = !s.EnableFeemarket
+	defer func() { s.EnableFeemarket = !s.EnableFeemarket }()
+	s.SetupTestFromCheckpoint("checkpoint", setup)
+	s.Require().Equal(2, setups)
+}
@@ -0,0 +1,251 @@
+package vm
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/testutil/statetests"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// differentialGasLimit is the gas limit of the fuzzed messages.
+	differentialGasLimit = 1_000_000
+	// differentialMaxInputSize bounds the size of the fuzzed code and calldata.
+	differentialMaxInputSize = 4096
+)
+
+var (
+	differentialSender   = common.HexToAddress("0xf0221e6ba1a9ce000000000000000000000000f1")
+	differentialContract = common.HexToAddress("0xf0221e6ba1a9ce000000000000000000000000c0")
+	differentialBalance  = new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)
+)
+
+// differentialResult is the outcome of a message, which must be the same on the
+// keeper and on the reference EVM.
+type differentialResult struct {
+	Failed     bool
+	ReturnData []byte
+	GasUsed    uint64
+	LogsHash   common.Hash
+	StateRoot  common.Hash
+}
+
+// FuzzDifferentialEVM executes the fuzzed code, called with the fuzzed calldata
+// and value, or run as the init code of a contract creation, with the EVM of the
+// keeper and with a vanilla go-ethereum EVM on an in-memory state, and diffs
+// their return data, gas used, logs and post states to catch the divergences
+// of the keeper, e.g. in its StateDB or in its gas accounting.
+//
+// Both EVMs share the same chain config, block context and extra EIPs. The
+// static precompiles of the chain are disabled and the coinbase is the zero
+// address, because the keeper warms the zero address instead of the coinbase
+// (EIP-3651). The EIP-7623 floor of the calldata gas, which the keeper doesn't
+// charge, is added to its gas used.
+func FuzzDifferentialEVM(f *testing.F, create network.CreateEvmApp, options ...network.ConfigOption) {
+	nw := network.NewUnitTestNetwork(create, options...)
+	ctx := nw.GetContext().
+		WithBlockGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(30_000_000))
+	evmKeeper := nw.App.GetEVMKeeper()
+
+	evmParams := evmKeeper.GetParams(ctx)
+	evmParams.ActiveStaticPrecompiles = nil
+	require.NoError(f, evmKeeper.SetParams(ctx, evmParams))
+	// the reference EVM charges the gas used only
+	feemarketParams := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+	feemarketParams.MinGasMultiplier = sdkmath.LegacyZeroDec()
+	require.NoError(f, nw.App.GetFeeMarketKeeper().SetParams(ctx, feemarketParams))
+
+	// SSTORE, SLOAD and LOG0 of the calldata
+	f.Add(common.FromHex("0x60003560005560005460015560206000a0"), common.FromHex("0x2a"), uint64(0), false)
+	// CALLVALUE, BALANCE and SELFBALANCE
+	f.Add(common.FromHex("0x34600055303160015547600255"), []byte{}, uint64(7), false)
+	// clearing a storage slot for a refund, then REVERT
+	f.Add(common.FromHex("0x6000600055600160205260206020fd"), []byte{}, uint64(0), false)
+	// CREATE of a contract returning one byte of code
+	f.Add(common.FromHex("0x69600160005360016000f3600052600a60166000f0600055"), []byte{}, uint64(1), false)
+	// init code storing a slot and returning one byte of code
+	f.Add(common.FromHex("0x602a60005560016000f3"), []byte{}, uint64(0), true)
+	// SELFDESTRUCT to a new account
+	f.Add(common.FromHex("0x73f0221e6ba1a9ce00000000000000000000000000ff"), []byte{}, uint64(3), false)
+
+	f.Fuzz(func(t *testing.T, code, data []byte, value uint64, contractCreation bool) {
+		if len(code) > differentialMaxInputSize || len(data) > differentialMaxInputSize {
+			t.Skip("input too large")
+		}
+
+		msg := &core.Message{
+			From:      differentialSender,
+			Nonce:     1,
+			Value:     new(big.Int).SetUint64(value),
+			GasLimit:  differentialGasLimit,
+			GasPrice:  new(big.Int),
+			GasFeeCap: new(big.Int),
+			GasTipCap: new(big.Int),
+			Data:      data,
+		}
+		if contractCreation {
+			msg.Data = code
+		} else {
+			msg.To = &differentialContract
+		}
+
+		cacheCtx, _ := ctx.CacheContext()
+		keeperResult, keeperErr := applyKeeperMessage(cacheCtx, nw, msg, code)
+		refResult, refErr := applyReferenceMessage(cacheCtx, nw, msg, code)
+
+		require.Equal(t, refErr != nil, keeperErr != nil, "message errors differ: reference %v, keeper %v", refErr, keeperErr)
+		if refErr != nil {
+			return
+		}
+		require.Equal(t, refResult.Failed, keeperResult.Failed, "execution failures differ")
+		require.True(t, bytes.Equal(refResult.ReturnData, keeperResult.ReturnData), "return data differ: reference %x, keeper %x", refResult.ReturnData, keeperResult.ReturnData)
+		require.Equal(t, refResult.GasUsed, keeperResult.GasUsed, "gas used differ")
+		require.Equal(t, refResult.LogsHash, keeperResult.LogsHash, "logs differ")
+		require.Equal(t, refResult.StateRoot, keeperResult.StateRoot, "post states differ")
+	})
+}
+
+// applyKeeperMessage applies the message with the EVM of the keeper after
+// setting the code of the contract and funding the sender.
+func applyKeeperMessage(ctx sdk.Context, nw *network.UnitTestNetwork, msg *core.Message, code []byte) (*differentialResult, error) {
+	evmKeeper := nw.App.GetEVMKeeper()
+	accountKeeper := nw.App.GetAccountKeeper()
+
+	// the nonce of the sender is incremented by the ante handler
+	if err := evmKeeper.SetAccount(ctx, msg.From, statedb.Account{
+		Nonce:    msg.Nonce + 1,
+		Balance:  uint256.MustFromBig(differentialBalance),
+		CodeHash: ethtypes.EmptyCodeHash.Bytes(),
+	}); err != nil {
+		return nil, err
+	}
+	codeHash := crypto.Keccak256Hash(code)
+	evmKeeper.SetCode(ctx, codeHash.Bytes(), code)
+	if err := evmKeeper.SetAccount(ctx, differentialContract, statedb.Account{
+		Nonce:    1,
+		Balance:  new(uint256.Int),
+		CodeHash: codeHash.Bytes(),
+	}); err != nil {
+		return nil, err
+	}
+	evmKeeper.SetState(ctx, differentialContract, common.Hash{}, common.BigToHash(big.NewInt(1)).Bytes())
+
+	before := statetests.Accounts(ctx, accountKeeper)
+
+	cfg := &statedb.EVMConfig{
+		Params:   evmKeeper.GetParams(ctx),
+		CoinBase: common.Address{},
+		BaseFee:  new(big.Int),
+	}
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	res, err := evmKeeper.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	gasUsed := res.GasUsed
+	if evmKeeper.GetBlockRules(ctx).IsPrague {
+		floorDataGas, err := core.FloorDataGas(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		gasUsed = max(gasUsed, floorDataGas)
+	}
+
+	touched := map[common.Address]struct{}{msg.From: {}, differentialContract: {}}
+	for addr := range statetests.Accounts(ctx, accountKeeper) {
+		if _, found := before[addr]; !found {
+			touched[addr] = struct{}{}
+		}
+	}
+
+	return &differentialResult{
+		Failed:     res.Failed(),
+		ReturnData: res.Ret,
+		GasUsed:    gasUsed,
+		LogsHash:   differentialLogsHash(evmtypes.LogsToEthereum(res.Logs)),
+		StateRoot:  statetests.StateRoot(ctx, evmKeeper, touched),
+	}, nil
+}
+
+// applyReferenceMessage applies the message with a vanilla go-ethereum EVM on
+// an in-memory state with the same accounts, in the block of the context.
+func applyReferenceMessage(ctx sdk.Context, nw *network.UnitTestNetwork, msg *core.Message, code []byte) (*differentialResult, error) {
+	evmKeeper := nw.App.GetEVMKeeper()
+
+	stateDB, err := state.New(ethtypes.EmptyRootHash, state.NewDatabaseForTesting())
+	if err != nil {
+		return nil, err
+	}
+	stateDB.SetNonce(msg.From, msg.Nonce, tracing.NonceChangeUnspecified)
+	stateDB.SetBalance(msg.From, uint256.MustFromBig(differentialBalance), tracing.BalanceChangeUnspecified)
+	stateDB.SetNonce(differentialContract, 1, tracing.NonceChangeUnspecified)
+	stateDB.SetCode(differentialContract, code)
+	stateDB.SetState(differentialContract, common.Hash{}, common.BigToHash(big.NewInt(1)))
+	stateDB.Finalise(true)
+
+	blockHash := ctx.HeaderHash()
+	if len(blockHash) == 0 {
+		blockHash = evmKeeper.GetBlockHash(ctx, uint64(ctx.BlockHeight())) //#nosec G115 -- block height is never negative
+	}
+	random := evmtypes.PrevRandao(blockHash)
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     evmKeeper.GetHashFn(ctx),
+		Coinbase:    common.Address{},
+		GasLimit:    cosmosevmtypes.BlockGasLimit(ctx),
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		Time:        uint64(ctx.BlockHeader().Time.Unix()), //#nosec G115 -- int overflow is not a concern here
+		Difficulty:  big.NewInt(0),
+		BaseFee:     new(big.Int),
+		Random:      &random,
+	}
+	vmConfig := vm.Config{
+		NoBaseFee: true,
+		ExtraEips: evmKeeper.GetParams(ctx).EIPsAt(ctx.BlockHeight()),
+	}
+	evm := vm.NewEVM(blockCtx, stateDB, evmtypes.GetEthChainConfig(), vmConfig)
+
+	res, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(blockCtx.GasLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &differentialResult{
+		Failed:     res.Failed(),
+		ReturnData: res.ReturnData,
+		GasUsed:    res.UsedGas,
+		LogsHash:   differentialLogsHash(stateDB.Logs()),
+		StateRoot:  stateDB.IntermediateRoot(true),
+	}, nil
+}
+
+func differentialLogsHash(logs []*ethtypes.Log) common.Hash {
+	bz, err := rlp.EncodeToBytes(logs)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode the logs: %s", err))
+	}
+	return crypto.Keccak256Hash(bz)
+}
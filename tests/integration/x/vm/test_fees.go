@@ -251,7 +251,7 @@ func (s *KeeperTestSuite) TestCheckSenderBalance() {
 			tx := evmtypes.NewTx(ethTxParams)
 			tx.From = tc.from
 
-			txData, _ := evmtypes.UnpackTxData(tx.Data)
+			txData, _ := tx.GetTxData()
 
 			acct := s.Network.App.GetEVMKeeper().GetAccountOrEmpty(s.Network.GetContext(), addr)
 			err := keeper.CheckSenderBalance(
@@ -502,7 +502,7 @@ func (s *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 			tx := evmtypes.NewTx(ethTxParams)
 			tx.From = tc.from
 
-			txData, _ := evmtypes.UnpackTxData(tx.Data)
+			txData, _ := tx.GetTxData()
 
 			baseFee := s.Network.App.GetEVMKeeper().GetBaseFee(s.Network.GetContext())
 			priority := evmtypes.GetTxPriority(txData, baseFee)
@@ -0,0 +1,110 @@
+package vm
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/precompiles/bech32"
+	"github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/testutil/contracts"
+	"github.com/cosmos/evm/testutil/gasregression"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gasFixturesVersion is the version of the fixture of testdata/gas the gas used
+// by the scenarios is checked against. It must be bumped, generating a new
+// fixture, when the gas used changes on purpose in a consensus breaking release.
+const gasFixturesVersion = 1
+
+// TestGasRegression checks the gas used by a fixed set of transfers, ERC20
+// operations and precompile calls against the gas fixture of the current
+// version, which is generated with UPDATE_GAS_FIXTURES=1.
+func (s *KeeperTestSuite) TestGasRegression() {
+	_, file, _, ok := runtime.Caller(0)
+	s.Require().True(ok)
+	tracker := gasregression.NewTracker(filepath.Join(filepath.Dir(file), "testdata", "gas"), gasFixturesVersion)
+
+	ctx := s.Network.GetContext()
+	// the gas used by the txs is tracked, not the minimum gas charged
+	feemarketParams := s.Network.App.GetFeeMarketKeeper().GetParams(ctx)
+	feemarketParams.MinGasMultiplier = sdkmath.LegacyZeroDec()
+	s.Require().NoError(s.Network.App.GetFeeMarketKeeper().SetParams(ctx, feemarketParams))
+
+	// the keys are fixed, as the gas of the calldata depends on the addresses
+	sender := &ethsecp256k1.PrivKey{Key: crypto.Keccak256([]byte("gas regression sender"))}
+	spender := &ethsecp256k1.PrivKey{Key: crypto.Keccak256([]byte("gas regression spender"))}
+	senderAddr := common.BytesToAddress(sender.PubKey().Address())
+	spenderAddr := common.BytesToAddress(spender.PubKey().Address())
+	recipient := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	amount := sdk.NewCoins(sdk.NewCoin(s.Network.GetBaseDenom(), sdkmath.NewIntWithDecimal(1, 24)))
+	s.Require().NoError(s.Network.FundAccount(senderAddr.Bytes(), amount))
+	s.Require().NoError(s.Network.FundAccount(spenderAddr.Bytes(), amount))
+
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	execute := func(scenario string, priv *ethsecp256k1.PrivKey, to *common.Address, value *big.Int, data []byte) {
+		builder := utiltx.NewEthTxBuilder(priv).
+			WithNonce(evmKeeper.GetNonce(ctx, common.BytesToAddress(priv.PubKey().Address()))).
+			WithGasLimit(10_000_000).
+			WithValue(value).
+			WithData(data)
+		if to != nil {
+			builder = builder.WithTo(*to)
+		} else {
+			builder = builder.WithContractCreation()
+		}
+		msg, err := builder.Msg()
+		s.Require().NoError(err)
+
+		res, err := evmKeeper.EthereumTx(ctx, msg)
+		s.Require().NoError(err, scenario)
+		s.Require().Empty(res.VmError, scenario)
+		tracker.Record(scenario, res.GasUsed)
+	}
+
+	// transfers
+	execute("transfer_new_account", sender, &recipient, big.NewInt(1), nil)
+	execute("transfer_existing_account", sender, &recipient, big.NewInt(1), nil)
+
+	// ERC20 operations
+	erc20 := contracts.NewERC20MinterBurnerDecimals()
+	deployData := append(append([]byte{}, contracts.ERC20MinterBurnerDecimalsContract.Bin...), erc20.PackConstructor("Gas", "GAS", 18)...)
+	tokenAddr := crypto.CreateAddress(senderAddr, evmKeeper.GetNonce(ctx, senderAddr))
+	execute("erc20_deploy", sender, nil, common.Big0, deployData)
+	execute("erc20_mint", sender, &tokenAddr, common.Big0, erc20.PackMint(senderAddr, big.NewInt(1000)))
+	execute("erc20_transfer_new_holder", sender, &tokenAddr, common.Big0, erc20.PackTransfer(recipient, big.NewInt(100)))
+	execute("erc20_transfer", sender, &tokenAddr, common.Big0, erc20.PackTransfer(recipient, big.NewInt(100)))
+	execute("erc20_approve", sender, &tokenAddr, common.Big0, erc20.PackApprove(spenderAddr, big.NewInt(100)))
+	execute("erc20_transfer_from", spender, &tokenAddr, common.Big0, erc20.PackTransferFrom(senderAddr, recipient, big.NewInt(50)))
+
+	// precompile calls
+	bankCallerAddr := crypto.CreateAddress(senderAddr, evmKeeper.GetNonce(ctx, senderAddr))
+	execute("bank_caller_deploy", sender, nil, common.Big0, contracts.BankCallerContract.Bin)
+	execute("precompile_bank_balances", sender, &bankCallerAddr, common.Big0, contracts.NewBankCaller().PackCallBalances(senderAddr))
+
+	bech32Precompile, err := bech32.NewPrecompile(6_000)
+	s.Require().NoError(err)
+	bech32Data, err := bech32Precompile.Pack("hexToBech32", senderAddr, "cosmos")
+	s.Require().NoError(err)
+	bech32Addr := bech32Precompile.Address()
+	execute("precompile_bech32_hex_to_bech32", sender, &bech32Addr, common.Big0, bech32Data)
+
+	stakingABI, err := staking.LoadABI()
+	s.Require().NoError(err)
+	delegateData, err := stakingABI.Pack("delegate", senderAddr, s.Network.GetValidators()[0].OperatorAddress, big.NewInt(1e18))
+	s.Require().NoError(err)
+	stakingAddr := common.HexToAddress(evmtypes.StakingPrecompileAddress)
+	execute("precompile_staking_delegate", sender, &stakingAddr, common.Big0, delegateData)
+
+	s.Require().NoError(tracker.Check(os.Getenv(gasregression.UpdateEnv) != ""))
+}
@@ -110,6 +110,28 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 			},
 			expPanic: false,
 		},
+		{
+			name: "valid account with archived storage",
+			malleate: func(network *network.UnitTestNetwork) {
+				acc := network.App.GetAccountKeeper().NewAccountWithAddress(ctx, address.Bytes())
+				network.App.GetAccountKeeper().SetAccount(ctx, acc)
+			},
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Accounts: []types.GenesisAccount{
+					{
+						Address:                address.String(),
+						Code:                   "1234",
+						ArchivedStorageRoot:    common.BytesToHash([]byte("root")).Hex(),
+						ArchivedStorageEntries: 2,
+						Storage: types.Storage{
+							types.NewState(common.BytesToHash([]byte("key")), common.BytesToHash([]byte("value"))),
+						},
+					},
+				},
+			},
+			expPanic: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -170,6 +192,14 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 							vmdb.GetState(common.HexToAddress(acct.Address), common.HexToHash(storage.Key)),
 						)
 					}
+
+					archived, ok := s.network.App.GetEVMKeeper().GetArchivedStorage(ctx, common.HexToAddress(acct.Address))
+					s.Require().Equal(acct.ArchivedStorageRoot != "", ok)
+					if ok {
+						s.Require().Equal(acct.ArchivedStorageRoot, archived.Root.Hex())
+						s.Require().Equal(acct.ArchivedStorageEntries, archived.Entries)
+						s.Require().Equal(uint64(len(acct.Storage)), archived.Restored)
+					}
 				}
 
 				// verify preinstalls
@@ -221,8 +251,8 @@ func (s *GenesisTestSuite) TestExportGenesis() {
 	s.Require().NoError(s.network.NextBlock())
 
 	genState := vm.ExportGenesis(s.network.GetContext(), s.network.App.GetEVMKeeper())
-	// Exported accounts 4 default preinstalls
-	s.Require().Len(genState.Accounts, 7)
+	// Exported accounts 5 default preinstalls and the EIP-2935 history contract
+	s.Require().Len(genState.Accounts, 9)
 
 	addrs := make([]string, len(genState.Accounts))
 	for i, acct := range genState.Accounts {
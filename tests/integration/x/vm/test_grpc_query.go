@@ -1,10 +1,13 @@
 package vm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -18,6 +21,7 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	testutiltypes "github.com/cosmos/evm/testutil/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	"github.com/cosmos/evm/x/vm/keeper/testdata"
@@ -86,6 +90,24 @@ func (s *KeeperTestSuite) TestQueryAccount() {
 			},
 			true,
 		},
+		{
+			"success - bech32 address",
+			func() *types.QueryAccountRequest {
+				// Add new unfunded key
+				index := s.Keyring.AddKey()
+				addr := s.Keyring.GetAccAddr(index)
+
+				return &types.QueryAccountRequest{
+					Address: addr.String(),
+				}
+			},
+			&types.QueryAccountResponse{
+				Balance:  "0",
+				CodeHash: common.BytesToHash(crypto.Keccak256(nil)).Hex(),
+				Nonce:    0,
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -314,6 +336,159 @@ func (s *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageSlots() {
+	testCases := []struct {
+		msg           string
+		getReqAndResp func() (*types.QueryStorageSlotsRequest, *types.QueryStorageSlotsResponse)
+		expPass       bool
+	}{
+		{
+			"invalid address",
+			func() (*types.QueryStorageSlotsRequest, *types.QueryStorageSlotsResponse) {
+				req := &types.QueryStorageSlotsRequest{
+					Slots: []*types.QueryStorageRequest{{Address: invalidAddress}},
+				}
+				return req, nil
+			},
+			false,
+		},
+		{
+			"too many slots",
+			func() (*types.QueryStorageSlotsRequest, *types.QueryStorageSlotsResponse) {
+				slots := make([]*types.QueryStorageRequest, 1025)
+				for i := range slots {
+					slots[i] = &types.QueryStorageRequest{Address: s.Keyring.GetAddr(0).String()}
+				}
+				return &types.QueryStorageSlotsRequest{Slots: slots}, nil
+			},
+			false,
+		},
+		{
+			"success",
+			func() (*types.QueryStorageSlotsRequest, *types.QueryStorageSlotsResponse) {
+				key1 := common.BytesToHash([]byte("key1"))
+				key2 := common.BytesToHash([]byte("key2"))
+				value := []byte("value")
+
+				newIndex := s.Keyring.AddKey()
+				addr := s.Keyring.GetAddr(newIndex)
+
+				s.Network.App.GetEVMKeeper().SetState(
+					s.Network.GetContext(),
+					addr,
+					key2,
+					value,
+				)
+
+				req := &types.QueryStorageSlotsRequest{
+					Slots: []*types.QueryStorageRequest{
+						{Address: addr.String(), Key: key2.String()},
+						{Address: addr.String(), Key: key1.String()},
+					},
+				}
+				return req, &types.QueryStorageSlotsResponse{
+					Values: []string{common.BytesToHash(value).String(), common.Hash{}.String()},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			req, expectedResp := tc.getReqAndResp()
+
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().StorageSlots(ctx, req)
+
+			s.Require().Equal(expectedResp, res)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryStorageRange() {
+	var (
+		addr common.Address
+		keys []common.Hash
+	)
+
+	testCases := []struct {
+		msg           string
+		getReqAndResp func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse)
+		expPass       bool
+	}{
+		{
+			"invalid address",
+			func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse) {
+				return &types.QueryStorageRangeRequest{Address: invalidAddress}, nil
+			},
+			false,
+		},
+		{
+			"success - all the entries",
+			func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse) {
+				req := &types.QueryStorageRangeRequest{Address: addr.String(), MaxResult: 10}
+				return req, &types.QueryStorageRangeResponse{
+					Storage: types.Storage{
+						types.NewState(keys[0], common.BytesToHash([]byte("value1"))),
+						types.NewState(keys[1], common.BytesToHash([]byte("value2"))),
+						types.NewState(keys[2], common.BytesToHash([]byte("value3"))),
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success - from a key start with a max result",
+			func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse) {
+				req := &types.QueryStorageRangeRequest{Address: addr.String(), KeyStart: keys[1].String(), MaxResult: 1}
+				return req, &types.QueryStorageRangeResponse{
+					Storage: types.Storage{types.NewState(keys[1], common.BytesToHash([]byte("value2")))},
+					NextKey: keys[2].String(),
+				}
+			},
+			true,
+		},
+		{
+			"success - no entries from the key start",
+			func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse) {
+				keyStart := common.BytesToHash([]byte{4})
+				req := &types.QueryStorageRangeRequest{Address: addr.String(), KeyStart: keyStart.String(), MaxResult: 10}
+				return req, &types.QueryStorageRangeResponse{}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.SetupTest()
+
+			addr = s.Keyring.GetAddr(s.Keyring.AddKey())
+			keys = []common.Hash{common.BytesToHash([]byte{1}), common.BytesToHash([]byte{2}), common.BytesToHash([]byte{3})}
+			for i, key := range keys {
+				s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), addr, key, []byte(fmt.Sprintf("value%d", i+1)))
+			}
+
+			req, expectedResp := tc.getReqAndResp()
+			res, err := s.Network.GetEvmClient().StorageRange(s.Network.GetContext(), req)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(expectedResp, res)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -1248,6 +1423,121 @@ func (s *KeeperTestSuite) TestTraceTx() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTraceCall() {
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	sender := s.Keyring.GetAddr(0)
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	ctorArgs, err := erc20Contract.ABI.Pack("", sender, supply)
+	s.Require().NoError(err)
+	data := erc20Contract.Bin
+	data = append(data, ctorArgs...)
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, Data: (*hexutil.Bytes)(&data)})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		msg           string
+		args          []byte
+		traceConfig   *types.TraceConfig
+		timeoutCap    time.Duration
+		sizeCap       int
+		resultSizeCap int
+		expPass       bool
+		expTrace      string
+	}{
+		{
+			msg:  "invalid args",
+			args: []byte("invalid args"),
+		},
+		{
+			msg:         "negative limit",
+			args:        args,
+			traceConfig: &types.TraceConfig{Limit: -1},
+		},
+		{
+			msg:      "default trace",
+			args:     args,
+			expPass:  true,
+			expTrace: "{\"gas\":",
+		},
+		{
+			msg:  "javascript tracer",
+			args: args,
+			traceConfig: &types.TraceConfig{
+				Tracer: "{count: 0, fault: function(log) {}, step: function(log) { this.count++; }, result: function() { return this.count > 0; }}",
+			},
+			expPass:  true,
+			expTrace: "true",
+		},
+		{
+			msg:  "javascript tracer timeout",
+			args: args,
+			traceConfig: &types.TraceConfig{
+				Tracer:  "{fault: function(log) {}, step: function(log) { while(true) {} }, result: function() { return null; }}",
+				Timeout: "100ms",
+			},
+		},
+		{
+			msg:         "timeout above the cap",
+			args:        args,
+			traceConfig: &types.TraceConfig{Timeout: "1m"},
+			timeoutCap:  time.Second,
+		},
+		{
+			msg:  "javascript tracer above the size cap",
+			args: args,
+			traceConfig: &types.TraceConfig{
+				Tracer: "{count: 0, fault: function(log) {}, step: function(log) { this.count++; }, result: function() { return this.count > 0; }}",
+			},
+			sizeCap: 16,
+		},
+		{
+			msg:  "javascript tracer result above the size cap",
+			args: args,
+			traceConfig: &types.TraceConfig{
+				Tracer: "{fault: function(log) {}, step: function(log) {}, result: function() { return 'x'.repeat(100); }}",
+			},
+			resultSizeCap: 64,
+		},
+		{
+			msg:         "opcode profiler",
+			args:        args,
+			traceConfig: &types.TraceConfig{Tracer: "opcodeProfiler"},
+			expPass:     true,
+			expTrace:    "{\"opcodes\":",
+		},
+	}
+
+	defer s.Network.App.GetEVMKeeper().SetTraceCaps(0, 0, 0)
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			s.Network.App.GetEVMKeeper().SetTraceCaps(tc.timeoutCap, tc.sizeCap, tc.resultSizeCap)
+			ctx := s.Network.GetContext()
+			req := &types.QueryTraceCallRequest{
+				Args:        tc.args,
+				GasCap:      config.DefaultGasCap,
+				TraceConfig: tc.traceConfig,
+				BlockNumber: ctx.BlockHeight(),
+				BlockTime:   ctx.BlockTime(),
+				BlockMaxGas: ctx.ConsensusParams().Block.MaxGas,
+				ChainId:     s.Network.GetEIP155ChainID().Int64(),
+			}
+
+			res, err := s.Network.GetEvmClient().TraceCall(ctx, req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(strings.HasPrefix(string(res.Data), tc.expTrace), string(res.Data))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestTraceBlock() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -1700,6 +1990,106 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestEthCallInterrupted() {
+	s.SetupTest()
+
+	// the init code loops until the gas runs out
+	sender := s.Keyring.GetAddr(0)
+	loop := hexutil.Bytes{byte(vm.JUMPDEST), byte(vm.PUSH1), 0, byte(vm.JUMP)}
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, Data: &loop})
+	s.Require().NoError(err)
+
+	k := s.Network.App.GetEVMKeeper()
+	res, err := k.EthCall(s.Network.GetContext(), &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+	s.Require().NoError(err)
+	s.Require().Equal(vm.ErrOutOfGas.Error(), res.VmError)
+
+	// the executions of a canceled query are interrupted long before the gas
+	// runs out
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctx := s.Network.GetContext().WithContext(canceled)
+	req := &types.EthCallRequest{Args: args, GasCap: 1_000_000_000}
+
+	_, err = k.EthCall(ctx, req)
+	s.Require().ErrorContains(err, types.ErrExecutionAborted.Error())
+
+	_, err = k.EstimateGas(ctx, req)
+	s.Require().ErrorContains(err, types.ErrExecutionAborted.Error())
+
+	// the EVM pooled after the interrupted execution isn't canceled
+	res, err = k.EthCall(s.Network.GetContext(), &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap})
+	s.Require().NoError(err)
+	s.Require().Equal(vm.ErrOutOfGas.Error(), res.VmError)
+}
+
+func (s *KeeperTestSuite) TestAccessList() {
+	s.SetupTest()
+
+	senderKey := s.Keyring.GetKey(0)
+	contractAddr, err := deployErc20Contract(senderKey, s.Factory)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+	recipient := utiltx.GenerateAddress()
+	transferData, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name          string
+		args          types.TransactionArgs
+		expAccessList bool
+	}{
+		{
+			"pass - transfer without accessed storage",
+			types.TransactionArgs{From: &senderKey.Addr, To: &recipient},
+			false,
+		},
+		{
+			"pass - erc20 transfer accessing the balances",
+			types.TransactionArgs{From: &senderKey.Addr, To: &contractAddr, Input: (*hexutil.Bytes)(&transferData)},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			args, err := json.Marshal(&tc.args)
+			s.Require().NoError(err)
+
+			res, err := s.Network.GetEvmClient().AccessList(
+				s.Network.GetContext(),
+				&types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap},
+			)
+			s.Require().NoError(err)
+			s.Require().Empty(res.VmError)
+			s.Require().NotZero(res.GasUsed)
+			if !tc.expAccessList {
+				s.Require().Empty(res.AccessList)
+				return
+			}
+
+			// the contract is the recipient, so it's only listed with the
+			// storage keys of the sender and recipient balances
+			s.Require().Len(res.AccessList, 1)
+			s.Require().Equal(contractAddr.Hex(), res.AccessList[0].Address)
+			s.Require().Len(res.AccessList[0].StorageKeys, 2)
+
+			// the access list reduces the gas used by the call
+			tc.args.AccessList = res.AccessList.ToEthAccessList()
+			args, err = json.Marshal(&tc.args)
+			s.Require().NoError(err)
+			withList, err := s.Network.GetEvmClient().EthCall(
+				s.Network.GetContext(),
+				&types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap},
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(res.GasUsed, withList.GasUsed)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestEmptyRequest() {
 	s.SetupTest()
 	k := s.Network.App.GetEVMKeeper()
@@ -1756,6 +2146,12 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"AccessList method",
+			func() (interface{}, error) {
+				return k.AccessList(s.Network.GetContext(), nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -11,7 +12,10 @@ import (
 	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -146,3 +150,48 @@ func (s *KeeperTestSuite) TestGetAccountOrEmpty() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetSenderAccount() {
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+
+	acc, account := evmKeeper.GetSenderAccount(ctx, common.Address{1})
+	s.Require().Nil(acc)
+	s.Require().Nil(account)
+
+	sender := s.Keyring.GetAddr(0)
+	acc, account = evmKeeper.GetSenderAccount(ctx, sender)
+	s.Require().NotNil(acc)
+	s.Require().Equal(sdk.AccAddress(sender.Bytes()), acc.GetAddress())
+	s.Require().Equal(evmKeeper.GetAccount(ctx, sender), account)
+}
+
+func (s *KeeperTestSuite) TestActivateFork() {
+	s.SetupTest()
+
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+	s.Require().Nil(evmtypes.GetEthChainConfig().OsakaTime)
+	s.Require().Error(evmKeeper.ActivateFork(ctx, "verkle"))
+
+	handler := evmKeeper.ForkUpgradeHandler(func(context.Context, upgradetypes.Plan, module.VersionMap) (module.VersionMap, error) {
+		return module.VersionMap{}, nil
+	})
+	// the upgrade runs in a branch of the block state
+	upgradeCtx, _ := ctx.CacheContext()
+	_, err := handler(upgradeCtx, upgradetypes.Plan{Name: "osaka", Height: ctx.BlockHeight()}, module.VersionMap{})
+	s.Require().NoError(err)
+	s.Require().Error(evmKeeper.ActivateFork(upgradeCtx, "osaka"), "a fork can only be activated once")
+
+	// the activation applies from the beginning of the block, which derives
+	// the chain config from the store
+	s.Require().Nil(evmtypes.GetEthChainConfig().OsakaTime)
+	s.Require().NoError(evmKeeper.BeginBlock(upgradeCtx))
+	osakaTime := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- block time is never negative
+	s.Require().Equal(osakaTime, *evmtypes.GetEthChainConfig().OsakaTime)
+	s.Require().Equal([]evmtypes.ForkActivation{{Fork: "osaka", Height: ctx.BlockHeight(), Timestamp: osakaTime}}, evmKeeper.GetForkActivations(upgradeCtx))
+
+	// the activation of a discarded branch is reverted with the state
+	s.Require().NoError(evmKeeper.LoadForkActivations(ctx))
+	s.Require().Nil(evmtypes.GetEthChainConfig().OsakaTime)
+}
@@ -3,12 +3,34 @@ package vm
 import (
 	"math/big"
 
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil"
+	commonfactory "github.com/cosmos/evm/testutil/integration/base/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/utils"
+	testutiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/keeper/testdata"
 	"github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (s *KeeperTestSuite) TestEthereumTx() {
@@ -68,7 +90,8 @@ func (s *KeeperTestSuite) TestEthereumTx() {
 				// check expected events were emitted
 				s.Require().NotEmpty(events)
 				s.Require().True(utils.ContainsEventType(events.ToABCIEvents(), types.EventTypeEthereumTx))
-				s.Require().True(utils.ContainsEventType(events.ToABCIEvents(), types.EventTypeTxLog))
+				// logs are only returned in the msg response
+				s.Require().False(utils.ContainsEventType(events.ToABCIEvents(), types.EventTypeTxLog))
 				s.Require().True(utils.ContainsEventType(events.ToABCIEvents(), sdktypes.EventTypeMessage))
 			}
 
@@ -79,6 +102,185 @@ func (s *KeeperTestSuite) TestEthereumTx() {
 	s.EnableFeemarket = false
 }
 
+func (s *KeeperTestSuite) TestEthereumTxCompactEvents() {
+	s.SetupTest()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	evmKeeper.SetCompactEvents(true)
+	defer evmKeeper.SetCompactEvents(false)
+
+	recipient := s.Keyring.GetAddr(1)
+	tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{
+		To:       &recipient,
+		Amount:   big.NewInt(1e18),
+		GasLimit: 100_000,
+	})
+	s.Require().NoError(err)
+
+	ctx := s.Network.GetContext()
+	res, err := evmKeeper.EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+	s.Require().NoError(err)
+	s.Require().False(res.Failed())
+	s.Require().NotZero(res.GasUsed)
+
+	// the gas used and the status are only returned in the msg response
+	events := ctx.EventManager().Events().ToABCIEvents()
+	s.Require().False(utils.ContainsEventType(events, types.EventTypeEthereumTx))
+	s.Require().True(utils.ContainsEventType(events, sdktypes.EventTypeMessage))
+}
+
+func (s *KeeperTestSuite) TestEthereumTxModifiedAccounts() {
+	s.SetupTest()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	evmKeeper.SetRecordModifiedAccounts(true)
+	defer evmKeeper.SetRecordModifiedAccounts(false)
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := s.Keyring.GetAddr(1)
+	tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{
+		To:       &recipient,
+		Amount:   big.NewInt(1e18),
+		GasLimit: 100_000,
+	})
+	s.Require().NoError(err)
+
+	ctx := s.Network.GetContext()
+	msg := tx.GetMsgs()[0].(*types.MsgEthereumTx)
+	res, err := evmKeeper.EthereumTx(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().False(res.Failed())
+
+	expEvent, err := types.NewModifiedAccountsEvent(common.HexToHash(msg.Hash), []common.Address{sender, recipient})
+	s.Require().NoError(err)
+	s.Require().Contains(ctx.EventManager().Events(), expEvent)
+}
+
+func (s *KeeperTestSuite) TestEthereumTxBlockedRecipient() {
+	s.SetupTest()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	recipient := common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName))
+	tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{
+		To:       &recipient,
+		Amount:   big.NewInt(1e18),
+		GasLimit: 100_000,
+	})
+	s.Require().NoError(err)
+
+	ctx := s.Network.GetContext()
+	balance := evmKeeper.GetBalance(ctx, recipient)
+	res, err := evmKeeper.EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+	s.Require().NoError(err)
+	s.Require().True(res.Failed())
+	s.Require().Contains(res.VmError, types.ErrBlockedAddress.Error())
+	s.Require().Equal(balance, evmKeeper.GetBalance(ctx, recipient))
+}
+
+func (s *KeeperTestSuite) TestEthereumTxSelfDestructToBlockedRecipient() {
+	s.SetupTest()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	beneficiary := common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName))
+	// init code: PUSH20 <beneficiary> SELFDESTRUCT
+	initCode := append(append([]byte{0x73}, beneficiary.Bytes()...), 0xff)
+	tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{
+		Amount:   big.NewInt(1e18),
+		Input:    initCode,
+		GasLimit: 100_000,
+	})
+	s.Require().NoError(err)
+
+	ctx := s.Network.GetContext()
+	balance := evmKeeper.GetBalance(ctx, beneficiary)
+	res, err := evmKeeper.EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+	s.Require().NoError(err)
+	s.Require().True(res.Failed())
+	s.Require().Contains(res.VmError, types.ErrBlockedAddress.Error())
+	s.Require().Less(res.GasUsed, uint64(100_000))
+	s.Require().Equal(balance, evmKeeper.GetBalance(ctx, beneficiary))
+}
+
+func (s *KeeperTestSuite) TestEthereumTxSelfDestruct() {
+	defer func() { s.EnableCancunHF = true }()
+
+	testCases := []struct {
+		name      string
+		cancun    bool
+		expDelete bool
+	}{
+		{"EIP-6780 - the contract is kept", true, false},
+		{"before Cancun - the contract is deleted", false, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.EnableCancunHF = tc.cancun
+			s.SetupTest()
+			evmKeeper := s.Network.App.GetEVMKeeper()
+			beneficiary := s.Keyring.GetAddr(1)
+
+			// runtime code: PUSH20 <beneficiary> SELFDESTRUCT
+			runtimeCode := append(append([]byte{0x73}, beneficiary.Bytes()...), 0xff)
+			// init code: CODECOPY the runtime code appended to it and RETURN it
+			initCode := []byte{0x60, byte(len(runtimeCode)), 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, byte(len(runtimeCode)), 0x60, 0x00, 0xf3}
+			tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{
+				Input:    append(initCode, runtimeCode...),
+				GasLimit: 100_000,
+			})
+			s.Require().NoError(err)
+
+			ctx := s.Network.GetContext()
+			msg := tx.GetMsgs()[0].(*types.MsgEthereumTx)
+			res, err := evmKeeper.EthereumTx(ctx, msg)
+			s.Require().NoError(err)
+			s.Require().False(res.Failed(), res.VmError)
+			contract := crypto.CreateAddress(s.Keyring.GetAddr(0), msg.AsTransaction().Nonce())
+
+			tx, err = s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{
+				Nonce:    msg.AsTransaction().Nonce() + 1,
+				To:       &contract,
+				Amount:   big.NewInt(1e18),
+				GasLimit: 100_000,
+			})
+			s.Require().NoError(err)
+
+			balance := evmKeeper.GetBalance(ctx, beneficiary)
+			res, err = evmKeeper.EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+			s.Require().NoError(err)
+			s.Require().False(res.Failed(), res.VmError)
+
+			// the value is sent to the beneficiary in both cases
+			s.Require().Equal(new(big.Int).Add(balance.ToBig(), big.NewInt(1e18)), evmKeeper.GetBalance(ctx, beneficiary).ToBig())
+			s.Require().True(evmKeeper.GetBalance(ctx, contract).IsZero())
+			codeHash := evmKeeper.GetCodeHash(ctx, contract)
+			s.Require().Equal(tc.expDelete, types.IsEmptyCodeHash(codeHash.Bytes()))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEthereumTxSelfDestructInCreation() {
+	s.SetupTest()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	beneficiary := s.Keyring.GetAddr(1)
+	// init code: PUSH20 <beneficiary> SELFDESTRUCT
+	initCode := append(append([]byte{0x73}, beneficiary.Bytes()...), 0xff)
+	tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{
+		Amount:   big.NewInt(1e18),
+		Input:    initCode,
+		GasLimit: 100_000,
+	})
+	s.Require().NoError(err)
+
+	ctx := s.Network.GetContext()
+	msg := tx.GetMsgs()[0].(*types.MsgEthereumTx)
+	balance := evmKeeper.GetBalance(ctx, beneficiary)
+	res, err := evmKeeper.EthereumTx(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().False(res.Failed(), res.VmError)
+
+	// the contract self-destructed in its creation tx is deleted after EIP-6780
+	contract := crypto.CreateAddress(s.Keyring.GetAddr(0), msg.AsTransaction().Nonce())
+	s.Require().Nil(evmKeeper.GetAccount(ctx, contract))
+	s.Require().Equal(new(big.Int).Add(balance.ToBig(), big.NewInt(1e18)), evmKeeper.GetBalance(ctx, beneficiary).ToBig())
+}
+
 func (s *KeeperTestSuite) TestUpdateParams() {
 	s.SetupTest()
 	testCases := []struct {
@@ -178,3 +380,368 @@ func (s *KeeperTestSuite) TestRegisterPreinstalls() {
 		s.Require().NoError(err)
 	}
 }
+
+func (s *KeeperTestSuite) TestRecoverStuckFunds() {
+	s.SetupTest()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := s.Keyring.GetAccAddr(1)
+	amount := sdktypes.NewCoins(sdktypes.NewInt64Coin(types.GetEVMCoinDenom(), 1e18))
+
+	testCases := []struct {
+		name        string
+		getMsg      func() *types.MsgRecoverStuckFunds
+		expectedErr error
+	}{
+		{
+			name: "fail - invalid authority",
+			getMsg: func() *types.MsgRecoverStuckFunds {
+				return &types.MsgRecoverStuckFunds{Authority: "foobar"}
+			},
+			expectedErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "fail - unknown module account",
+			getMsg: func() *types.MsgRecoverStuckFunds {
+				return &types.MsgRecoverStuckFunds{
+					Authority:  authority,
+					ModuleName: "foobar",
+					Recipient:  recipient.String(),
+					Amount:     amount,
+				}
+			},
+			expectedErr: errortypes.ErrUnauthorized,
+		},
+		{
+			name: "fail - module account not recoverable",
+			getMsg: func() *types.MsgRecoverStuckFunds {
+				return &types.MsgRecoverStuckFunds{
+					Authority:  authority,
+					ModuleName: stakingtypes.BondedPoolName,
+					Recipient:  recipient.String(),
+					Amount:     amount,
+				}
+			},
+			expectedErr: errortypes.ErrUnauthorized,
+		},
+		{
+			name: "pass - recover the stuck funds",
+			getMsg: func() *types.MsgRecoverStuckFunds {
+				ctx := s.Network.GetContext()
+				err := testutil.FundModuleAccount(ctx, s.Network.App.GetBankKeeper(), minttypes.ModuleName, amount)
+				s.Require().NoError(err)
+				return &types.MsgRecoverStuckFunds{
+					Authority:  authority,
+					ModuleName: minttypes.ModuleName,
+					Recipient:  recipient.String(),
+					Amount:     amount,
+				}
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run("MsgRecoverStuckFunds_"+tc.name, func() {
+			msg := tc.getMsg()
+			ctx := s.Network.GetContext()
+			balance := s.Network.App.GetBankKeeper().GetBalance(ctx, recipient, types.GetEVMCoinDenom())
+			_, err := s.Network.App.GetEVMKeeper().RecoverStuckFunds(ctx, msg)
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Contains(err.Error(), tc.expectedErr.Error())
+			} else {
+				s.Require().NoError(err)
+				newBalance := s.Network.App.GetBankKeeper().GetBalance(ctx, recipient, types.GetEVMCoinDenom())
+				s.Require().Equal(balance.Add(amount[0]), newBalance)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEthereumTxBatch() {
+	amount := big.NewInt(1e18)
+
+	transfer := types.EvmTxArgs{Amount: amount, GasLimit: 100_000}
+	// a contract creation whose init code stops the execution
+	create := types.EvmTxArgs{Input: []byte{byte(vm.STOP)}, GasLimit: 100_000}
+	// a contract creation whose init code is the INVALID opcode
+	failedCreate := types.EvmTxArgs{Input: []byte{byte(vm.INVALID)}, GasLimit: 100_000}
+
+	testCases := []struct {
+		name        string
+		txArgs      []types.EvmTxArgs
+		expPass     bool
+		expTransfer int64
+	}{
+		{
+			"success - batch of transfers",
+			[]types.EvmTxArgs{transfer, transfer},
+			true,
+			2,
+		},
+		{
+			"success - contract creation and transfer",
+			[]types.EvmTxArgs{create, transfer},
+			true,
+			1,
+		},
+		{
+			"fail - a failed tx reverts the batch",
+			[]types.EvmTxArgs{transfer, failedCreate},
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			evmKeeper := s.Network.App.GetEVMKeeper()
+			txConfig := s.Network.GetEncodingConfig().TxConfig
+			sender := s.Keyring.GetKey(0)
+			recipient := s.Keyring.GetAddr(1)
+
+			ctx := s.Network.GetContext()
+			nonce := evmKeeper.GetNonce(ctx, sender.Addr)
+			prevBalance := evmKeeper.GetBalance(ctx, recipient)
+
+			msgs := make([]sdktypes.Msg, len(tc.txArgs))
+			for i, args := range tc.txArgs {
+				args.Nonce = nonce + uint64(i) //nolint:gosec // G115
+				if args.Amount != nil {
+					args.To = &recipient
+				}
+				msg, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, args)
+				s.Require().NoError(err)
+				msgs[i] = &msg
+			}
+			tx, err := testutiltx.PrepareEthTx(txConfig, nil, msgs...)
+			s.Require().NoError(err)
+			txBytes, err := txConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			blockRes, err := s.Network.NextBlockWithTxs(txBytes)
+			s.Require().NoError(err)
+			txRes := blockRes.TxResults[0]
+
+			ctx = s.Network.GetContext()
+			// the nonces are incremented in any case
+			s.Require().Equal(nonce+uint64(len(msgs)), evmKeeper.GetNonce(ctx, sender.Addr))
+
+			expBalance := new(big.Int).Add(prevBalance.ToBig(), new(big.Int).Mul(amount, big.NewInt(tc.expTransfer)))
+			s.Require().Equal(expBalance, evmKeeper.GetBalance(ctx, recipient).ToBig())
+
+			if !tc.expPass {
+				s.Require().Equal(types.ErrBatchedTxFailed.ABCICode(), txRes.Code, txRes.Log)
+				s.Require().Equal(types.ErrBatchedTxFailed.Codespace(), txRes.Codespace)
+
+				// all the txs of the batch are part of the block with a failed
+				// receipt, as the fees of their gas limit are charged
+				encodingConfig := s.Network.GetEncodingConfig()
+				clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+				idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+				block := &cmttypes.Block{
+					Header: cmttypes.Header{Height: ctx.BlockHeight()},
+					Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBytes}},
+				}
+				s.Require().NoError(idxer.IndexBlock(block, blockRes.TxResults))
+				for i, msg := range msgs {
+					ethMsg := msg.(*types.MsgEthereumTx)
+					res, err := idxer.GetByTxHash(common.HexToHash(ethMsg.Hash))
+					s.Require().NoError(err)
+					s.Require().True(res.Failed)
+					s.Require().Equal(ethMsg.GetGas(), res.GasUsed)
+					s.Require().Equal(uint32(i), res.MsgIndex) //nolint:gosec // G115
+				}
+				return
+			}
+
+			s.Require().Equal(uint32(0), txRes.Code, txRes.Log)
+
+			// one receipt per message
+			var txData sdktypes.TxMsgData
+			s.Require().NoError(s.Network.GetEncodingConfig().Codec.Unmarshal(txRes.Data, &txData))
+			s.Require().Len(txData.MsgResponses, len(msgs))
+			for i, msgRes := range txData.MsgResponses {
+				var res types.MsgEthereumTxResponse
+				s.Require().NoError(proto.Unmarshal(msgRes.Value, &res))
+				s.Require().False(res.Failed())
+				s.Require().Equal(msgs[i].(*types.MsgEthereumTx).Hash, res.Hash)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMixedTx() {
+	amount := big.NewInt(1e18)
+	gasLimit := uint64(500_000)
+
+	testCases := []struct {
+		name    string
+		ethArgs types.EvmTxArgs
+		expPass bool
+	}{
+		{
+			"success - bank send and eth transfer",
+			types.EvmTxArgs{Amount: amount, GasLimit: 100_000},
+			true,
+		},
+		{
+			"fail - a failed eth tx reverts the bank send",
+			// a contract creation whose init code is the INVALID opcode
+			types.EvmTxArgs{Input: []byte{byte(vm.INVALID)}, GasLimit: 100_000},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			evmKeeper := s.Network.App.GetEVMKeeper()
+			sender := s.Keyring.GetKey(0)
+			recipient := s.Keyring.GetKey(1)
+
+			ctx := s.Network.GetContext()
+			nonce := evmKeeper.GetNonce(ctx, sender.Addr)
+			prevBalance := evmKeeper.GetBalance(ctx, recipient.Addr)
+
+			// the eth tx is signed with the sequence of the cosmos tx as nonce
+			// and doesn't pay fees
+			ethArgs := tc.ethArgs
+			ethArgs.Nonce = nonce
+			ethArgs.GasPrice = big.NewInt(0)
+			if ethArgs.Amount != nil {
+				ethArgs.To = &recipient.Addr
+			}
+			ethMsg, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, ethArgs)
+			s.Require().NoError(err)
+
+			sendAmount := sdkmath.NewInt(1e18)
+			bankMsg := banktypes.NewMsgSend(
+				sender.AccAddr,
+				recipient.AccAddr,
+				sdktypes.NewCoins(sdktypes.NewCoin(s.Network.GetBaseDenom(), sendAmount)),
+			)
+
+			txRes, err := s.Factory.CommitCosmosTx(sender.Priv, commonfactory.CosmosTxArgs{
+				Gas:  &gasLimit,
+				Msgs: []sdktypes.Msg{bankMsg, &ethMsg},
+			})
+			s.Require().NoError(err)
+
+			ctx = s.Network.GetContext()
+			// the sequence is incremented once
+			s.Require().Equal(nonce+1, evmKeeper.GetNonce(ctx, sender.Addr))
+
+			if !tc.expPass {
+				s.Require().NotEqual(uint32(0), txRes.Code)
+				s.Require().Contains(txRes.Log, types.ErrVMExecution.Error())
+				s.Require().Equal(prevBalance, evmKeeper.GetBalance(ctx, recipient.Addr))
+				return
+			}
+
+			s.Require().Equal(uint32(0), txRes.Code, txRes.Log)
+
+			// the recipient received the bank send and the eth transfer
+			expBalance := new(big.Int).Add(prevBalance.ToBig(), new(big.Int).Add(sendAmount.BigInt(), amount))
+			s.Require().Equal(expBalance, evmKeeper.GetBalance(ctx, recipient.Addr).ToBig())
+
+			var txData sdktypes.TxMsgData
+			s.Require().NoError(s.Network.GetEncodingConfig().Codec.Unmarshal(txRes.Data, &txData))
+			s.Require().Len(txData.MsgResponses, 2)
+			var res types.MsgEthereumTxResponse
+			s.Require().NoError(proto.Unmarshal(txData.MsgResponses[1].Value, &res))
+			s.Require().False(res.Failed())
+			s.Require().Equal(ethMsg.Hash, res.Hash)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestContractStorageExpiry() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	owner := s.Keyring.GetAddr(0)
+	sender := s.Keyring.GetAccAddr(0).String()
+
+	params := evmKeeper.GetParams(ctx)
+	params.StorageExpiryBlocks = 10
+	s.Require().NoError(evmKeeper.SetParams(ctx, params))
+
+	supply := big.NewInt(100)
+	contractAddr := s.DeployTestContract(s.T(), ctx, owner, supply)
+	accessHeight, ok := evmKeeper.GetContractAccessHeight(ctx, contractAddr)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(ctx.BlockHeight()), accessHeight)
+
+	storage := evmKeeper.GetAccountStorage(ctx, contractAddr)
+	s.Require().Greater(len(storage), 1)
+
+	// the storage can't be archived before the expiry blocks
+	archiveMsg := &types.MsgArchiveContractStorage{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Address:   contractAddr.Hex(),
+	}
+	_, err := evmKeeper.ArchiveContractStorage(ctx, archiveMsg)
+	s.Require().ErrorIs(err, errortypes.ErrInvalidRequest)
+
+	// only the governance can archive a storage
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = evmKeeper.ArchiveContractStorage(ctx, &types.MsgArchiveContractStorage{Authority: sender, Address: contractAddr.Hex()})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	archiveRes, err := evmKeeper.ArchiveContractStorage(ctx, archiveMsg)
+	s.Require().NoError(err)
+	s.Require().Equal(storage.Root().Hex(), archiveRes.StorageRoot)
+	s.Require().Equal(uint64(len(storage)), archiveRes.Entries)
+	s.Require().Empty(evmKeeper.GetAccountStorage(ctx, contractAddr))
+
+	_, err = evmKeeper.ArchiveContractStorage(ctx, archiveMsg)
+	s.Require().ErrorIs(err, types.ErrContractStorageArchived)
+
+	// the executions accessing the archived storage fail
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+	_, err = evmKeeper.CallEVM(ctx, erc20Contract.ABI, owner, contractAddr, false, nil, "balanceOf", owner)
+	s.Require().ErrorContains(err, types.ErrContractStorageArchived.Error())
+
+	// the entries are only restored with the proofs of their inclusion in the root
+	proofs := storage.Proofs()
+	invalid := proofs[0]
+	invalid.State.Value = common.Hash{}.Hex()
+	restoreMsg := &types.MsgRestoreContractStorage{Sender: sender, Address: contractAddr.Hex(), Entries: []types.StorageProof{invalid}}
+	_, err = evmKeeper.RestoreContractStorage(ctx, restoreMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidState)
+
+	// the storage is restored in chunks, and stays archived until it's restored
+	restoreMsg.Entries = proofs[:1]
+	restoreRes, err := evmKeeper.RestoreContractStorage(ctx, restoreMsg)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(len(storage)-1), restoreRes.Remaining)
+
+	// the restored entries aren't counted twice
+	restoreRes, err = evmKeeper.RestoreContractStorage(ctx, restoreMsg)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(len(storage)-1), restoreRes.Remaining)
+
+	_, err = evmKeeper.CallEVM(ctx, erc20Contract.ABI, owner, contractAddr, false, nil, "balanceOf", owner)
+	s.Require().ErrorContains(err, types.ErrContractStorageArchived.Error())
+
+	restoreMsg.Entries = proofs
+	restoreRes, err = evmKeeper.RestoreContractStorage(ctx, restoreMsg)
+	s.Require().NoError(err)
+	s.Require().Zero(restoreRes.Remaining)
+	s.Require().Equal(storage, evmKeeper.GetAccountStorage(ctx, contractAddr))
+	_, archived := evmKeeper.GetArchivedStorage(ctx, contractAddr)
+	s.Require().False(archived)
+
+	accessHeight, ok = evmKeeper.GetContractAccessHeight(ctx, contractAddr)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(ctx.BlockHeight()), accessHeight)
+
+	res, err := evmKeeper.CallEVM(ctx, erc20Contract.ABI, owner, contractAddr, false, nil, "balanceOf", owner)
+	s.Require().NoError(err)
+	balance, err := erc20Contract.ABI.Unpack("balanceOf", res.Ret)
+	s.Require().NoError(err)
+	s.Require().Equal(supply, balance[0])
+}
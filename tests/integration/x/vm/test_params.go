@@ -1,8 +1,15 @@
 package vm
 
 import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+
 	"github.com/cosmos/evm/testutil/config"
 	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestParams() {
@@ -101,12 +108,118 @@ func (s *KeeperTestSuite) TestParams() {
 			},
 			expected: true,
 		},
+		{
+			name: "success - EVM chain id param matching the chain config is set",
+			paramsFun: func() interface{} {
+				params := defaultChainEVMParams
+				params.EVMChainID = types.GetEthChainConfig().ChainID.Uint64()
+				err := s.Network.App.GetEVMKeeper().SetParams(s.Network.GetContext(), params)
+				s.Require().NoError(err)
+				return params.EVMChainID
+			},
+			getFun: func() interface{} {
+				return s.Network.App.GetEVMKeeper().GetParams(s.Network.GetContext()).EVMChainID
+			},
+			expected: true,
+		},
+		{
+			name: "fail - EVM chain id param not matching the chain config is rejected",
+			paramsFun: func() interface{} {
+				params := defaultChainEVMParams
+				params.EVMChainID = types.GetEthChainConfig().ChainID.Uint64() + 1
+				err := s.Network.App.GetEVMKeeper().SetParams(s.Network.GetContext(), params)
+				s.Require().ErrorContains(err, "doesn't match the evm chain config chain id")
+				return params.EVMChainID
+			},
+			getFun: func() interface{} {
+				return s.Network.App.GetEVMKeeper().GetParams(s.Network.GetContext()).EVMChainID
+			},
+			expected: false,
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			s.SetupTest()
 
-			s.Require().Equal(tc.paramsFun(), tc.getFun(), "expected different params")
+			if tc.expected {
+				s.Require().Equal(tc.paramsFun(), tc.getFun(), "expected different params")
+			} else {
+				s.Require().NotEqual(tc.paramsFun(), tc.getFun(), "expected params not to be set")
+			}
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetBlockParams() {
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+
+	evmParams := evmKeeper.GetBlockParams(ctx)
+	s.Require().Equal(evmKeeper.GetParams(ctx), evmParams)
+
+	// the updated evmParams are returned for the rest of the block
+	evmParams.ExtraEIPs = []int64{}
+	evmParams.AllowUnprotectedTxs = !evmParams.AllowUnprotectedTxs
+	s.Require().NoError(evmKeeper.SetParams(ctx, evmParams))
+	s.Require().Equal(evmKeeper.GetParams(ctx), evmKeeper.GetBlockParams(ctx))
+
+	// the reverted update isn't cached either
+	cacheCtx, _ := ctx.CacheContext()
+	evmParams.AllowUnprotectedTxs = !evmParams.AllowUnprotectedTxs
+	s.Require().NoError(evmKeeper.SetParams(cacheCtx, evmParams))
+	s.Require().Equal(evmParams.AllowUnprotectedTxs, evmKeeper.GetBlockParams(cacheCtx).AllowUnprotectedTxs)
+	s.Require().Equal(!evmParams.AllowUnprotectedTxs, evmKeeper.GetBlockParams(ctx).AllowUnprotectedTxs)
+
+	// the cache is loaded again by the next block
+	s.Require().NoError(s.Network.NextBlock())
+	ctx = s.Network.GetContext()
+	s.Require().Equal(evmKeeper.GetParams(ctx), evmKeeper.GetBlockParams(ctx))
+}
+
+func (s *KeeperTestSuite) TestGetBlockRules() {
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+
+	expRules := func(ctx sdk.Context) params.Rules {
+		return types.GetEthChainConfig().Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	}
+
+	s.Require().Equal(expRules(ctx), evmKeeper.GetBlockRules(ctx))
+	s.Require().Equal(expRules(ctx), evmKeeper.GetBlockRules(ctx))
+
+	// the rules of an overridden block time aren't the cached ones
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	s.Require().Equal(expRules(ctx), evmKeeper.GetBlockRules(ctx))
+}
+
+func (s *KeeperTestSuite) TestValidateEVMChainID() {
+	s.SetupTest()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+
+	// an unpinned evm chain id matches any chain config
+	s.Require().NoError(evmKeeper.ValidateEVMChainID(ctx))
+
+	evmParams := evmKeeper.GetParams(ctx)
+	evmParams.EVMChainID = types.GetEthChainConfig().ChainID.Uint64()
+	s.Require().NoError(evmKeeper.SetParams(ctx, evmParams))
+	s.Require().NoError(evmKeeper.ValidateEVMChainID(ctx))
+
+	// a node configured with the evm chain id of another chain is rejected
+	denom := types.GetEVMCoinDenom()
+	extendedDenom := types.GetEVMCoinExtendedDenom()
+	decimals := types.GetEVMCoinDecimals()
+	configurator := types.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	s.Require().NoError(configurator.
+		WithChainConfig(types.DefaultChainConfig(evmParams.EVMChainID + 1)).
+		WithEVMCoinInfo(types.EvmCoinInfo{Denom: denom, ExtendedDenom: extendedDenom, Decimals: decimals}).
+		Configure())
+	defer s.configureEVM()
+
+	err := evmKeeper.ValidateEVMChainID(ctx)
+	s.Require().ErrorIs(err, types.ErrInvalidChainConfig)
+	// a node restored from a snapshot halts on its first block
+	err = evmKeeper.BeginBlock(ctx)
+	s.Require().ErrorIs(err, types.ErrInvalidChainConfig)
+}
@@ -0,0 +1,43 @@
+package vm
+
+import (
+	"os"
+
+	"github.com/cosmos/evm/testutil/statetests"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// TestStateTests runs the bundled state tests against the keeper, and the
+// Ethereum state tests of the directory of EVM_STATE_TESTS_DIR if it's set,
+// e.g. the GeneralStateTests of the ethereum/tests or the state_tests of the
+// execution-spec-tests fixtures. Only the bundled tests must pass, the pass
+// rates of the others are logged to track the equivalence of the EVM.
+func (s *KeeperTestSuite) TestStateTests() {
+	ctx := s.Network.GetContext()
+	// the Ethereum execution charges the gas used only
+	feemarketParams := s.Network.App.GetFeeMarketKeeper().GetParams(ctx)
+	feemarketParams.MinGasMultiplier = sdkmath.LegacyZeroDec()
+	s.Require().NoError(s.Network.App.GetFeeMarketKeeper().SetParams(ctx, feemarketParams))
+
+	runner := statetests.NewRunner(s.Network.App.GetEVMKeeper(), s.Network.App.GetAccountKeeper())
+
+	bundled, err := statetests.LoadBundledStateTests()
+	s.Require().NoError(err)
+	report := runner.Run(ctx, bundled)
+	passed := 0
+	for fork, result := range report.Forks {
+		s.Require().Empty(result.Failures, "bundled state tests of %s failed", fork)
+		passed += result.Passed
+	}
+	s.Require().NotZero(passed, "no bundled state test ran:\n%s", report)
+
+	dir := os.Getenv("EVM_STATE_TESTS_DIR")
+	if dir == "" {
+		return
+	}
+	stateTests, err := statetests.LoadStateTests(dir)
+	s.Require().NoError(err)
+	report = runner.Run(ctx, stateTests)
+	s.T().Logf("state tests of %s:\n%s", dir, report)
+}
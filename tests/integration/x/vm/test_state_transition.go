@@ -1,26 +1,29 @@
 package vm
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/evm/testutil/chaos"
 	"github.com/cosmos/evm/testutil/config"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/testutil/integration/evm/utils"
 	testKeyring "github.com/cosmos/evm/testutil/keyring"
-	utiltx "github.com/cosmos/evm/testutil/tx"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	"github.com/cosmos/evm/x/vm/keeper"
 	"github.com/cosmos/evm/x/vm/types"
@@ -61,7 +64,7 @@ func (s *KeeperTestSuite) TestGetHashFn() {
 			uint64(s.Network.GetContext().BlockHeight()), //nolint:gosec // G115
 			func() sdk.Context {
 				header := tmproto.Header{}
-				header.Height = s.Network.GetContext().BlockHeight()
+				header.Height = h.Height
 				return s.Network.GetContext().WithBlockHeader(header)
 			},
 			common.Hash{},
@@ -76,33 +79,51 @@ func (s *KeeperTestSuite) TestGetHashFn() {
 		},
 		{
 			"case 2.1: height lower than current one, hist info not found",
-			1,
+			300,
 			func() sdk.Context {
-				return s.Network.GetContext().WithBlockHeight(10)
+				return s.Network.GetContext().WithBlockHeight(310)
 			},
 			common.Hash{},
 		},
 		{
 			"case 2.2: height lower than current one, invalid hist info header",
-			1,
+			300,
 			func() sdk.Context {
-				s.Require().NoError(s.Network.App.GetStakingKeeper().SetHistoricalInfo(s.Network.GetContext(), 1, &stakingtypes.HistoricalInfo{}))
-				return s.Network.GetContext().WithBlockHeight(10)
+				s.Require().NoError(s.Network.App.GetStakingKeeper().SetHistoricalInfo(s.Network.GetContext(), 300, &stakingtypes.HistoricalInfo{}))
+				return s.Network.GetContext().WithBlockHeight(310)
 			},
 			common.Hash{},
 		},
 		{
 			"case 2.3: height lower than current one, calculated from hist info header",
-			1,
+			300,
 			func() sdk.Context {
 				histInfo := &stakingtypes.HistoricalInfo{
 					Header: header,
 				}
-				s.Require().NoError(s.Network.App.GetStakingKeeper().SetHistoricalInfo(s.Network.GetContext(), 1, histInfo))
-				return s.Network.GetContext().WithBlockHeight(10)
+				s.Require().NoError(s.Network.App.GetStakingKeeper().SetHistoricalInfo(s.Network.GetContext(), 300, histInfo))
+				return s.Network.GetContext().WithBlockHeight(310)
+			},
+			common.BytesToHash(hash),
+		},
+		{
+			"case 2.4: height lower than current one, hist info pruned, served from the history contract",
+			4000,
+			func() sdk.Context {
+				s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), params.HistoryStorageAddress, common.BigToHash(big.NewInt(4000)), hash)
+				return s.Network.GetContext().WithBlockHeight(5000)
 			},
 			common.BytesToHash(hash),
 		},
+		{
+			"case 2.5: height lower than current one, hist info pruned, out of the history window",
+			4000,
+			func() sdk.Context {
+				s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), params.HistoryStorageAddress, common.BigToHash(big.NewInt(4000)), hash)
+				return s.Network.GetContext().WithBlockHeight(4000 + int64(params.HistoryServeWindow))
+			},
+			common.Hash{},
+		},
 		{
 			"case 3: height greater than current one",
 			200,
@@ -261,7 +282,6 @@ func (s *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			ethCfg := types.GetEthChainConfig()
 			ethCfg.HomesteadBlock = big.NewInt(2)
 			ethCfg.IstanbulBlock = big.NewInt(3)
-			signer := gethtypes.LatestSignerForChainID(ethCfg.ChainID)
 
 			// in the future, fork not enabled
 			shanghaiTime := uint64(s.Network.GetContext().BlockTime().Unix()) + 10000 //#nosec G115 -- int overflow is not a concern here
@@ -270,13 +290,10 @@ func (s *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			ctx := s.Network.GetContext().WithBlockHeight(tc.height)
 
 			addr := s.Keyring.GetAddr(0)
-			krSigner := utiltx.NewSigner(s.Keyring.GetPrivKey(0))
 			nonce := s.Network.App.GetEVMKeeper().GetNonce(ctx, addr)
 			m, err := newNativeMessage(
 				nonce,
-				addr,
-				krSigner,
-				signer,
+				s.Keyring.GetPrivKey(0),
 				gethtypes.AccessListTxType,
 				tc.data,
 				tc.accessList,
@@ -479,6 +496,55 @@ func (s *KeeperTestSuite) TestRefundGas() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRefundGasToFeePayer() {
+	baseDenom := types.GetEVMCoinDenom()
+
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(baseDenom, sdkmath.NewInt(6e18))),
+		},
+	}
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[banktypes.ModuleName] = bankGenesis
+
+	Keyring := testKeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(Keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	sender := Keyring.GetKey(0)
+	sponsor := Keyring.GetAddr(1)
+
+	coreMsg, err := txFactory.GenerateGethCoreMsg(
+		sender.Priv,
+		types.EvmTxArgs{To: &sponsor, GasPrice: big.NewInt(1e9)},
+	)
+	s.Require().NoError(err)
+
+	ctx := unitNetwork.GetContext()
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+	evmKeeper.SetTransientFeePayer(ctx, sponsor)
+
+	prevSenderBalance := evmKeeper.GetBalance(ctx, sender.Addr)
+	prevSponsorBalance := evmKeeper.GetBalance(ctx, sponsor)
+
+	err = evmKeeper.RefundGas(ctx, *coreMsg, 1000, unitNetwork.GetBaseDenom())
+	s.Require().NoError(err)
+
+	// the leftover gas is refunded to the sponsor that paid the fees
+	s.Require().Equal(prevSenderBalance, evmKeeper.GetBalance(ctx, sender.Addr))
+	s.Require().Equal(
+		new(big.Int).Add(prevSponsorBalance.ToBig(), big.NewInt(1000*1e9)),
+		evmKeeper.GetBalance(ctx, sponsor).ToBig(),
+	)
+}
+
 func (s *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	s.SetupTest()
 	testCases := []struct {
@@ -537,6 +603,62 @@ func (s *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCheckBlockGasLimit() {
+	s.SetupTest()
+	testCases := []struct {
+		name          string
+		blockConsumed uint64
+		gasUsed       uint64
+		expErr        bool
+		expGasUsed    uint64
+	}{
+		{
+			"tx gas fits in the block",
+			50,
+			50,
+			false,
+			50,
+		},
+		{
+			"tx gas exceeds the gas left in the block",
+			60,
+			50,
+			true,
+			40,
+		},
+		{
+			"no gas left in the block",
+			100,
+			50,
+			true,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			blockGasMeter := storetypes.NewGasMeter(100)
+			blockGasMeter.ConsumeGas(tc.blockConsumed, "")
+			ctx := s.Network.GetContext().
+				WithBlockGasMeter(blockGasMeter).
+				WithGasMeter(storetypes.NewInfiniteGasMeter())
+			ctx.GasMeter().ConsumeGas(tc.gasUsed, "")
+
+			err := s.Network.App.GetEVMKeeper().CheckBlockGasLimit(ctx)
+			if tc.expErr {
+				s.Require().ErrorIs(err, types.ErrBlockGasLimitExceeded)
+			} else {
+				s.Require().NoError(err)
+			}
+			s.Require().Equal(tc.expGasUsed, ctx.GasMeter().GasConsumed())
+			// the block gas meter doesn't panic when the SDK consumes the gas of the tx
+			s.Require().NotPanics(func() {
+				blockGasMeter.ConsumeGas(ctx.GasMeter().GasConsumedToLimit(), "block gas meter")
+			})
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestEVMConfig() {
 	s.SetupTest()
 
@@ -557,6 +679,25 @@ func (s *KeeperTestSuite) TestEVMConfig() {
 	s.Require().Equal(proposerHextAddress, cfg.CoinBase)
 }
 
+func (s *KeeperTestSuite) TestVMConfigScheduledEIPs() {
+	s.SetupTest()
+
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+	params := evmKeeper.GetParams(ctx)
+	params.ScheduledEIPs = []types.ScheduledEIP{{EIP: 1884, Height: ctx.BlockHeight() + 1}}
+	s.Require().NoError(evmKeeper.SetParams(ctx, params))
+
+	cfg, err := evmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err)
+
+	// the scheduled EIPs are only activated from their height
+	vmConfig := evmKeeper.VMConfig(ctx, core.Message{}, cfg, nil)
+	s.Require().NotContains(vmConfig.ExtraEips, 1884)
+	vmConfig = evmKeeper.VMConfig(ctx.WithBlockHeight(ctx.BlockHeight()+1), core.Message{}, cfg, nil)
+	s.Require().Contains(vmConfig.ExtraEips, 1884)
+}
+
 func (s *KeeperTestSuite) TestApplyTransaction() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -814,6 +955,39 @@ func (s *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (s *KeeperTestSuite) TestApplyMessageInterruptedMidExecution() {
+	s.SetupTest()
+
+	// the init code loops until the gas runs out
+	sender := s.Keyring.GetAddr(0)
+	loop := hexutil.Bytes{byte(vm.JUMPDEST), byte(vm.PUSH1), 0, byte(vm.JUMP)}
+	args := types.TransactionArgs{From: &sender, Data: &loop}
+
+	k := s.Network.App.GetEVMKeeper()
+	proposerAddress := s.Network.GetContext().BlockHeader().ProposerAddress
+	cfg, err := k.EVMConfig(s.Network.GetContext(), proposerAddress)
+	s.Require().NoError(err)
+	msg, err := args.ToMessage(1_000_000_000, cfg.BaseFee, true, true)
+	s.Require().NoError(err)
+
+	// the go context of the query is canceled after 1000 opcodes, which
+	// interrupts the execution long before the gas runs out
+	canceled, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx := s.Network.GetContext().WithContext(canceled)
+	tracer := chaos.CancelAfterOpcodes(1000, cancel)
+
+	_, err = k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, k.TxConfig(ctx, common.Hash{}))
+	s.Require().ErrorContains(err, types.ErrExecutionAborted.Error())
+	s.Require().ErrorContains(err, context.Canceled.Error())
+
+	// the EVM pooled after the interrupted execution isn't canceled
+	msg.GasLimit = 100_000
+	res, err := k.ApplyMessageWithConfig(s.Network.GetContext(), msg, nil, false, cfg, k.TxConfig(s.Network.GetContext(), common.Hash{}))
+	s.Require().NoError(err)
+	s.Require().Equal(vm.ErrOutOfGas.Error(), res.VmError)
+}
+
 func (s *KeeperTestSuite) TestGetProposerAddress() {
 	s.SetupTest()
 	address := sdk.ConsAddress(s.Keyring.GetAddr(0).Bytes())
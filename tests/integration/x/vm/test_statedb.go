@@ -518,6 +518,45 @@ func (s *KeeperTestSuite) TestSuicide() {
 	s.Require().False(db.HasSelfDestructed(secondAddress))
 }
 
+func (s *KeeperTestSuite) TestIterateStorage() {
+	s.SetupTest()
+	addr := s.Keyring.GetAddr(0)
+
+	vmdb := s.StateDB()
+	keys := make([]common.Hash, 5)
+	for i := range keys {
+		keys[i] = common.BigToHash(big.NewInt(int64(i + 1)))
+		vmdb.SetState(addr, keys[i], common.BigToHash(big.NewInt(int64(i+10))))
+	}
+	s.Require().NoError(vmdb.Commit())
+
+	// the storage is paginated in ascending key order
+	var (
+		iterated  []common.Hash
+		pageToken []byte
+		pages     int
+	)
+	for {
+		nextToken, err := s.Network.App.GetEVMKeeper().IterateStorage(s.Network.GetContext(), addr, pageToken, 2, func(key, _ common.Hash) bool {
+			iterated = append(iterated, key)
+			return true
+		})
+		s.Require().NoError(err)
+		pages++
+		if nextToken == nil {
+			break
+		}
+		pageToken = nextToken
+	}
+	s.Require().Equal(3, pages)
+	s.Require().Equal(keys, iterated)
+
+	_, err := s.Network.App.GetEVMKeeper().IterateStorage(s.Network.GetContext(), addr, []byte{1, 2}, 2, func(_, _ common.Hash) bool {
+		return true
+	})
+	s.Require().ErrorContains(err, "invalid storage page token length")
+}
+
 func (s *KeeperTestSuite) TestExist() {
 	testCases := []struct {
 		name     string
@@ -999,9 +1038,10 @@ func (s *KeeperTestSuite) TestDeleteAccount() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			s.SetupTest()
+			s.SetupTestFromCheckpoint("deploy test contract", func() {
+				contractAddr = s.DeployTestContract(s.T(), s.Network.GetContext(), s.Keyring.GetAddr(0), supply)
+			})
 			ctx = s.Network.GetContext()
-			contractAddr = s.DeployTestContract(s.T(), ctx, s.Keyring.GetAddr(0), supply)
 
 			addr := tc.malleate()
 
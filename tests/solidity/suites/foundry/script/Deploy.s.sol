@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.18;
+
+import {Counter} from "../src/Counter.sol";
+import {WithVm} from "../src/Vm.sol";
+
+// Deploy deploys the counter with the CREATE2 deployer preinstalled on the
+// chain, so that the fork tests know its address, and increments it.
+contract Deploy is WithVm {
+    function run() external returns (Counter counter) {
+        vm.startBroadcast(vm.envUint("PRIVATE_KEY"));
+        counter = new Counter{salt: COUNTER_SALT}();
+        counter.increment();
+        vm.stopBroadcast();
+    }
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.18;
+
+// Vm is the subset of the Foundry cheatcodes used by the scripts and the
+// tests, declared here so that the suite doesn't depend on forge-std.
+interface Vm {
+    function envUint(string calldata name) external view returns (uint256);
+
+    function startBroadcast(uint256 privateKey) external;
+
+    function stopBroadcast() external;
+
+    function addr(uint256 privateKey) external pure returns (address);
+}
+
+abstract contract WithVm {
+    Vm internal constant vm = Vm(address(uint160(uint256(keccak256("hevm cheat code")))));
+
+    // CREATE2_DEPLOYER is the deterministic deployment proxy preinstalled on
+    // the chain, used by Foundry to deploy the contracts with a salt.
+    address internal constant CREATE2_DEPLOYER = 0x4e59b44847b379578588920cA78FbF26c0B4956C;
+
+    bytes32 internal constant COUNTER_SALT = keccak256("cosmos-evm-foundry-counter");
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.18;
+
+import {Counter} from "../src/Counter.sol";
+
+contract CounterTest {
+    Counter internal counter;
+
+    function setUp() public {
+        counter = new Counter();
+    }
+
+    function testIncrement() public {
+        counter.increment();
+        require(counter.count() == 1, "count should be 1");
+    }
+
+    function testDecrementUnderflow() public {
+        try counter.decrement() {
+            revert("decrement should revert");
+        } catch (bytes memory reason) {
+            require(
+                keccak256(reason) == keccak256(abi.encodeWithSelector(Counter.CountUnderflow.selector, 0)),
+                "unexpected revert reason"
+            );
+        }
+    }
+}
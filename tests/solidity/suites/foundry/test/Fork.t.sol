@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.18;
+
+import {Counter} from "../src/Counter.sol";
+import {WithVm} from "../src/Vm.sol";
+
+// ForkTest runs against a fork of the node, after the Deploy script, when
+// FORK_TESTS is set.
+contract ForkTest is WithVm {
+    function counterAddress() internal pure returns (address) {
+        bytes32 hash = keccak256(
+            abi.encodePacked(bytes1(0xff), CREATE2_DEPLOYER, COUNTER_SALT, keccak256(type(Counter).creationCode))
+        );
+        return address(uint160(uint256(hash)));
+    }
+
+    function testForkedState() public {
+        if (vm.envUint("FORK_TESTS") == 0) {
+            return;
+        }
+
+        require(block.number > 0, "the fork should be at the latest block of the node");
+        require(CREATE2_DEPLOYER.code.length > 0, "the CREATE2 deployer should be preinstalled");
+
+        Counter counter = Counter(counterAddress());
+        require(address(counter).code.length > 0, "the counter should be deployed by the script");
+        require(counter.count() == 1, "the counter should be incremented by the script");
+
+        address deployer = vm.addr(vm.envUint("PRIVATE_KEY"));
+        require(deployer.balance > 0, "the deployer should have a balance");
+
+        // the forked state can be modified locally
+        counter.increment();
+        require(counter.count() == 2, "the counter should be incremented locally");
+    }
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.18;
+
+contract Counter {
+    uint256 public count;
+
+    event Incremented(address indexed sender, uint256 count);
+
+    error CountUnderflow(uint256 count);
+
+    function increment() public {
+        count++;
+        emit Incremented(msg.sender, count);
+    }
+
+    function decrement() public {
+        if (count == 0) {
+            revert CountUnderflow(count);
+        }
+        count--;
+    }
+}
@@ -0,0 +1,46 @@
+const { expect } = require('chai')
+const hre = require('hardhat')
+
+// The fork test runs on the in-process Hardhat network forked from the node,
+// which reads the state of the node at the fork block over the JSON-RPC.
+describe('Fork', function () {
+  const url = hre.config.networks.cosmos.url
+  const [privateKey] = hre.config.networks.cosmos.accounts
+
+  it('should fork the state of the node', async function () {
+    const provider = new hre.ethers.JsonRpcProvider(url)
+    const wallet = new hre.ethers.Wallet(privateKey, provider)
+
+    // deploy the counter on the node
+    const factory = await hre.ethers.getContractFactory('Counter', wallet)
+    const counter = await factory.deploy()
+    await counter.waitForDeployment()
+    const receipt = await (await counter.increment()).wait(1)
+    const address = await counter.getAddress()
+
+    await hre.network.provider.request({
+      method: 'hardhat_reset',
+      params: [{ forking: { jsonRpcUrl: url, blockNumber: receipt.blockNumber } }]
+    })
+
+    // the state of the node is available on the fork
+    const forked = await hre.ethers.getContractAt('Counter', address)
+    expect(await forked.count()).to.equal(1n)
+    expect(await hre.ethers.provider.getBalance(wallet.address)).to.equal(
+      await provider.getBalance(wallet.address, receipt.blockNumber)
+    )
+    expect(await hre.ethers.provider.getTransactionCount(wallet.address)).to.equal(
+      await provider.getTransactionCount(wallet.address, receipt.blockNumber)
+    )
+
+    // and can be modified locally
+    await hre.network.provider.request({
+      method: 'hardhat_impersonateAccount',
+      params: [wallet.address]
+    })
+    const impersonated = await hre.ethers.getSigner(wallet.address)
+    await forked.connect(impersonated).increment()
+    expect(await forked.count()).to.equal(2n)
+    expect(await counter.count()).to.equal(1n)
+  })
+})
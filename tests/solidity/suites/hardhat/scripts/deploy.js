@@ -0,0 +1,21 @@
+const hre = require('hardhat')
+
+// Deploys the counter and increments it, as the deployment scripts of the
+// Hardhat projects do.
+async function main () {
+  const counter = await hre.ethers.deployContract('Counter')
+  await counter.waitForDeployment()
+
+  const tx = await counter.increment()
+  const receipt = await tx.wait(1)
+  if (receipt.status !== 1) {
+    throw new Error(`increment failed: ${receipt.hash}`)
+  }
+
+  console.log(`Counter deployed at ${await counter.getAddress()} in block ${receipt.blockNumber}`)
+}
+
+main().catch((error) => {
+  console.error(error)
+  process.exitCode = 1
+})
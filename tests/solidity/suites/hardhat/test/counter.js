@@ -0,0 +1,58 @@
+const { expect } = require('chai')
+const hre = require('hardhat')
+
+describe('Counter', function () {
+  let counter
+  let signer
+
+  before(async function () {
+    ;[signer] = await hre.ethers.getSigners()
+    counter = await hre.ethers.deployContract('Counter')
+    await counter.waitForDeployment()
+  })
+
+  it('should deploy the contract code', async function () {
+    const code = await hre.ethers.provider.getCode(await counter.getAddress())
+    expect(code).to.not.equal('0x')
+    expect(await counter.count()).to.equal(0n)
+  })
+
+  it('should emit the events of the transactions', async function () {
+    await expect(counter.increment())
+      .to.emit(counter, 'Incremented')
+      .withArgs(signer.address, 1n)
+    expect(await counter.count()).to.equal(1n)
+
+    const events = await counter.queryFilter(counter.filters.Incremented(signer.address))
+    expect(events).to.have.lengthOf(1)
+    expect(events[0].args.count).to.equal(1n)
+  })
+
+  it('should return the custom errors of the reverts', async function () {
+    await counter.decrement()
+    await expect(counter.decrement())
+      .to.be.revertedWithCustomError(counter, 'CountUnderflow')
+      .withArgs(0n)
+  })
+
+  it('should estimate the gas and the fees of the transactions', async function () {
+    const gas = await counter.increment.estimateGas()
+    expect(gas > 21000n).to.equal(true)
+
+    const feeData = await hre.ethers.provider.getFeeData()
+    expect(feeData.maxFeePerGas > 0n).to.equal(true)
+    expect(feeData.maxPriorityFeePerGas >= 0n).to.equal(true)
+  })
+
+  it('should return the blocks by tag', async function () {
+    const latest = await hre.ethers.provider.getBlock('latest')
+    for (const tag of ['earliest', 'safe', 'finalized', 'pending']) {
+      const block = await hre.ethers.provider.getBlock(tag)
+      expect(block, tag).to.not.equal(null)
+      expect(block.number <= latest.number + 1, tag).to.equal(true)
+    }
+
+    const chainId = await hre.network.provider.send('eth_chainId')
+    expect(BigInt(chainId)).to.equal(BigInt(hre.network.config.chainId))
+  })
+})
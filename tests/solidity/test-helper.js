@@ -113,21 +113,23 @@ function restoreHardhatConfig(hardhatConfigPath, backupPath) {
   }
 }
 
-// Function to sync configuration from Go to Hardhat
+// Function to sync configuration from Go to the Hardhat configs of the suites
 function syncConfiguration() {
   // Adjust these paths based on your project structure
   const goConfigPath = path.join(__dirname, '../../evmd/cmd/evmd/config/config.go')
-  const hardhatConfigPath = path.join(__dirname, './suites/precompiles/hardhat.config.js')
+  const hardhatConfigPaths = ['precompiles', 'hardhat'].map((suite) =>
+    path.join(__dirname, 'suites', suite, 'hardhat.config.js')
+  )
 
   logger.info('Syncing configuration from Go to Hardhat...')
 
-  // Create backup before modifying
-  const backupPath = backupHardhatConfig(hardhatConfigPath)
-
   const chainId = extractChainIDFromGo(goConfigPath)
-  updateHardhatConfig(chainId, hardhatConfigPath)
-
-  return { hardhatConfigPath, backupPath }
+  return hardhatConfigPaths.map((hardhatConfigPath) => {
+    // Create backup before modifying
+    const backupPath = backupHardhatConfig(hardhatConfigPath)
+    updateHardhatConfig(chainId, hardhatConfigPath)
+    return { hardhatConfigPath, backupPath }
+  })
 }
 
 function checkTestEnv () {
@@ -381,8 +383,8 @@ async function main () {
     throw error
   } finally {
     // Always restore the original config, even if tests fail
-    if (configPaths) {
-      restoreHardhatConfig(configPaths.hardhatConfigPath, configPaths.backupPath)
+    for (const { hardhatConfigPath, backupPath } of configPaths) {
+      restoreHardhatConfig(hardhatConfigPath, backupPath)
     }
 
     if (proc) {
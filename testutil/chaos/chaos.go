@@ -0,0 +1,126 @@
+// Package chaos injects faults in the dependencies of the JSON-RPC server and
+// of the EVM indexer, i.e. the CometBFT RPC client, the indexer DB and the go
+// context of the EVM executions, so that the tests can exercise their error
+// paths.
+//
+// The faults are registered by method name in an Injector shared by the
+// wrapped dependencies, and can be injected or cleared while the test network
+// is running:
+//
+//	faults := chaos.NewInjector()
+//	client := chaos.NewCometRPC(client, faults)
+//	faults.Inject("BlockResults", chaos.Fault{Delay: time.Second})
+package chaos
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrInjected is an error to inject, to tell the injected faults from the
+// other errors.
+var ErrInjected = errors.New("injected fault")
+
+// Fault is a fault injected in the calls of a method.
+type Fault struct {
+	// Delay delays the calls. A call fails with the error of its context if
+	// the context is done before the delay.
+	Delay time.Duration
+	// Err fails the calls after the delay.
+	Err error
+	// Times is the number of calls faulted, all the calls if zero.
+	Times int
+}
+
+// Injector injects the faults in the calls of the methods of the wrapped
+// dependencies. It is safe for concurrent use.
+type Injector struct {
+	mu     sync.Mutex
+	faults map[string]*Fault
+	// faulted is the number of faulted calls of each method
+	faulted map[string]int
+}
+
+// NewInjector returns an injector without faults.
+func NewInjector() *Injector {
+	return &Injector{
+		faults:  make(map[string]*Fault),
+		faulted: make(map[string]int),
+	}
+}
+
+// Inject injects the fault in the next calls of the method, replacing its
+// previous fault.
+func (i *Injector) Inject(method string, fault Fault) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.faults[method] = &fault
+}
+
+// Clear clears the fault of the method.
+func (i *Injector) Clear(method string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.faults, method)
+}
+
+// Reset clears the faults of all the methods and their number of faulted
+// calls.
+func (i *Injector) Reset() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	clear(i.faults)
+	clear(i.faulted)
+}
+
+// Faulted returns the number of faulted calls of the method.
+func (i *Injector) Faulted(method string) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.faulted[method]
+}
+
+// apply applies the fault of the method to a call, returning the error to
+// fail the call with.
+func (i *Injector) apply(ctx context.Context, method string) error {
+	fault, found := i.next(method)
+	if !found {
+		return nil
+	}
+
+	if fault.Delay > 0 {
+		timer := time.NewTimer(fault.Delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return fault.Err
+}
+
+// next returns the fault of the next call of the method.
+func (i *Injector) next(method string) (Fault, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	fault, found := i.faults[method]
+	if !found {
+		return Fault{}, false
+	}
+	if fault.Times > 0 {
+		fault.Times--
+		if fault.Times == 0 {
+			delete(i.faults, method)
+		}
+	}
+	i.faulted[method]++
+	return *fault, true
+}
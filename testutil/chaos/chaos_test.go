@@ -0,0 +1,116 @@
+package chaos_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	"github.com/cosmos/evm/testutil/chaos"
+)
+
+func TestCometRPC(t *testing.T) {
+	faults := chaos.NewInjector()
+	mockClient := mocks.NewClient(t)
+	status := &coretypes.ResultStatus{}
+	mockClient.On("Status", mock.Anything).Return(status, nil)
+	client := chaos.NewCometRPC(mockClient, faults)
+	ctx := context.Background()
+
+	// the calls without faults reach the wrapped client
+	res, err := client.Status(ctx)
+	require.NoError(t, err)
+	require.Same(t, status, res)
+
+	// the faulted calls fail, up to the number of faulted calls
+	faults.Inject("Status", chaos.Fault{Err: chaos.ErrInjected, Times: 2})
+	for range 2 {
+		_, err = client.Status(ctx)
+		require.ErrorIs(t, err, chaos.ErrInjected)
+	}
+	_, err = client.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, faults.Faulted("Status"))
+
+	// the delayed calls fail with the error of their context
+	faults.Inject("Status", chaos.Fault{Delay: time.Hour})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = client.Status(timeoutCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	faults.Inject("Status", chaos.Fault{Delay: 10 * time.Millisecond})
+	start := time.Now()
+	_, err = client.Status(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+
+	faults.Clear("Status")
+	_, err = client.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, faults.Faulted("Status"))
+
+	faults.Reset()
+	require.Zero(t, faults.Faulted("Status"))
+}
+
+func TestDB(t *testing.T) {
+	faults := chaos.NewInjector()
+	db := chaos.NewDB(dbm.NewMemDB(), faults)
+
+	faults.Inject("Set", chaos.Fault{Err: chaos.ErrInjected, Times: 1})
+	require.ErrorIs(t, db.Set([]byte("a"), []byte("1")), chaos.ErrInjected)
+	value, err := db.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Nil(t, value, "the faulted write is not applied")
+	require.NoError(t, db.Set([]byte("a"), []byte("1")))
+
+	faults.Inject("Get", chaos.Fault{Err: chaos.ErrInjected, Times: 1})
+	_, err = db.Get([]byte("a"))
+	require.ErrorIs(t, err, chaos.ErrInjected)
+
+	// the writes of the batches are faulted as a whole
+	faults.Inject("Batch.Write", chaos.Fault{Err: chaos.ErrInjected, Times: 1})
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("b"), []byte("2")))
+	require.ErrorIs(t, batch.Write(), chaos.ErrInjected)
+	value, err = db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Nil(t, value, "the faulted batch is not applied")
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	value, err = db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+}
+
+func TestCancelAfterOpcodes(t *testing.T) {
+	statedb, err := state.New(common.Hash{}, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+
+	var canceled int
+	cfg := &runtime.Config{
+		State:     statedb,
+		EVMConfig: vm.Config{Tracer: chaos.CancelAfterOpcodes(3, func() { canceled++ })},
+	}
+	// PUSH1 0, PUSH1 0, RETURN, with the cancellation on the return
+	_, _, err = runtime.Execute([]byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURN)}, nil, cfg)
+	require.NoError(t, err)
+	require.Equal(t, 1, canceled)
+
+	// the cancel function is called once
+	_, _, err = runtime.Execute([]byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURN)}, nil, cfg)
+	require.NoError(t, err)
+	require.Equal(t, 1, canceled)
+}
@@ -0,0 +1,174 @@
+package chaos
+
+import (
+	"context"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var _ rpcclient.Client = (*CometRPC)(nil)
+
+// CometRPC is a CometBFT RPC client injecting the faults of its methods, named
+// after the methods of rpcclient.Client, before calling the wrapped client.
+// The methods not used by the JSON-RPC server and the EVM indexer are called
+// without faults.
+type CometRPC struct {
+	rpcclient.Client
+	faults *Injector
+}
+
+// NewCometRPC returns the client injecting the faults in the calls of client.
+func NewCometRPC(client rpcclient.Client, faults *Injector) *CometRPC {
+	return &CometRPC{Client: client, faults: faults}
+}
+
+func (c *CometRPC) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	if err := c.faults.apply(ctx, "ABCIQuery"); err != nil {
+		return nil, err
+	}
+	return c.Client.ABCIQuery(ctx, path, data)
+}
+
+func (c *CometRPC) ABCIQueryWithOptions(
+	ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	if err := c.faults.apply(ctx, "ABCIQueryWithOptions"); err != nil {
+		return nil, err
+	}
+	return c.Client.ABCIQueryWithOptions(ctx, path, data, opts)
+}
+
+func (c *CometRPC) BroadcastTxCommit(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	if err := c.faults.apply(ctx, "BroadcastTxCommit"); err != nil {
+		return nil, err
+	}
+	return c.Client.BroadcastTxCommit(ctx, tx)
+}
+
+func (c *CometRPC) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	if err := c.faults.apply(ctx, "BroadcastTxAsync"); err != nil {
+		return nil, err
+	}
+	return c.Client.BroadcastTxAsync(ctx, tx)
+}
+
+func (c *CometRPC) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	if err := c.faults.apply(ctx, "BroadcastTxSync"); err != nil {
+		return nil, err
+	}
+	return c.Client.BroadcastTxSync(ctx, tx)
+}
+
+func (c *CometRPC) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	if err := c.faults.apply(ctx, "Block"); err != nil {
+		return nil, err
+	}
+	return c.Client.Block(ctx, height)
+}
+
+func (c *CometRPC) BlockByHash(ctx context.Context, hash []byte) (*coretypes.ResultBlock, error) {
+	if err := c.faults.apply(ctx, "BlockByHash"); err != nil {
+		return nil, err
+	}
+	return c.Client.BlockByHash(ctx, hash)
+}
+
+func (c *CometRPC) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	if err := c.faults.apply(ctx, "BlockResults"); err != nil {
+		return nil, err
+	}
+	return c.Client.BlockResults(ctx, height)
+}
+
+func (c *CometRPC) Header(ctx context.Context, height *int64) (*coretypes.ResultHeader, error) {
+	if err := c.faults.apply(ctx, "Header"); err != nil {
+		return nil, err
+	}
+	return c.Client.Header(ctx, height)
+}
+
+func (c *CometRPC) HeaderByHash(ctx context.Context, hash bytes.HexBytes) (*coretypes.ResultHeader, error) {
+	if err := c.faults.apply(ctx, "HeaderByHash"); err != nil {
+		return nil, err
+	}
+	return c.Client.HeaderByHash(ctx, hash)
+}
+
+func (c *CometRPC) Validators(ctx context.Context, height *int64, page, perPage *int) (*coretypes.ResultValidators, error) {
+	if err := c.faults.apply(ctx, "Validators"); err != nil {
+		return nil, err
+	}
+	return c.Client.Validators(ctx, height, page, perPage)
+}
+
+func (c *CometRPC) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error) {
+	if err := c.faults.apply(ctx, "Tx"); err != nil {
+		return nil, err
+	}
+	return c.Client.Tx(ctx, hash, prove)
+}
+
+func (c *CometRPC) TxSearch(
+	ctx context.Context, query string, prove bool, page, perPage *int, orderBy string,
+) (*coretypes.ResultTxSearch, error) {
+	if err := c.faults.apply(ctx, "TxSearch"); err != nil {
+		return nil, err
+	}
+	return c.Client.TxSearch(ctx, query, prove, page, perPage, orderBy)
+}
+
+func (c *CometRPC) BlockSearch(
+	ctx context.Context, query string, page, perPage *int, orderBy string,
+) (*coretypes.ResultBlockSearch, error) {
+	if err := c.faults.apply(ctx, "BlockSearch"); err != nil {
+		return nil, err
+	}
+	return c.Client.BlockSearch(ctx, query, page, perPage, orderBy)
+}
+
+func (c *CometRPC) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	if err := c.faults.apply(ctx, "Status"); err != nil {
+		return nil, err
+	}
+	return c.Client.Status(ctx)
+}
+
+func (c *CometRPC) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error) {
+	if err := c.faults.apply(ctx, "NetInfo"); err != nil {
+		return nil, err
+	}
+	return c.Client.NetInfo(ctx)
+}
+
+func (c *CometRPC) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
+	if err := c.faults.apply(ctx, "ConsensusParams"); err != nil {
+		return nil, err
+	}
+	return c.Client.ConsensusParams(ctx, height)
+}
+
+func (c *CometRPC) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	if err := c.faults.apply(ctx, "UnconfirmedTxs"); err != nil {
+		return nil, err
+	}
+	return c.Client.UnconfirmedTxs(ctx, limit)
+}
+
+func (c *CometRPC) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconfirmedTxs, error) {
+	if err := c.faults.apply(ctx, "NumUnconfirmedTxs"); err != nil {
+		return nil, err
+	}
+	return c.Client.NumUnconfirmedTxs(ctx)
+}
+
+func (c *CometRPC) Subscribe(
+	ctx context.Context, subscriber, query string, outCapacity ...int,
+) (<-chan coretypes.ResultEvent, error) {
+	if err := c.faults.apply(ctx, "Subscribe"); err != nil {
+		return nil, err
+	}
+	return c.Client.Subscribe(ctx, subscriber, query, outCapacity...)
+}
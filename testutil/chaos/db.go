@@ -0,0 +1,103 @@
+package chaos
+
+import (
+	"context"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+var (
+	_ dbm.DB    = (*DB)(nil)
+	_ dbm.Batch = (*batch)(nil)
+)
+
+// DB is a database injecting the faults of its reads and writes, named Get,
+// Set, SetSync, Delete and DeleteSync, and of the writes of its batches, named
+// Batch.Set, Batch.Delete, Batch.Write and Batch.WriteSync, before calling
+// the wrapped database. The faulted writes are not applied.
+type DB struct {
+	dbm.DB
+	faults *Injector
+}
+
+// NewDB returns the database injecting the faults in the calls of db.
+func NewDB(db dbm.DB, faults *Injector) *DB {
+	return &DB{DB: db, faults: faults}
+}
+
+func (db *DB) Get(key []byte) ([]byte, error) {
+	if err := db.faults.apply(context.Background(), "Get"); err != nil {
+		return nil, err
+	}
+	return db.DB.Get(key)
+}
+
+func (db *DB) Set(key, value []byte) error {
+	if err := db.faults.apply(context.Background(), "Set"); err != nil {
+		return err
+	}
+	return db.DB.Set(key, value)
+}
+
+func (db *DB) SetSync(key, value []byte) error {
+	if err := db.faults.apply(context.Background(), "SetSync"); err != nil {
+		return err
+	}
+	return db.DB.SetSync(key, value)
+}
+
+func (db *DB) Delete(key []byte) error {
+	if err := db.faults.apply(context.Background(), "Delete"); err != nil {
+		return err
+	}
+	return db.DB.Delete(key)
+}
+
+func (db *DB) DeleteSync(key []byte) error {
+	if err := db.faults.apply(context.Background(), "DeleteSync"); err != nil {
+		return err
+	}
+	return db.DB.DeleteSync(key)
+}
+
+func (db *DB) NewBatch() dbm.Batch {
+	return &batch{Batch: db.DB.NewBatch(), faults: db.faults}
+}
+
+func (db *DB) NewBatchWithSize(size int) dbm.Batch {
+	return &batch{Batch: db.DB.NewBatchWithSize(size), faults: db.faults}
+}
+
+// batch is a batch of the DB injecting the faults of its writes.
+type batch struct {
+	dbm.Batch
+	faults *Injector
+}
+
+func (b *batch) Set(key, value []byte) error {
+	if err := b.faults.apply(context.Background(), "Batch.Set"); err != nil {
+		return err
+	}
+	return b.Batch.Set(key, value)
+}
+
+func (b *batch) Delete(key []byte) error {
+	if err := b.faults.apply(context.Background(), "Batch.Delete"); err != nil {
+		return err
+	}
+	return b.Batch.Delete(key)
+}
+
+func (b *batch) Write() error {
+	if err := b.faults.apply(context.Background(), "Batch.Write"); err != nil {
+		return err
+	}
+	return b.Batch.Write()
+}
+
+func (b *batch) WriteSync() error {
+	if err := b.faults.apply(context.Background(), "Batch.WriteSync"); err != nil {
+		return err
+	}
+	return b.Batch.WriteSync()
+}
@@ -0,0 +1,22 @@
+package chaos
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/core/tracing"
+)
+
+// CancelAfterOpcodes returns the tracing hooks calling cancel once the EVM
+// executed n opcodes. Given the cancel function of the go context of a query,
+// the execution is interrupted mid-EVM as on the deadline of the query.
+func CancelAfterOpcodes(n uint64, cancel context.CancelFunc) *tracing.Hooks {
+	var executed atomic.Uint64
+	return &tracing.Hooks{
+		OnOpcode: func(uint64, byte, uint64, uint64, tracing.OpContext, []byte, int, error) {
+			if executed.Add(1) == n {
+				cancel()
+			}
+		},
+	}
+}
@@ -0,0 +1,1192 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// Balance is an auto generated low-level Go binding around an user-defined struct.
+type Balance struct {
+	ContractAddress common.Address
+	Amount          *big.Int
+}
+
+// BankCallerMetaData contains all meta data concerning the BankCaller contract.
+var BankCallerMetaData = bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"callBalances\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structBalance[]\",\"name\":\"balances\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"erc20Address\",\"type\":\"address\"}],\"name\":\"callSupplyOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"callTotalSupply\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"contractAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structBalance[]\",\"name\":\"totalSupply\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	ID:  "BankCaller",
+	Bin: "0x608060405234801561001057600080fd5b50610706806100206000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c806389129c6814610046578063acab2f9414610076578063bba60ca014610094575b600080fd5b610060600480360381019061005b91906102c2565b6100c4565b60405161006d91906103f5565b60405180910390f35b61007e61014e565b60405161008b91906103f5565b60405180910390f35b6100ae60048036038101906100a991906102c2565b6101cb565b6040516100bb9190610426565b60405180910390f35b606061080473ffffffffffffffffffffffffffffffffffffffff166327e235e3836040518263ffffffff1660e01b81526004016101019190610450565b600060405180830381865afa15801561011e573d6000803e3d6000fd5b505050506040513d6000823e3d601f19601f82011682018060405250810190610147919061065a565b9050919050565b606061080473ffffffffffffffffffffffffffffffffffffffff166318160ddd6040518163ffffffff1660e01b8152600401600060405180830381865afa15801561019d573d6000803e3d6000fd5b505050506040513d6000823e3d601f19601f820116820180604052508101906101c6919061065a565b905090565b600061080473ffffffffffffffffffffffffffffffffffffffff166362400e4c836040518263ffffffff1660e01b81526004016102089190610450565b602060405180830381865afa158015610225573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061024991906106a3565b9050919050565b6000604051905090565b600080fd5b600080fd5b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b600061028f82610264565b9050919050565b61029f81610284565b81146102aa57600080fd5b50565b6000813590506102bc81610296565b92915050565b6000602082840312156102d8576102d761025a565b5b60006102e6848285016102ad565b91505092915050565b600081519050919050565b600082825260208201905092915050565b6000819050602082019050919050565b61032481610284565b82525050565b6000819050919050565b61033d8161032a565b82525050565b604082016000820151610359600085018261031b565b50602082015161036c6020850182610334565b50505050565b600061037e8383610343565b60408301905092915050565b6000602082019050919050565b60006103a2826102ef565b6103ac81856102fa565b93506103b78361030b565b8060005b838110156103e85781516103cf8882610372565b97506103da8361038a565b9250506001810190506103bb565b5085935050505092915050565b6000602082019050818103600083015261040f8184610397565b905092915050565b6104208161032a565b82525050565b600060208201905061043b6000830184610417565b92915050565b61044a81610284565b82525050565b60006020820190506104656000830184610441565b92915050565b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6104b982610470565b810181811067ffffffffffffffff821117156104d8576104d7610481565b5b80604052505050565b60006104eb610250565b90506104f782826104b0565b919050565b600067ffffffffffffffff82111561051757610516610481565b5b602082029050602081019050919050565b600080fd5b600080fd5b60008151905061054181610296565b92915050565b6105508161032a565b811461055b57600080fd5b50565b60008151905061056d81610547565b92915050565b6000604082840312156105895761058861052d565b5b61059360406104e1565b905060006105a384828501610532565b60008301525060206105b78482850161055e565b60208301525092915050565b60006105d66105d1846104fc565b6104e1565b905080838252602082019050604084028301858111156105f9576105f8610528565b5b835b81811015610622578061060e8882610573565b8452602084019350506040810190506105fb565b5050509392505050565b600082601f8301126106415761064061046b565b5b81516106518482602086016105c3565b91505092915050565b6000602082840312156106705761066f61025a565b5b600082015167ffffffffffffffff81111561068e5761068d61025f565b5b61069a8482850161062c565b91505092915050565b6000602082840312156106b9576106b861025a565b5b60006106c78482850161055e565b9150509291505056fea2646970667358221220aed0829e9f1c8981bf008bd22564334d57e7025ce0ce259ebfde7a3fafe8430d64736f6c63430008140033",
+}
+
+// BankCaller is an auto generated Go binding around an Ethereum contract.
+type BankCaller struct {
+	abi abi.ABI
+}
+
+// NewBankCaller creates a new instance of BankCaller.
+func NewBankCaller() *BankCaller {
+	parsed, err := BankCallerMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &BankCaller{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *BankCaller) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackCallBalances is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x89129c68.
+//
+// Solidity: function callBalances(address account) view returns((address,uint256)[] balances)
+func (bankCaller *BankCaller) PackCallBalances(account common.Address) []byte {
+	enc, err := bankCaller.abi.Pack("callBalances", account)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackCallBalances is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x89129c68.
+//
+// Solidity: function callBalances(address account) view returns((address,uint256)[] balances)
+func (bankCaller *BankCaller) UnpackCallBalances(data []byte) ([]Balance, error) {
+	out, err := bankCaller.abi.Unpack("callBalances", data)
+	if err != nil {
+		return *new([]Balance), err
+	}
+	out0 := *abi.ConvertType(out[0], new([]Balance)).(*[]Balance)
+	return out0, err
+}
+
+// PackCallSupplyOf is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xbba60ca0.
+//
+// Solidity: function callSupplyOf(address erc20Address) view returns(uint256)
+func (bankCaller *BankCaller) PackCallSupplyOf(erc20Address common.Address) []byte {
+	enc, err := bankCaller.abi.Pack("callSupplyOf", erc20Address)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackCallSupplyOf is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xbba60ca0.
+//
+// Solidity: function callSupplyOf(address erc20Address) view returns(uint256)
+func (bankCaller *BankCaller) UnpackCallSupplyOf(data []byte) (*big.Int, error) {
+	out, err := bankCaller.abi.Unpack("callSupplyOf", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, err
+}
+
+// PackCallTotalSupply is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xacab2f94.
+//
+// Solidity: function callTotalSupply() view returns((address,uint256)[] totalSupply)
+func (bankCaller *BankCaller) PackCallTotalSupply() []byte {
+	enc, err := bankCaller.abi.Pack("callTotalSupply")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackCallTotalSupply is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xacab2f94.
+//
+// Solidity: function callTotalSupply() view returns((address,uint256)[] totalSupply)
+func (bankCaller *BankCaller) UnpackCallTotalSupply(data []byte) ([]Balance, error) {
+	out, err := bankCaller.abi.Unpack("callTotalSupply", data)
+	if err != nil {
+		return *new([]Balance), err
+	}
+	out0 := *abi.ConvertType(out[0], new([]Balance)).(*[]Balance)
+	return out0, err
+}
+
+// ERC20MinterBurnerDecimalsMetaData contains all meta data concerning the ERC20MinterBurnerDecimals contract.
+var ERC20MinterBurnerDecimalsMetaData = bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals_\",\"type\":\"uint8\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"BURNER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MINTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"PAUSER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"burnCoins\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"burnFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"getRoleMember\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleMemberCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ID:  "ERC20MinterBurnerDecimals",
+	Bin: "0x60806040523480156200001157600080fd5b5060405162003c3638038062003c368339818101604052810190620000379190620005f6565b828281600590816200004a9190620008db565b5080600690816200005c9190620008db565b5050506000600760006101000a81548160ff0219169083151502179055506200009e6000801b620000926200017b60201b60201c565b6200018360201b60201c565b620000df7f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a6620000d36200017b60201b60201c565b6200018360201b60201c565b620001207f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a620001146200017b60201b60201c565b6200018360201b60201c565b620001617f3c11d16cbaffd01df69ce1c404f6340ee057498f5f00246190ea54220576a848620001556200017b60201b60201c565b6200018360201b60201c565b62000172816200019960201b60201c565b505050620009c2565b600033905090565b620001958282620001b760201b60201c565b5050565b80600760016101000a81548160ff021916908360ff16021790555050565b620001c98282620001f560201b60201c565b620001f08160016000858152602001908152602001600020620002e660201b90919060201c565b505050565b6200020782826200031e60201b60201c565b620002e257600160008084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff021916908315150217905550620002876200017b60201b60201c565b73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45b5050565b600062000316836000018373ffffffffffffffffffffffffffffffffffffffff1660001b6200038860201b60201c565b905092915050565b600080600084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16905092915050565b60006200039c83836200040260201b60201c565b620003f7578260000182908060018154018082558091505060019003906000526020600020016000909190919091505582600001805490508360010160008481526020019081526020016000208190555060019050620003fc565b600090505b92915050565b600080836001016000848152602001908152602001600020541415905092915050565b6000604051905090565b600080fd5b600080fd5b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6200048e8262000443565b810181811067ffffffffffffffff82111715620004b057620004af62000454565b5b80604052505050565b6000620004c562000425565b9050620004d3828262000483565b919050565b600067ffffffffffffffff821115620004f657620004f562000454565b5b620005018262000443565b9050602081019050919050565b60005b838110156200052e57808201518184015260208101905062000511565b60008484015250505050565b6000620005516200054b84620004d8565b620004b9565b90508281526020810184848401111562000570576200056f6200043e565b5b6200057d8482856200050e565b509392505050565b600082601f8301126200059d576200059c62000439565b5b8151620005af8482602086016200053a565b91505092915050565b600060ff82169050919050565b620005d081620005b8565b8114620005dc57600080fd5b50565b600081519050620005f081620005c5565b92915050565b6000806000606084860312156200061257620006116200042f565b5b600084015167ffffffffffffffff81111562000633576200063262000434565b5b620006418682870162000585565b935050602084015167ffffffffffffffff81111562000665576200066462000434565b5b620006738682870162000585565b92505060406200068686828701620005df565b9150509250925092565b600081519050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b60006002820490506001821680620006e357607f821691505b602082108103620006f957620006f86200069b565b5b50919050565b60008190508160005260206000209050919050565b60006020601f8301049050919050565b600082821b905092915050565b600060088302620007637fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8262000724565b6200076f868362000724565b95508019841693508086168417925050509392505050565b6000819050919050565b6000819050919050565b6000620007bc620007b6620007b08462000787565b62000791565b62000787565b9050919050565b6000819050919050565b620007d8836200079b565b620007f0620007e782620007c3565b84845462000731565b825550505050565b600090565b62000807620007f8565b62000814818484620007cd565b505050565b5b818110156200083c5762000830600082620007fd565b6001810190506200081a565b5050565b601f8211156200088b576200085581620006ff565b620008608462000714565b8101602085101562000870578190505b620008886200087f8562000714565b83018262000819565b50505b505050565b600082821c905092915050565b6000620008b06000198460080262000890565b1980831691505092915050565b6000620008cb83836200089d565b9150826002028217905092915050565b620008e68262000690565b67ffffffffffffffff81111562000902576200090162000454565b5b6200090e8254620006ca565b6200091b82828562000840565b600060209050601f8311600181146200095357600084156200093e578287015190505b6200094a8582620008bd565b865550620009ba565b601f1984166200096386620006ff565b60005b828110156200098d5784890151825560018201915060208501945060208101905062000966565b86831015620009ad5784890151620009a9601f8916826200089d565b8355505b6001600288020188555050505b505050505050565b61326480620009d26000396000f3fe608060405234801561001057600080fd5b50600436106101da5760003560e01c80635c975abb11610104578063a217fddf116100a2578063d539139311610071578063d53913931461057d578063d547741f1461059b578063dd62ed3e146105b7578063e63ab1e9146105e7576101da565b8063a217fddf146104cf578063a457c2d7146104ed578063a9059cbb1461051d578063ca15c8731461054d576101da565b80638456cb59116100de5780638456cb59146104475780639010d07c1461045157806391d148541461048157806395d89b41146104b1576101da565b80635c975abb146103dd57806370a08231146103fb57806379cc67901461042b576101da565b8063282c51f31161017c578063395093511161014b578063395093511461036b5780633f4ba83a1461039b57806340c10f19146103a557806342966c68146103c1576101da565b8063282c51f3146102f75780632f2ff15d14610315578063313ce5671461033157806336568abe1461034f576101da565b806318160ddd116101b857806318160ddd1461025d5780631cf2c7e21461027b57806323b872dd14610297578063248a9ca3146102c7576101da565b806301ffc9a7146101df57806306fdde031461020f578063095ea7b31461022d575b600080fd5b6101f960048036038101906101f491906120ab565b610605565b60405161020691906120f3565b60405180910390f35b61021761067f565b604051610224919061219e565b60405180910390f35b61024760048036038101906102429190612254565b610711565b60405161025491906120f3565b60405180910390f35b610265610734565b60405161027291906122a3565b60405180910390f35b61029560048036038101906102909190612254565b61073e565b005b6102b160048036038101906102ac91906122be565b6107bc565b6040516102be91906120f3565b60405180910390f35b6102e160048036038101906102dc9190612347565b6107eb565b6040516102ee9190612383565b60405180910390f35b6102ff61080a565b60405161030c9190612383565b60405180910390f35b61032f600480360381019061032a919061239e565b61082e565b005b61033961084f565b60405161034691906123fa565b60405180910390f35b6103696004803603810190610364919061239e565b610866565b005b61038560048036038101906103809190612254565b6108e9565b60405161039291906120f3565b60405180910390f35b6103a3610920565b005b6103bf60048036038101906103ba9190612254565b61099a565b005b6103db60048036038101906103d69190612415565b610a18565b005b6103e5610a2c565b6040516103f291906120f3565b60405180910390f35b61041560048036038101906104109190612442565b610a43565b60405161042291906122a3565b60405180910390f35b61044560048036038101906104409190612254565b610a8c565b005b61044f610aac565b005b61046b6004803603810190610466919061246f565b610b26565b60405161047891906124be565b60405180910390f35b61049b6004803603810190610496919061239e565b610b55565b6040516104a891906120f3565b60405180910390f35b6104b9610bbf565b6040516104c6919061219e565b60405180910390f35b6104d7610c51565b6040516104e49190612383565b60405180910390f35b61050760048036038101906105029190612254565b610c58565b60405161051491906120f3565b60405180910390f35b61053760048036038101906105329190612254565b610ccf565b60405161054491906120f3565b60405180910390f35b61056760048036038101906105629190612347565b610cf2565b60405161057491906122a3565b60405180910390f35b610585610d16565b6040516105929190612383565b60405180910390f35b6105b560048036038101906105b0919061239e565b610d3a565b005b6105d160048036038101906105cc91906124d9565b610d5b565b6040516105de91906122a3565b60405180910390f35b6105ef610de2565b6040516105fc9190612383565b60405180910390f35b60007f5a05180f000000000000000000000000000000000000000000000000000000007bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff19161480610678575061067782610e06565b5b9050919050565b60606005805461068e90612548565b80601f01602080910402602001604051908101604052809291908181526020018280546106ba90612548565b80156107075780601f106106dc57610100808354040283529160200191610707565b820191906000526020600020905b8154815290600101906020018083116106ea57829003601f168201915b5050505050905090565b60008061071c610e80565b9050610729818585610e88565b600191505092915050565b6000600454905090565b61076f7f3c11d16cbaffd01df69ce1c404f6340ee057498f5f00246190ea54220576a84861076a610e80565b610b55565b6107ae576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107a5906125eb565b60405180910390fd5b6107b88282611051565b5050565b6000806107c7610e80565b90506107d4858285611220565b6107df8585856112ac565b60019150509392505050565b6000806000838152602001908152602001600020600101549050919050565b7f3c11d16cbaffd01df69ce1c404f6340ee057498f5f00246190ea54220576a84881565b610837826107eb565b61084081611525565b61084a8383611539565b505050565b6000600760019054906101000a900460ff16905090565b61086e610e80565b73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16146108db576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016108d29061267d565b60405180910390fd5b6108e5828261156d565b5050565b6000806108f4610e80565b90506109158185856109068589610d5b565b61091091906126cc565b610e88565b600191505092915050565b6109517f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a61094c610e80565b610b55565b610990576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161098790612772565b60405180910390fd5b6109986115a1565b565b6109cb7f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a66109c6610e80565b610b55565b610a0a576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a0190612804565b60405180910390fd5b610a148282611604565b5050565b610a29610a23610e80565b82611051565b50565b6000600760009054906101000a900460ff16905090565b6000600260008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050919050565b610a9e82610a98610e80565b83611220565b610aa88282611051565b5050565b610add7f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a610ad8610e80565b610b55565b610b1c576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610b1390612896565b60405180910390fd5b610b2461175b565b565b6000610b4d82600160008681526020019081526020016000206117be90919063ffffffff16565b905092915050565b600080600084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16905092915050565b606060068054610bce90612548565b80601f0160208091040260200160405190810160405280929190818152602001828054610bfa90612548565b8015610c475780601f10610c1c57610100808354040283529160200191610c47565b820191906000526020600020905b815481529060010190602001808311610c2a57829003601f168201915b5050505050905090565b6000801b81565b600080610c63610e80565b90506000610c718286610d5b565b905083811015610cb6576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610cad90612928565b60405180910390fd5b610cc38286868403610e88565b60019250505092915050565b600080610cda610e80565b9050610ce78185856112ac565b600191505092915050565b6000610d0f600160008481526020019081526020016000206117d8565b9050919050565b7f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a681565b610d43826107eb565b610d4c81611525565b610d56838361156d565b505050565b6000600360008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905092915050565b7f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a81565b60007f7965db0b000000000000000000000000000000000000000000000000000000007bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff19161480610e795750610e78826117ed565b5b9050919050565b600033905090565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff1603610ef7576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610eee906129ba565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1603610f66576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610f5d90612a4c565b60405180910390fd5b80600360008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508173ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b9258360405161104491906122a3565b60405180910390a3505050565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff16036110c0576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016110b790612ade565b60405180910390fd5b6110cc82600083611857565b6000600260008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905081811015611153576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161114a90612b70565b60405180910390fd5b818103600260008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000208190555081600460008282540392505081905550600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161120791906122a3565b60405180910390a361121b83600084611867565b505050565b600061122c8484610d5b565b90507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff81146112a65781811015611298576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161128f90612bdc565b60405180910390fd5b6112a58484848403610e88565b5b50505050565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff160361131b576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161131290612c6e565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff160361138a576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161138190612d00565b60405180910390fd5b611395838383611857565b6000600260008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205490508181101561141c576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161141390612d92565b60405180910390fd5b818103600260008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000208190555081600260008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020600082825401925050819055508273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161150c91906122a3565b60405180910390a361151f848484611867565b50505050565b61153681611531610e80565b61186c565b50565b61154382826118f1565b61156881600160008581526020019081526020016000206119d190919063ffffffff16565b505050565b6115778282611a01565b61159c8160016000858152602001908152602001600020611ae290919063ffffffff16565b505050565b6115a9611b12565b6000600760006101000a81548160ff0219169083151502179055507f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa6115ed610e80565b6040516115fa91906124be565b60405180910390a1565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1603611673576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161166a90612dfe565b60405180910390fd5b61167f60008383611857565b806004600082825461169191906126cc565b9250508190555080600260008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020600082825401925050819055508173ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405161174391906122a3565b60405180910390a361175760008383611867565b5050565b611763611b5b565b6001600760006101000a81548160ff0219169083151502179055507f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a2586117a7610e80565b6040516117b491906124be565b60405180910390a1565b60006117cd8360000183611ba5565b60001c905092915050565b60006117e682600001611bd0565b9050919050565b60007f01ffc9a7000000000000000000000000000000000000000000000000000000007bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916149050919050565b611862838383611be1565b505050565b505050565b6118768282610b55565b6118ed5761188381611c39565b6118918360001c6020611c66565b6040516020016118a2929190612ef2565b6040516020818303038152906040526040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016118e4919061219e565b60405180910390fd5b5050565b6118fb8282610b55565b6119cd57600160008084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff021916908315150217905550611972610e80565b73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16837f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45b5050565b60006119f9836000018373ffffffffffffffffffffffffffffffffffffffff1660001b611ea2565b905092915050565b611a0b8282610b55565b15611ade57600080600084815260200190815260200160002060000160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff021916908315150217905550611a83610e80565b73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16837ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b60405160405180910390a45b5050565b6000611b0a836000018373ffffffffffffffffffffffffffffffffffffffff1660001b611f12565b905092915050565b611b1a610a2c565b611b59576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611b5090612f78565b60405180910390fd5b565b611b63610a2c565b15611ba3576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611b9a90612fe4565b60405180910390fd5b565b6000826000018281548110611bbd57611bbc613004565b5b9060005260206000200154905092915050565b600081600001805490509050919050565b611bec838383612026565b611bf4610a2c565b15611c34576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611c2b906130a5565b60405180910390fd5b505050565b6060611c5f8273ffffffffffffffffffffffffffffffffffffffff16601460ff16611c66565b9050919050565b606060006002836002611c7991906130c5565b611c8391906126cc565b67ffffffffffffffff811115611c9c57611c9b613107565b5b6040519080825280601f01601f191660200182016040528015611cce5781602001600182028036833780820191505090505b5090507f300000000000000000000000000000000000000000000000000000000000000081600081518110611d0657611d05613004565b5b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a9053507f780000000000000000000000000000000000000000000000000000000000000081600181518110611d6a57611d69613004565b5b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a90535060006001846002611daa91906130c5565b611db491906126cc565b90505b6001811115611e54577f3031323334353637383961626364656600000000000000000000000000000000600f861660108110611df657611df5613004565b5b1a60f81b828281518110611e0d57611e0c613004565b5b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a905350600485901c945080611e4d90613136565b9050611db7565b5060008414611e98576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611e8f906131ab565b60405180910390fd5b8091505092915050565b6000611eae838361202b565b611f07578260000182908060018154018082558091505060019003906000526020600020016000909190919091505582600001805490508360010160008481526020019081526020016000208190555060019050611f0c565b600090505b92915050565b6000808360010160008481526020019081526020016000205490506000811461201a576000600182611f4491906131cb565b9050600060018660000180549050611f5c91906131cb565b9050818114611fcb576000866000018281548110611f7d57611f7c613004565b5b9060005260206000200154905080876000018481548110611fa157611fa0613004565b5b90600052602060002001819055508387600101600083815260200190815260200160002081905550505b85600001805480611fdf57611fde6131ff565b5b600190038181906000526020600020016000905590558560010160008681526020019081526020016000206000905560019350505050612020565b60009150505b92915050565b505050565b600080836001016000848152602001908152602001600020541415905092915050565b600080fd5b60007fffffffff0000000000000000000000000000000000000000000000000000000082169050919050565b61208881612053565b811461209357600080fd5b50565b6000813590506120a58161207f565b92915050565b6000602082840312156120c1576120c061204e565b5b60006120cf84828501612096565b91505092915050565b60008115159050919050565b6120ed816120d8565b82525050565b600060208201905061210860008301846120e4565b92915050565b600081519050919050565b600082825260208201905092915050565b60005b8381101561214857808201518184015260208101905061212d565b60008484015250505050565b6000601f19601f8301169050919050565b60006121708261210e565b61217a8185612119565b935061218a81856020860161212a565b61219381612154565b840191505092915050565b600060208201905081810360008301526121b88184612165565b905092915050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b60006121eb826121c0565b9050919050565b6121fb816121e0565b811461220657600080fd5b50565b600081359050612218816121f2565b92915050565b6000819050919050565b6122318161221e565b811461223c57600080fd5b50565b60008135905061224e81612228565b92915050565b6000806040838503121561226b5761226a61204e565b5b600061227985828601612209565b925050602061228a8582860161223f565b9150509250929050565b61229d8161221e565b82525050565b60006020820190506122b86000830184612294565b92915050565b6000806000606084860312156122d7576122d661204e565b5b60006122e586828701612209565b93505060206122f686828701612209565b92505060406123078682870161223f565b9150509250925092565b6000819050919050565b61232481612311565b811461232f57600080fd5b50565b6000813590506123418161231b565b92915050565b60006020828403121561235d5761235c61204e565b5b600061236b84828501612332565b91505092915050565b61237d81612311565b82525050565b60006020820190506123986000830184612374565b92915050565b600080604083850312156123b5576123b461204e565b5b60006123c385828601612332565b92505060206123d485828601612209565b9150509250929050565b600060ff82169050919050565b6123f4816123de565b82525050565b600060208201905061240f60008301846123eb565b92915050565b60006020828403121561242b5761242a61204e565b5b60006124398482850161223f565b91505092915050565b6000602082840312156124585761245761204e565b5b600061246684828501612209565b91505092915050565b600080604083850312156124865761248561204e565b5b600061249485828601612332565b92505060206124a58582860161223f565b9150509250929050565b6124b8816121e0565b82525050565b60006020820190506124d360008301846124af565b92915050565b600080604083850312156124f0576124ef61204e565b5b60006124fe85828601612209565b925050602061250f85828601612209565b9150509250929050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000600282049050600182168061256057607f821691505b60208210810361257357612572612519565b5b50919050565b7f45524332304d696e7465724275726e6572446563696d616c733a206d7573742060008201527f68617665206275726e657220726f6c6520746f206275726e0000000000000000602082015250565b60006125d5603883612119565b91506125e082612579565b604082019050919050565b60006020820190508181036000830152612604816125c8565b9050919050565b7f416363657373436f6e74726f6c3a2063616e206f6e6c792072656e6f756e636560008201527f20726f6c657320666f722073656c660000000000000000000000000000000000602082015250565b6000612667602f83612119565b91506126728261260b565b604082019050919050565b600060208201905081810360008301526126968161265a565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b60006126d78261221e565b91506126e28361221e565b92508282019050808211156126fa576126f961269d565b5b92915050565b7f45524332304d696e7465724275726e6572446563696d616c733a206d7573742060008201527f686176652070617573657220726f6c6520746f20756e70617573650000000000602082015250565b600061275c603b83612119565b915061276782612700565b604082019050919050565b6000602082019050818103600083015261278b8161274f565b9050919050565b7f45524332304d696e7465724275726e6572446563696d616c733a206d7573742060008201527f68617665206d696e74657220726f6c6520746f206d696e740000000000000000602082015250565b60006127ee603883612119565b91506127f982612792565b604082019050919050565b6000602082019050818103600083015261281d816127e1565b9050919050565b7f45524332304d696e7465724275726e6572446563696d616c733a206d7573742060008201527f686176652070617573657220726f6c6520746f20706175736500000000000000602082015250565b6000612880603983612119565b915061288b82612824565b604082019050919050565b600060208201905081810360008301526128af81612873565b9050919050565b7f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f7760008201527f207a65726f000000000000000000000000000000000000000000000000000000602082015250565b6000612912602583612119565b915061291d826128b6565b604082019050919050565b6000602082019050818103600083015261294181612905565b9050919050565b7f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460008201527f7265737300000000000000000000000000000000000000000000000000000000602082015250565b60006129a4602483612119565b91506129af82612948565b604082019050919050565b600060208201905081810360008301526129d381612997565b9050919050565b7f45524332303a20617070726f766520746f20746865207a65726f20616464726560008201527f7373000000000000000000000000000000000000000000000000000000000000602082015250565b6000612a36602283612119565b9150612a41826129da565b604082019050919050565b60006020820190508181036000830152612a6581612a29565b9050919050565b7f45524332303a206275726e2066726f6d20746865207a65726f2061646472657360008201527f7300000000000000000000000000000000000000000000000000000000000000602082015250565b6000612ac8602183612119565b9150612ad382612a6c565b604082019050919050565b60006020820190508181036000830152612af781612abb565b9050919050565b7f45524332303a206275726e20616d6f756e7420657863656564732062616c616e60008201527f6365000000000000000000000000000000000000000000000000000000000000602082015250565b6000612b5a602283612119565b9150612b6582612afe565b604082019050919050565b60006020820190508181036000830152612b8981612b4d565b9050919050565b7f45524332303a20696e73756666696369656e7420616c6c6f77616e6365000000600082015250565b6000612bc6601d83612119565b9150612bd182612b90565b602082019050919050565b60006020820190508181036000830152612bf581612bb9565b9050919050565b7f45524332303a207472616e736665722066726f6d20746865207a65726f20616460008201527f6472657373000000000000000000000000000000000000000000000000000000602082015250565b6000612c58602583612119565b9150612c6382612bfc565b604082019050919050565b60006020820190508181036000830152612c8781612c4b565b9050919050565b7f45524332303a207472616e7366657220746f20746865207a65726f206164647260008201527f6573730000000000000000000000000000000000000000000000000000000000602082015250565b6000612cea602383612119565b9150612cf582612c8e565b604082019050919050565b60006020820190508181036000830152612d1981612cdd565b9050919050565b7f45524332303a207472616e7366657220616d6f756e742065786365656473206260008201527f616c616e63650000000000000000000000000000000000000000000000000000602082015250565b6000612d7c602683612119565b9150612d8782612d20565b604082019050919050565b60006020820190508181036000830152612dab81612d6f565b9050919050565b7f45524332303a206d696e7420746f20746865207a65726f206164647265737300600082015250565b6000612de8601f83612119565b9150612df382612db2565b602082019050919050565b60006020820190508181036000830152612e1781612ddb565b9050919050565b600081905092915050565b7f416363657373436f6e74726f6c3a206163636f756e7420000000000000000000600082015250565b6000612e5f601783612e1e565b9150612e6a82612e29565b601782019050919050565b6000612e808261210e565b612e8a8185612e1e565b9350612e9a81856020860161212a565b80840191505092915050565b7f206973206d697373696e6720726f6c6520000000000000000000000000000000600082015250565b6000612edc601183612e1e565b9150612ee782612ea6565b601182019050919050565b6000612efd82612e52565b9150612f098285612e75565b9150612f1482612ecf565b9150612f208284612e75565b91508190509392505050565b7f5061757361626c653a206e6f7420706175736564000000000000000000000000600082015250565b6000612f62601483612119565b9150612f6d82612f2c565b602082019050919050565b60006020820190508181036000830152612f9181612f55565b9050919050565b7f5061757361626c653a2070617573656400000000000000000000000000000000600082015250565b6000612fce601083612119565b9150612fd982612f98565b602082019050919050565b60006020820190508181036000830152612ffd81612fc1565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b7f45524332305061757361626c653a20746f6b656e207472616e7366657220776860008201527f696c652070617573656400000000000000000000000000000000000000000000602082015250565b600061308f602a83612119565b915061309a82613033565b604082019050919050565b600060208201905081810360008301526130be81613082565b9050919050565b60006130d08261221e565b91506130db8361221e565b92508282026130e98161221e565b91508282048414831517613100576130ff61269d565b5b5092915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b60006131418261221e565b9150600082036131545761315361269d565b5b600182039050919050565b7f537472696e67733a20686578206c656e67746820696e73756666696369656e74600082015250565b6000613195602083612119565b91506131a08261315f565b602082019050919050565b600060208201905081810360008301526131c481613188565b9050919050565b60006131d68261221e565b91506131e18361221e565b92508282039050818111156131f9576131f861269d565b5b92915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603160045260246000fdfea264697066735822122085be590fcd27af24982116d9f60fa42a00c725b7ba15ba740f13e78af698fedf64736f6c63430008140033",
+}
+
+// ERC20MinterBurnerDecimals is an auto generated Go binding around an Ethereum contract.
+type ERC20MinterBurnerDecimals struct {
+	abi abi.ABI
+}
+
+// NewERC20MinterBurnerDecimals creates a new instance of ERC20MinterBurnerDecimals.
+func NewERC20MinterBurnerDecimals() *ERC20MinterBurnerDecimals {
+	parsed, err := ERC20MinterBurnerDecimalsMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &ERC20MinterBurnerDecimals{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *ERC20MinterBurnerDecimals) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackConstructor is the Go binding used to pack the parameters required for
+// contract deployment.
+//
+// Solidity: constructor(string name, string symbol, uint8 decimals_) returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackConstructor(name string, symbol string, decimals_ uint8) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("", name, symbol, decimals_)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackBURNERROLE is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackBURNERROLE() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("BURNER_ROLE")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackBURNERROLE is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackBURNERROLE(data []byte) ([32]byte, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("BURNER_ROLE", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, err
+}
+
+// PackDEFAULTADMINROLE is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackDEFAULTADMINROLE() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("DEFAULT_ADMIN_ROLE")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackDEFAULTADMINROLE is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackDEFAULTADMINROLE(data []byte) ([32]byte, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("DEFAULT_ADMIN_ROLE", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, err
+}
+
+// PackMINTERROLE is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackMINTERROLE() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("MINTER_ROLE")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackMINTERROLE is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackMINTERROLE(data []byte) ([32]byte, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("MINTER_ROLE", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, err
+}
+
+// PackPAUSERROLE is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackPAUSERROLE() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("PAUSER_ROLE")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackPAUSERROLE is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackPAUSERROLE(data []byte) ([32]byte, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("PAUSER_ROLE", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, err
+}
+
+// PackAllowance is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackAllowance(owner common.Address, spender common.Address) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("allowance", owner, spender)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackAllowance is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackAllowance(data []byte) (*big.Int, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("allowance", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, err
+}
+
+// PackApprove is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackApprove(spender common.Address, amount *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("approve", spender, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackApprove is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackApprove(data []byte) (bool, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("approve", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, err
+}
+
+// PackBalanceOf is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackBalanceOf(account common.Address) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("balanceOf", account)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackBalanceOf is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackBalanceOf(data []byte) (*big.Int, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("balanceOf", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, err
+}
+
+// PackBurn is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x42966c68.
+//
+// Solidity: function burn(uint256 amount) returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackBurn(amount *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("burn", amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackBurnCoins is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x1cf2c7e2.
+//
+// Solidity: function burnCoins(address from, uint256 amount) returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackBurnCoins(from common.Address, amount *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("burnCoins", from, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackBurnFrom is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x79cc6790.
+//
+// Solidity: function burnFrom(address account, uint256 amount) returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackBurnFrom(account common.Address, amount *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("burnFrom", account, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackDecimals is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackDecimals() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("decimals")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackDecimals is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackDecimals(data []byte) (uint8, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("decimals", data)
+	if err != nil {
+		return *new(uint8), err
+	}
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+	return out0, err
+}
+
+// PackDecreaseAllowance is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackDecreaseAllowance(spender common.Address, subtractedValue *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("decreaseAllowance", spender, subtractedValue)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackDecreaseAllowance is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackDecreaseAllowance(data []byte) (bool, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("decreaseAllowance", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, err
+}
+
+// PackGetRoleAdmin is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackGetRoleAdmin(role [32]byte) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("getRoleAdmin", role)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackGetRoleAdmin is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackGetRoleAdmin(data []byte) ([32]byte, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("getRoleAdmin", data)
+	if err != nil {
+		return *new([32]byte), err
+	}
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	return out0, err
+}
+
+// PackGetRoleMember is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x9010d07c.
+//
+// Solidity: function getRoleMember(bytes32 role, uint256 index) view returns(address)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackGetRoleMember(role [32]byte, index *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("getRoleMember", role, index)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackGetRoleMember is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x9010d07c.
+//
+// Solidity: function getRoleMember(bytes32 role, uint256 index) view returns(address)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackGetRoleMember(data []byte) (common.Address, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("getRoleMember", data)
+	if err != nil {
+		return *new(common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, err
+}
+
+// PackGetRoleMemberCount is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xca15c873.
+//
+// Solidity: function getRoleMemberCount(bytes32 role) view returns(uint256)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackGetRoleMemberCount(role [32]byte) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("getRoleMemberCount", role)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackGetRoleMemberCount is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xca15c873.
+//
+// Solidity: function getRoleMemberCount(bytes32 role) view returns(uint256)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackGetRoleMemberCount(data []byte) (*big.Int, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("getRoleMemberCount", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, err
+}
+
+// PackGrantRole is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackGrantRole(role [32]byte, account common.Address) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("grantRole", role, account)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackHasRole is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackHasRole(role [32]byte, account common.Address) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("hasRole", role, account)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackHasRole is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackHasRole(data []byte) (bool, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("hasRole", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, err
+}
+
+// PackIncreaseAllowance is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackIncreaseAllowance(spender common.Address, addedValue *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("increaseAllowance", spender, addedValue)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackIncreaseAllowance is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackIncreaseAllowance(data []byte) (bool, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("increaseAllowance", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, err
+}
+
+// PackMint is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackMint(to common.Address, amount *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("mint", to, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackName is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackName() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("name")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackName is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackName(data []byte) (string, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("name", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, err
+}
+
+// PackPause is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackPause() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("pause")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackPaused is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackPaused() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("paused")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackPaused is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackPaused(data []byte) (bool, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("paused", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, err
+}
+
+// PackRenounceRole is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackRenounceRole(role [32]byte, account common.Address) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("renounceRole", role, account)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackRevokeRole is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackRevokeRole(role [32]byte, account common.Address) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("revokeRole", role, account)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackSupportsInterface is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackSupportsInterface(interfaceId [4]byte) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("supportsInterface", interfaceId)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackSupportsInterface is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackSupportsInterface(data []byte) (bool, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("supportsInterface", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, err
+}
+
+// PackSymbol is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackSymbol() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("symbol")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackSymbol is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackSymbol(data []byte) (string, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("symbol", data)
+	if err != nil {
+		return *new(string), err
+	}
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	return out0, err
+}
+
+// PackTotalSupply is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackTotalSupply() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("totalSupply")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackTotalSupply is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackTotalSupply(data []byte) (*big.Int, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("totalSupply", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, err
+}
+
+// PackTransfer is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackTransfer(to common.Address, amount *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("transfer", to, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackTransfer is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackTransfer(data []byte) (bool, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("transfer", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, err
+}
+
+// PackTransferFrom is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackTransferFrom(from common.Address, to common.Address, amount *big.Int) []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("transferFrom", from, to, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// UnpackTransferFrom is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackTransferFrom(data []byte) (bool, error) {
+	out, err := eRC20MinterBurnerDecimals.abi.Unpack("transferFrom", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, err
+}
+
+// PackUnpause is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) PackUnpause() []byte {
+	enc, err := eRC20MinterBurnerDecimals.abi.Pack("unpause")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// ERC20MinterBurnerDecimalsApproval represents a Approval event raised by the ERC20MinterBurnerDecimals contract.
+type ERC20MinterBurnerDecimalsApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ERC20MinterBurnerDecimalsApprovalEventName = "Approval"
+
+// ContractEventName returns the user-defined event name.
+func (ERC20MinterBurnerDecimalsApproval) ContractEventName() string {
+	return ERC20MinterBurnerDecimalsApprovalEventName
+}
+
+// UnpackApprovalEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackApprovalEvent(log *types.Log) (*ERC20MinterBurnerDecimalsApproval, error) {
+	event := "Approval"
+	if log.Topics[0] != eRC20MinterBurnerDecimals.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ERC20MinterBurnerDecimalsApproval)
+	if len(log.Data) > 0 {
+		if err := eRC20MinterBurnerDecimals.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range eRC20MinterBurnerDecimals.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ERC20MinterBurnerDecimalsPaused represents a Paused event raised by the ERC20MinterBurnerDecimals contract.
+type ERC20MinterBurnerDecimalsPaused struct {
+	Account common.Address
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ERC20MinterBurnerDecimalsPausedEventName = "Paused"
+
+// ContractEventName returns the user-defined event name.
+func (ERC20MinterBurnerDecimalsPaused) ContractEventName() string {
+	return ERC20MinterBurnerDecimalsPausedEventName
+}
+
+// UnpackPausedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Paused(address account)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackPausedEvent(log *types.Log) (*ERC20MinterBurnerDecimalsPaused, error) {
+	event := "Paused"
+	if log.Topics[0] != eRC20MinterBurnerDecimals.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ERC20MinterBurnerDecimalsPaused)
+	if len(log.Data) > 0 {
+		if err := eRC20MinterBurnerDecimals.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range eRC20MinterBurnerDecimals.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ERC20MinterBurnerDecimalsRoleAdminChanged represents a RoleAdminChanged event raised by the ERC20MinterBurnerDecimals contract.
+type ERC20MinterBurnerDecimalsRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               *types.Log // Blockchain specific contextual infos
+}
+
+const ERC20MinterBurnerDecimalsRoleAdminChangedEventName = "RoleAdminChanged"
+
+// ContractEventName returns the user-defined event name.
+func (ERC20MinterBurnerDecimalsRoleAdminChanged) ContractEventName() string {
+	return ERC20MinterBurnerDecimalsRoleAdminChangedEventName
+}
+
+// UnpackRoleAdminChangedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackRoleAdminChangedEvent(log *types.Log) (*ERC20MinterBurnerDecimalsRoleAdminChanged, error) {
+	event := "RoleAdminChanged"
+	if log.Topics[0] != eRC20MinterBurnerDecimals.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ERC20MinterBurnerDecimalsRoleAdminChanged)
+	if len(log.Data) > 0 {
+		if err := eRC20MinterBurnerDecimals.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range eRC20MinterBurnerDecimals.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ERC20MinterBurnerDecimalsRoleGranted represents a RoleGranted event raised by the ERC20MinterBurnerDecimals contract.
+type ERC20MinterBurnerDecimalsRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ERC20MinterBurnerDecimalsRoleGrantedEventName = "RoleGranted"
+
+// ContractEventName returns the user-defined event name.
+func (ERC20MinterBurnerDecimalsRoleGranted) ContractEventName() string {
+	return ERC20MinterBurnerDecimalsRoleGrantedEventName
+}
+
+// UnpackRoleGrantedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackRoleGrantedEvent(log *types.Log) (*ERC20MinterBurnerDecimalsRoleGranted, error) {
+	event := "RoleGranted"
+	if log.Topics[0] != eRC20MinterBurnerDecimals.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ERC20MinterBurnerDecimalsRoleGranted)
+	if len(log.Data) > 0 {
+		if err := eRC20MinterBurnerDecimals.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range eRC20MinterBurnerDecimals.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ERC20MinterBurnerDecimalsRoleRevoked represents a RoleRevoked event raised by the ERC20MinterBurnerDecimals contract.
+type ERC20MinterBurnerDecimalsRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ERC20MinterBurnerDecimalsRoleRevokedEventName = "RoleRevoked"
+
+// ContractEventName returns the user-defined event name.
+func (ERC20MinterBurnerDecimalsRoleRevoked) ContractEventName() string {
+	return ERC20MinterBurnerDecimalsRoleRevokedEventName
+}
+
+// UnpackRoleRevokedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackRoleRevokedEvent(log *types.Log) (*ERC20MinterBurnerDecimalsRoleRevoked, error) {
+	event := "RoleRevoked"
+	if log.Topics[0] != eRC20MinterBurnerDecimals.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ERC20MinterBurnerDecimalsRoleRevoked)
+	if len(log.Data) > 0 {
+		if err := eRC20MinterBurnerDecimals.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range eRC20MinterBurnerDecimals.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ERC20MinterBurnerDecimalsTransfer represents a Transfer event raised by the ERC20MinterBurnerDecimals contract.
+type ERC20MinterBurnerDecimalsTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   *types.Log // Blockchain specific contextual infos
+}
+
+const ERC20MinterBurnerDecimalsTransferEventName = "Transfer"
+
+// ContractEventName returns the user-defined event name.
+func (ERC20MinterBurnerDecimalsTransfer) ContractEventName() string {
+	return ERC20MinterBurnerDecimalsTransferEventName
+}
+
+// UnpackTransferEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackTransferEvent(log *types.Log) (*ERC20MinterBurnerDecimalsTransfer, error) {
+	event := "Transfer"
+	if log.Topics[0] != eRC20MinterBurnerDecimals.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ERC20MinterBurnerDecimalsTransfer)
+	if len(log.Data) > 0 {
+		if err := eRC20MinterBurnerDecimals.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range eRC20MinterBurnerDecimals.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ERC20MinterBurnerDecimalsUnpaused represents a Unpaused event raised by the ERC20MinterBurnerDecimals contract.
+type ERC20MinterBurnerDecimalsUnpaused struct {
+	Account common.Address
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const ERC20MinterBurnerDecimalsUnpausedEventName = "Unpaused"
+
+// ContractEventName returns the user-defined event name.
+func (ERC20MinterBurnerDecimalsUnpaused) ContractEventName() string {
+	return ERC20MinterBurnerDecimalsUnpausedEventName
+}
+
+// UnpackUnpausedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Unpaused(address account)
+func (eRC20MinterBurnerDecimals *ERC20MinterBurnerDecimals) UnpackUnpausedEvent(log *types.Log) (*ERC20MinterBurnerDecimalsUnpaused, error) {
+	event := "Unpaused"
+	if log.Topics[0] != eRC20MinterBurnerDecimals.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(ERC20MinterBurnerDecimalsUnpaused)
+	if len(log.Data) > 0 {
+		if err := eRC20MinterBurnerDecimals.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range eRC20MinterBurnerDecimals.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// ReverterMetaData contains all meta data concerning the Reverter contract.
+var ReverterMetaData = bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"payable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"run\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"salt\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"v\",\"type\":\"uint256\"}],\"name\":\"transferFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	ID:  "Reverter",
+	Bin: "0x608060405260008055610c17806100176000396000f3fe60806040526004361061002d5760003560e01c806379388e9414610039578063c04062261461006257610034565b3661003457005b600080fd5b34801561004557600080fd5b50610060600480360381019061005b9190610360565b610079565b005b34801561006e57600080fd5b50610077610131565b005b808290604051610088906102d4565b82906040518091039083f590509050801580156100a9573d6000803e3d6000fd5b505061080173ffffffffffffffffffffffffffffffffffffffff166354be1a28306040518263ffffffff1660e01b81526004016100e691906103e1565b600060405180830381865afa158015610103573d6000803e3d6000fd5b505050506040513d6000823e3d601f19601f8201168201806040525081019061012c9190610884565b600080fd5b6000808154809291906101439061092b565b91905055503073ffffffffffffffffffffffffffffffffffffffff166379388e9460005460001b476040518363ffffffff1660e01b8152600401610188929190610991565b600060405180830381600087803b1580156101a257600080fd5b505af19250505080156101b3575060015b61022f5760006101c760005460001b610249565b90508073ffffffffffffffffffffffffffffffffffffffff16633ccfd60b6040518163ffffffff1660e01b8152600401600060405180830381600087803b15801561021157600080fd5b505af1158015610225573d6000803e3d6000fd5b5050505050610230565b5b6000808154809291906102429061092b565b9190505550565b60008060ff60f81b308460405180602001610263906102d4565b6020820181038252601f19601f820116604052506040516020016102879190610a01565b604051602081830303815290604052805190602001206040516020016102b09493929190610ace565b6040516020818303038152906040528051906020012060001c905080915050919050565b60c580610b1d83390190565b6000604051905090565b600080fd5b600080fd5b6000819050919050565b610307816102f4565b811461031257600080fd5b50565b600081359050610324816102fe565b92915050565b6000819050919050565b61033d8161032a565b811461034857600080fd5b50565b60008135905061035a81610334565b92915050565b60008060408385031215610377576103766102ea565b5b600061038585828601610315565b92505060206103968582860161034b565b9150509250929050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b60006103cb826103a0565b9050919050565b6103db816103c0565b82525050565b60006020820190506103f660008301846103d2565b92915050565b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b61044a82610401565b810181811067ffffffffffffffff8211171561046957610468610412565b5b80604052505050565b600061047c6102e0565b90506104888282610441565b919050565b600067ffffffffffffffff8211156104a8576104a7610412565b5b602082029050602081019050919050565b600080fd5b600080fd5b600080fd5b600080fd5b600067ffffffffffffffff8211156104e8576104e7610412565b5b6104f182610401565b9050602081019050919050565b60005b8381101561051c578082015181840152602081019050610501565b60008484015250505050565b600061053b610536846104cd565b610472565b905082815260208101848484011115610557576105566104c8565b5b6105628482856104fe565b509392505050565b600082601f83011261057f5761057e6103fc565b5b815161058f848260208601610528565b91505092915050565b600067ffffffffffffffff8211156105b3576105b2610412565b5b602082029050602081019050919050565b6000815190506105d381610334565b92915050565b600060ff82169050919050565b6105ef816105d9565b81146105fa57600080fd5b50565b60008151905061060c816105e6565b92915050565b600060608284031215610628576106276104be565b5b6106326060610472565b9050600082015167ffffffffffffffff811115610652576106516104c3565b5b61065e8482850161056a565b6000830152506020610672848285016105c4565b6020830152506040610686848285016105fd565b60408301525092915050565b60006106a56106a084610598565b610472565b905080838252602082019050602084028301858111156106c8576106c76104b9565b5b835b8181101561070f57805167ffffffffffffffff8111156106ed576106ec6103fc565b5b8086016106fa8982610612565b855260208501945050506020810190506106ca565b5050509392505050565b600082601f83011261072e5761072d6103fc565b5b815161073e848260208601610692565b91505092915050565b60006040828403121561075d5761075c6104be565b5b6107676040610472565b9050600082015167ffffffffffffffff811115610787576107866104c3565b5b6107938482850161056a565b600083015250602082015167ffffffffffffffff8111156107b7576107b66104c3565b5b6107c384828501610719565b60208301525092915050565b60006107e26107dd8461048d565b610472565b90508083825260208201905060208402830185811115610805576108046104b9565b5b835b8181101561084c57805167ffffffffffffffff81111561082a576108296103fc565b5b8086016108378982610747565b85526020850194505050602081019050610807565b5050509392505050565b600082601f83011261086b5761086a6103fc565b5b815161087b8482602086016107cf565b91505092915050565b6000806040838503121561089b5761089a6102ea565b5b600083015167ffffffffffffffff8111156108b9576108b86102ef565b5b6108c585828601610856565b925050602083015167ffffffffffffffff8111156108e6576108e56102ef565b5b6108f285828601610719565b9150509250929050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b60006109368261032a565b91507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8203610968576109676108fc565b5b600182019050919050565b61097c816102f4565b82525050565b61098b8161032a565b82525050565b60006040820190506109a66000830185610973565b6109b36020830184610982565b9392505050565b600081519050919050565b600081905092915050565b60006109db826109ba565b6109e581856109c5565b93506109f58185602086016104fe565b80840191505092915050565b6000610a0d82846109d0565b915081905092915050565b60007fff0000000000000000000000000000000000000000000000000000000000000082169050919050565b6000819050919050565b610a5f610a5a82610a18565b610a44565b82525050565b60008160601b9050919050565b6000610a7d82610a65565b9050919050565b6000610a8f82610a72565b9050919050565b610aa7610aa2826103c0565b610a84565b82525050565b6000819050919050565b610ac8610ac3826102f4565b610aad565b82525050565b6000610ada8287610a4e565b600182019150610aea8286610a96565b601482019150610afa8285610ab7565b602082019150610b0a8284610ab7565b6020820191508190509594505050505056fe608060405260b3806100126000396000f3fe6080604052348015600f57600080fd5b506004361060285760003560e01c80633ccfd60b14602d575b600080fd5b60336035565b005b3373ffffffffffffffffffffffffffffffffffffffff166108fc479081150290604051600060405180830381858888f19350505050158015607a573d6000803e3d6000fd5b5056fea26469706673582212207da4499020b38137ee79f072192ccaf6e65cda45d0852daec28c1899ffcaea8b64736f6c63430008140033a2646970667358221220626d4a567f7b53f59bf81d33094e24325d52fc78516a5763b7e8d3759f8ee18a64736f6c63430008140033",
+}
+
+// Reverter is an auto generated Go binding around an Ethereum contract.
+type Reverter struct {
+	abi abi.ABI
+}
+
+// NewReverter creates a new instance of Reverter.
+func NewReverter() *Reverter {
+	parsed, err := ReverterMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &Reverter{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *Reverter) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackRun is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc0406226.
+//
+// Solidity: function run() returns()
+func (reverter *Reverter) PackRun() []byte {
+	enc, err := reverter.abi.Pack("run")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// PackTransferFunds is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x79388e94.
+//
+// Solidity: function transferFunds(bytes32 salt, uint256 v) returns()
+func (reverter *Reverter) PackTransferFunds(salt [32]byte, v *big.Int) []byte {
+	enc, err := reverter.abi.Pack("transferFunds", salt, v)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// StorageHogMetaData contains all meta data concerning the StorageHog contract.
+var StorageHogMetaData = bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"slots\",\"type\":\"uint256\"}],\"name\":\"fill\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ID:  "StorageHog",
+	Bin: "0x602f61000d600039602f6000f360003560e01c633fda538914601357600080fd5b60043560005b81811015602d576001810181556001016019565b00",
+}
+
+// StorageHog is an auto generated Go binding around an Ethereum contract.
+type StorageHog struct {
+	abi abi.ABI
+}
+
+// NewStorageHog creates a new instance of StorageHog.
+func NewStorageHog() *StorageHog {
+	parsed, err := StorageHogMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &StorageHog{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *StorageHog) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackFill is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3fda5389.
+//
+// Solidity: function fill(uint256 slots) returns()
+func (storageHog *StorageHog) PackFill(slots *big.Int) []byte {
+	enc, err := storageHog.abi.Pack("fill", slots)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
@@ -0,0 +1,72 @@
+// Package contracts embeds the compiled artifacts of the contracts commonly
+// deployed by the tests, i.e. an ERC20, a reverter, a storage hog and a
+// precompile caller, with their typed Go bindings generated by abigen.
+//
+// The artifacts and the bindings are generated by the gen command, which
+// compiles the Solidity contracts with the solc binary of $SOLC, or reuses
+// their Hardhat artifacts if it is empty.
+package contracts
+
+//go:generate go run ./gen -solc=$SOLC
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	contractutils "github.com/cosmos/evm/contracts/utils"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+//go:embed artifacts/*.json
+var artifacts embed.FS
+
+var (
+	// ERC20MinterBurnerDecimalsContract is an ERC20 with the minter, burner
+	// and pauser roles and configurable decimals.
+	ERC20MinterBurnerDecimalsContract = MustLoad("ERC20MinterBurnerDecimals")
+	// ReverterContract is a contract whose run() calls itself to deploy a
+	// contract and fund it, then reverts the call.
+	ReverterContract = MustLoad("Reverter")
+	// BankCallerContract is a contract calling the bank precompile.
+	BankCallerContract = MustLoad("BankCaller")
+	// StorageHogContract is a contract whose fill(uint256 slots) writes the
+	// given number of storage slots.
+	StorageHogContract = MustLoad("StorageHog")
+)
+
+// Load returns the compiled test contract of the given name.
+func Load(name string) (evmtypes.CompiledContract, error) {
+	bz, err := artifacts.ReadFile(path.Join("artifacts", name+".json"))
+	if err != nil {
+		return evmtypes.CompiledContract{}, fmt.Errorf("unknown test contract %q", name)
+	}
+	return contractutils.ConvertHardhatBytesToCompiledContract(bz)
+}
+
+// MustLoad returns the compiled test contract of the given name and panics if
+// it doesn't exist.
+func MustLoad(name string) evmtypes.CompiledContract {
+	contract, err := Load(name)
+	if err != nil {
+		panic(err)
+	}
+	return contract
+}
+
+// Names returns the sorted names of the test contracts.
+func Names() []string {
+	entries, err := artifacts.ReadDir("artifacts")
+	if err != nil {
+		panic(err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	sort.Strings(names)
+	return names
+}
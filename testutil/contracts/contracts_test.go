@@ -0,0 +1,67 @@
+package contracts_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/testutil/contracts"
+)
+
+func TestLoad(t *testing.T) {
+	require.Equal(t, []string{"BankCaller", "ERC20MinterBurnerDecimals", "Reverter", "StorageHog"}, contracts.Names())
+
+	for _, name := range contracts.Names() {
+		contract, err := contracts.Load(name)
+		require.NoError(t, err, name)
+		require.NotEmpty(t, contract.Bin, name)
+		require.NotEmpty(t, contract.ABI.Methods, name)
+	}
+
+	_, err := contracts.Load("Unknown")
+	require.ErrorContains(t, err, "unknown test contract")
+}
+
+func TestBindings(t *testing.T) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	input, err := erc20.ABI.Pack("transfer", to, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, input, contracts.NewERC20MinterBurnerDecimals().PackTransfer(to, big.NewInt(1)))
+}
+
+func TestStorageHog(t *testing.T) {
+	statedb, err := state.New(common.Hash{}, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	cfg := &runtime.Config{State: statedb}
+	hog := contracts.NewStorageHog()
+
+	var gasUsed []uint64
+	for _, slots := range []int64{1, 2, 3} {
+		_, addr, _, err := runtime.Create(contracts.StorageHogContract.Bin, cfg)
+		require.NoError(t, err)
+
+		_, leftOver, err := runtime.Call(addr, hog.PackFill(big.NewInt(slots)), cfg)
+		require.NoError(t, err)
+		gasUsed = append(gasUsed, cfg.GasLimit-leftOver)
+
+		for i := int64(0); i <= slots; i++ {
+			expValue := common.BigToHash(big.NewInt(i + 1))
+			if i == slots {
+				expValue = common.Hash{}
+			}
+			require.Equal(t, expValue, statedb.GetState(addr, common.BigToHash(big.NewInt(i))))
+		}
+
+		_, _, err = runtime.Call(addr, []byte{0x01, 0x02, 0x03, 0x04}, cfg)
+		require.ErrorIs(t, err, vm.ErrExecutionReverted)
+	}
+	// every slot costs the same
+	require.Equal(t, gasUsed[1]-gasUsed[0], gasUsed[2]-gasUsed[1])
+}
@@ -0,0 +1,228 @@
+// Command gen generates the artifacts of the test contracts and their typed Go
+// bindings. The Solidity contracts are compiled with the solc binary given by
+// the -solc flag, or else taken from the artifacts compiled by Hardhat next to
+// their sources, and the contracts written in EVM assembly are assembled.
+//
+// It is run from the testutil/contracts directory with:
+//
+//	SOLC=solc go generate ./testutil/contracts
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/abigen"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/program"
+)
+
+// hardhatFormat is the format of the Hardhat artifacts.
+const hardhatFormat = "hh-sol-artifact-1"
+
+// contract is a test contract, compiled from its Solidity source or assembled.
+type contract struct {
+	name string
+	// source is the path of the Solidity source relative to the repository.
+	source string
+	// assemble returns the ABI, the init code and the runtime code of the
+	// contracts written in EVM assembly.
+	assemble func() (string, []byte, []byte)
+}
+
+var contracts = []contract{
+	{name: "ERC20MinterBurnerDecimals", source: "contracts/solidity/ERC20MinterBurnerDecimals.sol"},
+	{name: "Reverter", source: "precompiles/testutil/contracts/Reverter.sol"},
+	{name: "BankCaller", source: "precompiles/bank/testdata/BankCaller.sol"},
+	{name: "StorageHog", assemble: assembleStorageHog},
+}
+
+// artifact is a Hardhat artifact.
+type artifact struct {
+	Format                 string          `json:"_format"`
+	ContractName           string          `json:"contractName"`
+	SourceName             string          `json:"sourceName"`
+	ABI                    json.RawMessage `json:"abi"`
+	Bytecode               string          `json:"bytecode"`
+	DeployedBytecode       string          `json:"deployedBytecode"`
+	LinkReferences         json.RawMessage `json:"linkReferences"`
+	DeployedLinkReferences json.RawMessage `json:"deployedLinkReferences"`
+}
+
+func main() {
+	solc := flag.String("solc", "", "path of the solc binary, the Hardhat artifacts are used if empty")
+	root := flag.String("root", "../..", "path of the repository")
+	out := flag.String("out", ".", "output directory of the artifacts and bindings")
+	flag.Parse()
+
+	var types, abis, bytecodes []string
+	for _, c := range contracts {
+		var (
+			a   artifact
+			err error
+		)
+		switch {
+		case c.assemble != nil:
+			a = assembledArtifact(c)
+		case *solc != "":
+			a, err = compile(*solc, *root, c)
+		default:
+			a, err = hardhatArtifact(*root, c)
+		}
+		if err != nil {
+			log.Fatalf("failed to build the artifact of %s: %s", c.name, err)
+		}
+
+		bz, err := json.MarshalIndent(a, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		path := filepath.Join(*out, "artifacts", c.name+".json")
+		if err := os.WriteFile(path, append(bz, '\n'), 0o600); err != nil {
+			log.Fatal(err)
+		}
+
+		types = append(types, c.name)
+		abis = append(abis, string(a.ABI))
+		bytecodes = append(bytecodes, a.Bytecode)
+	}
+
+	bindings, err := abigen.BindV2(types, abis, bytecodes, "contracts", nil, nil)
+	if err != nil {
+		log.Fatalf("failed to generate the bindings: %s", err)
+	}
+	if err := os.WriteFile(filepath.Join(*out, "bindings.go"), []byte(bindings), 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// hardhatArtifact returns the artifact compiled by Hardhat next to the source
+// of the contract.
+func hardhatArtifact(root string, c contract) (artifact, error) {
+	path := filepath.Join(root, strings.TrimSuffix(c.source, ".sol")+".json")
+	bz, err := os.ReadFile(path) // #nosec G304 -- the paths of the artifacts are fixed
+	if err != nil {
+		return artifact{}, err
+	}
+
+	var a artifact
+	if err := json.Unmarshal(bz, &a); err != nil {
+		return artifact{}, err
+	}
+	return a, nil
+}
+
+// compile compiles the Solidity source of the contract with solc.
+func compile(solc, root string, c contract) (artifact, error) {
+	// #nosec G204 -- solc is given by the developer running the generator
+	cmd := exec.Command(solc,
+		"--combined-json", "abi,bin,bin-runtime",
+		"--optimize",
+		"--base-path", ".",
+		"--include-path", "contracts/node_modules",
+		c.source,
+	)
+	cmd.Dir = root
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return artifact{}, fmt.Errorf("%w: %s", err, stderr.String())
+	}
+
+	var res struct {
+		Contracts map[string]struct {
+			ABI        json.RawMessage `json:"abi"`
+			Bin        string          `json:"bin"`
+			BinRuntime string          `json:"bin-runtime"`
+		} `json:"contracts"`
+	}
+	if err := json.Unmarshal(output, &res); err != nil {
+		return artifact{}, err
+	}
+	compiled, found := res.Contracts[c.source+":"+c.name]
+	if !found {
+		return artifact{}, fmt.Errorf("contract not found in the solc output of %s", c.source)
+	}
+
+	// older solc versions encode the ABI as a string
+	abiJSON := compiled.ABI
+	var abiString string
+	if err := json.Unmarshal(abiJSON, &abiString); err == nil {
+		abiJSON = json.RawMessage(abiString)
+	}
+
+	return artifact{
+		Format:                 hardhatFormat,
+		ContractName:           c.name,
+		SourceName:             c.source,
+		ABI:                    abiJSON,
+		Bytecode:               "0x" + compiled.Bin,
+		DeployedBytecode:       "0x" + compiled.BinRuntime,
+		LinkReferences:         json.RawMessage("{}"),
+		DeployedLinkReferences: json.RawMessage("{}"),
+	}, nil
+}
+
+// assembledArtifact returns the artifact of the contract written in EVM
+// assembly.
+func assembledArtifact(c contract) artifact {
+	abiJSON, initCode, runtimeCode := c.assemble()
+	return artifact{
+		Format:                 hardhatFormat,
+		ContractName:           c.name,
+		SourceName:             "testutil/contracts/gen/main.go",
+		ABI:                    json.RawMessage(abiJSON),
+		Bytecode:               hexutil.Encode(initCode),
+		DeployedBytecode:       hexutil.Encode(runtimeCode),
+		LinkReferences:         json.RawMessage("{}"),
+		DeployedLinkReferences: json.RawMessage("{}"),
+	}
+}
+
+// assembleStorageHog assembles the StorageHog, whose fill(uint256 slots) sets
+// the slots 0 to slots-1 of its storage to 1 to slots, so that the gas of a
+// call grows linearly with the number of slots, without the overhead of the
+// code generated by solc.
+func assembleStorageHog() (string, []byte, []byte) {
+	const abiJSON = `[{"inputs":[{"internalType":"uint256","name":"slots","type":"uint256"}],"name":"fill","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+	selector := parsed.Methods["fill"].ID
+
+	// the code is assembled twice, to resolve the forward jumps
+	var fill, end uint64
+	var runtime *program.Program
+	for range 2 {
+		runtime = program.New()
+		// revert the calls of the other selectors
+		runtime.Push(0).Op(vm.CALLDATALOAD).Push(224).Op(vm.SHR).Push(selector).Op(vm.EQ)
+		runtime.Push(fill).Op(vm.JUMPI)
+		runtime.Push(0).Op(vm.DUP1, vm.REVERT)
+
+		// stack: [i, slots] with i from 0 to slots
+		_, fill = runtime.Jumpdest()
+		runtime.Push(4).Op(vm.CALLDATALOAD).Push(0)
+		_, loop := runtime.Jumpdest()
+		runtime.Op(vm.DUP2, vm.DUP2, vm.LT, vm.ISZERO).Push(end).Op(vm.JUMPI)
+		// storage[i] = i + 1
+		runtime.Push(1).Op(vm.DUP2, vm.ADD, vm.DUP2, vm.SSTORE)
+		runtime.Push(1).Op(vm.ADD).Jump(loop)
+		_, end = runtime.Jumpdest()
+		runtime.Op(vm.STOP)
+	}
+
+	initCode := program.New().ReturnViaCodeCopy(runtime.Bytes())
+	return abiJSON, initCode.Bytes(), runtime.Bytes()
+}
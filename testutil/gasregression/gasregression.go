@@ -0,0 +1,136 @@
+// Package gasregression tracks the gas used by a fixed set of scenarios in
+// versioned fixtures. A change of the gas used by a tx breaks the consensus
+// between the validators running different versions, so the gas used must
+// only change on purpose, with a new version of the fixtures.
+package gasregression
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// UpdateEnv is the environment variable updating the fixture of the current
+// version with the recorded gas when it's set.
+const UpdateEnv = "UPDATE_GAS_FIXTURES"
+
+// Fixture is the gas used by each scenario.
+type Fixture map[string]uint64
+
+// Tracker records the gas used by the scenarios and checks it against the
+// fixture of its version, stored in its directory as v<version>.json.
+type Tracker struct {
+	dir     string
+	version uint
+	gas     Fixture
+}
+
+// NewTracker returns a tracker of the gas used by the scenarios against the
+// fixture of the given version in the given directory.
+func NewTracker(dir string, version uint) *Tracker {
+	return &Tracker{
+		dir:     dir,
+		version: version,
+		gas:     make(Fixture),
+	}
+}
+
+// Path returns the path of the fixture of the version of the tracker.
+func (t *Tracker) Path() string {
+	return filepath.Join(t.dir, fmt.Sprintf("v%d.json", t.version))
+}
+
+// Record records the gas used by the scenario.
+func (t *Tracker) Record(scenario string, gasUsed uint64) {
+	t.gas[scenario] = gasUsed
+}
+
+// Check returns an error if the recorded gas differs from the fixture. With
+// update, the fixture is written with the recorded gas instead, unless the gas
+// of one of its scenarios changed, which requires a new version.
+func (t *Tracker) Check(update bool) error {
+	want, err := LoadFixture(t.Path())
+	switch {
+	case errors.Is(err, os.ErrNotExist) && update:
+		return t.write()
+	case errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("missing gas fixture %s, generate it with %s=1", t.Path(), UpdateEnv)
+	case err != nil:
+		return err
+	}
+
+	changed, added, removed := diff(want, t.gas)
+	if update {
+		if len(changed) > 0 {
+			return fmt.Errorf(
+				"the gas used by the scenarios of version %d can't change, bump the version to generate a new fixture:\n%s",
+				t.version, strings.Join(changed, "\n"),
+			)
+		}
+		return t.write()
+	}
+
+	diffs := append(append(changed, added...), removed...)
+	if len(diffs) == 0 {
+		return nil
+	}
+	sort.Strings(diffs)
+	return fmt.Errorf(
+		"the gas used differs from the fixture %s:\n%s\nif the change is intended, bump the version of the gas fixtures and generate the new fixture with %s=1",
+		t.Path(), strings.Join(diffs, "\n"), UpdateEnv,
+	)
+}
+
+// write writes the recorded gas to the fixture of the version.
+func (t *Tracker) write() error {
+	bz, err := json.MarshalIndent(t.gas, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(t.dir, 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(t.Path(), append(bz, '\n'), 0o600)
+}
+
+// LoadFixture loads the fixture of the given path.
+func LoadFixture(path string) (Fixture, error) {
+	bz, err := os.ReadFile(path) // #nosec G304 -- the fixtures are fixed
+	if err != nil {
+		return nil, err
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(bz, &fixture); err != nil {
+		return nil, fmt.Errorf("invalid gas fixture %s: %w", path, err)
+	}
+	return fixture, nil
+}
+
+// diff returns the sorted descriptions of the scenarios whose gas changed, and
+// of the ones added or removed from the fixture.
+func diff(want, got Fixture) (changed, added, removed []string) {
+	for scenario, gas := range got {
+		wantGas, found := want[scenario]
+		switch {
+		case !found:
+			added = append(added, fmt.Sprintf("%s: new scenario using %d gas", scenario, gas))
+		case gas != wantGas:
+			changed = append(changed, fmt.Sprintf("%s: %d gas used, expected %d (%+d)", scenario, gas, wantGas, int64(gas)-int64(wantGas))) //#nosec G115 -- the gas of the scenarios doesn't overflow int64
+		}
+	}
+	for scenario := range want {
+		if _, found := got[scenario]; !found {
+			removed = append(removed, fmt.Sprintf("%s: scenario removed", scenario))
+		}
+	}
+
+	sort.Strings(changed)
+	sort.Strings(added)
+	sort.Strings(removed)
+	return changed, added, removed
+}
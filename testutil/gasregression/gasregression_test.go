@@ -0,0 +1,54 @@
+package gasregression_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/testutil/gasregression"
+)
+
+func newTracker(dir string, version uint, gas gasregression.Fixture) *gasregression.Tracker {
+	tracker := gasregression.NewTracker(dir, version)
+	for scenario, gasUsed := range gas {
+		tracker.Record(scenario, gasUsed)
+	}
+	return tracker
+}
+
+func TestTracker(t *testing.T) {
+	dir := t.TempDir()
+	gas := gasregression.Fixture{"transfer": 21_000, "erc20_transfer": 34_512}
+
+	// the missing fixture must be generated
+	tracker := newTracker(dir, 1, gas)
+	require.Equal(t, filepath.Join(dir, "v1.json"), tracker.Path())
+	require.ErrorContains(t, tracker.Check(false), "missing gas fixture")
+	require.NoError(t, tracker.Check(true))
+
+	fixture, err := gasregression.LoadFixture(tracker.Path())
+	require.NoError(t, err)
+	require.Equal(t, gas, fixture)
+	require.NoError(t, newTracker(dir, 1, gas).Check(false))
+
+	// a gas change fails, and can't update the fixture of the version
+	changed := gasregression.Fixture{"transfer": 21_000, "erc20_transfer": 34_500, "bank_balances": 5_000}
+	err = newTracker(dir, 1, changed).Check(false)
+	require.ErrorContains(t, err, "erc20_transfer: 34500 gas used, expected 34512 (-12)")
+	require.ErrorContains(t, err, "bank_balances: new scenario using 5000 gas")
+	require.ErrorContains(t, newTracker(dir, 1, changed).Check(true), "bump the version")
+
+	// so it's recorded in the fixture of a new version
+	require.NoError(t, newTracker(dir, 2, changed).Check(true))
+	require.NoError(t, newTracker(dir, 2, changed).Check(false))
+	require.NoError(t, newTracker(dir, 1, gas).Check(false))
+
+	// the scenarios are added to and removed from the fixture of the version
+	err = newTracker(dir, 2, gasregression.Fixture{"transfer": 21_000}).Check(false)
+	require.ErrorContains(t, err, "erc20_transfer: scenario removed")
+	require.NoError(t, newTracker(dir, 2, gasregression.Fixture{"transfer": 21_000, "p256": 3_450}).Check(true))
+	fixture, err = gasregression.LoadFixture(filepath.Join(dir, "v2.json"))
+	require.NoError(t, err)
+	require.Equal(t, gasregression.Fixture{"transfer": 21_000, "p256": 3_450}, fixture)
+}
@@ -6,8 +6,6 @@ import (
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
-
-	storetypes "cosmossdk.io/store/types"
 )
 
 // NextBlock is a private helper function that runs the EndBlocker logic, commits the changes,
@@ -41,6 +39,9 @@ func (n *IntegrationNetwork) finalizeBlockAndCommit(duration time.Duration, txBy
 	// Calculate new block time after duration
 	newBlockTime := header.Time.Add(duration)
 	header.Time = newBlockTime
+	// Rotate the proposer as CometBFT does, in proportion to the validator powers
+	n.valSet = n.valSet.CopyIncrementProposerPriority(1)
+	header.ProposerAddress = n.valSet.Proposer.Address
 
 	// FinalizeBlock to run endBlock, deliverTx & beginBlock logic
 	req := buildFinalizeBlockReq(header, n.valSet.Validators, txBytes...)
@@ -57,8 +58,7 @@ func (n *IntegrationNetwork) finalizeBlockAndCommit(duration time.Duration, txBy
 	newCtx = newCtx.WithKVGasConfig(n.ctx.KVGasConfig())
 	newCtx = newCtx.WithTransientKVGasConfig(n.ctx.TransientKVGasConfig())
 	newCtx = newCtx.WithConsensusParams(n.ctx.ConsensusParams())
-	// This might have to be changed with time if we want to test gas limits
-	newCtx = newCtx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	newCtx = newCtx.WithBlockGasMeter(newBlockGasMeter(n.ctx.ConsensusParams()))
 	newCtx = newCtx.WithVoteInfos(req.DecidedLastCommit.GetVotes())
 	n.ctx = newCtx
 
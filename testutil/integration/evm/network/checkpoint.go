@@ -0,0 +1,82 @@
+package network
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Checkpoint is a committed state of the network, restored to run several tests
+// from the same state without repeating its setup.
+type Checkpoint struct {
+	// height is the height of the block executed again to restore the state
+	height  int64
+	appHash []byte
+
+	// ctx and valSet are the context and the validator set before the block
+	ctx    sdktypes.Context
+	valSet *cmttypes.ValidatorSet
+}
+
+// Checkpoint commits the state of the network, including the changes made
+// directly with its context e.g. through the keepers, and returns the checkpoint
+// of this state.
+func (n *IntegrationNetwork) Checkpoint() (Checkpoint, error) {
+	ms, ok := n.ctx.MultiStore().(storetypes.CacheMultiStore)
+	if !ok {
+		return Checkpoint{}, errors.New("the store of the network context isn't a cache multi store")
+	}
+	ms.Write()
+	if err := n.NextBlock(); err != nil {
+		return Checkpoint{}, err
+	}
+
+	// the state is restored by rolling back the next block and executing it
+	// again, which resets the state of the app as a regular block does
+	cp := Checkpoint{
+		height: n.ctx.BlockHeight() + 1,
+		ctx:    n.ctx,
+		valSet: n.valSet,
+	}
+	if err := n.NextBlock(); err != nil {
+		return Checkpoint{}, err
+	}
+	cp.appHash = n.app.LastCommitID().Hash
+
+	return cp, nil
+}
+
+// Restore restores the state of the network to the given checkpoint, discarding
+// the blocks committed after it.
+func (n *IntegrationNetwork) Restore(cp Checkpoint) error {
+	if err := n.app.GetBaseApp().CommitMultiStore().RollbackToVersion(cp.height - 1); err != nil {
+		return err
+	}
+
+	// discard the block the app may be finalizing on top of the rolled back state
+	if _, err := n.app.GetBaseApp().ProcessProposal(&abcitypes.RequestProcessProposal{
+		Height: cp.height,
+		Time:   cp.ctx.BlockTime().Add(time.Second),
+	}); err != nil {
+		return err
+	}
+
+	n.ctx = cp.ctx
+	n.valSet = cp.valSet
+	if _, err := n.finalizeBlockAndCommit(time.Second); err != nil {
+		return err
+	}
+
+	if appHash := n.app.LastCommitID().Hash; !bytes.Equal(appHash, cp.appHash) {
+		return fmt.Errorf("restored app hash %X, expected %X", appHash, cp.appHash)
+	}
+	return nil
+}
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"math/big"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration"
 	testtx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -34,6 +37,12 @@ type Config struct {
 	amountOfValidators  int
 	operatorsAddrs      []sdktypes.AccAddress
 	initialBondedAmount math.Int
+	// validatorPowers are the consensus powers of the validators, which all
+	// have a power of 1 if empty.
+	validatorPowers []int64
+
+	consensusParams *cmtproto.ConsensusParams
+	evmParams       *evmtypes.Params
 
 	chainCoins     ChainCoins
 	initialAmounts InitialAmounts
@@ -57,6 +66,7 @@ func DefaultConfig() Config {
 		initialAmounts:      DefaultInitialAmounts(),
 		initialBondedAmount: DefaultInitialBondedAmount(),
 		amountOfValidators:  3,
+		consensusParams:     integration.DefaultConsensusParams,
 
 		// Only one account besides the validators
 		preFundedAccounts: []sdktypes.AccAddress{account},
@@ -144,6 +154,37 @@ func WithAmountOfValidators(amount int) ConfigOption {
 	}
 }
 
+// WithValidatorPowers sets the amount of validators for the network and their
+// consensus powers, so that the proposer rotates in proportion to them.
+func WithValidatorPowers(powers ...int64) ConfigOption {
+	for _, power := range powers {
+		if power <= 0 {
+			panic(fmt.Sprintf("invalid validator power %d: must be positive", power))
+		}
+	}
+
+	return func(cfg *Config) {
+		cfg.amountOfValidators = len(powers)
+		cfg.validatorPowers = powers
+	}
+}
+
+// WithConsensusParams sets the consensus params of the network, e.g. to set
+// the max gas of the blocks.
+func WithConsensusParams(params *cmtproto.ConsensusParams) ConfigOption {
+	return func(cfg *Config) {
+		cfg.consensusParams = params
+	}
+}
+
+// WithEVMParams sets the params of the EVM module in the genesis of the
+// network, over the custom EVM genesis if any.
+func WithEVMParams(params evmtypes.Params) ConfigOption {
+	return func(cfg *Config) {
+		cfg.evmParams = &params
+	}
+}
+
 // WithPreFundedAccounts sets the pre-funded accounts for the network.
 func WithPreFundedAccounts(accounts ...sdktypes.AccAddress) ConfigOption {
 	return func(cfg *Config) {
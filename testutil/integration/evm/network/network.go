@@ -16,7 +16,6 @@ import (
 	"github.com/cometbft/cometbft/version"
 
 	"github.com/cosmos/evm"
-	"github.com/cosmos/evm/testutil/integration"
 	basenetwork "github.com/cosmos/evm/testutil/integration/base/network"
 	"github.com/cosmos/evm/types"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
@@ -24,6 +23,7 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
@@ -117,9 +117,9 @@ func (n *IntegrationNetwork) configureAndInitChain(evmApp evm.EvmApp) error {
 	bondedAmount := GetInitialBondedAmount(baseDecimals)
 
 	// create validator set with the amount of validators specified in the config
-	// with the default power of 1.
-	valSet, valSigners := createValidatorSetAndSigners(n.cfg.amountOfValidators)
-	totalBonded := bondedAmount.Mul(sdkmath.NewInt(int64(n.cfg.amountOfValidators)))
+	// with their powers, or with the default power of 1.
+	valSet, valSigners := createValidatorSetAndSigners(n.cfg.amountOfValidators, n.cfg.validatorPowers)
+	totalBonded := bondedAmount.Mul(sdkmath.NewInt(valSet.TotalVotingPower()))
 
 	// Build staking type validators and delegations
 	validators, err := createStakingValidators(valSet.Validators, bondedAmount, n.cfg.operatorsAddrs)
@@ -191,13 +191,17 @@ func (n *IntegrationNetwork) configureAndInitChain(evmApp evm.EvmApp) error {
 		return err
 	}
 
+	if n.cfg.evmParams != nil {
+		genesisState = setEVMParams(evmApp, genesisState, *n.cfg.evmParams)
+	}
+
 	// Init chain
 	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
 	if err != nil {
 		return err
 	}
 
-	consensusParams := integration.DefaultConsensusParams
+	consensusParams := n.cfg.consensusParams
 	now := time.Now()
 
 	if _, err = evmApp.InitChain(
@@ -239,14 +243,9 @@ func (n *IntegrationNetwork) configureAndInitChain(evmApp evm.EvmApp) error {
 	}
 
 	// Set networks global parameters
-	var blockMaxGas uint64 = math.MaxUint64
-	if consensusParams.Block != nil && consensusParams.Block.MaxGas > 0 {
-		blockMaxGas = uint64(consensusParams.Block.MaxGas) //#nosec G115 -- max gas will not exceed uint64
-	}
-
 	n.app = evmApp
 	n.ctx = n.ctx.WithConsensusParams(*consensusParams)
-	n.ctx = n.ctx.WithBlockGasMeter(types.NewInfiniteGasMeterWithLimit(blockMaxGas))
+	n.ctx = n.ctx.WithBlockGasMeter(newBlockGasMeter(*consensusParams))
 
 	n.validators = validators
 	n.valSet = valSet
@@ -255,6 +254,16 @@ func (n *IntegrationNetwork) configureAndInitChain(evmApp evm.EvmApp) error {
 	return nil
 }
 
+// newBlockGasMeter returns the gas meter of the blocks, limited to the max gas
+// of the consensus params if any.
+func newBlockGasMeter(consensusParams cmtproto.ConsensusParams) storetypes.GasMeter {
+	var blockMaxGas uint64 = math.MaxUint64
+	if consensusParams.Block != nil && consensusParams.Block.MaxGas > 0 {
+		blockMaxGas = uint64(consensusParams.Block.MaxGas) //#nosec G115 -- max gas will not exceed uint64
+	}
+	return types.NewInfiniteGasMeterWithLimit(blockMaxGas)
+}
+
 // GetConfig returns the network's configuration
 func (n *IntegrationNetwork) GetBaseDecimal() evmtypes.Decimals {
 	return n.baseDecimal
@@ -84,16 +84,21 @@ func genStateSetter[T proto.Message](moduleName string) genSetupFn {
 }
 
 // createValidatorSetAndSigners creates validator set with the amount of validators specified
-// with the default power of 1.
-func createValidatorSetAndSigners(numberOfValidators int) (*cmttypes.ValidatorSet, map[string]cmttypes.PrivValidator) {
+// with the given powers, or with the default power of 1 if there are none.
+func createValidatorSetAndSigners(numberOfValidators int, powers []int64) (*cmttypes.ValidatorSet, map[string]cmttypes.PrivValidator) {
 	// create validator set
 	tmValidators := make([]*cmttypes.Validator, 0, numberOfValidators)
 	signers := make(map[string]cmttypes.PrivValidator, numberOfValidators)
 
 	for i := 0; i < numberOfValidators; i++ {
+		power := int64(1)
+		if len(powers) > 0 {
+			power = powers[i]
+		}
+
 		privVal := mock.NewPV()
 		pubKey, _ := privVal.GetPubKey()
-		validator := cmttypes.NewValidator(pubKey, 1)
+		validator := cmttypes.NewValidator(pubKey, power)
 		tmValidators = append(tmValidators, validator)
 		signers[pubKey.Address().String()] = privVal
 	}
@@ -189,7 +194,7 @@ func createStakingValidator(val *cmttypes.Validator, bondedAmt sdkmath.Int, oper
 }
 
 // createStakingValidators creates staking validators from the given tm validators and bonded
-// amounts
+// amount per power
 func createStakingValidators(tmValidators []*cmttypes.Validator, bondedAmt sdkmath.Int, operatorsAddresses []sdktypes.AccAddress) ([]stakingtypes.Validator, error) {
 	if len(operatorsAddresses) == 0 {
 		return createStakingValidatorsWithRandomOperator(tmValidators, bondedAmt)
@@ -202,7 +207,7 @@ func createStakingValidatorsWithRandomOperator(tmValidators []*cmttypes.Validato
 	amountOfValidators := len(tmValidators)
 	stakingValidators := make([]stakingtypes.Validator, 0, amountOfValidators)
 	for _, val := range tmValidators {
-		validator, err := createStakingValidator(val, bondedAmt, nil)
+		validator, err := createStakingValidator(val, bondedAmt.MulRaw(val.VotingPower), nil)
 		if err != nil {
 			return nil, err
 		}
@@ -220,7 +225,7 @@ func createStakingValidatorsWithSpecificOperator(tmValidators []*cmttypes.Valida
 		panic(fmt.Sprintf("provided %d validator operator keys but need %d!", operatorsCount, amountOfValidators))
 	}
 	for i, val := range tmValidators {
-		validator, err := createStakingValidator(val, bondedAmt, &operatorsAddresses[i])
+		validator, err := createStakingValidator(val, bondedAmt.MulRaw(val.VotingPower), &operatorsAddresses[i])
 		if err != nil {
 			return nil, err
 		}
@@ -494,6 +499,15 @@ func newDefaultGenesisState(cosmosEVMApp evm.EvmApp, evmChainID uint64, params d
 	return genesisState
 }
 
+// setEVMParams sets the params of the EVM genesis state
+func setEVMParams(cosmosEVMApp evm.EvmApp, genesisState cosmosevmtypes.GenesisState, params evmtypes.Params) cosmosevmtypes.GenesisState {
+	evmGen := &evmtypes.GenesisState{}
+	cosmosEVMApp.AppCodec().MustUnmarshalJSON(genesisState[evmtypes.ModuleName], evmGen)
+	evmGen.Params = params
+	genesisState[evmtypes.ModuleName] = cosmosEVMApp.AppCodec().MustMarshalJSON(evmGen)
+	return genesisState
+}
+
 // customizeGenesis modifies genesis state if there are any custom genesis state
 // for specific modules
 func customizeGenesis(cosmosEVMApp evm.EvmApp, customGen CustomGenesisState, genesisState cosmosevmtypes.GenesisState) (cosmosevmtypes.GenesisState, error) {
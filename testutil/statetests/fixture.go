@@ -0,0 +1,258 @@
+package statetests
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+)
+
+// StateTest is a test of the GeneralStateTests format of the Ethereum tests,
+// also used by the state_test fixtures of the execution-spec-tests. It applies
+// a transaction, with the data, gas and value of each subtest, on a pre state
+// and checks the root of the post state and the hash of the logs, per fork.
+type StateTest struct {
+	// Name is the name of the test, prefixed by the file it was loaded from.
+	Name string
+
+	Env  stEnv                    `json:"env"`
+	Pre  ethtypes.GenesisAlloc    `json:"pre"`
+	Tx   stTransaction            `json:"transaction"`
+	Post map[string][]stPostState `json:"post"`
+}
+
+// Subtest selects the post state of a fork of a StateTest.
+type Subtest struct {
+	Fork  string
+	Index int
+}
+
+func (s Subtest) String() string {
+	return fmt.Sprintf("%s/%d", s.Fork, s.Index)
+}
+
+type stEnv struct {
+	Coinbase   common.UnprefixedAddress `json:"currentCoinbase"`
+	Difficulty *math.HexOrDecimal256    `json:"currentDifficulty"`
+	Random     *math.HexOrDecimal256    `json:"currentRandom"`
+	GasLimit   math.HexOrDecimal64      `json:"currentGasLimit"`
+	Number     math.HexOrDecimal64      `json:"currentNumber"`
+	Timestamp  math.HexOrDecimal64      `json:"currentTimestamp"`
+	BaseFee    *math.HexOrDecimal256    `json:"currentBaseFee"`
+}
+
+type stTransaction struct {
+	GasPrice             *math.HexOrDecimal256  `json:"gasPrice"`
+	MaxFeePerGas         *math.HexOrDecimal256  `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *math.HexOrDecimal256  `json:"maxPriorityFeePerGas"`
+	Nonce                math.HexOrDecimal64    `json:"nonce"`
+	To                   string                 `json:"to"`
+	Data                 []string               `json:"data"`
+	AccessLists          []*ethtypes.AccessList `json:"accessLists,omitempty"`
+	GasLimit             []math.HexOrDecimal64  `json:"gasLimit"`
+	Value                []string               `json:"value"`
+	PrivateKey           hexutil.Bytes          `json:"secretKey"`
+	Sender               *common.Address        `json:"sender"`
+	BlobVersionedHashes  []common.Hash          `json:"blobVersionedHashes,omitempty"`
+	AuthorizationList    []*stAuthorization     `json:"authorizationList,omitempty"`
+}
+
+type stAuthorization struct {
+	ChainID *math.HexOrDecimal256 `json:"chainId"`
+	Address common.Address        `json:"address"`
+	Nonce   math.HexOrDecimal64   `json:"nonce"`
+	V       math.HexOrDecimal64   `json:"v"`
+	R       *math.HexOrDecimal256 `json:"r"`
+	S       *math.HexOrDecimal256 `json:"s"`
+}
+
+type stPostState struct {
+	Root            common.UnprefixedHash `json:"hash"`
+	Logs            common.UnprefixedHash `json:"logs"`
+	ExpectException string                `json:"expectException"`
+	Indexes         struct {
+		Data  int `json:"data"`
+		Gas   int `json:"gas"`
+		Value int `json:"value"`
+	} `json:"indexes"`
+}
+
+// LoadStateTests loads the state tests of the JSON file at the path, or of
+// all the JSON files under the path if it's a directory. The tests are sorted
+// by name.
+func LoadStateTests(path string) ([]*StateTest, error) {
+	var tests []*StateTest
+	err := filepath.WalkDir(path, func(file string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(file) != ".json" {
+			return nil
+		}
+
+		bz, err := os.ReadFile(file) // #nosec G304 -- the fixtures are chosen by the caller
+		if err != nil {
+			return err
+		}
+		var fileTests map[string]*StateTest
+		if err := json.Unmarshal(bz, &fileTests); err != nil {
+			return fmt.Errorf("failed to decode the state tests of %s: %w", file, err)
+		}
+
+		rel, err := filepath.Rel(path, file)
+		if err != nil || rel == "." {
+			rel = filepath.Base(file)
+		}
+		for name, test := range fileTests {
+			test.Name = rel + "/" + name
+			tests = append(tests, test)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(tests, func(i, j int) bool { return tests[i].Name < tests[j].Name })
+	return tests, nil
+}
+
+// LoadBundledStateTests loads the state tests bundled with the package, which
+// pass on the Ethereum execution and cover the basics of the runner.
+func LoadBundledStateTests() ([]*StateTest, error) {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return nil, fmt.Errorf("could not get the package directory")
+	}
+	return LoadStateTests(filepath.Join(filepath.Dir(file), "testdata"))
+}
+
+// Subtests returns the subtests of all the forks of the test, sorted by fork.
+func (t *StateTest) Subtests() []Subtest {
+	var subtests []Subtest
+	for fork, posts := range t.Post {
+		for i := range posts {
+			subtests = append(subtests, Subtest{Fork: fork, Index: i})
+		}
+	}
+	sort.Slice(subtests, func(i, j int) bool {
+		if subtests[i].Fork != subtests[j].Fork {
+			return subtests[i].Fork < subtests[j].Fork
+		}
+		return subtests[i].Index < subtests[j].Index
+	})
+	return subtests
+}
+
+// message returns the message of the transaction of the subtest, with the gas
+// price effective at the base fee.
+func (t *StateTest) message(subtest Subtest, baseFee *big.Int) (*core.Message, error) {
+	post := t.Post[subtest.Fork][subtest.Index]
+	tx := t.Tx
+
+	var from common.Address
+	switch {
+	case tx.Sender != nil:
+		from = *tx.Sender
+	case len(tx.PrivateKey) > 0:
+		key, err := crypto.ToECDSA(tx.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		from = crypto.PubkeyToAddress(key.PublicKey)
+	default:
+		return nil, fmt.Errorf("no sender")
+	}
+
+	var to *common.Address
+	if tx.To != "" {
+		to = new(common.Address)
+		if err := to.UnmarshalText([]byte(tx.To)); err != nil {
+			return nil, fmt.Errorf("invalid to address: %w", err)
+		}
+	}
+
+	if post.Indexes.Data >= len(tx.Data) || post.Indexes.Value >= len(tx.Value) || post.Indexes.Gas >= len(tx.GasLimit) {
+		return nil, fmt.Errorf("indexes %+v out of bounds", post.Indexes)
+	}
+	data, err := hexutil.Decode(withHexPrefix(tx.Data[post.Indexes.Data]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx data: %w", err)
+	}
+	value := new(big.Int)
+	if valueHex := tx.Value[post.Indexes.Value]; valueHex != "0x" {
+		var ok bool
+		if value, ok = math.ParseBig256(valueHex); !ok {
+			return nil, fmt.Errorf("invalid tx value %q", valueHex)
+		}
+	}
+	var accessList ethtypes.AccessList
+	if len(tx.AccessLists) > post.Indexes.Data && tx.AccessLists[post.Indexes.Data] != nil {
+		accessList = *tx.AccessLists[post.Indexes.Data]
+	}
+
+	// the legacy txs pay their gas price, the dynamic fee txs their tip above
+	// the base fee up to their fee cap
+	gasPrice := (*big.Int)(tx.GasPrice)
+	gasFeeCap, gasTipCap := gasPrice, gasPrice
+	if tx.MaxFeePerGas != nil {
+		gasFeeCap = (*big.Int)(tx.MaxFeePerGas)
+		gasTipCap = (*big.Int)(tx.MaxPriorityFeePerGas)
+		if gasTipCap == nil {
+			gasTipCap = gasFeeCap
+		}
+		gasPrice = new(big.Int).Add(gasTipCap, baseFee)
+		if gasPrice.Cmp(gasFeeCap) > 0 {
+			gasPrice = gasFeeCap
+		}
+	}
+	if gasPrice == nil {
+		return nil, fmt.Errorf("no gas price")
+	}
+
+	var authorizations []ethtypes.SetCodeAuthorization
+	for _, auth := range tx.AuthorizationList {
+		authorizations = append(authorizations, ethtypes.SetCodeAuthorization{
+			ChainID: *uint256.MustFromBig((*big.Int)(auth.ChainID)),
+			Address: auth.Address,
+			Nonce:   uint64(auth.Nonce),
+			V:       uint8(auth.V), //#nosec G115 -- the v of a signature is a byte
+			R:       *uint256.MustFromBig((*big.Int)(auth.R)),
+			S:       *uint256.MustFromBig((*big.Int)(auth.S)),
+		})
+	}
+
+	return &core.Message{
+		From:                  from,
+		To:                    to,
+		Nonce:                 uint64(tx.Nonce),
+		Value:                 value,
+		GasLimit:              uint64(tx.GasLimit[post.Indexes.Gas]),
+		GasPrice:              gasPrice,
+		GasFeeCap:             gasFeeCap,
+		GasTipCap:             gasTipCap,
+		Data:                  data,
+		AccessList:            accessList,
+		BlobHashes:            tx.BlobVersionedHashes,
+		SetCodeAuthorizations: authorizations,
+	}, nil
+}
+
+func withHexPrefix(s string) string {
+	if strings.HasPrefix(s, "0x") {
+		return s
+	}
+	return "0x" + s
+}
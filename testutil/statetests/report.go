@@ -0,0 +1,81 @@
+package statetests
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ForkResult is the result of the subtests of a fork.
+type ForkResult struct {
+	Passed  int
+	Failed  int
+	Skipped int
+	// Failures are the names of the failed subtests with their errors.
+	Failures []string
+}
+
+// PassRate returns the ratio of the subtests which ran that passed.
+func (r ForkResult) PassRate() float64 {
+	if r.Passed+r.Failed == 0 {
+		return 0
+	}
+	return float64(r.Passed) / float64(r.Passed+r.Failed)
+}
+
+// Report is the result of the state tests per fork, which tracks the
+// equivalence of the EVM with the Ethereum execution.
+type Report struct {
+	Forks map[string]*ForkResult
+}
+
+// NewReport returns an empty report.
+func NewReport() *Report {
+	return &Report{Forks: make(map[string]*ForkResult)}
+}
+
+// Add adds the result of a subtest, which is skipped if err is ErrSkipped.
+func (r *Report) Add(name string, subtest Subtest, err error) {
+	result, ok := r.Forks[subtest.Fork]
+	if !ok {
+		result = &ForkResult{}
+		r.Forks[subtest.Fork] = result
+	}
+
+	switch {
+	case err == nil:
+		result.Passed++
+	case errors.Is(err, ErrSkipped):
+		result.Skipped++
+	default:
+		result.Failed++
+		result.Failures = append(result.Failures, fmt.Sprintf("%s/%s: %s", name, subtest, err))
+	}
+}
+
+// Failed returns the number of failed subtests of all the forks.
+func (r *Report) Failed() int {
+	failed := 0
+	for _, result := range r.Forks {
+		failed += result.Failed
+	}
+	return failed
+}
+
+// String returns the pass rates of the forks, sorted by name.
+func (r *Report) String() string {
+	forks := make([]string, 0, len(r.Forks))
+	for fork := range r.Forks {
+		forks = append(forks, fork)
+	}
+	sort.Strings(forks)
+
+	var sb strings.Builder
+	for _, fork := range forks {
+		result := r.Forks[fork]
+		fmt.Fprintf(&sb, "%s: %d/%d passed (%.2f%%), %d skipped\n",
+			fork, result.Passed, result.Passed+result.Failed, result.PassRate()*100, result.Skipped)
+	}
+	return sb.String()
+}
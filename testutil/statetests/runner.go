@@ -0,0 +1,350 @@
+package statetests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrSkipped is returned for the subtests that can't run against the keeper,
+// e.g. the ones of another fork than the active one.
+var ErrSkipped = errors.New("subtest skipped")
+
+// EVMKeeper defines the methods of the EVM keeper used to run the state tests.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	GetBlockRules(ctx sdk.Context) params.Rules
+	ApplyMessageWithConfig(
+		ctx sdk.Context,
+		msg core.Message,
+		tracer *tracing.Hooks,
+		commit bool,
+		cfg *statedb.EVMConfig,
+		txConfig statedb.TxConfig,
+	) (*evmtypes.MsgEthereumTxResponse, error)
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error
+	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
+	SetCode(ctx sdk.Context, codeHash, code []byte)
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+	ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool)
+}
+
+// AccountKeeper defines the methods of the account keeper used to find the
+// accounts created by the state tests.
+type AccountKeeper interface {
+	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool))
+}
+
+// Runner runs the state tests against the EVM keeper: the pre state of each
+// subtest is written to the keeper state, the message is applied with the
+// checks and the fees of the ante handler, and the post state read back from
+// the keeper is hashed into the state root of the Ethereum tests.
+//
+// The subtests run on a cache of the context which is discarded, and only
+// those of the fork active on the chain config run, the others being skipped.
+// The fee market must not charge a minimum gas, i.e. its min gas multiplier
+// must be zero.
+//
+// Known differences with the Ethereum execution, which fail the affected
+// subtests:
+//   - BLOCKHASH and PREVRANDAO return the CometBFT block hashes instead of the
+//     ones of the environment
+//   - the EIP-7623 floor of the calldata gas isn't charged
+//   - the addresses of the precompiles of the chain are not empty
+//
+// The blob and set code transactions, and the BlockchainTests, aren't
+// supported.
+type Runner struct {
+	evmKeeper     EVMKeeper
+	accountKeeper AccountKeeper
+}
+
+// NewRunner returns a runner of the state tests against the keepers.
+func NewRunner(evmKeeper EVMKeeper, accountKeeper AccountKeeper) *Runner {
+	return &Runner{evmKeeper: evmKeeper, accountKeeper: accountKeeper}
+}
+
+// Run runs all the subtests of the tests and reports their results per fork.
+func (r *Runner) Run(ctx sdk.Context, tests []*StateTest) *Report {
+	report := NewReport()
+	for _, test := range tests {
+		for _, subtest := range test.Subtests() {
+			report.Add(test.Name, subtest, r.RunSubtest(ctx, test, subtest))
+		}
+	}
+	return report
+}
+
+// RunSubtest runs a subtest on a cache of the context. It returns ErrSkipped
+// if the subtest can't run against the keeper, and an error if the post state
+// doesn't match.
+func (r *Runner) RunSubtest(ctx sdk.Context, test *StateTest, subtest Subtest) error {
+	posts, ok := test.Post[subtest.Fork]
+	if !ok || subtest.Index >= len(posts) {
+		return fmt.Errorf("no post state %s", subtest)
+	}
+	post := posts[subtest.Index]
+
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.
+		WithBlockHeight(int64(test.Env.Number)).                      //#nosec G115 -- the test block numbers are small
+		WithBlockTime(time.Unix(int64(test.Env.Timestamp), 0).UTC()). //#nosec G115 -- the test timestamps are small
+		WithBlockGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(uint64(test.Env.GasLimit)))
+
+	if fork := ForkName(r.evmKeeper.GetBlockRules(ctx)); fork != subtest.Fork {
+		return fmt.Errorf("%w: fork %s is not active, %s is", ErrSkipped, subtest.Fork, fork)
+	}
+	if len(test.Tx.BlobVersionedHashes) > 0 {
+		return fmt.Errorf("%w: blob txs are unsupported", ErrSkipped)
+	}
+	if len(test.Tx.AuthorizationList) > 0 {
+		return fmt.Errorf("%w: set code txs are unsupported", ErrSkipped)
+	}
+
+	baseFee := big.NewInt(0)
+	if test.Env.BaseFee != nil {
+		baseFee = (*big.Int)(test.Env.BaseFee)
+	}
+	msg, err := test.message(subtest, baseFee)
+	if err != nil {
+		return err
+	}
+
+	before := Accounts(ctx, r.accountKeeper)
+	if err := r.setAlloc(ctx, test.Pre); err != nil {
+		return fmt.Errorf("failed to set the pre state: %w", err)
+	}
+
+	logs, err := r.apply(ctx, msg, common.Address(test.Env.Coinbase), baseFee)
+	if post.ExpectException != "" {
+		if err == nil {
+			return fmt.Errorf("expected exception %q, got none", post.ExpectException)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unexpected error: %w", err)
+	}
+
+	if logsHash := rlpHash(logs); logsHash != common.Hash(post.Logs) {
+		return fmt.Errorf("post logs hash mismatch: got %s, want %s", logsHash, common.Hash(post.Logs))
+	}
+
+	// the touched accounts are the ones of the test and the ones created by
+	// the message, the other accounts of the chain being left out of the root
+	touched := map[common.Address]struct{}{
+		msg.From:                          {},
+		common.Address(test.Env.Coinbase): {},
+	}
+	if msg.To != nil {
+		touched[*msg.To] = struct{}{}
+	}
+	for addr := range test.Pre {
+		touched[addr] = struct{}{}
+	}
+	for addr := range Accounts(ctx, r.accountKeeper) {
+		if _, found := before[addr]; !found {
+			touched[addr] = struct{}{}
+		}
+	}
+
+	if root := StateRoot(ctx, r.evmKeeper, touched); root != common.Hash(post.Root) {
+		return fmt.Errorf("post state root mismatch: got %s, want %s", root, common.Hash(post.Root))
+	}
+	return nil
+}
+
+// apply applies the message as the ante handler and the EVM keeper do for an
+// Ethereum tx: the message is checked, its gas is bought and its nonce
+// incremented before it's applied, then the unused gas is refunded and the
+// tip is credited to the coinbase. It returns the logs of the message.
+func (r *Runner) apply(ctx sdk.Context, msg *core.Message, coinbase common.Address, baseFee *big.Int) ([]*ethtypes.Log, error) {
+	sender := r.evmKeeper.GetAccount(ctx, msg.From)
+	if sender == nil {
+		sender = statedb.NewEmptyAccount()
+	}
+	if sender.Nonce != msg.Nonce {
+		return nil, fmt.Errorf("%w: address %s, tx nonce %d, state nonce %d", core.ErrNonceTooLow, msg.From, msg.Nonce, sender.Nonce)
+	}
+	// EIP-3607: the senders are EOAs, or accounts delegated by EIP-7702
+	if code := r.evmKeeper.GetCode(ctx, common.BytesToHash(sender.CodeHash)); len(code) > 0 {
+		if _, delegated := ethtypes.ParseDelegation(code); !delegated {
+			return nil, fmt.Errorf("%w: address %s", core.ErrSenderNoEOA, msg.From)
+		}
+	}
+	if msg.GasFeeCap.Cmp(baseFee) < 0 {
+		return nil, fmt.Errorf("%w: fee cap %s, base fee %s", core.ErrFeeCapTooLow, msg.GasFeeCap, baseFee)
+	}
+	if msg.GasTipCap.Cmp(msg.GasFeeCap) > 0 {
+		return nil, fmt.Errorf("%w: tip %s, fee cap %s", core.ErrTipAboveFeeCap, msg.GasTipCap, msg.GasFeeCap)
+	}
+	if blockGasLimit := cosmosevmtypes.BlockGasLimit(ctx); msg.GasLimit > blockGasLimit {
+		return nil, fmt.Errorf("%w: tx gas %d, block gas limit %d", core.ErrGasLimitReached, msg.GasLimit, blockGasLimit)
+	}
+
+	gasLimit := new(big.Int).SetUint64(msg.GasLimit)
+	maxCost := new(big.Int).Add(new(big.Int).Mul(gasLimit, msg.GasFeeCap), msg.Value)
+	if sender.Balance.ToBig().Cmp(maxCost) < 0 {
+		return nil, fmt.Errorf("%w: address %s, have %s, want %s", core.ErrInsufficientFunds, msg.From, sender.Balance, maxCost)
+	}
+
+	cost := uint256.MustFromBig(new(big.Int).Mul(gasLimit, msg.GasPrice))
+	sender.Balance = new(uint256.Int).Sub(sender.Balance, cost)
+	sender.Nonce++
+	if err := r.evmKeeper.SetAccount(ctx, msg.From, *sender); err != nil {
+		return nil, err
+	}
+
+	cfg := &statedb.EVMConfig{
+		Params:   r.evmKeeper.GetParams(ctx),
+		CoinBase: coinbase,
+		BaseFee:  baseFee,
+	}
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	res, err := r.evmKeeper.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := new(big.Int).SetUint64(msg.GasLimit - res.GasUsed)
+	if err := r.addBalance(ctx, msg.From, refund.Mul(refund, msg.GasPrice)); err != nil {
+		return nil, err
+	}
+	tip := new(big.Int).Sub(msg.GasPrice, baseFee)
+	if err := r.addBalance(ctx, coinbase, tip.Mul(tip, new(big.Int).SetUint64(res.GasUsed))); err != nil {
+		return nil, err
+	}
+
+	return evmtypes.LogsToEthereum(res.Logs), nil
+}
+
+// addBalance credits the account with the amount, creating it if needed.
+func (r *Runner) addBalance(ctx sdk.Context, addr common.Address, amount *big.Int) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+	account := r.evmKeeper.GetAccount(ctx, addr)
+	if account == nil {
+		account = statedb.NewEmptyAccount()
+	}
+	account.Balance = new(uint256.Int).Add(account.Balance, uint256.MustFromBig(amount))
+	return r.evmKeeper.SetAccount(ctx, addr, *account)
+}
+
+// setAlloc writes the accounts of the alloc to the keeper state.
+func (r *Runner) setAlloc(ctx sdk.Context, alloc ethtypes.GenesisAlloc) error {
+	for addr, account := range alloc {
+		balance := new(uint256.Int)
+		if account.Balance != nil {
+			balance = uint256.MustFromBig(account.Balance)
+		}
+		codeHash := ethtypes.EmptyCodeHash
+		if len(account.Code) > 0 {
+			codeHash = crypto.Keccak256Hash(account.Code)
+			r.evmKeeper.SetCode(ctx, codeHash.Bytes(), account.Code)
+		}
+		if err := r.evmKeeper.SetAccount(ctx, addr, statedb.Account{
+			Nonce:    account.Nonce,
+			Balance:  balance,
+			CodeHash: codeHash.Bytes(),
+		}); err != nil {
+			return err
+		}
+		for key, value := range account.Storage {
+			r.evmKeeper.SetState(ctx, addr, key, value.Bytes())
+		}
+	}
+	return nil
+}
+
+// Accounts returns the addresses of all the accounts of the keeper state.
+func Accounts(ctx sdk.Context, accountKeeper AccountKeeper) map[common.Address]struct{} {
+	accounts := make(map[common.Address]struct{})
+	accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		accounts[common.BytesToAddress(account.GetAddress())] = struct{}{}
+		return false
+	})
+	return accounts
+}
+
+// StateRoot returns the root of the state trie of the accounts read from the
+// keeper state, leaving out the empty accounts as EIP-161 does.
+func StateRoot(ctx sdk.Context, evmKeeper EVMKeeper, addrs map[common.Address]struct{}) common.Hash {
+	// the state is only hashed, it's never committed to the database
+	trie, err := state.New(ethtypes.EmptyRootHash, state.NewDatabaseForTesting())
+	if err != nil {
+		panic(err)
+	}
+
+	for addr := range addrs {
+		account := evmKeeper.GetAccount(ctx, addr)
+		if account == nil {
+			continue
+		}
+		code := evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
+		if account.Nonce == 0 && account.Balance.IsZero() && len(code) == 0 {
+			continue
+		}
+
+		trie.SetNonce(addr, account.Nonce, tracing.NonceChangeUnspecified)
+		trie.SetBalance(addr, account.Balance, tracing.BalanceChangeUnspecified)
+		trie.SetCode(addr, code)
+		evmKeeper.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+			trie.SetState(addr, key, value)
+			return true
+		})
+	}
+	return trie.IntermediateRoot(true)
+}
+
+// ForkName returns the name, as used by the Ethereum tests, of the latest
+// fork active in the rules.
+func ForkName(rules params.Rules) string {
+	switch {
+	case rules.IsOsaka:
+		return "Osaka"
+	case rules.IsPrague:
+		return "Prague"
+	case rules.IsCancun:
+		return "Cancun"
+	case rules.IsShanghai:
+		return "Shanghai"
+	case rules.IsMerge:
+		return "Paris"
+	case rules.IsLondon:
+		return "London"
+	case rules.IsBerlin:
+		return "Berlin"
+	case rules.IsIstanbul:
+		return "Istanbul"
+	default:
+		return "Frontier"
+	}
+}
+
+func rlpHash(x interface{}) common.Hash {
+	bz, err := rlp.EncodeToBytes(x)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(bz)
+}
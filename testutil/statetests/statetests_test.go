@@ -0,0 +1,71 @@
+package statetests_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/tests"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/testutil/statetests"
+)
+
+const fixtures = "testdata/general_state_tests.json"
+
+func TestLoadStateTests(t *testing.T) {
+	stateTests, err := statetests.LoadStateTests("testdata")
+	require.NoError(t, err)
+	require.Len(t, stateTests, 2)
+	require.Equal(t, "general_state_tests.json/createContract", stateTests[0].Name)
+	require.Equal(t, "general_state_tests.json/sstoreTimestampLog", stateTests[1].Name)
+
+	require.Equal(t, []statetests.Subtest{
+		{Fork: "Cancun", Index: 0},
+		{Fork: "Cancun", Index: 1},
+		{Fork: "Prague", Index: 0},
+		{Fork: "Prague", Index: 1},
+		{Fork: "Prague", Index: 2},
+	}, stateTests[1].Subtests())
+
+	_, err = statetests.LoadStateTests("testdata/missing.json")
+	require.Error(t, err)
+}
+
+// TestFixturesReference checks that the bundled fixtures pass on the reference
+// Ethereum execution.
+func TestFixturesReference(t *testing.T) {
+	bz, err := os.ReadFile(fixtures)
+	require.NoError(t, err)
+	var stateTests map[string]*tests.StateTest
+	require.NoError(t, json.Unmarshal(bz, &stateTests))
+
+	for name, test := range stateTests {
+		for _, subtest := range test.Subtests() {
+			err := test.Run(subtest, vm.Config{}, false, rawdb.HashScheme, func(error, *tests.StateTestState) {})
+			require.NoError(t, err, "%s/%s/%d", name, subtest.Fork, subtest.Index)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	report := statetests.NewReport()
+	report.Add("a", statetests.Subtest{Fork: "Prague"}, nil)
+	report.Add("b", statetests.Subtest{Fork: "Prague", Index: 1}, errors.New("post state root mismatch"))
+	report.Add("c", statetests.Subtest{Fork: "Prague", Index: 2}, nil)
+	report.Add("c", statetests.Subtest{Fork: "Cancun"}, statetests.ErrSkipped)
+
+	require.Equal(t, 1, report.Failed())
+	require.Equal(t, []string{"b/Prague/1: post state root mismatch"}, report.Forks["Prague"].Failures)
+	require.Equal(t, "Cancun: 0/0 passed (0.00%), 1 skipped\nPrague: 2/3 passed (66.67%), 0 skipped\n", report.String())
+}
+
+func TestForkName(t *testing.T) {
+	require.Equal(t, "Prague", statetests.ForkName(params.Rules{IsBerlin: true, IsLondon: true, IsMerge: true, IsShanghai: true, IsCancun: true, IsPrague: true}))
+	require.Equal(t, "Paris", statetests.ForkName(params.Rules{IsBerlin: true, IsLondon: true, IsMerge: true}))
+	require.Equal(t, "Frontier", statetests.ForkName(params.Rules{}))
+}
@@ -0,0 +1,290 @@
+package tx
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// EthTxBuilder builds Ethereum txs of all types signed by a private key, e.g.
+//
+//	msg, err := NewEthTxBuilder(priv).
+//		WithNonce(nonce).
+//		WithTo(to).
+//		WithFeeCap(feeCap, tipCap).
+//		WithAccessList(accessList).
+//		Msg()
+//
+// Unless set with WithType, the type of the tx is inferred from its fields:
+// a set code tx (EIP-7702) if it has an authorization list, else a dynamic
+// fee tx (EIP-1559) if it has a fee cap, else an access list tx (EIP-2930) if
+// it has an access list, else a legacy tx.
+type EthTxBuilder struct {
+	priv    cryptotypes.PrivKey
+	chainID *big.Int
+	txType  *uint8
+
+	nonce      uint64
+	to         *common.Address
+	value      *big.Int
+	gasLimit   uint64
+	gasPrice   *big.Int
+	gasFeeCap  *big.Int
+	gasTipCap  *big.Int
+	data       []byte
+	accessList ethtypes.AccessList
+	authList   []ethtypes.SetCodeAuthorization
+}
+
+// NewEthTxBuilder returns a builder of the txs signed by the given private key
+// for the chain ID of the EVM, which are transfers of no value to the zero
+// address with a gas limit of 21000 and a gas price of zero by default.
+func NewEthTxBuilder(priv cryptotypes.PrivKey) *EthTxBuilder {
+	return &EthTxBuilder{
+		priv:     priv,
+		chainID:  evmtypes.GetEthChainConfig().ChainID,
+		to:       &common.Address{},
+		value:    new(big.Int),
+		gasLimit: params.TxGas,
+		gasPrice: new(big.Int),
+	}
+}
+
+// WithChainID sets the chain ID the tx is signed for.
+func (b *EthTxBuilder) WithChainID(chainID *big.Int) *EthTxBuilder {
+	b.chainID = chainID
+	return b
+}
+
+// WithType sets the type of the tx instead of inferring it from its fields.
+func (b *EthTxBuilder) WithType(txType uint8) *EthTxBuilder {
+	b.txType = &txType
+	return b
+}
+
+// WithNonce sets the nonce of the tx.
+func (b *EthTxBuilder) WithNonce(nonce uint64) *EthTxBuilder {
+	b.nonce = nonce
+	return b
+}
+
+// WithTo sets the recipient of the tx.
+func (b *EthTxBuilder) WithTo(to common.Address) *EthTxBuilder {
+	b.to = &to
+	return b
+}
+
+// WithContractCreation removes the recipient of the tx, which deploys its data.
+func (b *EthTxBuilder) WithContractCreation() *EthTxBuilder {
+	b.to = nil
+	return b
+}
+
+// WithValue sets the value transferred by the tx.
+func (b *EthTxBuilder) WithValue(value *big.Int) *EthTxBuilder {
+	b.value = value
+	return b
+}
+
+// WithGasLimit sets the gas limit of the tx.
+func (b *EthTxBuilder) WithGasLimit(gasLimit uint64) *EthTxBuilder {
+	b.gasLimit = gasLimit
+	return b
+}
+
+// WithGasPrice sets the gas price of the legacy and access list txs.
+func (b *EthTxBuilder) WithGasPrice(gasPrice *big.Int) *EthTxBuilder {
+	b.gasPrice = gasPrice
+	return b
+}
+
+// WithFeeCap sets the fee cap and the tip cap of the dynamic fee and set code
+// txs.
+func (b *EthTxBuilder) WithFeeCap(gasFeeCap, gasTipCap *big.Int) *EthTxBuilder {
+	b.gasFeeCap = gasFeeCap
+	b.gasTipCap = gasTipCap
+	return b
+}
+
+// WithData sets the calldata of the tx, or its init code if it creates a
+// contract.
+func (b *EthTxBuilder) WithData(data []byte) *EthTxBuilder {
+	b.data = data
+	return b
+}
+
+// WithAccessList sets the access list of the tx. An empty access list makes an
+// access list tx unless the tx has a fee cap.
+func (b *EthTxBuilder) WithAccessList(accessList ethtypes.AccessList) *EthTxBuilder {
+	if accessList == nil {
+		accessList = ethtypes.AccessList{}
+	}
+	b.accessList = accessList
+	return b
+}
+
+// WithAuthList sets the authorization list of the set code tx, whose
+// authorizations can be signed with SignSetCodeAuthorization.
+func (b *EthTxBuilder) WithAuthList(authList ...ethtypes.SetCodeAuthorization) *EthTxBuilder {
+	b.authList = authList
+	return b
+}
+
+// Type returns the type of the tx.
+func (b *EthTxBuilder) Type() uint8 {
+	switch {
+	case b.txType != nil:
+		return *b.txType
+	case b.authList != nil:
+		return ethtypes.SetCodeTxType
+	case b.gasFeeCap != nil:
+		return ethtypes.DynamicFeeTxType
+	case b.accessList != nil:
+		return ethtypes.AccessListTxType
+	default:
+		return ethtypes.LegacyTxType
+	}
+}
+
+// Tx returns the signed Ethereum tx.
+func (b *EthTxBuilder) Tx() (*ethtypes.Transaction, error) {
+	txData, err := b.txData()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := ethtypes.NewTx(txData)
+	signer := ethtypes.LatestSignerForChainID(b.chainID)
+	sig, _, err := NewSigner(b.priv).Sign("", signer.Hash(tx).Bytes(), signing.SignMode_SIGN_MODE_TEXTUAL)
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+// Msg returns the MsgEthereumTx of the signed tx, with its sender. It fails
+// for the set code txs, which are not supported by the EVM module.
+func (b *EthTxBuilder) Msg() (*evmtypes.MsgEthereumTx, error) {
+	tx, err := b.Tx()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &evmtypes.MsgEthereumTx{}
+	if err := msg.FromSignedEthereumTx(tx, ethtypes.LatestSignerForChainID(b.chainID)); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// RLP returns the binary encoding of the signed tx, as sent to
+// eth_sendRawTransaction.
+func (b *EthTxBuilder) RLP() ([]byte, error) {
+	tx, err := b.Tx()
+	if err != nil {
+		return nil, err
+	}
+	return tx.MarshalBinary()
+}
+
+func (b *EthTxBuilder) txData() (ethtypes.TxData, error) {
+	gasFeeCap, gasTipCap := b.gasFeeCap, b.gasTipCap
+	if gasFeeCap == nil {
+		gasFeeCap = new(big.Int)
+	}
+	if gasTipCap == nil {
+		gasTipCap = new(big.Int)
+	}
+
+	switch txType := b.Type(); txType {
+	case ethtypes.LegacyTxType:
+		if b.accessList != nil || b.authList != nil {
+			return nil, fmt.Errorf("legacy tx with an access list or an authorization list")
+		}
+		return &ethtypes.LegacyTx{
+			Nonce:    b.nonce,
+			GasPrice: b.gasPrice,
+			Gas:      b.gasLimit,
+			To:       b.to,
+			Value:    b.value,
+			Data:     b.data,
+		}, nil
+	case ethtypes.AccessListTxType:
+		if b.authList != nil {
+			return nil, fmt.Errorf("access list tx with an authorization list")
+		}
+		return &ethtypes.AccessListTx{
+			ChainID:    b.chainID,
+			Nonce:      b.nonce,
+			GasPrice:   b.gasPrice,
+			Gas:        b.gasLimit,
+			To:         b.to,
+			Value:      b.value,
+			Data:       b.data,
+			AccessList: b.accessList,
+		}, nil
+	case ethtypes.DynamicFeeTxType:
+		if b.authList != nil {
+			return nil, fmt.Errorf("dynamic fee tx with an authorization list")
+		}
+		return &ethtypes.DynamicFeeTx{
+			ChainID:    b.chainID,
+			Nonce:      b.nonce,
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  gasFeeCap,
+			Gas:        b.gasLimit,
+			To:         b.to,
+			Value:      b.value,
+			Data:       b.data,
+			AccessList: b.accessList,
+		}, nil
+	case ethtypes.SetCodeTxType:
+		if b.to == nil {
+			return nil, fmt.Errorf("set code tx without recipient")
+		}
+		return &ethtypes.SetCodeTx{
+			ChainID:    uint256.MustFromBig(b.chainID),
+			Nonce:      b.nonce,
+			GasTipCap:  uint256.MustFromBig(gasTipCap),
+			GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+			Gas:        b.gasLimit,
+			To:         *b.to,
+			Value:      uint256.MustFromBig(b.value),
+			Data:       b.data,
+			AccessList: b.accessList,
+			AuthList:   b.authList,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tx type %d", txType)
+	}
+}
+
+// SignSetCodeAuthorization signs the EIP-7702 authorization with the given
+// private key, which becomes the authority delegating its code to the address
+// of the authorization.
+func SignSetCodeAuthorization(priv cryptotypes.PrivKey, auth ethtypes.SetCodeAuthorization) (ethtypes.SetCodeAuthorization, error) {
+	ethPriv, ok := priv.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return ethtypes.SetCodeAuthorization{}, fmt.Errorf(
+			"invalid private key type for signing an authorization; expected %s, got %s",
+			ethsecp256k1.KeyType,
+			priv.Type(),
+		)
+	}
+
+	key, err := ethPriv.ToECDSA()
+	if err != nil {
+		return ethtypes.SetCodeAuthorization{}, err
+	}
+	return ethtypes.SignSetCode(key, auth)
+}
@@ -0,0 +1,125 @@
+package tx_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestEthTxBuilder(t *testing.T) {
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.
+		WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID]).
+		Configure())
+
+	from, priv := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+	accessList := ethtypes.AccessList{{Address: to, StorageKeys: []common.Hash{{0x01}}}}
+
+	auth, err := utiltx.SignSetCodeAuthorization(priv, ethtypes.SetCodeAuthorization{
+		ChainID: *uint256.MustFromBig(evmtypes.GetEthChainConfig().ChainID),
+		Address: to,
+		Nonce:   1,
+	})
+	require.NoError(t, err)
+	authority, err := auth.Authority()
+	require.NoError(t, err)
+	require.Equal(t, from, authority)
+
+	testCases := []struct {
+		name    string
+		builder *utiltx.EthTxBuilder
+		expType uint8
+		expErr  bool
+	}{
+		{
+			"legacy tx",
+			utiltx.NewEthTxBuilder(priv).WithGasPrice(big.NewInt(1)),
+			ethtypes.LegacyTxType,
+			false,
+		},
+		{
+			"access list tx",
+			utiltx.NewEthTxBuilder(priv).WithAccessList(accessList),
+			ethtypes.AccessListTxType,
+			false,
+		},
+		{
+			"access list tx with an empty access list",
+			utiltx.NewEthTxBuilder(priv).WithAccessList(nil),
+			ethtypes.AccessListTxType,
+			false,
+		},
+		{
+			"dynamic fee tx with an access list",
+			utiltx.NewEthTxBuilder(priv).WithFeeCap(big.NewInt(10), big.NewInt(2)).WithAccessList(accessList),
+			ethtypes.DynamicFeeTxType,
+			false,
+		},
+		{
+			"contract creation",
+			utiltx.NewEthTxBuilder(priv).WithContractCreation().WithData([]byte{0x00}),
+			ethtypes.LegacyTxType,
+			false,
+		},
+		{
+			"set code tx",
+			utiltx.NewEthTxBuilder(priv).WithFeeCap(big.NewInt(10), big.NewInt(2)).WithAuthList(auth),
+			ethtypes.SetCodeTxType,
+			false,
+		},
+		{
+			"fail - legacy tx with an access list",
+			utiltx.NewEthTxBuilder(priv).WithAccessList(accessList).WithType(ethtypes.LegacyTxType),
+			ethtypes.LegacyTxType,
+			true,
+		},
+		{
+			"fail - set code tx creating a contract",
+			utiltx.NewEthTxBuilder(priv).WithContractCreation().WithAuthList(auth),
+			ethtypes.SetCodeTxType,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := tc.builder.WithNonce(3).WithValue(big.NewInt(7))
+			require.Equal(t, tc.expType, builder.Type())
+
+			bz, err := builder.RLP()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			tx := new(ethtypes.Transaction)
+			require.NoError(t, tx.UnmarshalBinary(bz))
+			require.Equal(t, tc.expType, tx.Type())
+			require.Equal(t, uint64(3), tx.Nonce())
+			require.Equal(t, big.NewInt(7), tx.Value())
+			sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+			require.NoError(t, err)
+			require.Equal(t, from, sender)
+
+			msg, err := builder.Msg()
+			if tc.expType == ethtypes.SetCodeTxType {
+				require.ErrorIs(t, err, ethtypes.ErrTxTypeNotSupported)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tx.Hash().Hex(), msg.Hash)
+			require.Equal(t, from.Bytes(), msg.From)
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -17,4 +21,112 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+	// GetWitnessByTxHash returns an error if no witness was recorded for the tx.
+	GetWitnessByTxHash(common.Hash) (*TxWitness, error)
+
+	// IndexCallTraces stores the flat call traces of a block.
+	IndexCallTraces(int64, json.RawMessage) error
+	// GetCallTracesByBlock returns nil if the traces of the block were not stored.
+	GetCallTracesByBlock(int64) (json.RawMessage, error)
+
+	// GetModifiedAccountsByBlock returns nil if no modified accounts were recorded
+	// for the block.
+	GetModifiedAccountsByBlock(int64) ([]common.Address, error)
+
+	// GetEthTxHashesByCosmosTxHash returns nil if no eth tx was indexed for the
+	// cosmos tx.
+	GetEthTxHashesByCosmosTxHash([]byte) ([]common.Hash, error)
+	// GetCosmosTxHashByEthTxHash returns nil if the eth tx was indexed without the
+	// hash of its cosmos tx.
+	GetCosmosTxHashByEthTxHash(common.Hash) ([]byte, error)
+
+	// GetAddressTxs returns up to the given number of txs of an address of the
+	// given kinds, newest first, from a cursor, and the cursor to continue from,
+	// nil once all were returned.
+	GetAddressTxs(common.Address, AddressTxKind, []byte, int) ([]AddressTx, []byte, error)
+	// GetTokenTransfers returns up to the given number of token transfers of an
+	// address in a range of blocks, both included, oldest first.
+	GetTokenTransfers(common.Address, int64, int64, int) ([]*TokenTransfer, error)
+	// GetNFTsByOwner returns up to the given number of ERC721 and ERC1155 tokens
+	// held by an owner from a cursor, and the cursor to continue from, nil once
+	// all were returned.
+	GetNFTsByOwner(common.Address, []byte, int) ([]*NFT, []byte, error)
+	// GetContractCreation returns nil if the contract wasn't created by an eth tx.
+	GetContractCreation(common.Address) (*common.Hash, error)
+
+	// BackfillSenders stores the senders of the txs indexed without them, the
+	// blocks being loaded with the given function, which returns nil if the
+	// block isn't available.
+	BackfillSenders(func(int64) (*cmttypes.Block, error)) (int, error)
+	// BackfillNFTBalances rebuilds the balances of the NFT owners from the token
+	// transfers indexed without them.
+	BackfillNFTBalances() (int, error)
+}
+
+// AddressTxKind is the bitmask of the relations of an address with an eth tx.
+type AddressTxKind uint8
+
+const (
+	// AddressTxSent is the kind of the txs sent by the address
+	AddressTxSent AddressTxKind = 1 << iota
+	// AddressTxReceived is the kind of the txs sent to the address, or creating
+	// the contract at the address
+	AddressTxReceived
+	// AddressTxInternal is the kind of the txs whose internal calls are sent by
+	// or to the address, as found in the call traces of their block
+	AddressTxInternal
+
+	// AddressTxAll matches the txs of any kind
+	AddressTxAll = AddressTxSent | AddressTxReceived | AddressTxInternal
+)
+
+// AddressTx is an eth tx of an address, with the kinds of its relations with
+// the address.
+type AddressTx struct {
+	Hash       common.Hash
+	Height     int64
+	EthTxIndex int32
+	Kind       AddressTxKind
+}
+
+// The standards of the token transfers
+const (
+	TokenStandardERC20   = "erc20"
+	TokenStandardERC721  = "erc721"
+	TokenStandardERC1155 = "erc1155"
+)
+
+// TokenTransfer is a transfer of tokens decoded from a standard event logged by
+// an eth tx: an ERC20 or ERC721 Transfer, or an ERC1155 TransferSingle or one of
+// the transfers of a TransferBatch. TokenID is nil for the ERC20 transfers and
+// Operator is only set for the ERC1155 transfers. The mints are sent from, and
+// the burns to, the zero address.
+type TokenTransfer struct {
+	Standard         string          `json:"standard"`
+	Token            common.Address  `json:"token"`
+	Operator         *common.Address `json:"operator,omitempty"`
+	From             common.Address  `json:"from"`
+	To               common.Address  `json:"to"`
+	TokenID          *hexutil.Big    `json:"tokenId,omitempty"`
+	Value            *hexutil.Big    `json:"value"`
+	TransactionHash  common.Hash     `json:"transactionHash"`
+	BlockNumber      hexutil.Uint64  `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64  `json:"transactionIndex"`
+	LogIndex         hexutil.Uint64  `json:"logIndex"`
+}
+
+// NFT is the balance of an ERC721 or ERC1155 token held by an owner, as tracked
+// from the indexed token transfers. The balance of an ERC721 token is 1.
+type NFT struct {
+	Standard string         `json:"standard"`
+	Token    common.Address `json:"token"`
+	TokenID  *hexutil.Big   `json:"tokenId"`
+	Balance  *hexutil.Big   `json:"balance"`
+}
+
+// TxWitness is the set of accounts and storage slots read and written during
+// the execution of an eth tx, sorted by address and storage key.
+type TxWitness struct {
+	Reads  ethtypes.AccessList `json:"reads"`
+	Writes ethtypes.AccessList `json:"writes"`
 }
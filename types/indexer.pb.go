@@ -42,6 +42,9 @@ type TxResult struct {
 	// cumulative_gas_used specifies the cumulated amount of gas used for all
 	// processed messages within the current batch transaction.
 	CumulativeGasUsed uint64 `protobuf:"varint,7,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// sender is the address of the eth tx signer, stored so that it isn't
+	// recovered from the signature on every read
+	Sender []byte `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (m *TxResult) Reset()         { *m = TxResult{} }
@@ -84,26 +87,27 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/types/v1/indexer.proto", fileDescriptor_b69626dfe9e578b6) }
 
 var fileDescriptor_b69626dfe9e578b6 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
+	// 308 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x18, 0x86, 0x73, 0xb6, 0x4d, 0xe3, 0xa1, 0x83, 0xa9, 0x94, 0x68, 0xe1, 0x3c, 0x9d, 0x32, 0xdd,
-	0x51, 0xc4, 0xc5, 0xd1, 0x45, 0x5c, 0x8f, 0xba, 0xb8, 0x84, 0xb4, 0xf9, 0xbc, 0x04, 0x7a, 0x5e,
-	0xe9, 0x5d, 0x42, 0xfc, 0x07, 0x8e, 0xfe, 0x04, 0x7f, 0x8e, 0x63, 0x47, 0x47, 0x69, 0xf1, 0x7f,
-	0x48, 0x2e, 0xa1, 0x82, 0xdb, 0xf7, 0xf2, 0x3c, 0x1f, 0x2f, 0xbc, 0xf8, 0x72, 0xa1, 0x8d, 0xd2,
-	0x86, 0x43, 0xa5, 0xb8, 0x7d, 0x5d, 0x81, 0xe1, 0xd5, 0x94, 0x17, 0x2f, 0x19, 0xd4, 0xb0, 0x66,
-	0xab, 0xb5, 0xb6, 0x3a, 0x1c, 0xb5, 0x0a, 0x83, 0x4a, 0x31, 0xa7, 0xb0, 0x6a, 0x7a, 0x7e, 0x2a,
-	0xb5, 0xd4, 0x8e, 0xf3, 0xe6, 0x6a, 0xd5, 0xab, 0x1f, 0x84, 0x83, 0x59, 0x2d, 0xc0, 0x94, 0x4b,
-	0x1b, 0x8e, 0xb1, 0x9f, 0x43, 0x21, 0x73, 0x1b, 0x21, 0x8a, 0xe2, 0x9e, 0xe8, 0x52, 0x78, 0x86,
-	0x03, 0x5b, 0x27, 0xae, 0x23, 0x3a, 0xa0, 0x28, 0x3e, 0x16, 0x43, 0x5b, 0x3f, 0x34, 0x31, 0x9c,
-	0xe0, 0x43, 0x65, 0x64, 0xc7, 0x7a, 0x8e, 0x05, 0xca, 0xc8, 0x16, 0x52, 0x7c, 0x04, 0x36, 0x4f,
-	0xf6, 0xbf, 0x7d, 0x8a, 0xe2, 0x81, 0xc0, 0x60, 0xf3, 0x59, 0xf7, 0x3e, 0xc6, 0xfe, 0x73, 0x5a,
-	0x2c, 0x21, 0x8b, 0x06, 0x14, 0xc5, 0x81, 0xe8, 0x52, 0xd3, 0x28, 0x53, 0x93, 0x94, 0x06, 0xb2,
-	0xc8, 0xa7, 0x28, 0xee, 0x8b, 0xa1, 0x4c, 0xcd, 0xa3, 0x81, 0x2c, 0x64, 0x78, 0xb4, 0x28, 0x55,
-	0xb9, 0x4c, 0x6d, 0x51, 0x41, 0xb2, 0xb7, 0x86, 0xce, 0x3a, 0xf9, 0x43, 0xf7, 0xad, 0x7f, 0xdb,
-	0x7f, 0xfb, 0xb8, 0xf0, 0xee, 0x6e, 0x3e, 0xb7, 0x04, 0x6d, 0xb6, 0x04, 0x7d, 0x6f, 0x09, 0x7a,
-	0xdf, 0x11, 0x6f, 0xb3, 0x23, 0xde, 0xd7, 0x8e, 0x78, 0x4f, 0x13, 0x59, 0xd8, 0xbc, 0x9c, 0xb3,
-	0x85, 0x56, 0xfc, 0xff, 0xb4, 0x73, 0xdf, 0xad, 0x74, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x05,
-	0x03, 0xd2, 0x18, 0x75, 0x01, 0x00, 0x00,
+	0x14, 0x80, 0x73, 0xb6, 0x4d, 0xe3, 0x51, 0x07, 0x53, 0x29, 0xd1, 0x42, 0x3c, 0x9d, 0x32, 0xdd,
+	0x51, 0xc4, 0xc5, 0xd1, 0x45, 0x5c, 0x8f, 0xba, 0xb8, 0x84, 0xb4, 0x79, 0x5e, 0x02, 0xbd, 0x5e,
+	0xe9, 0x5d, 0x42, 0xfc, 0x07, 0x8e, 0xfe, 0x04, 0x7f, 0x8e, 0x63, 0x47, 0x47, 0x69, 0x7f, 0x87,
+	0x20, 0xbd, 0x1c, 0x15, 0xdc, 0xde, 0xc7, 0xf7, 0x3d, 0x1e, 0x3c, 0x7c, 0x35, 0x57, 0x5a, 0x2a,
+	0xcd, 0xa0, 0x96, 0xcc, 0xbc, 0xae, 0x40, 0xb3, 0x7a, 0xc2, 0xca, 0x65, 0x0e, 0x0d, 0xac, 0xe9,
+	0x6a, 0xad, 0x8c, 0x0a, 0x87, 0x6d, 0x42, 0xa1, 0x96, 0xd4, 0x26, 0xb4, 0x9e, 0x5c, 0x9c, 0x09,
+	0x25, 0x94, 0xf5, 0x6c, 0x3f, 0xb5, 0xe9, 0xf5, 0x0f, 0xc2, 0xc1, 0xb4, 0xe1, 0xa0, 0xab, 0x85,
+	0x09, 0x47, 0xd8, 0x2f, 0xa0, 0x14, 0x85, 0x89, 0x10, 0x41, 0x49, 0x87, 0x3b, 0x0a, 0xcf, 0x71,
+	0x60, 0x9a, 0xd4, 0xde, 0x88, 0x8e, 0x08, 0x4a, 0x4e, 0x78, 0xdf, 0x34, 0x8f, 0x7b, 0x0c, 0xc7,
+	0xf8, 0x58, 0x6a, 0xe1, 0x5c, 0xc7, 0xba, 0x40, 0x6a, 0xd1, 0x4a, 0x82, 0x07, 0x60, 0x8a, 0xf4,
+	0xb0, 0xdb, 0x25, 0x28, 0xe9, 0x71, 0x0c, 0xa6, 0x98, 0xba, 0xf5, 0x11, 0xf6, 0x5f, 0xb2, 0x72,
+	0x01, 0x79, 0xd4, 0x23, 0x28, 0x09, 0xb8, 0xa3, 0xfd, 0x45, 0x91, 0xe9, 0xb4, 0xd2, 0x90, 0x47,
+	0x3e, 0x41, 0x49, 0x97, 0xf7, 0x45, 0xa6, 0x9f, 0x34, 0xe4, 0x21, 0xc5, 0xc3, 0x79, 0x25, 0xab,
+	0x45, 0x66, 0xca, 0x1a, 0xd2, 0x43, 0xd5, 0xb7, 0xd5, 0xe9, 0x9f, 0x7a, 0x70, 0xfd, 0x08, 0xfb,
+	0x1a, 0x96, 0x39, 0xac, 0xa3, 0x80, 0xa0, 0x64, 0xc0, 0x1d, 0xdd, 0x75, 0xdf, 0x3e, 0x2e, 0xbd,
+	0xfb, 0xdb, 0xcf, 0x6d, 0x8c, 0x36, 0xdb, 0x18, 0x7d, 0x6f, 0x63, 0xf4, 0xbe, 0x8b, 0xbd, 0xcd,
+	0x2e, 0xf6, 0xbe, 0x76, 0xb1, 0xf7, 0x3c, 0x16, 0xa5, 0x29, 0xaa, 0x19, 0x9d, 0x2b, 0xc9, 0xfe,
+	0xbf, 0x7c, 0xe6, 0xdb, 0xef, 0xdd, 0xfc, 0x06, 0x00, 0x00, 0xff, 0xff, 0x48, 0x13, 0xe0, 0x87,
+	0x8d, 0x01, 0x00, 0x00,
 }
 
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
@@ -126,6 +130,13 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.CumulativeGasUsed != 0 {
 		i = encodeVarintIndexer(dAtA, i, uint64(m.CumulativeGasUsed))
 		i--
@@ -207,6 +218,10 @@ func (m *TxResult) Size() (n int) {
 	if m.CumulativeGasUsed != 0 {
 		n += 1 + sovIndexer(uint64(m.CumulativeGasUsed))
 	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
 	return n
 }
 
@@ -379,6 +394,40 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndexer(dAtA[iNdEx:])
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockCache caches a value loaded from the store that doesn't change during a
+// block, e.g. the params of a module, so that it isn't unmarshaled for every
+// tx. The value is cached per exec mode, as the check and finalize states
+// differ, and it's loaded again when the context is at another height.
+//
+// The module must Reset the cache at BeginBlock, as a block can be executed
+// more than once, and Bypass it when the value is written, as the write may
+// still be reverted with the cached context it was written to. A bypassed cache
+// loads the value from the store until the next block.
+//
+// The cached value is shared by all the callers, it must not be modified.
+type BlockCache[T any] struct {
+	mtx    sync.Mutex
+	blocks map[sdk.ExecMode]*blockValue[T]
+}
+
+type blockValue[T any] struct {
+	height int64
+	bypass bool
+	loaded bool
+	value  T
+}
+
+// NewBlockCache returns an empty block cache.
+func NewBlockCache[T any]() *BlockCache[T] {
+	return &BlockCache[T]{blocks: make(map[sdk.ExecMode]*blockValue[T])}
+}
+
+// Get returns the value cached for the block of the context, calling load to
+// load it from the store if it isn't cached.
+func (c *BlockCache[T]) Get(ctx sdk.Context, load func(ctx sdk.Context) T) T {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	block, ok := c.blocks[ctx.ExecMode()]
+	if !ok || block.height != ctx.BlockHeight() {
+		block = &blockValue[T]{height: ctx.BlockHeight()}
+		c.blocks[ctx.ExecMode()] = block
+	}
+
+	if block.bypass {
+		return load(ctx)
+	}
+	if !block.loaded {
+		block.value = load(ctx)
+		block.loaded = true
+	}
+	return block.value
+}
+
+// Reset drops the value cached for the exec mode of the context.
+func (c *BlockCache[T]) Reset(ctx sdk.Context) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.blocks[ctx.ExecMode()] = &blockValue[T]{height: ctx.BlockHeight()}
+}
+
+// Bypass drops the value cached for the exec mode of the context and disables
+// the cache for the rest of the block.
+func (c *BlockCache[T]) Bypass(ctx sdk.Context) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.blocks[ctx.ExecMode()] = &blockValue[T]{height: ctx.BlockHeight(), bypass: true}
+}
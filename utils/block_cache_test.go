@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBlockCache(t *testing.T) {
+	cache := NewBlockCache[int]()
+
+	loads := 0
+	value := 1
+	load := func(sdk.Context) int {
+		loads++
+		return value
+	}
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: 1}, false, log.NewNopLogger()).
+		WithExecMode(sdk.ExecModeFinalize)
+
+	// the value is loaded once per block
+	require.Equal(t, 1, cache.Get(ctx, load))
+	value = 2
+	require.Equal(t, 1, cache.Get(ctx, load))
+	require.Equal(t, 1, loads)
+
+	// the value is cached per exec mode
+	checkCtx := ctx.WithExecMode(sdk.ExecModeCheck)
+	require.Equal(t, 2, cache.Get(checkCtx, load))
+	require.Equal(t, 2, loads)
+
+	// the value is loaded again for another block
+	value = 3
+	require.Equal(t, 3, cache.Get(ctx.WithBlockHeight(2), load))
+	require.Equal(t, 3, loads)
+
+	// the value is loaded again once reset
+	cache.Reset(ctx)
+	require.Equal(t, 3, cache.Get(ctx, load))
+	require.Equal(t, 4, loads)
+
+	// the bypassed cache loads the value until the next block
+	cache.Bypass(ctx)
+	require.Equal(t, 3, cache.Get(ctx, load))
+	require.Equal(t, 3, cache.Get(ctx, load))
+	require.Equal(t, 6, loads)
+	require.Equal(t, 2, cache.Get(checkCtx, load))
+	require.Equal(t, 6, loads)
+
+	cache.Reset(ctx)
+	require.Equal(t, 3, cache.Get(ctx, load))
+	require.Equal(t, 3, cache.Get(ctx, load))
+	require.Equal(t, 7, loads)
+}
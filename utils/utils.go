@@ -74,6 +74,25 @@ func HexAddressFromBech32String(addr string) (res common.Address, err error) {
 	return common.BytesToAddress(accAddr), nil
 }
 
+// ParseAddress parses an account address given either in its 0x prefixed hex
+// form, or in its bech32 form with the account prefix of the chain.
+func ParseAddress(addr string) (common.Address, error) {
+	if strings.HasPrefix(addr, sdk.GetConfig().GetBech32AccountAddrPrefix()+"1") {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return common.Address{}, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid bech32 address '%s': %s", addr, err)
+		}
+		return common.BytesToAddress(accAddr), nil
+	}
+
+	if !strings.HasPrefix(addr, "0x") || !common.IsHexAddress(addr) {
+		return common.Address{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "address '%s' is not a valid ethereum hex or bech32 address", addr,
+		)
+	}
+	return common.HexToAddress(addr), nil
+}
+
 // IsSupportedKey returns true if the pubkey type is supported by the chain
 // (i.e. eth_secp256k1, amino multisig, ed25519).
 // NOTE: Nested multisigs are not supported.
@@ -3,6 +3,7 @@ package utils
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -254,6 +255,39 @@ func TestAddressConversion(t *testing.T) {
 	require.Equal(t, hex, gotAddr.Hex())
 }
 
+func TestParseAddress(t *testing.T) {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount("cosmos", "cosmospub")
+
+	hex := "0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E"
+
+	testCases := []struct {
+		name    string
+		address string
+		expPass bool
+	}{
+		{"pass - hex address", hex, true},
+		{"pass - lowercase hex address", strings.ToLower(hex), true},
+		{"pass - bech32 address", "cosmos10jmp6sgh4cc6zt3e8gw05wavvejgr5pwsjskvv", true},
+		{"fail - hex address without prefix", hex[2:], false},
+		{"fail - invalid bech32 checksum", "cosmos10jmp6sgh4cc6zt3e8gw05wavvejgr5pwsjskva", false},
+		{"fail - other bech32 prefix", "evmos10jmp6sgh4cc6zt3e8gw05wavvejgr5pwfggzsr", false},
+		{"fail - empty address", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := ParseAddress(tc.address)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, hex, addr.Hex())
+			} else {
+				require.ErrorContains(t, err, "invalid address")
+			}
+		})
+	}
+}
+
 func TestGetIBCDenomAddress(t *testing.T) {
 	testCases := []struct {
 		name        string
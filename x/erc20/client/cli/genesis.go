@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// MigrateGenesisCmd returns the command that imports the erc20 token pairs of an
+// Evmos-era genesis export into the erc20 genesis state of genesis.json
+func MigrateGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-erc20-genesis [legacy-genesis-file]",
+		Short: "Import the erc20 token pairs of an Evmos-era genesis export into genesis.json",
+		Long: `Import the erc20 token pairs and precompiles of a genesis exported by an
+Evmos-era chain into the erc20 genesis state of genesis.json.
+
+The addresses are checksummed and the pairs of the native coins that aren't
+precompiles yet are registered as dynamic precompiles, as the native coins are
+only served through their precompiles. The token pairs and precompiles that are
+already in the genesis state are kept as they are.
+
+NOTE: the ERC20 balances of the native coin pairs backed by deployed contracts,
+as before the Evmos v19 migration, aren't converted back to coins, so the
+legacy genesis must be exported after that migration.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := server.GetServerContextFromCmd(cmd).Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			legacyAppState, _, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal legacy genesis state: %w", err)
+			}
+
+			legacyGenState, ok := legacyAppState[types.ModuleName]
+			if !ok {
+				return fmt.Errorf("no %s genesis state in %s", types.ModuleName, args[0])
+			}
+
+			migrated, err := types.MigrateLegacyGenesis(legacyGenState)
+			if err != nil {
+				return err
+			}
+
+			return importGenesisTokenPairs(clientCtx.Codec, config.GenesisFile(), migrated)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// importGenesisTokenPairs adds the token pairs and precompiles of the migrated
+// genesis state that aren't registered yet to the erc20 genesis state of the
+// given genesis file
+func importGenesisTokenPairs(cdc codec.JSONCodec, genFile string, migrated *types.GenesisState) error {
+	appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	var erc20GenState types.GenesisState
+	if err := cdc.UnmarshalJSON(appState[types.ModuleName], &erc20GenState); err != nil {
+		return fmt.Errorf("failed to unmarshal erc20 genesis state: %w", err)
+	}
+
+	registered := make(map[string]bool)
+	for _, pair := range erc20GenState.TokenPairs {
+		registered[pair.Erc20Address] = true
+		registered[pair.Denom] = true
+	}
+
+	for _, pair := range migrated.TokenPairs {
+		if registered[pair.Erc20Address] || registered[pair.Denom] {
+			continue
+		}
+		erc20GenState.TokenPairs = append(erc20GenState.TokenPairs, pair)
+		registered[pair.Erc20Address] = true
+		registered[pair.Denom] = true
+	}
+
+	params := &erc20GenState.Params
+	for _, precompile := range migrated.Params.NativePrecompiles {
+		if !slices.Contains(params.NativePrecompiles, precompile) && !slices.Contains(params.DynamicPrecompiles, precompile) {
+			params.NativePrecompiles = append(params.NativePrecompiles, precompile)
+		}
+	}
+	for _, precompile := range migrated.Params.DynamicPrecompiles {
+		if !slices.Contains(params.NativePrecompiles, precompile) && !slices.Contains(params.DynamicPrecompiles, precompile) {
+			params.DynamicPrecompiles = append(params.DynamicPrecompiles, precompile)
+		}
+	}
+	slices.Sort(params.NativePrecompiles)
+	slices.Sort(params.DynamicPrecompiles)
+
+	if err := erc20GenState.Validate(); err != nil {
+		return fmt.Errorf("invalid erc20 genesis state: %w", err)
+	}
+
+	erc20GenStateBz, err := cdc.MarshalJSON(&erc20GenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal erc20 genesis state: %w", err)
+	}
+	appState[types.ModuleName] = erc20GenStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	appGenesis.AppState = appStateJSON
+	return genutil.ExportGenesisFile(appGenesis, genFile)
+}
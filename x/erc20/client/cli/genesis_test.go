@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestImportGenesisTokenPairs(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	nativePair := types.NewTokenPair(common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"), "aatom", types.OWNER_MODULE)
+	genState := types.DefaultGenesisState()
+	genState.TokenPairs = []types.TokenPair{nativePair}
+	genState.Params.NativePrecompiles = []string{nativePair.Erc20Address}
+	genStateBz, err := cdc.MarshalJSON(genState)
+	require.NoError(t, err)
+	appState, err := json.Marshal(map[string]json.RawMessage{types.ModuleName: genStateBz})
+	require.NoError(t, err)
+
+	genFile := filepath.Join(t.TempDir(), "genesis.json")
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test", appState)
+	require.NoError(t, appGenesis.SaveAs(genFile))
+
+	ibcPair := types.NewTokenPair(common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"), "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", types.OWNER_MODULE)
+	migrated := types.NewGenesisState(
+		types.NewParams(true, []string{}, []string{ibcPair.Erc20Address}, true),
+		// the pair of the already registered denom is skipped
+		[]types.TokenPair{ibcPair, types.NewTokenPair(common.HexToAddress("0x1"), nativePair.Denom, types.OWNER_EXTERNAL)},
+		[]types.Allowance{},
+	)
+
+	// the token pairs are added once and the existing ones are kept
+	require.NoError(t, importGenesisTokenPairs(cdc, genFile, &migrated))
+	require.NoError(t, importGenesisTokenPairs(cdc, genFile, &migrated))
+
+	exported, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	require.NoError(t, err)
+
+	var exportedGenState types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(exported[types.ModuleName], &exportedGenState))
+	require.Equal(t, []types.TokenPair{nativePair, ibcPair}, exportedGenState.TokenPairs)
+	require.Equal(t, []string{nativePair.Erc20Address}, exportedGenState.Params.NativePrecompiles)
+	require.Equal(t, []string{ibcPair.Erc20Address}, exportedGenState.Params.DynamicPrecompiles)
+}
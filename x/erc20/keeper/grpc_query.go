@@ -6,7 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/erc20/types"
 
 	"cosmossdk.io/store/prefix"
@@ -53,18 +53,19 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	// check if the token is a hex address, if not, check if it is a valid SDK
-	// denom
-	if err := cosmosevmtypes.ValidateAddress(req.Token); err != nil {
-		if err := sdk.ValidateDenom(req.Token); err != nil {
-			return nil, status.Errorf(
-				codes.InvalidArgument,
-				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
-			)
-		}
+	// check if the token is a hex or bech32 address, if not, check if it is a
+	// valid SDK denom
+	token := req.Token
+	if addr, err := utils.ParseAddress(req.Token); err == nil {
+		token = addr.Hex()
+	} else if err := sdk.ValidateDenom(req.Token); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for token %s, should be either an hex ('0x...') or bech32 address, or a cosmos denom", req.Token,
+		)
 	}
 
-	id := k.GetTokenPairID(ctx, req.Token)
+	id := k.GetTokenPairID(ctx, token)
 
 	if len(id) == 0 {
 		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
@@ -0,0 +1,133 @@
+// Package v2 migrates the store of the erc20 module from consensus version 1
+// to 2.
+package v2
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// addressLength is the length of the hex addresses of the precompiles params,
+// which are stored concatenated.
+const addressLength = 42
+
+// MigrateStore migrates the erc20 store from version 1 to 2:
+//   - the native and dynamic precompiles are checksummed, deduplicated and
+//     sorted, as the precompiles are looked up by their checksummed address
+//   - the token pairs are stored under the ID of their checksummed ERC20
+//     address, and the indexes of the pairs by ERC20 address and by denom are
+//     rebuilt from them
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	params := types.NewParams(
+		store.Has(types.ParamStoreKeyEnableErc20),
+		checksumPrecompiles(store.Get(types.ParamStoreKeyNativePrecompiles)),
+		checksumPrecompiles(store.Get(types.ParamStoreKeyDynamicPrecompiles)),
+		store.Has(types.ParamStoreKeyPermissionlessRegistration),
+	)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store.Set(types.ParamStoreKeyNativePrecompiles, concatPrecompiles(params.NativePrecompiles))
+	store.Set(types.ParamStoreKeyDynamicPrecompiles, concatPrecompiles(params.DynamicPrecompiles))
+
+	pairs, err := tokenPairs(store, cdc)
+	if err != nil {
+		return err
+	}
+
+	pairStore := prefix.NewStore(store, types.KeyPrefixTokenPair)
+	erc20Store := prefix.NewStore(store, types.KeyPrefixTokenPairByERC20)
+	denomStore := prefix.NewStore(store, types.KeyPrefixTokenPairByDenom)
+	for _, key := range storeKeys(pairStore) {
+		pairStore.Delete(key)
+	}
+	for _, key := range storeKeys(erc20Store) {
+		erc20Store.Delete(key)
+	}
+	for _, key := range storeKeys(denomStore) {
+		denomStore.Delete(key)
+	}
+
+	for _, pair := range pairs {
+		pair.Erc20Address = common.HexToAddress(pair.Erc20Address).Hex()
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+
+		bz, err := cdc.Marshal(&pair)
+		if err != nil {
+			return err
+		}
+		id := pair.GetID()
+		pairStore.Set(id, bz)
+		erc20Store.Set(pair.GetERC20Contract().Bytes(), id)
+		denomStore.Set([]byte(pair.Denom), id)
+	}
+	return nil
+}
+
+// tokenPairs returns the token pairs of the store.
+func tokenPairs(store storetypes.KVStore, cdc codec.BinaryCodec) ([]types.TokenPair, error) {
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixTokenPair)
+	defer iterator.Close()
+
+	var pairs []types.TokenPair
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		if err := cdc.Unmarshal(iterator.Value(), &pair); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, nil
+}
+
+// storeKeys returns the keys of the store. They're collected before the entries
+// are deleted, as the store can't be written while it's iterated.
+func storeKeys(store storetypes.KVStore) [][]byte {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	return keys
+}
+
+// checksumPrecompiles returns the deduplicated checksummed addresses of the
+// stored precompiles, which are sorted by the params. The invalid addresses are
+// kept as is, to fail the validation.
+func checksumPrecompiles(bz []byte) []string {
+	var precompiles []string
+	for i := 0; i+addressLength <= len(bz); i += addressLength {
+		precompile := string(bz[i : i+addressLength])
+		if common.IsHexAddress(precompile) {
+			precompile = common.HexToAddress(precompile).Hex()
+		}
+		if !slices.Contains(precompiles, precompile) {
+			precompiles = append(precompiles, precompile)
+		}
+	}
+	return precompiles
+}
+
+// concatPrecompiles returns the concatenated precompiles, as they're stored.
+func concatPrecompiles(precompiles []string) []byte {
+	bz := make([]byte, 0, addressLength*len(precompiles))
+	for _, precompile := range precompiles {
+		bz = append(bz, precompile...)
+	}
+	return bz
+}
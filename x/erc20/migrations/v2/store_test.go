@@ -0,0 +1,56 @@
+package v2_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/cosmos/evm/x/erc20/migrations/v2"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	native := common.HexToAddress("0xAbCdEf0123456789aBcDeF0123456789AbCdEf01")
+	dynamic := common.HexToAddress("0x0123456789aBcDeF0123456789AbCdEf01aBcDeF")
+	store.Set(types.ParamStoreKeyEnableErc20, []byte("0x01"))
+	store.Set(types.ParamStoreKeyNativePrecompiles, []byte(strings.ToLower(native.Hex())+native.Hex()))
+	store.Set(types.ParamStoreKeyDynamicPrecompiles, []byte(strings.ToLower(dynamic.Hex())))
+
+	// a pair stored under the id of its lower case address, with a stale index
+	pair := types.NewTokenPair(native, "atest", types.OWNER_MODULE)
+	legacyPair := pair
+	legacyPair.Erc20Address = strings.ToLower(pair.Erc20Address)
+	prefix.NewStore(store, types.KeyPrefixTokenPair).Set(legacyPair.GetID(), cdc.MustMarshal(&legacyPair))
+	prefix.NewStore(store, types.KeyPrefixTokenPairByDenom).Set([]byte(pair.Denom), legacyPair.GetID())
+	prefix.NewStore(store, types.KeyPrefixTokenPairByDenom).Set([]byte("removed"), []byte("stale"))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	require.Equal(t, []byte(native.Hex()), store.Get(types.ParamStoreKeyNativePrecompiles))
+	require.Equal(t, []byte(dynamic.Hex()), store.Get(types.ParamStoreKeyDynamicPrecompiles))
+	require.True(t, store.Has(types.ParamStoreKeyEnableErc20))
+	require.False(t, store.Has(types.ParamStoreKeyPermissionlessRegistration))
+
+	pairStore := prefix.NewStore(store, types.KeyPrefixTokenPair)
+	require.False(t, pairStore.Has(legacyPair.GetID()))
+	var migrated types.TokenPair
+	cdc.MustUnmarshal(pairStore.Get(pair.GetID()), &migrated)
+	require.Equal(t, pair, migrated)
+
+	require.Equal(t, pair.GetID(), prefix.NewStore(store, types.KeyPrefixTokenPairByERC20).Get(native.Bytes()))
+	require.Equal(t, pair.GetID(), prefix.NewStore(store, types.KeyPrefixTokenPairByDenom).Get([]byte(pair.Denom)))
+	require.False(t, prefix.NewStore(store, types.KeyPrefixTokenPairByDenom).Has([]byte("removed")))
+}
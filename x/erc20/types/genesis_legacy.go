@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// LegacyGenesisState is the erc20 genesis state exported by the Evmos-era chains.
+// It's decoded with the standard JSON decoder so that the removed fields (e.g.
+// enable_evm_hook) are ignored, and the contract owners are accepted both by
+// name and by number.
+type LegacyGenesisState struct {
+	Params     LegacyParams      `json:"params"`
+	TokenPairs []LegacyTokenPair `json:"token_pairs"`
+}
+
+// LegacyParams are the erc20 params of the Evmos-era chains.
+type LegacyParams struct {
+	EnableErc20        bool     `json:"enable_erc20"`
+	NativePrecompiles  []string `json:"native_precompiles"`
+	DynamicPrecompiles []string `json:"dynamic_precompiles"`
+}
+
+// LegacyTokenPair is a token pair of the Evmos-era chains.
+type LegacyTokenPair struct {
+	Erc20Address  string          `json:"erc20_address"`
+	Denom         string          `json:"denom"`
+	Enabled       bool            `json:"enabled"`
+	ContractOwner json.RawMessage `json:"contract_owner"`
+}
+
+// MigrateLegacyGenesis converts the exported erc20 genesis state of an Evmos-era
+// chain into the current genesis state:
+//   - the hex addresses are checksummed, as the precompiles are looked up by
+//     their checksummed address
+//   - the pairs of the native coins that aren't precompiles yet are registered as
+//     dynamic precompiles, as the coins are only served through the precompiles
+//   - the permissionless registration is set to its default value
+func MigrateLegacyGenesis(bz json.RawMessage) (*GenesisState, error) {
+	var legacy LegacyGenesisState
+	if err := json.Unmarshal(bz, &legacy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal legacy erc20 genesis state: %w", err)
+	}
+
+	params := DefaultParams()
+	params.EnableErc20 = legacy.Params.EnableErc20
+	nativePrecompiles, err := checksumAddresses(legacy.Params.NativePrecompiles)
+	if err != nil {
+		return nil, fmt.Errorf("invalid native precompiles: %w", err)
+	}
+	dynamicPrecompiles, err := checksumAddresses(legacy.Params.DynamicPrecompiles)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dynamic precompiles: %w", err)
+	}
+	params.NativePrecompiles = nativePrecompiles
+	params.DynamicPrecompiles = dynamicPrecompiles
+
+	pairs := make([]TokenPair, 0, len(legacy.TokenPairs))
+	for _, legacyPair := range legacy.TokenPairs {
+		owner, err := parseLegacyOwner(legacyPair.ContractOwner)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contract owner of token pair %s: %w", legacyPair.Denom, err)
+		}
+
+		erc20Address, err := checksumAddresses([]string{legacyPair.Erc20Address})
+		if err != nil {
+			return nil, fmt.Errorf("invalid ERC20 address of token pair %s: %w", legacyPair.Denom, err)
+		}
+		pair := TokenPair{
+			Erc20Address:  erc20Address[0],
+			Denom:         legacyPair.Denom,
+			Enabled:       legacyPair.Enabled,
+			ContractOwner: owner,
+		}
+
+		if pair.IsNativeCoin() &&
+			!slices.Contains(params.NativePrecompiles, pair.Erc20Address) &&
+			!slices.Contains(params.DynamicPrecompiles, pair.Erc20Address) {
+			params.DynamicPrecompiles = append(params.DynamicPrecompiles, pair.Erc20Address)
+		}
+		pairs = append(pairs, pair)
+	}
+
+	slices.Sort(params.NativePrecompiles)
+	slices.Sort(params.DynamicPrecompiles)
+
+	genState := NewGenesisState(params, pairs, []Allowance{})
+	if err := genState.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid migrated erc20 genesis state: %w", err)
+	}
+	return &genState, nil
+}
+
+// checksumAddresses returns the given hex addresses in their checksummed form.
+func checksumAddresses(addresses []string) ([]string, error) {
+	checksummed := make([]string, len(addresses))
+	for i, address := range addresses {
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("invalid hex address %s", address)
+		}
+		checksummed[i] = common.HexToAddress(address).Hex()
+	}
+	return checksummed, nil
+}
+
+// parseLegacyOwner parses a contract owner given either by its enum name, or by
+// its number. The unspecified owner is rejected.
+func parseLegacyOwner(bz json.RawMessage) (Owner, error) {
+	var owner Owner
+	var number int32
+	if err := json.Unmarshal(bz, &number); err == nil {
+		if _, ok := Owner_name[number]; !ok {
+			return OWNER_UNSPECIFIED, fmt.Errorf("unknown owner %d", number)
+		}
+		owner = Owner(number)
+	} else {
+		var name string
+		if err := json.Unmarshal(bz, &name); err != nil {
+			return OWNER_UNSPECIFIED, fmt.Errorf("expected an owner name or number, got %s", bz)
+		}
+		value, ok := Owner_value[name]
+		if !ok {
+			return OWNER_UNSPECIFIED, fmt.Errorf("unknown owner %s", name)
+		}
+		owner = Owner(value)
+	}
+
+	if owner == OWNER_UNSPECIFIED {
+		return OWNER_UNSPECIFIED, errors.New("unspecified owner")
+	}
+	return owner, nil
+}
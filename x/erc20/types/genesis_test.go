@@ -1,8 +1,10 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -328,3 +330,103 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *GenesisTestSuite) TestMigrateLegacyGenesis() {
+	ibcAddress := "0x80b5a32e4f032b2a058b4f29ec95eefeeb87adcd"
+	erc20Address := "0xd4949664cd82660aae99bedc034a0dea8a0bd517"
+
+	testCases := []struct {
+		name      string
+		legacy    string
+		expPass   bool
+		expPairs  []types.TokenPair
+		expParams types.Params
+	}{
+		{
+			name: "pass - evmos v20 export with a removed param and owners by name and number",
+			legacy: fmt.Sprintf(`{
+				"params": {"enable_erc20": true, "enable_evm_hook": true, "native_precompiles": [], "dynamic_precompiles": ["%s"]},
+				"token_pairs": [
+					{"erc20_address": "%s", "denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "enabled": true, "contract_owner": "OWNER_MODULE"},
+					{"erc20_address": "%s", "denom": "erc20/%s", "enabled": false, "contract_owner": 2}
+				]
+			}`, ibcAddress, ibcAddress, erc20Address, erc20Address),
+			expPass: true,
+			expPairs: []types.TokenPair{
+				{
+					Erc20Address:  common.HexToAddress(ibcAddress).Hex(),
+					Denom:         "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+					Enabled:       true,
+					ContractOwner: types.OWNER_MODULE,
+				},
+				{
+					Erc20Address:  common.HexToAddress(erc20Address).Hex(),
+					Denom:         "erc20/" + erc20Address,
+					Enabled:       false,
+					ContractOwner: types.OWNER_EXTERNAL,
+				},
+			},
+			expParams: types.NewParams(true, []string{}, []string{common.HexToAddress(ibcAddress).Hex()}, true),
+		},
+		{
+			name: "pass - native coin pair registered as dynamic precompile",
+			legacy: fmt.Sprintf(`{
+				"params": {"enable_erc20": false},
+				"token_pairs": [{"erc20_address": "%s", "denom": "uatom", "enabled": true, "contract_owner": 1}]
+			}`, ibcAddress),
+			expPass: true,
+			expPairs: []types.TokenPair{
+				{
+					Erc20Address:  common.HexToAddress(ibcAddress).Hex(),
+					Denom:         "uatom",
+					Enabled:       true,
+					ContractOwner: types.OWNER_MODULE,
+				},
+			},
+			expParams: types.NewParams(false, []string{}, []string{common.HexToAddress(ibcAddress).Hex()}, true),
+		},
+		{
+			name:    "fail - unspecified owner",
+			legacy:  fmt.Sprintf(`{"token_pairs": [{"erc20_address": "%s", "denom": "uatom", "contract_owner": "OWNER_UNSPECIFIED"}]}`, ibcAddress),
+			expPass: false,
+		},
+		{
+			name:    "fail - unknown owner",
+			legacy:  fmt.Sprintf(`{"token_pairs": [{"erc20_address": "%s", "denom": "uatom", "contract_owner": 5}]}`, ibcAddress),
+			expPass: false,
+		},
+		{
+			name:    "fail - invalid pair address",
+			legacy:  `{"token_pairs": [{"erc20_address": "0x1234", "denom": "uatom", "contract_owner": 1}]}`,
+			expPass: false,
+		},
+		{
+			name:    "fail - invalid precompile address",
+			legacy:  `{"params": {"dynamic_precompiles": ["evmos"]}}`,
+			expPass: false,
+		},
+		{
+			name: "fail - duplicated denom",
+			legacy: fmt.Sprintf(`{"token_pairs": [
+				{"erc20_address": "%s", "denom": "uatom", "contract_owner": 1},
+				{"erc20_address": "%s", "denom": "uatom", "contract_owner": 2}
+			]}`, ibcAddress, erc20Address),
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			genState, err := types.MigrateLegacyGenesis([]byte(tc.legacy))
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPairs, genState.TokenPairs)
+			suite.Require().Equal(tc.expParams, genState.Params)
+			suite.Require().Empty(genState.Allowances)
+		})
+	}
+}
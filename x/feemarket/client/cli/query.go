@@ -1,10 +1,14 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/evm/x/feemarket/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 )
@@ -23,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetSimulateBaseFeeCmd(),
 	)
 	return cmd
 }
@@ -114,3 +119,43 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetSimulateBaseFeeCmd projects the base fees for a sequence of block utilizations
+func GetSimulateBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-base-fee [utilization]...",
+		Short: "Project the base fees of the next blocks for the given block utilizations",
+		Long: `Project the base fees of the next blocks for the given block utilizations under the current parameters.
+Each utilization is the gas used by a block as a fraction of the block gas limit, between 0 and 1.`,
+		Example: "evmd query feemarket simulate-base-fee 1 1 0.5 0",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			utilizations := make([]sdkmath.LegacyDec, len(args))
+			for i, arg := range args {
+				utilizations[i], err = sdkmath.LegacyNewDecFromStr(arg)
+				if err != nil {
+					return fmt.Errorf("invalid utilization %s: %w", arg, err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateBaseFee(cmd.Context(), &types.QuerySimulateBaseFeeRequest{
+				Utilizations: utilizations,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -14,6 +14,10 @@ import (
 
 // BeginBlock updates base fee
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
+	// the base fee set by BeginBlock isn't reverted, so the params can be
+	// cached for the txs of the block
+	defer k.paramsCache.Reset(ctx)
+
 	baseFee := k.CalculateBaseFee(ctx)
 
 	// return immediately if base fee is nil
@@ -23,6 +27,14 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 
 	k.SetBaseFee(ctx, baseFee)
 
+	// keep the base fee in the history, which is pruned to the last
+	// BaseFeeHistory heights
+	history := k.GetParams(ctx).BaseFeeHistory
+	if history > 0 {
+		k.SetBaseFeeAt(ctx, ctx.BlockHeight(), baseFee)
+	}
+	k.PruneBaseFeeHistory(ctx, ctx.BlockHeight(), history)
+
 	defer func() {
 		floatBaseFee, err := baseFee.Float64()
 		if err != nil {
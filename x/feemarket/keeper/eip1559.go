@@ -3,6 +3,8 @@ package keeper
 import (
 	"math"
 
+	"github.com/cosmos/evm/x/feemarket/types"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,8 +22,6 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	consParams := ctx.ConsensusParams()
-
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
@@ -40,13 +40,25 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 
 	parentGasUsed := k.GetBlockGasWanted(ctx)
 
-	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
+	return calculateBaseFee(params, parentBaseFee, parentGasUsed, blockGasLimit(ctx))
+}
+
+// blockGasLimit returns the block gas limit of the consensus params, which is
+// unlimited (MaxUint64) if MaxGas is -1.
+func blockGasLimit(ctx sdk.Context) sdkmath.Int {
+	consParams := ctx.ConsensusParams()
 
 	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
 	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = sdkmath.NewInt(consParams.Block.MaxGas)
+		return sdkmath.NewInt(consParams.Block.MaxGas)
 	}
 
+	return sdkmath.NewIntFromUint64(math.MaxUint64)
+}
+
+// calculateBaseFee returns the base fee of the block following a parent block
+// with the given base fee, gas used and gas limit.
+func calculateBaseFee(params types.Params, parentBaseFee sdkmath.LegacyDec, parentGasUsed uint64, gasLimit sdkmath.Int) sdkmath.LegacyDec {
 	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
 	// validation
 	parentGasTargetInt := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -13,6 +16,10 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
+// maxSimulatedBlocks is the maximum number of block utilizations accepted by
+// the SimulateBaseFee query.
+const maxSimulatedBlocks = 1000
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -34,6 +41,28 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// BaseFeeAt implements the Query/BaseFeeAt gRPC method
+func (k Keeper) BaseFeeAt(c context.Context, req *types.QueryBaseFeeAtRequest) (*types.QueryBaseFeeAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height must be positive, got %d", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	baseFee, found := k.GetBaseFeeAt(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "base fee of height %d not found in the base fee history", req.Height)
+	}
+
+	return &types.QueryBaseFeeAtResponse{
+		BaseFee: baseFee,
+	}, nil
+}
+
 // BlockGas implements the Query/BlockGas gRPC method
 func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -47,3 +76,43 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// SimulateBaseFee implements the Query/SimulateBaseFee gRPC method. Starting
+// from the current base fee, it applies the EIP1559 adjustment of the current
+// params once per block utilization, using the block gas limit of the current
+// consensus params.
+func (k Keeper) SimulateBaseFee(c context.Context, req *types.QuerySimulateBaseFeeRequest) (*types.QuerySimulateBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Utilizations) > maxSimulatedBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot simulate more than %d blocks, got %d", maxSimulatedBlocks, len(req.Utilizations))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	if params.NoBaseFee {
+		return nil, status.Error(codes.FailedPrecondition, "base fee is disabled")
+	}
+
+	gasLimit := blockGasLimit(ctx)
+	baseFee := params.BaseFee
+	baseFees := make([]sdkmath.LegacyDec, 0, len(req.Utilizations))
+
+	for i, utilization := range req.Utilizations {
+		if utilization.IsNil() || utilization.IsNegative() || utilization.GT(sdkmath.LegacyOneDec()) {
+			return nil, status.Errorf(codes.InvalidArgument, "utilization %d must be between 0 and 1, got %s", i, utilization)
+		}
+
+		// the gas used can't overflow as the utilization is at most 1
+		gasUsed := utilization.MulInt(gasLimit).TruncateInt().Uint64()
+		baseFee = calculateBaseFee(params, baseFee, gasUsed, gasLimit)
+		baseFees = append(baseFees, baseFee)
+	}
+
+	return &types.QuerySimulateBaseFeeResponse{
+		BaseFees: baseFees,
+	}, nil
+}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,6 +21,8 @@ type Keeper struct {
 	transientKey storetypes.StoreKey
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
+	// paramsCache caches the params, with the base fee, once per block
+	paramsCache *utils.BlockCache[types.Params]
 }
 
 // NewKeeper generates new fee market module keeper
@@ -35,6 +39,7 @@ func NewKeeper(
 		storeKey:     storeKey,
 		authority:    authority,
 		transientKey: transientKey,
+		paramsCache:  utils.NewBlockCache[types.Params](),
 	}
 }
 
@@ -81,3 +86,60 @@ func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64
 	k.SetTransientBlockGasWanted(ctx, result)
 	return result, nil
 }
+
+// ----------------------------------------------------------------------------
+// Base Fee History
+// Required by the RPC to serve the base fees of the heights pruned from the
+// state.
+// ----------------------------------------------------------------------------
+
+// SetBaseFeeAt sets the base fee of a block height in the base fee history.
+func (k Keeper) SetBaseFeeAt(ctx sdk.Context, height int64, baseFee math.LegacyDec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BaseFeeHistoryKey(height), bz)
+}
+
+// GetBaseFeeAt returns the base fee of a block height from the base fee
+// history, and false if the height isn't in the history.
+func (k Keeper) GetBaseFeeAt(ctx sdk.Context, height int64) (math.LegacyDec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeHistoryKey(height))
+	if bz == nil {
+		return math.LegacyDec{}, false
+	}
+
+	var baseFee math.LegacyDec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee, true
+}
+
+// PruneBaseFeeHistory deletes from the base fee history the heights older than
+// the last history heights up to the given height.
+func (k Keeper) PruneBaseFeeHistory(ctx sdk.Context, height int64, history uint64) {
+	// the heights kept are (height - history, height]
+	if history >= uint64(height) { //#nosec G115 -- the block height is never negative
+		return
+	}
+	oldest := height - int64(history) + 1 //#nosec G115 -- checked above
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.BaseFeeHistoryKey(0), types.BaseFeeHistoryKey(oldest))
+	defer iterator.Close()
+
+	// the keys are collected before deleting them, as the store can't be
+	// written while iterated
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/feemarket/migrations/legacy"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateLegacyStore converts the feemarket store of an Ethermint or Evmos
+// chain into the store at consensus version 1.
+func (m Migrator) MigrateLegacyStore(ctx sdk.Context) error {
+	if err := legacy.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	// the params are written directly to the store
+	m.keeper.paramsCache.Bypass(ctx)
+	return nil
+}
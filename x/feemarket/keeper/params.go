@@ -28,10 +28,18 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	}
 
 	store.Set(types.ParamsKey, bz)
+	k.paramsCache.Bypass(ctx)
 
 	return nil
 }
 
+// GetBlockParams returns the fee market params of the block of the context,
+// which are loaded from the store once per block. The returned params must not
+// be modified.
+func (k Keeper) GetBlockParams(ctx sdk.Context) types.Params {
+	return k.paramsCache.Get(ctx, k.GetParams)
+}
+
 // ----------------------------------------------------------------------------
 // Parent Base Fee
 // Required by EIP1559 base fee calculation.
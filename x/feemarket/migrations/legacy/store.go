@@ -0,0 +1,106 @@
+// Package legacy converts the feemarket store of the Ethermint and Evmos chains
+// into the store of the feemarket module, so that these chains can adopt the
+// module in place with an upgrade.
+package legacy
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// baseFeeField is the field number of the base fee of the legacy params, which
+// is an integer instead of a decimal.
+const baseFeeField protowire.Number = 6
+
+// keyLegacyBaseFee is the key of the base fee stored apart from the params by
+// the first Ethermint releases.
+var keyLegacyBaseFee = []byte{2}
+
+// MigrateStore converts the feemarket store of a legacy chain into the store of
+// the feemarket module at consensus version 1. The params are the ones of the
+// Ethermint and Evmos chains, whose base fee is an integer, and the base fee
+// stored apart from them is moved into them. The base fee history is enabled
+// with its default length.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return errors.New("no legacy feemarket params")
+	}
+
+	var (
+		fields  []byte
+		baseFee *math.Int
+	)
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		if m < 0 {
+			return protowire.ParseError(m)
+		}
+		value := bz[n : n+m]
+		bz = bz[n+m:]
+
+		if num != baseFeeField {
+			fields = append(protowire.AppendTag(fields, num, typ), value...)
+			continue
+		}
+		v, k := protowire.ConsumeString(value)
+		if k < 0 {
+			return protowire.ParseError(k)
+		}
+		fee, ok := math.NewIntFromString(v)
+		if !ok {
+			return fmt.Errorf("invalid legacy base fee %s", v)
+		}
+		baseFee = &fee
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(fields, &params); err != nil {
+		return fmt.Errorf("failed to decode the legacy feemarket params: %w", err)
+	}
+
+	switch legacyBaseFee := store.Get(keyLegacyBaseFee); {
+	case baseFee != nil:
+		params.BaseFee = math.LegacyNewDecFromInt(*baseFee)
+	case legacyBaseFee != nil:
+		params.BaseFee = math.LegacyNewDecFromBigInt(new(big.Int).SetBytes(legacyBaseFee))
+	default:
+		params.BaseFee = math.LegacyZeroDec()
+	}
+	store.Delete(keyLegacyBaseFee)
+
+	if params.MinGasPrice.IsNil() {
+		params.MinGasPrice = math.LegacyZeroDec()
+	}
+	if params.MinGasMultiplier.IsNil() {
+		params.MinGasMultiplier = types.DefaultMinGasMultiplier
+	}
+	params.BaseFeeHistory = types.DefaultBaseFeeHistory
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid legacy feemarket params: %w", err)
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
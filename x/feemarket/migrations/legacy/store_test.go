@@ -0,0 +1,86 @@
+package legacy_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/evm/x/feemarket/migrations/legacy"
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// legacyParams returns the encoded legacy feemarket params, with the given
+// integer base fee if it's set.
+func legacyParams(baseFee string) []byte {
+	var bz []byte
+	bz = protowire.AppendVarint(protowire.AppendTag(bz, 2, protowire.VarintType), 8)
+	bz = protowire.AppendVarint(protowire.AppendTag(bz, 3, protowire.VarintType), 2)
+	bz = protowire.AppendVarint(protowire.AppendTag(bz, 5, protowire.VarintType), 10)
+	if baseFee != "" {
+		bz = protowire.AppendString(protowire.AppendTag(bz, 6, protowire.BytesType), baseFee)
+	}
+	minGasPrice, _ := math.LegacyNewDecWithPrec(5, 1).Marshal()
+	bz = protowire.AppendBytes(protowire.AppendTag(bz, 7, protowire.BytesType), minGasPrice)
+	minGasMultiplier, _ := math.LegacyNewDecWithPrec(25, 2).Marshal()
+	return protowire.AppendBytes(protowire.AppendTag(bz, 8, protowire.BytesType), minGasMultiplier)
+}
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	testCases := []struct {
+		name          string
+		baseFee       string
+		legacyBaseFee *big.Int
+		expBaseFee    math.LegacyDec
+	}{
+		{"integer base fee of the params", "1000000000", nil, math.LegacyNewDec(1_000_000_000)},
+		{"base fee stored apart from the params", "", big.NewInt(875_000_000), math.LegacyNewDec(875_000_000)},
+		{"no base fee", "", nil, math.LegacyZeroDec()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+			store := ctx.KVStore(storeKey)
+			store.Set(types.ParamsKey, legacyParams(tc.baseFee))
+			if tc.legacyBaseFee != nil {
+				store.Set([]byte{2}, tc.legacyBaseFee.Bytes())
+			}
+
+			require.NoError(t, legacy.MigrateStore(ctx, storeKey, cdc))
+
+			var params types.Params
+			cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+			require.Equal(t, types.Params{
+				BaseFeeChangeDenominator: 8,
+				ElasticityMultiplier:     2,
+				EnableHeight:             10,
+				BaseFee:                  tc.expBaseFee,
+				MinGasPrice:              math.LegacyNewDecWithPrec(5, 1),
+				MinGasMultiplier:         math.LegacyNewDecWithPrec(25, 2),
+				BaseFeeHistory:           types.DefaultBaseFeeHistory,
+			}, params)
+			require.False(t, store.Has([]byte{2}))
+		})
+	}
+}
+
+func TestMigrateStoreInvalid(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	require.ErrorContains(t, legacy.MigrateStore(ctx, storeKey, cdc), "no legacy feemarket params")
+
+	ctx.KVStore(storeKey).Set(types.ParamsKey, legacyParams("0.5"))
+	require.ErrorContains(t, legacy.MigrateStore(ctx, storeKey, cdc), "invalid legacy base fee 0.5")
+}
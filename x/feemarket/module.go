@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/evm/x/feemarket/client/cli"
 	"github.com/cosmos/evm/x/feemarket/keeper"
+	"github.com/cosmos/evm/x/feemarket/simulation"
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/core/appmodule"
@@ -35,6 +36,9 @@ var (
 	_ appmodule.HasEndBlocker   = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
 	_ module.HasABCIGenesis     = AppModule{}
+
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the fee market module.
@@ -154,7 +158,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 
 // GenerateGenesisState creates a randomized GenState of the fee market module.
-func (AppModule) GenerateGenesisState(_ *module.SimulationState) {
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns the msgs of the fee market module used for the
+// governance proposals of the simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.keeper)
 }
 
 // WeightedOperations returns the all the fee market module operations with their respective weights.
@@ -0,0 +1,36 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// RandomizedGenState generates a random genesis state of the fee market module,
+// from the genesis state of the app if it's set or from the default one.
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.DefaultGenesisState()
+	if bz, ok := simState.GenState[types.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, genesis)
+	}
+	genesis.Params = randomParams(simState.Rand, genesis.Params)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
+
+// randomParams returns the given params with random values of the params which
+// don't gate the fees. The base fee and the min gas price stay disabled if they
+// are, as the cosmos txs of the operations of the other modules pay random
+// fees.
+func randomParams(r *rand.Rand, params types.Params) types.Params {
+	params.BaseFeeChangeDenominator = uint32(r.Intn(16) + 1) //#nosec G115 -- positive and lower than 17
+	params.ElasticityMultiplier = uint32(r.Intn(4) + 1)      //#nosec G115 -- positive and lower than 5
+	params.BaseFee = math.LegacyNewDec(r.Int63n(1e10))
+	params.MinGasMultiplier = math.LegacyNewDecWithPrec(r.Int63n(101), 2)
+	params.BaseFeeHistory = uint64(r.Intn(100)) //#nosec G115 -- positive
+	return params
+}
@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/evm/x/feemarket/keeper"
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams int = 100
+
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params" //#nosec G101 -- not a credential
+)
+
+// ProposalMsgs returns the weighted governance proposal msgs of the fee market
+// module.
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams of the fee market module
+// changing random params of the current ones.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		authority := sdk.AccAddress(address.Module(govtypes.ModuleName))
+
+		return &types.MsgUpdateParams{
+			Authority: authority.String(),
+			Params:    randomParams(r, k.GetParams(ctx)),
+		}
+	}
+}
@@ -46,6 +46,9 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// base_fee_history is the number of the most recent block base fees kept in
+	// the store, older ones are pruned. 0 disables the base fee history.
+	BaseFeeHistory uint64 `protobuf:"varint,9,opt,name=base_fee_history,json=baseFeeHistory,proto3" json:"base_fee_history,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,6 +112,13 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeHistory() uint64 {
+	if m != nil {
+		return m.BaseFeeHistory
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
 }
@@ -118,34 +128,35 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x4f, 0xdb, 0x40,
+	0x14, 0xcf, 0x95, 0x10, 0x92, 0xa3, 0xa9, 0xd2, 0x13, 0x55, 0x2d, 0x50, 0x8d, 0x45, 0x07, 0x2c,
+	0x06, 0x5b, 0x88, 0xad, 0x52, 0x87, 0x06, 0xd4, 0xa2, 0x8a, 0x4a, 0xc8, 0x43, 0x87, 0x2e, 0xa7,
+	0xb3, 0x79, 0xd8, 0x4f, 0xf8, 0xee, 0x22, 0xdf, 0x11, 0x35, 0x5f, 0xa1, 0x53, 0xd5, 0x4f, 0xd1,
+	0x91, 0x8f, 0xc1, 0xc8, 0x58, 0x75, 0x40, 0x55, 0x32, 0xf0, 0x35, 0x2a, 0x7c, 0x90, 0x78, 0x61,
+	0xc8, 0x72, 0x7a, 0xf7, 0xfb, 0xfd, 0xde, 0x4f, 0xef, 0x1f, 0xdd, 0xcd, 0xb4, 0x91, 0xda, 0xc4,
+	0x30, 0x96, 0xf1, 0x39, 0x80, 0x14, 0xd5, 0x05, 0xd8, 0x78, 0xbc, 0xbf, 0xf8, 0x44, 0xa3, 0x4a,
+	0x5b, 0xcd, 0x5e, 0x3b, 0x61, 0x04, 0x63, 0x19, 0x2d, 0xb8, 0xf1, 0xfe, 0xe6, 0x4b, 0x21, 0x51,
+	0xe9, 0xb8, 0x7e, 0x9d, 0x76, 0x73, 0x23, 0xd7, 0xb9, 0xae, 0xc3, 0xf8, 0x3e, 0x72, 0xe8, 0xce,
+	0xaf, 0x36, 0xed, 0x9c, 0x8a, 0x4a, 0x48, 0xc3, 0x7c, 0xba, 0xae, 0x34, 0x4f, 0x85, 0x01, 0x7e,
+	0x0e, 0xe0, 0x91, 0x80, 0x84, 0xdd, 0xa4, 0xa7, 0xf4, 0x50, 0x18, 0xf8, 0x08, 0xc0, 0xde, 0xd3,
+	0xad, 0x47, 0x92, 0x67, 0x85, 0x50, 0x39, 0xf0, 0x33, 0x50, 0x5a, 0xa2, 0x12, 0x56, 0x57, 0xde,
+	0xb3, 0x80, 0x84, 0xfd, 0xc4, 0x4b, 0x9d, 0xfa, 0xb0, 0x16, 0x1c, 0x2d, 0x78, 0x76, 0x40, 0x5f,
+	0x41, 0x29, 0x8c, 0xc5, 0x0c, 0xed, 0x84, 0xcb, 0xcb, 0xd2, 0xe2, 0xa8, 0x44, 0xa8, 0xbc, 0x95,
+	0x3a, 0x71, 0x63, 0x41, 0x7e, 0x99, 0x73, 0xec, 0x2d, 0xed, 0x83, 0x12, 0x69, 0x09, 0xbc, 0x00,
+	0xcc, 0x0b, 0xeb, 0xad, 0x06, 0x24, 0x5c, 0x49, 0x9e, 0x3b, 0xf0, 0xb8, 0xc6, 0xd8, 0x21, 0xed,
+	0xce, 0xab, 0xee, 0x04, 0x24, 0xec, 0x0d, 0xc3, 0xeb, 0xdb, 0xed, 0xd6, 0xdf, 0xdb, 0xed, 0x2d,
+	0x37, 0x1f, 0x73, 0x76, 0x11, 0xa1, 0x8e, 0xa5, 0xb0, 0x45, 0x74, 0x02, 0xb9, 0xc8, 0x26, 0x47,
+	0x90, 0xfd, 0xbe, 0xbb, 0xda, 0x23, 0xc9, 0xda, 0x43, 0xbd, 0xec, 0x84, 0xf6, 0x25, 0x2a, 0x9e,
+	0x0b, 0xc3, 0x47, 0x15, 0x66, 0xe0, 0xad, 0x2d, 0xe9, 0xb4, 0x2e, 0x51, 0x7d, 0x12, 0xe6, 0xf4,
+	0x3e, 0x99, 0x7d, 0xa5, 0xec, 0xd1, 0xad, 0xd1, 0x69, 0x77, 0x49, 0xcb, 0x81, 0xb3, 0x6c, 0xcc,
+	0x23, 0xa4, 0x83, 0xf9, 0x0e, 0x0a, 0x34, 0x56, 0x57, 0x13, 0xaf, 0x17, 0x90, 0xb0, 0x9d, 0xbc,
+	0x78, 0x68, 0xe4, 0xd8, 0xa1, 0xef, 0x76, 0x7e, 0xdc, 0x5d, 0xed, 0xbd, 0x69, 0x1c, 0xd2, 0xf7,
+	0xc6, 0x29, 0xb9, 0x8d, 0x7f, 0x6e, 0x77, 0xdb, 0x83, 0xd5, 0x64, 0x80, 0x0a, 0x2d, 0x8a, 0x72,
+	0xbe, 0xfa, 0xe1, 0x87, 0xeb, 0xa9, 0x4f, 0x6e, 0xa6, 0x3e, 0xf9, 0x37, 0xf5, 0xc9, 0xcf, 0x99,
+	0xdf, 0xba, 0x99, 0xf9, 0xad, 0x3f, 0x33, 0xbf, 0xf5, 0x6d, 0x37, 0x47, 0x5b, 0x5c, 0xa6, 0x51,
+	0xa6, 0x65, 0xfc, 0x84, 0xb7, 0x9d, 0x8c, 0xc0, 0xa4, 0x9d, 0xfa, 0xbc, 0x0e, 0xfe, 0x0f, 0x00,
+	0xea, 0xbb, 0x08, 0x0f, 0xcb, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +179,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseFeeHistory != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeHistory))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -261,6 +277,9 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeHistory != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeHistory))
+	}
 	return n
 }
 
@@ -478,6 +497,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeHistory", wireType)
+			}
+			m.BaseFeeHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -20,6 +24,7 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBaseFeeHistory
 )
 
 const (
@@ -29,8 +34,15 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBaseFeeHistory = []byte{prefixBaseFeeHistory}
 )
 
+// BaseFeeHistoryKey returns the key of the base fee of a block height in the
+// base fee history, the big endian height keeps the history sorted.
+func BaseFeeHistoryKey(height int64) []byte {
+	return append(KeyPrefixBaseFeeHistory, sdk.Uint64ToBigEndian(uint64(height))...) //#nosec G115 -- the block height is never negative
+}
+
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
@@ -21,6 +21,8 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeHistory is the number of block base fees kept in the store
+	DefaultBaseFeeHistory = uint64(10_000)
 )
 
 // Parameter keys
@@ -84,6 +86,7 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeHistory:           DefaultBaseFeeHistory,
 	}
 }
 
@@ -191,6 +191,92 @@ func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
 
+// QueryBaseFeeAtRequest defines the request type for querying the EIP1559 base
+// fee of a block height.
+type QueryBaseFeeAtRequest struct {
+	// height is the block height of the base fee
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBaseFeeAtRequest) Reset()         { *m = QueryBaseFeeAtRequest{} }
+func (m *QueryBaseFeeAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeAtRequest) ProtoMessage()    {}
+func (*QueryBaseFeeAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{4}
+}
+func (m *QueryBaseFeeAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeAtRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeAtRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeAtRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBaseFeeAtResponse returns the EIP1559 base fee of a block height.
+type QueryBaseFeeAtResponse struct {
+	// base_fee is the EIP1559 base fee of the block height
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+}
+
+func (m *QueryBaseFeeAtResponse) Reset()         { *m = QueryBaseFeeAtResponse{} }
+func (m *QueryBaseFeeAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeAtResponse) ProtoMessage()    {}
+func (*QueryBaseFeeAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{5}
+}
+func (m *QueryBaseFeeAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeAtResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeAtResponse proto.InternalMessageInfo
+
 // QueryBlockGasRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBlockGasRequest struct {
@@ -200,7 +286,7 @@ func (m *QueryBlockGasRequest) Reset()         { *m = QueryBlockGasRequest{} }
 func (m *QueryBlockGasRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockGasRequest) ProtoMessage()    {}
 func (*QueryBlockGasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c588b2369eb47d1, []int{4}
+	return fileDescriptor_2c588b2369eb47d1, []int{6}
 }
 func (m *QueryBlockGasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +325,7 @@ func (m *QueryBlockGasResponse) Reset()         { *m = QueryBlockGasResponse{} }
 func (m *QueryBlockGasResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockGasResponse) ProtoMessage()    {}
 func (*QueryBlockGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c588b2369eb47d1, []int{5}
+	return fileDescriptor_2c588b2369eb47d1, []int{7}
 }
 func (m *QueryBlockGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,13 +361,98 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QuerySimulateBaseFeeRequest defines the request type for projecting the
+// EIP1559 base fees of the next blocks.
+type QuerySimulateBaseFeeRequest struct {
+	// utilizations are the gas used by each of the next blocks as a fraction of
+	// the block gas limit, between 0 and 1
+	Utilizations []cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,rep,name=utilizations,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"utilizations"`
+}
+
+func (m *QuerySimulateBaseFeeRequest) Reset()         { *m = QuerySimulateBaseFeeRequest{} }
+func (m *QuerySimulateBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBaseFeeRequest) ProtoMessage()    {}
+func (*QuerySimulateBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{8}
+}
+func (m *QuerySimulateBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBaseFeeRequest.Merge(m, src)
+}
+func (m *QuerySimulateBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateBaseFeeRequest proto.InternalMessageInfo
+
+// QuerySimulateBaseFeeResponse returns the projected EIP1559 base fees.
+type QuerySimulateBaseFeeResponse struct {
+	// base_fees are the base fees of the blocks following each of the
+	// utilizations, starting from the current base fee
+	BaseFees []cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,rep,name=base_fees,json=baseFees,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fees"`
+}
+
+func (m *QuerySimulateBaseFeeResponse) Reset()         { *m = QuerySimulateBaseFeeResponse{} }
+func (m *QuerySimulateBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBaseFeeResponse) ProtoMessage()    {}
+func (*QuerySimulateBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{9}
+}
+func (m *QuerySimulateBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBaseFeeResponse.Merge(m, src)
+}
+func (m *QuerySimulateBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateBaseFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryBaseFeeAtRequest)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeAtRequest")
+	proto.RegisterType((*QueryBaseFeeAtResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeAtResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QuerySimulateBaseFeeRequest)(nil), "cosmos.evm.feemarket.v1.QuerySimulateBaseFeeRequest")
+	proto.RegisterType((*QuerySimulateBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QuerySimulateBaseFeeResponse")
 }
 
 func init() {
@@ -289,36 +460,45 @@ func init() {
 }
 
 var fileDescriptor_2c588b2369eb47d1 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x11, 0x91, 0xb6, 0xc7, 0x02, 0x47, 0x0a, 0xc8, 0x20, 0x9b, 0xba, 0x48, 0xa1, 0x05,
-	0xee, 0xd4, 0xb2, 0xb1, 0x61, 0x21, 0x58, 0x18, 0xc0, 0x1b, 0x2c, 0xd5, 0xc5, 0xbc, 0x5e, 0xad,
-	0xf4, 0x7c, 0x6e, 0xee, 0x12, 0x91, 0x95, 0x99, 0x01, 0x84, 0xf8, 0x0f, 0x8c, 0xfc, 0x06, 0xa6,
-	0x8e, 0x95, 0x58, 0x10, 0x43, 0x85, 0x12, 0x24, 0xfe, 0x06, 0xca, 0xdd, 0xb9, 0xd4, 0x54, 0x56,
-	0xb3, 0x58, 0x4f, 0xcf, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x4f, 0x87, 0xd7, 0x33, 0xa5, 0xa5, 0xd2,
-	0x0c, 0xc6, 0x92, 0xed, 0x02, 0x48, 0x3e, 0x1c, 0x80, 0x61, 0xe3, 0x2d, 0x76, 0x30, 0x82, 0xe1,
-	0x84, 0x96, 0x43, 0x65, 0x14, 0xb9, 0xee, 0x40, 0x14, 0xc6, 0x92, 0x9e, 0x80, 0xe8, 0x78, 0x2b,
-	0xb8, 0xc2, 0x65, 0x5e, 0x28, 0x66, 0xbf, 0x0e, 0x1b, 0xf4, 0x9a, 0x08, 0xff, 0x0d, 0x3a, 0x60,
-	0x57, 0x28, 0xa1, 0x6c, 0xc9, 0xe6, 0x95, 0xef, 0xde, 0x12, 0x4a, 0x89, 0x7d, 0x60, 0xbc, 0xcc,
-	0x19, 0x2f, 0x0a, 0x65, 0xb8, 0xc9, 0x55, 0xa1, 0xdd, 0xdf, 0xb8, 0x8b, 0xc9, 0xcb, 0xb9, 0xaf,
-	0x17, 0x7c, 0xc8, 0xa5, 0x4e, 0xe1, 0x60, 0x04, 0xda, 0xc4, 0xaf, 0xf0, 0xd5, 0x5a, 0x57, 0x97,
-	0xaa, 0xd0, 0x40, 0x12, 0xdc, 0x29, 0x6d, 0xe7, 0x06, 0xba, 0x8d, 0xee, 0x5e, 0xda, 0x8e, 0x68,
-	0xc3, 0x1a, 0xd4, 0x0d, 0x26, 0x2b, 0x87, 0xc7, 0x51, 0xeb, 0xcb, 0x9f, 0xaf, 0x9b, 0x28, 0xf5,
-	0x93, 0xf1, 0xaa, 0xa7, 0x4e, 0xb8, 0x86, 0xa7, 0x00, 0x95, 0x62, 0x8a, 0xbb, 0xf5, 0xb6, 0x97,
-	0x7c, 0x84, 0x97, 0xfb, 0x5c, 0xc3, 0xce, 0x2e, 0x80, 0x15, 0x5d, 0x49, 0xa2, 0x9f, 0xc7, 0xd1,
-	0x4d, 0xa7, 0xab, 0xdf, 0x0c, 0x68, 0xae, 0x98, 0xe4, 0x66, 0x8f, 0x3e, 0x07, 0xc1, 0xb3, 0xc9,
-	0x13, 0xc8, 0xd2, 0xa5, 0xbe, 0xe3, 0x88, 0xaf, 0x55, 0x9c, 0xfb, 0x2a, 0x1b, 0x3c, 0xe3, 0x27,
-	0xdb, 0x6d, 0xe0, 0xd5, 0xff, 0xfa, 0x5e, 0xec, 0x32, 0x6e, 0x0b, 0xee, 0x96, 0x6b, 0xa7, 0xf3,
-	0x72, 0xfb, 0x5b, 0x1b, 0x5f, 0xb4, 0x58, 0xf2, 0x1e, 0xe1, 0x8e, 0xdb, 0x8a, 0xdc, 0x6b, 0x5c,
-	0xfb, 0x6c, 0x94, 0xc1, 0xfd, 0xc5, 0xc0, 0xce, 0x41, 0xdc, 0x7b, 0xf7, 0xfd, 0xf7, 0xa7, 0x0b,
-	0x6b, 0x24, 0x62, 0x4d, 0x47, 0x77, 0x31, 0x92, 0x8f, 0x08, 0x2f, 0xf9, 0xac, 0xc8, 0x39, 0x12,
-	0xf5, 0xa4, 0x83, 0x07, 0x0b, 0xa2, 0xbd, 0xa3, 0x0d, 0xeb, 0x68, 0x9d, 0xac, 0x35, 0x3a, 0xaa,
-	0xee, 0x43, 0x3e, 0x23, 0xbc, 0x5c, 0x65, 0x4a, 0xce, 0x93, 0xa9, 0xdf, 0x24, 0xa0, 0x8b, 0xc2,
-	0xbd, 0xad, 0x4d, 0x6b, 0xeb, 0x0e, 0x89, 0x9b, 0x6d, 0xcd, 0x47, 0x76, 0x04, 0xd7, 0xc9, 0xe3,
-	0xc3, 0x69, 0x88, 0x8e, 0xa6, 0x21, 0xfa, 0x35, 0x0d, 0xd1, 0x87, 0x59, 0xd8, 0x3a, 0x9a, 0x85,
-	0xad, 0x1f, 0xb3, 0xb0, 0xf5, 0xba, 0x27, 0x72, 0xb3, 0x37, 0xea, 0xd3, 0x4c, 0xc9, 0xd3, 0x3c,
-	0x6f, 0x4f, 0x31, 0x99, 0x49, 0x09, 0xba, 0xdf, 0xb1, 0xaf, 0xe5, 0xe1, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x9a, 0xf3, 0x49, 0xdd, 0x03, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6f, 0x12, 0x41,
+	0x14, 0xc6, 0x19, 0x1b, 0x69, 0x19, 0x4d, 0xd4, 0xb1, 0x60, 0xb3, 0x6d, 0x96, 0x76, 0x31, 0xc1,
+	0x62, 0x9d, 0x49, 0x51, 0x2f, 0x1e, 0x8c, 0x25, 0xc6, 0x5e, 0x3c, 0x28, 0x5e, 0xd4, 0x0b, 0x0e,
+	0x38, 0x2c, 0x1b, 0x58, 0x86, 0x32, 0x03, 0x11, 0x8d, 0x17, 0xcf, 0x1e, 0x34, 0xc6, 0x8b, 0x27,
+	0x8f, 0x1e, 0x8d, 0x7f, 0x45, 0x8f, 0x4d, 0xbc, 0x18, 0x0f, 0x8d, 0x01, 0x13, 0xff, 0x0d, 0xc3,
+	0xcc, 0x2c, 0xb2, 0xb4, 0x2b, 0xdb, 0x4b, 0x33, 0x7d, 0x7c, 0xef, 0x7d, 0xbf, 0xf9, 0x78, 0x03,
+	0xcc, 0xd5, 0xb8, 0xf0, 0xb9, 0x20, 0xac, 0xef, 0x93, 0x3a, 0x63, 0x3e, 0xed, 0x36, 0x99, 0x24,
+	0xfd, 0x6d, 0xb2, 0xd7, 0x63, 0xdd, 0x01, 0xee, 0x74, 0xb9, 0xe4, 0xe8, 0x92, 0x16, 0x61, 0xd6,
+	0xf7, 0xf1, 0x44, 0x84, 0xfb, 0xdb, 0xd6, 0x05, 0xea, 0x7b, 0x6d, 0x4e, 0xd4, 0x5f, 0xad, 0xb5,
+	0xf2, 0x51, 0x03, 0xff, 0x35, 0x6a, 0xe1, 0xb2, 0xcb, 0x5d, 0xae, 0x8e, 0x64, 0x7c, 0x32, 0xd5,
+	0x35, 0x97, 0x73, 0xb7, 0xc5, 0x08, 0xed, 0x78, 0x84, 0xb6, 0xdb, 0x5c, 0x52, 0xe9, 0xf1, 0xb6,
+	0xd0, 0x9f, 0x3a, 0xcb, 0x10, 0x3d, 0x1c, 0x73, 0x3d, 0xa0, 0x5d, 0xea, 0x8b, 0x32, 0xdb, 0xeb,
+	0x31, 0x21, 0x9d, 0x27, 0xf0, 0x62, 0xa8, 0x2a, 0x3a, 0xbc, 0x2d, 0x18, 0x2a, 0xc1, 0x64, 0x47,
+	0x55, 0x56, 0xc0, 0x3a, 0xb8, 0x72, 0xa6, 0x98, 0xc5, 0x11, 0xd7, 0xc0, 0xba, 0xb1, 0x94, 0xda,
+	0x3f, 0xcc, 0x26, 0xbe, 0xfc, 0xf9, 0x5a, 0x00, 0x65, 0xd3, 0xe9, 0xa4, 0xcd, 0xe8, 0x12, 0x15,
+	0xec, 0x1e, 0x63, 0x81, 0x63, 0x19, 0x2e, 0x87, 0xcb, 0xc6, 0xf2, 0x16, 0x5c, 0xaa, 0x52, 0xc1,
+	0x2a, 0x75, 0xc6, 0x94, 0x69, 0xaa, 0x94, 0xfd, 0x79, 0x98, 0x5d, 0xd5, 0xbe, 0xe2, 0x79, 0x13,
+	0x7b, 0x9c, 0xf8, 0x54, 0x36, 0xf0, 0x7d, 0xe6, 0xd2, 0xda, 0xe0, 0x2e, 0xab, 0x95, 0x17, 0xab,
+	0x7a, 0x86, 0x43, 0x60, 0x7a, 0x7a, 0xe6, 0x8e, 0x34, 0x66, 0x28, 0x03, 0x93, 0x0d, 0xe6, 0xb9,
+	0x0d, 0xa9, 0x46, 0x2e, 0x94, 0xcd, 0x7f, 0xce, 0x63, 0x98, 0x99, 0x6d, 0x30, 0x18, 0xb7, 0x8f,
+	0x60, 0xe4, 0xc6, 0x57, 0x8b, 0x8d, 0x92, 0x09, 0xae, 0xd7, 0xe2, 0xb5, 0xe6, 0x2e, 0x9d, 0x04,
+	0xbd, 0x09, 0xd3, 0x33, 0x75, 0x63, 0x78, 0x1e, 0x2e, 0xb8, 0x54, 0x18, 0xbe, 0xf1, 0xd1, 0xa9,
+	0xc3, 0x55, 0x25, 0x7d, 0xe4, 0xf9, 0xbd, 0x16, 0x95, 0x2c, 0x1c, 0x20, 0xda, 0x85, 0x67, 0x7b,
+	0xd2, 0x6b, 0x79, 0x2f, 0xf5, 0xd7, 0xbb, 0x02, 0xd6, 0x17, 0xe2, 0x52, 0x86, 0x1a, 0x9d, 0x67,
+	0x70, 0xed, 0x78, 0x1f, 0x43, 0x76, 0x07, 0xa6, 0x82, 0x28, 0x4e, 0xe4, 0xb2, 0x64, 0xb2, 0x10,
+	0xc5, 0x4f, 0x49, 0x78, 0x5a, 0x59, 0xa0, 0xb7, 0x00, 0x26, 0xf5, 0xaa, 0xa0, 0xab, 0x91, 0xbb,
+	0x74, 0x74, 0x3f, 0xad, 0xad, 0x78, 0x62, 0x4d, 0xec, 0xe4, 0xdf, 0x7c, 0xff, 0xfd, 0xe1, 0xd4,
+	0x06, 0xca, 0x92, 0xa8, 0x97, 0xa4, 0x77, 0x13, 0xbd, 0x07, 0x70, 0xd1, 0x5c, 0x17, 0xcd, 0xb1,
+	0x08, 0xa7, 0x6f, 0x5d, 0x8b, 0xa9, 0x36, 0x44, 0x9b, 0x8a, 0x28, 0x87, 0x36, 0x22, 0x89, 0x82,
+	0x88, 0xd1, 0x67, 0x00, 0x53, 0x93, 0x7d, 0x44, 0x38, 0x96, 0xcf, 0x64, 0xd3, 0x2d, 0x12, 0x5b,
+	0x6f, 0xc8, 0x8a, 0x8a, 0x6c, 0x0b, 0x15, 0xe6, 0x92, 0x91, 0x57, 0xfa, 0xd5, 0xbc, 0x46, 0x1f,
+	0x01, 0x5c, 0x0a, 0x16, 0x18, 0xcd, 0x4b, 0x22, 0xfc, 0x00, 0x2c, 0x1c, 0x57, 0x6e, 0xf8, 0x0a,
+	0x8a, 0xef, 0x32, 0x72, 0xa2, 0xf9, 0xc6, 0x2d, 0x15, 0x97, 0x0a, 0xf4, 0x0d, 0xc0, 0x73, 0x33,
+	0x5b, 0x8c, 0x6e, 0xfc, 0xdf, 0xef, 0xf8, 0xc7, 0x65, 0xdd, 0x3c, 0x61, 0x57, 0xec, 0x30, 0x85,
+	0xe9, 0xac, 0x04, 0xa9, 0x96, 0x76, 0xf6, 0x87, 0x36, 0x38, 0x18, 0xda, 0xe0, 0xd7, 0xd0, 0x06,
+	0xef, 0x46, 0x76, 0xe2, 0x60, 0x64, 0x27, 0x7e, 0x8c, 0xec, 0xc4, 0xd3, 0xbc, 0xeb, 0xc9, 0x46,
+	0xaf, 0x8a, 0x6b, 0xdc, 0x9f, 0x9e, 0xf7, 0x62, 0x6a, 0xa2, 0x1c, 0x74, 0x98, 0xa8, 0x26, 0xd5,
+	0x4f, 0xfb, 0xf5, 0xbf, 0x03, 0x00, 0xc0, 0xb6, 0x73, 0xdd, 0x8a, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,8 +517,13 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// BaseFeeAt queries the base fee of a block height from the base fee history.
+	BaseFeeAt(ctx context.Context, in *QueryBaseFeeAtRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// SimulateBaseFee projects the base fees of the next blocks for a sequence of
+	// block utilizations under the current parameters.
+	SimulateBaseFee(ctx context.Context, in *QuerySimulateBaseFeeRequest, opts ...grpc.CallOption) (*QuerySimulateBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -367,6 +552,15 @@ func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeAt(ctx context.Context, in *QueryBaseFeeAtRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtResponse, error) {
+	out := new(QueryBaseFeeAtResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/BaseFeeAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error) {
 	out := new(QueryBlockGasResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/BlockGas", in, out, opts...)
@@ -376,14 +570,28 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) SimulateBaseFee(ctx context.Context, in *QuerySimulateBaseFeeRequest, opts ...grpc.CallOption) (*QuerySimulateBaseFeeResponse, error) {
+	out := new(QuerySimulateBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/SimulateBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// BaseFeeAt queries the base fee of a block height from the base fee history.
+	BaseFeeAt(context.Context, *QueryBaseFeeAtRequest) (*QueryBaseFeeAtResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// SimulateBaseFee projects the base fees of the next blocks for a sequence of
+	// block utilizations under the current parameters.
+	SimulateBaseFee(context.Context, *QuerySimulateBaseFeeRequest) (*QuerySimulateBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -396,9 +604,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
+func (*UnimplementedQueryServer) BaseFeeAt(ctx context.Context, req *QueryBaseFeeAtRequest) (*QueryBaseFeeAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeAt not implemented")
+}
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) SimulateBaseFee(ctx context.Context, req *QuerySimulateBaseFeeRequest) (*QuerySimulateBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -440,6 +654,24 @@ func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/BaseFeeAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeAt(ctx, req.(*QueryBaseFeeAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBlockGasRequest)
 	if err := dec(in); err != nil {
@@ -458,6 +690,25 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/SimulateBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBaseFee(ctx, req.(*QuerySimulateBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -470,10 +721,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
 		},
+		{
+			MethodName: "BaseFeeAt",
+			Handler:    _Query_BaseFeeAt_Handler,
+		},
 		{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "SimulateBaseFee",
+			Handler:    _Query_SimulateBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -593,6 +852,67 @@ func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBaseFeeAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBlockGasRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -644,6 +964,80 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySimulateBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Utilizations) > 0 {
+		for iNdEx := len(m.Utilizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Utilizations[iNdEx].Size()
+				i -= size
+				if _, err := m.Utilizations[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFees) > 0 {
+		for iNdEx := len(m.BaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BaseFees[iNdEx].Size()
+				i -= size
+				if _, err := m.BaseFees[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -697,6 +1091,29 @@ func (m *QueryBaseFeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBaseFeeAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryBlockGasRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -718,8 +1135,38 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QuerySimulateBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Utilizations) > 0 {
+		for _, e := range m.Utilizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BaseFees) > 0 {
+		for _, e := range m.BaseFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -993,6 +1440,159 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeAtRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeAtRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeAtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeAtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1112,6 +1712,178 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySimulateBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilizations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Utilizations = append(m.Utilizations, v)
+			if err := m.Utilizations[len(m.Utilizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.BaseFees = append(m.BaseFees, v)
+			if err := m.BaseFees[len(m.BaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
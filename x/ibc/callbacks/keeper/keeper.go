@@ -13,6 +13,7 @@ import (
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	"github.com/cosmos/evm/x/ibc/callbacks/types"
 	evmante "github.com/cosmos/evm/x/vm/ante"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 	callbacktypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -155,6 +156,11 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	}
 
 	contractAddr := common.HexToAddress(contractAddress)
+	// the packets calling a contract outside of the allowlist of the evm params
+	// fail with an error acknowledgement, which refunds the transfer
+	if !k.evmKeeper.GetParams(ctx).IsIBCCallbackContractAllowed(contractAddr) {
+		return errorsmod.Wrapf(types.ErrContractNotAllowed, "contract %s is not allowed to be called by the received packets", contractAddr)
+	}
 	contractAccount := k.evmKeeper.GetAccountOrEmpty(ctx, contractAddr)
 
 	// Check if the contract address contains code.
@@ -185,9 +191,9 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract
 
-	remainingGas := math.NewIntFromUint64(cachedCtx.GasMeter().GasRemaining()).BigInt()
+	remainingGas := math.NewIntFromUint64(evmtypes.GetGasRatio().CosmosToEVMGas(cachedCtx.GasMeter().GasRemaining())).BigInt()
 
-	// Call the EVM with the remaining gas as the maximum gas limit.
+	// Call the EVM with the remaining gas, translated into EVM gas, as the maximum gas limit.
 	// Up to now, the remaining gas is equal to the callback gas limit set by the user.
 	// NOTE: use the cached ctx for the EVM calls.
 	res, err := k.evmKeeper.CallEVM(cachedCtx, erc20.ABI, receiverHex, tokenPair.GetERC20Contract(), true, remainingGas, "approve", contractAddr, amountInt.BigInt())
@@ -196,7 +202,7 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	}
 
 	// Consume the actual used gas on the original callback context.
-	ctx.GasMeter().ConsumeGas(res.GasUsed, "callback allowance")
+	ctx.GasMeter().ConsumeGas(evmtypes.GetGasRatio().EVMToCosmosGas(res.GasUsed), "callback allowance")
 	remainingGas = remainingGas.Sub(remainingGas, math.NewIntFromUint64(res.GasUsed).BigInt())
 	if ctx.GasMeter().IsOutOfGas() || remainingGas.Cmp(big.NewInt(0)) < 0 {
 		return errorsmod.Wrapf(types.ErrOutOfGas, "out of gas")
@@ -219,7 +225,7 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	}
 
 	// Consume the actual gas used on the original callback context.
-	ctx.GasMeter().ConsumeGas(res.GasUsed, "callback function")
+	ctx.GasMeter().ConsumeGas(evmtypes.GetGasRatio().EVMToCosmosGas(res.GasUsed), "callback function")
 	if ctx.GasMeter().IsOutOfGas() {
 		return errorsmod.Wrapf(types.ErrOutOfGas, "out of gas")
 	}
@@ -325,14 +331,14 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 
 	// Call the onPacketAcknowledgement function in the contract
 	// NOTE: use the cached ctx for the EVM calls.
-	res, err := k.evmKeeper.CallEVM(cachedCtx, *abi, sender, contractAddr, true, math.NewIntFromUint64(cachedCtx.GasMeter().GasRemaining()).BigInt(), "onPacketAcknowledgement",
+	res, err := k.evmKeeper.CallEVM(cachedCtx, *abi, sender, contractAddr, true, math.NewIntFromUint64(evmtypes.GetGasRatio().CosmosToEVMGas(cachedCtx.GasMeter().GasRemaining())).BigInt(), "onPacketAcknowledgement",
 		packet.GetSourceChannel(), packet.GetSourcePort(), packet.GetSequence(), packet.GetData(), acknowledgement)
 	if err != nil {
 		return errorsmod.Wrapf(types.ErrCallbackFailed, "EVM returned error: %s", err.Error())
 	}
 
 	// Consume the actual gas used on the original callback context.
-	ctx.GasMeter().ConsumeGas(res.GasUsed, "callback onPacketAcknowledgement")
+	ctx.GasMeter().ConsumeGas(evmtypes.GetGasRatio().EVMToCosmosGas(res.GasUsed), "callback onPacketAcknowledgement")
 	if ctx.GasMeter().IsOutOfGas() {
 		return errorsmod.Wrapf(types.ErrCallbackFailed, "out of gas")
 	}
@@ -424,14 +430,14 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 		return err
 	}
 
-	res, err := k.evmKeeper.CallEVM(ctx, *abi, sender, contractAddr, true, math.NewIntFromUint64(cachedCtx.GasMeter().GasRemaining()).BigInt(), "onPacketTimeout",
+	res, err := k.evmKeeper.CallEVM(ctx, *abi, sender, contractAddr, true, math.NewIntFromUint64(evmtypes.GetGasRatio().CosmosToEVMGas(cachedCtx.GasMeter().GasRemaining())).BigInt(), "onPacketTimeout",
 		packet.GetSourceChannel(), packet.GetSourcePort(), packet.GetSequence(), packet.GetData())
 	if err != nil {
 		return errorsmod.Wrapf(types.ErrCallbackFailed, "EVM returned error: %s", err.Error())
 	}
 
 	// Consume the actual gas used on the original callback context.
-	ctx.GasMeter().ConsumeGas(res.GasUsed, "callback onPacketAcknowledgement")
+	ctx.GasMeter().ConsumeGas(evmtypes.GetGasRatio().EVMToCosmosGas(res.GasUsed), "callback onPacketAcknowledgement")
 	if ctx.GasMeter().IsOutOfGas() {
 		return errorsmod.Wrapf(types.ErrCallbackFailed, "out of gas")
 	}
@@ -15,4 +15,5 @@ var (
 	ErrAllowanceFailed        = errorsmod.Register(ModuleName, 7, "allowance failed")
 	ErrEVMCallFailed          = errorsmod.Register(ModuleName, 8, "evm call failed")
 	ErrOutOfGas               = errorsmod.Register(ModuleName, 9, "out of gas")
+	ErrContractNotAllowed     = errorsmod.Register(ModuleName, 10, "contract not allowed")
 )
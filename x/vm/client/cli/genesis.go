@@ -0,0 +1,279 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const flagPreinstallNames = "names"
+
+// AddGenesisPreinstallsCmd returns the command that adds the default
+// preinstalls to the evm genesis state of genesis.json
+func AddGenesisPreinstallsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-preinstalls",
+		Short: "Add the default preinstalled contracts to genesis.json",
+		Long: `Add the default preinstalled contracts (Create2 deployer, Multicall3, Permit2,
+EIP-2470 and Safe singleton factories) to the evm genesis state of genesis.json,
+so that they are deployed at their canonical addresses at genesis. Preinstalls
+that are already in the genesis state are kept as they are.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := server.GetServerContextFromCmd(cmd).Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			names, err := cmd.Flags().GetStringSlice(flagPreinstallNames)
+			if err != nil {
+				return err
+			}
+
+			preinstalls, err := selectPreinstalls(names)
+			if err != nil {
+				return err
+			}
+
+			return addGenesisPreinstalls(clientCtx.Codec, config.GenesisFile(), preinstalls)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().StringSlice(flagPreinstallNames, nil, "names of the default preinstalls to add (default: all)")
+
+	return cmd
+}
+
+// selectPreinstalls returns the default preinstalls with the given names, or
+// all of them if no names are given
+func selectPreinstalls(names []string) ([]types.Preinstall, error) {
+	if len(names) == 0 {
+		return types.DefaultPreinstalls, nil
+	}
+
+	preinstalls := make([]types.Preinstall, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, preinstall := range types.DefaultPreinstalls {
+			if strings.EqualFold(preinstall.Name, name) {
+				preinstalls = append(preinstalls, preinstall)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown preinstall %q", name)
+		}
+	}
+	return preinstalls, nil
+}
+
+// addGenesisPreinstalls adds the preinstalls that aren't deployed yet to the
+// evm genesis state of the given genesis file
+func addGenesisPreinstalls(cdc codec.JSONCodec, genFile string, preinstalls []types.Preinstall) error {
+	appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	var evmGenState types.GenesisState
+	if err := cdc.UnmarshalJSON(appState[types.ModuleName], &evmGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+	}
+
+	deployed := make(map[common.Address]bool)
+	for _, preinstall := range evmGenState.Preinstalls {
+		deployed[common.HexToAddress(preinstall.Address)] = true
+	}
+	for _, account := range evmGenState.Accounts {
+		deployed[common.HexToAddress(account.Address)] = true
+	}
+
+	for _, preinstall := range preinstalls {
+		address := common.HexToAddress(preinstall.Address)
+		if deployed[address] {
+			continue
+		}
+		evmGenState.Preinstalls = append(evmGenState.Preinstalls, preinstall)
+		deployed[address] = true
+	}
+
+	if err := evmGenState.Validate(); err != nil {
+		return fmt.Errorf("invalid evm genesis state: %w", err)
+	}
+
+	evmGenStateBz, err := cdc.MarshalJSON(&evmGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal evm genesis state: %w", err)
+	}
+	appState[types.ModuleName] = evmGenStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	appGenesis.AppState = appStateJSON
+	return genutil.ExportGenesisFile(appGenesis, genFile)
+}
+
+// SweepGenesisEmptyAccountsCmd returns the command that removes the empty
+// accounts from the auth genesis state of genesis.json
+func SweepGenesisEmptyAccountsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-empty-accounts",
+		Short: "Remove the empty accounts from genesis.json",
+		Long: `Remove the empty accounts from the auth genesis state of genesis.json, as EIP-158
+does: the base accounts which have never sent a tx, aren't contracts, have no
+balance of any denom and no stake, delegated or unbonding. It's meant to shrink the genesis of a chain restarted from
+an exported state, the running chains sweep their accounts at the end of the
+blocks with the empty_account_sweep_batch param of the evm module.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := server.GetServerContextFromCmd(cmd).Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			removed, err := sweepGenesisEmptyAccounts(clientCtx.Codec, config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("removed %d empty accounts\n", removed)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// sweepGenesisEmptyAccounts removes the empty accounts, and their empty
+// balances, from the auth and bank genesis states of the given genesis file,
+// and returns the number of removed accounts
+func sweepGenesisEmptyAccounts(cdc codec.Codec, genFile string) (int, error) {
+	appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to unpack genesis accounts: %w", err)
+	}
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	// the accounts holding coins, fractional coins or code aren't empty
+	nonEmpty := make(map[string]bool)
+	for _, balance := range bankGenState.Balances {
+		if !balance.Coins.IsZero() {
+			nonEmpty[balance.Address] = true
+		}
+	}
+	if bz, ok := appState[precisebanktypes.ModuleName]; ok {
+		var precisebankGenState precisebanktypes.GenesisState
+		if err := cdc.UnmarshalJSON(bz, &precisebankGenState); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal precisebank genesis state: %w", err)
+		}
+		for _, balance := range precisebankGenState.Balances {
+			if !balance.Amount.IsZero() {
+				nonEmpty[balance.Address] = true
+			}
+		}
+	}
+	// the stake of the validators and the delegators is held by the staking
+	// module, the accounts with staking state aren't empty
+	stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+	for _, validator := range stakingGenState.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		if err != nil {
+			return 0, fmt.Errorf("invalid validator operator address %s: %w", validator.OperatorAddress, err)
+		}
+		nonEmpty[sdk.AccAddress(valAddr).String()] = true
+	}
+	for _, delegation := range stakingGenState.Delegations {
+		nonEmpty[delegation.DelegatorAddress] = true
+	}
+	for _, ubd := range stakingGenState.UnbondingDelegations {
+		nonEmpty[ubd.DelegatorAddress] = true
+	}
+	for _, redelegation := range stakingGenState.Redelegations {
+		nonEmpty[redelegation.DelegatorAddress] = true
+	}
+	contracts := make(map[common.Address]bool)
+	if bz, ok := appState[types.ModuleName]; ok {
+		var evmGenState types.GenesisState
+		if err := cdc.UnmarshalJSON(bz, &evmGenState); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+		}
+		for _, account := range evmGenState.Accounts {
+			contracts[common.HexToAddress(account.Address)] = true
+		}
+		for _, preinstall := range evmGenState.Preinstalls {
+			contracts[common.HexToAddress(preinstall.Address)] = true
+		}
+	}
+
+	kept := make(authtypes.GenesisAccounts, 0, len(accounts))
+	removed := make(map[string]bool)
+	for _, account := range accounts {
+		baseAccount, ok := account.(*authtypes.BaseAccount)
+		if !ok || baseAccount.Sequence != 0 || baseAccount.PubKey != nil ||
+			nonEmpty[baseAccount.Address] || contracts[common.BytesToAddress(baseAccount.GetAddress())] {
+			kept = append(kept, account)
+			continue
+		}
+		removed[baseAccount.Address] = true
+	}
+	if len(removed) == 0 {
+		return 0, nil
+	}
+
+	authGenState.Accounts, err = authtypes.PackAccounts(kept)
+	if err != nil {
+		return 0, fmt.Errorf("failed to pack genesis accounts: %w", err)
+	}
+	balances := make([]banktypes.Balance, 0, len(bankGenState.Balances))
+	for _, balance := range bankGenState.Balances {
+		if !removed[balance.Address] {
+			balances = append(balances, balance)
+		}
+	}
+	bankGenState.Balances = balances
+
+	if appState[authtypes.ModuleName], err = cdc.MarshalJSON(&authGenState); err != nil {
+		return 0, fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+	if appState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGenState); err != nil {
+		return 0, fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	appGenesis.AppState = appStateJSON
+	return len(removed), genutil.ExportGenesisFile(appGenesis, genFile)
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAddGenesisPreinstalls(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	genState := types.DefaultGenesisState()
+	genState.Preinstalls = []types.Preinstall{types.DefaultPreinstalls[0]}
+	genStateBz, err := cdc.MarshalJSON(genState)
+	require.NoError(t, err)
+	appState, err := json.Marshal(map[string]json.RawMessage{types.ModuleName: genStateBz})
+	require.NoError(t, err)
+
+	genFile := filepath.Join(t.TempDir(), "genesis.json")
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test", appState)
+	require.NoError(t, appGenesis.SaveAs(genFile))
+
+	preinstalls, err := selectPreinstalls([]string{"multicall3", "EIP-2470 singleton factory"})
+	require.NoError(t, err)
+	require.Len(t, preinstalls, 2)
+
+	_, err = selectPreinstalls([]string{"unknown"})
+	require.ErrorContains(t, err, "unknown preinstall")
+
+	// the preinstalls are added once and the existing ones are kept
+	all, err := selectPreinstalls(nil)
+	require.NoError(t, err)
+	require.NoError(t, addGenesisPreinstalls(cdc, genFile, all))
+	require.NoError(t, addGenesisPreinstalls(cdc, genFile, all))
+
+	exported, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	require.NoError(t, err)
+
+	var exportedGenState types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(exported[types.ModuleName], &exportedGenState))
+	require.Equal(t, types.DefaultPreinstalls, exportedGenState.Preinstalls)
+}
+
+func TestSweepGenesisEmptyAccounts(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	empty := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	funded := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	used := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+	// the validator operator and the delegator have their whole stake bonded
+	operator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	delegator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	unbonding := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	usedAccount := authtypes.NewBaseAccountWithAddress(used)
+	usedAccount.Sequence = 1
+	authGenStateBz, err := cdc.MarshalJSON(authtypes.NewGenesisState(authtypes.DefaultParams(), authtypes.GenesisAccounts{
+		authtypes.NewBaseAccountWithAddress(empty),
+		authtypes.NewBaseAccountWithAddress(funded),
+		usedAccount,
+		authtypes.NewBaseAccountWithAddress(contract.Bytes()),
+		authtypes.NewBaseAccountWithAddress(operator),
+		authtypes.NewBaseAccountWithAddress(delegator),
+		authtypes.NewBaseAccountWithAddress(unbonding),
+	}))
+	require.NoError(t, err)
+
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = []banktypes.Balance{
+		{Address: empty.String(), Coins: sdk.Coins{}},
+		{Address: funded.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("aatom", 1))},
+	}
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	require.NoError(t, err)
+
+	evmGenState := types.DefaultGenesisState()
+	evmGenState.Accounts = []types.GenesisAccount{{Address: contract.Hex(), Code: "600160005260206000f3"}}
+	evmGenStateBz, err := cdc.MarshalJSON(evmGenState)
+	require.NoError(t, err)
+
+	valAddr := sdk.ValAddress(operator).String()
+	stakingGenState := stakingtypes.DefaultGenesisState()
+	stakingGenState.Validators = []stakingtypes.Validator{{OperatorAddress: valAddr}}
+	stakingGenState.Delegations = []stakingtypes.Delegation{{DelegatorAddress: delegator.String(), ValidatorAddress: valAddr}}
+	stakingGenState.UnbondingDelegations = []stakingtypes.UnbondingDelegation{{DelegatorAddress: unbonding.String(), ValidatorAddress: valAddr}}
+	stakingGenStateBz, err := cdc.MarshalJSON(stakingGenState)
+	require.NoError(t, err)
+
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		authtypes.ModuleName:    authGenStateBz,
+		banktypes.ModuleName:    bankGenStateBz,
+		stakingtypes.ModuleName: stakingGenStateBz,
+		types.ModuleName:        evmGenStateBz,
+	})
+	require.NoError(t, err)
+
+	genFile := filepath.Join(t.TempDir(), "genesis.json")
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test", appState)
+	require.NoError(t, appGenesis.SaveAs(genFile))
+
+	// only the empty account is removed, and a second sweep is a no-op
+	removed, err := sweepGenesisEmptyAccounts(cdc, genFile)
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+	removed, err = sweepGenesisEmptyAccounts(cdc, genFile)
+	require.NoError(t, err)
+	require.Zero(t, removed)
+
+	exported, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	require.NoError(t, err)
+
+	exportedAuthGenState := authtypes.GetGenesisStateFromAppState(cdc, exported)
+	exportedAccounts, err := authtypes.UnpackAccounts(exportedAuthGenState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, exportedAccounts, 6)
+	for _, account := range exportedAccounts {
+		require.NotEqual(t, empty, account.GetAddress())
+	}
+
+	exportedBankGenState := banktypes.GetGenesisStateFromAppState(cdc, exported)
+	require.Len(t, exportedBankGenState.Balances, 1)
+	require.Equal(t, funded.String(), exportedBankGenState.Balances[0].Address)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/evm/contracts"
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/types"
 
@@ -39,6 +40,7 @@ func GetQueryCmd() *cobra.Command {
 		Bech32ToHexCmd(),
 		GetBankBalanceCmd(),
 		GetERC20BalanceCmd(),
+		GetCallCmd(),
 	)
 	return cmd
 }
@@ -48,7 +50,7 @@ func GetStorageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "storage ADDRESS KEY",
 		Short: "Gets storage for an account with a given key and height",
-		Long:  "Gets storage for an account with a given key and height. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Long:  "Gets storage for an account with a given key and height. The address may be given in the 0x or bech32 form. If the height is not provided, it will use the latest height from context.", //nolint:lll
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -75,7 +77,11 @@ func GetStorageCmd() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printJSON(clientCtx, struct {
+				addressOutput
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			}{newAddressOutput(address), key, res.Value})
 		},
 	}
 
@@ -88,7 +94,7 @@ func GetCodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "code ADDRESS",
 		Short: "Gets code from an account",
-		Long:  "Gets code from an account. If the height is not provided, it will use the latest height from context.",
+		Long:  "Gets the hex-encoded code from an account. The address may be given in the 0x or bech32 form. If the height is not provided, it will use the latest height from context.", //nolint:lll
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -112,7 +118,10 @@ func GetCodeCmd() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printJSON(clientCtx, struct {
+				addressOutput
+				Code hexutil.Bytes `json:"code"`
+			}{newAddressOutput(address), res.Code})
 		},
 	}
 
@@ -125,7 +134,7 @@ func GetAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "account ADDRESS",
 		Short: "Gets account info from an address",
-		Long:  "Gets account info from an address. If the height is not provided, it will use the latest height from context.",
+		Long:  "Gets the balance, code hash and nonce of an account. The address may be given in the 0x or bech32 form. If the height is not provided, it will use the latest height from context.", //nolint:lll
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -149,7 +158,12 @@ func GetAccountCmd() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printJSON(clientCtx, struct {
+				addressOutput
+				Balance  string `json:"balance"`
+				CodeHash string `json:"code_hash"`
+				Nonce    uint64 `json:"nonce,string"`
+			}{newAddressOutput(address), res.Balance, res.CodeHash, res.Nonce})
 		},
 	}
 
@@ -256,8 +270,8 @@ func Bech32ToHexCmd() *cobra.Command {
 func GetBankBalanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "balance-bank [address] [denom]",
-		Short:   "Get the bank balance for a given 0x address and bank denom",
-		Long:    "Get the bank balance for a given 0x address and bank denom.",
+		Short:   "Get the bank balance for a given 0x or bech32 address and bank denom",
+		Long:    "Get the bank balance for a given 0x or bech32 address and bank denom.",
 		Example: "evmd query evm balance-bank 0xA2A8B87390F8F2D188242656BFb6852914073D06 atoken",
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -266,10 +280,15 @@ func GetBankBalanceCmd() *cobra.Command {
 				return err
 			}
 
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
 			queryClient := banktypes.NewQueryClient(clientCtx)
 
 			res, err := queryClient.Balance(cmd.Context(), &banktypes.QueryBalanceRequest{
-				Address: utils.Bech32StringFromHexAddress(args[0]),
+				Address: utils.Bech32StringFromHexAddress(address),
 				Denom:   args[1],
 			})
 			if err != nil {
@@ -287,8 +306,8 @@ func GetBankBalanceCmd() *cobra.Command {
 func GetERC20BalanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "balance-erc20 [address] [erc20-address]",
-		Short:   "Get the ERC20 balance for a given 0x address and erc20 address",
-		Long:    "Get the ERC20 balance for a given 0x address and erc20 address.",
+		Short:   "Get the ERC20 balance for a given 0x or bech32 address and erc20 address",
+		Long:    "Get the ERC20 balance for a given 0x or bech32 address and erc20 address.",
 		Example: "evmd query evm balance-erc20 0xA2A8B87390F8F2D188242656BFb6852914073D06 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE",
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -297,17 +316,27 @@ func GetERC20BalanceCmd() *cobra.Command {
 				return err
 			}
 
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			erc20Hex, err := accountToHex(args[1])
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			input, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(
 				"balanceOf",
-				common.HexToAddress(args[0]),
+				common.HexToAddress(address),
 			)
 			if err != nil {
 				return err
 			}
 
-			erc20Address := common.HexToAddress(args[1])
+			erc20Address := common.HexToAddress(erc20Hex)
 
 			callData, err := json.Marshal(types.TransactionArgs{
 				To:    &erc20Address,
@@ -333,7 +362,7 @@ func GetERC20BalanceCmd() *cobra.Command {
 				return err
 			}
 
-			fmt.Printf("balance:\n  amount: %s\n  erc20_address: %s\n", balance.String(), args[1])
+			fmt.Printf("balance:\n  amount: %s\n  erc20_address: %s\n", balance.String(), erc20Hex)
 
 			return nil
 		},
@@ -342,3 +371,91 @@ func GetERC20BalanceCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCallCmd calls a contract method without sending a transaction
+func GetCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call [contract_address] [method] [args_json]",
+		Short: "Call a contract method without sending a transaction",
+		Long: `Call a contract method without sending a transaction, and print the decoded return
+values. The method arguments are given as a JSON array and encoded with the contract
+ABI file passed with '--abi'. If the height is not provided, it will use the latest
+height from context.`,
+		Example: `evmd query evm call 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE balanceOf '["0xA2A8B87390F8F2D188242656BFb6852914073D06"]' --abi erc20.json`,
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contract, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			method, input, err := packMethodCall(cmd, args[1:])
+			if err != nil {
+				return err
+			}
+
+			contractAddr := common.HexToAddress(contract)
+			callArgs := types.TransactionArgs{
+				To:    &contractAddr,
+				Input: (*hexutil.Bytes)(&input),
+			}
+
+			fromAddress, err := cmd.Flags().GetString(flagFromAddress)
+			if err != nil {
+				return err
+			}
+			if fromAddress != "" {
+				from, err := accountToHex(fromAddress)
+				if err != nil {
+					return err
+				}
+				fromAddr := common.HexToAddress(from)
+				callArgs.From = &fromAddr
+			}
+
+			callData, err := json.Marshal(callArgs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EthCall(
+				rpctypes.ContextWithHeight(clientCtx.Height),
+				&types.EthCallRequest{
+					Args:   callData,
+					GasCap: config.DefaultGasCap,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			if res.Failed() {
+				return fmt.Errorf("call reverted: %s", res.VmError)
+			}
+
+			values, err := method.Outputs.Unpack(res.Ret)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(formatABIValues(values), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", out))
+		},
+	}
+
+	cmd.Flags().String(flagABI, "", "Path to the contract ABI JSON file")
+	cmd.Flags().String(flagFromAddress, "", "Address the call is sent from")
+	_ = cmd.MarkFlagRequired(flagABI)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
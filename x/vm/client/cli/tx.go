@@ -2,16 +2,19 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/types"
 
@@ -22,7 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	types2 "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -39,6 +42,9 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		NewRawTxCmd(),
 		NewSendTxCmd(ac),
+		NewTransferTxCmd(),
+		NewCallTxCmd(),
+		NewRestoreStorageTxCmd(),
 	)
 	return txCmd
 }
@@ -59,121 +65,428 @@ func NewRawTxCmd() *cobra.Command {
 				return errors.Wrap(err, "failed to decode ethereum tx hex bytes")
 			}
 
-			// verify that the chain-id entered is a base 10 integer
-			chainIDInt, ok := new(big.Int).SetString(clientCtx.ChainID, 10)
-			if !ok {
-				return errors.Wrapf(errortypes.ErrInvalidChainID, "epoch %s must be base-10 integer format", clientCtx.ChainID)
+			// the evm chain id is decoupled from the cosmos chain id, so the
+			// sender is recovered with the chain id the tx is signed for and
+			// the chain id itself is verified by the node
+			ethTx := &ethtypes.Transaction{}
+			if err := ethTx.UnmarshalBinary(data); err != nil {
+				return errors.Wrap(err, "failed to decode ethereum tx")
 			}
 
-			msg := &types.MsgEthereumTx{}
-			if err := msg.UnmarshalBinary(data, ethtypes.LatestSignerForChainID(chainIDInt)); err != nil {
-				return err
+			return broadcastEthTx(clientCtx, ethTx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSendTxCmd returns a CLI command handler for creating a MsgSend transaction.
+func NewSendTxCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [from_key_or_address] [to_address] [amount]",
+		Short: "Send funds from one account to another.",
+		Long: `Send funds from one account to another. Both 0x and bech32 addresses
+may be used.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+When using '--dry-run' a key name cannot be used, only an 0x or bech32 address.
+`,
+		Example: "evmd tx evm send 0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E 0xA2A8B87390F8F2D188242656BFb6852914073D06 10utoken",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromAddr := args[0]
+			if strings.HasPrefix(args[0], "0x") {
+				fromAddr = utils.Bech32StringFromHexAddress(args[0])
 			}
 
-			if err := msg.ValidateBasic(); err != nil {
+			err := cmd.Flags().Set(flags.FlagFrom, fromAddr)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
 				return err
 			}
 
-			baseDenom := types.GetEVMCoinDenom()
-
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), baseDenom)
+			toAddr, err := ac.StringToBytes(utils.Bech32StringFromHexAddress(args[1]))
 			if err != nil {
 				return err
 			}
 
-			if clientCtx.GenerateOnly {
-				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
 
-				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			if len(coins) == 0 {
+				return fmt.Errorf("invalid coins")
 			}
 
-			if !clientCtx.SkipConfirm {
-				out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
+			msg := types2.NewMsgSend(clientCtx.GetFromAddress(), toAddr, coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-				_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+	flags.AddTxFlagsToCmd(cmd)
 
-				buf := bufio.NewReader(os.Stdin)
-				ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+	return cmd
+}
 
-				if err != nil || !ok {
-					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
-					return err
-				}
+// NewTransferTxCmd returns a CLI command handler for sending the EVM denom with
+// an Ethereum transaction signed by a key of the keyring.
+func NewTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [from_key_or_address] [to_address] [amount]",
+		Short: "Transfer an amount in wei with an Ethereum transaction",
+		Long: `Transfer an amount of the EVM denom, in wei, with an Ethereum transaction signed by
+the given key. Both 0x and bech32 addresses may be used.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+`,
+		Example: "evmd tx evm transfer mykey 0xA2A8B87390F8F2D188242656BFb6852914073D06 1000000000000000000",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := getEthTxClientContext(cmd, args[0])
+			if err != nil {
+				return err
 			}
 
-			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			to, err := accountToHex(args[1])
 			if err != nil {
 				return err
 			}
 
-			// broadcast to a CometBFT node
-			res, err := clientCtx.BroadcastTx(txBytes)
+			amount, ok := new(big.Int).SetString(args[2], 10)
+			if !ok || amount.Sign() < 0 {
+				return fmt.Errorf("invalid amount %s", args[2])
+			}
+
+			toAddr := common.HexToAddress(to)
+			ethTx, err := newSignedEthTx(cmd, clientCtx, &toAddr, amount, nil)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return broadcastEthTx(clientCtx, ethTx)
 		},
 	}
 
+	addEthTxFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// NewSendTxCmd returns a CLI command handler for creating a MsgSend transaction.
-func NewSendTxCmd(ac address.Codec) *cobra.Command {
+// NewCallTxCmd returns a CLI command handler for calling a contract method with
+// an Ethereum transaction signed by a key of the keyring.
+func NewCallTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "send [from_key_or_address] [to_address] [amount]",
-		Short: "Send funds from one account to another.",
-		Long: `Send funds from one account to another. Both 0x and bech32 addresses
-may be used.
+		Use:   "call [from_key_or_address] [contract_address] [method] [args_json]",
+		Short: "Call a contract method with an Ethereum transaction",
+		Long: `Call a contract method with an Ethereum transaction signed by the given key. The
+method arguments are given as a JSON array and encoded with the contract ABI file
+passed with '--abi'. Integers may be given as decimal or 0x strings, and bytes as
+0x strings.
 Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
-When using '--dry-run' a key name cannot be used, only an 0x or bech32 address.
 `,
-		Example: "evmd tx evm send 0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E 0xA2A8B87390F8F2D188242656BFb6852914073D06 10utoken",
-		Args:    cobra.ExactArgs(3),
+		Example: `evmd tx evm call mykey 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE transfer '["0xA2A8B87390F8F2D188242656BFb6852914073D06", "1000"]' --abi erc20.json`,
+		Args:    cobra.RangeArgs(3, 4),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			fromAddr := args[0]
-			if strings.HasPrefix(args[0], "0x") {
-				fromAddr = utils.Bech32StringFromHexAddress(args[0])
+			clientCtx, err := getEthTxClientContext(cmd, args[0])
+			if err != nil {
+				return err
 			}
 
-			err := cmd.Flags().Set(flags.FlagFrom, fromAddr)
+			contract, err := accountToHex(args[1])
 			if err != nil {
 				return err
 			}
-			clientCtx, err := client.GetClientTxContext(cmd)
+
+			_, input, err := packMethodCall(cmd, args[2:])
 			if err != nil {
 				return err
 			}
 
-			toAddr, err := ac.StringToBytes(utils.Bech32StringFromHexAddress(args[1]))
+			value, err := cmd.Flags().GetString(flagValue)
 			if err != nil {
 				return err
 			}
+			amount, ok := new(big.Int).SetString(value, 10)
+			if !ok || amount.Sign() < 0 {
+				return fmt.Errorf("invalid value %s", value)
+			}
 
-			coins, err := sdk.ParseCoinsNormalized(args[2])
+			contractAddr := common.HexToAddress(contract)
+			ethTx, err := newSignedEthTx(cmd, clientCtx, &contractAddr, amount, input)
 			if err != nil {
 				return err
 			}
 
-			if len(coins) == 0 {
-				return fmt.Errorf("invalid coins")
+			return broadcastEthTx(clientCtx, ethTx)
+		},
+	}
+
+	cmd.Flags().String(flagABI, "", "Path to the contract ABI JSON file")
+	cmd.Flags().String(flagValue, "0", "Amount in wei sent with the call")
+	_ = cmd.MarkFlagRequired(flagABI)
+	addEthTxFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRestoreStorageTxCmd returns a CLI command handler for creating a
+// MsgRestoreContractStorage transaction.
+func NewRestoreStorageTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore-storage [contract_address] [storage_file]",
+		Short: "Restore entries of the archived storage of a contract",
+		Long: `Restore entries of the archived storage of a contract from the JSON file of all its
+entries, e.g. [{"key":"0x...","value":"0x..."}] as in the genesis accounts. The
+entries sorted by key from --offset, up to --limit, are sent with the proofs of
+their inclusion in the root of the archived storage, so that a large storage is
+restored over several transactions. The storage stays archived until all its
+entries are restored.
+`,
+		Example: "evmd tx evm restore-storage 0xA2A8B87390F8F2D188242656BFb6852914073D06 storage.json --offset 500 --limit 500 --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			msg := types2.NewMsgSend(clientCtx.GetFromAddress(), toAddr, coins)
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return errors.Wrap(err, "failed to read the storage file")
+			}
+			var storage types.Storage
+			if err := json.Unmarshal(bz, &storage); err != nil {
+				return errors.Wrap(err, "failed to decode the storage file")
+			}
+			if err := storage.Validate(); err != nil {
+				return err
+			}
+
+			offset, err := cmd.Flags().GetUint64(flagOffset)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+			proofs := storage.Proofs()
+			if offset >= uint64(len(proofs)) {
+				return fmt.Errorf("offset %d out of %d entries", offset, len(proofs))
+			}
+			proofs = proofs[offset:]
+			if limit != 0 && limit < uint64(len(proofs)) {
+				proofs = proofs[:limit]
+			}
+
+			msg := &types.MsgRestoreContractStorage{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Address: args[0],
+				Entries: proofs,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Uint64(flagOffset, 0, "Index of the first restored entry in the entries sorted by key")
+	cmd.Flags().Uint64(flagLimit, 0, "Maximum number of restored entries, all the remaining entries if 0")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// getEthTxClientContext returns the client context of a command signing an
+// Ethereum transaction with the given key name or address.
+func getEthTxClientContext(cmd *cobra.Command, from string) (client.Context, error) {
+	if strings.HasPrefix(from, "0x") {
+		from = utils.Bech32StringFromHexAddress(from)
+	}
+
+	if err := cmd.Flags().Set(flags.FlagFrom, from); err != nil {
+		return client.Context{}, err
+	}
+	return client.GetClientTxContext(cmd)
+}
+
+// addEthTxFlags adds the fee flags of the Ethereum transactions.
+func addEthTxFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagGasLimit, 0, "Gas limit of the transaction, estimated if 0")
+	cmd.Flags().String(flagGasPrice, "", "Gas price in wei of a legacy transaction, a dynamic fee transaction is sent if empty")
+	cmd.Flags().String(flagPriorityFee, "0", "Max priority fee per gas in wei of a dynamic fee transaction")
+}
+
+// newSignedEthTx builds an Ethereum transaction from the sender of the client
+// context, with the nonce, fees and gas limit queried from the node unless they
+// are given with the flags, and signs it with the keyring.
+func newSignedEthTx(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	to *common.Address,
+	value *big.Int,
+	input []byte,
+) (*ethtypes.Transaction, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	from := common.BytesToAddress(clientCtx.GetFromAddress())
+
+	configRes, err := queryClient.Config(cmd.Context(), &types.QueryConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+	chainID := new(big.Int).SetUint64(configRes.Config.ChainId)
+
+	accountRes, err := queryClient.Account(cmd.Context(), &types.QueryAccountRequest{Address: from.Hex()})
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	if gasLimit == 0 {
+		callArgs, err := json.Marshal(types.TransactionArgs{
+			From:  &from,
+			To:    to,
+			Value: (*hexutil.Big)(value),
+			Input: (*hexutil.Bytes)(&input),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := queryClient.EstimateGas(cmd.Context(), &types.EthCallRequest{
+			Args:   callArgs,
+			GasCap: config.DefaultGasCap,
+		})
+		if err != nil {
+			return nil, err
+		}
+		gasLimit = res.Gas
+	}
+
+	var txData ethtypes.TxData
+	gasPrice, err := cmd.Flags().GetString(flagGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	if gasPrice != "" {
+		price, ok := new(big.Int).SetString(gasPrice, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid gas price %s", gasPrice)
+		}
+		txData = &ethtypes.LegacyTx{
+			Nonce:    accountRes.Nonce,
+			GasPrice: price,
+			Gas:      gasLimit,
+			To:       to,
+			Value:    value,
+			Data:     input,
+		}
+	} else {
+		priorityFee, err := cmd.Flags().GetString(flagPriorityFee)
+		if err != nil {
+			return nil, err
+		}
+		tip, ok := new(big.Int).SetString(priorityFee, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid priority fee %s", priorityFee)
+		}
+
+		baseFeeRes, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+		if err != nil {
+			return nil, err
+		}
+		if baseFeeRes.BaseFee == nil {
+			return nil, fmt.Errorf("the base fee is disabled, a legacy transaction must be sent with --%s", flagGasPrice)
+		}
+
+		// the fee cap covers a doubling of the base fee before the tx is included
+		feeCap := new(big.Int).Mul(baseFeeRes.BaseFee.BigInt(), big.NewInt(2))
+		txData = &ethtypes.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     accountRes.Nonce,
+			GasTipCap: tip,
+			GasFeeCap: feeCap.Add(feeCap, tip),
+			Gas:       gasLimit,
+			To:        to,
+			Value:     value,
+			Data:      input,
+		}
+	}
+
+	ethTx := ethtypes.NewTx(txData)
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	sig, _, err := clientCtx.Keyring.SignByAddress(clientCtx.GetFromAddress(), signer.Hash(ethTx).Bytes(), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethTx.WithSignature(signer, sig)
+}
+
+// broadcastEthTx wraps the signed Ethereum transaction in a cosmos transaction,
+// and prints or broadcasts it.
+func broadcastEthTx(clientCtx client.Context, ethTx *ethtypes.Transaction) error {
+	msg := &types.MsgEthereumTx{}
+	if err := msg.FromSignedEthereumTx(ethTx, types.TxSigner(ethTx)); err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	baseDenom := types.GetEVMCoinDenom()
+
+	tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+	}
+
+	if !clientCtx.SkipConfirm {
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+
+		buf := bufio.NewReader(os.Stdin)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
+			return err
+		}
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	// broadcast to a CometBFT node
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
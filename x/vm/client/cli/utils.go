@@ -1,15 +1,59 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"os"
+	"reflect"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
 
+	"github.com/cosmos/evm/utils"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	flagABI         = "abi"
+	flagValue       = "value"
+	flagGasLimit    = "gas-limit"
+	flagGasPrice    = "gas-price"
+	flagPriorityFee = "priority-fee"
+	flagFromAddress = "from-address"
+	flagOffset      = "offset"
+	flagLimit       = "limit"
+)
+
+// addressOutput is the address of a query output in both its hex and bech32 forms.
+type addressOutput struct {
+	Address       string `json:"address"`
+	Bech32Address string `json:"bech32_address"`
+}
+
+func newAddressOutput(hexAddr string) addressOutput {
+	return addressOutput{
+		Address:       hexAddr,
+		Bech32Address: utils.Bech32StringFromHexAddress(hexAddr),
+	}
+}
+
+// printJSON prints the JSON encoding of the output in the output format of the
+// client context.
+func printJSON(clientCtx client.Context, out interface{}) error {
+	bz, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintRaw(bz)
+}
+
 func accountToHex(addr string) (string, error) {
 	if strings.HasPrefix(addr, sdk.GetConfig().GetBech32AccountAddrPrefix()) {
 		// Check to see if address is Cosmos bech32 formatted
@@ -44,3 +88,214 @@ func formatKeyToHash(key string) string {
 
 	return ethkey.Hex()
 }
+
+// packMethodCall encodes the call of the method, given with its optional JSON
+// array of arguments, with the ABI file of the command flag.
+func packMethodCall(cmd *cobra.Command, args []string) (abi.Method, []byte, error) {
+	abiPath, err := cmd.Flags().GetString(flagABI)
+	if err != nil {
+		return abi.Method{}, nil, err
+	}
+
+	contractABI, err := loadABI(abiPath)
+	if err != nil {
+		return abi.Method{}, nil, err
+	}
+
+	method, ok := contractABI.Methods[args[0]]
+	if !ok {
+		return abi.Method{}, nil, fmt.Errorf("method %s not found in the ABI", args[0])
+	}
+
+	argsJSON := "[]"
+	if len(args) > 1 {
+		argsJSON = args[1]
+	}
+
+	values, err := parseABIArgs(method.Inputs, argsJSON)
+	if err != nil {
+		return abi.Method{}, nil, err
+	}
+
+	input, err := contractABI.Pack(method.Name, values...)
+	if err != nil {
+		return abi.Method{}, nil, err
+	}
+	return method, input, nil
+}
+
+// loadABI reads a contract ABI from a JSON file, either holding the ABI itself
+// or a compiled contract with an "abi" field.
+func loadABI(path string) (abi.ABI, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+
+	var artifact struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+	if err := json.Unmarshal(bz, &artifact); err == nil && len(artifact.ABI) > 0 {
+		bz = artifact.ABI
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(string(bz)))
+	if err != nil {
+		return abi.ABI{}, errors.Wrap(err, "invalid contract ABI")
+	}
+	return contractABI, nil
+}
+
+// parseABIArgs converts the JSON array of arguments to the Go values of the
+// ABI arguments types.
+func parseABIArgs(inputs abi.Arguments, argsJSON string) ([]interface{}, error) {
+	var rawArgs []json.RawMessage
+	if err := json.Unmarshal([]byte(argsJSON), &rawArgs); err != nil {
+		return nil, errors.Wrap(err, "arguments must be a JSON array")
+	}
+
+	if len(rawArgs) != len(inputs) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(inputs), len(rawArgs))
+	}
+
+	values := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		value, err := parseABIArg(input.Type, rawArgs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid argument %s", input.Name)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// parseABIArg converts a JSON argument to the Go value of the ABI type.
+func parseABIArg(t abi.Type, raw json.RawMessage) (interface{}, error) {
+	switch t.T {
+	case abi.AddressTy:
+		var addr string
+		if err := json.Unmarshal(raw, &addr); err != nil {
+			return nil, err
+		}
+		hexAddr, err := accountToHex(addr)
+		if err != nil {
+			return nil, err
+		}
+		return common.HexToAddress(hexAddr), nil
+
+	case abi.IntTy, abi.UintTy:
+		// integers are given either as JSON numbers or as decimal or 0x strings
+		str := strings.Trim(string(raw), `"`)
+		n, ok := new(big.Int).SetString(str, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", str)
+		}
+		if !fitsABIInt(t, n) {
+			return nil, fmt.Errorf("integer %s overflows %s", str, t)
+		}
+		if t.Size > 64 {
+			return n, nil
+		}
+		if t.T == abi.UintTy {
+			return reflect.ValueOf(n.Uint64()).Convert(t.GetType()).Interface(), nil
+		}
+		return reflect.ValueOf(n.Int64()).Convert(t.GetType()).Interface(), nil
+
+	case abi.BoolTy:
+		var b bool
+		err := json.Unmarshal(raw, &b)
+		return b, err
+
+	case abi.StringTy:
+		var str string
+		err := json.Unmarshal(raw, &str)
+		return str, err
+
+	case abi.BytesTy:
+		var bz hexutil.Bytes
+		err := json.Unmarshal(raw, &bz)
+		return []byte(bz), err
+
+	case abi.FixedBytesTy:
+		var bz hexutil.Bytes
+		if err := json.Unmarshal(raw, &bz); err != nil {
+			return nil, err
+		}
+		if len(bz) != t.Size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", t.Size, len(bz))
+		}
+		value := reflect.New(t.GetType()).Elem()
+		reflect.Copy(value, reflect.ValueOf([]byte(bz)))
+		return value.Interface(), nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return nil, err
+		}
+
+		var value reflect.Value
+		if t.T == abi.SliceTy {
+			value = reflect.MakeSlice(t.GetType(), len(elems), len(elems))
+		} else {
+			if len(elems) != t.Size {
+				return nil, fmt.Errorf("expected %d elements, got %d", t.Size, len(elems))
+			}
+			value = reflect.New(t.GetType()).Elem()
+		}
+
+		for i, elem := range elems {
+			elemValue, err := parseABIArg(*t.Elem, elem)
+			if err != nil {
+				return nil, err
+			}
+			value.Index(i).Set(reflect.ValueOf(elemValue))
+		}
+		return value.Interface(), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported argument type %s", t)
+	}
+}
+
+// fitsABIInt returns whether the integer is in the range of the ABI integer type.
+func fitsABIInt(t abi.Type, n *big.Int) bool {
+	if t.T == abi.UintTy {
+		return n.Sign() >= 0 && n.BitLen() <= t.Size
+	}
+	// the signed integers range from -2^(size-1) to 2^(size-1)-1
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1)) //nolint:gosec // G115
+	return n.Cmp(limit) < 0 && n.Cmp(limit.Neg(limit)) >= 0
+}
+
+// formatABIValues converts the bytes of the decoded ABI values to hex strings, so
+// that they're printed as in the Ethereum JSON-RPC.
+func formatABIValues(values []interface{}) []interface{} {
+	formatted := make([]interface{}, len(values))
+	for i, value := range values {
+		formatted[i] = formatABIValue(reflect.ValueOf(value))
+	}
+	return formatted
+}
+
+func formatABIValue(value reflect.Value) interface{} {
+	if value.Type() == reflect.TypeOf(common.Address{}) {
+		return value.Interface()
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(bz), value)
+			return hexutil.Bytes(bz)
+		}
+		elems := make([]interface{}, value.Len())
+		for i := range elems {
+			elems[i] = formatABIValue(value.Index(i))
+		}
+		return elems
+	default:
+		return value.Interface()
+	}
+}
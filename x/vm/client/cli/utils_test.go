@@ -1,9 +1,11 @@
 package cli
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -98,3 +100,31 @@ func TestAddressToCosmosAddress(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, baseAddr, ethFormatted)
 }
+
+func TestParseABIArgs(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"f","inputs":[
+		{"name":"to","type":"address"},{"name":"amount","type":"uint256"},{"name":"small","type":"uint8"},
+		{"name":"ok","type":"bool"},{"name":"data","type":"bytes"},{"name":"id","type":"bytes4"},
+		{"name":"list","type":"int64[]"},{"name":"name","type":"string"}]}]`))
+	require.NoError(t, err)
+	inputs := contractABI.Methods["f"].Inputs
+
+	to := common.HexToAddress("0x6A98D72760f7bbA69d62Ed6F48278451251948E7")
+	values, err := parseABIArgs(inputs, `["`+to.Hex()+`", "0x10", 7, true, "0x0102", "0xdeadbeef", [1, "-2"], "name"]`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		to, big.NewInt(16), uint8(7), true, []byte{1, 2}, [4]byte{0xde, 0xad, 0xbe, 0xef}, []int64{1, -2}, "name",
+	}, values)
+
+	_, err = contractABI.Pack("f", values...)
+	require.NoError(t, err)
+
+	_, err = parseABIArgs(inputs, `["`+to.Hex()+`"]`)
+	require.Error(t, err, "missing arguments")
+
+	_, err = parseABIArgs(inputs, `["`+to.Hex()+`", "0x10", 256, true, "0x0102", "0xdeadbeef", [], ""]`)
+	require.Error(t, err, "uint8 overflow")
+
+	_, err = parseABIArgs(inputs, `["`+to.Hex()+`", "0x10", 7, true, "0x0102", "0xdead", [], ""]`)
+	require.Error(t, err, "fixed bytes length")
+}
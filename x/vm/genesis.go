@@ -55,6 +55,14 @@ func InitGenesis(
 		for _, storage := range account.Storage {
 			k.SetState(ctx, address, common.HexToHash(storage.Key), common.HexToHash(storage.Value).Bytes())
 		}
+
+		if account.ArchivedStorageRoot != "" {
+			k.SetArchivedStorage(ctx, address, types.ArchivedStorage{
+				Root:     common.HexToHash(account.ArchivedStorageRoot),
+				Entries:  account.ArchivedStorageEntries,
+				Restored: uint64(len(account.Storage)),
+			})
+		}
 	}
 
 	if err := k.AddPreinstalls(ctx, data.Preinstalls); err != nil {
@@ -75,6 +83,10 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 			Code:    common.Bytes2Hex(k.GetCode(ctx, codeHash)),
 			Storage: storage,
 		}
+		if archived, ok := k.GetArchivedStorage(ctx, address); ok {
+			genAccount.ArchivedStorageRoot = archived.Root.Hex()
+			genAccount.ArchivedStorageEntries = archived.Entries
+		}
 
 		ethGenAccounts = append(ethGenAccounts, genAccount)
 		return false
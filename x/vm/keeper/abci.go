@@ -10,10 +10,36 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// BeginBlock loads the params, chain rules and base fee cached for the block,
+// stores the block hash for the BLOCKHASH opcode and the EIP-2935 history
+// contract and emits a base fee event which will be adjusted to the evm decimals
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	logger := ctx.Logger().With("begin_block", "evm")
 
+	// the forks activated by the upgrades of the block, which run before, apply
+	// to its txs
+	if err := k.LoadForkActivations(ctx); err != nil {
+		return err
+	}
+
+	// the values cached for the block are loaded here, before the txs
+	k.paramsCache.Reset(ctx)
+	k.rulesCache.Reset(ctx)
+	k.GetBlockParams(ctx)
+	k.GetBlockRules(ctx)
+
+	// a node restored from a state-sync snapshot isn't loaded with its state, it
+	// checks the pinned evm chain id when it executes its first block
+	if err := validateEVMChainID(k.GetBlockParams(ctx).EVMChainID); err != nil {
+		return err
+	}
+	k.GetBlockBaseFee(ctx)
+
+	k.StoreBlockHash(ctx)
+	if err := k.ProcessParentBlockHash(ctx); err != nil {
+		return err
+	}
+
 	// Base fee is already set on FeeMarket BeginBlock
 	// that runs before this one
 	// We emit this event on the EVM and FeeMarket modules
@@ -35,14 +61,26 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// KVStore, reconciles the gas used by the eth txs with the block gas meter and sweeps a batch of
+// the accounts for the empty ones. The EVM end block logic doesn't update the validator set, thus
+// it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
+	// the optimistic executions of the txs which weren't executed are discarded
+	k.discardParallelExecutions()
+
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	k.reconcileBlockGas(infCtx)
+
+	if batch := k.GetBlockParams(ctx).EmptyAccountSweepBatch; batch > 0 {
+		if removed := k.SweepEmptyAccounts(infCtx, batch); len(removed) > 0 {
+			k.Logger(ctx).Debug("removed empty accounts", "count", len(removed))
+		}
+	}
+
 	return nil
 }
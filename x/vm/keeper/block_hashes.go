@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// blockHashBufferLength is the length of the ring buffer of the recent block
+// hashes, i.e. the number of block hashes served by BLOCKHASH.
+const blockHashBufferLength = 256
+
+// historyBufferLength is the length of the ring buffer of the EIP-2935 history
+// contract, i.e. the number of block hashes it serves.
+const historyBufferLength = params.HistoryServeWindow - 1
+
+// StoreBlockHash stores the CometBFT hash of the current block in the ring
+// buffer of the recent block hashes, since the block headers passed to the app
+// don't include the hashes of the previous blocks.
+func (k *Keeper) StoreBlockHash(ctx sdk.Context) {
+	hash := ctx.HeaderHash()
+	if len(hash) == 0 {
+		return
+	}
+
+	height := uint64(ctx.BlockHeight()) //#nosec G115 -- block height is never negative
+	bz := make([]byte, 8, 8+len(hash))
+	binary.BigEndian.PutUint64(bz, height)
+	ctx.KVStore(k.storeKey).Set(types.BlockHashKey(height%blockHashBufferLength), append(bz, hash...))
+}
+
+// GetBlockHash returns the CometBFT hash of the given block from the ring
+// buffer of the recent block hashes, or nil if it isn't in the buffer.
+func (k *Keeper) GetBlockHash(ctx sdk.Context, height uint64) []byte {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockHashKey(height % blockHashBufferLength))
+	if len(bz) <= 8 || binary.BigEndian.Uint64(bz) != height {
+		return nil
+	}
+	return bz[8:]
+}
+
+// ProcessParentBlockHash stores the hash of the parent block in the storage of
+// the EIP-2935 history contract once Prague is active, deploying the contract
+// at its canonical address first if needed.
+func (k *Keeper) ProcessParentBlockHash(ctx sdk.Context) error {
+	height := ctx.BlockHeight()
+	if height <= 1 {
+		return nil
+	}
+
+	parentHeight := uint64(height - 1) //#nosec G115 -- the parent height is positive
+	parentHash := k.GetBlockHash(ctx, parentHeight)
+	timestamp := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- block time is never negative
+	if len(parentHash) == 0 || !types.GetEthChainConfig().IsPrague(big.NewInt(height), timestamp) {
+		return nil
+	}
+
+	if err := k.deployHistoryStorage(ctx); err != nil {
+		return err
+	}
+
+	k.SetState(ctx, params.HistoryStorageAddress, historyStorageSlot(parentHeight), parentHash)
+	return nil
+}
+
+// getHistoricalBlockHash returns the hash of the given block from the storage
+// of the EIP-2935 history contract, or an empty hash if it isn't served.
+func (k *Keeper) getHistoricalBlockHash(ctx sdk.Context, height uint64) common.Hash {
+	current := uint64(ctx.BlockHeight()) //#nosec G115 -- block height is never negative
+	if height >= current || current-height > historyBufferLength {
+		return common.Hash{}
+	}
+	return k.GetState(ctx, params.HistoryStorageAddress, historyStorageSlot(height))
+}
+
+// deployHistoryStorage sets the code of the EIP-2935 history contract at its
+// canonical address if it isn't set yet.
+func (k *Keeper) deployHistoryStorage(ctx sdk.Context) error {
+	codeHash := crypto.Keccak256(params.HistoryStorageCode)
+	if bytes.Equal(k.GetCodeHash(ctx, params.HistoryStorageAddress).Bytes(), codeHash) {
+		return nil
+	}
+
+	account := k.GetAccountOrEmpty(ctx, params.HistoryStorageAddress)
+	account.CodeHash = codeHash
+	if account.Nonce == 0 {
+		account.Nonce = 1
+	}
+	if err := k.SetAccount(ctx, params.HistoryStorageAddress, account); err != nil {
+		return err
+	}
+	k.SetCode(ctx, codeHash, params.HistoryStorageCode)
+
+	k.Logger(ctx).Info("deployed the eip-2935 history contract", "address", params.HistoryStorageAddress.Hex())
+	return nil
+}
+
+// historyStorageSlot returns the slot of the history contract storing the hash
+// of the given block.
+func historyStorageSlot(height uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(height % historyBufferLength))
+}
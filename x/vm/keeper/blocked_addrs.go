@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// GetBlockedAddrsCallHook returns a closure that fails the calls to the blocked
+// module accounts. The x/bank keeper refuses to credit them, so the value sent
+// by the call would be stuck in the module account. The hook has no access to
+// the call value, the module accounts holding no code, calling them is only
+// useful to transfer value.
+func (k *Keeper) GetBlockedAddrsCallHook() types.CallHook {
+	return func(_ *vm.EVM, _ common.Address, recipient common.Address) error {
+		if k.blockedAddrs[recipient] {
+			return errorsmod.Wrapf(types.ErrBlockedAddress, "cannot call %s", recipient.Hex())
+		}
+		return nil
+	}
+}
+
+// blockedCreditsErr returns the error of the SELFDESTRUCT that credited a blocked
+// module account during the execution, if any. Unlike the calls, the SELFDESTRUCT
+// beneficiary credit can't be refused by the EVM, so the message fails instead.
+func blockedCreditsErr(credits []common.Address) error {
+	if len(credits) == 0 {
+		return nil
+	}
+	return errorsmod.Wrapf(types.ErrBlockedAddress, "cannot selfdestruct to %s", credits[0].Hex())
+}
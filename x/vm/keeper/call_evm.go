@@ -1,14 +1,17 @@
 package keeper
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"math/big"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/x/vm/types"
@@ -104,5 +107,39 @@ func (k Keeper) CallEVMWithData(
 		return res, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
 	}
 
+	if commit {
+		if err := emitInternalTxEvent(ctx, msg, res); err != nil {
+			return nil, err
+		}
+	}
+
 	return res, nil
 }
+
+// emitInternalTxEvent emits the sender, the recipient, the gas used and the logs
+// of an evm call committed outside of an eth tx, so that they can be served to
+// the evm clients along the eth txs.
+func emitInternalTxEvent(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) error {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySender, msg.From.Hex()),
+		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+	}
+	if msg.To != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, msg.To.Hex()))
+	} else {
+		contract := crypto.CreateAddress(msg.From, msg.Nonce)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyContractAddress, contract.Hex()))
+	}
+	if len(res.Logs) > 0 {
+		// the internal txs have no msg response to return their logs in
+		logs := types.TransactionLogs{Logs: res.Logs}
+		bz, err := logs.Marshal()
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to marshal logs")
+		}
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTxLogs, base64.StdEncoding.EncodeToString(bz)))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeInternalTx, attrs...))
+	return nil
+}
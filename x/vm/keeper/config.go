@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
@@ -16,15 +19,28 @@ import (
 
 // EVMConfig creates the EVMConfig based on current state
 func (k *Keeper) EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress) (*statedb.EVMConfig, error) {
-	params := k.GetParams(ctx)
+	return k.newEVMConfig(ctx, proposerAddress, k.GetParams(ctx), k.GetBaseFee)
+}
+
+// blockEVMConfig creates the EVMConfig like EVMConfig, from the params and the
+// base fee cached once per block. The params of the config must not be modified.
+func (k *Keeper) blockEVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress) (*statedb.EVMConfig, error) {
+	return k.newEVMConfig(ctx, proposerAddress, k.GetBlockParams(ctx), k.GetBlockBaseFee)
+}
 
+func (k *Keeper) newEVMConfig(
+	ctx sdk.Context,
+	proposerAddress sdk.ConsAddress,
+	params types.Params,
+	getBaseFee func(sdk.Context) *big.Int,
+) (*statedb.EVMConfig, error) {
 	// get the coinbase address from the block proposer
 	coinbase, err := k.GetCoinbaseAddress(ctx, proposerAddress)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to obtain coinbase address")
 	}
 
-	baseFee := k.GetBaseFee(ctx)
+	baseFee := getBaseFee(ctx)
 	return &statedb.EVMConfig{
 		Params:   params,
 		CoinBase: coinbase,
@@ -32,6 +48,35 @@ func (k *Keeper) EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress) (*s
 	}, nil
 }
 
+// blockRules are the chain rules of a block, with the chain config and the
+// block time they are derived from.
+type blockRules struct {
+	chainConfig *types.ChainConfig
+	time        uint64
+	rules       params.Rules
+}
+
+func newBlockRules(ctx sdk.Context) blockRules {
+	blockTime := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+	return blockRules{
+		chainConfig: types.GetChainConfig(),
+		time:        blockTime,
+		rules:       types.GetEthChainConfig().Rules(big.NewInt(ctx.BlockHeight()), true, blockTime),
+	}
+}
+
+// GetBlockRules returns the chain rules of the block of the context, which are
+// derived from the chain config once per block.
+func (k Keeper) GetBlockRules(ctx sdk.Context) params.Rules {
+	cached := k.rulesCache.Get(ctx, newBlockRules)
+	// the chain config is replaced when a fork is activated, and the block time
+	// of a context can be overridden, e.g. by the tests
+	if cached.chainConfig != types.GetChainConfig() || cached.time != uint64(ctx.BlockTime().Unix()) { //#nosec G115 -- int overflow is not a concern here
+		return newBlockRules(ctx).rules
+	}
+	return cached.rules
+}
+
 // TxConfig loads `TxConfig` from current transient storage
 func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig {
 	return statedb.NewTxConfig(
@@ -43,7 +88,7 @@ func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig
 }
 
 // VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
-// module parameters. The config generated uses the default JumpTable from the EVM.
+// module parameters at the block height. The config generated uses the default JumpTable from the EVM.
 func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig, tracer *tracing.Hooks) vm.Config {
 	noBaseFee := true
 	if types.IsLondon(types.GetEthChainConfig(), ctx.BlockHeight()) {
@@ -54,6 +99,6 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 		EnablePreimageRecording: cfg.EnablePreimageRecording,
 		Tracer:                  tracer,
 		NoBaseFee:               noBaseFee,
-		ExtraEips:               cfg.Params.EIPs(),
+		ExtraEips:               cfg.Params.EIPsAt(ctx.BlockHeight()),
 	}
 }
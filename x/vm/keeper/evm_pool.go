@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// evmPool reuses the EVM instances, along with their interpreter and the
+// JUMPDEST analysis of the executed contracts, across the txs of a block.
+var evmPool sync.Pool
+
+// pooledEVM is an EVM instance with the values it was created with that can't
+// be changed once the instance is created.
+type pooledEVM struct {
+	evm   *vm.EVM
+	hooks *types.DefaultOpCodesHooks
+	// precompiles are the default precompiles of the chain rules, the precompile
+	// call hook replaces them when calling a stateful precompile
+	precompiles vm.PrecompiledContracts
+
+	chainConfig *types.ChainConfig
+	blockNumber int64
+	blockTime   uint64
+	extraEips   []int
+}
+
+// acquireEVM returns an EVM like the one returned by NewEVM, reusing a pooled
+// instance created for the same block if possible. The returned function puts
+// the EVM back in the pool, it must be called once the execution is over.
+func (k *Keeper) acquireEVM(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *statedb.EVMConfig,
+	tracer *tracing.Hooks,
+	stateDB vm.StateDB,
+) (*vm.EVM, func()) {
+	blockCtx := k.newBlockContext(ctx, cfg)
+	txCtx := core.NewEVMTxContext(&msg)
+	vmConfig := k.newVMConfig(ctx, msg, cfg, tracer)
+	chainConfig := types.GetChainConfig()
+
+	p, ok := evmPool.Get().(*pooledEVM)
+	if ok && p.chainConfig == chainConfig && p.blockNumber == blockCtx.BlockNumber.Int64() &&
+		p.blockTime == blockCtx.Time && slices.Equal(p.extraEips, vmConfig.ExtraEips) {
+		k.addOpCodeHooks(ctx, msg, cfg, p.hooks)
+		p.evm.Context = blockCtx
+		p.evm.StateDB = stateDB
+		p.evm.Config = vmConfig
+		p.evm.SetTxContext(txCtx)
+		p.evm.WithPrecompiles(p.precompiles)
+	} else {
+		ethCfg := types.GetEthChainConfig()
+		p = &pooledEVM{
+			hooks:       &types.DefaultOpCodesHooks{},
+			chainConfig: chainConfig,
+			blockNumber: blockCtx.BlockNumber.Int64(),
+			blockTime:   blockCtx.Time,
+			extraEips:   slices.Clone(vmConfig.ExtraEips),
+		}
+		k.addOpCodeHooks(ctx, msg, cfg, p.hooks)
+		rules := ethCfg.Rules(blockCtx.BlockNumber, blockCtx.Random != nil, blockCtx.Time)
+		p.precompiles = vm.ActivePrecompiledContracts(rules)
+		p.evm = vm.NewEVMWithHooks(p.hooks, blockCtx, txCtx, stateDB, ethCfg, vmConfig)
+		p.evm.WithPrecompiles(p.precompiles)
+	}
+
+	return p.evm, func() {
+		// a cancelled EVM can't be reset
+		if p.evm.Cancelled() {
+			return
+		}
+		// drop the references to the tx and its context
+		p.hooks.Reset()
+		p.evm.Context = vm.BlockContext{}
+		p.evm.StateDB = nil
+		p.evm.Config.Tracer = nil
+		p.evm.TxContext = vm.TxContext{}
+		evmPool.Put(p)
+	}
+}
+
+// interruptOnDone cancels the EVM once the go context of ctx is done, e.g. on
+// the deadline of a query. The executions of the finalized blocks are never
+// interrupted. The returned function stops the interruption, it must be called
+// before the EVM is released, as a cancelled EVM can't be pooled.
+func interruptOnDone(ctx sdk.Context, evm *vm.EVM) func() {
+	done := ctx.Context().Done()
+	if done == nil || ctx.ExecMode() == sdk.ExecModeFinalize {
+		return func() {}
+	}
+
+	stop, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-done:
+			evm.Cancel()
+		case <-stop:
+		}
+	}()
+	return func() {
+		close(stop)
+		<-stopped
+	}
+}
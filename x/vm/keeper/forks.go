@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// ActivateFork stores the activation of the given fork from the current block.
+// The EVM chain config is derived from the stored activations at the beginning
+// of each block, so the fork applies to the txs of the upgrade block, and
+// is discarded with the store writes if the upgrade fails.
+func (k Keeper) ActivateFork(ctx sdk.Context, fork string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixForkActivation)
+	if store.Has([]byte(fork)) {
+		return errorsmod.Wrapf(types.ErrInvalidChainConfig, "fork %s is already activated", fork)
+	}
+
+	height, timestamp := ctx.BlockHeight(), uint64(ctx.BlockTime().Unix()) //#nosec G115 -- block time is never negative
+	if _, err := types.GetChainConfig().WithForkActivated(fork, height, timestamp); err != nil {
+		return err
+	}
+
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(height)) //#nosec G115 -- block height is never negative
+	binary.BigEndian.PutUint64(bz[8:], timestamp)
+	store.Set([]byte(fork), bz)
+
+	k.Logger(ctx).Info("activated evm fork", "fork", fork, "height", height, "time", timestamp)
+	return nil
+}
+
+// GetForkActivations returns the fork activations stored by the upgrades, in
+// the order of their activation.
+func (k Keeper) GetForkActivations(ctx sdk.Context) []types.ForkActivation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixForkActivation)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var activations []types.ForkActivation
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		activations = append(activations, types.ForkActivation{
+			Fork:      string(iterator.Key()),
+			Height:    int64(binary.BigEndian.Uint64(bz)), //#nosec G115 -- stored from a block height
+			Timestamp: binary.BigEndian.Uint64(bz[8:]),
+		})
+	}
+	sort.SliceStable(activations, func(i, j int) bool {
+		if activations[i].Height != activations[j].Height {
+			return activations[i].Height < activations[j].Height
+		}
+		return activations[i].Timestamp < activations[j].Timestamp
+	})
+	return activations
+}
+
+// LoadForkActivations derives the EVM chain config from the fork activations
+// stored by the upgrades. It's called when the app is loaded, at the beginning
+// of each block and after each commit, so that the config follows the store,
+// including after a state-sync restore.
+func (k Keeper) LoadForkActivations(ctx sdk.Context) error {
+	return types.SetForkActivations(k.GetForkActivations(ctx))
+}
+
+// ForkUpgradeHandler wraps the given upgrade handler so that the EVM fork named
+// after the upgrade plan (e.g. "prague") is activated at the upgrade height.
+func (k Keeper) ForkUpgradeHandler(handler upgradetypes.UpgradeHandler) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if err := k.ActivateFork(sdk.UnwrapSDKContext(ctx), plan.Name); err != nil {
+			return nil, err
+		}
+		return handler(ctx, plan, fromVM)
+	}
+}
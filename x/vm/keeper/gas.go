@@ -44,8 +44,13 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+		// refund to the fee payer, which is the sender unless the fees were sponsored,
+		// from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		feePayer, found := k.GetTransientFeePayer(ctx)
+		if !found {
+			feePayer = msg.From
+		}
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, feePayer.Bytes(), refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -65,6 +70,27 @@ func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
 	ctx.GasMeter().ConsumeGas(gasUsed, "apply evm transaction")
 }
 
+// CheckBlockGasLimit fails the tx with ErrBlockGasLimitExceeded if its gas
+// doesn't fit in the gas left in the block. The gas of the tx is then limited
+// to the gas left in the block, so that the tx fails with the registered error
+// instead of the out of gas panic of the block gas meter when the SDK consumes
+// the gas of the tx.
+func (k *Keeper) CheckBlockGasLimit(ctx sdk.Context) error {
+	blockGasMeter := ctx.BlockGasMeter()
+	if blockGasMeter == nil {
+		return nil
+	}
+
+	gasUsed := ctx.GasMeter().GasConsumedToLimit()
+	gasLeft := blockGasMeter.Limit() - blockGasMeter.GasConsumedToLimit()
+	if gasUsed <= gasLeft {
+		return nil
+	}
+
+	k.ResetGasMeterAndConsumeGas(ctx, gasLeft)
+	return errorsmod.Wrapf(types.ErrBlockGasLimitExceeded, "gas used %d, block gas left %d", gasUsed, gasLeft)
+}
+
 // GasToRefund calculates the amount of gas the state machine should refund to the sender. It is
 // capped by the refund quotient value.
 // Note: do not pass 0 to refundQuotient
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
@@ -22,8 +23,10 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/utils"
 	evmante "github.com/cosmos/evm/x/vm/ante"
 	"github.com/cosmos/evm/x/vm/statedb"
+	_ "github.com/cosmos/evm/x/vm/tracers" // register the cosmos evm tracers, e.g. opcodeProfiler
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -33,8 +36,23 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
+// queryContext returns the sdk context of a query whose go context is the one
+// of the gRPC request, so that the EVM executions of the query are interrupted
+// once the request is canceled or its deadline is exceeded.
+func queryContext(c context.Context) sdk.Context {
+	return sdk.UnwrapSDKContext(c).WithContext(c)
+}
+
 const (
 	defaultTraceTimeout = 5 * time.Second
+
+	// maxStorageRangeResults is the maximum number of storage entries returned
+	// by a StorageRange query, the rest can be queried from the next key
+	maxStorageRangeResults = 1024
+
+	// maxStorageSlots is the maximum number of storage slots read by a single
+	// StorageSlots query
+	maxStorageSlots = 1024
 )
 
 // Account implements the Query/Account gRPC method. The method returns the
@@ -44,14 +62,13 @@ func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*typ
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+	addr, err := utils.ParseAddress(req.Address)
+	if err != nil {
 		return nil, status.Error(
 			codes.InvalidArgument, err.Error(),
 		)
 	}
 
-	addr := common.HexToAddress(req.Address)
-
 	ctx := sdk.UnwrapSDKContext(c)
 	acct := k.GetAccountOrEmpty(ctx, addr)
 
@@ -67,7 +84,8 @@ func (k Keeper) CosmosAccount(c context.Context, req *types.QueryCosmosAccountRe
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+	ethAddr, err := utils.ParseAddress(req.Address)
+	if err != nil {
 		return nil, status.Error(
 			codes.InvalidArgument, err.Error(),
 		)
@@ -75,7 +93,6 @@ func (k Keeper) CosmosAccount(c context.Context, req *types.QueryCosmosAccountRe
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	ethAddr := common.HexToAddress(req.Address)
 	cosmosAddr := sdk.AccAddress(ethAddr.Bytes())
 
 	account := k.accountKeeper.GetAccount(ctx, cosmosAddr)
@@ -137,7 +154,8 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*typ
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+	address, err := utils.ParseAddress(req.Address)
+	if err != nil {
 		return nil, status.Error(
 			codes.InvalidArgument,
 			types.ErrZeroAddress.Error(),
@@ -146,7 +164,7 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*typ
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	balanceInt := k.GetBalance(ctx, common.HexToAddress(req.Address))
+	balanceInt := k.GetBalance(ctx, address)
 
 	return &types.QueryBalanceResponse{
 		Balance: balanceInt.String(),
@@ -159,7 +177,8 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+	address, err := utils.ParseAddress(req.Address)
+	if err != nil {
 		return nil, status.Error(
 			codes.InvalidArgument,
 			types.ErrZeroAddress.Error(),
@@ -168,7 +187,6 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	address := common.HexToAddress(req.Address)
 	key := common.HexToHash(req.Key)
 
 	state := k.GetState(ctx, address, key)
@@ -179,13 +197,48 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// StorageSlots implements the Query/StorageSlots gRPC method. The storage slots
+// are all read from the state of the same height, in the order they are requested.
+func (k Keeper) StorageSlots(c context.Context, req *types.QueryStorageSlotsRequest) (*types.QueryStorageSlotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Slots) > maxStorageSlots {
+		return nil, status.Errorf(codes.InvalidArgument, "too many storage slots: %d > %d", len(req.Slots), maxStorageSlots)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	values := make([]string, len(req.Slots))
+	for i, slot := range req.Slots {
+		if slot == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "empty storage slot %d", i)
+		}
+		address, err := utils.ParseAddress(slot.Address)
+		if err != nil {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				types.ErrZeroAddress.Error(),
+			)
+		}
+
+		state := k.GetState(ctx, address, common.HexToHash(slot.Key))
+		values[i] = state.Hex()
+	}
+
+	return &types.QueryStorageSlotsResponse{
+		Values: values,
+	}, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+	address, err := utils.ParseAddress(req.Address)
+	if err != nil {
 		return nil, status.Error(
 			codes.InvalidArgument,
 			types.ErrZeroAddress.Error(),
@@ -194,7 +247,6 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.Que
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	address := common.HexToAddress(req.Address)
 	acct := k.GetAccountWithoutBalance(ctx, address)
 
 	var code []byte
@@ -223,7 +275,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := queryContext(c)
 
 	var args types.TransactionArgs
 	err := json.Unmarshal(req.Args, &args)
@@ -272,6 +324,9 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	if fromType == types.RPC {
+		ctx = queryContext(c)
+	}
 
 	if req.GasCap < ethparams.TxGas {
 		return nil, status.Errorf(codes.InvalidArgument, "gas cap cannot be lower than %d", ethparams.TxGas)
@@ -440,6 +495,83 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// AccessList implements the eth_createAccessList rpc api. The call is executed
+// with the access list of the addresses and storage keys accessed by its previous
+// execution, until the access list doesn't change anymore.
+func (k Keeper) AccessList(c context.Context, req *types.EthCallRequest) (*types.QueryAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := queryContext(c)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+
+	// the sender, the recipient and the precompiles are always warm, so they
+	// aren't added to the access list
+	excluded := map[common.Address]struct{}{from: {}, to: {}}
+	rules := types.GetEthChainConfig().Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	for _, addr := range vm.ActivePrecompiles(rules) {
+		excluded[addr] = struct{}{}
+	}
+	for _, addr := range cfg.Params.ActiveStaticPrecompiles {
+		excluded[common.HexToAddress(addr)] = struct{}{}
+	}
+
+	var accessList ethtypes.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+
+	prevTracer := logger.NewAccessListTracer(accessList, excluded)
+	for {
+		accessList = prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee, false, false)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, excluded)
+		txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+		// pass false to not commit StateDB
+		tmpCtx, _ := ctx.CacheContext()
+		res, err := k.ApplyMessageWithConfig(tmpCtx, msg, tracer.Hooks(), false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.QueryAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -459,7 +591,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := queryContext(c)
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -480,7 +612,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := types.MakeSigner(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
@@ -489,12 +621,11 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	// and avoid stacking the gas used of every predecessor in the same gas meter
 
 	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+		msg, err := tx.ToMessage(signer, cfg.BaseFee)
 		if err != nil {
 			continue
 		}
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.AsTransaction().Hash()
 		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
 		// reset gas meter for each transaction
 		ctx = evmante.BuildEvmExecutionCtx(ctx).
@@ -506,8 +637,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(rsp.Logs))
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
+	txConfig.TxHash = req.Msg.AsTransaction().Hash()
 	if len(req.Predecessors) > 0 {
 		txConfig.TxIndex++
 	}
@@ -518,7 +648,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
 	}
 
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
+	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, req.Msg, req.TraceConfig, false, tracerConfig)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -553,7 +683,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := queryContext(c)
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -574,7 +704,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := types.MakeSigner(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	txsLength := len(req.Txs)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
@@ -582,10 +712,9 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.AsTransaction().Hash()
 		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, true, nil)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -611,7 +740,26 @@ func (k *Keeper) traceTx(
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 	signer ethtypes.Signer,
-	tx *ethtypes.Transaction,
+	tx *types.MsgEthereumTx,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	// the sender of the msg is used as is, the txs traced are the ones of the
+	// blocks, whose sender was verified when they were included
+	msg, err := tx.ToMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg *core.Message,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
@@ -623,10 +771,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -653,12 +797,17 @@ func (k *Keeper) traceTx(
 	}
 
 	tCtx := &tracers.Context{
-		BlockHash: txConfig.BlockHash,
-		TxIndex:   int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
-		TxHash:    txConfig.TxHash,
+		BlockHash:   txConfig.BlockHash,
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		TxIndex:     int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
+		TxHash:      txConfig.TxHash,
 	}
 
+	isJS := traceConfig.Tracer != "" && tracers.DefaultDirectory.IsJS(traceConfig.Tracer)
 	if traceConfig.Tracer != "" {
+		if k.tracerSizeCap > 0 && isJS && len(traceConfig.Tracer) > k.tracerSizeCap {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "javascript tracer size %d exceeds the cap of %d bytes", len(traceConfig.Tracer), k.tracerSizeCap)
+		}
 		if tracer, err = tracers.DefaultDirectory.New(traceConfig.Tracer, tCtx, tracerJSONConfig,
 			types.GetEthChainConfig()); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
@@ -671,6 +820,9 @@ func (k *Keeper) traceTx(
 			return nil, 0, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
 		}
 	}
+	if k.traceTimeoutCap > 0 && timeout > k.traceTimeoutCap {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "trace timeout %s exceeds the cap of %s", timeout, k.traceTimeoutCap)
+	}
 
 	// Handle timeouts and RPC cancellations
 	deadlineCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
@@ -683,23 +835,192 @@ func (k *Keeper) traceTx(
 		}
 	}()
 
-	// Build EVM execution context
+	// Build EVM execution context, interrupted with the tracer on timeout
 	ctx = evmante.BuildEvmExecutionCtx(ctx).
+		WithContext(deadlineCtx).
 		WithGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(msg.GasLimit))
 	res, err := k.ApplyMessageWithConfig(ctx, *msg, tracer.Hooks, commitMessage, cfg, txConfig)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
 
-	var result interface{}
-	result, err = tracer.GetResult()
+	traceResult, err := tracer.GetResult()
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
+	if k.tracerResultSizeCap > 0 && isJS && len(traceResult) > k.tracerResultSizeCap {
+		return nil, 0, status.Errorf(codes.ResourceExhausted, "javascript tracer result size %d exceeds the cap of %d bytes", len(traceResult), k.tracerResultSizeCap)
+	}
 
+	var result interface{} = traceResult
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the requested block.
+// The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	contextHeight := req.BlockNumber
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := queryContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	// to get the base fee we only need the block max gas in the consensus params
+	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+	})
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// compute and use base fee of the height that is being traced
+	baseFee := k.feeMarketWrapper.CalculateBaseFee(ctx)
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee, false, false)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, &msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
+// StorageRange returns the storage entries of a contract, starting from the
+// given key, at the state before the given predecessors of the requested block
+// are applied. The entries are ordered by key, and the number of returned
+// entries is capped to maxStorageRangeResults.
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (*types.QueryStorageRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := utils.ParseAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	// get the context of block beginning
+	contextHeight := req.BlockNumber
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	// to get the base fee we only need the block max gas in the consensus params
+	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+	})
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// compute and use base fee of the requested height
+	baseFee := k.feeMarketWrapper.CalculateBaseFee(ctx)
+	if baseFee != nil {
+		cfg.BaseFee = baseFee
+	}
+
+	signer := types.MakeSigner(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// replay the predecessors, the changes are committed to the query context
+	// store, which is discarded after the query
+	for i, tx := range req.Predecessors {
+		msg, err := tx.ToMessage(signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		txConfig.TxHash = tx.AsTransaction().Hash()
+		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+		// reset gas meter for each transaction
+		ctx = evmante.BuildEvmExecutionCtx(ctx).
+			WithGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(msg.GasLimit))
+		rsp, err := k.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, txConfig)
+		if err != nil {
+			continue
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+
+	maxResult := req.MaxResult
+	if maxResult > maxStorageRangeResults {
+		maxResult = maxStorageRangeResults
+	}
+
+	keyStart := common.HexToHash(req.KeyStart)
+
+	res := &types.QueryStorageRangeResponse{}
+	nextKey, err := k.IterateStorage(ctx, address, keyStart.Bytes(), maxResult, func(key, value common.Hash) bool {
+		res.Storage = append(res.Storage, types.NewState(key, value))
+		return true
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if nextKey != nil {
+		res.NextKey = common.BytesToHash(nextKey).Hex()
+	}
+
+	return res, nil
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
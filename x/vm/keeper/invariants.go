@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// BalancesInvariantRoute is the route of the invariant of the evm balances
+	BalancesInvariantRoute = "evm-balances"
+	// CodeHashesInvariantRoute is the route of the invariant of the code hashes
+	CodeHashesInvariantRoute = "code-hashes"
+
+	// maxInvariantAddresses is the maximum number of addresses listed by the
+	// message of a broken invariant
+	maxInvariantAddresses = 10
+)
+
+// RegisterInvariants registers the invariants of the evm module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, BalancesInvariantRoute, BalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, CodeHashesInvariantRoute, CodeHashesInvariant(k))
+}
+
+// AllInvariants runs all the invariants of the evm module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := BalancesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return CodeHashesInvariant(k)(ctx)
+	}
+}
+
+// BalancesInvariant checks that the sum of the evm balances of the accounts, in
+// 18 decimals, matches the supply of the evm denom. The fractional balances are
+// backed by the balance of the precisebank reserve, which isn't an evm balance,
+// less a remainder lower than one unit of the evm denom, so that the sum may be
+// lower than the supply by less than one unit.
+func BalancesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		total, accounts, err := k.totalEVMBalance(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, BalancesInvariantRoute, err.Error()), true
+		}
+
+		denom := types.GetEVMCoinDenom()
+		supply := k.bankWrapper.GetSupply(ctx, denom).Amount
+		conversionFactor := types.GetEVMCoinDecimals().ConversionFactor()
+		diff := supply.Mul(conversionFactor).Sub(total)
+		broken := diff.IsNegative() || diff.GTE(conversionFactor)
+
+		return sdk.FormatInvariant(types.ModuleName, BalancesInvariantRoute, fmt.Sprintf(
+			"\tsum of the evm balances of %d accounts: %s%s\n\tsupply: %s%s\n",
+			accounts, total, types.GetEVMCoinExtendedDenom(), supply, denom,
+		)), broken
+	}
+}
+
+// CodeHashesInvariant checks that the code of every code hash of a contract is
+// stored.
+func CodeHashesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			contracts, broken int
+			missing           []string
+		)
+		k.IterateContracts(ctx, func(addr common.Address, codeHash common.Hash) bool {
+			contracts++
+			if types.IsEmptyCodeHash(codeHash.Bytes()) || len(k.GetCode(ctx, codeHash)) > 0 {
+				return false
+			}
+			broken++
+			if len(missing) < maxInvariantAddresses {
+				missing = append(missing, fmt.Sprintf("\t%s: no code of hash %s\n", addr.Hex(), codeHash.Hex()))
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, CodeHashesInvariantRoute, fmt.Sprintf(
+			"%d contracts checked, %d without code\n%s", contracts, broken, strings.Join(missing, ""),
+		)), broken > 0
+	}
+}
+
+// totalEVMBalance returns the sum of the evm balances of the accounts of the
+// x/auth store, and the number of accounts.
+func (k *Keeper) totalEVMBalance(ctx sdk.Context) (math.Int, int, error) {
+	// the x/auth store is iterated directly, as the account keeper can't
+	// iterate the accounts
+	authKey, ok := k.storeKeys[authtypes.StoreKey]
+	if !ok {
+		return math.Int{}, 0, fmt.Errorf("no %s store key", authtypes.StoreKey)
+	}
+
+	iterator := prefix.NewStore(ctx.KVStore(authKey), authtypes.AddressStoreKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	total, accounts := math.ZeroInt(), 0
+	for ; iterator.Valid(); iterator.Next() {
+		_, addr, err := sdk.AccAddressKey.Decode(iterator.Key())
+		if err != nil {
+			return math.Int{}, 0, fmt.Errorf("invalid account key %X: %w", iterator.Key(), err)
+		}
+		balance := k.GetBalance(ctx, common.BytesToAddress(addr))
+		if balance == nil {
+			return math.Int{}, 0, fmt.Errorf("invalid evm balance of account %s", addr)
+		}
+		total = total.Add(math.NewIntFromBigInt(balance.ToBig()))
+		accounts++
+	}
+	return total, accounts, nil
+}
+
+// BlockGasInvariant checks that the gas used by the eth txs of the block, as
+// consumed by their cosmos txs according to their receipts, doesn't exceed the
+// gas consumed by the block gas meter, which also accounts the gas of the other
+// cosmos txs. It returns both amounts and an error if the invariant is broken.
+func (k Keeper) BlockGasInvariant(ctx sdk.Context) (evmGasUsed, blockGasUsed uint64, err error) {
+	evmGasUsed = k.GetTransientBlockGasUsed(ctx)
+	if ctx.BlockGasMeter() == nil {
+		return evmGasUsed, 0, nil
+	}
+
+	blockGasUsed = ctx.BlockGasMeter().GasConsumed()
+	if evmGasUsed > blockGasUsed {
+		return evmGasUsed, blockGasUsed, fmt.Errorf(
+			"%w: the eth txs used %d gas, the block gas meter consumed %d",
+			types.ErrInvalidGasUsed, evmGasUsed, blockGasUsed,
+		)
+	}
+	return evmGasUsed, blockGasUsed, nil
+}
+
+// reconcileBlockGas checks the block gas invariant, called in EndBlock. The
+// gas amounts are exported as metrics, and a mismatch is logged and counted,
+// it halts the node in the strict gas accounting mode.
+func (k Keeper) reconcileBlockGas(ctx sdk.Context) {
+	evmGasUsed, blockGasUsed, err := k.BlockGasInvariant(ctx)
+
+	telemetry.SetGauge(float32(evmGasUsed), "evm", "block", "gas_used")
+	telemetry.SetGauge(float32(blockGasUsed), "evm", "block", "block_gas_used")
+
+	if err == nil {
+		return
+	}
+
+	telemetry.IncrCounter(1, "evm", "block", "gas_mismatch")
+	k.Logger(ctx).Error("block gas accounting mismatch", "height", ctx.BlockHeight(), "error", err.Error())
+	if k.strictGasAccounting {
+		panic(fmt.Sprintf("block gas accounting mismatch at height %d: %s", ctx.BlockHeight(), err))
+	}
+}
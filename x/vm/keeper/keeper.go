@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -13,6 +14,8 @@ import (
 
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/store/backend"
+	vmstoretypes "github.com/cosmos/evm/x/vm/store/types"
 	"github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/evm/x/vm/wrappers"
 
@@ -43,6 +46,9 @@ type Keeper struct {
 	// KVStore Keys for modules wired to app
 	storeKeys map[string]*storetypes.KVStoreKey
 
+	// storageBackend defines the layout of the contract storage in the store
+	storageBackend vmstoretypes.StorageBackend
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
 
@@ -62,6 +68,32 @@ type Keeper struct {
 
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
+	// recordWitness enables emitting the accounts and storage slots accessed by each eth tx
+	recordWitness bool
+
+	// recordModifiedAccounts enables emitting the accounts modified by each eth tx
+	recordModifiedAccounts bool
+
+	// compactEvents disables emitting the ethereum_tx event of the executed eth txs
+	compactEvents bool
+
+	// strictGasAccounting halts the node when the gas used by the eth txs of a
+	// block exceeds the gas consumed by the block gas meter
+	strictGasAccounting bool
+
+	// traceTimeoutCap is the cap on the timeout requested by the trace queries, 0 is no cap
+	traceTimeoutCap time.Duration
+	// tracerSizeCap is the cap on the size in bytes of the JavaScript tracers, 0 is no cap
+	tracerSizeCap int
+	// tracerResultSizeCap is the cap on the size in bytes of the results of the
+	// JavaScript tracers, 0 is no cap
+	tracerResultSizeCap int
+
+	// blockedAddrs defines the module accounts that can't receive EVM value transfers
+	blockedAddrs map[common.Address]bool
+	// recoverableModules defines the module accounts whose funds can be recovered
+	// by governance, as their balance isn't accounted by their module
+	recoverableModules map[string]bool
 
 	hooks types.EvmHooks
 	// EVM Hooks for tx post-processing
@@ -70,6 +102,14 @@ type Keeper struct {
 	// Some of these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+
+	// parallelExecution executes the eth txs of the blocks optimistically in
+	// parallel, nil if it's disabled
+	parallelExecution *parallelExecution
+
+	// paramsCache and rulesCache cache the params and the chain rules once per block
+	paramsCache *utils.BlockCache[types.Params]
+	rulesCache  *utils.BlockCache[blockRules]
 }
 
 // NewKeeper generates new evm module keeper
@@ -111,6 +151,9 @@ func NewKeeper(
 		tracer:           tracer,
 		erc20Keeper:      erc20Keeper,
 		storeKeys:        keys,
+		storageBackend:   backend.NewPrefixBackend(),
+		paramsCache:      utils.NewBlockCache[types.Params](),
+		rulesCache:       utils.NewBlockCache[blockRules](),
 	}
 }
 
@@ -119,6 +162,87 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+// SetStorageBackend sets the layout of the contract storage in the store, the
+// default one stores the slots of a contract under the prefix of its address.
+// The backend is part of the state, it must be the same on all the nodes and
+// can't be changed on a running chain without migrating the contract storage.
+// The storage proofs of eth_getProof are only built for the default backend.
+func (k *Keeper) SetStorageBackend(storageBackend vmstoretypes.StorageBackend) *Keeper {
+	k.storageBackend = storageBackend
+	return k
+}
+
+// SetRecordWitness enables or disables emitting a tx witness event, with the
+// accounts and storage slots read and written, for every applied eth tx.
+// The event is node local and it's meant to be stored by the evm indexer.
+func (k *Keeper) SetRecordWitness(enabled bool) *Keeper {
+	k.recordWitness = enabled
+	return k
+}
+
+// SetRecordModifiedAccounts enables or disables emitting an event with the
+// accounts modified by every applied eth tx. The event is node local and it's
+// meant to be aggregated per block by the evm indexer.
+func (k *Keeper) SetRecordModifiedAccounts(enabled bool) *Keeper {
+	k.recordModifiedAccounts = enabled
+	return k
+}
+
+// SetCompactEvents enables or disables the compact events mode, in which the
+// ethereum_tx event with the gas used and the status of every executed eth tx
+// isn't emitted, because they are in the msg response of the tx result. Events
+// are not part of the consensus, so the mode is node local.
+func (k *Keeper) SetCompactEvents(enabled bool) *Keeper {
+	k.compactEvents = enabled
+	return k
+}
+
+// SetStrictGasAccounting enables or disables halting the node, in EndBlock,
+// when the gas used by the eth txs of the block, according to their receipts,
+// exceeds the gas consumed by the block gas meter. The mismatch is always
+// logged and counted in the metrics, the mode is node local.
+func (k *Keeper) SetStrictGasAccounting(enabled bool) *Keeper {
+	k.strictGasAccounting = enabled
+	return k
+}
+
+// SetTraceCaps sets the caps on the timeout, on the size of the JavaScript
+// tracer and on the size of its result requested by the TraceTx, TraceCall and
+// TraceBlock queries, a zero cap disables it. A JavaScript tracer whose result
+// exceeds its cap fails the query. The memory held by a JavaScript tracer while
+// it runs isn't capped, only its run time is.
+func (k *Keeper) SetTraceCaps(timeoutCap time.Duration, tracerSizeCap, tracerResultSizeCap int) *Keeper {
+	k.traceTimeoutCap = timeoutCap
+	k.tracerSizeCap = tracerSizeCap
+	k.tracerResultSizeCap = tracerResultSizeCap
+	return k
+}
+
+// SetBlockedAddrs sets the module accounts, keyed by their bech32 address, that
+// can't receive EVM value transfers. The eth txs transferring value to them fail
+// instead of leaving the funds stuck in the module accounts.
+func (k *Keeper) SetBlockedAddrs(blockedAddrs map[string]bool) *Keeper {
+	k.blockedAddrs = make(map[common.Address]bool, len(blockedAddrs))
+	for addr, blocked := range blockedAddrs {
+		if blocked {
+			k.blockedAddrs[common.BytesToAddress(sdk.MustAccAddressFromBech32(addr))] = true
+		}
+	}
+	return k
+}
+
+// SetRecoverableModules sets the module accounts whose funds can be sent to a
+// recipient by a RecoverStuckFunds proposal. Only the module accounts whose
+// balance isn't accounted by their module should be set, e.g. the mint module
+// account, which is emptied by every block.
+func (k *Keeper) SetRecoverableModules(moduleNames ...string) *Keeper {
+	k.recoverableModules = make(map[string]bool, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		k.recoverableModules[moduleName] = true
+	}
+	return k
+}
+
 // ----------------------------------------------------------------------------
 // Block Bloom
 // Required by Web3 API.
@@ -317,6 +441,21 @@ func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
 	return baseFee
 }
 
+// GetBlockBaseFee returns the base fee of the block, as GetBaseFee, from the
+// fee market params cached once per block.
+func (k Keeper) GetBlockBaseFee(ctx sdk.Context) *big.Int {
+	ethCfg := types.GetEthChainConfig()
+	if !types.IsLondon(ethCfg, ctx.BlockHeight()) {
+		return nil
+	}
+	baseFee := k.feeMarketWrapper.GetBlockBaseFee(ctx)
+	if baseFee == nil {
+		// return 0 if feemarket not enabled.
+		baseFee = big.NewInt(0)
+	}
+	return baseFee
+}
+
 // GetMinGasMultiplier returns the MinGasMultiplier param from the fee market module
 func (k Keeper) GetMinGasMultiplier(ctx sdk.Context) math.LegacyDec {
 	return k.feeMarketWrapper.GetParams(ctx).MinGasMultiplier
@@ -328,10 +467,76 @@ func (k Keeper) GetMinGasPrice(ctx sdk.Context) math.LegacyDec {
 	return k.feeMarketWrapper.GetParams(ctx).MinGasPrice
 }
 
-// ResetTransientGasUsed reset gas used to prepare for execution of current cosmos tx, called in ante handler.
+// GetBlockMinGasPrice returns the MinGasPrice param of the block, as
+// GetMinGasPrice, from the fee market params cached once per block.
+func (k Keeper) GetBlockMinGasPrice(ctx sdk.Context) math.LegacyDec {
+	return k.feeMarketWrapper.GetBlockParams(ctx).MinGasPrice
+}
+
+// ResetTransientGasUsed reset gas used, and the mixed tx flag, to prepare for execution of current cosmos tx,
+// called in ante handler.
 func (k Keeper) ResetTransientGasUsed(ctx sdk.Context) {
 	store := ctx.TransientStore(k.transientKey)
 	store.Delete(types.KeyPrefixTransientGasUsed)
+	store.Delete(types.KeyPrefixTransientMixedTx)
+}
+
+// SetTransientFeePayer sets the account that paid the fees of the current cosmos tx,
+// which is refunded the leftover gas, called in ante handler.
+func (k Keeper) SetTransientFeePayer(ctx sdk.Context, feePayer common.Address) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientFeePayer, feePayer.Bytes())
+}
+
+// GetTransientFeePayer returns the account that paid the fees of the current cosmos tx,
+// or false if it isn't set.
+func (k Keeper) GetTransientFeePayer(ctx sdk.Context) (common.Address, bool) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientFeePayer)
+	if bz == nil {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// SetTransientMsgCount sets the number of eth msgs of the current cosmos tx,
+// called in ante handler.
+func (k Keeper) SetTransientMsgCount(ctx sdk.Context, count uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientMsgCount, sdk.Uint64ToBigEndian(count))
+}
+
+// GetTransientMsgCount returns the number of eth msgs of the current cosmos tx.
+func (k Keeper) GetTransientMsgCount(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientMsgCount))
+}
+
+// SetTransientMixedTx flags the current cosmos tx as a mixed tx, combining an eth msg
+// with cosmos msgs, called in ante handler.
+func (k Keeper) SetTransientMixedTx(ctx sdk.Context) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientMixedTx, []byte{1})
+}
+
+// IsTransientMixedTx returns true if the current cosmos tx is a mixed tx.
+func (k Keeper) IsTransientMixedTx(ctx sdk.Context) bool {
+	store := ctx.TransientStore(k.transientKey)
+	return store.Has(types.KeyPrefixTransientMixedTx)
+}
+
+// AddTransientBlockGasUsed accumulates the gas used by the eth txs of the block, as consumed
+// by their cosmos txs, called by the msg server.
+func (k Keeper) AddTransientBlockGasUsed(ctx sdk.Context, gasUsed uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	total := sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientBlockGasUsed)) + gasUsed
+	store.Set(types.KeyPrefixTransientBlockGasUsed, sdk.Uint64ToBigEndian(total))
+}
+
+// GetTransientBlockGasUsed returns the gas used by the eth txs of the block.
+func (k Keeper) GetTransientBlockGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientBlockGasUsed))
 }
 
 // GetTransientGasUsed returns the gas used by current cosmos tx.
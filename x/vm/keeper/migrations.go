@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/cosmos/evm/x/vm/migrations/legacy"
+	v2 "github.com/cosmos/evm/x/vm/migrations/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	// the params are written directly to the store
+	m.keeper.paramsCache.Bypass(ctx)
+	return nil
+}
+
+// MigrateLegacyStore converts the evm store of an Ethermint or Evmos chain with
+// the given layout into the store at consensus version 1, from which the store
+// is migrated to the current version by the module migrations.
+func (m Migrator) MigrateLegacyStore(ctx sdk.Context, layout legacy.Layout) error {
+	authStoreKey, ok := m.keeper.storeKeys[authtypes.StoreKey]
+	if !ok {
+		return errors.New("no x/auth store key")
+	}
+	if err := legacy.MigrateStore(ctx, m.keeper.storeKey, authStoreKey, m.keeper.cdc, layout); err != nil {
+		return err
+	}
+
+	m.keeper.paramsCache.Bypass(ctx)
+	return nil
+}
@@ -3,21 +3,24 @@ package keeper
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
 
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	evmante "github.com/cosmos/evm/x/vm/ante"
 	"github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -42,11 +45,39 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplyTransaction(ctx, msg)
+	// the eth msg of a mixed tx is executed with the eth gas config and its own
+	// gas meter, the gas it used is then consumed, translated into cosmos gas, by
+	// the cosmos tx, which paid the fees
+	mixedTx := k.IsTransientMixedTx(ctx)
+	execCtx := ctx
+	if mixedTx {
+		execCtx = evmante.BuildEvmExecutionCtx(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	}
+
+	response, err := k.ApplyTransaction(execCtx, msg)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
 
+	// the gas consumed by the cosmos tx for the eth msg, accumulated for the
+	// reconciliation with the block gas meter
+	gasConsumed := response.GasUsed
+	if mixedTx {
+		gasConsumed = types.GetGasRatio().EVMToCosmosGas(response.GasUsed)
+		ctx.GasMeter().ConsumeGas(gasConsumed, "eth msg of the mixed tx")
+	}
+	k.AddTransientBlockGasUsed(ctx, gasConsumed)
+
+	// the eth txs batched in a cosmos tx are executed atomically, a failed one
+	// reverts the whole batch, whose txs are all served with a failed receipt
+	if response.Failed() && k.GetTransientMsgCount(ctx) > 1 {
+		return nil, errorsmod.Wrapf(types.ErrBatchedTxFailed, "tx %s: %s", response.Hash, response.VmError)
+	}
+
+	if err := k.CheckBlockGasLimit(ctx); err != nil {
+		return nil, err
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "ethereum_tx", "total"},
@@ -99,32 +130,24 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.VmError))
 	}
 
-	txLogAttrs := make([]sdk.Attribute, len(response.Logs))
-	for i, log := range response.Logs {
-		value, err := json.Marshal(log)
-		if err != nil {
-			return nil, errorsmod.Wrap(err, "failed to encode log")
-		}
-		txLogAttrs[i] = sdk.NewAttribute(types.AttributeKeyTxLog, string(value))
-	}
-
-	// emit events
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
+	// emit events, the logs are only returned in the msg response, which is
+	// stored in the tx result data, to not encode them again in the events
+	events := make(sdk.Events, 0, 2)
+	if !k.compactEvents {
+		// the hash and the index are in the event of the ante handler, and the
+		// gas used and the status in the msg response
+		events = append(events, sdk.NewEvent(
 			types.EventTypeEthereumTx,
 			attrs...,
-		),
-		sdk.NewEvent(
-			types.EventTypeTxLog,
-			txLogAttrs...,
-		),
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, types.HexAddress(msg.From)),
-			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", tx.Type())),
-		),
-	})
+		))
+	}
+	events = append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, types.HexAddress(msg.From)),
+		sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", tx.Type())),
+	))
+	ctx.EventManager().EmitEvents(events)
 
 	return response, nil
 }
@@ -164,3 +187,65 @@ func (k *Keeper) RegisterPreinstalls(goCtx context.Context, req *types.MsgRegist
 
 	return &types.MsgRegisterPreinstallsResponse{}, nil
 }
+
+// RecoverStuckFunds implements the gRPC MsgServer interface. When a RecoverStuckFunds
+// proposal passes, it sends the funds stuck in the module account to the
+// recipient. The recovery can only be performed if the requested authority is
+// the Cosmos SDK governance module account, and only for the module accounts
+// set as recoverable.
+func (k *Keeper) RecoverStuckFunds(goCtx context.Context, req *types.MsgRecoverStuckFunds) (*types.MsgRecoverStuckFundsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	if !k.recoverableModules[req.ModuleName] {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "funds of the module account %s can't be recovered", req.ModuleName)
+	}
+
+	if k.accountKeeper.GetModuleAddress(req.ModuleName) == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownAddress, "module account %s does not exist", req.ModuleName)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid recipient address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, req.ModuleName, recipient, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecoverStuckFundsResponse{}, nil
+}
+
+// ArchiveContractStorage implements the gRPC MsgServer interface. When an
+// ArchiveContractStorage proposal passes, it archives the storage of a contract
+// which hasn't been accessed for the storage expiry blocks, keeping only the
+// merkle root of its entries.
+func (k *Keeper) ArchiveContractStorage(goCtx context.Context, req *types.MsgArchiveContractStorage) (*types.MsgArchiveContractStorageResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	archived, err := k.ArchiveStorage(ctx, common.HexToAddress(req.Address))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgArchiveContractStorageResponse{StorageRoot: archived.Root.Hex(), Entries: archived.Entries}, nil
+}
+
+// RestoreContractStorage implements the gRPC MsgServer interface. It restores
+// entries of the archived storage of a contract, each with the proof of its
+// inclusion in the root of the archived storage. Any account can restore them.
+func (k *Keeper) RestoreContractStorage(goCtx context.Context, req *types.MsgRestoreContractStorage) (*types.MsgRestoreContractStorageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	remaining, err := k.RestoreStorage(ctx, common.HexToAddress(req.Address), req.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRestoreContractStorageResponse{Remaining: remaining}, nil
+}
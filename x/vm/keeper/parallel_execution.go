@@ -0,0 +1,310 @@
+package keeper
+
+import (
+	"math/big"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/store/rwset"
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// parallelExecution executes the eth txs of a block optimistically in parallel
+// once the block begins, each on its own branch of the block state, recording
+// the state it reads and writes.
+//
+// The txs are then executed by the block in order as usual, except that the
+// writes of the optimistic execution of a tx are applied instead if the values
+// it read are the same as on the block state at that point, i.e. if it didn't
+// conflict with the txs before it. So the results are the same as the ones of
+// the sequential execution, and only the txs conflicting with the txs before
+// them are executed twice, e.g. the value transfers, which all update the total
+// supply of the evm denom.
+type parallelExecution struct {
+	txDecoder   sdk.TxDecoder
+	anteHandler sdk.AnteHandler
+
+	mtx sync.Mutex
+	// txs are the txs of the block scheduled to be executed
+	txs [][]byte
+	// height is the height of the block of the executions
+	height int64
+	// executions are the optimistic executions of the eth txs by hash, which
+	// are removed when they're applied or discarded
+	executions map[common.Hash]*optimisticExecution
+
+	// applied and conflicts count the optimistic executions applied to the
+	// blocks and the ones discarded because they conflicted
+	applied   atomic.Uint64
+	conflicts atomic.Uint64
+}
+
+// optimisticExecution is the execution of an eth tx on a branch of the block
+// state, before the txs preceding it in the block were executed.
+type optimisticExecution struct {
+	hash    common.Hash
+	store   *rwset.Store
+	cfg     *statedb.EVMConfig
+	res     *types.MsgEthereumTxResponse
+	stateDB *statedb.StateDB
+	events  sdk.Events
+}
+
+// SetParallelExecution enables the experimental parallel execution of the eth
+// txs, which are decoded with the given decoder and go through the given ante
+// handler before their optimistic execution, like in the block. The txs of a
+// block must be scheduled with ScheduleParallelExecution by the PreBlocker, and
+// executed with ExecuteParallel by the BeginBlocker once the state of the block
+// is set up.
+//
+// The mode is node local, the blocks have the same results as with the
+// sequential execution. The eth txs aren't executed in parallel if a tracer is
+// set, as the traces of their optimistic execution would be misleading. A nil
+// ante handler disables the mode.
+func (k *Keeper) SetParallelExecution(txDecoder sdk.TxDecoder, anteHandler sdk.AnteHandler) *Keeper {
+	if anteHandler == nil {
+		k.parallelExecution = nil
+		return k
+	}
+
+	k.parallelExecution = &parallelExecution{
+		txDecoder:   txDecoder,
+		anteHandler: anteHandler,
+	}
+	return k
+}
+
+// ParallelExecutionStats returns the number of optimistic executions applied to
+// the blocks, and the number of the ones which conflicted with the txs before
+// them, whose txs were executed again, since the parallel execution was enabled.
+func (k *Keeper) ParallelExecutionStats() (applied, conflicts uint64) {
+	p := k.parallelExecution
+	if p == nil {
+		return 0, 0
+	}
+	return p.applied.Load(), p.conflicts.Load()
+}
+
+// ScheduleParallelExecution schedules the txs of the block being finalized to
+// be executed in parallel by ExecuteParallel. It's a no-op if the parallel
+// execution is disabled.
+func (k *Keeper) ScheduleParallelExecution(txs [][]byte) {
+	p := k.parallelExecution
+	if p == nil {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.txs = txs
+}
+
+// ExecuteParallel executes the scheduled eth txs optimistically in parallel on
+// the state of the block of the context, and keeps their executions to apply
+// them to the block if they don't conflict. The executions of the previous
+// block are discarded.
+func (k *Keeper) ExecuteParallel(ctx sdk.Context) {
+	p := k.parallelExecution
+	if p == nil {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.discard()
+	txs := p.txs
+	p.txs = nil
+	if len(txs) == 0 || k.tracer != "" {
+		return
+	}
+
+	// the executions only read the block state, which isn't written before
+	// they're all done
+	executions := make([]*optimisticExecution, len(txs))
+	var (
+		wg   sync.WaitGroup
+		next atomic.Int64
+	)
+	for range min(runtime.GOMAXPROCS(0), len(txs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := next.Add(1) - 1; i < int64(len(txs)); i = next.Add(1) - 1 {
+				executions[i] = k.executeOptimistically(ctx, p, txs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	p.height = ctx.BlockHeight()
+	p.executions = make(map[common.Hash]*optimisticExecution, len(executions))
+	for _, execution := range executions {
+		if execution == nil {
+			continue
+		}
+		if _, ok := p.executions[execution.hash]; ok {
+			execution.stateDB.Release()
+			continue
+		}
+		p.executions[execution.hash] = execution
+	}
+	telemetry.IncrCounter(float32(len(p.executions)), "evm", "parallel", "executed")
+}
+
+// executeOptimistically executes the tx, if it's an eth tx, on a branch of the
+// state of the context. It returns nil if the tx isn't executed or if its
+// execution failed, in which case the block executes it again.
+func (k *Keeper) executeOptimistically(ctx sdk.Context, p *parallelExecution, txBytes []byte) (execution *optimisticExecution) {
+	// a panic is raised again by the execution of the tx in the block
+	defer func() {
+		if r := recover(); r != nil {
+			k.Logger(ctx).Debug("optimistic execution panicked", "panic", r)
+			execution = nil
+		}
+	}()
+
+	tx, err := p.txDecoder(txBytes)
+	if err != nil {
+		return nil
+	}
+	// the batched and the mixed txs are executed in the block only
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil
+	}
+	msgEth, ok := msgs[0].(*types.MsgEthereumTx)
+	if !ok {
+		return nil
+	}
+
+	// the gas meter of the context is shared by the executions, each one has
+	// its own until the ante handler sets the one of the tx
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// the ante handler deducts the fees and increments the nonce of the sender,
+	// like in the block, on a branch whose reads aren't recorded
+	anteStore := ctx.MultiStore().CacheMultiStore()
+	anteCtx := ctx.WithMultiStore(anteStore).
+		WithTxBytes(txBytes).
+		WithEventManager(sdk.NewEventManager())
+	anteCtx, err = p.anteHandler(anteCtx, tx, false)
+	if err != nil {
+		return nil
+	}
+
+	store := rwset.NewStore(anteStore)
+	execCtx := anteCtx.WithMultiStore(store).WithEventManager(sdk.NewEventManager())
+
+	cfg, err := k.blockEVMConfig(execCtx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
+	if err != nil {
+		return nil
+	}
+	ethTx := msgEth.AsTransaction()
+	signer := types.MakeSigner(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+	if err != nil {
+		return nil
+	}
+
+	// the index of the tx and of its logs in the block are set once applied
+	txConfig := statedb.NewTxConfig(common.BytesToHash(ctx.HeaderHash()), ethTx.Hash(), 0, 0)
+	res, stateDB, err := k.applyMessageWithConfig(execCtx, *msg, nil, true, cfg, txConfig)
+	if err != nil {
+		return nil
+	}
+	store.Write()
+
+	return &optimisticExecution{
+		hash:    txConfig.TxHash,
+		store:   store,
+		cfg:     cfg,
+		res:     res,
+		stateDB: stateDB,
+		events:  execCtx.EventManager().Events(),
+	}
+}
+
+// applyOptimisticExecution applies the optimistic execution of the tx to the
+// block state of the context if the values it read are unchanged, and returns
+// its result like applyMessageWithConfig. It returns false if there's no
+// execution of the tx or if it conflicts, in which case the tx must be executed.
+func (k *Keeper) applyOptimisticExecution(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, *statedb.StateDB, bool) {
+	p := k.parallelExecution
+	if p == nil || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return nil, nil, false
+	}
+
+	execution := p.take(ctx.BlockHeight(), txConfig.TxHash)
+	if execution == nil {
+		return nil, nil, false
+	}
+
+	// the config is the same unless the params were updated during the block
+	if !reflect.DeepEqual(execution.cfg, cfg) || !execution.store.Valid(ctx.MultiStore()) {
+		execution.stateDB.Release()
+		p.conflicts.Add(1)
+		telemetry.IncrCounter(1, "evm", "parallel", "conflicts")
+		return nil, nil, false
+	}
+
+	execution.store.Apply(ctx.MultiStore())
+	ctx.EventManager().EmitEvents(execution.events)
+	for i, log := range execution.res.Logs {
+		log.TxIndex = uint64(txConfig.TxIndex)
+		log.Index = uint64(txConfig.LogIndex) + uint64(i) //#nosec G115 -- int overflow is not a concern here
+	}
+	p.applied.Add(1)
+	telemetry.IncrCounter(1, "evm", "parallel", "applied")
+	return execution.res, execution.stateDB, true
+}
+
+// discardParallelExecutions discards the optimistic executions which weren't
+// applied, at the end of the block.
+func (k *Keeper) discardParallelExecutions() {
+	p := k.parallelExecution
+	if p == nil {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.discard()
+}
+
+// take removes and returns the optimistic execution of the tx in the block at
+// the given height, if any.
+func (p *parallelExecution) take(height int64, hash common.Hash) *optimisticExecution {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.height != height {
+		return nil
+	}
+	execution := p.executions[hash]
+	delete(p.executions, hash)
+	return execution
+}
+
+// discard releases the executions which weren't applied.
+func (p *parallelExecution) discard() {
+	for _, execution := range p.executions {
+		execution.stateDB.Release()
+	}
+	p.executions = nil
+}
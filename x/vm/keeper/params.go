@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/types"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -34,6 +36,10 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	if err := validateEVMChainID(params.EVMChainID); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
@@ -41,9 +47,42 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	}
 
 	store.Set(types.KeyPrefixParams, bz)
+	k.paramsCache.Bypass(ctx)
 	return nil
 }
 
+// ValidateEVMChainID checks that the pinned evm chain id param, if any, is the
+// chain id of the evm chain config of the node. It's meant to be called once
+// the app is loaded, so that a node configured with the chain id of another
+// chain refuses to start instead of signing and verifying the txs with it. The
+// check also runs at the beginning of each block, for the nodes restored from
+// a state-sync snapshot.
+func (k Keeper) ValidateEVMChainID(ctx sdk.Context) error {
+	return validateEVMChainID(k.GetParams(ctx).EVMChainID)
+}
+
+// validateEVMChainID checks that a pinned evm chain id is the one of the evm
+// chain config, which all the signers are built from
+func validateEVMChainID(evmChainID uint64) error {
+	if evmChainID == 0 {
+		return nil
+	}
+	if chainID := types.GetEthChainConfig().ChainID; chainID.Uint64() != evmChainID {
+		return errorsmod.Wrapf(
+			types.ErrInvalidChainConfig,
+			"evm chain id param %d doesn't match the evm chain config chain id %s", evmChainID, chainID,
+		)
+	}
+	return nil
+}
+
+// GetBlockParams returns the evm params of the block of the context, which are
+// loaded from the store once per block. The returned params share their slices
+// with the cache, they must not be modified.
+func (k Keeper) GetBlockParams(ctx sdk.Context) types.Params {
+	return k.paramsCache.Get(ctx, k.GetParams)
+}
+
 // EnableStaticPrecompiles appends the addresses of the given Precompiles to the list
 // of active static precompiles.
 func (k Keeper) EnableStaticPrecompiles(ctx sdk.Context, addresses ...common.Address) error {
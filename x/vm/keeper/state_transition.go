@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -39,7 +40,18 @@ func (k *Keeper) NewEVM(
 	tracer *tracing.Hooks,
 	stateDB vm.StateDB,
 ) *vm.EVM {
-	blockCtx := vm.BlockContext{
+	blockCtx := k.newBlockContext(ctx, cfg)
+	txCtx := core.NewEVMTxContext(&msg)
+	vmConfig := k.newVMConfig(ctx, msg, cfg, tracer)
+
+	evmHooks := types.NewDefaultOpCodesHooks()
+	k.addOpCodeHooks(ctx, msg, cfg, evmHooks)
+	return vm.NewEVMWithHooks(evmHooks, blockCtx, txCtx, stateDB, types.GetEthChainConfig(), vmConfig)
+}
+
+// newBlockContext returns the block context of the EVM.
+func (k *Keeper) newBlockContext(ctx sdk.Context, cfg *statedb.EVMConfig) vm.BlockContext {
+	return vm.BlockContext{
 		CanTransfer: core.CanTransfer,
 		Transfer:    core.Transfer,
 		GetHash:     k.GetHashFn(ctx),
@@ -49,34 +61,51 @@ func (k *Keeper) NewEVM(
 		Time:        uint64(ctx.BlockHeader().Time.Unix()), //#nosec G115 -- int overflow is not a concern here
 		Difficulty:  big.NewInt(0),                         // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
-		Random:      &common.MaxHash, // need to be different than nil to signal it is after the merge and pick up the right opcodes
+		Random:      k.getPrevRandao(ctx), // need to be different than nil to signal it is after the merge and pick up the right opcodes
 	}
+}
 
-	ethCfg := types.GetEthChainConfig()
-	txCtx := core.NewEVMTxContext(&msg)
+// getPrevRandao returns the PREVRANDAO value of the current block, derived
+// from its CometBFT hash.
+func (k *Keeper) getPrevRandao(ctx sdk.Context) *common.Hash {
+	blockHash := ctx.HeaderHash()
+	if len(blockHash) == 0 {
+		// the header hash isn't set in the query contexts
+		blockHash = k.GetBlockHash(ctx, uint64(ctx.BlockHeight())) //#nosec G115 -- block height is never negative
+	}
+	random := types.PrevRandao(blockHash)
+	return &random
+}
+
+// newVMConfig returns the EVM config, with the tracer defined by the keeper
+// options if tracer is nil.
+func (k *Keeper) newVMConfig(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer *tracing.Hooks) vm.Config {
 	if tracer == nil {
-		tracer = k.Tracer(ctx, msg, ethCfg)
+		tracer = k.Tracer(ctx, msg, types.GetEthChainConfig())
 	}
-	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
+	return k.VMConfig(ctx, msg, cfg, tracer)
+}
 
+// addOpCodeHooks sets the hooks for the EVM opcodes.
+func (k *Keeper) addOpCodeHooks(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, evmHooks types.OpCodeHooks) {
 	signer := msg.From
 	accessControl := types.NewRestrictedPermissionPolicy(&cfg.Params.AccessControl, signer)
 
-	// Set hooks for the EVM opcodes
-	evmHooks := types.NewDefaultOpCodesHooks()
 	evmHooks.AddCreateHooks(
 		accessControl.GetCreateHook(signer),
 	)
 	evmHooks.AddCallHooks(
 		accessControl.GetCallHook(signer),
+		k.GetBlockedAddrsCallHook(),
 		k.GetPrecompilesCallHook(ctx),
 	)
-	return vm.NewEVMWithHooks(evmHooks, blockCtx, txCtx, stateDB, ethCfg, vmConfig)
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
 //  1. The requested height matches the current height from context (and thus same epoch number)
-//  2. The requested height is from an previous height from the same chain epoch
+//  2. The requested height is from an previous height from the same chain epoch, served from the
+//     ring buffer of the recent block hashes, the historical info of x/staking or, if pruned, from
+//     the EIP-2935 history contract
 //  3. The requested height is from a height greater than the latest one
 func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	return func(height uint64) common.Hash {
@@ -110,10 +139,18 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 		case ctx.BlockHeight() > h:
 			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
 			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// The CometBFT hashes of the recent blocks are read from the ring buffer of the store, which matches
+			// the hashes of the blocks returned by the JSON-RPC.
+			if hash := k.GetBlockHash(ctx, height); len(hash) != 0 {
+				return common.BytesToHash(hash)
+			}
+
 			histInfo, err := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if err != nil {
+				// fall back to the hashes stored by the EIP-2935 history contract
+				// in case the historical info is pruned
 				k.Logger(ctx).Debug("error while getting historical info", "height", h, "error", err.Error())
-				return common.Hash{}
+				return k.getHistoricalBlockHash(ctx, height)
 			}
 
 			header, err := cmttypes.HeaderFromProto(&histInfo.Header)
@@ -153,7 +190,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		bloomReceipt ethtypes.Bloom
 	)
 
-	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
+	cfg, err := k.blockEVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
@@ -161,7 +198,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	txConfig := k.TxConfig(ctx, ethTx.Hash())
 
 	// get the signer according to the chain rules from the config and block height
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := types.MakeSigner(big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
@@ -172,8 +209,16 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commit := ctx.CacheContext()
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, nil, true, cfg, txConfig)
+	// the optimistic execution of the tx is applied if it doesn't conflict,
+	// otherwise pass true to commit the StateDB
+	res, stateDB, applied := k.applyOptimisticExecution(tmpCtx, cfg, txConfig)
+	if !applied {
+		res, stateDB, err = k.applyMessageWithConfig(tmpCtx, *msg, nil, true, cfg, txConfig)
+	}
+	if stateDB != nil {
+		// nothing references the StateDB once the tx is applied
+		defer stateDB.Release()
+	}
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -181,6 +226,15 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
 
+	// the witness is emitted on the parent context, so it's kept for failed txs as well
+	if k.recordWitness {
+		event, err := types.NewTxWitnessEvent(txConfig.TxHash, stateDB.TxWitness())
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to encode tx witness")
+		}
+		ctx.EventManager().EmitEvent(event)
+	}
+
 	logs := types.LogsToEthereum(res.Logs)
 
 	// Compute block bloom filter
@@ -241,6 +295,20 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		}
 	}
 
+	// the state changes of the failed txs are discarded, but the fees and the
+	// nonce of the sender are changed in any case
+	if k.recordModifiedAccounts {
+		accounts := []common.Address{msg.From}
+		if !res.Failed() {
+			accounts = append(accounts, stateDB.ModifiedAccounts()...)
+		}
+		event, err := types.NewModifiedAccountsEvent(txConfig.TxHash, accounts)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to encode modified accounts")
+		}
+		ctx.EventManager().EmitEvent(event)
+	}
+
 	evmDenom := types.GetEVMCoinDenom()
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
@@ -329,24 +397,54 @@ func (k *Keeper) ApplyMessageWithConfig(
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 ) (*types.MsgEthereumTxResponse, error) {
+	res, stateDB, err := k.applyMessageWithConfig(ctx, msg, tracer, commit, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+	// nothing references the StateDB once the message is applied
+	stateDB.Release()
+	return res, nil
+}
+
+// applyMessageWithConfig implements ApplyMessageWithConfig, it also returns the
+// StateDB used for the execution. The caller must release the StateDB, which is
+// only returned if no error occurred.
+func (k *Keeper) applyMessageWithConfig(
+	ctx sdk.Context,
+	msg core.Message,
+	tracer *tracing.Hooks,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (_ *types.MsgEthereumTxResponse, _ *statedb.StateDB, err error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
-	stateDB := statedb.New(ctx, k, txConfig)
-	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
+	stateDB := statedb.NewFromPool(ctx, k, txConfig)
+	defer func() {
+		if err != nil {
+			stateDB.Release()
+		}
+	}()
+	evm, releaseEVM := k.acquireEVM(ctx, msg, cfg, tracer, stateDB)
+	defer releaseEVM()
+	// the interruption is stopped before the EVM is released
+	defer interruptOnDone(ctx, evm)()
 
 	leftoverGas := msg.GasLimit
 
 	// Allow the tracer captures the tx level events, mainly the gas consumption.
 	vmCfg := evm.Config
 	if vmCfg.Tracer != nil {
-		vmCfg.Tracer.OnTxStart(
-			evm.GetVMContext(),
-			ethtypes.NewTx(&ethtypes.LegacyTx{To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit}),
-			msg.From,
-		)
+		if vmCfg.Tracer.OnTxStart != nil {
+			vmCfg.Tracer.OnTxStart(
+				evm.GetVMContext(),
+				ethtypes.NewTx(&ethtypes.LegacyTx{To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit}),
+				msg.From,
+			)
+		}
 		defer func() {
 			if vmCfg.Tracer.OnTxEnd != nil {
 				vmCfg.Tracer.OnTxEnd(&ethtypes.Receipt{GasUsed: msg.GasLimit - leftoverGas}, vmErr)
@@ -363,37 +461,67 @@ func (k *Keeper) ApplyMessageWithConfig(
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, ethCfg, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+		return nil, nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
 	}
 	leftoverGas -= intrinsicGas
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	rules := k.GetBlockRules(ctx)
 	stateDB.Prepare(rules, msg.From, common.Address{}, msg.To, evm.ActivePrecompiles(), msg.AccessList)
 
 	convertedValue, err := utils.Uint256FromBigInt(msg.Value)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	// the nonce of the sender after the message, the ante handler already
+	// incremented it for every eth tx of the cosmos tx
+	nonce := max(stateDB.GetNonce(sender.Address()), msg.Nonce+1)
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
-		// - increase sender's nonce by one no matter the result.
+		// - restore sender's nonce after the evm call no matter the result.
 		stateDB.SetNonce(sender.Address(), msg.Nonce, tracing.NonceChangeEoACall)
+	}
+
+	// the calls to the blocked module accounts fail through the call hook, the
+	// SELFDESTRUCT crediting them fails the message with the execution reverted
+	stateDB.SetBlockedAddrs(k.blockedAddrs)
+	snapshot := stateDB.Snapshot()
+
+	if contractCreation {
 		ret, _, leftoverGas, vmErr = evm.Create(sender.Address(), msg.Data, leftoverGas, convertedValue)
-		stateDB.SetNonce(sender.Address(), msg.Nonce+1, tracing.NonceChangeContractCreator)
 	} else {
 		ret, leftoverGas, vmErr = evm.Call(sender.Address(), *msg.To, msg.Data, leftoverGas, convertedValue)
 	}
 
+	// an interrupted execution stops at its next jump, its result is meaningless
+	if evm.Cancelled() {
+		return nil, nil, errorsmod.Wrapf(types.ErrExecutionAborted, "%v", context.Cause(ctx.Context()))
+	}
+
+	// the archived storages are read as empty, so the executions accessing
+	// them fail like the ones crediting the blocked accounts
+	execErr := blockedCreditsErr(stateDB.BlockedCredits())
+	if execErr == nil {
+		execErr = k.archivedStorageErr(ctx, stateDB.StorageAccessedAccounts())
+	}
+	if execErr != nil {
+		stateDB.RevertToSnapshot(snapshot)
+		ret, vmErr = nil, execErr
+	}
+
+	if contractCreation {
+		stateDB.SetNonce(sender.Address(), nonce, tracing.NonceChangeContractCreator)
+	}
+
 	refundQuotient := params.RefundQuotient
 
 	// After EIP-3529: refunds are capped to gasUsed / 5
@@ -403,7 +531,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 
 	// calculate gas refund
 	if msg.GasLimit < leftoverGas {
-		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+		return nil, nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
 	}
 	// refund gas
 	temporaryGasUsed := msg.GasLimit - leftoverGas
@@ -422,8 +550,9 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
 		if err := stateDB.Commit(); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+			return nil, nil, errorsmod.Wrap(types.ErrStateDBCommit, err.Error())
 		}
+		k.recordStorageAccesses(ctx, stateDB.StorageAccessedAccounts())
 	}
 
 	// calculate a minimum amount of gas to be charged to sender if GasLimit
@@ -434,11 +563,11 @@ func (k *Keeper) ApplyMessageWithConfig(
 	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
 
 	if !minimumGasUsed.TruncateInt().IsUint64() {
-		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "minimumGasUsed(%s) is not a uint64", minimumGasUsed.TruncateInt().String())
+		return nil, nil, errorsmod.Wrapf(types.ErrGasOverflow, "minimumGasUsed(%s) is not a uint64", minimumGasUsed.TruncateInt().String())
 	}
 
 	if msg.GasLimit < leftoverGas {
-		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.GasLimit, leftoverGas)
+		return nil, nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.GasLimit, leftoverGas)
 	}
 
 	gasUsed := math.LegacyMaxDec(minimumGasUsed, math.LegacyNewDec(int64(temporaryGasUsed))).TruncateInt().Uint64() //#nosec G115 -- int overflow is not a concern here
@@ -451,5 +580,5 @@ func (k *Keeper) ApplyMessageWithConfig(
 		Ret:     ret,
 		Logs:    types.NewLogsFromEth(stateDB.Logs()),
 		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+	}, stateDB, nil
 }
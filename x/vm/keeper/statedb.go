@@ -10,10 +10,12 @@ import (
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ statedb.Keeper = &Keeper{}
@@ -33,11 +35,26 @@ func (k *Keeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Accou
 	return acct
 }
 
+// GetSenderAccount returns the auth account of the address together with its
+// EVM account (nonce, balance and code hash), reading the auth account once so
+// that the ante handler can verify the sender and increment its nonce without
+// loading the account again. Both are nil if the account doesn't exist.
+func (k *Keeper) GetSenderAccount(ctx sdk.Context, addr common.Address) (sdk.AccountI, *statedb.Account) {
+	acct := k.accountKeeper.GetAccount(ctx, addr.Bytes())
+	if acct == nil {
+		return nil, nil
+	}
+
+	return acct, &statedb.Account{
+		Nonce:    acct.GetSequence(),
+		Balance:  k.GetBalance(ctx, addr),
+		CodeHash: k.GetCodeHash(ctx, addr).Bytes(),
+	}
+}
+
 // GetState loads contract state from database.
 func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
-
-	value := store.Get(key.Bytes())
+	value := k.storageBackend.Get(ctx.KVStore(k.storeKey), addr, key)
 	if len(value) == 0 {
 		return common.Hash{}
 	}
@@ -47,9 +64,7 @@ func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash)
 
 // GetFastState loads contract state from database.
 func (k *Keeper) GetFastState(ctx sdk.Context, addr common.Address, key common.Hash) []byte {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
-
-	return store.Get(key.Bytes())
+	return k.storageBackend.Get(ctx.KVStore(k.storeKey), addr, key)
 }
 
 // GetCodeHash loads the code hash from the database for the given contract address.
@@ -88,12 +103,16 @@ func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
 	return store.Get(codeHash.Bytes())
 }
 
-// ForEachStorage iterate contract storage, callback return false to break early
+// ForEachStorage iterate contract storage in ascending key order, callback
+// return false to break early
 func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
-	store := ctx.KVStore(k.storeKey)
-	prefix := types.AddressStoragePrefix(addr)
+	k.ForEachStorageFrom(ctx, addr, common.Hash{}, cb)
+}
 
-	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+// ForEachStorageFrom iterate contract storage in ascending key order, starting
+// from the given key, callback return false to break early
+func (k *Keeper) ForEachStorageFrom(ctx sdk.Context, addr common.Address, start common.Hash, cb func(key, value common.Hash) bool) {
+	iterator := k.storageBackend.Iterator(ctx.KVStore(k.storeKey), addr, start)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -107,6 +126,27 @@ func (k *Keeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(ke
 	}
 }
 
+// IterateStorage iterates at most limit entries of the contract storage in
+// ascending key order, starting from the entry of the given pagination token,
+// nil to start from the first entry. It returns the pagination token of the
+// next entry, nil once the storage is exhausted.
+func (k *Keeper) IterateStorage(
+	ctx sdk.Context,
+	addr common.Address,
+	pageToken []byte,
+	limit uint64,
+	cb func(key, value common.Hash) bool,
+) ([]byte, error) {
+	forEach := func(start common.Hash, cb func(key, value common.Hash) bool) {
+		k.ForEachStorageFrom(ctx, addr, start, cb)
+	}
+	nextToken, err := statedb.IterateStoragePage(forEach, pageToken, limit, cb)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	return nextToken, nil
+}
+
 // SetBalance update account's balance, compare with current balance first, then decide to mint or burn.
 func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *uint256.Int) error {
 	if amount == nil {
@@ -170,8 +210,7 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 
 // SetState update contract storage.
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
-	store.Set(key.Bytes(), value)
+	k.storageBackend.Set(ctx.KVStore(k.storeKey), addr, key, value)
 
 	k.Logger(ctx).Debug(
 		"state updated",
@@ -183,8 +222,7 @@ func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash,
 // DeleteState deletes the entry for the given key in the contract storage
 // at the defined contract address.
 func (k *Keeper) DeleteState(ctx sdk.Context, addr common.Address, key common.Hash) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
-	store.Delete(key.Bytes())
+	k.storageBackend.Delete(ctx.KVStore(k.storeKey), addr, key)
 
 	k.Logger(ctx).Debug(
 		"state deleted",
@@ -271,6 +309,7 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 
 	// clear code hash
 	k.DeleteCodeHash(ctx, addr)
+	k.deleteStorageExpiry(ctx, addr)
 
 	// remove auth account
 	k.accountKeeper.RemoveAccount(ctx, acct)
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetContractAccessHeight returns the height of the last block in which the
+// storage of the contract was read or written, if it was recorded.
+func (k *Keeper) GetContractAccessHeight(ctx sdk.Context, addr common.Address) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ContractAccessHeightKey(addr))
+	if len(bz) != 8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// GetArchivedStorage returns the record of the archived storage of the
+// contract, if its storage is archived.
+func (k *Keeper) GetArchivedStorage(ctx sdk.Context, addr common.Address) (types.ArchivedStorage, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ArchivedStorageKey(addr))
+	if len(bz) != common.HashLength+16 {
+		return types.ArchivedStorage{}, false
+	}
+	return types.ArchivedStorage{
+		Root:     common.BytesToHash(bz[:common.HashLength]),
+		Entries:  binary.BigEndian.Uint64(bz[common.HashLength:]),
+		Restored: binary.BigEndian.Uint64(bz[common.HashLength+8:]),
+	}, true
+}
+
+// SetArchivedStorage sets the record of the archived storage of the contract.
+func (k *Keeper) SetArchivedStorage(ctx sdk.Context, addr common.Address, archived types.ArchivedStorage) {
+	bz := make([]byte, 0, common.HashLength+16)
+	bz = append(bz, archived.Root.Bytes()...)
+	bz = binary.BigEndian.AppendUint64(bz, archived.Entries)
+	bz = binary.BigEndian.AppendUint64(bz, archived.Restored)
+	ctx.KVStore(k.storeKey).Set(types.ArchivedStorageKey(addr), bz)
+}
+
+// ArchiveStorage deletes the storage of a contract which hasn't been accessed
+// for the storage expiry blocks, and keeps the merkle root of its entries,
+// against which they're proven to restore it. It returns the record of the
+// archived storage.
+//
+// The contracts whose access isn't recorded, i.e. which haven't been accessed
+// since the expiry was enabled, can't be archived.
+func (k *Keeper) ArchiveStorage(ctx sdk.Context, addr common.Address) (types.ArchivedStorage, error) {
+	expiryBlocks := k.GetBlockParams(ctx).StorageExpiryBlocks
+	if expiryBlocks == 0 {
+		return types.ArchivedStorage{}, errorsmod.Wrap(errortypes.ErrInvalidRequest, "storage expiry is disabled")
+	}
+
+	if !k.IsContract(ctx, addr) {
+		return types.ArchivedStorage{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s is not a contract", addr.Hex())
+	}
+
+	if _, archived := k.GetArchivedStorage(ctx, addr); archived {
+		return types.ArchivedStorage{}, errorsmod.Wrapf(types.ErrContractStorageArchived, "contract %s", addr.Hex())
+	}
+
+	accessHeight, ok := k.GetContractAccessHeight(ctx, addr)
+	if !ok {
+		return types.ArchivedStorage{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "no recorded access to the storage of contract %s", addr.Hex())
+	}
+	if expiryHeight := accessHeight + expiryBlocks; uint64(ctx.BlockHeight()) < expiryHeight { //#nosec G115 -- block height is never negative
+		return types.ArchivedStorage{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"storage of contract %s accessed at height %d expires at height %d", addr.Hex(), accessHeight, expiryHeight,
+		)
+	}
+
+	storage := k.GetAccountStorage(ctx, addr)
+	if len(storage) == 0 {
+		return types.ArchivedStorage{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "contract %s has no storage", addr.Hex())
+	}
+
+	// the entries are deleted once the iteration over the storage is done
+	for _, state := range storage {
+		k.DeleteState(ctx, addr, common.HexToHash(state.Key))
+	}
+
+	archived := types.ArchivedStorage{Root: storage.Root(), Entries: uint64(len(storage))}
+	k.SetArchivedStorage(ctx, addr, archived)
+	ctx.KVStore(k.storeKey).Delete(types.ContractAccessHeightKey(addr))
+	return archived, nil
+}
+
+// RestoreStorage restores entries of the archived storage of the contract,
+// each proven against the root of the archived storage, so that a large
+// storage is restored over several transactions. The storage stays archived
+// until all its entries are restored. It returns the number of entries left to
+// restore.
+func (k *Keeper) RestoreStorage(ctx sdk.Context, addr common.Address, entries []types.StorageProof) (uint64, error) {
+	archived, ok := k.GetArchivedStorage(ctx, addr)
+	if !ok {
+		return 0, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "storage of contract %s is not archived", addr.Hex())
+	}
+
+	for _, entry := range entries {
+		if err := entry.Verify(archived.Root, archived.Entries); err != nil {
+			return 0, err
+		}
+
+		// the archived storage is empty, so a stored entry was already restored
+		key := common.HexToHash(entry.State.Key)
+		if len(k.GetFastState(ctx, addr, key)) != 0 {
+			continue
+		}
+		k.SetState(ctx, addr, key, common.HexToHash(entry.State.Value).Bytes())
+		archived.Restored++
+	}
+
+	if archived.Restored < archived.Entries {
+		k.SetArchivedStorage(ctx, addr, archived)
+		return archived.Entries - archived.Restored, nil
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.ArchivedStorageKey(addr))
+	// the restored storage doesn't expire before the expiry blocks
+	k.recordStorageAccesses(ctx, []common.Address{addr})
+	return 0, nil
+}
+
+// recordStorageAccesses records the current height as the last access to the
+// storage of the given accounts, if the storage expiry is enabled.
+func (k *Keeper) recordStorageAccesses(ctx sdk.Context, addrs []common.Address) {
+	if len(addrs) == 0 || k.GetBlockParams(ctx).StorageExpiryBlocks == 0 {
+		return
+	}
+
+	height := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())) //#nosec G115 -- block height is never negative
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range addrs {
+		store.Set(types.ContractAccessHeightKey(addr), height)
+	}
+}
+
+// archivedStorageErr returns an error if the storage of one of the given
+// accounts is archived, in which case it was read as empty.
+func (k *Keeper) archivedStorageErr(ctx sdk.Context, addrs []common.Address) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range addrs {
+		if store.Has(types.ArchivedStorageKey(addr)) {
+			return errorsmod.Wrapf(types.ErrContractStorageArchived, "contract %s", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// deleteStorageExpiry deletes the recorded access and the archived storage
+// record of the contract.
+func (k *Keeper) deleteStorageExpiry(ctx sdk.Context, addr common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ContractAccessHeightKey(addr))
+	store.Delete(types.ArchivedStorageKey(addr))
+}
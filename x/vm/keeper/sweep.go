@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SweepEmptyAccounts scans at most batch accounts of the x/auth store, in the
+// order of their addresses, from the account following the last one scanned by
+// the previous sweep, and removes the empty ones, as EIP-158 does. The sweep
+// starts over from the first account once the last one is scanned. It returns
+// the addresses of the removed accounts.
+func (k *Keeper) SweepEmptyAccounts(ctx sdk.Context, batch uint64) []common.Address {
+	// the x/auth store is iterated directly, as the account keeper can't
+	// resume an iteration from an address
+	authKey, ok := k.storeKeys[authtypes.StoreKey]
+	if !ok || batch == 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var start []byte
+	if cursor := store.Get(types.KeyEmptyAccountSweepCursor); cursor != nil {
+		// the iteration resumes right after the last scanned account
+		start = append(bytes.Clone(cursor), 0)
+	}
+
+	accounts := prefix.NewStore(ctx.KVStore(authKey), authtypes.AddressStoreKeyPrefix)
+	iterator := accounts.Iterator(start, nil)
+
+	var (
+		scanned uint64
+		lastKey []byte
+		empty   []sdk.AccountI
+	)
+	for ; iterator.Valid() && scanned < batch; iterator.Next() {
+		scanned++
+		lastKey = bytes.Clone(iterator.Key())
+
+		_, addr, err := sdk.AccAddressKey.Decode(lastKey)
+		if err != nil {
+			continue
+		}
+		if acc := k.accountKeeper.GetAccount(ctx, addr); acc != nil && k.isEmptyAccount(ctx, acc) {
+			empty = append(empty, acc)
+		}
+	}
+	exhausted := !iterator.Valid()
+	iterator.Close()
+
+	if exhausted {
+		store.Delete(types.KeyEmptyAccountSweepCursor)
+	} else {
+		store.Set(types.KeyEmptyAccountSweepCursor, lastKey)
+	}
+
+	// the accounts are removed once the iteration over the store is closed
+	removed := make([]common.Address, 0, len(empty))
+	for _, acc := range empty {
+		k.accountKeeper.RemoveAccount(ctx, acc)
+		removed = append(removed, common.BytesToAddress(acc.GetAddress()))
+	}
+	return removed
+}
+
+// isEmptyAccount returns true if the account is a base account which has never
+// sent a tx, has no code, no balance of any denom and no staking state. The
+// module and vesting accounts are never empty.
+func (k *Keeper) isEmptyAccount(ctx sdk.Context, acc sdk.AccountI) bool {
+	if _, ok := acc.(*authtypes.BaseAccount); !ok {
+		return false
+	}
+	if acc.GetSequence() != 0 || acc.GetPubKey() != nil {
+		return false
+	}
+
+	addr := common.BytesToAddress(acc.GetAddress())
+	if k.IsContract(ctx, addr) {
+		return false
+	}
+	// the evm balance includes the fractional balance of the extended denom
+	if balance := k.GetBalance(ctx, addr); balance == nil || !balance.IsZero() {
+		return false
+	}
+
+	hasCoins := false
+	k.bankWrapper.IterateAccountBalances(ctx, acc.GetAddress(), func(sdk.Coin) bool {
+		hasCoins = true
+		return true
+	})
+	return !hasCoins && !k.hasStakingState(ctx, acc.GetAddress())
+}
+
+// hasStakingState returns true if the account is a validator operator, or has
+// delegations, unbonding delegations or redelegations. Their tokens are held by
+// the staking module, so an account whose whole stake is bonded or unbonding
+// has no balance but isn't empty. Any error of the staking keeper is treated as
+// staking state, so that an account is only removed once it's known to be empty.
+func (k *Keeper) hasStakingState(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if _, err := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(addr)); !errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return true
+	}
+	if delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, addr, 1); err != nil || len(delegations) > 0 {
+		return true
+	}
+	if ubds, err := k.stakingKeeper.GetUnbondingDelegations(ctx, addr, 1); err != nil || len(ubds) > 0 {
+		return true
+	}
+	redelegations, err := k.stakingKeeper.GetRedelegations(ctx, addr, 1)
+	return err != nil || len(redelegations) > 0
+}
@@ -0,0 +1,203 @@
+package legacy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// field numbers of the ethermint.evm.v1.Params
+const (
+	ethermintEVMDenomField            protowire.Number = 1
+	ethermintEnableCreateField        protowire.Number = 2
+	ethermintEnableCallField          protowire.Number = 3
+	ethermintExtraEIPsField           protowire.Number = 4
+	ethermintAllowUnprotectedTxsField protowire.Number = 6
+)
+
+// evmosExtraEIPsField is the field number of the named EIPs of the
+// evmos.evm.v1.Params, the other fields are the ones of the current params.
+const evmosExtraEIPsField protowire.Number = 4
+
+// field numbers of the ethermint.types.v1.EthAccount
+const (
+	ethAccountBaseAccountField protowire.Number = 1
+	ethAccountCodeHashField    protowire.Number = 2
+)
+
+// decodeEthermintParams decodes the ethermint.evm.v1.Params. The create and
+// call flags are converted into the access control, as disabled flags restrict
+// the creates and calls to nobody, and the chain config is dropped, as it's set
+// by the app.
+func decodeEthermintParams(bz []byte) (types.Params, error) {
+	params := types.Params{
+		AccessControl: types.AccessControl{
+			Create: types.AccessControlType{AccessType: types.AccessTypeRestricted},
+			Call:   types.AccessControlType{AccessType: types.AccessTypeRestricted},
+		},
+	}
+
+	err := rangeFields(bz, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch num {
+		case ethermintEVMDenomField:
+			denom, n := protowire.ConsumeString(value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			params.EvmDenom = denom
+		case ethermintEnableCreateField, ethermintEnableCallField, ethermintAllowUnprotectedTxsField:
+			v, n := protowire.ConsumeVarint(value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			enabled := protowire.DecodeBool(v)
+			switch num {
+			case ethermintEnableCreateField:
+				if enabled {
+					params.AccessControl.Create.AccessType = types.AccessTypePermissionless
+				}
+			case ethermintEnableCallField:
+				if enabled {
+					params.AccessControl.Call.AccessType = types.AccessTypePermissionless
+				}
+			default:
+				params.AllowUnprotectedTxs = enabled
+			}
+		case ethermintExtraEIPsField:
+			eips, err := decodeInt64s(typ, value)
+			if err != nil {
+				return err
+			}
+			params.ExtraEIPs = append(params.ExtraEIPs, eips...)
+		}
+		return nil
+	})
+	return params, err
+}
+
+// decodeEvmosParams decodes the evmos.evm.v1.Params, whose named EIPs are
+// converted into their number, as the other fields are the ones of the current
+// params.
+func decodeEvmosParams(cdc codec.BinaryCodec, bz []byte) (types.Params, error) {
+	var (
+		fields []byte
+		eips   []int64
+	)
+	err := rangeFields(bz, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if num != evmosExtraEIPsField {
+			fields = append(protowire.AppendTag(fields, num, typ), value...)
+			return nil
+		}
+
+		name, n := protowire.ConsumeString(value)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		eip, err := parseEIPName(name)
+		if err != nil {
+			return err
+		}
+		eips = append(eips, eip)
+		return nil
+	})
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(fields, &params); err != nil {
+		return types.Params{}, err
+	}
+	params.ExtraEIPs = eips
+	return params, nil
+}
+
+// decodeEthAccount decodes the ethermint.types.v1.EthAccount into its encoded
+// base account and its code hash.
+func decodeEthAccount(bz []byte) (baseAccount []byte, codeHash common.Hash, err error) {
+	err = rangeFields(bz, func(num protowire.Number, _ protowire.Type, value []byte) error {
+		switch num {
+		case ethAccountBaseAccountField:
+			v, n := protowire.ConsumeBytes(value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			baseAccount = v
+		case ethAccountCodeHashField:
+			v, n := protowire.ConsumeString(value)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			codeHash = common.HexToHash(v)
+		}
+		return nil
+	})
+	return baseAccount, codeHash, err
+}
+
+// parseEIPName returns the number of an EIP named by the Evmos chains, e.g.
+// 3855 for "ethereum_3855" and 1 for "evmos_1".
+func parseEIPName(name string) (int64, error) {
+	i := strings.LastIndex(name, "_")
+	if i < 0 {
+		return 0, fmt.Errorf("invalid EIP name %s", name)
+	}
+	eip, err := strconv.ParseInt(name[i+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid EIP name %s: %w", name, err)
+	}
+	return eip, nil
+}
+
+// decodeInt64s decodes the value of a repeated int64 field, packed or not.
+func decodeInt64s(typ protowire.Type, value []byte) ([]int64, error) {
+	if typ == protowire.VarintType {
+		v, n := protowire.ConsumeVarint(value)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		return []int64{int64(v)}, nil //#nosec G115 -- int64 fields are encoded as their two's complement
+	}
+
+	packed, n := protowire.ConsumeBytes(value)
+	if n < 0 {
+		return nil, protowire.ParseError(n)
+	}
+	var values []int64
+	for len(packed) > 0 {
+		v, n := protowire.ConsumeVarint(packed)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		values = append(values, int64(v)) //#nosec G115 -- int64 fields are encoded as their two's complement
+		packed = packed[n:]
+	}
+	return values, nil
+}
+
+// rangeFields calls fn with the number, the wire type and the encoded value of
+// each field of the encoded message.
+func rangeFields(bz []byte, fn func(num protowire.Number, typ protowire.Type, value []byte) error) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		if m < 0 {
+			return protowire.ParseError(m)
+		}
+		if err := fn(num, typ, bz[n:n+m]); err != nil {
+			return err
+		}
+		bz = bz[n+m:]
+	}
+	return nil
+}
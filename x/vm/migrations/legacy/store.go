@@ -0,0 +1,153 @@
+// Package legacy converts the evm store of the Ethermint and Evmos chains into
+// the store of the evm module, so that these chains can adopt the module in
+// place with an upgrade.
+//
+// The contract code and storage are kept as they are, as their layout didn't
+// change. The params are converted from their legacy encoding, and the code
+// hashes of the Ethermint accounts are moved from the accounts of x/auth to the
+// evm store.
+package legacy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Layout is the layout of the evm store of a legacy chain.
+type Layout int
+
+const (
+	// LayoutEthermint is the layout of the Ethermint chains, whose params are
+	// the ethermint.evm.v1.Params, with the create and call flags, the chain
+	// config and the EIPs as numbers.
+	LayoutEthermint Layout = iota + 1
+	// LayoutEvmos is the layout of the Evmos chains since v20, whose params only
+	// differ from the current ones by their EIPs, named as "ethereum_3855".
+	LayoutEvmos
+)
+
+const (
+	// ethAccountTypeURL is the type URL of the Ethermint accounts, which carry
+	// the code hash of the account along the base account
+	ethAccountTypeURL = "/ethermint.types.v1.EthAccount"
+	// baseAccountTypeURL is the type URL of the base accounts of x/auth
+	baseAccountTypeURL = "/cosmos.auth.v1beta1.BaseAccount"
+)
+
+// emptyCodeHash is the code hash of the accounts without code, which isn't
+// stored.
+var emptyCodeHash = crypto.Keccak256Hash(nil)
+
+// MigrateStore converts the evm store of a legacy chain with the given layout
+// into the store of the evm module at consensus version 1, and replaces the
+// Ethermint accounts of the x/auth store by base accounts, storing their code
+// hashes in the evm store.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey, authStoreKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	layout Layout,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	var (
+		params types.Params
+		err    error
+	)
+	bz := store.Get(types.KeyPrefixParams)
+	if bz == nil {
+		return errors.New("no legacy evm params")
+	}
+	switch layout {
+	case LayoutEthermint:
+		params, err = decodeEthermintParams(bz)
+	case LayoutEvmos:
+		params, err = decodeEvmosParams(cdc, bz)
+	default:
+		return fmt.Errorf("unknown legacy evm store layout %d", layout)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode the legacy evm params: %w", err)
+	}
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid legacy evm params: %w", err)
+	}
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyPrefixParams, bz)
+
+	return migrateEthAccounts(ctx.KVStore(authStoreKey), store)
+}
+
+// ethAccount is an Ethermint account of the x/auth store.
+type ethAccount struct {
+	key         []byte
+	baseAccount []byte
+	codeHash    common.Hash
+}
+
+// migrateEthAccounts replaces the Ethermint accounts of the x/auth store by
+// their base account, and stores their code hash in the evm store.
+func migrateEthAccounts(authStore, store storetypes.KVStore) error {
+	accounts, err := ethAccounts(authStore)
+	if err != nil {
+		return err
+	}
+
+	prefixLen := len(authtypes.AddressStoreKeyPrefix.Bytes())
+	for _, account := range accounts {
+		bz, err := (&codectypes.Any{TypeUrl: baseAccountTypeURL, Value: account.baseAccount}).Marshal()
+		if err != nil {
+			return err
+		}
+		authStore.Set(account.key, bz)
+
+		if account.codeHash == (common.Hash{}) || account.codeHash == emptyCodeHash {
+			continue
+		}
+		addr := common.BytesToAddress(account.key[prefixLen:])
+		store.Set(append(types.KeyPrefixCodeHash, addr.Bytes()...), account.codeHash.Bytes())
+	}
+	return nil
+}
+
+// ethAccounts returns the Ethermint accounts of the x/auth store. They're
+// collected before the accounts are replaced, as the store can't be written
+// while it's iterated.
+func ethAccounts(authStore storetypes.KVStore) ([]ethAccount, error) {
+	iterator := storetypes.KVStorePrefixIterator(authStore, authtypes.AddressStoreKeyPrefix.Bytes())
+	defer iterator.Close()
+
+	var accounts []ethAccount
+	for ; iterator.Valid(); iterator.Next() {
+		var account codectypes.Any
+		if err := account.Unmarshal(iterator.Value()); err != nil {
+			return nil, fmt.Errorf("failed to decode account %X: %w", iterator.Key(), err)
+		}
+		if account.TypeUrl != ethAccountTypeURL {
+			continue
+		}
+
+		baseAccount, codeHash, err := decodeEthAccount(account.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode Ethermint account %X: %w", iterator.Key(), err)
+		}
+		accounts = append(accounts, ethAccount{key: iterator.Key(), baseAccount: baseAccount, codeHash: codeHash})
+	}
+	return accounts, nil
+}
@@ -0,0 +1,152 @@
+package legacy_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/evm/x/vm/migrations/legacy"
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func setup(t *testing.T) (sdk.Context, *storetypes.KVStoreKey, *storetypes.KVStoreKey, codec.Codec) {
+	t.Helper()
+
+	keys := storetypes.NewKVStoreKeys(types.StoreKey, authtypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil)
+	return ctx, keys[types.StoreKey], keys[authtypes.StoreKey], moduletestutil.MakeTestEncodingConfig().Codec
+}
+
+func appendString(bz []byte, num protowire.Number, v string) []byte {
+	return protowire.AppendString(protowire.AppendTag(bz, num, protowire.BytesType), v)
+}
+
+func appendBool(bz []byte, num protowire.Number, v bool) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(bz, num, protowire.VarintType), protowire.EncodeBool(v))
+}
+
+func TestMigrateStoreEthermint(t *testing.T) {
+	ctx, storeKey, authStoreKey, cdc := setup(t)
+
+	// ethermint.evm.v1.Params with packed EIPs and a chain config
+	var packedEIPs []byte
+	packedEIPs = protowire.AppendVarint(packedEIPs, 3855)
+	packedEIPs = protowire.AppendVarint(packedEIPs, 2200)
+	var bz []byte
+	bz = appendString(bz, 1, "aphoton")
+	bz = appendBool(bz, 2, true)
+	bz = protowire.AppendBytes(protowire.AppendTag(bz, 4, protowire.BytesType), packedEIPs)
+	bz = protowire.AppendBytes(protowire.AppendTag(bz, 5, protowire.BytesType), appendString(nil, 1, "0"))
+	bz = appendBool(bz, 6, true)
+	ctx.KVStore(storeKey).Set(types.KeyPrefixParams, bz)
+
+	require.NoError(t, legacy.MigrateStore(ctx, storeKey, authStoreKey, cdc, legacy.LayoutEthermint))
+
+	var params types.Params
+	cdc.MustUnmarshal(ctx.KVStore(storeKey).Get(types.KeyPrefixParams), &params)
+	require.Equal(t, "aphoton", params.EvmDenom)
+	require.Equal(t, []int64{3855, 2200}, params.ExtraEIPs)
+	require.True(t, params.AllowUnprotectedTxs)
+	require.Equal(t, types.AccessTypePermissionless, params.AccessControl.Create.AccessType)
+	require.Equal(t, types.AccessTypeRestricted, params.AccessControl.Call.AccessType)
+}
+
+func TestMigrateStoreEvmos(t *testing.T) {
+	ctx, storeKey, authStoreKey, cdc := setup(t)
+
+	// evmos.evm.v1.Params are the current params with named EIPs
+	want := types.DefaultParams()
+	want.ExtraEIPs = nil
+	want.EVMChannels = []string{"channel-0"}
+	bz := cdc.MustMarshal(&want)
+	bz = appendString(bz, 4, "ethereum_3855")
+	bz = appendString(bz, 4, "ethereum_2200")
+	ctx.KVStore(storeKey).Set(types.KeyPrefixParams, bz)
+
+	require.NoError(t, legacy.MigrateStore(ctx, storeKey, authStoreKey, cdc, legacy.LayoutEvmos))
+
+	var params types.Params
+	cdc.MustUnmarshal(ctx.KVStore(storeKey).Get(types.KeyPrefixParams), &params)
+	want.ExtraEIPs = []int64{3855, 2200}
+	require.Equal(t, want, params)
+
+	// the EIPs must be named by their number
+	want.ExtraEIPs = nil
+	ctx.KVStore(storeKey).Set(types.KeyPrefixParams, appendString(cdc.MustMarshal(&want), 4, "ethereum"))
+	require.ErrorContains(t, legacy.MigrateStore(ctx, storeKey, authStoreKey, cdc, legacy.LayoutEvmos), "invalid EIP name ethereum")
+}
+
+func TestMigrateStoreEthAccounts(t *testing.T) {
+	ctx, storeKey, authStoreKey, cdc := setup(t)
+	params := types.DefaultParams()
+	ctx.KVStore(storeKey).Set(types.KeyPrefixParams, cdc.MustMarshal(&params))
+
+	authStore := ctx.KVStore(authStoreKey)
+	setAccount := func(addr common.Address, typeURL string, value []byte) []byte {
+		key := append(authtypes.AddressStoreKeyPrefix.Bytes(), addr.Bytes()...)
+		bz, err := (&codectypes.Any{TypeUrl: typeURL, Value: value}).Marshal()
+		require.NoError(t, err)
+		authStore.Set(key, bz)
+		return key
+	}
+	baseAccount := func(addr common.Address, number uint64) []byte {
+		bz, err := authtypes.NewBaseAccount(addr.Bytes(), nil, number, 0).Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+	ethAccount := func(addr common.Address, number uint64, codeHash common.Hash) []byte {
+		bz := protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), baseAccount(addr, number))
+		return appendString(bz, 2, codeHash.Hex())
+	}
+
+	contract := common.HexToAddress("0x01")
+	eoa := common.HexToAddress("0x02")
+	base := common.HexToAddress("0x03")
+	codeHash := crypto.Keccak256Hash([]byte{0x60})
+	contractKey := setAccount(contract, "/ethermint.types.v1.EthAccount", ethAccount(contract, 1, codeHash))
+	eoaKey := setAccount(eoa, "/ethermint.types.v1.EthAccount", ethAccount(eoa, 2, crypto.Keccak256Hash(nil)))
+	baseKey := setAccount(base, "/cosmos.auth.v1beta1.BaseAccount", baseAccount(base, 3))
+	baseBz := authStore.Get(baseKey)
+
+	require.NoError(t, legacy.MigrateStore(ctx, storeKey, authStoreKey, cdc, legacy.LayoutEvmos))
+
+	// the Ethermint accounts are replaced by their base account
+	for addr, key := range map[common.Address][]byte{contract: contractKey, eoa: eoaKey} {
+		var account codectypes.Any
+		require.NoError(t, account.Unmarshal(authStore.Get(key)))
+		require.Equal(t, "/cosmos.auth.v1beta1.BaseAccount", account.TypeUrl)
+		var decoded authtypes.BaseAccount
+		require.NoError(t, decoded.Unmarshal(account.Value))
+		require.Equal(t, sdk.AccAddress(addr.Bytes()).String(), decoded.Address)
+	}
+	require.Equal(t, baseBz, authStore.Get(baseKey))
+
+	// and only the code hash of the contract is stored
+	store := ctx.KVStore(storeKey)
+	require.Equal(t, codeHash.Bytes(), store.Get(append(types.KeyPrefixCodeHash, contract.Bytes()...)))
+	require.False(t, store.Has(append(types.KeyPrefixCodeHash, eoa.Bytes()...)))
+}
+
+func TestMigrateStoreInvalid(t *testing.T) {
+	ctx, storeKey, authStoreKey, cdc := setup(t)
+	require.ErrorContains(t, legacy.MigrateStore(ctx, storeKey, authStoreKey, cdc, legacy.LayoutEvmos), "no legacy evm params")
+
+	// an unpacked EIP which isn't activateable
+	bz := appendString(nil, 1, "aphoton")
+	bz = protowire.AppendVarint(protowire.AppendTag(bz, 4, protowire.VarintType), 1)
+	ctx.KVStore(storeKey).Set(types.KeyPrefixParams, bz)
+	require.ErrorContains(t, legacy.MigrateStore(ctx, storeKey, authStoreKey, cdc, legacy.Layout(0)), "unknown legacy evm store layout")
+	require.ErrorContains(t, legacy.MigrateStore(ctx, storeKey, authStoreKey, cdc, legacy.LayoutEthermint), "invalid legacy evm params")
+}
@@ -0,0 +1,95 @@
+// Package v2 migrates the store of the evm module from consensus version 1 to 2.
+package v2
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore migrates the evm store from version 1 to 2:
+//   - the addresses of the params are checksummed, and the active static
+//     precompiles are deduplicated and sorted, as the precompiles are looked up
+//     by their checksummed address
+//   - if the storage expiry is enabled, the access height of the contracts
+//     without a recorded access is set to the height of the upgrade, so that
+//     their storage can expire
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if bz := store.Get(types.KeyPrefixParams); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	params.ActiveStaticPrecompiles = checksumAddresses(params.ActiveStaticPrecompiles)
+	slices.Sort(params.ActiveStaticPrecompiles)
+	params.UnprotectedTxsAllowlist = checksumAddresses(params.UnprotectedTxsAllowlist)
+	params.AccessControl.Create.AccessControlList = checksumAddresses(params.AccessControl.Create.AccessControlList)
+	params.AccessControl.Call.AccessControlList = checksumAddresses(params.AccessControl.Call.AccessControlList)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyPrefixParams, bz)
+
+	if params.StorageExpiryBlocks == 0 {
+		return nil
+	}
+
+	height := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())) //#nosec G115 -- block height is never negative
+	for _, addr := range unrecordedContracts(store) {
+		store.Set(types.ContractAccessHeightKey(addr), height)
+	}
+	return nil
+}
+
+// unrecordedContracts returns the contracts whose storage isn't archived and
+// whose access isn't recorded. They're collected before the access heights are
+// set, as the store can't be written while it's iterated.
+func unrecordedContracts(store storetypes.KVStore) []common.Address {
+	var contracts []common.Address
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixCodeHash)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := common.BytesToAddress(iterator.Key()[len(types.KeyPrefixCodeHash):])
+		if store.Has(types.ContractAccessHeightKey(addr)) || store.Has(types.ArchivedStorageKey(addr)) {
+			continue
+		}
+		contracts = append(contracts, addr)
+	}
+	return contracts
+}
+
+// checksumAddresses returns the deduplicated checksummed addresses, in their
+// order. The invalid addresses are kept as is, to fail the validation.
+func checksumAddresses(addresses []string) []string {
+	if len(addresses) == 0 {
+		return addresses
+	}
+
+	checksummed := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if common.IsHexAddress(address) {
+			address = common.HexToAddress(address).Hex()
+		}
+		if !slices.Contains(checksummed, address) {
+			checksummed = append(checksummed, address)
+		}
+	}
+	return checksummed
+}
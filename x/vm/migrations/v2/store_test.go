@@ -0,0 +1,71 @@
+package v2_test
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/cosmos/evm/x/vm/migrations/v2"
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test")).WithBlockHeight(100)
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	precompile := common.HexToAddress("0xAbCdEf0123456789aBcDeF0123456789AbCdEf01")
+	params.ActiveStaticPrecompiles = []string{
+		strings.ToLower(precompile.Hex()),
+		types.StakingPrecompileAddress,
+		precompile.Hex(),
+	}
+	allowed := common.HexToAddress("0x0123456789aBcDeF0123456789AbCdEf01aBcDeF")
+	params.UnprotectedTxsAllowlist = []string{strings.ToLower(allowed.Hex())}
+	params.StorageExpiryBlocks = 1_000
+	store.Set(types.KeyPrefixParams, cdc.MustMarshal(&params))
+
+	unrecorded := common.HexToAddress("0x01")
+	recorded := common.HexToAddress("0x02")
+	archived := common.HexToAddress("0x03")
+	for _, addr := range []common.Address{unrecorded, recorded, archived} {
+		store.Set(append(types.KeyPrefixCodeHash, addr.Bytes()...), common.Hash{1}.Bytes())
+	}
+	store.Set(types.ContractAccessHeightKey(recorded), binary.BigEndian.AppendUint64(nil, 10))
+	store.Set(types.ArchivedStorageKey(archived), common.Hash{2}.Bytes())
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	var migrated types.Params
+	cdc.MustUnmarshal(store.Get(types.KeyPrefixParams), &migrated)
+	require.Equal(t, []string{types.StakingPrecompileAddress, precompile.Hex()}, migrated.ActiveStaticPrecompiles)
+	require.Equal(t, []string{allowed.Hex()}, migrated.UnprotectedTxsAllowlist)
+	require.NoError(t, migrated.Validate())
+
+	// only the access of the contracts not recorded nor archived is set
+	require.Equal(t, binary.BigEndian.AppendUint64(nil, 100), store.Get(types.ContractAccessHeightKey(unrecorded)))
+	require.Equal(t, binary.BigEndian.AppendUint64(nil, 10), store.Get(types.ContractAccessHeightKey(recorded)))
+	require.False(t, store.Has(types.ContractAccessHeightKey(archived)))
+}
+
+func TestMigrateStoreInvalidParams(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	params := types.DefaultParams()
+	params.ActiveStaticPrecompiles = []string{"not an address"}
+	ctx.KVStore(storeKey).Set(types.KeyPrefixParams, cdc.MustMarshal(&params))
+
+	require.ErrorContains(t, v2.MigrateStore(ctx, storeKey, cdc), "invalid precompile")
+}
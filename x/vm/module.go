@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/evm/x/vm/client/cli"
 	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/simulation"
 	"github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/core/address"
@@ -27,14 +28,19 @@ import (
 )
 
 // consensusVersion defines the current x/evm module consensus version.
-const consensusVersion = 1
+const consensusVersion = 2
 
 var (
 	_ module.AppModuleBasic = AppModuleBasic{}
 	_ module.HasABCIGenesis = AppModule{}
+	_ module.HasInvariants  = AppModule{}
 
-	_ appmodule.HasBeginBlocker = AppModule{}
-	_ appmodule.HasEndBlocker   = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
+
+	_ appmodule.HasBeginBlocker      = AppModule{}
+	_ appmodule.HasEndBlocker        = AppModule{}
+	_ appmodule.HasPrepareCheckState = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the evm module.
@@ -127,6 +133,11 @@ func (AppModule) Name() string {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 1 to 2: %w", types.ModuleName, err))
+	}
 }
 
 // BeginBlock returns the begin blocker for the evm module.
@@ -142,6 +153,13 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	return am.keeper.EndBlock(c)
 }
 
+// PrepareCheckState reloads the EVM forks activated by the upgrades from the
+// committed state, so that the CheckTx and the queries follow the last commit.
+func (am AppModule) PrepareCheckState(ctx context.Context) error {
+	c := sdk.UnwrapSDKContext(ctx)
+	return am.keeper.LoadForkActivations(c)
+}
+
 // InitGenesis performs genesis initialization for the evm module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
@@ -163,12 +181,26 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {
 }
 
 // GenerateGenesisState creates a randomized GenState of the evm module.
-func (AppModule) GenerateGenesisState(_ *module.SimulationState) {
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns the msgs of the evm module used for the governance
+// proposals of the simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.keeper)
 }
 
 // WeightedOperations returns the all the evm module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.ak, am.keeper)
+}
+
+// RegisterInvariants registers the invariants of the evm module, checking that
+// the evm balances match the supply of the evm denom and that the code of the
+// contracts is stored.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // IsAppModule implements the appmodule.AppModule interface.
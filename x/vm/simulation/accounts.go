@@ -0,0 +1,50 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// RandomAccounts generates n random accounts of the simulation with eth_secp256k1
+// keys, whose addresses are the ones of the Ethereum accounts, so that they can
+// sign the Ethereum txs of the operations of the evm module. It's the random
+// account function of the simulations of the apps with the evm module, instead
+// of simtypes.RandomAccounts.
+func RandomAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accs := make([]simtypes.Account, 0, n)
+	idx := make(map[string]struct{}, n)
+	for len(accs) < n {
+		// don't need that much entropy for simulation
+		seed := make([]byte, 15)
+		if _, err := r.Read(seed); err != nil {
+			panic(err)
+		}
+		key, err := crypto.ToECDSA(crypto.Keccak256(seed))
+		if err != nil {
+			continue
+		}
+
+		privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(key)}
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+		if _, exists := idx[string(addr)]; exists {
+			continue
+		}
+		idx[string(addr)] = struct{}{}
+
+		accs = append(accs, simtypes.Account{
+			PrivKey:       privKey,
+			PubKey:        privKey.PubKey(),
+			Address:       addr,
+			ConsKey:       ed25519.GenPrivKeyFromSecret(seed),
+			AddressBech32: addr.String(),
+		})
+	}
+	return accs
+}
@@ -0,0 +1,36 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// RandomizedGenState generates a random genesis state of the evm module, from
+// the genesis state of the app if it's set, e.g. with its preinstalls, or from
+// the default one.
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.DefaultGenesisState()
+	if bz, ok := simState.GenState[types.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, genesis)
+	}
+	genesis.Params = randomParams(simState.Rand, genesis.Params)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
+
+// randomParams returns the given params with random values of the params the
+// simulation doesn't depend on. The calls and creates stay permissionless, as
+// the operations of the simulation send them from random accounts, and the
+// empty accounts aren't swept, as the operations of the other modules expect
+// the accounts of the simulation to exist.
+func randomParams(r *rand.Rand, params types.Params) types.Params {
+	params.AllowUnprotectedTxs = r.Intn(2) == 0
+	params.StorageExpiryBlocks = 0
+	if r.Intn(2) == 0 {
+		params.StorageExpiryBlocks = uint64(r.Intn(1000) + 1) //#nosec G115 -- positive
+	}
+	return params
+}
@@ -0,0 +1,259 @@
+package simulation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgEthSimpleTransfer = "op_weight_msg_eth_simple_transfer" //#nosec G101 -- not a credential
+	OpWeightMsgEthCreateContract = "op_weight_msg_eth_create_contract" //#nosec G101 -- not a credential
+
+	DefaultWeightMsgEthSimpleTransfer = 100
+	DefaultWeightMsgEthCreateContract = 20
+)
+
+// msgType is the type of the msgs of the operations
+var msgType = sdk.MsgTypeURL(&types.MsgEthereumTx{})
+
+// estimateGasCap is the cap on the gas of the contract deployments estimated by
+// the operations.
+const estimateGasCap uint64 = 25_000_000
+
+// WeightedOperations returns all the operations of the evm module with their
+// respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txConfig client.TxConfig,
+	ak types.AccountKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgEthSimpleTransfer, weightMsgEthCreateContract int
+	appParams.GetOrGenerate(OpWeightMsgEthSimpleTransfer, &weightMsgEthSimpleTransfer, nil, func(_ *rand.Rand) {
+		weightMsgEthSimpleTransfer = DefaultWeightMsgEthSimpleTransfer
+	})
+	appParams.GetOrGenerate(OpWeightMsgEthCreateContract, &weightMsgEthCreateContract, nil, func(_ *rand.Rand) {
+		weightMsgEthCreateContract = DefaultWeightMsgEthCreateContract
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgEthSimpleTransfer,
+			SimulateEthSimpleTransfer(txConfig, ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEthCreateContract,
+			SimulateEthCreateContract(txConfig, ak, k),
+		),
+	}
+}
+
+// SimulateEthSimpleTransfer simulates an Ethereum tx transferring a random
+// amount of the evm denom between two random accounts.
+func SimulateEthSimpleTransfer(txConfig client.TxConfig, ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		recipient := common.BytesToAddress(to.Address)
+
+		gasPrice := randomGasPrice(r, ctx, k)
+		spendable, skip := spendableBalance(ctx, ak, k, from, gasPrice, params.TxGas)
+		if skip != "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, skip), nil, nil
+		}
+		amount := simtypes.RandomAmount(r, spendable)
+
+		return deliverEthTx(r, app, ctx, txConfig, k, from, &recipient, amount.BigInt(), nil, params.TxGas, gasPrice)
+	}
+}
+
+// SimulateEthCreateContract simulates an Ethereum tx deploying an ERC20 contract
+// from a random account, with the gas estimated by the keeper.
+func SimulateEthCreateContract(txConfig client.TxConfig, ak types.AccountKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		sender := common.BytesToAddress(from.Address)
+
+		data, err := deployData(r)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack the deployment"), nil, err
+		}
+
+		input := hexutil.Bytes(data)
+		args, err := json.Marshal(&types.TransactionArgs{From: &sender, Data: &input})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to marshal the tx args"), nil, err
+		}
+		res, err := k.EstimateGasInternal(ctx, &types.EthCallRequest{
+			Args:    args,
+			GasCap:  estimateGasCap,
+			ChainId: types.GetEthChainConfig().ChainID.Int64(),
+		}, types.Internal)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to estimate the gas"), nil, err
+		}
+
+		gasPrice := randomGasPrice(r, ctx, k)
+		if _, skip := spendableBalance(ctx, ak, k, from, gasPrice, res.Gas); skip != "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, skip), nil, nil
+		}
+
+		return deliverEthTx(r, app, ctx, txConfig, k, from, nil, common.Big0, data, res.Gas, gasPrice)
+	}
+}
+
+// deployData returns the data of the deployment of an ERC20 contract with a
+// random name, symbol and decimals.
+func deployData(r *rand.Rand) ([]byte, error) {
+	contract := contracts.ERC20MinterBurnerDecimalsContract
+	symbol := simtypes.RandStringOfLength(r, 4)
+	args, err := contract.ABI.Pack("", "Simulation "+symbol, symbol, uint8(r.Intn(19))) //#nosec G115 -- lower than 19
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, len(contract.Bin)+len(args))
+	data = append(data, contract.Bin...)
+	return append(data, args...), nil
+}
+
+// randomGasPrice returns a gas price, in the evm denom with 18 decimals, paying
+// the base fee and the min gas price, with a random tip of up to 100%.
+func randomGasPrice(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper) *big.Int {
+	gasPrice := k.GetMinGasPrice(ctx).Ceil().TruncateInt().BigInt()
+	if baseFee := k.GetBaseFee(ctx); baseFee != nil && baseFee.Cmp(gasPrice) > 0 {
+		gasPrice = baseFee
+	}
+	if gasPrice.Sign() == 0 {
+		return gasPrice
+	}
+	tip := new(big.Int).Rand(r, new(big.Int).Add(gasPrice, common.Big1))
+	return tip.Add(tip, gasPrice)
+}
+
+// spendableBalance returns the balance of the account left once the fee of a tx
+// of the given gas and gas price is paid, or the reason why the account can't
+// send the tx. The vesting accounts are skipped, as their balance may be
+// locked.
+func spendableBalance(
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	k *keeper.Keeper,
+	from simtypes.Account,
+	gasPrice *big.Int,
+	gas uint64,
+) (math.Int, string) {
+	if _, ok := from.PrivKey.(*ethsecp256k1.PrivKey); !ok {
+		return math.Int{}, "account without an eth_secp256k1 key"
+	}
+	if _, ok := ak.GetAccount(ctx, from.Address).(vestingexported.VestingAccount); ok {
+		return math.Int{}, "vesting account"
+	}
+
+	balance := k.GetBalance(ctx, common.BytesToAddress(from.Address))
+	if balance == nil {
+		return math.Int{}, "invalid balance"
+	}
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))
+	spendable := new(big.Int).Sub(balance.ToBig(), fee)
+	if spendable.Sign() <= 0 {
+		return math.Int{}, "insufficient balance"
+	}
+	return math.NewIntFromBigInt(spendable), ""
+}
+
+// deliverEthTx signs an Ethereum tx of the given sender, a legacy or a dynamic
+// fee tx at random, and delivers it. The operation fails if the tx isn't
+// delivered or if its execution fails.
+func deliverEthTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txConfig client.TxConfig,
+	k *keeper.Keeper,
+	from simtypes.Account,
+	to *common.Address,
+	amount *big.Int,
+	data []byte,
+	gas uint64,
+	gasPrice *big.Int,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	chainID := types.GetEthChainConfig().ChainID
+	nonce := k.GetNonce(ctx, common.BytesToAddress(from.Address))
+
+	var txData ethtypes.TxData
+	if r.Intn(2) == 0 {
+		txData = &ethtypes.LegacyTx{Nonce: nonce, GasPrice: gasPrice, Gas: gas, To: to, Value: amount, Data: data}
+	} else {
+		txData = &ethtypes.DynamicFeeTx{
+			ChainID: chainID, Nonce: nonce, GasTipCap: gasPrice, GasFeeCap: gasPrice, Gas: gas, To: to, Value: amount, Data: data,
+		}
+	}
+
+	privKey, ok := from.PrivKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "account without an eth_secp256k1 key"), nil, nil
+	}
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid private key"), nil, err
+	}
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	tx, err := ethtypes.SignNewTx(key, signer, txData)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign the tx"), nil, err
+	}
+
+	msg := new(types.MsgEthereumTx)
+	if err := msg.FromSignedEthereumTx(tx, signer); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to build the msg"), nil, err
+	}
+	sdkTx, err := msg.BuildTx(txConfig.NewTxBuilder(), types.GetEVMCoinDenom())
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to build the tx"), nil, err
+	}
+
+	_, res, err := app.SimDeliver(txConfig.TxEncoder(), sdkTx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+	txRes, err := types.DecodeTxResponse(res.Data)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to decode the tx response"), nil, err
+	}
+	if txRes.Failed() {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "tx execution failed"), nil,
+			errors.New(txRes.VmError)
+	}
+
+	return simtypes.NewOperationMsg(msg, true, fmt.Sprintf("gas used %d", txRes.GasUsed)), nil, nil
+}
@@ -54,6 +54,13 @@ func newAccessList() *accessList {
 	}
 }
 
+// reset clears the access list, keeping the allocated memory.
+func (al *accessList) reset() {
+	clear(al.addresses)
+	clear(al.slots)
+	al.slots = al.slots[:0]
+}
+
 // AddAddress adds an address to the access list, and returns 'true' if the operation
 // caused a change (addr was not previously in the list).
 func (al *accessList) AddAddress(address common.Address) bool {
@@ -27,6 +27,9 @@ type Keeper interface {
 	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
 	// the callback returns false to break early
 	ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool)
+	// iterates the storage in ascending key order from the start key, the
+	// callback returns false to break early
+	ForEachStorageFrom(ctx sdk.Context, addr common.Address, start common.Hash, cb func(key, value common.Hash) bool)
 
 	// Write methods, only called by `StateDB.Commit()`
 	SetAccount(ctx sdk.Context, addr common.Address, account Account) error
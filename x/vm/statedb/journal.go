@@ -51,6 +51,13 @@ func newJournal() *journal {
 	}
 }
 
+// reset clears the journal, keeping the allocated memory.
+func (j *journal) reset() {
+	clear(j.entries)
+	j.entries = j.entries[:0]
+	clear(j.dirties)
+}
+
 // sortedDirties sort the dirty addresses for deterministic iteration
 func (j *journal) sortedDirties() []common.Address {
 	keys := make([]common.Address, 0, len(j.dirties))
@@ -134,6 +141,8 @@ type (
 		prev uint64
 	}
 	addLogChange struct{}
+	// blockedCreditChange is a SELFDESTRUCT crediting a blocked account
+	blockedCreditChange struct{}
 
 	// Changes to the access list
 	accessListAddAccountChange struct {
@@ -164,6 +173,7 @@ var (
 	_ JournalEntry = codeChange{}
 	_ JournalEntry = refundChange{}
 	_ JournalEntry = addLogChange{}
+	_ JournalEntry = blockedCreditChange{}
 	_ JournalEntry = accessListAddAccountChange{}
 	_ JournalEntry = accessListAddSlotChange{}
 	_ JournalEntry = precompileCallChange{}
@@ -271,6 +281,14 @@ func (ch addLogChange) Dirtied() *common.Address {
 	return nil
 }
 
+func (ch blockedCreditChange) Revert(s *StateDB) {
+	s.blockedCredits = s.blockedCredits[:len(s.blockedCredits)-1]
+}
+
+func (ch blockedCreditChange) Dirtied() *common.Address {
+	return nil
+}
+
 func (ch accessListAddAccountChange) Revert(s *StateDB) {
 	/*
 		One important invariant here, is that whenever a (addr, slot) is added, if the
@@ -0,0 +1,51 @@
+package statedb
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// stateDBPool reuses the maps and slices allocated by a StateDB across txs.
+var stateDBPool = sync.Pool{
+	New: func() interface{} {
+		return New(sdk.Context{}, nil, TxConfig{})
+	},
+}
+
+// NewFromPool returns a StateDB from the pool, initialized like the one
+// returned by New. The StateDB can be returned to the pool with Release once
+// neither it nor the EVM that executed on top of it are referenced anymore.
+func NewFromPool(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
+	s := stateDBPool.Get().(*StateDB)
+	s.keeper = keeper
+	s.ctx = ctx
+	s.txConfig = txConfig
+	return s
+}
+
+// Release resets the StateDB and returns it to the pool. The StateDB must not
+// be used after the call.
+func (s *StateDB) Release() {
+	s.keeper = nil
+	s.ctx = sdk.Context{}
+	s.cacheCtx = sdk.Context{}
+	s.writeCache = nil
+	s.snapshotter = nil
+	clear(s.transientStorage)
+	s.journal.reset()
+	s.validRevisions = s.validRevisions[:0]
+	s.nextRevisionID = 0
+	clear(s.stateObjects)
+	s.txConfig = TxConfig{}
+	s.refund = 0
+	// the logs are returned to the caller, so their backing array isn't reused
+	s.logs = nil
+	s.accessList.reset()
+	s.precompileCallsCounter = 0
+	s.blockedAddrs = nil
+	clear(s.blockedCredits)
+	s.blockedCredits = s.blockedCredits[:0]
+
+	stateDBPool.Put(s)
+}
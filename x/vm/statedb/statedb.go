@@ -1,6 +1,7 @@
 package statedb
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"slices"
@@ -18,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/trie/utils"
 	"github.com/holiman/uint256"
 
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/vm/store/snapshotmulti"
 	vmstoretypes "github.com/cosmos/evm/x/vm/store/types"
 	"github.com/cosmos/evm/x/vm/types"
@@ -79,6 +81,11 @@ type StateDB struct {
 
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
+
+	// blockedAddrs are the accounts that can't be credited by a SELFDESTRUCT
+	blockedAddrs map[common.Address]bool
+	// blockedCredits are the blocked accounts credited by a SELFDESTRUCT
+	blockedCredits []common.Address
 }
 
 func (s *StateDB) CreateContract(address common.Address) {
@@ -130,7 +137,11 @@ func (s *StateDB) Finalise(deleteEmptyObjects bool) {
 		}
 		if obj.selfDestructed || (deleteEmptyObjects && obj.empty()) {
 			delete(s.stateObjects, obj.address)
+			continue
 		}
+		// the contracts created by the finalised tx can't be destructed by the
+		// next ones after EIP-6780
+		obj.newContract = false
 	}
 }
 
@@ -221,6 +232,76 @@ func (s *StateDB) Logs() []*ethtypes.Log {
 	return s.logs
 }
 
+// TxWitness returns the accounts and storage slots read and written so far.
+// Slots are reported as read when their committed value was loaded, and as
+// written when their current value differs from the committed one. Accounts
+// that don't exist are not reported unless they are created by the tx.
+func (s *StateDB) TxWitness() *cosmosevmtypes.TxWitness {
+	addrs := make([]common.Address, 0, len(s.stateObjects))
+	for addr := range s.stateObjects {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	witness := &cosmosevmtypes.TxWitness{
+		Reads:  make(ethtypes.AccessList, 0, len(addrs)),
+		Writes: ethtypes.AccessList{},
+	}
+	for _, addr := range addrs {
+		witness.Reads = append(witness.Reads, ethtypes.AccessTuple{
+			Address:     addr,
+			StorageKeys: s.stateObjects[addr].originStorage.SortedKeys(),
+		})
+	}
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		keys := make([]common.Hash, 0, len(obj.dirtyStorage))
+		for _, key := range obj.dirtyStorage.SortedKeys() {
+			if obj.dirtyStorage[key] != obj.originStorage[key] {
+				keys = append(keys, key)
+			}
+		}
+		witness.Writes = append(witness.Writes, ethtypes.AccessTuple{
+			Address:     addr,
+			StorageKeys: keys,
+		})
+	}
+	return witness
+}
+
+// ModifiedAccounts returns the accounts whose balance, nonce, code or storage
+// were changed so far, sorted by address.
+func (s *StateDB) ModifiedAccounts() []common.Address {
+	return s.journal.sortedDirties()
+}
+
+// StorageAccessedAccounts returns the accounts whose committed storage was read
+// or written so far, sorted by address.
+func (s *StateDB) StorageAccessedAccounts() []common.Address {
+	addrs := make([]common.Address, 0, len(s.stateObjects))
+	for addr, obj := range s.stateObjects {
+		if len(obj.originStorage) > 0 {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+	return addrs
+}
+
+// SetBlockedAddrs sets the accounts that can't be credited by a SELFDESTRUCT.
+func (s *StateDB) SetBlockedAddrs(blockedAddrs map[common.Address]bool) {
+	s.blockedAddrs = blockedAddrs
+}
+
+// BlockedCredits returns the blocked accounts credited by a SELFDESTRUCT so far.
+func (s *StateDB) BlockedCredits() []common.Address {
+	return s.blockedCredits
+}
+
 // AddRefund adds gas to the refund counter
 func (s *StateDB) AddRefund(gas uint64) {
 	s.journal.append(refundChange{prev: s.refund})
@@ -387,13 +468,20 @@ func (s *StateDB) CreateAccount(addr common.Address) {
 	}
 }
 
-// ForEachStorage iterate the contract storage, the iteration order is not defined.
+// ForEachStorage iterate the committed contract storage in ascending key
+// order, with the values modified by the pending changes.
 func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.Hash) bool) error {
+	return s.ForEachStorageFrom(addr, common.Hash{}, cb)
+}
+
+// ForEachStorageFrom iterate the committed contract storage in ascending key
+// order from the given key, with the values modified by the pending changes.
+func (s *StateDB) ForEachStorageFrom(addr common.Address, start common.Hash, cb func(key, value common.Hash) bool) error {
 	so := s.getStateObject(addr)
 	if so == nil {
 		return nil
 	}
-	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
+	s.keeper.ForEachStorageFrom(s.ctx, addr, start, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
 		}
@@ -405,6 +493,18 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	return nil
 }
 
+// IterateStorage iterates at most limit entries of the contract storage, as
+// ForEachStorage, starting from the entry of the given pagination token, nil
+// to start from the first entry. It returns the pagination token of the next
+// entry, nil once the storage is exhausted.
+func (s *StateDB) IterateStorage(addr common.Address, pageToken []byte, limit uint64, cb func(key, value common.Hash) bool) ([]byte, error) {
+	forEach := func(start common.Hash, cb func(key, value common.Hash) bool) {
+		// the iteration of the storage never fails
+		_ = s.ForEachStorageFrom(addr, start, cb)
+	}
+	return IterateStoragePage(forEach, pageToken, limit, cb)
+}
+
 func (s *StateDB) setStateObject(object *stateObject) {
 	s.stateObjects[object.Address()] = object
 }
@@ -435,6 +535,10 @@ func (s *StateDB) AddBalance(addr common.Address, amount *uint256.Int, reason tr
 	if stateObject == nil {
 		return uint256.Int{}
 	}
+	if reason == tracing.BalanceIncreaseSelfdestruct && !amount.IsZero() && s.blockedAddrs[addr] {
+		s.journal.append(blockedCreditChange{})
+		s.blockedCredits = append(s.blockedCredits, addr)
+	}
 	return stateObject.AddBalance(amount)
 }
 
@@ -499,13 +603,20 @@ func (s *StateDB) SelfDestruct(addr common.Address) uint256.Int {
 	return prevBalance
 }
 
+// SelfDestruct6780 is the SELFDESTRUCT of EIP-6780, activated with Cancun,
+// which only self-destructs the contracts created in the current transaction.
+// The opcode has already sent the balance to the beneficiary, so the other
+// contracts keep their code and storage, and only the balance is moved. It
+// returns the balance of the account and whether it is self-destructed.
 func (s *StateDB) SelfDestruct6780(addr common.Address) (uint256.Int, bool) {
 	stateObject := s.getStateObject(addr)
 	if stateObject == nil {
 		return uint256.Int{}, false
 	}
 
-	// todo: this is not equivalent to upstream (https://github.com/cosmos/evm/pull/181/#discussion_r2105471095)
+	// the StateDB lives for a single transaction, so the contracts flagged by
+	// CreateContract are the ones created by the current transaction, unless
+	// the creation was reverted
 	if stateObject.newContract {
 		return s.SelfDestruct(addr), true
 	}
@@ -569,8 +680,8 @@ func (s *StateDB) Prepare(rules params.Rules, sender, coinbase common.Address, d
 	}
 	if rules.IsEIP2929 {
 		// Clear out any leftover from previous executions
-		al := newAccessList()
-		s.accessList = al
+		al := s.accessList
+		al.reset()
 
 		al.AddAddress(sender)
 		if dst != nil {
@@ -591,7 +702,7 @@ func (s *StateDB) Prepare(rules params.Rules, sender, coinbase common.Address, d
 		}
 	}
 	// Reset transient storage at the beginning of transaction execution
-	s.transientStorage = newTransientStorage()
+	clear(s.transientStorage)
 }
 
 // AddAddressToAccessList adds the given address to the access list
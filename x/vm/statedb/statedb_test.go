@@ -570,6 +570,25 @@ func (suite *StateDBTestSuite) TestRefund() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestBlockedCredits() {
+	db := statedb.New(sdk.Context{}, mocks.NewEVMKeeper(), emptyTxConfig)
+	db.SetBlockedAddrs(map[common.Address]bool{address2: true})
+
+	// only the SELFDESTRUCT credits of the blocked accounts are recorded
+	db.AddBalance(address2, uint256.NewInt(1), tracing.BalanceChangeTransfer)
+	db.AddBalance(address, uint256.NewInt(1), tracing.BalanceIncreaseSelfdestruct)
+	db.AddBalance(address2, uint256.NewInt(0), tracing.BalanceIncreaseSelfdestruct)
+	suite.Require().Empty(db.BlockedCredits())
+
+	rev := db.Snapshot()
+	db.AddBalance(address2, uint256.NewInt(1), tracing.BalanceIncreaseSelfdestruct)
+	suite.Require().Equal([]common.Address{address2}, db.BlockedCredits())
+
+	// the reverted credits are dropped
+	db.RevertToSnapshot(rev)
+	suite.Require().Empty(db.BlockedCredits())
+}
+
 func (suite *StateDBTestSuite) TestIterateStorage() {
 	ctx := sdk.Context{}
 
@@ -605,6 +624,248 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+func (suite *StateDBTestSuite) TestIterateStoragePages() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	key3 := common.BigToHash(big.NewInt(3))
+	value := common.BigToHash(big.NewInt(10))
+	value2 := common.BigToHash(big.NewInt(20))
+
+	keeper := mocks.NewEVMKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key3, value)
+	db.SetState(address, key1, value)
+	db.SetState(address, key2, value)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	// the pending changes are iterated with their new values
+	db.SetState(address, key2, value2)
+
+	collectPage := func(pageToken []byte, limit uint64) ([]common.Hash, []common.Hash, []byte) {
+		var keys, values []common.Hash
+		nextToken, err := db.IterateStorage(address, pageToken, limit, func(key, value common.Hash) bool {
+			keys = append(keys, key)
+			values = append(values, value)
+			return true
+		})
+		suite.Require().NoError(err)
+		return keys, values, nextToken
+	}
+
+	keys, values, nextToken := collectPage(nil, 2)
+	suite.Require().Equal([]common.Hash{key1, key2}, keys)
+	suite.Require().Equal([]common.Hash{value, value2}, values)
+	suite.Require().Equal(key3.Bytes(), nextToken)
+
+	keys, _, nextToken = collectPage(nextToken, 2)
+	suite.Require().Equal([]common.Hash{key3}, keys)
+	suite.Require().Nil(nextToken)
+
+	// a zero limit returns the token of the first entry
+	keys, _, nextToken = collectPage(nil, 0)
+	suite.Require().Empty(keys)
+	suite.Require().Equal(key1.Bytes(), nextToken)
+
+	// breaking early returns the token of the following entry
+	nextToken, err := db.IterateStorage(address, nil, 3, func(_, _ common.Hash) bool {
+		return false
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(key2.Bytes(), nextToken)
+
+	_, err = db.IterateStorage(address, []byte{1}, 3, func(_, _ common.Hash) bool {
+		return true
+	})
+	suite.Require().ErrorContains(err, "invalid storage page token length")
+}
+
+func (suite *StateDBTestSuite) TestSelfDestruct6780() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	code := []byte("hello world")
+
+	testCases := []struct {
+		name      string
+		malleate  func(db *statedb.StateDB)
+		destruct  bool
+		expDelete bool
+	}{
+		{
+			"existing contract is not destructed",
+			func(db *statedb.StateDB) {
+				db.CreateAccount(address)
+				db.CreateContract(address)
+				db.SetCode(address, code)
+				db.SetState(address, key1, value1)
+				db.AddBalance(address, uint256.NewInt(100), tracing.BalanceChangeUnspecified)
+				suite.Require().NoError(db.Commit())
+			},
+			false,
+			false,
+		},
+		{
+			"contract created in the tx is destructed",
+			func(db *statedb.StateDB) {},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keeper := mocks.NewEVMKeeper()
+			tc.malleate(statedb.New(sdk.Context{}, keeper, emptyTxConfig))
+
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			if tc.destruct {
+				db.CreateAccount(address)
+				db.CreateContract(address)
+				db.SetCode(address, code)
+				db.SetState(address, key1, value1)
+				db.AddBalance(address, uint256.NewInt(100), tracing.BalanceChangeUnspecified)
+			}
+
+			balance, destructed := db.SelfDestruct6780(address)
+			suite.Require().Equal(tc.expDelete, destructed)
+			suite.Require().Equal(tc.expDelete, db.HasSelfDestructed(address))
+			suite.Require().Equal(uint64(100), balance.Uint64())
+			suite.Require().NoError(db.Commit())
+
+			if tc.expDelete {
+				suite.Require().Nil(keeper.GetAccount(sdk.Context{}, address))
+				return
+			}
+			db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			suite.Require().Equal(code, db.GetCode(address))
+			suite.Require().Equal(value1, db.GetState(address, key1))
+		})
+	}
+
+	// a reverted creation doesn't allow the contract to be destructed
+	db := statedb.New(sdk.Context{}, mocks.NewEVMKeeper(), emptyTxConfig)
+	db.CreateAccount(address)
+	rev := db.Snapshot()
+	db.CreateContract(address)
+	db.RevertToSnapshot(rev)
+	_, destructed := db.SelfDestruct6780(address)
+	suite.Require().False(destructed)
+
+	// neither does the creation by a finalised tx
+	db.CreateContract(address)
+	db.SetCode(address, code)
+	db.Finalise(true)
+	_, destructed = db.SelfDestruct6780(address)
+	suite.Require().False(destructed)
+}
+
+func (suite *StateDBTestSuite) TestTxWitness() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(1))
+
+	keeper := mocks.NewEVMKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetNonce(address2, 1, tracing.NonceChangeUnspecified)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.GetState(address2, key2)
+	// reads of non existent accounts are not reported
+	db.GetState(address3, key1)
+	db.SetState(address, key1, value1)
+	// a slot restored to its committed value is not reported as written
+	db.SetState(address, key2, value1)
+	db.SetState(address, key2, common.Hash{})
+
+	witness := db.TxWitness()
+	suite.Require().Equal(ethtypes.AccessList{
+		{Address: address, StorageKeys: []common.Hash{key1, key2}},
+		{Address: address2, StorageKeys: []common.Hash{key2}},
+	}, witness.Reads)
+	suite.Require().Equal(ethtypes.AccessList{
+		{Address: address, StorageKeys: []common.Hash{key1}},
+	}, witness.Writes)
+}
+
+func (suite *StateDBTestSuite) TestStorageAccessedAccounts() {
+	key := common.BigToHash(big.NewInt(1))
+
+	keeper := mocks.NewEVMKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetNonce(address, 1, tracing.NonceChangeUnspecified)
+	db.SetNonce(address2, 1, tracing.NonceChangeUnspecified)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	// the accounts loaded without accessing their storage are not reported
+	db.GetBalance(address3)
+	db.GetNonce(address)
+	suite.Require().Empty(db.StorageAccessedAccounts())
+
+	db.SetState(address2, key, common.BigToHash(big.NewInt(1)))
+	db.GetState(address, key)
+	suite.Require().Equal([]common.Address{address, address2}, db.StorageAccessedAccounts())
+}
+
+func (suite *StateDBTestSuite) TestRelease() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	rules := ethparams.TestChainConfig.Rules(big.NewInt(0), false, 0)
+	keeper := mocks.NewEVMKeeper()
+
+	db := statedb.NewFromPool(sdk.Context{}, keeper, emptyTxConfig)
+	db.Prepare(rules, address, common.Address{}, &address2, nil, ethtypes.AccessList{{Address: address3, StorageKeys: []common.Hash{key1}}})
+	db.SetState(address, key1, value1)
+	db.SetTransientState(address, key1, value1)
+	db.AddRefund(10)
+	db.AddLog(&ethtypes.Log{Address: address})
+	db.Snapshot()
+	db.Release()
+
+	// whether or not the pool returns the same instance, it has no leftovers
+	for i := 0; i < 2; i++ {
+		db = statedb.NewFromPool(sdk.Context{}, keeper, emptyTxConfig)
+		suite.Require().Equal(keeper, db.Keeper())
+		suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+		suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key1))
+		suite.Require().Equal(uint64(0), db.GetRefund())
+		suite.Require().Empty(db.Logs())
+		suite.Require().False(db.AddressInAccessList(address3))
+		suite.Require().Empty(db.TxWitness().Writes)
+		db.Release()
+	}
+}
+
+func BenchmarkStateDB(b *testing.B) {
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(2))
+	rules := ethparams.TestChainConfig.Rules(big.NewInt(0), false, 0)
+	keeper := mocks.NewEVMKeeper()
+
+	run := func(db *statedb.StateDB) {
+		db.Prepare(rules, address, common.Address{}, &address2, nil, nil)
+		db.AddBalance(address, uint256.NewInt(1), tracing.BalanceChangeUnspecified)
+		db.SetState(address2, key, value)
+		db.AddLog(&ethtypes.Log{Address: address2})
+	}
+
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			run(statedb.New(sdk.Context{}, keeper, emptyTxConfig))
+		}
+	})
+	b.Run("pool", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			db := statedb.NewFromPool(sdk.Context{}, keeper, emptyTxConfig)
+			run(db)
+			db.Release()
+		}
+	})
+}
+
 func CollectContractStorage(db vm.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	stDB, ok := db.(*statedb.StateDB)
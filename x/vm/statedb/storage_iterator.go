@@ -0,0 +1,49 @@
+package statedb
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ForEachStorageFromFn iterates the storage of a contract in ascending key
+// order, starting from the given key, the callback returns false to break
+// early.
+type ForEachStorageFromFn func(start common.Hash, cb func(key, value common.Hash) bool)
+
+// IterateStoragePage iterates at most limit storage entries walked by forEach,
+// starting from the entry of the given pagination token, nil to start from the
+// first entry. The pagination token is the key of an entry, so the pages stay
+// consistent when the storage is modified between them. It returns the token
+// of the entry following the last iterated one, also when the callback breaks
+// early, which is nil once the storage is exhausted.
+func IterateStoragePage(
+	forEach ForEachStorageFromFn,
+	pageToken []byte,
+	limit uint64,
+	cb func(key, value common.Hash) bool,
+) ([]byte, error) {
+	var start common.Hash
+	if pageToken != nil {
+		if len(pageToken) != common.HashLength {
+			return nil, fmt.Errorf("invalid storage page token length %d, expected %d", len(pageToken), common.HashLength)
+		}
+		start = common.BytesToHash(pageToken)
+	}
+
+	var (
+		nextToken []byte
+		count     uint64
+		stop      bool
+	)
+	forEach(start, func(key, value common.Hash) bool {
+		if stop || count == limit {
+			nextToken = key.Bytes()
+			return false
+		}
+		count++
+		stop = !cb(key, value)
+		return true
+	})
+	return nextToken, nil
+}
@@ -0,0 +1,94 @@
+package backend
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	vmstoretypes "github.com/cosmos/evm/x/vm/store/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	_ vmstoretypes.StorageBackend = PrefixBackend{}
+	_ vmstoretypes.StorageBackend = FlatBackend{}
+)
+
+// PrefixBackend is the default storage backend. It stores the slots of a
+// contract under the prefix of its address, with their 32-byte values.
+type PrefixBackend struct{}
+
+// NewPrefixBackend returns the default storage backend.
+func NewPrefixBackend() PrefixBackend {
+	return PrefixBackend{}
+}
+
+// Get implements StorageBackend.
+func (PrefixBackend) Get(store storetypes.KVStore, addr common.Address, key common.Hash) []byte {
+	return prefix.NewStore(store, types.AddressStoragePrefix(addr)).Get(key.Bytes())
+}
+
+// Set implements StorageBackend.
+func (PrefixBackend) Set(store storetypes.KVStore, addr common.Address, key common.Hash, value []byte) {
+	prefix.NewStore(store, types.AddressStoragePrefix(addr)).Set(key.Bytes(), value)
+}
+
+// Delete implements StorageBackend.
+func (PrefixBackend) Delete(store storetypes.KVStore, addr common.Address, key common.Hash) {
+	prefix.NewStore(store, types.AddressStoragePrefix(addr)).Delete(key.Bytes())
+}
+
+// Iterator implements StorageBackend.
+func (PrefixBackend) Iterator(store storetypes.KVStore, addr common.Address, start common.Hash) storetypes.Iterator {
+	return prefix.NewStore(store, types.AddressStoragePrefix(addr)).Iterator(start.Bytes(), nil)
+}
+
+// FlatBackend is an experimental storage backend. It stores the slots in a
+// single flat key space, keyed by the hash of the contract address followed by
+// the slot key, as the storage snapshot of geth, so that the keys of all the
+// contracts have the same length and are evenly spread over the store. The
+// values are stored without their leading zeros.
+//
+// The storage of a contract stays contiguous and ordered by slot key, so it
+// can be iterated as with the default backend.
+type FlatBackend struct{}
+
+// NewFlatBackend returns the experimental flat storage backend.
+func NewFlatBackend() FlatBackend {
+	return FlatBackend{}
+}
+
+// Get implements StorageBackend.
+func (FlatBackend) Get(store storetypes.KVStore, addr common.Address, key common.Hash) []byte {
+	return store.Get(flatStateKey(addr, key))
+}
+
+// Set implements StorageBackend. A zero value deletes the slot, as it can't be
+// told apart from an unset slot once trimmed.
+func (b FlatBackend) Set(store storetypes.KVStore, addr common.Address, key common.Hash, value []byte) {
+	value = bytes.TrimLeft(value, "\x00")
+	if len(value) == 0 {
+		b.Delete(store, addr, key)
+		return
+	}
+	store.Set(flatStateKey(addr, key), value)
+}
+
+// Delete implements StorageBackend.
+func (FlatBackend) Delete(store storetypes.KVStore, addr common.Address, key common.Hash) {
+	store.Delete(flatStateKey(addr, key))
+}
+
+// Iterator implements StorageBackend.
+func (FlatBackend) Iterator(store storetypes.KVStore, addr common.Address, start common.Hash) storetypes.Iterator {
+	return prefix.NewStore(store, types.FlatStoragePrefix(addr)).Iterator(start.Bytes(), nil)
+}
+
+// flatStateKey returns the key of the storage slot of the contract in the
+// layout of the flat backend.
+func flatStateKey(addr common.Address, key common.Hash) []byte {
+	return append(types.FlatStoragePrefix(addr), key.Bytes()...)
+}
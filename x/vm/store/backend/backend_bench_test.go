@@ -0,0 +1,114 @@
+package backend_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	dbm "github.com/cosmos/cosmos-db"
+	vmstoretypes "github.com/cosmos/evm/x/vm/store/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	benchContracts = 100
+	benchSlots     = 1000
+	// benchBlockOps is the number of storage operations committed per block
+	benchBlockOps = 1000
+)
+
+var benchSink []byte
+
+// benchSlot returns a slot key spread over the key space, as the slots of the
+// mappings of Solidity contracts.
+func benchSlot(i int) common.Hash {
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], uint64(i)) //#nosec G115 -- i is never negative
+	return crypto.Keccak256Hash(bz[:])
+}
+
+// setupIAVLStore returns an IAVL store holding the storage of the benchmark
+// contracts in the layout of the given backend, with small values like the
+// balances of tokens.
+func setupIAVLStore(b *testing.B, storageBackend vmstoretypes.StorageBackend) (storetypes.CommitKVStore, []common.Address) {
+	b.Helper()
+	store, err := iavl.LoadStore(dbm.NewMemDB(), log.NewNopLogger(), storetypes.NewKVStoreKey("evm"), storetypes.CommitID{}, iavl.DefaultIAVLCacheSize, false, metrics.NewNoOpMetrics())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	addrs := make([]common.Address, benchContracts)
+	for i := range addrs {
+		addrs[i] = common.BytesToAddress(crypto.Keccak256([]byte(fmt.Sprintf("contract-%d", i))))
+		for j := 0; j < benchSlots; j++ {
+			storageBackend.Set(store, addrs[i], benchSlot(j), common.BigToHash(common.Big1).Bytes())
+		}
+	}
+	store.Commit()
+	return store, addrs
+}
+
+// BenchmarkSload measures the reads of committed storage slots, as SLOAD.
+func BenchmarkSload(b *testing.B) {
+	for _, tc := range backends {
+		storageBackend := tc.backend
+		b.Run(tc.name, func(b *testing.B) {
+			store, addrs := setupIAVLStore(b, storageBackend)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				benchSink = storageBackend.Get(store, addrs[i%benchContracts], benchSlot(i%benchSlots))
+			}
+		})
+	}
+}
+
+// BenchmarkSstore measures the writes of storage slots, committed every
+// benchBlockOps writes as in a block, as SSTORE.
+func BenchmarkSstore(b *testing.B) {
+	for _, tc := range backends {
+		storageBackend := tc.backend
+		b.Run(tc.name, func(b *testing.B) {
+			store, addrs := setupIAVLStore(b, storageBackend)
+			value := common.BigToHash(common.Big2).Bytes()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				storageBackend.Set(store, addrs[i%benchContracts], benchSlot(i%(2*benchSlots)), value)
+				if i%benchBlockOps == benchBlockOps-1 {
+					store.Commit()
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkStorageIteration measures the iteration over the storage of a
+// contract, as the genesis export and the storage range queries.
+func BenchmarkStorageIteration(b *testing.B) {
+	for _, tc := range backends {
+		storageBackend := tc.backend
+		b.Run(tc.name, func(b *testing.B) {
+			store, addrs := setupIAVLStore(b, storageBackend)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				iterator := storageBackend.Iterator(store, addrs[i%benchContracts], common.Hash{})
+				for ; iterator.Valid(); iterator.Next() {
+					benchSink = iterator.Value()
+				}
+				iterator.Close()
+			}
+		})
+	}
+}
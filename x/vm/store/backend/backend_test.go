@@ -0,0 +1,80 @@
+package backend_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/x/vm/store/backend"
+	vmstoretypes "github.com/cosmos/evm/x/vm/store/types"
+
+	"cosmossdk.io/store/dbadapter"
+)
+
+var backends = []struct {
+	name    string
+	backend vmstoretypes.StorageBackend
+}{
+	{"prefix", backend.NewPrefixBackend()},
+	{"flat", backend.NewFlatBackend()},
+}
+
+func TestStorageBackend(t *testing.T) {
+	addr := common.BigToAddress(big.NewInt(101))
+	addr2 := common.BigToAddress(big.NewInt(102))
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	key3 := common.BigToHash(big.NewInt(3))
+	value := common.BigToHash(big.NewInt(1234))
+
+	for _, tc := range backends {
+		storageBackend := tc.backend
+		t.Run(tc.name, func(t *testing.T) {
+			store := dbadapter.Store{DB: dbm.NewMemDB()}
+
+			require.Nil(t, storageBackend.Get(store, addr, key1))
+			storageBackend.Set(store, addr, key3, value.Bytes())
+			storageBackend.Set(store, addr, key1, value.Bytes())
+			storageBackend.Set(store, addr, key2, value.Bytes())
+			storageBackend.Set(store, addr2, key1, value.Bytes())
+			require.Equal(t, value, common.BytesToHash(storageBackend.Get(store, addr, key1)))
+
+			storageBackend.Delete(store, addr, key2)
+			require.Nil(t, storageBackend.Get(store, addr, key2))
+			require.NotNil(t, storageBackend.Get(store, addr2, key1))
+
+			// the storage of a contract is iterated in ascending key order,
+			// without the storage of the other contracts
+			var keys []common.Hash
+			iterator := storageBackend.Iterator(store, addr, common.Hash{})
+			for ; iterator.Valid(); iterator.Next() {
+				keys = append(keys, common.BytesToHash(iterator.Key()))
+				require.Equal(t, value, common.BytesToHash(iterator.Value()))
+			}
+			require.NoError(t, iterator.Close())
+			require.Equal(t, []common.Hash{key1, key3}, keys)
+
+			iterator = storageBackend.Iterator(store, addr, key2)
+			require.True(t, iterator.Valid())
+			require.Equal(t, key3.Bytes(), iterator.Key())
+			require.NoError(t, iterator.Close())
+		})
+	}
+}
+
+func TestFlatBackendTrimsValues(t *testing.T) {
+	addr := common.BigToAddress(big.NewInt(101))
+	key := common.BigToHash(big.NewInt(1))
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	flatBackend := backend.NewFlatBackend()
+
+	flatBackend.Set(store, addr, key, common.BigToHash(big.NewInt(0x0102)).Bytes())
+	require.Equal(t, []byte{0x01, 0x02}, flatBackend.Get(store, addr, key))
+
+	// a zero value deletes the slot
+	flatBackend.Set(store, addr, key, common.Hash{}.Bytes())
+	require.Nil(t, flatBackend.Get(store, addr, key))
+}
@@ -0,0 +1,338 @@
+package rwset
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Store is a CacheMultiStore branching a MultiStore which records the reads of
+// the branch from the stores of its parent, i.e. the read set, and the writes
+// of the branch, i.e. the write set.
+//
+// It's used to execute a tx optimistically on a state, and to apply its writes
+// to another state, e.g. the same one after the txs preceding it in the block,
+// only if all the values it read are the same there. Otherwise the tx must be
+// executed again.
+//
+// A read is only recorded when it misses the branch, so the values written by
+// the execution and read again aren't part of the read set. Writing the Store
+// records its writes, which aren't written to its parent.
+//
+// NOTE: a Store isn't safe for concurrent use, as an execution context.
+type Store struct {
+	*branch
+	// recorders are the stores recording the reads from the parent by key
+	recorders map[storetypes.StoreKey]*kvStore
+}
+
+var _ storetypes.CacheMultiStore = (*Store)(nil)
+
+// NewStore creates a new Store recording the reads from the given MultiStore.
+func NewStore(parent storetypes.MultiStore) *Store {
+	s := &Store{recorders: make(map[storetypes.StoreKey]*kvStore)}
+	s.branch = newBranch(func(key storetypes.StoreKey) storetypes.KVStore {
+		store := &kvStore{KVStore: parent.GetKVStore(key), reads: make(map[string][]byte)}
+		s.recorders[key] = store
+		return store
+	})
+	return s
+}
+
+// Valid returns true if all the values in the read set are the same in the
+// given MultiStore, in which case an execution on it would have read the
+// values the recorded execution read.
+func (s *Store) Valid(ms storetypes.MultiStore) bool {
+	for key, store := range s.recorders {
+		if !store.valid(ms.GetKVStore(key)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply applies the write set to the given MultiStore. The Store must be
+// written first.
+func (s *Store) Apply(ms storetypes.MultiStore) {
+	// the stores are written in a deterministic order, in case they're traced
+	keys := make([]storetypes.StoreKey, 0, len(s.recorders))
+	for key := range s.recorders {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
+
+	for _, key := range keys {
+		store := ms.GetKVStore(key)
+		for _, write := range s.recorders[key].writes {
+			if write.value == nil {
+				store.Delete(write.key)
+			} else {
+				store.Set(write.key, write.value)
+			}
+		}
+	}
+}
+
+// kvStore records the reads from its parent and the writes to it, which aren't
+// written to the parent.
+type kvStore struct {
+	storetypes.KVStore
+	// reads are the first values read by key, nil if the key wasn't found
+	reads map[string][]byte
+	// iterations are the ranges iterated over
+	iterations []*iteration
+	// writes are the values written in order, nil for a delete
+	writes []write
+}
+
+type write struct {
+	key, value []byte
+}
+
+// Get returns the value of the parent, recording it.
+func (s *kvStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	if _, ok := s.reads[string(key)]; !ok {
+		s.reads[string(key)] = value
+	}
+	return value
+}
+
+// Has returns if the parent has the key, recording its value.
+func (s *kvStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set records the value written.
+func (s *kvStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	s.writes = append(s.writes, write{key: key, value: value})
+}
+
+// Delete records the deleted key.
+func (s *kvStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	s.writes = append(s.writes, write{key: key})
+}
+
+// Iterator iterates over the parent, recording the pairs iterated over.
+func (s *kvStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, false)
+}
+
+// ReverseIterator iterates over the parent in reverse, recording the pairs
+// iterated over.
+func (s *kvStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, true)
+}
+
+func (s *kvStore) iterator(start, end []byte, reverse bool) storetypes.Iterator {
+	it := &iteration{start: bytes.Clone(start), end: bytes.Clone(end), reverse: reverse}
+	s.iterations = append(s.iterations, it)
+	return &iterator{Iterator: it.open(s.KVStore), iteration: it}
+}
+
+// CacheWrap branches the store, the reads of the branch being recorded.
+func (s *kvStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+//
+// NOTE: the tracing isn't supported, the branch is returned without tracing.
+func (s *kvStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+// valid returns true if the reads and the iterations of the store are the
+// same on the given store.
+func (s *kvStore) valid(store storetypes.KVStore) bool {
+	for key, value := range s.reads {
+		if !bytes.Equal(store.Get([]byte(key)), value) {
+			return false
+		}
+	}
+	for _, it := range s.iterations {
+		if !it.valid(store) {
+			return false
+		}
+	}
+	return true
+}
+
+// iteration is an iteration over a range of the parent of a kvStore.
+type iteration struct {
+	start, end []byte
+	reverse    bool
+	// pairs are the key value pairs iterated over
+	pairs []pair
+	// exhausted is true if the iteration reached the end of the range
+	exhausted bool
+}
+
+type pair struct {
+	key, value []byte
+}
+
+func (it *iteration) open(store storetypes.KVStore) storetypes.Iterator {
+	if it.reverse {
+		return store.ReverseIterator(it.start, it.end)
+	}
+	return store.Iterator(it.start, it.end)
+}
+
+// valid returns true if iterating over the range of the given store yields
+// the recorded pairs, and then ends if the recorded iteration ended.
+func (it *iteration) valid(store storetypes.KVStore) bool {
+	iter := it.open(store)
+	defer iter.Close()
+
+	for _, pair := range it.pairs {
+		if !iter.Valid() || !bytes.Equal(iter.Key(), pair.key) || !bytes.Equal(iter.Value(), pair.value) {
+			return false
+		}
+		iter.Next()
+	}
+	return !it.exhausted || !iter.Valid()
+}
+
+// iterator records the pairs of the positions its consumer observes or skips.
+type iterator struct {
+	storetypes.Iterator
+	iteration *iteration
+	position  int
+}
+
+// record records the pair of the current position, or that the iteration is
+// exhausted.
+func (it *iterator) record() bool {
+	if !it.Iterator.Valid() {
+		it.iteration.exhausted = true
+		return false
+	}
+	if it.position == len(it.iteration.pairs) {
+		it.iteration.pairs = append(it.iteration.pairs, pair{
+			key:   bytes.Clone(it.Iterator.Key()),
+			value: bytes.Clone(it.Iterator.Value()),
+		})
+	}
+	return true
+}
+
+func (it *iterator) Valid() bool {
+	return it.record()
+}
+
+func (it *iterator) Next() {
+	it.record()
+	it.Iterator.Next()
+	it.position++
+}
+
+func (it *iterator) Key() []byte {
+	it.record()
+	return it.Iterator.Key()
+}
+
+func (it *iterator) Value() []byte {
+	it.record()
+	return it.Iterator.Value()
+}
+
+// branch is a CacheMultiStore which branches the stores of its parent on their
+// first access.
+type branch struct {
+	parent func(storetypes.StoreKey) storetypes.KVStore
+	stores map[storetypes.StoreKey]storetypes.CacheKVStore
+}
+
+var _ storetypes.CacheMultiStore = (*branch)(nil)
+
+func newBranch(parent func(storetypes.StoreKey) storetypes.KVStore) *branch {
+	return &branch{
+		parent: parent,
+		stores: make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+	}
+}
+
+// GetStoreType returns the type of the store.
+func (b *branch) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (b *branch) CacheWrap() storetypes.CacheWrap {
+	return b.CacheMultiStore().(storetypes.CacheWrap)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+//
+// NOTE: the tracing isn't supported, the branch is returned without tracing.
+func (b *branch) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return b.CacheWrap()
+}
+
+// CacheMultiStore branches the branch, its stores being branched on their
+// first access as well.
+func (b *branch) CacheMultiStore() storetypes.CacheMultiStore {
+	return newBranch(b.GetKVStore)
+}
+
+// CacheMultiStoreWithVersion panics, as the past versions aren't branched.
+func (b *branch) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	panic("cannot branch a read set recording multi-store with a version")
+}
+
+// GetStore returns the branched store by key.
+func (b *branch) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return b.GetKVStore(key)
+}
+
+// GetKVStore returns the branched store by key, branching it on its first
+// access.
+func (b *branch) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if store, ok := b.stores[key]; ok {
+		return store
+	}
+
+	store := cachekv.NewStore(b.parent(key))
+	b.stores[key] = store
+	return store
+}
+
+// TracingEnabled returns if tracing is enabled for the MultiStore.
+func (b *branch) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer sets the tracer for the MultiStore.
+//
+// NOTE: SetTracer is a no-op function.
+func (b *branch) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return b
+}
+
+// SetTracingContext sets the tracing context for the MultiStore.
+//
+// NOTE: SetTracingContext is a no-op function.
+func (b *branch) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return b
+}
+
+// LatestVersion panics, as for the branches of the cache multistore.
+func (b *branch) LatestVersion() int64 {
+	panic("cannot get latest version from branch cached multi-store")
+}
+
+// Write writes the accessed stores to the stores of the parent.
+func (b *branch) Write() {
+	for _, store := range b.stores {
+		store.Write()
+	}
+}
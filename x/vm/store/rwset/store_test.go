@@ -0,0 +1,120 @@
+package rwset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/x/vm/store/rwset"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	keyA = storetypes.NewKVStoreKey("a")
+	keyB = storetypes.NewKVStoreKey("b")
+)
+
+// setupStore returns a branch of a multistore with the given pairs of keys and
+// values in the store "a".
+func setupStore(t *testing.T, pairs ...string) storetypes.CacheMultiStore {
+	t.Helper()
+
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	for i := 0; i < len(pairs); i += 2 {
+		cms.GetKVStore(keyA).Set([]byte(pairs[i]), []byte(pairs[i+1]))
+	}
+	cms.Commit()
+	return cms.CacheMultiStore()
+}
+
+func TestStoreWrites(t *testing.T) {
+	state := setupStore(t, "k1", "v1", "k2", "v2")
+	store := rwset.NewStore(state)
+
+	storeA := store.GetKVStore(keyA)
+	storeA.Set([]byte("k1"), []byte("w1"))
+	storeA.Delete([]byte("k2"))
+	store.GetKVStore(keyB).Set([]byte("k"), []byte("w"))
+	require.Equal(t, []byte("w1"), storeA.Get([]byte("k1")))
+
+	// the writes of the store aren't written to its parent
+	store.Write()
+	require.Equal(t, []byte("v1"), state.GetKVStore(keyA).Get([]byte("k1")))
+	require.Nil(t, state.GetKVStore(keyB).Get([]byte("k")))
+
+	// the blind writes don't depend on the state they're applied to
+	require.True(t, store.Valid(state))
+	other := setupStore(t, "k1", "other")
+	require.True(t, store.Valid(other))
+
+	store.Apply(other)
+	require.Equal(t, []byte("w1"), other.GetKVStore(keyA).Get([]byte("k1")))
+	require.False(t, other.GetKVStore(keyA).Has([]byte("k2")))
+	require.Equal(t, []byte("w"), other.GetKVStore(keyB).Get([]byte("k")))
+}
+
+func TestStoreReads(t *testing.T) {
+	state := setupStore(t, "k1", "v1")
+	store := rwset.NewStore(state)
+
+	// the values read, found or not, must be the same
+	require.Equal(t, []byte("v1"), store.GetKVStore(keyA).Get([]byte("k1")))
+	require.False(t, store.GetKVStore(keyB).Has([]byte("k")))
+	require.True(t, store.Valid(state))
+
+	require.False(t, store.Valid(setupStore(t, "k1", "v2")))
+	require.True(t, store.Valid(setupStore(t, "k1", "v1", "k2", "v2")))
+
+	created := setupStore(t, "k1", "v1")
+	created.GetKVStore(keyB).Set([]byte("k"), []byte("v"))
+	require.False(t, store.Valid(created))
+
+	// the values read from the writes of the store itself aren't recorded
+	store = rwset.NewStore(state)
+	nested := store.CacheMultiStore()
+	nested.GetKVStore(keyA).Set([]byte("k2"), []byte("w2"))
+	nested.Write()
+	require.Equal(t, []byte("w2"), store.GetKVStore(keyA).Get([]byte("k2")))
+	require.True(t, store.Valid(setupStore(t, "k2", "other")))
+}
+
+func TestStoreIterations(t *testing.T) {
+	state := setupStore(t, "k1", "v1", "k2", "v2", "k3", "v3")
+
+	// a partial iteration only depends on the pairs iterated over
+	store := rwset.NewStore(state)
+	iter := store.GetKVStore(keyA).Iterator([]byte("k"), nil)
+	require.Equal(t, []byte("k1"), iter.Key())
+	iter.Next()
+	require.Equal(t, []byte("v2"), iter.Value())
+	require.NoError(t, iter.Close())
+
+	require.True(t, store.Valid(state))
+	require.True(t, store.Valid(setupStore(t, "k1", "v1", "k2", "v2", "k4", "v4")))
+	require.False(t, store.Valid(setupStore(t, "k1", "v1", "k2", "other")))
+	require.False(t, store.Valid(setupStore(t, "k1", "v1", "k11", "v11", "k2", "v2")))
+
+	// an exhausted iteration depends on the whole range
+	store = rwset.NewStore(state)
+	iter = store.GetKVStore(keyA).ReverseIterator([]byte("k2"), []byte("k4"))
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"k3", "k2"}, keys)
+
+	require.True(t, store.Valid(state))
+	require.True(t, store.Valid(setupStore(t, "k1", "other", "k2", "v2", "k3", "v3", "k4", "v4")))
+	require.False(t, store.Valid(setupStore(t, "k2", "v2", "k3", "v3", "k33", "v33")))
+	require.False(t, store.Valid(setupStore(t, "k3", "v3")))
+}
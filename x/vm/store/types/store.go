@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/ethereum/go-ethereum/common"
+
 	storetypes "cosmossdk.io/store/types"
 )
 
@@ -42,3 +44,25 @@ type SnapshotMultiStore interface {
 	Snapshotter
 	storetypes.CacheMultiStore
 }
+
+// StorageBackend defines the layout of the contract storage in the KV store of
+// the EVM module.
+//
+// The layout is part of the state, so the backend of a chain can't be changed
+// without migrating the storage of all the contracts.
+type StorageBackend interface {
+	// Get returns the value of the storage slot of the contract, nil if the
+	// slot isn't set.
+	Get(store storetypes.KVStore, addr common.Address, key common.Hash) []byte
+
+	// Set sets the value of the storage slot of the contract.
+	Set(store storetypes.KVStore, addr common.Address, key common.Hash, value []byte)
+
+	// Delete deletes the storage slot of the contract.
+	Delete(store storetypes.KVStore, addr common.Address, key common.Hash)
+
+	// Iterator returns an iterator over the storage of the contract, in
+	// ascending key order from the given key. The keys of the iterator are the
+	// 32-byte slot keys, its values are the slot values.
+	Iterator(store storetypes.KVStore, addr common.Address, start common.Hash) storetypes.Iterator
+}
@@ -0,0 +1,161 @@
+package versioned
+
+import (
+	"fmt"
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/iavl"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Store is a MultiStore for the queries, set with the baseapp
+// SetQueryMultiStore, which reads the committed versions of the IAVL stores of
+// the CommitMultiStore.
+//
+// Unlike the CommitMultiStore, it doesn't load the immutable trees of all the
+// stores at the queried version and branch them for each query. A query only
+// loads and branches the stores it reads, so that the historical eth_call and
+// eth_getBalance don't allocate a branch of the whole state, and don't take the
+// locks of the stores written by the block execution.
+type Store struct {
+	storetypes.CommitMultiStore
+}
+
+var _ storetypes.MultiStore = (*Store)(nil)
+
+// NewStore creates a new Store reading the versions of the given CommitMultiStore.
+func NewStore(cms storetypes.CommitMultiStore) *Store {
+	return &Store{CommitMultiStore: cms}
+}
+
+// CacheMultiStoreWithVersion branches the stores at the given version, each
+// store being loaded on its first access. Writing the returned branch panics,
+// as the stores of a committed version are immutable, but it can be branched
+// again to write in it.
+func (s *Store) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	if latest := s.LatestVersion(); version <= 0 || version > latest {
+		return nil, fmt.Errorf("version %d does not exist, the latest version is %d", version, latest)
+	}
+
+	return newBranch(version, func(key storetypes.StoreKey) storetypes.KVStore {
+		return s.loadStore(key, version)
+	}), nil
+}
+
+// loadStore returns the store at the given version. The stores which aren't
+// IAVL stores, e.g. the transient ones, are returned as is, as the
+// CommitMultiStore does for the queries.
+func (s *Store) loadStore(key storetypes.StoreKey, version int64) storetypes.KVStore {
+	store := s.GetCommitKVStore(key)
+	if store == nil {
+		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
+	}
+
+	iavlStore, ok := store.(*iavl.Store)
+	if !ok {
+		return store
+	}
+
+	immutable, err := iavlStore.GetImmutable(version)
+	if err != nil {
+		panic(fmt.Errorf("failed to load store %s at version %d: %w", key.Name(), version, err))
+	}
+	return immutable
+}
+
+// branch is a CacheMultiStore which branches the stores of its parent on their
+// first access.
+//
+// NOTE: a branch isn't safe for concurrent use, as a query context.
+type branch struct {
+	version int64
+	parent  func(storetypes.StoreKey) storetypes.KVStore
+	stores  map[storetypes.StoreKey]storetypes.CacheKVStore
+}
+
+var _ storetypes.CacheMultiStore = (*branch)(nil)
+
+func newBranch(version int64, parent func(storetypes.StoreKey) storetypes.KVStore) *branch {
+	return &branch{
+		version: version,
+		parent:  parent,
+		stores:  make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+	}
+}
+
+// GetStoreType returns the type of the store.
+func (b *branch) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (b *branch) CacheWrap() storetypes.CacheWrap {
+	return b.CacheMultiStore().(storetypes.CacheWrap)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+//
+// NOTE: the tracing isn't supported, the branch is returned without tracing.
+func (b *branch) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return b.CacheWrap()
+}
+
+// CacheMultiStore branches the branch, its stores being branched on their
+// first access as well.
+func (b *branch) CacheMultiStore() storetypes.CacheMultiStore {
+	return newBranch(b.version, b.GetKVStore)
+}
+
+// CacheMultiStoreWithVersion panics, as a branch is already at a version.
+func (b *branch) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	panic("cannot branch a versioned multi-store with a version")
+}
+
+// GetStore returns the branched store by key.
+func (b *branch) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return b.GetKVStore(key)
+}
+
+// GetKVStore returns the branched store by key, branching it on its first
+// access.
+func (b *branch) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if store, ok := b.stores[key]; ok {
+		return store
+	}
+
+	store := cachekv.NewStore(b.parent(key))
+	b.stores[key] = store
+	return store
+}
+
+// TracingEnabled returns if tracing is enabled for the MultiStore.
+func (b *branch) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer sets the tracer for the MultiStore.
+//
+// NOTE: SetTracer is a no-op function.
+func (b *branch) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return b
+}
+
+// SetTracingContext sets the tracing context for the MultiStore.
+//
+// NOTE: SetTracingContext is a no-op function.
+func (b *branch) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return b
+}
+
+// LatestVersion returns the version of the branched stores.
+func (b *branch) LatestVersion() int64 {
+	return b.version
+}
+
+// Write writes the accessed stores to the stores of the parent.
+func (b *branch) Write() {
+	for _, store := range b.stores {
+		store.Write()
+	}
+}
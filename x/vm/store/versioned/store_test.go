@@ -0,0 +1,103 @@
+package versioned_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/x/vm/store/versioned"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	keyA      = storetypes.NewKVStoreKey("a")
+	keyB      = storetypes.NewKVStoreKey("b")
+	transient = storetypes.NewTransientStoreKey("transient")
+)
+
+// setupStore commits a version per value of the key "k" of the store "a".
+func setupStore(t *testing.T, values ...string) *rootmulti.Store {
+	t.Helper()
+
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(transient, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	for _, value := range values {
+		cms.GetKVStore(keyA).Set([]byte("k"), []byte(value))
+		cms.Commit()
+	}
+	return cms
+}
+
+func TestCacheMultiStoreWithVersion(t *testing.T) {
+	cms := setupStore(t, "v1", "v2", "v3")
+	store := versioned.NewStore(cms)
+	require.Equal(t, int64(3), store.LatestVersion())
+
+	for version, value := range []string{"v1", "v2", "v3"} {
+		branch, err := store.CacheMultiStoreWithVersion(int64(version + 1))
+		require.NoError(t, err)
+		require.Equal(t, int64(version+1), branch.LatestVersion())
+		require.Equal(t, []byte(value), branch.GetKVStore(keyA).Get([]byte("k")))
+		require.Nil(t, branch.GetKVStore(keyB).Get([]byte("k")))
+	}
+
+	_, err := store.CacheMultiStoreWithVersion(0)
+	require.Error(t, err)
+	_, err = store.CacheMultiStoreWithVersion(4)
+	require.Error(t, err)
+}
+
+func TestBranchReadsCommittedVersion(t *testing.T) {
+	cms := setupStore(t, "v1")
+	branch, err := versioned.NewStore(cms).CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+
+	// the writes of the next block aren't read by the branch, even before
+	// they're committed
+	cms.GetKVStore(keyA).Set([]byte("k"), []byte("v2"))
+	require.Equal(t, []byte("v1"), branch.GetKVStore(keyA).Get([]byte("k")))
+	cms.Commit()
+	require.Equal(t, []byte("v1"), branch.GetKVStore(keyA).Get([]byte("k")))
+
+	// the transient stores are read as is
+	cms.GetKVStore(transient).Set([]byte("k"), []byte("t"))
+	require.Equal(t, []byte("t"), branch.GetKVStore(transient).Get([]byte("k")))
+
+	require.Panics(t, func() {
+		branch.GetKVStore(storetypes.NewKVStoreKey("unknown"))
+	})
+}
+
+func TestBranchWrites(t *testing.T) {
+	cms := setupStore(t, "v1")
+	branch, err := versioned.NewStore(cms).CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+
+	branch.GetKVStore(keyA).Set([]byte("k"), []byte("branch"))
+	require.Equal(t, []byte("branch"), branch.GetKVStore(keyA).Get([]byte("k")))
+
+	// the writes of a nested branch are only written to its parent
+	nested := branch.CacheMultiStore()
+	require.Equal(t, []byte("branch"), nested.GetKVStore(keyA).Get([]byte("k")))
+	nested.GetKVStore(keyA).Set([]byte("k"), []byte("nested"))
+	nested.GetKVStore(keyB).Set([]byte("k"), []byte("nested"))
+	require.Equal(t, []byte("branch"), branch.GetKVStore(keyA).Get([]byte("k")))
+	require.Nil(t, branch.GetKVStore(keyB).Get([]byte("k")))
+
+	nested.Write()
+	require.Equal(t, []byte("nested"), branch.GetKVStore(keyA).Get([]byte("k")))
+	require.Equal(t, []byte("nested"), branch.GetKVStore(keyB).Get([]byte("k")))
+	require.Equal(t, []byte("v1"), cms.GetKVStore(keyA).Get([]byte("k")))
+
+	// the committed version is immutable
+	require.Panics(t, branch.Write)
+}
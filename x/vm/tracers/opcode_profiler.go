@@ -0,0 +1,199 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// OpcodeProfilerName is the name to select the opcode profiler in debug_trace* calls
+const OpcodeProfilerName = "opcodeProfiler"
+
+func init() {
+	tracers.DefaultDirectory.Register(OpcodeProfilerName, NewOpcodeProfiler, false)
+}
+
+// OpcodeStat is the number of executions and the gas spent by an opcode or a call depth
+type OpcodeStat struct {
+	Count uint64 `json:"count"`
+	Gas   uint64 `json:"gas"`
+}
+
+// DepthStat is the OpcodeStat of a call depth
+type DepthStat struct {
+	Depth int `json:"depth"`
+	OpcodeStat
+}
+
+// OpcodeProfile is the result of the opcode profiler
+type OpcodeProfile struct {
+	Opcodes map[string]*OpcodeStat `json:"opcodes"`
+	Depths  []*DepthStat           `json:"depths"`
+}
+
+// callFrame tracks whether a call executed any opcode, so that the gas used by
+// precompiles and plain transfers is attributed to the calling opcode.
+type callFrame struct {
+	op       vm.OpCode
+	depth    int
+	executed bool
+}
+
+// opcodeProfiler aggregates the gas and the number of executions per opcode and
+// per call depth, instead of keeping a struct log per executed opcode.
+//
+// The gas forwarded by the CALL family of opcodes is not accounted to them, but
+// to the opcodes executed by the sub call, so that the gas of all the opcodes
+// adds up to the execution gas of the tx.
+//
+// Example:
+//
+//	> debug.traceTransaction("0x...", {tracer: "opcodeProfiler"})
+//	{
+//	  "opcodes": {"PUSH1": {"count": 12, "gas": 36}, "SSTORE": {"count": 1, "gas": 22100}, ...},
+//	  "depths": [{"depth": 1, "count": 98, "gas": 24330}, {"depth": 2, "count": 40, "gas": 5210}]
+//	}
+type opcodeProfiler struct {
+	opcodes map[vm.OpCode]*OpcodeStat
+	depths  []*DepthStat
+	frames  []*callFrame
+
+	lastOp    vm.OpCode
+	lastDepth int
+
+	interrupt atomic.Bool // Atomic flag to signal execution interruption
+	reason    error       // Textual reason for the interruption
+}
+
+// NewOpcodeProfiler returns a native go tracer which aggregates the gas and the
+// number of executions per opcode and per call depth.
+func NewOpcodeProfiler(_ *tracers.Context, _ json.RawMessage, _ *params.ChainConfig) (*tracers.Tracer, error) {
+	t := &opcodeProfiler{
+		opcodes: make(map[vm.OpCode]*OpcodeStat),
+	}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnOpcode: t.OnOpcode,
+			OnEnter:  t.OnEnter,
+			OnExit:   t.OnExit,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+// OnOpcode accounts the cost of the opcode to the opcode and the call depth.
+func (t *opcodeProfiler) OnOpcode(_ uint64, op byte, _, cost uint64, _ tracing.OpContext, _ []byte, depth int, _ error) {
+	if t.interrupt.Load() {
+		return
+	}
+
+	opcode := vm.OpCode(op)
+	t.opcodeStat(opcode).add(1, cost)
+	t.depthStat(depth).add(1, cost)
+	t.lastOp, t.lastDepth = opcode, depth
+	if len(t.frames) > 0 {
+		t.frames[len(t.frames)-1].executed = true
+	}
+}
+
+// OnEnter moves the gas forwarded by a CALL family opcode to the sub call.
+func (t *opcodeProfiler) OnEnter(depth int, _ byte, _ common.Address, _ common.Address, _ []byte, gas uint64, _ *big.Int) {
+	if t.interrupt.Load() {
+		return
+	}
+
+	frame := &callFrame{op: t.lastOp, depth: t.lastDepth}
+	t.frames = append(t.frames, frame)
+	if depth == 0 {
+		return
+	}
+
+	switch frame.op {
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		t.opcodeStat(frame.op).sub(gas)
+		t.depthStat(frame.depth).sub(gas)
+	}
+}
+
+// OnExit accounts the gas of calls that didn't execute any opcode (precompiles
+// and transfers) to the calling opcode.
+func (t *opcodeProfiler) OnExit(depth int, _ []byte, gasUsed uint64, _ error, _ bool) {
+	if t.interrupt.Load() || len(t.frames) == 0 {
+		return
+	}
+
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if depth == 0 || frame.executed {
+		return
+	}
+	t.opcodeStat(frame.op).add(0, gasUsed)
+	t.depthStat(frame.depth).add(0, gasUsed)
+}
+
+// GetResult returns the json-encoded opcode profile.
+func (t *opcodeProfiler) GetResult() (json.RawMessage, error) {
+	profile := OpcodeProfile{
+		Opcodes: make(map[string]*OpcodeStat, len(t.opcodes)),
+		Depths:  make([]*DepthStat, 0, len(t.depths)),
+	}
+	for op, stat := range t.opcodes {
+		profile.Opcodes[op.String()] = stat
+	}
+	for _, stat := range t.depths {
+		if stat != nil {
+			profile.Depths = append(profile.Depths, stat)
+		}
+	}
+
+	res, err := json.Marshal(profile)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *opcodeProfiler) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+func (t *opcodeProfiler) opcodeStat(op vm.OpCode) *OpcodeStat {
+	stat, ok := t.opcodes[op]
+	if !ok {
+		stat = &OpcodeStat{}
+		t.opcodes[op] = stat
+	}
+	return stat
+}
+
+func (t *opcodeProfiler) depthStat(depth int) *OpcodeStat {
+	for len(t.depths) <= depth {
+		t.depths = append(t.depths, nil)
+	}
+	if t.depths[depth] == nil {
+		t.depths[depth] = &DepthStat{Depth: depth}
+	}
+	return &t.depths[depth].OpcodeStat
+}
+
+func (s *OpcodeStat) add(count, gas uint64) {
+	s.Count += count
+	s.Gas += gas
+}
+
+func (s *OpcodeStat) sub(gas uint64) {
+	if gas > s.Gas {
+		s.Gas = 0
+		return
+	}
+	s.Gas -= gas
+}
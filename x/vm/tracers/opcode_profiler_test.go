@@ -0,0 +1,66 @@
+package tracers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/stretchr/testify/require"
+
+	evmtracers "github.com/cosmos/evm/x/vm/tracers"
+)
+
+func TestOpcodeProfiler(t *testing.T) {
+	tracer, err := tracers.DefaultDirectory.New(evmtracers.OpcodeProfilerName, &tracers.Context{}, nil, nil)
+	require.NoError(t, err)
+
+	contract := common.BigToAddress(big.NewInt(1000))
+	precompile := common.BytesToAddress([]byte{0x1})
+	hooks := tracer.Hooks
+
+	hooks.OnEnter(0, byte(vm.CALL), common.Address{}, contract, nil, 100000, nil)
+	hooks.OnOpcode(0, byte(vm.PUSH1), 100000, 3, nil, nil, 1, nil)
+	// call to a contract forwarding 9000 gas, which spends 3
+	hooks.OnOpcode(2, byte(vm.CALL), 99997, 10000, nil, nil, 1, nil)
+	hooks.OnEnter(1, byte(vm.CALL), contract, contract, nil, 9000, nil)
+	hooks.OnOpcode(0, byte(vm.PUSH1), 9000, 3, nil, nil, 2, nil)
+	hooks.OnExit(1, nil, 3, nil, false)
+	// call to a precompile forwarding 4000 gas, which spends 100
+	hooks.OnOpcode(3, byte(vm.STATICCALL), 90994, 5000, nil, nil, 1, nil)
+	hooks.OnEnter(1, byte(vm.STATICCALL), contract, precompile, nil, 4000, nil)
+	hooks.OnExit(1, nil, 100, nil, false)
+	hooks.OnOpcode(4, byte(vm.STOP), 89894, 0, nil, nil, 1, nil)
+	hooks.OnExit(0, nil, 10106, nil, false)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var profile evmtracers.OpcodeProfile
+	require.NoError(t, json.Unmarshal(res, &profile))
+	require.Equal(t, map[string]*evmtracers.OpcodeStat{
+		"PUSH1":      {Count: 2, Gas: 6},
+		"CALL":       {Count: 1, Gas: 1000},
+		"STATICCALL": {Count: 1, Gas: 1100},
+		"STOP":       {Count: 1, Gas: 0},
+	}, profile.Opcodes)
+	require.Equal(t, []*evmtracers.DepthStat{
+		{Depth: 1, OpcodeStat: evmtracers.OpcodeStat{Count: 4, Gas: 2103}},
+		{Depth: 2, OpcodeStat: evmtracers.OpcodeStat{Count: 1, Gas: 3}},
+	}, profile.Depths)
+}
+
+func TestOpcodeProfilerStop(t *testing.T) {
+	tracer, err := tracers.DefaultDirectory.New(evmtracers.OpcodeProfilerName, &tracers.Context{}, nil, nil)
+	require.NoError(t, err)
+
+	tracer.Stop(errors.New("execution timeout"))
+	tracer.Hooks.OnOpcode(0, byte(vm.PUSH1), 100000, 3, nil, nil, 1, nil)
+
+	res, err := tracer.GetResult()
+	require.EqualError(t, err, "execution timeout")
+	require.JSONEq(t, `{"opcodes":{},"depths":[]}`, string(res))
+}
@@ -3,6 +3,10 @@ package types
 import (
 	"errors"
 	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
 
 	gethparams "github.com/ethereum/go-ethereum/params"
 
@@ -15,7 +19,68 @@ const testChainID uint64 = 262144
 
 // chainConfig is the chain configuration used in the EVM to defined which
 // opcodes are active based on Ethereum upgrades.
-var chainConfig *ChainConfig
+var chainConfig activeChainConfig
+
+// ForkActivation is the activation of a fork by an upgrade, at the block height
+// and time of the upgrade.
+type ForkActivation struct {
+	Fork      string
+	Height    int64
+	Timestamp uint64
+}
+
+// activeChainConfig is the configured chain configuration with the forks
+// activated by the upgrades. The block execution updates the activated forks
+// while the CheckTx, the queries and the JSON-RPC server read the config
+// concurrently, so the config is swapped atomically.
+type activeChainConfig struct {
+	config atomic.Pointer[ChainConfig]
+
+	// mu guards the updates of the config
+	mu sync.Mutex
+	// base is the chain configuration set by the EVMConfigurator
+	base *ChainConfig
+	// activations are the fork activations applied to the base config
+	activations []ForkActivation
+}
+
+// get returns the chain configuration with the activated forks
+func (a *activeChainConfig) get() *ChainConfig {
+	return a.config.Load()
+}
+
+// set sets the configured chain configuration, without any activated fork
+func (a *activeChainConfig) set(cc *ChainConfig) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.base, a.activations = cc, nil
+	a.config.Store(cc)
+}
+
+// setForkActivations sets the chain configuration to the configured one with
+// the given forks activated. The config is only swapped if the activations
+// changed, so that the values derived from it stay cached.
+func (a *activeChainConfig) setForkActivations(activations []ForkActivation) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.base == nil {
+		return errors.New("chainConfig not set")
+	}
+	if slices.Equal(a.activations, activations) {
+		return nil
+	}
+
+	cc := a.base
+	for _, activation := range activations {
+		var err error
+		if cc, err = cc.WithForkActivated(activation.Fork, activation.Height, activation.Timestamp); err != nil {
+			return err
+		}
+	}
+	a.activations = slices.Clone(activations)
+	a.config.Store(cc)
+	return nil
+}
 
 // EthereumConfig returns an Ethereum ChainConfig for EVM state transitions.
 // All the negative or nil values are converted to nil
@@ -115,7 +180,7 @@ func DefaultChainConfig(evmChainID uint64) *ChainConfig {
 // default values. The method is private because it should only be called once
 // in the EVMConfigurator.
 func setChainConfig(cc *ChainConfig) error {
-	if chainConfig != nil {
+	if chainConfig.get() != nil {
 		return errors.New("chainConfig already set. Cannot set again the chainConfig")
 	}
 	config := DefaultChainConfig(0)
@@ -125,11 +190,76 @@ func setChainConfig(cc *ChainConfig) error {
 	if err := config.Validate(); err != nil {
 		return err
 	}
-	chainConfig = config
+	chainConfig.set(config)
 
 	return nil
 }
 
+// blockForks maps the name of the block based forks that can be activated by
+// an upgrade plan to their activation block in the ChainConfig.
+var blockForks = map[string]func(cc *ChainConfig) **sdkmath.Int{
+	"homestead":      func(cc *ChainConfig) **sdkmath.Int { return &cc.HomesteadBlock },
+	"dao":            func(cc *ChainConfig) **sdkmath.Int { return &cc.DAOForkBlock },
+	"eip150":         func(cc *ChainConfig) **sdkmath.Int { return &cc.EIP150Block },
+	"eip155":         func(cc *ChainConfig) **sdkmath.Int { return &cc.EIP155Block },
+	"eip158":         func(cc *ChainConfig) **sdkmath.Int { return &cc.EIP158Block },
+	"byzantium":      func(cc *ChainConfig) **sdkmath.Int { return &cc.ByzantiumBlock },
+	"constantinople": func(cc *ChainConfig) **sdkmath.Int { return &cc.ConstantinopleBlock },
+	"petersburg":     func(cc *ChainConfig) **sdkmath.Int { return &cc.PetersburgBlock },
+	"istanbul":       func(cc *ChainConfig) **sdkmath.Int { return &cc.IstanbulBlock },
+	"muirglacier":    func(cc *ChainConfig) **sdkmath.Int { return &cc.MuirGlacierBlock },
+	"berlin":         func(cc *ChainConfig) **sdkmath.Int { return &cc.BerlinBlock },
+	"london":         func(cc *ChainConfig) **sdkmath.Int { return &cc.LondonBlock },
+	"arrowglacier":   func(cc *ChainConfig) **sdkmath.Int { return &cc.ArrowGlacierBlock },
+	"grayglacier":    func(cc *ChainConfig) **sdkmath.Int { return &cc.GrayGlacierBlock },
+	"mergenetsplit":  func(cc *ChainConfig) **sdkmath.Int { return &cc.MergeNetsplitBlock },
+}
+
+// timeForks maps the name of the timestamp based forks that can be activated
+// by an upgrade plan to their activation time in the ChainConfig.
+var timeForks = map[string]func(cc *ChainConfig) **sdkmath.Int{
+	"shanghai": func(cc *ChainConfig) **sdkmath.Int { return &cc.ShanghaiTime },
+	"cancun":   func(cc *ChainConfig) **sdkmath.Int { return &cc.CancunTime },
+	"prague":   func(cc *ChainConfig) **sdkmath.Int { return &cc.PragueTime },
+	"osaka":    func(cc *ChainConfig) **sdkmath.Int { return &cc.OsakaTime },
+}
+
+// PendingForks returns the sorted names of the forks that are not activated
+// in the ChainConfig and can be activated by an upgrade plan.
+func (cc ChainConfig) PendingForks() []string {
+	var forks []string
+	for _, table := range []map[string]func(cc *ChainConfig) **sdkmath.Int{blockForks, timeForks} {
+		for name, field := range table {
+			if *field(&cc) == nil {
+				forks = append(forks, name)
+			}
+		}
+	}
+	sort.Strings(forks)
+	return forks
+}
+
+// WithForkActivated returns a copy of the ChainConfig with the given fork
+// activated at the provided block height, or at the block time for the
+// timestamp based forks.
+func (cc ChainConfig) WithForkActivated(fork string, height int64, timestamp uint64) (*ChainConfig, error) {
+	var value sdkmath.Int
+	field, ok := blockForks[fork]
+	if ok {
+		value = sdkmath.NewInt(height)
+	} else if field, ok = timeForks[fork]; ok {
+		value = sdkmath.NewIntFromUint64(timestamp)
+	} else {
+		return nil, errorsmod.Wrapf(ErrInvalidChainConfig, "unknown fork %s", fork)
+	}
+
+	*field(&cc) = &value
+	if err := cc.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(err, "cannot activate fork %s", fork)
+	}
+	return &cc, nil
+}
+
 func getBlockValue(block *sdkmath.Int) *big.Int {
 	if block == nil || block.IsNegative() {
 		return nil
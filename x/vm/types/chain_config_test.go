@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -353,3 +354,83 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestChainConfigWithForkActivated(t *testing.T) {
+	pending := *types.DefaultChainConfig(0)
+	pending.PragueTime = nil
+	require.Equal(t, []string{"osaka", "prague"}, pending.PendingForks())
+	outOfOrder := pending
+	outOfOrder.OsakaTime = newIntPtr(500)
+
+	testCases := []struct {
+		name     string
+		config   types.ChainConfig
+		fork     string
+		expError bool
+	}{
+		{"unknown fork", pending, "verkle", true},
+		{"out of order fork", outOfOrder, "prague", true},
+		{"time based fork", pending, "prague", false},
+		{"block based fork", types.ChainConfig{}, "homestead", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cc, err := tc.config.WithForkActivated(tc.fork, 10, 1000)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotContains(t, cc.PendingForks(), tc.fork)
+			// the original config is left untouched
+			require.Contains(t, tc.config.PendingForks(), tc.fork)
+		})
+	}
+
+	cc, err := pending.WithForkActivated("prague", 10, 1000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), *cc.EthereumConfig(nil).PragueTime)
+	cc, err = types.ChainConfig{}.WithForkActivated("homestead", 10, 1000)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), cc.EthereumConfig(nil).HomesteadBlock.Int64())
+}
+
+func TestSetForkActivations(t *testing.T) {
+	pending := types.DefaultChainConfig(0)
+	pending.PragueTime = nil
+	pending.OsakaTime = nil
+	configurator := types.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	defer configurator.ResetTestConfig()
+	require.NoError(t, configurator.
+		WithChainConfig(pending).
+		WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID]).
+		Configure())
+
+	// the config is read concurrently while the forks are activated
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_ = types.GetEthChainConfig().PragueTime
+		}
+	}()
+
+	activations := []types.ForkActivation{{Fork: "prague", Height: 10, Timestamp: 1000}, {Fork: "osaka", Height: 20, Timestamp: 2000}}
+	require.NoError(t, types.SetForkActivations(activations))
+	<-done
+	require.Equal(t, uint64(1000), *types.GetEthChainConfig().PragueTime)
+	require.Equal(t, uint64(2000), *types.GetEthChainConfig().OsakaTime)
+
+	// the same activations keep the config
+	cc := types.GetChainConfig()
+	require.NoError(t, types.SetForkActivations(activations))
+	require.Same(t, cc, types.GetChainConfig())
+
+	// the activations are applied to the configured config, not the current one
+	require.NoError(t, types.SetForkActivations(nil))
+	require.Equal(t, pending, types.GetChainConfig())
+	require.Error(t, types.SetForkActivations([]types.ForkActivation{{Fork: "verkle"}}))
+	require.Equal(t, pending, types.GetChainConfig())
+}
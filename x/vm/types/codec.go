@@ -25,7 +25,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "os/evm/MsgUpdateParams"
+	updateParamsName           = "os/evm/MsgUpdateParams"
+	recoverStuckFundsName      = "os/evm/MsgRecoverStuckFunds"
+	archiveContractStorageName = "os/evm/MsgArchiveContractStorage"
+	restoreContractStorageName = "os/evm/MsgRestoreContractStorage"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,6 +47,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgRecoverStuckFunds{},
+		&MsgArchiveContractStorage{},
+		&MsgRestoreContractStorage{},
 	)
 	registry.RegisterInterface(
 		"os.vm.v1.TxData",
@@ -91,4 +97,7 @@ func UnpackTxData(anyTxData *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRecoverStuckFunds{}, recoverStuckFundsName, nil)
+	cdc.RegisterConcrete(&MsgArchiveContractStorage{}, archiveContractStorageName, nil)
+	cdc.RegisterConcrete(&MsgRestoreContractStorage{}, restoreContractStorageName, nil)
 }
@@ -30,6 +30,10 @@ func (ec *EVMConfigurator) Configure() error {
 		return err
 	}
 
+	if err := setGasRatio(ec.gasRatio); err != nil {
+		return err
+	}
+
 	if err := extendDefaultExtraEIPs(ec.extendedDefaultExtraEIPs); err != nil {
 		return err
 	}
@@ -51,10 +55,16 @@ func (ec *EVMConfigurator) ResetTestConfig() {
 
 // GetEthChainConfig returns the `chainConfig` used in the EVM (geth type).
 func GetEthChainConfig() *geth.ChainConfig {
-	return chainConfig.EthereumConfig(nil)
+	return chainConfig.get().EthereumConfig(nil)
 }
 
 // GetChainConfig returns the `chainConfig`.
 func GetChainConfig() *ChainConfig {
-	return chainConfig
+	return chainConfig.get()
+}
+
+// SetForkActivations sets the `chainConfig` to the configured one with the
+// given forks activated, in order.
+func SetForkActivations(activations []ForkActivation) error {
+	return chainConfig.setForkActivations(activations)
 }
@@ -17,7 +17,7 @@ import (
 
 // testChainConfig is the chain configuration used in the EVM to defined which
 // opcodes are active based on Ethereum upgrades.
-var testChainConfig *ChainConfig
+var testChainConfig activeChainConfig
 
 // Configure applies the changes to the virtual machine configuration.
 func (ec *EVMConfigurator) Configure() error {
@@ -35,6 +35,10 @@ func (ec *EVMConfigurator) Configure() error {
 		return err
 	}
 
+	if err := setGasRatio(ec.gasRatio); err != nil {
+		return err
+	}
+
 	if err := extendDefaultExtraEIPs(ec.extendedDefaultExtraEIPs); err != nil {
 		return err
 	}
@@ -53,11 +57,12 @@ func (ec *EVMConfigurator) Configure() error {
 func (ec *EVMConfigurator) ResetTestConfig() {
 	vm.ResetActivators()
 	resetEVMCoinInfo()
-	testChainConfig = nil
+	gasRatio = DefaultGasRatio
+	testChainConfig.set(nil)
 }
 
 func setTestChainConfig(cc *ChainConfig) error {
-	if testChainConfig != nil {
+	if testChainConfig.get() != nil {
 		return errors.New("chainConfig already set. Cannot set again the chainConfig. Call the configurators ResetTestConfig method before configuring a new chain.")
 	}
 	config := DefaultChainConfig(0)
@@ -67,16 +72,22 @@ func setTestChainConfig(cc *ChainConfig) error {
 	if err := config.Validate(); err != nil {
 		return err
 	}
-	testChainConfig = config
+	testChainConfig.set(config)
 	return nil
 }
 
 // GetEthChainConfig returns the `chainConfig` used in the EVM (geth type).
 func GetEthChainConfig() *geth.ChainConfig {
-	return testChainConfig.EthereumConfig(nil)
+	return testChainConfig.get().EthereumConfig(nil)
 }
 
 // GetChainConfig returns the `chainConfig`.
 func GetChainConfig() *ChainConfig {
-	return testChainConfig
+	return testChainConfig.get()
+}
+
+// SetForkActivations sets the `chainConfig` to the configured one with the
+// given forks activated, in order.
+func SetForkActivations(activations []ForkActivation) error {
+	return testChainConfig.setForkActivations(activations)
 }
@@ -20,6 +20,7 @@ type EVMConfigurator struct {
 	extendedDefaultExtraEIPs []int64
 	chainConfig              *ChainConfig
 	evmCoinInfo              EvmCoinInfo
+	gasRatio                 *GasRatio
 }
 
 // NewEVMConfigurator returns a pointer to a new EVMConfigurator object.
@@ -55,6 +56,13 @@ func (ec *EVMConfigurator) WithEVMCoinInfo(coinInfo EvmCoinInfo) *EVMConfigurato
 	return ec
 }
 
+// WithGasRatio allows to define the ratio used to translate the gas consumed on
+// the Cosmos side into EVM gas, and vice versa. It defaults to DefaultGasRatio.
+func (ec *EVMConfigurator) WithGasRatio(ratio GasRatio) *EVMConfigurator {
+	ec.gasRatio = &ratio
+	return ec
+}
+
 func extendDefaultExtraEIPs(extraEIPs []int64) error {
 	for _, eip := range extraEIPs {
 		if slices.Contains(DefaultExtraEIPs, eip) {
@@ -32,6 +32,13 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrBlockGasLimitExceeded
+	codeErrStateDBCommit
+	codeErrBlockedAddress
+	codeErrInvalidGasUsed
+	codeErrContractStorageArchived
+	codeErrExecutionAborted
+	codeErrBatchedTxFailed
 )
 
 var (
@@ -92,6 +99,30 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrBlockGasLimitExceeded returns an error if the gas used by a tx exceeds the gas left in the block.
+	// The tx fee is deducted in the ante handler, so the tx is still part of the EVM block.
+	ErrBlockGasLimitExceeded = errorsmod.Register(ModuleName, codeErrBlockGasLimitExceeded, "block gas limit exceeded")
+
+	// ErrStateDBCommit returns an error if the state changes of an executed tx can't be committed.
+	// The tx fee is deducted in the ante handler, so the tx is still part of the EVM block.
+	ErrStateDBCommit = errorsmod.Register(ModuleName, codeErrStateDBCommit, "failed to commit stateDB")
+
+	// ErrBlockedAddress returns an error if an EVM value transfer is sent to a blocked module account.
+	ErrBlockedAddress = errorsmod.Register(ModuleName, codeErrBlockedAddress, "blocked address")
+
+	// ErrInvalidGasUsed returns an error if the gas used by the eth txs of a block exceeds the block gas consumption.
+	ErrInvalidGasUsed = errorsmod.Register(ModuleName, codeErrInvalidGasUsed, "invalid gas used")
+
+	// ErrContractStorageArchived returns an error if an EVM execution accesses the archived storage of a contract.
+	ErrContractStorageArchived = errorsmod.Register(ModuleName, codeErrContractStorageArchived, "contract storage archived")
+
+	// ErrExecutionAborted returns an error if an EVM execution is interrupted before its end, e.g. on the deadline of a query.
+	ErrExecutionAborted = errorsmod.Register(ModuleName, codeErrExecutionAborted, "execution aborted")
+
+	// ErrBatchedTxFailed returns an error if an eth tx batched with others in a cosmos tx fails, reverting the batch.
+	// The tx fees are deducted in the ante handler, so the txs of the batch are still part of the EVM block, as failed txs.
+	ErrBatchedTxFailed = errorsmod.Register(ModuleName, codeErrBatchedTxFailed, "batched evm transaction failed")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// EthereumTx is the gogoproto custom type of the MsgEthereumTx raw field. It
+// keeps the transaction decoded from its binary encoding, so that it's decoded
+// a single time per message instead of being rebuilt from the TxData on every
+// AsTransaction call.
+type EthereumTx struct {
+	*ethtypes.Transaction
+}
+
+// NewEthereumTx wraps an ethereum transaction into the raw field type
+func NewEthereumTx(tx *ethtypes.Transaction) *EthereumTx {
+	return &EthereumTx{tx}
+}
+
+// Size returns the length of the binary encoding of the transaction, which is
+// cached by the transaction once it's been decoded or encoded.
+func (tx EthereumTx) Size() int {
+	if tx.Transaction == nil {
+		return 0
+	}
+	return int(tx.Transaction.Size()) //#nosec G115 -- tx size is bounded by the block size
+}
+
+// Marshal returns the binary encoding of the transaction
+func (tx EthereumTx) Marshal() ([]byte, error) {
+	if tx.Transaction == nil {
+		return nil, nil
+	}
+	return tx.MarshalBinary()
+}
+
+// MarshalTo writes the binary encoding of the transaction to data
+func (tx *EthereumTx) MarshalTo(data []byte) (int, error) {
+	bz, err := tx.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) != tx.Size() {
+		return 0, errors.New("invalid ethereum tx encoding size")
+	}
+	return copy(data, bz), nil
+}
+
+// Unmarshal decodes the binary encoding of the transaction
+func (tx *EthereumTx) Unmarshal(data []byte) error {
+	decoded := new(ethtypes.Transaction)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	tx.Transaction = decoded
+	return nil
+}
+
+// MarshalJSON encodes the binary encoding of the transaction as a hex string
+func (tx EthereumTx) MarshalJSON() ([]byte, error) {
+	bz, err := tx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(hexutil.Bytes(bz))
+}
+
+// UnmarshalJSON decodes a transaction from the hex string of its binary encoding
+func (tx *EthereumTx) UnmarshalJSON(data []byte) error {
+	var bz hexutil.Bytes
+	if err := json.Unmarshal(data, &bz); err != nil {
+		return err
+	}
+	return tx.Unmarshal(bz)
+}
@@ -4,18 +4,34 @@ package types
 const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
 	EventTypeBlockBloom = "block_bloom"
-	EventTypeTxLog      = "tx_log"
-	EventTypeFeeMarket  = "evm_fee_market"
+	// EventTypeTxLog is no longer emitted, the logs are only returned in the
+	// msg response. It's kept to parse the logs of the legacy tx results.
+	EventTypeTxLog            = "tx_log"
+	EventTypeFeeMarket        = "evm_fee_market"
+	EventTypeTxWitness        = "tx_witness"
+	EventTypeModifiedAccounts = "tx_modified_accounts"
+	// EventTypeInternalTx is emitted for the evm calls committed by the cosmos
+	// msgs, e.g. the erc20 mints of the coin conversions.
+	EventTypeInternalTx = "internal_ethereum_tx"
+	// EventTypeFeeSponsorship is emitted for the eth txs whose fees are paid by
+	// a sponsor through a fee allowance.
+	EventTypeFeeSponsorship = "fee_sponsorship"
 
-	AttributeKeyBaseFee         = "base_fee"
-	AttributeKeyContractAddress = "contract"
-	AttributeKeyRecipient       = "recipient"
-	AttributeKeyTxHash          = "txHash"
-	AttributeKeyEthereumTxHash  = "ethereumTxHash"
-	AttributeKeyTxIndex         = "txIndex"
-	AttributeKeyTxGasUsed       = "txGasUsed"
-	AttributeKeyTxType          = "txType"
-	AttributeKeyTxLog           = "txLog"
+	AttributeKeyBaseFee          = "base_fee"
+	AttributeKeyContractAddress  = "contract"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeySender           = "sender"
+	AttributeKeyTxHash           = "txHash"
+	AttributeKeyEthereumTxHash   = "ethereumTxHash"
+	AttributeKeyTxIndex          = "txIndex"
+	AttributeKeyTxGasUsed        = "txGasUsed"
+	AttributeKeyTxType           = "txType"
+	AttributeKeyTxLog            = "txLog"
+	AttributeKeyTxLogs           = "txLogs"
+	AttributeKeyTxWitness        = "witness"
+	AttributeKeyModifiedAccounts = "accounts"
+	AttributeKeySponsor          = "sponsor"
+	AttributeKeyGrantee          = "grantee"
 
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
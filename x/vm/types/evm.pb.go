@@ -74,6 +74,30 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// evm_chain_id pins the EIP-155 chain id of the chain, so that a chain
+	// can't be started with an EVM chain config of a different chain id.
+	// It's not pinned when it's 0.
+	EVMChainID uint64 `protobuf:"varint,10,opt,name=evm_chain_id,json=evmChainId,proto3" json:"evm_chain_id,omitempty"`
+	// unprotected_txs_allowlist defines the slice of hex addresses allowed to
+	// submit unprotected (i.e non EIP155 signed) transactions when
+	// allow_unprotected_txs is disabled.
+	UnprotectedTxsAllowlist []string `protobuf:"bytes,11,rep,name=unprotected_txs_allowlist,json=unprotectedTxsAllowlist,proto3" json:"unprotected_txs_allowlist,omitempty"`
+	// scheduled_eips defines the additional EIPs for the vm.Config that are
+	// only activated from a block height, on top of the extra_eips
+	ScheduledEIPs []ScheduledEIP `protobuf:"bytes,12,rep,name=scheduled_eips,json=scheduledEips,proto3" json:"scheduled_eips"`
+	// empty_account_sweep_batch is the number of accounts scanned at the end of
+	// every block for the empty accounts (zero nonce and balance, no code) to
+	// remove, as EIP-158 does. 0 disables the sweep.
+	EmptyAccountSweepBatch uint64 `protobuf:"varint,13,opt,name=empty_account_sweep_batch,json=emptyAccountSweepBatch,proto3" json:"empty_account_sweep_batch,omitempty"`
+	// storage_expiry_blocks is the number of blocks after which the storage of a
+	// contract which hasn't been read or written can be archived, until it's
+	// restored from its entries. 0 disables the recording of the contract
+	// accesses and the archival.
+	StorageExpiryBlocks uint64 `protobuf:"varint,14,opt,name=storage_expiry_blocks,json=storageExpiryBlocks,proto3" json:"storage_expiry_blocks,omitempty"`
+	// ibc_callback_contracts_allowlist defines the slice of hex addresses of the
+	// contracts the destination callbacks of the received ICS-20 packets can
+	// call. Any contract can be called when it's empty.
+	IBCCallbackContractsAllowlist []string `protobuf:"bytes,15,rep,name=ibc_callback_contracts_allowlist,json=ibcCallbackContractsAllowlist,proto3" json:"ibc_callback_contracts_allowlist,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -151,6 +175,104 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetEVMChainID() uint64 {
+	if m != nil {
+		return m.EVMChainID
+	}
+	return 0
+}
+
+func (m *Params) GetUnprotectedTxsAllowlist() []string {
+	if m != nil {
+		return m.UnprotectedTxsAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetScheduledEIPs() []ScheduledEIP {
+	if m != nil {
+		return m.ScheduledEIPs
+	}
+	return nil
+}
+
+func (m *Params) GetEmptyAccountSweepBatch() uint64 {
+	if m != nil {
+		return m.EmptyAccountSweepBatch
+	}
+	return 0
+}
+
+func (m *Params) GetStorageExpiryBlocks() uint64 {
+	if m != nil {
+		return m.StorageExpiryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetIBCCallbackContractsAllowlist() []string {
+	if m != nil {
+		return m.IBCCallbackContractsAllowlist
+	}
+	return nil
+}
+
+// ScheduledEIP defines an additional EIP for the vm.Config activated from a
+// block height
+type ScheduledEIP struct {
+	// eip is the number of the EIP
+	EIP int64 `protobuf:"varint,1,opt,name=eip,proto3" json:"eip,omitempty"`
+	// height is the block height the EIP is activated from
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ScheduledEIP) Reset()         { *m = ScheduledEIP{} }
+func (m *ScheduledEIP) String() string { return proto.CompactTextString(m) }
+func (*ScheduledEIP) ProtoMessage()    {}
+func (*ScheduledEIP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{1}
+}
+func (m *ScheduledEIP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledEIP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledEIP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledEIP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledEIP.Merge(m, src)
+}
+func (m *ScheduledEIP) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledEIP) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledEIP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledEIP proto.InternalMessageInfo
+
+func (m *ScheduledEIP) GetEIP() int64 {
+	if m != nil {
+		return m.EIP
+	}
+	return 0
+}
+
+func (m *ScheduledEIP) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -164,7 +286,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{1}
+	return fileDescriptor_d1129b8db63d55c7, []int{2}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,7 +347,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{2}
+	return fileDescriptor_d1129b8db63d55c7, []int{3}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,7 +457,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{3}
+	return fileDescriptor_d1129b8db63d55c7, []int{4}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,7 +526,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{4}
+	return fileDescriptor_d1129b8db63d55c7, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,6 +569,72 @@ func (m *State) GetValue() string {
 	return ""
 }
 
+// StorageProof defines an entry of an archived storage with the proof of its
+// inclusion in the root of the archived storage.
+type StorageProof struct {
+	// state is the key value pair of the entry
+	State State `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	// index is the position of the entry in the archived storage sorted by key
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// siblings are the hex hashes of the sibling nodes on the path from the leaf
+	// of the entry to the root
+	Siblings []string `protobuf:"bytes,3,rep,name=siblings,proto3" json:"siblings,omitempty"`
+}
+
+func (m *StorageProof) Reset()         { *m = StorageProof{} }
+func (m *StorageProof) String() string { return proto.CompactTextString(m) }
+func (*StorageProof) ProtoMessage()    {}
+func (*StorageProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{6}
+}
+func (m *StorageProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageProof.Merge(m, src)
+}
+func (m *StorageProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageProof proto.InternalMessageInfo
+
+func (m *StorageProof) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return State{}
+}
+
+func (m *StorageProof) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *StorageProof) GetSiblings() []string {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
 // TransactionLogs define the logs generated from a transaction execution
 // with a given hash. It it used for import/export data as transactions are not
 // persisted on blockchain state after an upgrade.
@@ -461,7 +649,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{5}
+	return fileDescriptor_d1129b8db63d55c7, []int{7}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +725,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{6}
+	return fileDescriptor_d1129b8db63d55c7, []int{8}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,7 +840,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{7}
+	return fileDescriptor_d1129b8db63d55c7, []int{9}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +881,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{8}
+	return fileDescriptor_d1129b8db63d55c7, []int{10}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +941,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{9}
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -874,7 +1062,7 @@ func (m *Preinstall) Reset()         { *m = Preinstall{} }
 func (m *Preinstall) String() string { return proto.CompactTextString(m) }
 func (*Preinstall) ProtoMessage()    {}
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{10}
+	return fileDescriptor_d1129b8db63d55c7, []int{12}
 }
 func (m *Preinstall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -927,10 +1115,12 @@ func (m *Preinstall) GetCode() string {
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
+	proto.RegisterType((*ScheduledEIP)(nil), "cosmos.evm.vm.v1.ScheduledEIP")
 	proto.RegisterType((*AccessControl)(nil), "cosmos.evm.vm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "cosmos.evm.vm.v1.AccessControlType")
 	proto.RegisterType((*ChainConfig)(nil), "cosmos.evm.vm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "cosmos.evm.vm.v1.State")
+	proto.RegisterType((*StorageProof)(nil), "cosmos.evm.vm.v1.StorageProof")
 	proto.RegisterType((*TransactionLogs)(nil), "cosmos.evm.vm.v1.TransactionLogs")
 	proto.RegisterType((*Log)(nil), "cosmos.evm.vm.v1.Log")
 	proto.RegisterType((*TxResult)(nil), "cosmos.evm.vm.v1.TxResult")
@@ -942,132 +1132,148 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 1988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x5d, 0x6f, 0x1b, 0xc7,
-	0xd5, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xc9, 0x34, 0xed, 0x68, 0xf9, 0xee,
-	0xdb, 0x0b, 0xd5, 0x48, 0x25, 0x4b, 0x8e, 0x5a, 0xc3, 0xe9, 0x07, 0x44, 0x99, 0x69, 0xa5, 0xda,
-	0x0e, 0x31, 0x54, 0x12, 0xa4, 0x68, 0xb1, 0x18, 0xee, 0x8e, 0x97, 0x1b, 0xee, 0xee, 0x10, 0x3b,
-	0x4b, 0x86, 0xea, 0x2f, 0x08, 0x7c, 0x95, 0xfe, 0x00, 0x03, 0x01, 0x7a, 0x93, 0x4b, 0xff, 0x84,
-	0x5e, 0x06, 0xbd, 0xca, 0x65, 0x51, 0xa0, 0x8b, 0x82, 0xbe, 0x08, 0xa0, 0x4b, 0xfd, 0x82, 0x62,
-	0x3e, 0xf8, 0x29, 0x85, 0x55, 0x01, 0xc1, 0x9e, 0xe7, 0xcc, 0x39, 0xcf, 0x73, 0x66, 0xe6, 0xec,
-	0xec, 0x59, 0x82, 0x8a, 0x43, 0x59, 0x48, 0xd9, 0x3e, 0xe9, 0x87, 0xfb, 0xfc, 0xef, 0x80, 0x8f,
-	0xf6, 0xba, 0x31, 0x4d, 0x28, 0x34, 0xe4, 0xdc, 0x1e, 0xb7, 0xf0, 0xbf, 0x83, 0xca, 0x1d, 0x1c,
-	0xfa, 0x11, 0xdd, 0x17, 0xff, 0x4a, 0xa7, 0x4a, 0xc9, 0xa3, 0x1e, 0x15, 0xc3, 0x7d, 0x3e, 0x92,
-	0x56, 0xeb, 0x6d, 0x16, 0xac, 0x36, 0x70, 0x8c, 0x43, 0x06, 0x0f, 0x40, 0x8e, 0xf4, 0x43, 0xdb,
-	0x25, 0x11, 0x0d, 0xcb, 0x99, 0x6a, 0x66, 0x37, 0x57, 0x2b, 0x5d, 0xa5, 0xa6, 0x71, 0x81, 0xc3,
-	0xe0, 0xa9, 0x35, 0x9e, 0xb2, 0x90, 0x4e, 0xfa, 0xe1, 0x33, 0x3e, 0x84, 0xc7, 0x00, 0x90, 0x41,
-	0x12, 0x63, 0x9b, 0xf8, 0x5d, 0x56, 0xd6, 0xaa, 0xd9, 0xdd, 0x6c, 0xcd, 0x1a, 0xa6, 0x66, 0xae,
-	0xce, 0xad, 0xf5, 0xd3, 0x06, 0xbb, 0x4a, 0xcd, 0x3b, 0x8a, 0x60, 0xec, 0x68, 0xa1, 0x9c, 0x00,
-	0x75, 0xbf, 0xcb, 0xe0, 0x21, 0xd8, 0xc2, 0x41, 0x40, 0xbf, 0xb4, 0x7b, 0x11, 0xcf, 0x88, 0x38,
-	0x09, 0x71, 0xed, 0x64, 0xc0, 0xca, 0x2b, 0xd5, 0xcc, 0xae, 0x8e, 0x36, 0xc5, 0xe4, 0x27, 0x93,
-	0xb9, 0xf3, 0x01, 0x8f, 0x29, 0xf0, 0x74, 0x9c, 0x36, 0x8e, 0x22, 0x12, 0xb0, 0xf2, 0x5a, 0x35,
-	0xbb, 0x9b, 0xab, 0x6d, 0x0c, 0x53, 0x33, 0x5f, 0xff, 0xf4, 0xc5, 0x89, 0x32, 0xa3, 0x3c, 0xe9,
-	0x87, 0x23, 0x00, 0xff, 0x04, 0x8a, 0xd8, 0x71, 0x08, 0x63, 0xb6, 0x43, 0xa3, 0x24, 0xa6, 0x41,
-	0x59, 0xaf, 0x66, 0x76, 0xf3, 0x87, 0xe6, 0xde, 0xfc, 0xe6, 0xed, 0x1d, 0x0b, 0xbf, 0x13, 0xe9,
-	0x56, 0xdb, 0xfa, 0x2e, 0x35, 0x97, 0x86, 0xa9, 0xb9, 0x3e, 0x63, 0x46, 0xeb, 0x78, 0x1a, 0xc2,
-	0xa7, 0xe0, 0x1e, 0x76, 0x12, 0xbf, 0x4f, 0x6c, 0x96, 0xe0, 0xc4, 0x77, 0xec, 0x6e, 0x4c, 0x1c,
-	0x1a, 0x76, 0xfd, 0x80, 0xb0, 0x72, 0x8e, 0xe7, 0x87, 0xee, 0x4a, 0x87, 0xa6, 0x98, 0x6f, 0x4c,
-	0xa6, 0x9f, 0xde, 0x7f, 0xfd, 0xc3, 0xdb, 0x87, 0xdb, 0x53, 0xe7, 0x3b, 0xe0, 0x27, 0x2c, 0x4f,
-	0xe5, 0x4c, 0xd3, 0x97, 0x8d, 0xec, 0x99, 0xa6, 0x67, 0x0d, 0xed, 0x4c, 0xd3, 0x57, 0x8d, 0x35,
-	0xeb, 0x2f, 0x19, 0x30, 0x9b, 0x0b, 0x3c, 0x06, 0xab, 0x4e, 0x4c, 0x70, 0x42, 0xc4, 0xb1, 0xe5,
-	0x0f, 0xff, 0xff, 0xbf, 0xac, 0xe9, 0xfc, 0xa2, 0x4b, 0x6a, 0x1a, 0x5f, 0x17, 0x52, 0x81, 0xf0,
-	0x57, 0x40, 0x73, 0x70, 0x10, 0x94, 0x97, 0xff, 0x57, 0x02, 0x11, 0x66, 0xfd, 0x2b, 0x03, 0xee,
-	0x5c, 0xf3, 0x80, 0x0e, 0xc8, 0xab, 0x3d, 0x4f, 0x2e, 0xba, 0x32, 0xb9, 0xe2, 0xe1, 0x83, 0x1f,
-	0xe3, 0x16, 0xa4, 0x3f, 0x19, 0xa6, 0x26, 0x98, 0xe0, 0xab, 0xd4, 0x84, 0xb2, 0x7c, 0xa6, 0x88,
-	0x2c, 0x04, 0xf0, 0xd8, 0x03, 0x3a, 0x60, 0x73, 0xf6, 0x60, 0xed, 0xc0, 0x67, 0x49, 0x79, 0x59,
-	0xd4, 0xc4, 0xe3, 0x61, 0x6a, 0xce, 0x26, 0xf6, 0xdc, 0x67, 0xc9, 0x55, 0x6a, 0x56, 0x66, 0x58,
-	0xa7, 0x23, 0x2d, 0x74, 0x07, 0xcf, 0x07, 0x58, 0xdf, 0x1a, 0x20, 0x7f, 0xd2, 0xc6, 0x7e, 0x74,
-	0x42, 0xa3, 0x57, 0xbe, 0x07, 0xff, 0x08, 0x36, 0xda, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x5b,
-	0x01, 0x75, 0x3a, 0xea, 0x89, 0x79, 0xfc, 0xcf, 0xd4, 0xdc, 0x92, 0x0b, 0x64, 0x6e, 0x67, 0xcf,
-	0xa7, 0xfb, 0x21, 0x4e, 0xda, 0x7b, 0xa7, 0x11, 0x17, 0xdd, 0x96, 0xa2, 0x73, 0x91, 0x16, 0x2a,
-	0x8e, 0x2d, 0x35, 0x6e, 0x80, 0x6d, 0x50, 0x74, 0x31, 0xb5, 0x5f, 0xd1, 0xb8, 0xa3, 0xc8, 0x97,
-	0x05, 0x79, 0xed, 0x47, 0xc9, 0x87, 0xa9, 0x59, 0x78, 0x76, 0xfc, 0xf1, 0x47, 0x34, 0xee, 0x08,
-	0x8a, 0xab, 0xd4, 0xdc, 0x92, 0x62, 0xb3, 0x44, 0x16, 0x2a, 0xb8, 0x98, 0x8e, 0xdd, 0xe0, 0x67,
-	0xc0, 0x18, 0x3b, 0xb0, 0x5e, 0xb7, 0x4b, 0xe3, 0xa4, 0x9c, 0xe5, 0x0f, 0x5e, 0xed, 0x67, 0xc3,
-	0xd4, 0x2c, 0x2a, 0xca, 0xa6, 0x9c, 0xb9, 0x4a, 0xcd, 0xbb, 0x73, 0xa4, 0x2a, 0xc6, 0x42, 0x45,
-	0x45, 0xab, 0x5c, 0x61, 0x0b, 0x14, 0x88, 0xdf, 0x3d, 0x38, 0x7a, 0xa4, 0x16, 0xa0, 0x89, 0x05,
-	0xfc, 0x66, 0xd1, 0x02, 0xf2, 0xf5, 0xd3, 0xc6, 0xc1, 0xd1, 0xa3, 0x51, 0xfe, 0x9b, 0xea, 0xda,
-	0x98, 0x62, 0xb1, 0x50, 0x5e, 0x42, 0x99, 0xfc, 0x48, 0xe3, 0x48, 0x69, 0xac, 0xde, 0x56, 0xe3,
-	0xe8, 0x26, 0x8d, 0xa3, 0x59, 0x8d, 0xa3, 0x59, 0x8d, 0x27, 0x4a, 0x63, 0xed, 0xb6, 0x1a, 0x4f,
-	0x6e, 0xd2, 0x78, 0x32, 0xab, 0x21, 0x7d, 0x78, 0x31, 0xb5, 0x2e, 0xfe, 0x8c, 0xa3, 0xc4, 0xef,
-	0x85, 0x4a, 0x46, 0xbf, 0x75, 0x31, 0xcd, 0x45, 0x5a, 0xa8, 0x38, 0xb6, 0x48, 0xf6, 0x0e, 0x28,
-	0x39, 0x34, 0x62, 0x09, 0xb7, 0x45, 0xb4, 0x1b, 0x10, 0x25, 0x91, 0x13, 0x12, 0x4f, 0x16, 0x49,
-	0xdc, 0x97, 0x12, 0x37, 0x85, 0x5b, 0x68, 0x73, 0xd6, 0x2c, 0xc5, 0x6c, 0x60, 0x74, 0x49, 0x42,
-	0x62, 0xd6, 0xea, 0xc5, 0x9e, 0x12, 0x02, 0x42, 0xe8, 0x83, 0x45, 0x42, 0xaa, 0xac, 0xe6, 0x43,
-	0x2d, 0xb4, 0x31, 0x31, 0x49, 0x81, 0xcf, 0x41, 0xd1, 0xe7, 0xaa, 0xad, 0x5e, 0xa0, 0xe8, 0xf3,
-	0x82, 0xfe, 0x70, 0x11, 0xbd, 0x7a, 0x14, 0x66, 0x03, 0x2d, 0xb4, 0x3e, 0x32, 0x48, 0x6a, 0x17,
-	0xc0, 0xb0, 0xe7, 0xc7, 0xb6, 0x17, 0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x9f, 0x2f,
-	0xa2, 0xbf, 0x27, 0xe9, 0xaf, 0x07, 0x5b, 0xc8, 0xe0, 0xc6, 0xdf, 0x4a, 0x9b, 0x54, 0x69, 0x82,
-	0x42, 0x8b, 0xc4, 0x81, 0x1f, 0x29, 0xfe, 0x75, 0xc1, 0xff, 0x68, 0x11, 0xbf, 0xaa, 0xa0, 0xe9,
-	0x30, 0x0b, 0xe5, 0x25, 0x1c, 0x93, 0x06, 0x34, 0x72, 0xe9, 0x88, 0xf4, 0xce, 0xad, 0x49, 0xa7,
-	0xc3, 0x2c, 0x94, 0x97, 0x50, 0x92, 0x7a, 0x60, 0x13, 0xc7, 0x31, 0xfd, 0x72, 0x6e, 0x43, 0xa0,
-	0xe0, 0xfe, 0xc5, 0x22, 0xee, 0xd1, 0xe5, 0x7a, 0x3d, 0x9a, 0x5f, 0xae, 0xdc, 0x3a, 0xb3, 0x25,
-	0x2e, 0x80, 0x5e, 0x8c, 0x2f, 0xe6, 0x74, 0x4a, 0xb7, 0xde, 0xf8, 0xeb, 0xc1, 0x16, 0x32, 0xb8,
-	0x71, 0x46, 0xe5, 0x0b, 0x50, 0x0a, 0x49, 0xec, 0x11, 0x3b, 0x22, 0x09, 0xeb, 0x06, 0x7e, 0xa2,
-	0x74, 0xb6, 0x6e, 0xfd, 0x1c, 0xdc, 0x14, 0x6e, 0x21, 0x28, 0xcc, 0x2f, 0x95, 0x55, 0x6a, 0xdd,
-	0x03, 0xba, 0xc3, 0xdf, 0x16, 0xb6, 0xef, 0x96, 0xcb, 0xd5, 0xcc, 0xae, 0x86, 0xd6, 0x04, 0x3e,
-	0x75, 0x61, 0x09, 0xac, 0xc8, 0x0e, 0xeb, 0x1e, 0xd7, 0x45, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2,
-	0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x02, 0xc6, 0x18, 0x7e, 0x0a, 0xd6, 0x59, 0x1b, 0x47, 0x5e,
-	0x1b, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x5f, 0x64, 0x7c, 0xb0, 0x28, 0xe3, 0x92, 0xcc, 0x78,
-	0x26, 0xce, 0x42, 0x85, 0x11, 0x3e, 0xf7, 0x43, 0x02, 0x1b, 0x20, 0xef, 0xe0, 0xc8, 0xe9, 0x45,
-	0x92, 0xf5, 0x81, 0x60, 0xdd, 0x5f, 0xc4, 0xaa, 0x5e, 0xc5, 0x53, 0x51, 0x16, 0x02, 0x12, 0x8d,
-	0x18, 0xbb, 0x31, 0xf6, 0x7a, 0x44, 0x32, 0xbe, 0x77, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x46, 0x8c, 0x7d, 0x12, 0x77, 0x02, 0xc5, 0xb8, 0x73, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x04, 0xe3, 0x0b, 0x00, 0x28, 0xc3, 0x1d, 0x2c, 0x09, 0x4d, 0x41, 0xb8, 0xb7, 0x88, 0x50, 0xb5,
-	0xaf, 0x93, 0x20, 0x0b, 0xe5, 0x04, 0xe0, 0x74, 0x67, 0x9a, 0xbe, 0x62, 0xac, 0x9e, 0x69, 0xfa,
-	0xb6, 0x71, 0xf7, 0x4c, 0xd3, 0xef, 0x1a, 0x65, 0x6b, 0x1f, 0xac, 0xf0, 0x16, 0x8f, 0x40, 0x03,
-	0x64, 0x3b, 0xe4, 0x42, 0xf6, 0x05, 0x88, 0x0f, 0xf9, 0xd9, 0xf7, 0x71, 0xd0, 0x23, 0xf2, 0x75,
-	0x8e, 0x24, 0xb0, 0x1a, 0x60, 0xe3, 0x3c, 0xc6, 0x11, 0xe3, 0xed, 0x21, 0x8d, 0x9e, 0x53, 0x8f,
-	0x41, 0x08, 0xb4, 0x36, 0x66, 0x6d, 0x15, 0x2b, 0xc6, 0xf0, 0xa7, 0x40, 0x0b, 0xa8, 0xc7, 0x44,
-	0x63, 0x93, 0x3f, 0xdc, 0xba, 0xde, 0x45, 0x3d, 0xa7, 0x1e, 0x12, 0x2e, 0xd6, 0xdf, 0x97, 0x41,
-	0xf6, 0x39, 0xf5, 0x60, 0x19, 0xac, 0x61, 0xd7, 0x8d, 0x09, 0x63, 0x8a, 0x69, 0x04, 0xe1, 0x36,
-	0x58, 0x4d, 0x68, 0xd7, 0x77, 0x24, 0x5d, 0x0e, 0x29, 0xc4, 0x85, 0x5d, 0x9c, 0x60, 0xd1, 0x03,
-	0x14, 0x90, 0x18, 0xf3, 0x6e, 0x5b, 0x94, 0xba, 0x1d, 0xf5, 0xc2, 0x16, 0x89, 0xc5, 0xab, 0x5c,
-	0xab, 0x6d, 0x5c, 0xa6, 0x66, 0x5e, 0xd8, 0x5f, 0x0a, 0x33, 0x9a, 0x06, 0xf0, 0x7d, 0xb0, 0x96,
-	0x0c, 0x6c, 0xb1, 0x86, 0x15, 0xb1, 0xc5, 0x9b, 0x97, 0xa9, 0xb9, 0x91, 0x4c, 0x96, 0xf9, 0x3b,
-	0xcc, 0xda, 0x68, 0x35, 0x19, 0xf0, 0xff, 0xe1, 0x3e, 0xd0, 0x93, 0x81, 0xed, 0x47, 0x2e, 0x19,
-	0x88, 0x97, 0xb8, 0x56, 0x2b, 0x5d, 0xa6, 0xa6, 0x31, 0xe5, 0x7e, 0xca, 0xe7, 0xd0, 0x5a, 0x32,
-	0x10, 0x03, 0xf8, 0x3e, 0x00, 0x32, 0x25, 0xa1, 0x20, 0xdf, 0xc9, 0xeb, 0x97, 0xa9, 0x99, 0x13,
-	0x56, 0xc1, 0x3d, 0x19, 0x42, 0x0b, 0xac, 0x48, 0x6e, 0x5d, 0x70, 0x17, 0x2e, 0x53, 0x53, 0x0f,
-	0xa8, 0x27, 0x39, 0xe5, 0x14, 0xdf, 0xaa, 0x98, 0x84, 0xb4, 0x4f, 0x5c, 0xf1, 0x62, 0xd4, 0xd1,
-	0x08, 0x5a, 0x5f, 0x2f, 0x03, 0xfd, 0x7c, 0x80, 0x08, 0xeb, 0x05, 0x09, 0xfc, 0x08, 0x18, 0xa2,
-	0x57, 0xc4, 0x4e, 0x62, 0xcf, 0x6c, 0x6d, 0xed, 0xfe, 0xe4, 0x35, 0x36, 0xef, 0x61, 0xa1, 0x8d,
-	0x91, 0xe9, 0x58, 0xed, 0x7f, 0x09, 0xac, 0xb4, 0x02, 0x4a, 0x43, 0x51, 0x09, 0x05, 0x24, 0x01,
-	0xfc, 0x4c, 0xec, 0x9a, 0x38, 0xe5, 0xac, 0xe8, 0xc3, 0xff, 0xef, 0xfa, 0x29, 0xcf, 0x95, 0x4a,
-	0xed, 0x3e, 0xef, 0xc2, 0xaf, 0x52, 0xb3, 0x28, 0xb5, 0x55, 0xbc, 0xf5, 0xed, 0x0f, 0x6f, 0x1f,
-	0x66, 0xf8, 0x06, 0x8b, 0x7a, 0x32, 0x40, 0x36, 0x26, 0x89, 0x38, 0xb9, 0x02, 0xe2, 0x43, 0x7e,
-	0xe1, 0xc4, 0xa4, 0x4f, 0xe2, 0x84, 0xb8, 0xea, 0x4b, 0x6b, 0x8c, 0xf9, 0xed, 0xe5, 0x61, 0x66,
-	0xf7, 0x18, 0x71, 0xe5, 0x71, 0xa0, 0x35, 0x0f, 0xb3, 0x4f, 0x18, 0x71, 0x9f, 0x6a, 0x5f, 0x7d,
-	0x63, 0x2e, 0x59, 0x18, 0xe4, 0x55, 0x8b, 0xde, 0xeb, 0x06, 0x64, 0x41, 0x99, 0x1d, 0x82, 0x02,
-	0x4b, 0x68, 0x8c, 0x3d, 0x62, 0x77, 0xc8, 0x85, 0x2a, 0x36, 0x59, 0x3a, 0xca, 0xfe, 0x7b, 0x72,
-	0xc1, 0xd0, 0x34, 0x50, 0x12, 0xdf, 0x68, 0x20, 0x7f, 0x1e, 0x63, 0x87, 0xa8, 0x86, 0x9b, 0x17,
-	0x2c, 0x87, 0xb1, 0x92, 0x50, 0x88, 0x6b, 0xf3, 0x67, 0x92, 0xf6, 0x12, 0xf5, 0x50, 0x8d, 0x20,
-	0x8f, 0x88, 0x09, 0x19, 0x10, 0x47, 0xec, 0xa5, 0x86, 0x14, 0x82, 0x47, 0x60, 0xdd, 0xf5, 0x19,
-	0x6e, 0x05, 0xe2, 0x53, 0xcd, 0xe9, 0xc8, 0xe5, 0xd7, 0x8c, 0xcb, 0xd4, 0x2c, 0xa8, 0x89, 0x26,
-	0xb7, 0xa3, 0x19, 0x04, 0x3f, 0x04, 0x1b, 0x93, 0x30, 0x91, 0xad, 0xd8, 0x1b, 0xbd, 0x06, 0x2f,
-	0x53, 0xb3, 0x38, 0x76, 0x15, 0x33, 0x68, 0x0e, 0xcb, 0x4b, 0xbf, 0xd5, 0xf3, 0x44, 0x05, 0xea,
-	0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44, 0xc5, 0xad, 0x20, 0x09, 0xe0, 0x87, 0x20, 0x47,
-	0xfb, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0xd1, 0x3b, 0xe5, 0x0f, 0xdf, 0xbb, 0x5e, 0x06, 0x53, 0x1f,
-	0x23, 0x68, 0xe2, 0xcf, 0x17, 0x47, 0x22, 0x91, 0x64, 0x48, 0x42, 0x1a, 0x5f, 0x88, 0xee, 0x48,
-	0x2d, 0x4e, 0x4e, 0xbc, 0x10, 0x76, 0x34, 0x83, 0x60, 0x0d, 0x40, 0x15, 0x16, 0x93, 0xa4, 0x17,
-	0x47, 0xb6, 0xb8, 0x04, 0x0a, 0x22, 0x56, 0x3c, 0x8a, 0x72, 0x16, 0x89, 0xc9, 0x67, 0x38, 0xc1,
-	0xe8, 0x9a, 0x05, 0xfe, 0x1a, 0x40, 0x79, 0x26, 0xf6, 0x17, 0x8c, 0x46, 0xfc, 0x93, 0xea, 0x95,
-	0xef, 0xa9, 0xf6, 0x46, 0xe8, 0xcb, 0x59, 0x95, 0xb3, 0x21, 0xd1, 0x19, 0xa3, 0x6a, 0x15, 0x67,
-	0x9a, 0xae, 0x19, 0x2b, 0x67, 0x9a, 0xbe, 0x66, 0xe8, 0xe3, 0xfd, 0x53, 0xab, 0x40, 0x9b, 0x23,
-	0x3c, 0x95, 0x9e, 0xf5, 0x12, 0x80, 0x46, 0x4c, 0x7c, 0xde, 0x84, 0x06, 0x01, 0xbf, 0xb9, 0x22,
-	0x1c, 0x92, 0xd1, 0x95, 0xc9, 0xc7, 0xd3, 0x85, 0xb9, 0x3c, 0x5b, 0x98, 0x10, 0x68, 0x0e, 0x75,
-	0x89, 0x28, 0x8d, 0x1c, 0x12, 0xe3, 0x87, 0x7f, 0xcb, 0x80, 0xa9, 0x2f, 0x4f, 0xf8, 0x4b, 0x50,
-	0x39, 0x3e, 0x39, 0xa9, 0x37, 0x9b, 0xf6, 0xf9, 0xe7, 0x8d, 0xba, 0xdd, 0xa8, 0xa3, 0x17, 0xa7,
-	0xcd, 0xe6, 0xe9, 0xc7, 0x2f, 0x9f, 0xd7, 0x9b, 0x4d, 0x63, 0xa9, 0xf2, 0xe0, 0xf5, 0x9b, 0x6a,
-	0x79, 0xe2, 0xdf, 0x20, 0x71, 0xe8, 0x33, 0xe6, 0xd3, 0x28, 0xe0, 0x02, 0x1f, 0x80, 0xed, 0xe9,
-	0x68, 0x54, 0x6f, 0x9e, 0xa3, 0xd3, 0x93, 0xf3, 0xfa, 0x33, 0x23, 0x53, 0x29, 0xbf, 0x7e, 0x53,
-	0x2d, 0x4d, 0x22, 0x11, 0x61, 0x49, 0xec, 0x3b, 0xfc, 0xc9, 0x7b, 0x02, 0xca, 0x37, 0x6b, 0xd6,
-	0x9f, 0x19, 0xcb, 0x95, 0xca, 0xeb, 0x37, 0xd5, 0xed, 0x9b, 0x14, 0x89, 0x5b, 0xd1, 0xbe, 0xfa,
-	0xeb, 0xce, 0x52, 0xed, 0xe9, 0x77, 0xc3, 0x9d, 0xcc, 0xf7, 0xc3, 0x9d, 0xcc, 0xbf, 0x87, 0x3b,
-	0x99, 0xaf, 0xdf, 0xed, 0x2c, 0x7d, 0xff, 0x6e, 0x67, 0xe9, 0x1f, 0xef, 0x76, 0x96, 0xfe, 0x50,
-	0xf5, 0xfc, 0xa4, 0xdd, 0x6b, 0xed, 0x39, 0x34, 0xdc, 0x9f, 0xff, 0xa5, 0x81, 0x7f, 0x53, 0xb3,
-	0xd6, 0xaa, 0xf8, 0x41, 0xe8, 0xf1, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x92, 0xb6, 0x78, 0x1a,
-	0x69, 0x12, 0x00, 0x00,
+	// 2247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
+	0xf9, 0x17, 0xc5, 0x95, 0x44, 0x0e, 0x29, 0x6a, 0x3d, 0x7a, 0xf1, 0x8a, 0x8e, 0xb5, 0xcc, 0xfe,
+	0xff, 0x07, 0xd5, 0x48, 0x25, 0x4b, 0x8e, 0x5a, 0xd7, 0xe9, 0x0b, 0x44, 0x89, 0x69, 0xa9, 0xda,
+	0x0e, 0x31, 0x54, 0x12, 0xa4, 0x48, 0xb1, 0x18, 0xee, 0x8e, 0x97, 0x6b, 0xed, 0xee, 0x10, 0x3b,
+	0x4b, 0x99, 0xea, 0x27, 0x08, 0x7c, 0x4a, 0x3f, 0x80, 0x81, 0x00, 0xbd, 0xe4, 0x98, 0x8f, 0xd0,
+	0x63, 0xd0, 0x53, 0x6e, 0x2d, 0x0a, 0x74, 0x51, 0xd0, 0x87, 0x00, 0x3a, 0xea, 0x13, 0x14, 0xf3,
+	0xc2, 0x57, 0xc9, 0xac, 0x0a, 0x08, 0xf6, 0x3c, 0x6f, 0xbf, 0xdf, 0xcc, 0x33, 0xcf, 0xcc, 0x3e,
+	0x43, 0x50, 0x76, 0x28, 0x0b, 0x29, 0xdb, 0x25, 0xe7, 0xe1, 0x2e, 0xff, 0xdb, 0xe3, 0xa3, 0x9d,
+	0x4e, 0x4c, 0x13, 0x0a, 0x75, 0x69, 0xdb, 0xe1, 0x1a, 0xfe, 0xb7, 0x57, 0xbe, 0x83, 0x43, 0x3f,
+	0xa2, 0xbb, 0xe2, 0x5f, 0xe9, 0x54, 0x5e, 0xf3, 0xa8, 0x47, 0xc5, 0x70, 0x97, 0x8f, 0xa4, 0xd6,
+	0xfa, 0xfb, 0x22, 0x58, 0x6c, 0xe0, 0x18, 0x87, 0x0c, 0xee, 0x81, 0x3c, 0x39, 0x0f, 0x6d, 0x97,
+	0x44, 0x34, 0x34, 0x32, 0x95, 0xcc, 0x76, 0xbe, 0xba, 0x76, 0x95, 0x9a, 0xfa, 0x05, 0x0e, 0x83,
+	0x27, 0xd6, 0xd0, 0x64, 0xa1, 0x1c, 0x39, 0x0f, 0x8f, 0xf9, 0x10, 0x1e, 0x02, 0x40, 0x7a, 0x49,
+	0x8c, 0x6d, 0xe2, 0x77, 0x98, 0xa1, 0x55, 0xb2, 0xdb, 0xd9, 0xaa, 0xd5, 0x4f, 0xcd, 0x7c, 0x8d,
+	0x6b, 0x6b, 0xf5, 0x06, 0xbb, 0x4a, 0xcd, 0x3b, 0x0a, 0x60, 0xe8, 0x68, 0xa1, 0xbc, 0x10, 0x6a,
+	0x7e, 0x87, 0xc1, 0x7d, 0xb0, 0x8e, 0x83, 0x80, 0xbe, 0xb2, 0xbb, 0x11, 0x9f, 0x11, 0x71, 0x12,
+	0xe2, 0xda, 0x49, 0x8f, 0x19, 0x0b, 0x95, 0xcc, 0x76, 0x0e, 0xad, 0x0a, 0xe3, 0xa7, 0x23, 0xdb,
+	0x69, 0x8f, 0xc7, 0x14, 0xf9, 0x74, 0x9c, 0x36, 0x8e, 0x22, 0x12, 0x30, 0x63, 0xa9, 0x92, 0xdd,
+	0xce, 0x57, 0x57, 0xfa, 0xa9, 0x59, 0xa8, 0x7d, 0xf6, 0xec, 0x48, 0xa9, 0x51, 0x81, 0x9c, 0x87,
+	0x03, 0x01, 0xfe, 0x11, 0x94, 0xb0, 0xe3, 0x10, 0xc6, 0x6c, 0x87, 0x46, 0x49, 0x4c, 0x03, 0x23,
+	0x57, 0xc9, 0x6c, 0x17, 0xf6, 0xcd, 0x9d, 0xe9, 0xe4, 0xed, 0x1c, 0x0a, 0xbf, 0x23, 0xe9, 0x56,
+	0x5d, 0xff, 0x3e, 0x35, 0xe7, 0xfa, 0xa9, 0xb9, 0x3c, 0xa1, 0x46, 0xcb, 0x78, 0x5c, 0x84, 0x4f,
+	0xc0, 0x26, 0x76, 0x12, 0xff, 0x9c, 0xd8, 0x2c, 0xc1, 0x89, 0xef, 0xd8, 0x9d, 0x98, 0x38, 0x34,
+	0xec, 0xf8, 0x01, 0x61, 0x46, 0x9e, 0xcf, 0x0f, 0xdd, 0x95, 0x0e, 0x4d, 0x61, 0x6f, 0x8c, 0xcc,
+	0xf0, 0xe1, 0x70, 0x39, 0x7e, 0x64, 0xfb, 0xae, 0x01, 0x2a, 0x99, 0x6d, 0xad, 0x5a, 0xea, 0xa7,
+	0x26, 0x90, 0xcb, 0xf1, 0xa3, 0xfa, 0x31, 0x02, 0x72, 0x35, 0x7e, 0x54, 0x77, 0x39, 0xdb, 0x54,
+	0xba, 0x6c, 0x91, 0xa7, 0xc0, 0x67, 0x89, 0x51, 0x90, 0x6c, 0xdd, 0x89, 0x9c, 0x1d, 0x0e, 0xcc,
+	0xf0, 0x4b, 0x50, 0x62, 0x4e, 0x9b, 0xb8, 0xdd, 0x80, 0xb8, 0x72, 0xdf, 0x8a, 0x95, 0xec, 0x76,
+	0x61, 0x7f, 0xeb, 0x7a, 0x22, 0x9a, 0x03, 0xbf, 0x5a, 0xbd, 0x31, 0xca, 0xc3, 0xb8, 0x96, 0xa1,
+	0xe5, 0x21, 0x98, 0xd8, 0xce, 0x5f, 0x80, 0x4d, 0x12, 0x76, 0x92, 0x0b, 0x1b, 0x3b, 0x0e, 0xed,
+	0x46, 0x89, 0xcd, 0x5e, 0x11, 0xd2, 0xb1, 0x5b, 0x38, 0x71, 0xda, 0xc6, 0x32, 0x5f, 0x18, 0xda,
+	0x10, 0x0e, 0x87, 0xd2, 0xde, 0xe4, 0xe6, 0x2a, 0xb7, 0xf2, 0x4a, 0x60, 0x09, 0x8d, 0xb1, 0x47,
+	0x6c, 0xd2, 0xeb, 0xf8, 0xf1, 0x85, 0xdd, 0x0a, 0xa8, 0x73, 0xc6, 0x8c, 0x92, 0x08, 0x5b, 0x55,
+	0xc6, 0x9a, 0xb0, 0x55, 0x85, 0x09, 0xbe, 0x04, 0x15, 0xbf, 0xe5, 0xd8, 0x0e, 0x0e, 0x82, 0x16,
+	0x76, 0xce, 0xe4, 0xde, 0x62, 0x27, 0x19, 0xcf, 0xc7, 0x8a, 0xa8, 0x8e, 0xf7, 0xfb, 0xa9, 0x79,
+	0xbf, 0x5e, 0x3d, 0x3a, 0x52, 0xae, 0x47, 0x03, 0xcf, 0x61, 0x66, 0xd0, 0x7d, 0xbf, 0xe5, 0xbc,
+	0xdb, 0xfc, 0xe4, 0xde, 0xeb, 0x1f, 0xbf, 0x7b, 0xb0, 0x31, 0x76, 0x0c, 0x7b, 0xfc, 0x20, 0xca,
+	0xc3, 0x73, 0xa2, 0xe5, 0xe6, 0xf5, 0xec, 0x89, 0x96, 0xcb, 0xea, 0xda, 0x89, 0x96, 0x5b, 0xd4,
+	0x97, 0xac, 0x43, 0x50, 0x1c, 0xcf, 0x14, 0xdc, 0x04, 0x59, 0xe2, 0x77, 0xc4, 0xc1, 0xca, 0x56,
+	0x97, 0xfa, 0xa9, 0x99, 0xad, 0xd5, 0x1b, 0x88, 0xeb, 0xe0, 0x06, 0x58, 0x6c, 0x13, 0xdf, 0x6b,
+	0x27, 0xc6, 0x3c, 0xb7, 0x22, 0x25, 0x59, 0x7f, 0xce, 0x80, 0xc9, 0xaa, 0x83, 0x87, 0x60, 0xd1,
+	0x89, 0x09, 0x4e, 0x88, 0xc0, 0x29, 0xec, 0xff, 0xdf, 0x7f, 0xa9, 0xde, 0xd3, 0x8b, 0x0e, 0xa9,
+	0x6a, 0x7c, 0xe7, 0x90, 0x0a, 0x84, 0xbf, 0x02, 0x1a, 0x4f, 0x97, 0x31, 0xff, 0xbf, 0x02, 0x88,
+	0x30, 0xeb, 0x5f, 0x19, 0x70, 0xe7, 0x9a, 0x07, 0x74, 0x40, 0x41, 0x9d, 0xae, 0xe4, 0xa2, 0x23,
+	0x27, 0x57, 0xda, 0x7f, 0xef, 0x5d, 0xd8, 0x02, 0xf4, 0xff, 0x79, 0x7d, 0x8f, 0xe4, 0xab, 0xd4,
+	0x84, 0xf2, 0xa2, 0x18, 0x03, 0xb2, 0x10, 0xc0, 0x43, 0x0f, 0xe8, 0x80, 0xd5, 0xc9, 0x23, 0x6c,
+	0x8b, 0xfd, 0x9d, 0x17, 0xfb, 0xfb, 0xa8, 0x9f, 0x9a, 0x93, 0x13, 0x7b, 0xea, 0xb3, 0xe4, 0x2a,
+	0x35, 0xcb, 0x13, 0xa8, 0xe3, 0x91, 0x16, 0xba, 0x83, 0xa7, 0x03, 0xac, 0x6f, 0x75, 0x50, 0x10,
+	0xc7, 0xec, 0x88, 0x46, 0x2f, 0x7c, 0x0f, 0x7e, 0x09, 0x56, 0xda, 0x34, 0x24, 0x2c, 0x21, 0xd8,
+	0x95, 0x05, 0xa9, 0xee, 0xc6, 0x47, 0xff, 0x4c, 0xcd, 0x75, 0xb9, 0x40, 0xe6, 0x9e, 0xed, 0xf8,
+	0x74, 0x37, 0xc4, 0x49, 0x7b, 0xa7, 0x1e, 0x71, 0xd2, 0x0d, 0x49, 0x3a, 0x15, 0x69, 0xa1, 0xd2,
+	0x50, 0x23, 0x0a, 0x18, 0xb6, 0x41, 0xc9, 0xc5, 0xd4, 0x7e, 0x41, 0xe3, 0x33, 0x05, 0x3e, 0x2f,
+	0xc0, 0xab, 0xef, 0x04, 0xef, 0xa7, 0x66, 0xf1, 0xf8, 0xf0, 0x93, 0x8f, 0x69, 0x7c, 0x26, 0x20,
+	0xae, 0x52, 0x73, 0x5d, 0x92, 0x4d, 0x02, 0x59, 0xa8, 0xe8, 0x62, 0x3a, 0x74, 0x83, 0x9f, 0x03,
+	0x7d, 0xe8, 0xc0, 0xba, 0x9d, 0x0e, 0x8d, 0x13, 0x23, 0xcb, 0xaf, 0xd8, 0xea, 0x4f, 0xfb, 0xa9,
+	0x59, 0x52, 0x90, 0x4d, 0x69, 0xb9, 0x4a, 0xcd, 0xbb, 0x53, 0xa0, 0x2a, 0xc6, 0x42, 0x25, 0x05,
+	0xab, 0x5c, 0x61, 0x0b, 0x14, 0x89, 0xdf, 0xd9, 0x3b, 0x78, 0xa8, 0x16, 0xa0, 0x89, 0x05, 0xfc,
+	0x66, 0xd6, 0x02, 0x0a, 0xb5, 0x7a, 0x63, 0xef, 0xe0, 0xe1, 0x60, 0xfe, 0xab, 0x92, 0x6a, 0x1c,
+	0xc5, 0x42, 0x05, 0x29, 0xca, 0xc9, 0x0f, 0x38, 0x0e, 0x14, 0xc7, 0xe2, 0x6d, 0x39, 0x0e, 0x6e,
+	0xe2, 0x38, 0x98, 0xe4, 0x38, 0x98, 0xe4, 0x78, 0xac, 0x38, 0x96, 0x6e, 0xcb, 0xf1, 0xf8, 0x26,
+	0x8e, 0xc7, 0x93, 0x1c, 0xd2, 0x87, 0x17, 0x53, 0xeb, 0xe2, 0x4f, 0x38, 0x4a, 0xfc, 0x6e, 0xa8,
+	0x68, 0x72, 0xb7, 0x2e, 0xa6, 0xa9, 0x48, 0x0b, 0x95, 0x86, 0x1a, 0x89, 0x7e, 0x06, 0xd6, 0x1c,
+	0x1a, 0xb1, 0x84, 0xeb, 0x22, 0xda, 0x09, 0x88, 0xa2, 0xc8, 0x0b, 0x8a, 0xc7, 0xb3, 0x28, 0xee,
+	0x49, 0x8a, 0x9b, 0xc2, 0x2d, 0xb4, 0x3a, 0xa9, 0x96, 0x64, 0x36, 0xd0, 0x3b, 0x24, 0x21, 0x31,
+	0x6b, 0x75, 0x63, 0x4f, 0x11, 0x01, 0x41, 0xf4, 0xe1, 0x2c, 0x22, 0x55, 0x56, 0xd3, 0xa1, 0x16,
+	0x5a, 0x19, 0xa9, 0x24, 0xc1, 0x17, 0xa0, 0xe4, 0x73, 0xd6, 0x56, 0x37, 0x50, 0xf0, 0x05, 0x01,
+	0xbf, 0x3f, 0x0b, 0x5e, 0x1d, 0x85, 0xc9, 0x40, 0x0b, 0x2d, 0x0f, 0x14, 0x12, 0xda, 0x05, 0x30,
+	0xec, 0xfa, 0xb1, 0xed, 0x05, 0xd8, 0xf1, 0x49, 0xac, 0xe0, 0x8b, 0x02, 0xfe, 0x67, 0xb3, 0xe0,
+	0x37, 0x25, 0xfc, 0xf5, 0x60, 0x0b, 0xe9, 0x5c, 0xf9, 0x5b, 0xa9, 0x93, 0x2c, 0x4d, 0x50, 0x6c,
+	0x91, 0x38, 0xf0, 0x23, 0x85, 0xbf, 0x2c, 0xf0, 0x1f, 0xce, 0xc2, 0x57, 0x15, 0x34, 0x1e, 0x66,
+	0xa1, 0x82, 0x14, 0x87, 0xa0, 0x01, 0x8d, 0x5c, 0x3a, 0x00, 0xbd, 0x73, 0x6b, 0xd0, 0xf1, 0x30,
+	0x0b, 0x15, 0xa4, 0x28, 0x41, 0x3d, 0xb0, 0x8a, 0xe3, 0x98, 0xbe, 0x9a, 0x4a, 0x08, 0x14, 0xd8,
+	0x3f, 0x9f, 0x85, 0x3d, 0xb8, 0x5c, 0xaf, 0x47, 0xf3, 0xcb, 0x95, 0x6b, 0x27, 0x52, 0xe2, 0x02,
+	0xe8, 0xc5, 0xf8, 0x62, 0x8a, 0x67, 0xed, 0xd6, 0x89, 0xbf, 0x1e, 0x6c, 0x21, 0x9d, 0x2b, 0x27,
+	0x58, 0x5e, 0x82, 0xb5, 0x90, 0xc4, 0x1e, 0xb1, 0x23, 0x92, 0xb0, 0x4e, 0xe0, 0x27, 0x8a, 0x67,
+	0xfd, 0xd6, 0xe7, 0xe0, 0xa6, 0x70, 0x0b, 0x41, 0xa1, 0x7e, 0xae, 0xb4, 0x92, 0x6b, 0x13, 0xe4,
+	0x86, 0x7d, 0x9b, 0x21, 0xfa, 0x94, 0x25, 0x47, 0x35, 0x69, 0x6b, 0x60, 0x41, 0xf6, 0xd2, 0x9b,
+	0x9c, 0x17, 0x49, 0x01, 0x96, 0x41, 0xce, 0x25, 0x8e, 0x1f, 0xe2, 0x80, 0x19, 0x65, 0x11, 0x30,
+	0x94, 0xe1, 0x67, 0x60, 0x99, 0xb5, 0x71, 0xe4, 0xb5, 0xb1, 0x6f, 0x27, 0x7e, 0x48, 0x8c, 0x7b,
+	0x62, 0xc6, 0x7b, 0xb3, 0x66, 0xbc, 0x26, 0x67, 0x3c, 0x11, 0x67, 0xa1, 0xe2, 0x40, 0x3e, 0xf5,
+	0x43, 0x02, 0x1b, 0xa0, 0xe0, 0xe0, 0xc8, 0xe9, 0x46, 0x12, 0xf5, 0x3d, 0x81, 0xba, 0x3b, 0x0b,
+	0x55, 0x7d, 0x8a, 0xc7, 0xa2, 0x2c, 0x04, 0xa4, 0x34, 0x40, 0xec, 0xc4, 0xd8, 0xeb, 0x12, 0x89,
+	0x78, 0xff, 0xd6, 0x88, 0x63, 0x51, 0x16, 0x02, 0x52, 0x1a, 0x20, 0x9e, 0x93, 0xf8, 0x2c, 0x50,
+	0x88, 0x5b, 0xb7, 0x46, 0x1c, 0x8b, 0xb2, 0x10, 0x90, 0x92, 0x40, 0x7c, 0x06, 0x00, 0x65, 0xf8,
+	0x0c, 0x4b, 0x40, 0x53, 0x00, 0xee, 0xcc, 0x02, 0x54, 0x0f, 0x95, 0x51, 0x90, 0x85, 0xf2, 0x42,
+	0xe0, 0x70, 0x27, 0x5a, 0x6e, 0x41, 0x5f, 0x3c, 0xd1, 0x72, 0x1b, 0xfa, 0xdd, 0x13, 0x2d, 0x77,
+	0x57, 0x37, 0xac, 0x5d, 0xb0, 0xc0, 0x9b, 0x79, 0x02, 0x75, 0x90, 0x3d, 0x23, 0x17, 0xb2, 0x2f,
+	0x40, 0x7c, 0xc8, 0xf7, 0xfe, 0x1c, 0x07, 0x5d, 0x22, 0x3f, 0xe7, 0x48, 0x0a, 0x56, 0x17, 0x14,
+	0x9b, 0xb2, 0x89, 0x6d, 0xc4, 0x94, 0xbe, 0x80, 0x8f, 0xc0, 0x02, 0x7f, 0x2d, 0x0c, 0x9a, 0xb9,
+	0xbb, 0x37, 0x74, 0xe0, 0xdc, 0xac, 0xfa, 0x2f, 0xe9, 0xcb, 0xa1, 0xfd, 0xc8, 0x25, 0x3d, 0x01,
+	0xad, 0x21, 0x29, 0xf0, 0xb2, 0x62, 0x7e, 0x2b, 0xf0, 0x23, 0x8f, 0x19, 0x59, 0xf1, 0x00, 0x18,
+	0xca, 0x56, 0x03, 0xac, 0x9c, 0xc6, 0x38, 0x62, 0xfc, 0xfd, 0x41, 0xa3, 0xa7, 0xd4, 0x63, 0x10,
+	0x02, 0xad, 0x8d, 0x59, 0x5b, 0x4d, 0x59, 0x8c, 0xe1, 0x4f, 0x80, 0x16, 0x50, 0x8f, 0x89, 0x7e,
+	0xaa, 0xb0, 0xbf, 0x7e, 0x7d, 0x32, 0x4f, 0xa9, 0x87, 0x84, 0x8b, 0xf5, 0xb7, 0x79, 0x90, 0x7d,
+	0x4a, 0x3d, 0x68, 0x80, 0x25, 0xec, 0xba, 0x31, 0x61, 0x4c, 0x21, 0x0d, 0x44, 0xde, 0xd2, 0x26,
+	0xb4, 0xe3, 0x3b, 0x12, 0x2e, 0x8f, 0x94, 0xc4, 0x89, 0x5d, 0x9c, 0x60, 0xd1, 0x7a, 0x14, 0x91,
+	0x18, 0xf3, 0xe7, 0x9c, 0x38, 0x61, 0x76, 0xd4, 0x0d, 0x5b, 0x24, 0x16, 0x1d, 0x84, 0x56, 0x5d,
+	0xb9, 0x4c, 0xcd, 0x82, 0xd0, 0x3f, 0x17, 0x6a, 0x34, 0x2e, 0xc0, 0x0f, 0xc0, 0x52, 0xd2, 0xb3,
+	0xc5, 0x1a, 0x16, 0xc4, 0xce, 0xae, 0x5e, 0xa6, 0xe6, 0x4a, 0x32, 0x5a, 0xe6, 0xef, 0x30, 0x6b,
+	0xa3, 0xc5, 0xa4, 0xc7, 0xff, 0x87, 0xbb, 0x20, 0x97, 0xf4, 0x6c, 0x99, 0xb6, 0x45, 0x81, 0xbe,
+	0x76, 0x99, 0x9a, 0xfa, 0x98, 0x7b, 0x9d, 0xdb, 0xd0, 0x52, 0xd2, 0x13, 0x03, 0xf8, 0x01, 0x00,
+	0x72, 0x4a, 0x82, 0x41, 0xb6, 0x02, 0xcb, 0x97, 0xa9, 0x99, 0x17, 0x5a, 0x81, 0x3d, 0x1a, 0x42,
+	0x6b, 0xb0, 0x25, 0x39, 0x81, 0x5d, 0xbc, 0x4c, 0xcd, 0x5c, 0x40, 0x3d, 0x89, 0xa9, 0x36, 0xc8,
+	0x00, 0x4b, 0x31, 0x09, 0xe9, 0x39, 0x71, 0xc5, 0xf7, 0x38, 0x87, 0x06, 0xa2, 0xf5, 0xf5, 0x3c,
+	0xc8, 0x9d, 0xf6, 0x10, 0x61, 0xdd, 0x20, 0x81, 0x1f, 0x03, 0x7d, 0xf0, 0x86, 0xb1, 0x27, 0x52,
+	0x5b, 0xbd, 0x37, 0xfa, 0x7a, 0x4e, 0x7b, 0x58, 0x68, 0x65, 0xa0, 0x3a, 0x54, 0xf9, 0x5f, 0x03,
+	0x0b, 0xad, 0x80, 0xd2, 0x50, 0x54, 0x49, 0x11, 0x49, 0x01, 0x7e, 0x2e, 0xb2, 0x26, 0x76, 0x39,
+	0x2b, 0x4a, 0xee, 0xfd, 0xeb, 0xbb, 0x3c, 0x55, 0x2a, 0xd5, 0x7b, 0xbc, 0xf8, 0xae, 0x52, 0xb3,
+	0x24, 0xb9, 0x55, 0xbc, 0xf5, 0xed, 0x8f, 0xdf, 0x3d, 0xc8, 0xf0, 0x04, 0x8b, 0x7a, 0xd2, 0x41,
+	0x36, 0x26, 0x89, 0xd8, 0xb9, 0x22, 0xe2, 0x43, 0x5e, 0x90, 0x31, 0x39, 0x27, 0x71, 0x42, 0x5c,
+	0xf5, 0x94, 0x1f, 0xca, 0xfc, 0xd2, 0xf4, 0x30, 0xb3, 0xbb, 0x8c, 0xb8, 0x72, 0x3b, 0xd0, 0x92,
+	0x87, 0xd9, 0xa7, 0x8c, 0xb8, 0x4f, 0xb4, 0xaf, 0xbe, 0x31, 0xe7, 0x2c, 0x0c, 0x0a, 0xea, 0x65,
+	0xd0, 0xed, 0x04, 0x64, 0x46, 0x99, 0xed, 0x83, 0xe2, 0xe0, 0xcd, 0x78, 0x46, 0x2e, 0x54, 0xb1,
+	0xc9, 0xd2, 0x51, 0xfa, 0xdf, 0x93, 0x0b, 0x86, 0xc6, 0x05, 0x45, 0xf1, 0x8d, 0x06, 0x0a, 0xa7,
+	0x31, 0x76, 0x88, 0xea, 0xf3, 0x79, 0xc1, 0x72, 0x31, 0x56, 0x14, 0x4a, 0xe2, 0xdc, 0xfc, 0x2a,
+	0xa0, 0xdd, 0x44, 0x9d, 0xe5, 0x81, 0xc8, 0x23, 0x62, 0x42, 0x7a, 0xc4, 0x11, 0xb9, 0xd4, 0x90,
+	0x92, 0xe0, 0x01, 0x58, 0x76, 0x7d, 0x86, 0x5b, 0x81, 0xf8, 0x2d, 0xc0, 0x39, 0x93, 0xcb, 0xaf,
+	0xea, 0x97, 0xa9, 0x59, 0x54, 0x86, 0x26, 0xd7, 0xa3, 0x09, 0x09, 0x7e, 0x04, 0x56, 0x46, 0x61,
+	0x62, 0xb6, 0x22, 0x37, 0xb9, 0x2a, 0xbc, 0x4c, 0xcd, 0xd2, 0xd0, 0x55, 0x58, 0xd0, 0x94, 0x2c,
+	0xbf, 0x35, 0xad, 0xae, 0x27, 0x2a, 0x30, 0x87, 0xa4, 0xc0, 0xb5, 0x81, 0x1f, 0xfa, 0x89, 0xa8,
+	0xb8, 0x05, 0x24, 0x05, 0xf8, 0x11, 0xc8, 0xd3, 0x73, 0x12, 0xc7, 0xbe, 0x4b, 0x98, 0x68, 0xd9,
+	0x0a, 0xfb, 0xf7, 0xaf, 0x97, 0xc1, 0xd8, 0x1b, 0x08, 0x8d, 0xfc, 0xf9, 0xe2, 0x48, 0x24, 0x26,
+	0x19, 0x92, 0x90, 0xc6, 0x17, 0x46, 0x61, 0xb4, 0x38, 0x69, 0x78, 0x26, 0xf4, 0x68, 0x42, 0x82,
+	0x55, 0x00, 0x55, 0x58, 0x4c, 0x92, 0x6e, 0x1c, 0xd9, 0xe2, 0x12, 0x28, 0x8a, 0x58, 0x71, 0x14,
+	0xa5, 0x15, 0x09, 0xe3, 0x31, 0x4e, 0x30, 0xba, 0xa6, 0x81, 0xbf, 0x06, 0x50, 0xee, 0x89, 0xfd,
+	0x92, 0xd1, 0x88, 0xbf, 0xe4, 0x5e, 0xf8, 0x9e, 0xea, 0xaa, 0x04, 0xbf, 0xb4, 0xaa, 0x39, 0xeb,
+	0x52, 0x3a, 0x61, 0x54, 0xad, 0xe2, 0x44, 0xcb, 0x69, 0xfa, 0xc2, 0x89, 0x96, 0x5b, 0xd2, 0x73,
+	0xc3, 0xfc, 0xa9, 0x55, 0xa0, 0xd5, 0x81, 0x3c, 0x36, 0x3d, 0xeb, 0x39, 0x00, 0x8d, 0x98, 0xf8,
+	0xbc, 0xf7, 0x0d, 0x02, 0x7e, 0x73, 0x45, 0x38, 0x24, 0x83, 0x2b, 0x93, 0x8f, 0xc7, 0x0b, 0x73,
+	0x7e, 0xb2, 0x30, 0x21, 0xd0, 0x1c, 0xea, 0x12, 0x51, 0x1a, 0x79, 0x24, 0xc6, 0x0f, 0xfe, 0x9a,
+	0x01, 0x63, 0x0f, 0x5e, 0xf8, 0x4b, 0x50, 0x3e, 0x3c, 0x3a, 0xaa, 0x35, 0x9b, 0xf6, 0xe9, 0x17,
+	0x8d, 0x9a, 0xdd, 0xa8, 0xa1, 0x67, 0xf5, 0x66, 0xb3, 0xfe, 0xc9, 0xf3, 0xa7, 0xb5, 0x66, 0x53,
+	0x9f, 0x2b, 0xbf, 0xf7, 0xfa, 0x4d, 0xc5, 0x18, 0xf9, 0x37, 0x48, 0x1c, 0xfa, 0x8c, 0xf9, 0x34,
+	0x0a, 0x38, 0xc1, 0x87, 0x60, 0x63, 0x3c, 0x1a, 0xd5, 0x9a, 0xa7, 0xa8, 0x7e, 0x74, 0x5a, 0x3b,
+	0xd6, 0x33, 0x65, 0xe3, 0xf5, 0x9b, 0xca, 0xda, 0x28, 0x12, 0x11, 0x96, 0xc4, 0x3e, 0xff, 0x21,
+	0x08, 0x3e, 0x06, 0xc6, 0xcd, 0x9c, 0xb5, 0x63, 0x7d, 0xbe, 0x5c, 0x7e, 0xfd, 0xa6, 0xb2, 0x71,
+	0x13, 0x23, 0x71, 0xcb, 0xda, 0x57, 0x7f, 0xd9, 0x9a, 0xab, 0x3e, 0xf9, 0xbe, 0xbf, 0x95, 0xf9,
+	0xa1, 0xbf, 0x95, 0xf9, 0x77, 0x7f, 0x2b, 0xf3, 0xf5, 0xdb, 0xad, 0xb9, 0x1f, 0xde, 0x6e, 0xcd,
+	0xfd, 0xe3, 0xed, 0xd6, 0xdc, 0x1f, 0x2a, 0x9e, 0x9f, 0xb4, 0xbb, 0xad, 0x1d, 0x87, 0x86, 0xbb,
+	0xd3, 0xbf, 0x91, 0xf0, 0xa7, 0x3c, 0x6b, 0x2d, 0x8a, 0x5f, 0x1c, 0x1f, 0xfd, 0x67, 0x00, 0xfd,
+	0x10, 0x1d, 0xe4, 0xca, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1090,6 +1296,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IBCCallbackContractsAllowlist) > 0 {
+		for iNdEx := len(m.IBCCallbackContractsAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IBCCallbackContractsAllowlist[iNdEx])
+			copy(dAtA[i:], m.IBCCallbackContractsAllowlist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.IBCCallbackContractsAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.StorageExpiryBlocks != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.StorageExpiryBlocks))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.EmptyAccountSweepBatch != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.EmptyAccountSweepBatch))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ScheduledEIPs) > 0 {
+		for iNdEx := len(m.ScheduledEIPs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledEIPs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.UnprotectedTxsAllowlist) > 0 {
+		for iNdEx := len(m.UnprotectedTxsAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnprotectedTxsAllowlist[iNdEx])
+			copy(dAtA[i:], m.UnprotectedTxsAllowlist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.UnprotectedTxsAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.EVMChainID != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.EVMChainID))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -1157,6 +1410,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledEIP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledEIP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledEIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EIP != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.EIP))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1588,6 +1874,53 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StorageProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TransactionLogs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2030,6 +2363,48 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if m.EVMChainID != 0 {
+		n += 1 + sovEvm(uint64(m.EVMChainID))
+	}
+	if len(m.UnprotectedTxsAllowlist) > 0 {
+		for _, s := range m.UnprotectedTxsAllowlist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.ScheduledEIPs) > 0 {
+		for _, e := range m.ScheduledEIPs {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.EmptyAccountSweepBatch != 0 {
+		n += 1 + sovEvm(uint64(m.EmptyAccountSweepBatch))
+	}
+	if m.StorageExpiryBlocks != 0 {
+		n += 1 + sovEvm(uint64(m.StorageExpiryBlocks))
+	}
+	if len(m.IBCCallbackContractsAllowlist) > 0 {
+		for _, s := range m.IBCCallbackContractsAllowlist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledEIP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EIP != 0 {
+		n += 1 + sovEvm(uint64(m.EIP))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvm(uint64(m.Height))
+	}
 	return n
 }
 
@@ -2183,6 +2558,26 @@ func (m *State) Size() (n int) {
 	return n
 }
 
+func (m *StorageProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.Index != 0 {
+		n += 1 + sovEvm(uint64(m.Index))
+	}
+	if len(m.Siblings) > 0 {
+		for _, s := range m.Siblings {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *TransactionLogs) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2620,20 +3015,263 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMChainID", wireType)
 			}
-			iNdEx += skippy
-		}
+			m.EVMChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EVMChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnprotectedTxsAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnprotectedTxsAllowlist = append(m.UnprotectedTxsAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEIPs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledEIPs = append(m.ScheduledEIPs, ScheduledEIP{})
+			if err := m.ScheduledEIPs[len(m.ScheduledEIPs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyAccountSweepBatch", wireType)
+			}
+			m.EmptyAccountSweepBatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmptyAccountSweepBatch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageExpiryBlocks", wireType)
+			}
+			m.StorageExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCCallbackContractsAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCCallbackContractsAllowlist = append(m.IBCCallbackContractsAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledEIP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledEIP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledEIP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP", wireType)
+			}
+			m.EIP = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EIP |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -3832,6 +4470,140 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StorageProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Siblings = append(m.Siblings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+)
+
+// GasRatio is the ratio used to translate the gas consumed on the Cosmos side,
+// e.g. the store accesses of the precompiles, into EVM gas, and the gas used by
+// the EVM calls made by the Cosmos modules, e.g. the IBC callbacks, into Cosmos
+// gas. EVMGas units of EVM gas are worth CosmosGas units of Cosmos gas.
+type GasRatio struct {
+	CosmosGas uint64
+	EVMGas    uint64
+}
+
+// DefaultGasRatio translates 1 unit of Cosmos gas into 1 unit of EVM gas.
+var DefaultGasRatio = GasRatio{CosmosGas: 1, EVMGas: 1}
+
+// gasRatio is the ratio used to translate the gas between Cosmos and the EVM.
+// It can only be set via `EVMConfigurator` before starting the app.
+var gasRatio = DefaultGasRatio
+
+// Validate returns an error if one of the terms of the ratio is zero.
+func (r GasRatio) Validate() error {
+	if r.CosmosGas == 0 || r.EVMGas == 0 {
+		return errors.New("the terms of the gas ratio must be positive")
+	}
+	return nil
+}
+
+// CosmosToEVMGas translates the Cosmos gas into EVM gas, rounding up. The
+// result is capped to the max uint64.
+func (r GasRatio) CosmosToEVMGas(gas uint64) uint64 {
+	return convertGas(gas, r.EVMGas, r.CosmosGas)
+}
+
+// EVMToCosmosGas translates the EVM gas into Cosmos gas, rounding up. The
+// result is capped to the max uint64.
+func (r GasRatio) EVMToCosmosGas(gas uint64) uint64 {
+	return convertGas(gas, r.CosmosGas, r.EVMGas)
+}
+
+// convertGas returns ceil(gas * num / denom), capped to the max uint64.
+func convertGas(gas, num, denom uint64) uint64 {
+	if num == denom {
+		return gas
+	}
+	res := new(big.Int).Mul(new(big.Int).SetUint64(gas), new(big.Int).SetUint64(num))
+	d := new(big.Int).SetUint64(denom)
+	res.Add(res, new(big.Int).Sub(d, big.NewInt(1)))
+	res.Quo(res, d)
+	if !res.IsUint64() {
+		return ^uint64(0)
+	}
+	return res.Uint64()
+}
+
+// setGasRatio sets the ratio used to translate the gas between Cosmos and the
+// EVM, the default one is kept if it's nil.
+func setGasRatio(r *GasRatio) error {
+	if r == nil {
+		return nil
+	}
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	gasRatio = *r
+	return nil
+}
+
+// GetGasRatio returns the ratio used to translate the gas between Cosmos and
+// the EVM.
+func GetGasRatio() GasRatio {
+	return gasRatio
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestGasRatio(t *testing.T) {
+	testCases := []struct {
+		name      string
+		ratio     types.GasRatio
+		expPass   bool
+		gas       uint64
+		expEVM    uint64
+		expCosmos uint64
+	}{
+		{
+			"success - default ratio",
+			types.DefaultGasRatio,
+			true,
+			1000,
+			1000,
+			1000,
+		},
+		{
+			"success - cosmos gas worth more evm gas",
+			types.GasRatio{CosmosGas: 2, EVMGas: 3},
+			true,
+			1001,
+			1502,
+			668,
+		},
+		{
+			"success - translated gas capped to the max uint64",
+			types.GasRatio{CosmosGas: 1, EVMGas: 2},
+			true,
+			math.MaxUint64,
+			math.MaxUint64,
+			math.MaxUint64/2 + 1,
+		},
+		{
+			"fail - zero cosmos gas",
+			types.GasRatio{CosmosGas: 0, EVMGas: 1},
+			false,
+			0,
+			0,
+			0,
+		},
+		{
+			"fail - zero evm gas",
+			types.GasRatio{CosmosGas: 1, EVMGas: 0},
+			false,
+			0,
+			0,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.ratio.Validate()
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expEVM, tc.ratio.CosmosToEVMGas(tc.gas))
+			require.Equal(t, tc.expCosmos, tc.ratio.EVMToCosmosGas(tc.gas))
+		})
+	}
+}
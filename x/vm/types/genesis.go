@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
 	"github.com/cosmos/evm/types"
 )
 
@@ -11,6 +13,23 @@ func (ga GenesisAccount) Validate() error {
 	if err := types.ValidateAddress(ga.Address); err != nil {
 		return err
 	}
+	if ga.ArchivedStorageRoot != "" {
+		if root, err := hexutil.Decode(ga.ArchivedStorageRoot); err != nil || len(root) != 32 {
+			return fmt.Errorf("invalid archived storage root %s", ga.ArchivedStorageRoot)
+		}
+		if ga.Code == "" {
+			return fmt.Errorf("archived storage root of an account without code")
+		}
+		if ga.ArchivedStorageEntries == 0 {
+			return fmt.Errorf("archived storage without entries")
+		}
+		// the storage holds the restored entries of the archived storage
+		if uint64(len(ga.Storage)) >= ga.ArchivedStorageEntries {
+			return fmt.Errorf("archived storage of %d entries with %d restored entries", ga.ArchivedStorageEntries, len(ga.Storage))
+		}
+	} else if ga.ArchivedStorageEntries != 0 {
+		return fmt.Errorf("archived storage entries without archived storage root")
+	}
 	return ga.Storage.Validate()
 }
 
@@ -98,6 +98,12 @@ type GenesisAccount struct {
 	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	// storage defines the set of state key values for the account.
 	Storage Storage `protobuf:"bytes,3,rep,name=storage,proto3,castrepeated=Storage" json:"storage"`
+	// archived_storage_root defines the hex merkle root of the archived storage
+	// of the account, in which case the storage only holds its restored entries.
+	ArchivedStorageRoot string `protobuf:"bytes,4,opt,name=archived_storage_root,json=archivedStorageRoot,proto3" json:"archived_storage_root,omitempty"`
+	// archived_storage_entries defines the number of entries of the archived
+	// storage of the account.
+	ArchivedStorageEntries uint64 `protobuf:"varint,5,opt,name=archived_storage_entries,json=archivedStorageEntries,proto3" json:"archived_storage_entries,omitempty"`
 }
 
 func (m *GenesisAccount) Reset()         { *m = GenesisAccount{} }
@@ -154,6 +160,20 @@ func (m *GenesisAccount) GetStorage() Storage {
 	return nil
 }
 
+func (m *GenesisAccount) GetArchivedStorageRoot() string {
+	if m != nil {
+		return m.ArchivedStorageRoot
+	}
+	return ""
+}
+
+func (m *GenesisAccount) GetArchivedStorageEntries() uint64 {
+	if m != nil {
+		return m.ArchivedStorageEntries
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.vm.v1.GenesisState")
 	proto.RegisterType((*GenesisAccount)(nil), "cosmos.evm.vm.v1.GenesisAccount")
@@ -162,29 +182,32 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x29, 0x0c, 0x43, 0x40, 0xca, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0xba, 0xcf, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51, 0x81, 0x59,
-	0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27, 0xce, 0x13,
-	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd, 0xc5, 0x56,
-	0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81, 0x69, 0x4c,
-	0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x90, 0x27, 0x17, 0x77, 0x41, 0x51, 0x6a, 0x66, 0x5e,
-	0x71, 0x49, 0x62, 0x4e, 0x4e, 0xb1, 0x04, 0x33, 0xd8, 0x21, 0x32, 0x58, 0x4c, 0x80, 0x2b, 0x42,
-	0x36, 0x05, 0x59, 0xaf, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x7b, 0x85, 0x24, 0xb8, 0xd8, 0x13,
-	0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0x5e, 0x64, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x84, 0xb8,
-	0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0x4e, 0xe6, 0x0c, 0x02, 0xb3, 0x85, 0xdc, 0xb9, 0xd8, 0x8b,
-	0x4b, 0xf2, 0x8b, 0x12, 0xd3, 0x53, 0xa1, 0xee, 0x10, 0xc7, 0x74, 0x07, 0x38, 0xec, 0x9c, 0x44,
-	0x40, 0x4e, 0x58, 0x75, 0x5f, 0x9e, 0x3d, 0x18, 0xa2, 0x1e, 0xe2, 0x1a, 0x98, 0x6e, 0x27, 0xab,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x8a, 0xc2, 0x0a, 0x50, 0x24, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0xa3, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x23, 0x28, 0x5f,
-	0x27, 0x02, 0x00, 0x00,
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6a, 0xe2, 0x40,
+	0x18, 0xc7, 0x33, 0xab, 0xab, 0xeb, 0xb8, 0x2c, 0xbb, 0xb3, 0xee, 0xee, 0x20, 0x4b, 0x0c, 0x9e,
+	0x42, 0x0f, 0x09, 0xda, 0x4b, 0x69, 0x4f, 0x15, 0x8a, 0xf4, 0x56, 0xe2, 0xad, 0x17, 0x19, 0x93,
+	0x21, 0x06, 0x4c, 0x26, 0xcc, 0x8c, 0xd2, 0xbe, 0x45, 0x1f, 0xa3, 0xf4, 0xd4, 0xc7, 0xf0, 0xe8,
+	0xb1, 0xa7, 0x5a, 0xf4, 0xd0, 0x27, 0xe8, 0xbd, 0x64, 0x26, 0x4a, 0x6a, 0x0a, 0x43, 0xf8, 0x32,
+	0xff, 0xff, 0xef, 0xfb, 0xfe, 0x09, 0x1f, 0x34, 0x7d, 0x26, 0x62, 0x26, 0x5c, 0xba, 0x88, 0xdd,
+	0xec, 0xf4, 0xdc, 0x90, 0x26, 0x54, 0x44, 0xc2, 0x49, 0x39, 0x93, 0x0c, 0xfd, 0xd4, 0xba, 0x43,
+	0x17, 0xb1, 0x93, 0x9d, 0x5e, 0xfb, 0x17, 0x89, 0xa3, 0x84, 0xb9, 0xea, 0xa9, 0x4d, 0xed, 0x76,
+	0xa9, 0x49, 0x66, 0xd7, 0x5a, 0x2b, 0x64, 0x21, 0x53, 0xa5, 0x9b, 0x55, 0xfa, 0xb6, 0xbb, 0x06,
+	0xf0, 0xfb, 0x50, 0x0f, 0x1a, 0x49, 0x22, 0x29, 0x1a, 0xc2, 0x6f, 0xc4, 0xf7, 0xd9, 0x3c, 0x91,
+	0x02, 0x03, 0xab, 0x62, 0x37, 0xfb, 0x96, 0x73, 0x38, 0xda, 0xc9, 0x89, 0x73, 0x6d, 0x1c, 0x34,
+	0x96, 0xcf, 0x1d, 0xe3, 0xfe, 0xf5, 0xf1, 0x08, 0x78, 0x7b, 0x18, 0x9d, 0xc1, 0x5a, 0x4a, 0x38,
+	0x89, 0x05, 0xfe, 0x62, 0x01, 0xbb, 0xd9, 0xc7, 0xe5, 0x36, 0x57, 0x4a, 0x2f, 0xe2, 0x39, 0x82,
+	0x2e, 0x61, 0x33, 0xe5, 0x34, 0x4a, 0x84, 0x24, 0xb3, 0x99, 0xc0, 0x15, 0x15, 0xe4, 0xff, 0x27,
+	0x1d, 0xf6, 0xa6, 0x62, 0x97, 0x22, 0xdb, 0x7d, 0x03, 0xf0, 0xc7, 0xc7, 0xbc, 0x08, 0xc3, 0x3a,
+	0x09, 0x02, 0x4e, 0x45, 0xf6, 0x89, 0xc0, 0x6e, 0x78, 0xbb, 0x57, 0x84, 0x60, 0xd5, 0x67, 0x01,
+	0x55, 0x91, 0x1b, 0x9e, 0xaa, 0xd1, 0x10, 0xd6, 0x85, 0x64, 0x9c, 0x84, 0x34, 0xcf, 0xf1, 0xaf,
+	0x9c, 0x43, 0xfd, 0xbb, 0x41, 0x2b, 0x8b, 0xf0, 0xb0, 0xee, 0xd4, 0x47, 0xda, 0xaf, 0xd3, 0xec,
+	0x68, 0xd4, 0x87, 0x7f, 0x08, 0xf7, 0xa7, 0xd1, 0x82, 0x06, 0xe3, 0xfc, 0x6e, 0xcc, 0x19, 0x93,
+	0xb8, 0xaa, 0xa6, 0xfd, 0xde, 0x89, 0x39, 0xed, 0x31, 0x26, 0xd1, 0x09, 0xc4, 0x25, 0x86, 0x26,
+	0x92, 0x47, 0x54, 0xe0, 0xaf, 0x16, 0xb0, 0xab, 0xde, 0xdf, 0x03, 0xec, 0x42, 0xab, 0x83, 0xd3,
+	0xe5, 0xc6, 0x04, 0xab, 0x8d, 0x09, 0x5e, 0x36, 0x26, 0xb8, 0xdb, 0x9a, 0xc6, 0x6a, 0x6b, 0x1a,
+	0x4f, 0x5b, 0xd3, 0xb8, 0xb6, 0xc2, 0x48, 0x4e, 0xe7, 0x13, 0xc7, 0x67, 0xb1, 0x5b, 0x58, 0x98,
+	0x9b, 0x6c, 0x65, 0xe4, 0x6d, 0x4a, 0xc5, 0xa4, 0xa6, 0x96, 0xe3, 0xf8, 0x7d, 0x00, 0x91, 0x46,
+	0x1b, 0xeb, 0x95, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -268,6 +291,18 @@ func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ArchivedStorageEntries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ArchivedStorageEntries))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ArchivedStorageRoot) > 0 {
+		i -= len(m.ArchivedStorageRoot)
+		copy(dAtA[i:], m.ArchivedStorageRoot)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ArchivedStorageRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Storage) > 0 {
 		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -353,6 +388,13 @@ func (m *GenesisAccount) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.ArchivedStorageRoot)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ArchivedStorageEntries != 0 {
+		n += 1 + sovGenesis(uint64(m.ArchivedStorageEntries))
+	}
 	return n
 }
 
@@ -640,6 +682,57 @@ func (m *GenesisAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedStorageRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedStorageRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedStorageEntries", wireType)
+			}
+			m.ArchivedStorageEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArchivedStorageEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -69,6 +69,79 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 			},
 			true,
 		},
+		{
+			"archived storage",
+			GenesisAccount{
+				Address:                suite.address,
+				Code:                   suite.code,
+				ArchivedStorageRoot:    suite.hash.Hex(),
+				ArchivedStorageEntries: 2,
+			},
+			true,
+		},
+		{
+			"archived storage with restored entries",
+			GenesisAccount{
+				Address:                suite.address,
+				Code:                   suite.code,
+				ArchivedStorageRoot:    suite.hash.Hex(),
+				ArchivedStorageEntries: 2,
+				Storage: Storage{
+					NewState(suite.hash, suite.hash),
+				},
+			},
+			true,
+		},
+		{
+			"archived storage without entries",
+			GenesisAccount{
+				Address:             suite.address,
+				Code:                suite.code,
+				ArchivedStorageRoot: suite.hash.Hex(),
+			},
+			false,
+		},
+		{
+			"archived storage entries without root",
+			GenesisAccount{
+				Address:                suite.address,
+				Code:                   suite.code,
+				ArchivedStorageEntries: 2,
+			},
+			false,
+		},
+		{
+			"invalid archived storage root",
+			GenesisAccount{
+				Address:                suite.address,
+				Code:                   suite.code,
+				ArchivedStorageRoot:    "0x1234",
+				ArchivedStorageEntries: 2,
+			},
+			false,
+		},
+		{
+			"archived storage without code",
+			GenesisAccount{
+				Address:                suite.address,
+				ArchivedStorageRoot:    suite.hash.Hex(),
+				ArchivedStorageEntries: 2,
+			},
+			false,
+		},
+		{
+			"archived storage with all its entries restored",
+			GenesisAccount{
+				Address:                suite.address,
+				Code:                   suite.code,
+				ArchivedStorageRoot:    suite.hash.Hex(),
+				ArchivedStorageEntries: 1,
+				Storage: Storage{
+					NewState(suite.hash, suite.hash),
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,9 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -27,6 +30,12 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixForkActivation
+	prefixBlockHash
+	prefixEmptyAccountSweepCursor
+	prefixContractAccessHeight
+	prefixArchivedStorage
+	prefixFlatStorage
 )
 
 // prefix bytes for the EVM transient store
@@ -35,22 +44,38 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayer
+	prefixTransientMsgCount
+	prefixTransientMixedTx
+	prefixTransientBlockGasUsed
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode     = []byte{prefixCode}
-	KeyPrefixStorage  = []byte{prefixStorage}
-	KeyPrefixParams   = []byte{prefixParams}
-	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	KeyPrefixCode           = []byte{prefixCode}
+	KeyPrefixStorage        = []byte{prefixStorage}
+	KeyPrefixParams         = []byte{prefixParams}
+	KeyPrefixCodeHash       = []byte{prefixCodeHash}
+	KeyPrefixForkActivation = []byte{prefixForkActivation}
+	KeyPrefixBlockHash      = []byte{prefixBlockHash}
+	// KeyEmptyAccountSweepCursor is the key of the x/auth store key of the last
+	// account scanned by the sweep of the empty accounts
+	KeyEmptyAccountSweepCursor    = []byte{prefixEmptyAccountSweepCursor}
+	KeyPrefixContractAccessHeight = []byte{prefixContractAccessHeight}
+	KeyPrefixArchivedStorage      = []byte{prefixArchivedStorage}
+	KeyPrefixFlatStorage          = []byte{prefixFlatStorage}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom        = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex      = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize      = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed      = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayer     = []byte{prefixTransientFeePayer}
+	KeyPrefixTransientMsgCount     = []byte{prefixTransientMsgCount}
+	KeyPrefixTransientMixedTx      = []byte{prefixTransientMixedTx}
+	KeyPrefixTransientBlockGasUsed = []byte{prefixTransientBlockGasUsed}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -58,6 +83,30 @@ func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
 
+// FlatStoragePrefix returns a prefix to iterate over a given account storage in
+// the layout of the flat storage backend, keyed by the hash of the address.
+func FlatStoragePrefix(address common.Address) []byte {
+	return append(KeyPrefixFlatStorage, crypto.Keccak256(address.Bytes())...)
+}
+
+// BlockHashKey returns the key of the ring buffer slot storing the hash of the
+// given block.
+func BlockHashKey(slot uint64) []byte {
+	return append(KeyPrefixBlockHash, sdk.Uint64ToBigEndian(slot)...)
+}
+
+// ContractAccessHeightKey returns the key of the height of the last block in
+// which the storage of the contract was accessed.
+func ContractAccessHeightKey(address common.Address) []byte {
+	return append(KeyPrefixContractAccessHeight, address.Bytes()...)
+}
+
+// ArchivedStorageKey returns the key of the root and the entry counts of the
+// archived storage of the contract.
+func ArchivedStorageKey(address common.Address) []byte {
+	return append(KeyPrefixArchivedStorage, address.Bytes()...)
+}
+
 // StateKey defines the full key under which an account state is stored.
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
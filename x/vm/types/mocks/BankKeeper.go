@@ -50,6 +50,24 @@ func (_m *BankKeeper) GetBalance(ctx context.Context, addr types.AccAddress, den
 	return r0
 }
 
+// GetSupply provides a mock function with given fields: ctx, denom
+func (_m *BankKeeper) GetSupply(ctx context.Context, denom string) types.Coin {
+	ret := _m.Called(ctx, denom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSupply")
+	}
+
+	var r0 types.Coin
+	if rf, ok := ret.Get(0).(func(context.Context, string) types.Coin); ok {
+		r0 = rf(ctx, denom)
+	} else {
+		r0 = ret.Get(0).(types.Coin)
+	}
+
+	return r0
+}
+
 // IsSendEnabledCoins provides a mock function with given fields: ctx, coins
 func (_m *BankKeeper) IsSendEnabledCoins(ctx context.Context, coins ...types.Coin) error {
 	_va := make([]interface{}, len(coins))
@@ -75,6 +93,11 @@ func (_m *BankKeeper) IsSendEnabledCoins(ctx context.Context, coins ...types.Coi
 	return r0
 }
 
+// IterateAccountBalances provides a mock function with given fields: ctx, account, cb
+func (_m *BankKeeper) IterateAccountBalances(ctx context.Context, account types.AccAddress, cb func(types.Coin) bool) {
+	_m.Called(ctx, account, cb)
+}
+
 // MintCoins provides a mock function with given fields: ctx, moduleName, amt
 func (_m *BankKeeper) MintCoins(ctx context.Context, moduleName string, amt types.Coins) error {
 	ret := _m.Called(ctx, moduleName, amt)
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"bytes"
 	"errors"
 	"maps"
 	"math/big"
@@ -57,10 +58,17 @@ func (k EVMKeeper) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
 	return k.codes[codeHash]
 }
 
-func (k EVMKeeper) ForEachStorage(_ sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+func (k EVMKeeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	k.ForEachStorageFrom(ctx, addr, common.Hash{}, cb)
+}
+
+func (k EVMKeeper) ForEachStorageFrom(_ sdk.Context, addr common.Address, start common.Hash, cb func(key, value common.Hash) bool) {
 	if acct, ok := k.accounts[addr]; ok {
-		for k, v := range acct.states {
-			if !cb(k, v) {
+		for _, key := range acct.states.SortedKeys() {
+			if bytes.Compare(key.Bytes(), start.Bytes()) < 0 {
+				continue
+			}
+			if !cb(key, acct.states[key]) {
 				return
 			}
 		}
@@ -52,6 +52,24 @@ func (_m *FeeMarketKeeper) GetBaseFee(ctx types.Context) math.LegacyDec {
 	return r0
 }
 
+// GetBlockParams provides a mock function with given fields: ctx
+func (_m *FeeMarketKeeper) GetBlockParams(ctx types.Context) feemarkettypes.Params {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockParams")
+	}
+
+	var r0 feemarkettypes.Params
+	if rf, ok := ret.Get(0).(func(types.Context) feemarkettypes.Params); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(feemarkettypes.Params)
+	}
+
+	return r0
+}
+
 // GetParams provides a mock function with given fields: ctx
 func (_m *FeeMarketKeeper) GetParams(ctx types.Context) feemarkettypes.Params {
 	ret := _m.Called(ctx)
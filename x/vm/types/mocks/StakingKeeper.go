@@ -19,6 +19,36 @@ type StakingKeeper struct {
 	mock.Mock
 }
 
+// GetDelegatorDelegations provides a mock function with given fields: ctx, delegator, maxRetrieve
+func (_m *StakingKeeper) GetDelegatorDelegations(ctx context.Context, delegator cosmos_sdktypes.AccAddress, maxRetrieve uint16) ([]types.Delegation, error) {
+	ret := _m.Called(ctx, delegator, maxRetrieve)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegatorDelegations")
+	}
+
+	var r0 []types.Delegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, cosmos_sdktypes.AccAddress, uint16) ([]types.Delegation, error)); ok {
+		return rf(ctx, delegator, maxRetrieve)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, cosmos_sdktypes.AccAddress, uint16) []types.Delegation); ok {
+		r0 = rf(ctx, delegator, maxRetrieve)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Delegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, cosmos_sdktypes.AccAddress, uint16) error); ok {
+		r1 = rf(ctx, delegator, maxRetrieve)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHistoricalInfo provides a mock function with given fields: ctx, height
 func (_m *StakingKeeper) GetHistoricalInfo(ctx context.Context, height int64) (types.HistoricalInfo, error) {
 	ret := _m.Called(ctx, height)
@@ -47,6 +77,94 @@ func (_m *StakingKeeper) GetHistoricalInfo(ctx context.Context, height int64) (t
 	return r0, r1
 }
 
+// GetRedelegations provides a mock function with given fields: ctx, delegator, maxRetrieve
+func (_m *StakingKeeper) GetRedelegations(ctx context.Context, delegator cosmos_sdktypes.AccAddress, maxRetrieve uint16) ([]types.Redelegation, error) {
+	ret := _m.Called(ctx, delegator, maxRetrieve)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRedelegations")
+	}
+
+	var r0 []types.Redelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, cosmos_sdktypes.AccAddress, uint16) ([]types.Redelegation, error)); ok {
+		return rf(ctx, delegator, maxRetrieve)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, cosmos_sdktypes.AccAddress, uint16) []types.Redelegation); ok {
+		r0 = rf(ctx, delegator, maxRetrieve)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Redelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, cosmos_sdktypes.AccAddress, uint16) error); ok {
+		r1 = rf(ctx, delegator, maxRetrieve)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnbondingDelegations provides a mock function with given fields: ctx, delegator, maxRetrieve
+func (_m *StakingKeeper) GetUnbondingDelegations(ctx context.Context, delegator cosmos_sdktypes.AccAddress, maxRetrieve uint16) ([]types.UnbondingDelegation, error) {
+	ret := _m.Called(ctx, delegator, maxRetrieve)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnbondingDelegations")
+	}
+
+	var r0 []types.UnbondingDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, cosmos_sdktypes.AccAddress, uint16) ([]types.UnbondingDelegation, error)); ok {
+		return rf(ctx, delegator, maxRetrieve)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, cosmos_sdktypes.AccAddress, uint16) []types.UnbondingDelegation); ok {
+		r0 = rf(ctx, delegator, maxRetrieve)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.UnbondingDelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, cosmos_sdktypes.AccAddress, uint16) error); ok {
+		r1 = rf(ctx, delegator, maxRetrieve)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetValidator provides a mock function with given fields: ctx, addr
+func (_m *StakingKeeper) GetValidator(ctx context.Context, addr cosmos_sdktypes.ValAddress) (types.Validator, error) {
+	ret := _m.Called(ctx, addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValidator")
+	}
+
+	var r0 types.Validator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, cosmos_sdktypes.ValAddress) (types.Validator, error)); ok {
+		return rf(ctx, addr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, cosmos_sdktypes.ValAddress) types.Validator); ok {
+		r0 = rf(ctx, addr)
+	} else {
+		r0 = ret.Get(0).(types.Validator)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, cosmos_sdktypes.ValAddress) error); ok {
+		r1 = rf(ctx, addr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetValidatorByConsAddr provides a mock function with given fields: ctx, consAddr
 func (_m *StakingKeeper) GetValidatorByConsAddr(ctx context.Context, consAddr cosmos_sdktypes.ConsAddress) (types.Validator, error) {
 	ret := _m.Called(ctx, consAddr)
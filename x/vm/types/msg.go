@@ -12,6 +12,7 @@ import (
 	protov2 "google.golang.org/protobuf/proto"
 
 	evmapi "github.com/cosmos/evm/api/cosmos/evm/vm/v1"
+	"github.com/cosmos/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -33,6 +34,9 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgRecoverStuckFunds{}
+	_ sdk.Msg    = &MsgArchiveContractStorage{}
+	_ sdk.Msg    = &MsgRestoreContractStorage{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -121,29 +125,26 @@ func newMsgEthereumTx(
 		}
 	}
 
-	dataAny, err := PackTxData(txData)
-	if err != nil {
+	msg := &MsgEthereumTx{}
+	if err := msg.FromEthereumTx(ethtypes.NewTx(txData.AsEthereumData())); err != nil {
 		panic(err)
 	}
-
-	msg := MsgEthereumTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
-	return &msg
+	return msg
 }
 
-// FromEthereumTx populates the message fields from the given ethereum transaction
+// FromEthereumTx populates the message fields from the given ethereum transaction.
+// The transaction is kept in the raw field, the legacy data field is cleared.
 func (msg *MsgEthereumTx) FromEthereumTx(tx *ethtypes.Transaction) error {
-	txData, err := NewTxDataFromTx(tx)
-	if err != nil {
-		return err
+	if !isSupportedTxType(tx.Type()) {
+		return ethtypes.ErrTxTypeNotSupported
 	}
-
-	anyTxData, err := PackTxData(txData)
-	if err != nil {
+	// reject the values that don't fit in the TxData
+	if _, err := NewTxDataFromTx(tx); err != nil {
 		return err
 	}
 
-	msg.Data = anyTxData
+	msg.Data = nil
+	msg.Raw = NewEthereumTx(tx)
 	msg.Hash = tx.Hash().Hex()
 	return nil
 }
@@ -185,7 +186,11 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "tx size is deprecated")
 	}
 
-	txData, err := UnpackTxData(msg.Data)
+	if msg.Data != nil && msg.Raw != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "tx data and raw tx are both set")
+	}
+
+	txData, err := msg.GetTxData()
 	if err != nil {
 		return errorsmod.Wrap(err, "failed to unpack tx data")
 	}
@@ -297,6 +302,9 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 
 // GetGas implements the GasTx interface. It returns the GasLimit of the transaction.
 func (msg MsgEthereumTx) GetGas() uint64 {
+	if tx := msg.rawTx(); tx != nil {
+		return tx.Gas()
+	}
 	txData, err := UnpackTxData(msg.Data)
 	if err != nil {
 		return 0
@@ -306,6 +314,10 @@ func (msg MsgEthereumTx) GetGas() uint64 {
 
 // GetFee returns the fee for non dynamic fee tx
 func (msg MsgEthereumTx) GetFee() *big.Int {
+	if tx := msg.rawTx(); tx != nil {
+		// the fee cap of legacy and access list txs is the gas price
+		return fee(tx.GasFeeCap(), tx.Gas())
+	}
 	txData, err := UnpackTxData(msg.Data)
 	if err != nil {
 		return nil
@@ -315,7 +327,7 @@ func (msg MsgEthereumTx) GetFee() *big.Int {
 
 // GetEffectiveFee returns the fee for dynamic fee tx
 func (msg MsgEthereumTx) GetEffectiveFee(baseFee *big.Int) *big.Int {
-	txData, err := UnpackTxData(msg.Data)
+	txData, err := msg.GetTxData()
 	if err != nil {
 		return nil
 	}
@@ -328,8 +340,31 @@ func (msg *MsgEthereumTx) GetFrom() sdk.AccAddress {
 	return sdk.AccAddress(msg.From)
 }
 
-// AsTransaction creates an Ethereum Transaction type from the msg fields
+// GetTxData returns the TxData of the transaction. It's converted from the raw
+// transaction, or unpacked from the data field of legacy encoded messages.
+func (msg MsgEthereumTx) GetTxData() (TxData, error) {
+	if msg.Raw == nil {
+		return UnpackTxData(msg.Data)
+	}
+
+	tx := msg.rawTx()
+	if tx == nil {
+		return nil, errors.New("raw tx is empty")
+	}
+	if !isSupportedTxType(tx.Type()) {
+		return nil, ethtypes.ErrTxTypeNotSupported
+	}
+	return NewTxDataFromTx(tx)
+}
+
+// AsTransaction returns the Ethereum Transaction of the msg. The decoded raw
+// transaction is returned as is, while it's rebuilt from the TxData of legacy
+// encoded messages.
 func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
+	if msg.Raw != nil {
+		return msg.rawTx()
+	}
+
 	txData, err := UnpackTxData(msg.Data)
 	if err != nil {
 		return nil
@@ -338,6 +373,24 @@ func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	return ethtypes.NewTx(txData.AsEthereumData())
 }
 
+// rawTx returns the decoded raw transaction, nil for legacy encoded messages
+func (msg MsgEthereumTx) rawTx() *ethtypes.Transaction {
+	if msg.Raw == nil {
+		return nil
+	}
+	return msg.Raw.Transaction
+}
+
+// isSupportedTxType returns true for the ethereum tx types that have a TxData
+func isSupportedTxType(txType uint8) bool {
+	switch txType {
+	case ethtypes.LegacyTxType, ethtypes.AccessListTxType, ethtypes.DynamicFeeTxType:
+		return true
+	default:
+		return false
+	}
+}
+
 func bigMin(x, y *big.Int) *big.Int {
 	if x.Cmp(y) > 0 {
 		return y
@@ -347,7 +400,26 @@ func bigMin(x, y *big.Int) *big.Int {
 
 // AsMessage creates an Ethereum core.Message from the msg fields
 func (msg MsgEthereumTx) AsMessage(baseFee *big.Int) (*core.Message, error) {
-	txData, err := UnpackTxData(msg.Data)
+	if tx := msg.rawTx(); tx != nil {
+		gasPrice, gasFeeCap, gasTipCap := tx.GasPrice(), tx.GasFeeCap(), tx.GasTipCap()
+		if baseFee != nil {
+			gasPrice = bigMin(gasPrice.Add(gasTipCap, baseFee), gasFeeCap)
+		}
+		return &core.Message{
+			From:       msg.GetSender(),
+			To:         tx.To(),
+			Nonce:      tx.Nonce(),
+			Value:      tx.Value(),
+			GasLimit:   tx.Gas(),
+			GasPrice:   gasPrice,
+			GasFeeCap:  gasFeeCap,
+			GasTipCap:  gasTipCap,
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}, nil
+	}
+
+	txData, err := msg.GetTxData()
 	if err != nil {
 		return nil, err
 	}
@@ -371,6 +443,33 @@ func (msg MsgEthereumTx) AsMessage(baseFee *big.Int) (*core.Message, error) {
 	return &ethMsg, nil
 }
 
+// ToMessage converts the tx to a core.Message like core.TransactionToMessage
+// does, except that the sender is the From field instead of being recovered
+// from the signature, unless it's empty.
+func (msg *MsgEthereumTx) ToMessage(signer ethtypes.Signer, baseFee *big.Int) (*core.Message, error) {
+	if len(msg.From) > 0 {
+		signer = knownSenderSigner{Signer: signer, sender: msg.GetSender()}
+	}
+	return core.TransactionToMessage(msg.AsTransaction(), signer, baseFee)
+}
+
+// knownSenderSigner is a signer returning the known sender of a tx without
+// recovering it from the signature.
+type knownSenderSigner struct {
+	ethtypes.Signer
+	sender common.Address
+}
+
+func (s knownSenderSigner) Sender(*ethtypes.Transaction) (common.Address, error) {
+	return s.sender, nil
+}
+
+// Equal returns false, so that the sender cached in the tx by ethtypes.Sender
+// is never returned for another signer, as it isn't verified.
+func (s knownSenderSigner) Equal(ethtypes.Signer) bool {
+	return false
+}
+
 // VerifySender verify the sender address against the signature values using the latest signer for the given chainID.
 func (msg *MsgEthereumTx) VerifySender(signer ethtypes.Signer) error {
 	from, err := msg.recoverSender(signer)
@@ -410,7 +509,7 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 		return nil, err
 	}
 
-	txData, err := UnpackTxData(msg.Data)
+	txData, err := msg.GetTxData()
 	if err != nil {
 		return nil, err
 	}
@@ -446,3 +545,69 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRecoverStuckFunds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.ModuleName == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "module name cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(err, "invalid recipient address")
+	}
+
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount %s", m.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRecoverStuckFunds) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgArchiveContractStorage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return types.ValidateNonZeroAddress(m.Address)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgArchiveContractStorage) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRestoreContractStorage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := types.ValidateNonZeroAddress(m.Address); err != nil {
+		return err
+	}
+
+	if len(m.Entries) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "no storage entries to restore")
+	}
+	for _, entry := range m.Entries {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRestoreContractStorage) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -121,7 +121,7 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_BuildTx() {
 			configurator.ResetTestConfig()
 			suite.Require().NoError(configurator.WithEVMCoinInfo(coinInfo).Configure())
 			if strings.Contains(tc.name, "nil data") {
-				tc.msg.Data = nil
+				tc.msg.Data, tc.msg.Raw = nil, nil
 			}
 
 			baseDenom := types.GetEVMCoinDenom()
@@ -361,6 +361,7 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 			errMsg:     "gas limit must not be zero",
 		},
 		{
+			// nil values are encoded as zero in the raw tx
 			msg:        "nil gas price - AccessListTx",
 			to:         suite.to.Hex(),
 			from:       suite.from.Hex(),
@@ -371,8 +372,7 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 			gasTipCap:  nil,
 			accessList: &ethtypes.AccessList{},
 			chainID:    validChainID,
-			expectPass: false,
-			errMsg:     "cannot be nil: invalid gas price",
+			expectPass: true,
 		},
 		{
 			msg:        "negative gas price - AccessListTx",
@@ -415,6 +415,7 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 			errMsg:     "sender address is missing",
 		},
 		{
+			// the chain ID is checked by the signer in the ante handler
 			msg:        "chain ID not set on AccessListTx",
 			to:         suite.to.Hex(),
 			from:       suite.from.Hex(),
@@ -425,8 +426,7 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 			gasTipCap:  nil,
 			accessList: &ethtypes.AccessList{},
 			chainID:    nil,
-			expectPass: false,
-			errMsg:     "chain ID must be present on AccessList txs",
+			expectPass: true,
 		},
 		{
 			msg:        "nil tx.Data - AccessList Tx",
@@ -463,7 +463,7 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 
 			// apply nil assignment here to test ValidateBasic function instead of NewTx
 			if strings.Contains(tc.msg, "nil tx.Data") {
-				tx.Data = nil
+				tx.Data, tx.Raw = nil, nil
 			}
 
 			// for legacy_Tx need to sign tx because the chainID is derived
@@ -640,6 +640,35 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_Sign() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumTx_ToMessage() {
+	ethSigner := ethtypes.LatestSignerForChainID(suite.chainID)
+	tx := types.NewTx(&types.EvmTxArgs{
+		ChainID:  suite.chainID,
+		Nonce:    0,
+		To:       &suite.to,
+		GasLimit: 21000,
+		GasPrice: suite.hundredBigInt,
+	})
+	tx.From = suite.from.Bytes()
+	suite.Require().NoError(tx.Sign(ethSigner, suite.signer))
+
+	msg, err := tx.ToMessage(ethSigner, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, msg.From)
+
+	// the sender of the msg is used as is
+	tx.From = suite.to.Bytes()
+	msg, err = tx.ToMessage(ethSigner, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.to, msg.From)
+
+	// it's recovered from the signature if the msg has no sender
+	tx.From = nil
+	msg, err = tx.ToMessage(ethSigner, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.from, msg.From)
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_Getters() {
 	evmTx := &types.EvmTxArgs{
 		ChainID:  suite.chainID,
@@ -692,7 +721,7 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_Getters() {
 	for _, tc := range testCases {
 		tx := types.NewTx(evmTx)
 		if strings.Contains(tc.name, "nil data") {
-			tx.Data = nil
+			tx.Data, tx.Raw = nil, nil
 		}
 		switch {
 		case strings.Contains(tc.name, "get fee"):
@@ -818,6 +847,94 @@ func (suite *MsgsTestSuite) TestFromEthereumTx() {
 
 // TestTransactionCoding tests serializing/de-serializing to/from rlp and JSON.
 // adapted from go-ethereum
+func (suite *MsgsTestSuite) TestMsgEthereumTx_RawEncoding() {
+	privkey, _ := ethsecp256k1.GenerateKey()
+	ethPriv, err := privkey.ToECDSA()
+	suite.Require().NoError(err)
+
+	ethSigner := ethtypes.NewLondonSigner(suite.chainID)
+	tx, err := ethtypes.SignNewTx(ethPriv, ethSigner, &ethtypes.DynamicFeeTx{
+		ChainID:   suite.chainID,
+		Nonce:     1,
+		To:        &suite.to,
+		Value:     big.NewInt(10),
+		Gas:       21000,
+		GasFeeCap: big.NewInt(2),
+		GasTipCap: big.NewInt(1),
+	})
+	suite.Require().NoError(err)
+
+	msg := &types.MsgEthereumTx{}
+	suite.Require().NoError(msg.FromSignedEthereumTx(tx, ethSigner))
+	suite.Require().Nil(msg.Data)
+	suite.Require().Equal(tx, msg.AsTransaction())
+
+	// the tx is decoded once when the msg is unmarshaled
+	bz, err := msg.Marshal()
+	suite.Require().NoError(err)
+	decoded := &types.MsgEthereumTx{}
+	suite.Require().NoError(decoded.Unmarshal(bz))
+	suite.Require().NoError(decoded.ValidateBasic())
+	suite.Require().Equal(tx.Hash(), decoded.AsTransaction().Hash())
+	suite.Require().Same(decoded.AsTransaction(), decoded.AsTransaction())
+	suite.Require().Equal(uint64(21000), decoded.GetGas())
+	suite.Require().Equal(big.NewInt(42000), decoded.GetFee())
+
+	coreMsg, err := decoded.AsMessage(big.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(2), coreMsg.GasPrice)
+	suite.Require().Equal(&suite.to, coreMsg.To)
+
+	jsonBz, err := decoded.Raw.MarshalJSON()
+	suite.Require().NoError(err)
+	var raw types.EthereumTx
+	suite.Require().NoError(raw.UnmarshalJSON(jsonBz))
+	suite.Require().Equal(tx.Hash(), raw.Hash())
+
+	// a msg can't carry both encodings
+	txData, err := types.NewTxDataFromTx(tx)
+	suite.Require().NoError(err)
+	decoded.Data, err = types.PackTxData(txData)
+	suite.Require().NoError(err)
+	suite.Require().ErrorContains(decoded.ValidateBasic(), "tx data and raw tx are both set")
+
+	// blob txs have no TxData
+	blobTx := ethtypes.NewTx(&ethtypes.BlobTx{To: suite.to, Gas: 21000})
+	suite.Require().ErrorIs(msg.FromEthereumTx(blobTx), ethtypes.ErrTxTypeNotSupported)
+}
+
+func (suite *MsgsTestSuite) TestMsgEthereumTx_LegacyEncoding() {
+	txData := &types.AccessListTx{
+		ChainID:  &sdkmath.Int{},
+		Nonce:    1,
+		To:       suite.to.Hex(),
+		GasLimit: 21000,
+	}
+	*txData.ChainID = sdkmath.NewIntFromBigInt(suite.chainID)
+	gasPrice := sdkmath.NewInt(1)
+	txData.GasPrice = &gasPrice
+
+	dataAny, err := types.PackTxData(txData)
+	suite.Require().NoError(err)
+	msg := &types.MsgEthereumTx{Data: dataAny, From: suite.from.Bytes()}
+	tx := msg.AsTransaction()
+	msg.Hash = tx.Hash().Hex()
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Equal(uint64(21000), msg.GetGas())
+	suite.Require().Equal(big.NewInt(21000), msg.GetFee())
+
+	// the TxData values that can't be represented by a raw tx are still validated
+	txData.GasPrice = nil
+	msg.Data, err = types.PackTxData(txData)
+	suite.Require().NoError(err)
+	suite.Require().ErrorContains(msg.ValidateBasic(), "cannot be nil: invalid gas price")
+
+	txData.GasPrice, txData.ChainID = &gasPrice, nil
+	msg.Data, err = types.PackTxData(txData)
+	suite.Require().NoError(err)
+	suite.Require().ErrorContains(msg.ValidateBasic(), "chain ID must be present on AccessList txs")
+}
+
 func (suite *MsgsTestSuite) TestTransactionCoding() {
 	key, err := crypto.GenerateKey()
 	if err != nil {
@@ -46,6 +46,14 @@ func (h *DefaultOpCodesHooks) AddCreateHooks(hooks ...CreateHook) {
 	h.createHooks = append(h.createHooks, hooks...)
 }
 
+// Reset removes all the hooks, so that the instance can be reused.
+func (h *DefaultOpCodesHooks) Reset() {
+	clear(h.callHooks)
+	h.callHooks = h.callHooks[:0]
+	clear(h.createHooks)
+	h.createHooks = h.createHooks[:0]
+}
+
 // CreateHook checks if the caller has permission to deploy contracts
 func (h *DefaultOpCodesHooks) CreateHook(evm *vm.EVM, caller common.Address) error {
 	for _, hook := range h.createHooks {
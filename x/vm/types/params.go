@@ -20,6 +20,9 @@ var (
 	// DefaultEVMDenom is the default value for the evm denom
 	DefaultEVMDenom = "atest"
 	// DefaultEVMChainID is the default value for the evm chain ID
+	//
+	// Deprecated: the EVM chain id isn't derived from the cosmos chain id
+	// anymore, it's set by the evm-chain-id of the app config.
 	DefaultEVMChainID = "cosmos_262144-1"
 	// DefaultEVMDecimals is the default value for the evm denom decimal precision
 	DefaultEVMDecimals uint64 = 18
@@ -45,6 +48,10 @@ var (
 	}
 )
 
+// MaxEmptyAccountSweepBatch is the maximum number of accounts scanned per block
+// by the sweep of the empty accounts, which bounds its IO.
+const MaxEmptyAccountSweepBatch = 10_000
+
 // NewParams creates a new Params instance
 func NewParams(
 	allowUnprotectedTxs bool,
@@ -98,10 +105,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateScheduledEIPs(p.ScheduledEIPs, p.ExtraEIPs); err != nil {
+		return err
+	}
+
 	if err := validateBool(p.AllowUnprotectedTxs); err != nil {
 		return err
 	}
 
+	if err := validateAllowlistAddresses(p.UnprotectedTxsAllowlist); err != nil {
+		return err
+	}
+
+	if err := validateAllowlistAddresses(p.IBCCallbackContractsAllowlist); err != nil {
+		return err
+	}
+
 	if err := ValidatePrecompiles(p.ActiveStaticPrecompiles); err != nil {
 		return err
 	}
@@ -110,6 +129,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if p.EmptyAccountSweepBatch > MaxEmptyAccountSweepBatch {
+		return fmt.Errorf("empty account sweep batch %d exceeds the maximum %d", p.EmptyAccountSweepBatch, MaxEmptyAccountSweepBatch)
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -122,6 +145,18 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// EIPsAt returns the ExtraEIPs and the ScheduledEIPs activated at the given
+// block height as a int slice
+func (p Params) EIPsAt(height int64) []int {
+	eips := p.EIPs()
+	for _, scheduled := range p.ScheduledEIPs {
+		if scheduled.Height <= height {
+			eips = append(eips, int(scheduled.EIP))
+		}
+	}
+	return eips
+}
+
 // GetActiveStaticPrecompilesAddrs is a util function that the Active Precompiles
 // as a slice of addresses.
 func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
@@ -132,6 +167,30 @@ func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
 	return precompiles
 }
 
+// IsUnprotectedTxAllowed returns true if the sender is allowed to submit
+// unprotected (i.e non EIP155 signed) transactions, either because they are
+// allowed globally or because the sender is in the allowlist.
+func (p Params) IsUnprotectedTxAllowed(sender common.Address) bool {
+	if p.AllowUnprotectedTxs {
+		return true
+	}
+	return slices.ContainsFunc(p.UnprotectedTxsAllowlist, func(address string) bool {
+		return common.HexToAddress(address) == sender
+	})
+}
+
+// IsIBCCallbackContractAllowed returns true if the contract can be called by the
+// destination callbacks of the received ICS-20 packets, either because there's
+// no allowlist or because the contract is in it.
+func (p Params) IsIBCCallbackContractAllowed(contract common.Address) bool {
+	if len(p.IBCCallbackContractsAllowlist) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(p.IBCCallbackContractsAllowlist, func(address string) bool {
+		return common.HexToAddress(address) == contract
+	})
+}
+
 // IsEVMChannel returns true if the channel provided is in the list of
 // EVM channels
 func (p Params) IsEVMChannel(channel string) bool {
@@ -211,6 +270,33 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+// validateScheduledEIPs checks that the scheduled EIPs are activateable, are
+// scheduled at a positive height and are neither duplicated nor already part of
+// the extra EIPs.
+func validateScheduledEIPs(scheduledEIPs []ScheduledEIP, extraEIPs []int64) error {
+	uniqueEIPs := make(map[int64]struct{})
+	for _, eip := range extraEIPs {
+		uniqueEIPs[eip] = struct{}{}
+	}
+
+	for _, scheduled := range scheduledEIPs {
+		if !vm.ValidEip(int(scheduled.EIP)) {
+			return fmt.Errorf("scheduled EIP %d is not activateable, valid EIPs are: %s", scheduled.EIP, vm.ActivateableEips())
+		}
+
+		if scheduled.Height <= 0 {
+			return fmt.Errorf("scheduled EIP %d has a non-positive height: %d", scheduled.EIP, scheduled.Height)
+		}
+
+		if _, ok := uniqueEIPs[scheduled.EIP]; ok {
+			return fmt.Errorf("found duplicate EIP: %d", scheduled.EIP)
+		}
+		uniqueEIPs[scheduled.EIP] = struct{}{}
+	}
+
+	return nil
+}
+
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
 func ValidatePrecompiles(i interface{}) error {
 	precompiles, ok := i.([]string)
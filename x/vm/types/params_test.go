@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
@@ -39,6 +40,43 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "EIP 1000000 is not activateable, valid EIPs are",
 		},
+		{
+			name: "valid scheduled eips",
+			params: Params{
+				ExtraEIPs:     []int64{2929},
+				ScheduledEIPs: []ScheduledEIP{{EIP: 1884, Height: 10}, {EIP: 1344, Height: 20}},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid scheduled eip",
+			params: Params{
+				ScheduledEIPs: []ScheduledEIP{{EIP: 1000000, Height: 10}},
+			},
+			errContains: "scheduled EIP 1000000 is not activateable, valid EIPs are",
+		},
+		{
+			name: "scheduled eip at a non-positive height",
+			params: Params{
+				ScheduledEIPs: []ScheduledEIP{{EIP: 1884, Height: 0}},
+			},
+			errContains: "scheduled EIP 1884 has a non-positive height: 0",
+		},
+		{
+			name: "scheduled eip already in the extra eips",
+			params: Params{
+				ExtraEIPs:     []int64{1884},
+				ScheduledEIPs: []ScheduledEIP{{EIP: 1884, Height: 10}},
+			},
+			errContains: "found duplicate EIP: 1884",
+		},
+		{
+			name: "duplicate scheduled eip",
+			params: Params{
+				ScheduledEIPs: []ScheduledEIP{{EIP: 1884, Height: 10}, {EIP: 1884, Height: 20}},
+			},
+			errContains: "found duplicate EIP: 1884",
+		},
 		{
 			name: "unsorted precompiles",
 			params: Params{
@@ -49,6 +87,44 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid unprotected txs allowlist",
+			params: Params{
+				UnprotectedTxsAllowlist: []string{"0x1000000000000000000000000000000000000000"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid unprotected txs allowlist address",
+			params: Params{
+				UnprotectedTxsAllowlist: []string{"0x1000"},
+			},
+			errContains: "invalid whitelist address: 0x1000",
+		},
+		{
+			name: "valid ibc callback contracts allowlist",
+			params: Params{
+				IBCCallbackContractsAllowlist: []string{"0x1000000000000000000000000000000000000000"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid ibc callback contracts allowlist address",
+			params: Params{
+				IBCCallbackContractsAllowlist: []string{"0x1000"},
+			},
+			errContains: "invalid whitelist address: 0x1000",
+		},
+		{
+			name:    "valid empty account sweep batch",
+			params:  Params{EmptyAccountSweepBatch: MaxEmptyAccountSweepBatch},
+			expPass: true,
+		},
+		{
+			name:        "empty account sweep batch above the maximum",
+			params:      Params{EmptyAccountSweepBatch: MaxEmptyAccountSweepBatch + 1},
+			errContains: "empty account sweep batch 10001 exceeds the maximum 10000",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -75,6 +151,33 @@ func TestParamsValidate(t *testing.T) {
 	}
 }
 
+func TestParamsIsUnprotectedTxAllowed(t *testing.T) {
+	allowed := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000000")
+
+	params := DefaultParams()
+	require.False(t, params.IsUnprotectedTxAllowed(allowed))
+
+	params.UnprotectedTxsAllowlist = []string{allowed.Hex()}
+	require.True(t, params.IsUnprotectedTxAllowed(allowed))
+	require.False(t, params.IsUnprotectedTxAllowed(other))
+
+	params.AllowUnprotectedTxs = true
+	require.True(t, params.IsUnprotectedTxAllowed(other))
+}
+
+func TestParamsIsIBCCallbackContractAllowed(t *testing.T) {
+	allowed := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000000")
+
+	params := DefaultParams()
+	require.True(t, params.IsIBCCallbackContractAllowed(other))
+
+	params.IBCCallbackContractsAllowlist = []string{allowed.Hex()}
+	require.True(t, params.IsIBCCallbackContractAllowed(allowed))
+	require.False(t, params.IsIBCCallbackContractAllowed(other))
+}
+
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []int64{2929, 1884, 1344}
 	params := NewParams(false, extraEips, nil, nil, DefaultAccessControl)
@@ -83,6 +186,15 @@ func TestParamsEIPs(t *testing.T) {
 	require.Equal(t, []int{2929, 1884, 1344}, actual)
 }
 
+func TestParamsEIPsAt(t *testing.T) {
+	params := NewParams(false, []int64{2929}, nil, nil, DefaultAccessControl)
+	params.ScheduledEIPs = []ScheduledEIP{{EIP: 1884, Height: 10}, {EIP: 1344, Height: 20}}
+
+	require.Equal(t, []int{2929}, params.EIPsAt(9))
+	require.Equal(t, []int{2929, 1884}, params.EIPsAt(10))
+	require.Equal(t, []int{2929, 1884, 1344}, params.EIPsAt(20))
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateBool(""))
 	require.NoError(t, validateBool(true))
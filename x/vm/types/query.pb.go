@@ -1009,6 +1009,71 @@ func (m *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
+// QueryAccessListResponse defines AccessList response
+type QueryAccessListResponse struct {
+	// access_list is the list of the addresses and storage keys accessed by the
+	// call
+	AccessList AccessList `protobuf:"bytes,1,rep,name=access_list,json=accessList,proto3,castrepeated=AccessList" json:"accessList"`
+	// gas_used is the gas used by the call with the access list
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by vm execution
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *QueryAccessListResponse) Reset()         { *m = QueryAccessListResponse{} }
+func (m *QueryAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessListResponse) ProtoMessage()    {}
+func (*QueryAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+}
+func (m *QueryAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessListResponse.Merge(m, src)
+}
+func (m *QueryAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccessListResponse proto.InternalMessageInfo
+
+func (m *QueryAccessListResponse) GetAccessList() AccessList {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *QueryAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QueryAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -1036,7 +1101,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1203,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1263,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1358,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,23 +1394,40 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
-// QueryBaseFeeRequest defines the request type for querying the EIP1559 base
-// fee.
-type QueryBaseFeeRequest struct {
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// block_number of the block the call is executed on top of
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the block the call is executed on top of
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the block the call is executed on top of
+	BlockTime time.Time `protobuf:"bytes,6,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,7,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the block
+	BlockMaxGas int64 `protobuf:"varint,9,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
 }
 
-func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
-func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseFeeRequest) ProtoMessage()    {}
-func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
 }
-func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1355,36 +1437,99 @@ func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
 }
-func (m *QueryBaseFeeRequest) XXX_Size() int {
+func (m *QueryTraceCallRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
 
-// QueryBaseFeeResponse returns the EIP1559 base fee.
-type QueryBaseFeeResponse struct {
-	// base_fee is the EIP1559 base fee
-	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
 }
 
-func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
-func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseFeeResponse) ProtoMessage()    {}
-func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
 }
-func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceCallRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockMaxGas() int64 {
+	if m != nil {
+		return m.BlockMaxGas
+	}
+	return 0
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1394,35 +1539,63 @@ func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
 }
-func (m *QueryBaseFeeResponse) XXX_Size() int {
+func (m *QueryTraceCallResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
 
-// QueryGlobalMinGasPriceRequest defines the request type for querying the
-// GlobalMinGasPrice
-type QueryGlobalMinGasPriceRequest struct {
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGasPriceRequest{} }
-func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
-func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+// QueryStorageRangeRequest defines StorageRange request
+type QueryStorageRangeRequest struct {
+	// address is the ethereum hex address of the contract to query the storage
+	// range for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// key_start is the hex storage key the iteration starts from
+	KeyStart string `protobuf:"bytes,2,opt,name=key_start,json=keyStart,proto3" json:"key_start,omitempty"`
+	// max_result is the maximum number of storage entries returned
+	MaxResult uint64 `protobuf:"varint,3,opt,name=max_result,json=maxResult,proto3" json:"max_result,omitempty"`
+	// predecessors is an array of transactions included in the same block
+	// need to be replayed first to get the storage at the requested tx index.
+	Predecessors []*MsgEthereumTx `protobuf:"bytes,4,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
+	// block_number of the requested block
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the requested block
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the requested block
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the requested block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the requested block
+	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
 }
-func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryStorageRangeRequest) Reset()         { *m = QueryStorageRangeRequest{} }
+func (m *QueryStorageRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRangeRequest) ProtoMessage()    {}
+func (*QueryStorageRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+}
+func (m *QueryStorageRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGlobalMinGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorageRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGlobalMinGasPriceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorageRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1432,36 +1605,39 @@ func (m *QueryGlobalMinGasPriceRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryGlobalMinGasPriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGlobalMinGasPriceRequest.Merge(m, src)
+func (m *QueryStorageRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRangeRequest.Merge(m, src)
 }
-func (m *QueryGlobalMinGasPriceRequest) XXX_Size() int {
+func (m *QueryStorageRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGlobalMinGasPriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGlobalMinGasPriceRequest.DiscardUnknown(m)
+func (m *QueryStorageRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGlobalMinGasPriceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStorageRangeRequest proto.InternalMessageInfo
 
-// QueryGlobalMinGasPriceResponse returns the GlobalMinGasPrice
-type QueryGlobalMinGasPriceResponse struct {
-	// min_gas_price is the feemarket's min_gas_price
-	MinGasPrice cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.Int" json:"min_gas_price"`
+// QueryStorageRangeResponse defines StorageRange response
+type QueryStorageRangeResponse struct {
+	// storage is the list of the storage entries, ordered by key
+	Storage Storage `protobuf:"bytes,1,rep,name=storage,proto3,castrepeated=Storage" json:"storage"`
+	// next_key is the hex key of the next storage entry, empty if the storage
+	// has no entries left
+	NextKey string `protobuf:"bytes,2,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
 }
 
-func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGasPriceResponse{} }
-func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
-func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+func (m *QueryStorageRangeResponse) Reset()         { *m = QueryStorageRangeResponse{} }
+func (m *QueryStorageRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRangeResponse) ProtoMessage()    {}
+func (*QueryStorageRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
 }
-func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorageRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGlobalMinGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorageRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGlobalMinGasPriceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorageRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1471,173 +1647,466 @@ func (m *QueryGlobalMinGasPriceResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryGlobalMinGasPriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGlobalMinGasPriceResponse.Merge(m, src)
+func (m *QueryStorageRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRangeResponse.Merge(m, src)
 }
-func (m *QueryGlobalMinGasPriceResponse) XXX_Size() int {
+func (m *QueryStorageRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGlobalMinGasPriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGlobalMinGasPriceResponse.DiscardUnknown(m)
+func (m *QueryStorageRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGlobalMinGasPriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStorageRangeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
-	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
-	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.evm.vm.v1.QueryAccountRequest")
-	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.evm.vm.v1.QueryAccountResponse")
-	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountRequest")
-	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountResponse")
-	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountRequest")
-	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountResponse")
-	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.evm.vm.v1.QueryBalanceRequest")
-	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.evm.vm.v1.QueryBalanceResponse")
-	proto.RegisterType((*QueryStorageRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRequest")
-	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
-	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
-	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
-	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
-	proto.RegisterType((*QueryTxLogsResponse)(nil), "cosmos.evm.vm.v1.QueryTxLogsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.vm.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.vm.v1.QueryParamsResponse")
-	proto.RegisterType((*EthCallRequest)(nil), "cosmos.evm.vm.v1.EthCallRequest")
-	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.evm.vm.v1.EstimateGasResponse")
-	proto.RegisterType((*QueryTraceTxRequest)(nil), "cosmos.evm.vm.v1.QueryTraceTxRequest")
-	proto.RegisterType((*QueryTraceTxResponse)(nil), "cosmos.evm.vm.v1.QueryTraceTxResponse")
-	proto.RegisterType((*QueryTraceBlockRequest)(nil), "cosmos.evm.vm.v1.QueryTraceBlockRequest")
-	proto.RegisterType((*QueryTraceBlockResponse)(nil), "cosmos.evm.vm.v1.QueryTraceBlockResponse")
-	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.evm.vm.v1.QueryBaseFeeRequest")
-	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
-	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
-	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse")
+func (m *QueryStorageRangeResponse) GetStorage() Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
-
-var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1624 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0xf8, 0xe2, 0xec, 0x37, 0xb1, 0xc3, 0x42,
-	0x7e, 0x12, 0x76, 0x49, 0x4a, 0x2b, 0x95, 0x1e, 0xda, 0x24, 0x0a, 0x81, 0x02, 0x15, 0xdd, 0x46,
-	0x3d, 0x54, 0xaa, 0xac, 0xf1, 0x7a, 0x58, 0xaf, 0xe2, 0xdd, 0x31, 0x3b, 0xeb, 0xd4, 0x81, 0xd2,
-	0x43, 0xa5, 0x22, 0x28, 0x17, 0xa4, 0xde, 0x5b, 0x8e, 0xbd, 0xb5, 0x37, 0xfe, 0x05, 0x8e, 0x48,
-	0xbd, 0x54, 0x3d, 0xd0, 0x0a, 0x2a, 0xb5, 0x7f, 0x43, 0x4f, 0xd5, 0xfc, 0x58, 0xdb, 0xeb, 0xf5,
-	0xda, 0xa1, 0xa2, 0xb7, 0x4a, 0x56, 0x32, 0x3f, 0xde, 0xbc, 0xf7, 0x79, 0x6f, 0xde, 0xbc, 0xf7,
-	0xb1, 0xc1, 0x8c, 0x45, 0xa8, 0x4b, 0xa8, 0x81, 0xf7, 0x5d, 0x83, 0x7d, 0xd6, 0x8c, 0x5b, 0x0d,
-	0xec, 0x1f, 0xe8, 0x75, 0x9f, 0x04, 0x04, 0x4e, 0x8a, 0x5d, 0x1d, 0xef, 0xbb, 0x3a, 0xfb, 0xac,
-	0xa9, 0xc7, 0x90, 0xeb, 0x78, 0xc4, 0xe0, 0x7f, 0x85, 0x90, 0xba, 0x22, 0x55, 0x94, 0x11, 0xc5,
-	0xe2, 0xb4, 0xb1, 0xbf, 0x56, 0xc6, 0x01, 0x5a, 0x33, 0xea, 0xc8, 0x76, 0x3c, 0x14, 0x38, 0xc4,
-	0x93, 0xb2, 0x6a, 0xcc, 0x1c, 0x53, 0x2d, 0xf6, 0xa6, 0x63, 0x7b, 0x41, 0x53, 0x6e, 0x4d, 0xd9,
-	0xc4, 0x26, 0x7c, 0x68, 0xb0, 0x91, 0x5c, 0x9d, 0xb1, 0x09, 0xb1, 0x6b, 0xd8, 0x40, 0x75, 0xc7,
-	0x40, 0x9e, 0x47, 0x02, 0x6e, 0x89, 0xca, 0xdd, 0xa2, 0xdc, 0xe5, 0xb3, 0x72, 0xe3, 0xa6, 0x11,
-	0x38, 0x2e, 0xa6, 0x01, 0x72, 0xeb, 0x42, 0x40, 0x9b, 0x02, 0xf0, 0x43, 0x86, 0x76, 0x8b, 0x78,
-	0x37, 0x1d, 0xdb, 0xc4, 0xb7, 0x1a, 0x98, 0x06, 0xda, 0x35, 0x70, 0x3c, 0xb2, 0x4a, 0xeb, 0xc4,
-	0xa3, 0x18, 0xbe, 0x09, 0x46, 0x2d, 0xbe, 0x92, 0x57, 0xe6, 0x94, 0xa5, 0xdc, 0xfa, 0xac, 0xde,
-	0x1d, 0x1a, 0x7d, 0xab, 0x8a, 0x1c, 0x4f, 0x1e, 0x93, 0xc2, 0xda, 0xdb, 0x52, 0xdb, 0x86, 0x65,
-	0x91, 0x86, 0x17, 0x48, 0x23, 0x30, 0x0f, 0x32, 0xa8, 0x52, 0xf1, 0x31, 0xa5, 0x5c, 0xdd, 0x98,
-	0x19, 0x4e, 0x2f, 0x66, 0xef, 0x3f, 0x2e, 0x0e, 0xfd, 0xf9, 0xb8, 0x38, 0xa4, 0x59, 0x60, 0x2a,
-	0x7a, 0x54, 0x22, 0xc9, 0x83, 0x4c, 0x19, 0xd5, 0x90, 0x67, 0xe1, 0xf0, 0xac, 0x9c, 0xc2, 0xff,
-	0x83, 0x31, 0x8b, 0x54, 0x70, 0xa9, 0x8a, 0x68, 0x35, 0x3f, 0xcc, 0xf7, 0xb2, 0x6c, 0xe1, 0x32,
-	0xa2, 0x55, 0x38, 0x05, 0x46, 0x3c, 0xc2, 0x0e, 0xa5, 0xe6, 0x94, 0xa5, 0xb4, 0x29, 0x26, 0xda,
-	0xbb, 0x60, 0x5a, 0x7a, 0xcb, 0x9c, 0xf9, 0x07, 0x28, 0xef, 0x29, 0x40, 0xed, 0xa5, 0x41, 0x82,
-	0x9d, 0x07, 0x47, 0x44, 0x9c, 0x4a, 0x51, 0x4d, 0x13, 0x62, 0x75, 0x43, 0x2c, 0x42, 0x15, 0x64,
-	0x29, 0x33, 0xca, 0xf0, 0x0d, 0x73, 0x7c, 0xad, 0x39, 0x53, 0x81, 0x84, 0xd6, 0x92, 0xd7, 0x70,
-	0xcb, 0xd8, 0x97, 0x1e, 0x4c, 0xc8, 0xd5, 0x0f, 0xf8, 0xa2, 0x76, 0x15, 0xcc, 0x70, 0x1c, 0x1f,
-	0xa3, 0x9a, 0x53, 0x41, 0x01, 0xf1, 0xbb, 0x9c, 0x39, 0x05, 0xc6, 0x2d, 0xe2, 0x75, 0xe3, 0xc8,
-	0xb1, 0xb5, 0x8d, 0x98, 0x57, 0x0f, 0x15, 0x30, 0x9b, 0xa0, 0x4d, 0x3a, 0xb6, 0x08, 0x8e, 0x86,
-	0xa8, 0xa2, 0x1a, 0x43, 0xb0, 0xaf, 0xd1, 0xb5, 0x30, 0x89, 0x36, 0xc5, 0x3d, 0xbf, 0xca, 0xf5,
-	0x9c, 0x97, 0x49, 0xd4, 0x3a, 0x3a, 0x28, 0x89, 0xb4, 0xab, 0xd2, 0xd8, 0x47, 0x01, 0xf1, 0x91,
-	0x3d, 0xd8, 0x18, 0x9c, 0x04, 0xa9, 0x3d, 0x7c, 0x20, 0xf3, 0x8d, 0x0d, 0x3b, 0xcc, 0xaf, 0x4a,
-	0xf3, 0x2d, 0x65, 0xd2, 0xfc, 0x14, 0x18, 0xd9, 0x47, 0xb5, 0x46, 0x68, 0x5c, 0x4c, 0xb4, 0xb7,
-	0xc0, 0xa4, 0x4c, 0xa5, 0xca, 0x2b, 0x39, 0xb9, 0x08, 0x8e, 0x75, 0x9c, 0x93, 0x26, 0x20, 0x48,
-	0xb3, 0xdc, 0xe7, 0xa7, 0xc6, 0x4d, 0x3e, 0xd6, 0x6e, 0xcb, 0x17, 0xbf, 0xdb, 0xbc, 0x46, 0x6c,
-	0x1a, 0x9a, 0x80, 0x20, 0xcd, 0x5f, 0x8c, 0xd0, 0xcf, 0xc7, 0xf0, 0x12, 0x00, 0xed, 0xda, 0xc5,
-	0x7d, 0xcb, 0xad, 0x2f, 0x84, 0x4f, 0x9e, 0x15, 0x3a, 0x5d, 0x94, 0x49, 0x59, 0xe8, 0xf4, 0x1b,
-	0xed, 0x50, 0x99, 0x1d, 0x27, 0x3b, 0x40, 0x3e, 0x50, 0x64, 0x60, 0x43, 0xe3, 0x12, 0xe7, 0x32,
-	0x48, 0xd7, 0x88, 0xcd, 0xbc, 0x4b, 0x2d, 0xe5, 0xd6, 0x4f, 0xc4, 0xcb, 0xca, 0x35, 0x62, 0x9b,
-	0x5c, 0x04, 0xee, 0xf4, 0x00, 0xb5, 0x38, 0x10, 0x94, 0xb0, 0xd3, 0x89, 0xaa, 0x55, 0xf9, 0x6e,
-	0x20, 0x1f, 0xb9, 0x61, 0x1c, 0x34, 0x53, 0x02, 0x0c, 0x57, 0x25, 0xc0, 0x77, 0xc0, 0x68, 0x9d,
-	0xaf, 0xc8, 0xca, 0x97, 0x8f, 0x43, 0x14, 0x27, 0x36, 0xc7, 0x9e, 0x3e, 0x2f, 0x0e, 0x7d, 0xff,
-	0xc7, 0x8f, 0x2b, 0x8a, 0x29, 0x8f, 0x68, 0x4f, 0x14, 0x70, 0x64, 0x3b, 0xa8, 0x6e, 0xa1, 0x5a,
-	0xad, 0x23, 0xdc, 0xc8, 0xb7, 0x69, 0x78, 0x31, 0x6c, 0x0c, 0x4f, 0x82, 0x8c, 0x8d, 0x68, 0xc9,
-	0x42, 0x75, 0xf9, 0x46, 0x46, 0x6d, 0x44, 0xb7, 0x50, 0x1d, 0x7e, 0x0a, 0x26, 0xeb, 0x3e, 0xa9,
-	0x13, 0x8a, 0xfd, 0xd6, 0x3b, 0x63, 0x6f, 0x64, 0x7c, 0x73, 0xfd, 0xaf, 0xe7, 0x45, 0xdd, 0x76,
-	0x82, 0x6a, 0xa3, 0xac, 0x5b, 0xc4, 0x35, 0x64, 0xf3, 0x10, 0xff, 0xce, 0xd1, 0xca, 0x9e, 0x11,
-	0x1c, 0xd4, 0x31, 0xd5, 0xb7, 0xda, 0x0f, 0xdc, 0x3c, 0x1a, 0xea, 0x0a, 0x1f, 0xe7, 0x34, 0xc8,
-	0x5a, 0xac, 0x6a, 0x97, 0x9c, 0x4a, 0x3e, 0x3d, 0xa7, 0x2c, 0xa5, 0xcc, 0x0c, 0x9f, 0x5f, 0xa9,
-	0x68, 0xbb, 0xe0, 0xf8, 0x36, 0x0d, 0x1c, 0x17, 0x05, 0x78, 0x07, 0xb5, 0xa3, 0x31, 0x09, 0x52,
-	0x36, 0x12, 0xe0, 0xd3, 0x26, 0x1b, 0xb2, 0x15, 0x1f, 0x07, 0x1c, 0xf7, 0xb8, 0xc9, 0x86, 0x4c,
-	0xeb, 0xbe, 0x5b, 0xc2, 0xbe, 0x4f, 0xc4, 0x83, 0x1e, 0x33, 0x33, 0xfb, 0xee, 0x36, 0x9b, 0x6a,
-	0x0f, 0xd2, 0x61, 0x16, 0xf8, 0xc8, 0xc2, 0xbb, 0xcd, 0x30, 0x28, 0x6b, 0x20, 0xe5, 0xd2, 0xb0,
-	0xb7, 0x14, 0xe3, 0x11, 0xbe, 0x4e, 0xed, 0xed, 0xa0, 0x8a, 0x7d, 0xdc, 0x70, 0x77, 0x9b, 0x26,
-	0x93, 0x85, 0xef, 0x81, 0xf1, 0x80, 0x29, 0x29, 0xc9, 0xbe, 0x94, 0x4a, 0xea, 0x4b, 0xdc, 0x94,
-	0xec, 0x4b, 0xb9, 0xa0, 0x3d, 0x81, 0x5b, 0x60, 0xbc, 0xee, 0xe3, 0x0a, 0xb6, 0x30, 0xa5, 0xc4,
-	0xa7, 0xf9, 0x34, 0x4f, 0xc1, 0x81, 0xd6, 0x23, 0x87, 0x58, 0x5d, 0x2d, 0xd7, 0x88, 0xb5, 0x17,
-	0x56, 0xb0, 0x11, 0x1e, 0xc6, 0x1c, 0x5f, 0x13, 0xf5, 0x0b, 0xce, 0x02, 0x20, 0x44, 0xf8, 0x33,
-	0x1b, 0xe5, 0x11, 0x19, 0xe3, 0x2b, 0xbc, 0x33, 0x5d, 0x0e, 0xb7, 0x59, 0x83, 0xce, 0x67, 0xb8,
-	0x1b, 0xaa, 0x2e, 0xba, 0xb7, 0x1e, 0x76, 0x6f, 0x7d, 0x37, 0xec, 0xde, 0x9b, 0x13, 0x2c, 0xcd,
-	0x1e, 0xfd, 0x5a, 0x54, 0x44, 0xaa, 0x09, 0x4d, 0x6c, 0xbb, 0x67, 0xb6, 0x64, 0xff, 0x9d, 0x6c,
-	0x19, 0x8b, 0x64, 0x0b, 0xd4, 0xc0, 0x84, 0xf0, 0xc1, 0x45, 0xcd, 0x12, 0x4b, 0x10, 0xd0, 0x11,
-	0x86, 0xeb, 0xa8, 0xb9, 0x83, 0xe8, 0xfb, 0xe9, 0xec, 0xf0, 0x64, 0xca, 0xcc, 0x06, 0xcd, 0x92,
-	0xe3, 0x55, 0x70, 0x53, 0x5b, 0x91, 0xc5, 0xb1, 0x95, 0x0a, 0xed, 0xca, 0x55, 0x41, 0x01, 0x0a,
-	0x1f, 0x08, 0x1b, 0x6b, 0x4f, 0x52, 0xe0, 0x7f, 0x6d, 0xe1, 0x4d, 0xa6, 0xb5, 0x23, 0x75, 0x82,
-	0x66, 0x58, 0x3f, 0x06, 0xa7, 0x4e, 0xd0, 0xa4, 0xaf, 0x21, 0x75, 0xfe, 0xbb, 0xf5, 0x43, 0xde,
-	0xba, 0x76, 0x0e, 0x9c, 0x8c, 0x5d, 0x5c, 0x9f, 0x8b, 0x3e, 0xd1, 0xea, 0xf5, 0x14, 0x5f, 0xc2,
-	0xb8, 0xcd, 0x4a, 0xa7, 0xa2, 0xcb, 0x52, 0xc5, 0x05, 0x90, 0x65, 0x85, 0xbf, 0x74, 0x13, 0xcb,
-	0x5e, 0xba, 0x39, 0xfd, 0xcb, 0xf3, 0xe2, 0x09, 0xe1, 0x21, 0xad, 0xec, 0xe9, 0x0e, 0x31, 0x5c,
-	0x14, 0x54, 0xf5, 0x2b, 0x5e, 0xc0, 0x7a, 0x3c, 0x3f, 0xad, 0x15, 0x25, 0xbb, 0xd9, 0xa9, 0x91,
-	0x32, 0xaa, 0x5d, 0x77, 0xbc, 0x1d, 0x44, 0x6f, 0xf8, 0x4e, 0x8b, 0x5a, 0x68, 0x16, 0x28, 0x24,
-	0x09, 0x48, 0xc3, 0x1b, 0x60, 0xc2, 0x75, 0x3c, 0xe6, 0x74, 0xa9, 0xce, 0x36, 0xa4, 0xf5, 0x59,
-	0x76, 0x4b, 0xc9, 0x08, 0x72, 0x6e, 0x5b, 0xd5, 0xfa, 0xd7, 0x47, 0xc1, 0x08, 0xb7, 0x02, 0xbf,
-	0x52, 0x40, 0x46, 0x12, 0x2c, 0x38, 0x1f, 0xcf, 0xc2, 0x1e, 0x0c, 0x5a, 0x5d, 0x18, 0x24, 0x26,
-	0x70, 0x6a, 0x67, 0xbf, 0xfc, 0xe9, 0xf7, 0x6f, 0x86, 0xe7, 0xe1, 0x69, 0x23, 0xf6, 0xed, 0x42,
-	0x92, 0x2c, 0xe3, 0x8e, 0x4c, 0x9a, 0xbb, 0xf0, 0x5b, 0x05, 0x4c, 0x44, 0x78, 0x2c, 0x3c, 0x9b,
-	0x60, 0xa6, 0x17, 0x5f, 0x56, 0x57, 0x0f, 0x27, 0x2c, 0x91, 0xad, 0x73, 0x64, 0xab, 0x70, 0x25,
-	0x8e, 0x2c, 0xa4, 0xcc, 0x31, 0x80, 0x3f, 0x28, 0x60, 0xb2, 0x9b, 0x92, 0x42, 0x3d, 0xc1, 0x6c,
-	0x02, 0x13, 0x56, 0x8d, 0x43, 0xcb, 0x4b, 0xa4, 0x17, 0x39, 0xd2, 0x0b, 0x70, 0x3d, 0x8e, 0x74,
-	0x3f, 0x3c, 0xd3, 0x06, 0xdb, 0xc9, 0xb2, 0xef, 0xc2, 0x7b, 0x0a, 0xc8, 0x48, 0xf2, 0x99, 0x78,
-	0xb5, 0x51, 0x5e, 0x9b, 0x78, 0xb5, 0x5d, 0x1c, 0x56, 0x5b, 0xe5, 0xb0, 0x16, 0xe0, 0x99, 0x38,
-	0x2c, 0x49, 0x66, 0x69, 0x47, 0xe8, 0x1e, 0x2a, 0x20, 0x23, 0x69, 0x68, 0x22, 0x90, 0x28, 0xe7,
-	0x4d, 0x04, 0xd2, 0xc5, 0x66, 0xb5, 0x35, 0x0e, 0xe4, 0x2c, 0x5c, 0x8e, 0x03, 0xa1, 0x42, 0xb4,
-	0x8d, 0xc3, 0xb8, 0xb3, 0x87, 0x0f, 0xee, 0xc2, 0xdb, 0x20, 0xcd, 0xd8, 0x2a, 0xd4, 0x12, 0x53,
-	0xa6, 0x45, 0x81, 0xd5, 0xd3, 0x7d, 0x65, 0x24, 0x86, 0x65, 0x8e, 0xe1, 0x34, 0x3c, 0xd5, 0x2b,
-	0x9b, 0x2a, 0x91, 0x48, 0x7c, 0x06, 0x46, 0x05, 0x61, 0x83, 0x67, 0x12, 0x34, 0x47, 0x78, 0xa1,
-	0x3a, 0x3f, 0x40, 0x4a, 0x22, 0x98, 0xe3, 0x08, 0x54, 0x98, 0x8f, 0x23, 0x10, 0x64, 0x10, 0x36,
-	0x41, 0x46, 0x72, 0x41, 0x38, 0x17, 0xd7, 0x19, 0xa5, 0x89, 0xea, 0xe2, 0xa0, 0x4e, 0x16, 0xda,
-	0xd5, 0xb8, 0xdd, 0x19, 0xa8, 0xc6, 0xed, 0xe2, 0xa0, 0x5a, 0xb2, 0x98, 0xb9, 0x2f, 0x40, 0xae,
-	0x83, 0xcc, 0x1d, 0xc2, 0x7a, 0x0f, 0x9f, 0x7b, 0xb0, 0x41, 0x6d, 0x81, 0xdb, 0x9e, 0x83, 0x85,
-	0x1e, 0xb6, 0xa5, 0x38, 0x2b, 0x91, 0xf0, 0x73, 0x90, 0x91, 0x5d, 0x3e, 0x31, 0xf7, 0xa2, 0x84,
-	0x30, 0x31, 0xf7, 0xba, 0xc8, 0x42, 0x3f, 0xef, 0x45, 0x8b, 0x0f, 0x9a, 0xf0, 0xbe, 0x02, 0x40,
-	0xbb, 0xfd, 0xc0, 0xa5, 0x7e, 0xaa, 0x3b, 0xa9, 0x85, 0xba, 0x7c, 0x08, 0x49, 0x89, 0x63, 0x9e,
-	0xe3, 0x28, 0xc2, 0xd9, 0x24, 0x1c, 0xbc, 0x27, 0xb2, 0x40, 0xc8, 0x16, 0xd6, 0xa7, 0x1a, 0x74,
-	0x76, 0xbe, 0x3e, 0xd5, 0x20, 0xd2, 0x09, 0xfb, 0x05, 0x22, 0xec, 0x90, 0x2c, 0xf3, 0x25, 0x7f,
-	0x39, 0x93, 0xf8, 0xa6, 0x3a, 0x7e, 0x0b, 0x4a, 0xcc, 0xfc, 0xe8, 0x6f, 0x43, 0xfd, 0x32, 0x5f,
-	0x10, 0x2c, 0xf8, 0x9d, 0x02, 0x8e, 0xc5, 0x7a, 0x29, 0x4c, 0x2a, 0xc4, 0x49, 0x6d, 0x59, 0x3d,
-	0x7f, 0xf8, 0x03, 0x12, 0xda, 0x22, 0x87, 0x76, 0x0a, 0x16, 0xe3, 0xd0, 0x22, 0xed, 0x7b, 0xf3,
-	0xe2, 0xd3, 0x17, 0x05, 0xe5, 0xd9, 0x8b, 0x82, 0xf2, 0xdb, 0x8b, 0x82, 0xf2, 0xe8, 0x65, 0x61,
-	0xe8, 0xd9, 0xcb, 0xc2, 0xd0, 0xcf, 0x2f, 0x0b, 0x43, 0x9f, 0xcc, 0xc5, 0x09, 0x14, 0x53, 0xd2,
-	0x64, 0x6a, 0x38, 0x7d, 0x2a, 0x8f, 0x72, 0xba, 0xf6, 0xc6, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0x32, 0x62, 0xca, 0x4c, 0x14, 0x00, 0x00,
+func (m *QueryStorageRangeResponse) GetNextKey() string {
+	if m != nil {
+		return m.NextKey
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryStorageSlotsRequest is the request type for the Query/StorageSlots RPC
+// method.
+type QueryStorageSlotsRequest struct {
+	// slots is the list of the storage slots to read
+	Slots []*QueryStorageRequest `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Account queries an Ethereum account.
-	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
-	// CosmosAccount queries an Ethereum account's Cosmos Address.
-	CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error)
+func (m *QueryStorageSlotsRequest) Reset()         { *m = QueryStorageSlotsRequest{} }
+func (m *QueryStorageSlotsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageSlotsRequest) ProtoMessage()    {}
+func (*QueryStorageSlotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
+}
+func (m *QueryStorageSlotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageSlotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageSlotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageSlotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageSlotsRequest.Merge(m, src)
+}
+func (m *QueryStorageSlotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageSlotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageSlotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageSlotsRequest proto.InternalMessageInfo
+
+func (m *QueryStorageSlotsRequest) GetSlots() []*QueryStorageRequest {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+// QueryStorageSlotsResponse is the response type for the Query/StorageSlots RPC
+// method.
+type QueryStorageSlotsResponse struct {
+	// values are the storage state value hashes, in the order of the requested
+	// slots
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *QueryStorageSlotsResponse) Reset()         { *m = QueryStorageSlotsResponse{} }
+func (m *QueryStorageSlotsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageSlotsResponse) ProtoMessage()    {}
+func (*QueryStorageSlotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{30}
+}
+func (m *QueryStorageSlotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageSlotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageSlotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageSlotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageSlotsResponse.Merge(m, src)
+}
+func (m *QueryStorageSlotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageSlotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageSlotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageSlotsResponse proto.InternalMessageInfo
+
+func (m *QueryStorageSlotsResponse) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// QueryBaseFeeRequest defines the request type for querying the EIP1559 base
+// fee.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{31}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse returns the EIP1559 base fee.
+type QueryBaseFeeResponse struct {
+	// base_fee is the EIP1559 base fee
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{32}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+// QueryGlobalMinGasPriceRequest defines the request type for querying the
+// GlobalMinGasPrice
+type QueryGlobalMinGasPriceRequest struct {
+}
+
+func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGasPriceRequest{} }
+func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
+func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{33}
+}
+func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGlobalMinGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGlobalMinGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGlobalMinGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGlobalMinGasPriceRequest.Merge(m, src)
+}
+func (m *QueryGlobalMinGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGlobalMinGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGlobalMinGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGlobalMinGasPriceRequest proto.InternalMessageInfo
+
+// QueryGlobalMinGasPriceResponse returns the GlobalMinGasPrice
+type QueryGlobalMinGasPriceResponse struct {
+	// min_gas_price is the feemarket's min_gas_price
+	MinGasPrice cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.Int" json:"min_gas_price"`
+}
+
+func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGasPriceResponse{} }
+func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
+func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{34}
+}
+func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGlobalMinGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGlobalMinGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGlobalMinGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGlobalMinGasPriceResponse.Merge(m, src)
+}
+func (m *QueryGlobalMinGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGlobalMinGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGlobalMinGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGlobalMinGasPriceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
+	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.evm.vm.v1.QueryAccountRequest")
+	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.evm.vm.v1.QueryAccountResponse")
+	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountRequest")
+	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountResponse")
+	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountRequest")
+	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountResponse")
+	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.evm.vm.v1.QueryBalanceRequest")
+	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.evm.vm.v1.QueryBalanceResponse")
+	proto.RegisterType((*QueryStorageRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRequest")
+	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
+	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
+	proto.RegisterType((*QueryTxLogsResponse)(nil), "cosmos.evm.vm.v1.QueryTxLogsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.vm.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.vm.v1.QueryParamsResponse")
+	proto.RegisterType((*EthCallRequest)(nil), "cosmos.evm.vm.v1.EthCallRequest")
+	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.evm.vm.v1.EstimateGasResponse")
+	proto.RegisterType((*QueryAccessListResponse)(nil), "cosmos.evm.vm.v1.QueryAccessListResponse")
+	proto.RegisterType((*QueryTraceTxRequest)(nil), "cosmos.evm.vm.v1.QueryTraceTxRequest")
+	proto.RegisterType((*QueryTraceTxResponse)(nil), "cosmos.evm.vm.v1.QueryTraceTxResponse")
+	proto.RegisterType((*QueryTraceBlockRequest)(nil), "cosmos.evm.vm.v1.QueryTraceBlockRequest")
+	proto.RegisterType((*QueryTraceBlockResponse)(nil), "cosmos.evm.vm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "cosmos.evm.vm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "cosmos.evm.vm.v1.QueryTraceCallResponse")
+	proto.RegisterType((*QueryStorageRangeRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRangeRequest")
+	proto.RegisterType((*QueryStorageRangeResponse)(nil), "cosmos.evm.vm.v1.QueryStorageRangeResponse")
+	proto.RegisterType((*QueryStorageSlotsRequest)(nil), "cosmos.evm.vm.v1.QueryStorageSlotsRequest")
+	proto.RegisterType((*QueryStorageSlotsResponse)(nil), "cosmos.evm.vm.v1.QueryStorageSlotsResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.evm.vm.v1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
+	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
+
+var fileDescriptor_0e8f08e175b3ef0c = []byte{
+	// 1997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x9a, 0x94, 0x48, 0x3e, 0x4a, 0x89, 0x3c, 0x96, 0x63, 0x9a, 0xb1, 0x48, 0x79, 0x6d,
+	0x59, 0xb2, 0xac, 0x90, 0x91, 0x92, 0x16, 0xa8, 0x73, 0x68, 0x4d, 0xc1, 0x51, 0x52, 0xdb, 0x85,
+	0x4b, 0xab, 0x2d, 0x50, 0xa0, 0x20, 0x86, 0xe4, 0x98, 0x5c, 0x88, 0xbb, 0xcb, 0xec, 0x0c, 0x55,
+	0x2a, 0xa9, 0xdb, 0xa2, 0x68, 0x83, 0x04, 0xb9, 0x18, 0xe8, 0xad, 0x87, 0x36, 0xc7, 0x22, 0x97,
+	0xe6, 0xd4, 0x7c, 0x81, 0x1e, 0x72, 0x0c, 0xd0, 0x4b, 0xd1, 0x83, 0x5d, 0xd8, 0x05, 0xfa, 0xe7,
+	0x23, 0xf4, 0x54, 0xcc, 0xcc, 0x5b, 0xee, 0xae, 0x96, 0x4b, 0x32, 0x81, 0x93, 0x53, 0x00, 0xc1,
+	0xde, 0x99, 0x79, 0x7f, 0x7e, 0xf3, 0xde, 0x9b, 0x37, 0xef, 0x0d, 0xe1, 0x42, 0xcb, 0xe5, 0xb6,
+	0xcb, 0xab, 0xec, 0xc8, 0xae, 0xca, 0xbf, 0x9d, 0xea, 0x5b, 0x03, 0xe6, 0x1d, 0x57, 0xfa, 0x9e,
+	0x2b, 0x5c, 0xb2, 0xac, 0x57, 0x2b, 0xec, 0xc8, 0xae, 0xc8, 0xbf, 0x9d, 0xe2, 0x69, 0x6a, 0x5b,
+	0x8e, 0x5b, 0x55, 0xff, 0x6a, 0xa2, 0xe2, 0x16, 0x8a, 0x68, 0x52, 0xce, 0x34, 0x77, 0xf5, 0x68,
+	0xa7, 0xc9, 0x04, 0xdd, 0xa9, 0xf6, 0x69, 0xc7, 0x72, 0xa8, 0xb0, 0x5c, 0x07, 0x69, 0x8b, 0x31,
+	0x75, 0x52, 0xb4, 0x5e, 0x3b, 0x1f, 0x5b, 0x13, 0x43, 0x5c, 0x5a, 0xe9, 0xb8, 0x1d, 0x57, 0x7d,
+	0x56, 0xe5, 0x17, 0xce, 0x5e, 0xe8, 0xb8, 0x6e, 0xa7, 0xc7, 0xaa, 0xb4, 0x6f, 0x55, 0xa9, 0xe3,
+	0xb8, 0x42, 0x69, 0xe2, 0xb8, 0x5a, 0xc6, 0x55, 0x35, 0x6a, 0x0e, 0xee, 0x57, 0x85, 0x65, 0x33,
+	0x2e, 0xa8, 0xdd, 0xd7, 0x04, 0xe6, 0x0a, 0x90, 0xef, 0x4b, 0xb4, 0x7b, 0xae, 0x73, 0xdf, 0xea,
+	0xd4, 0xd9, 0x5b, 0x03, 0xc6, 0x85, 0x79, 0x1b, 0xce, 0x44, 0x66, 0x79, 0xdf, 0x75, 0x38, 0x23,
+	0xdf, 0x80, 0x85, 0x96, 0x9a, 0x29, 0x18, 0x6b, 0xc6, 0x66, 0x7e, 0x77, 0xb5, 0x72, 0xd2, 0x34,
+	0x95, 0xbd, 0x2e, 0xb5, 0x1c, 0x64, 0x43, 0x62, 0xf3, 0x5b, 0x28, 0xed, 0x46, 0xab, 0xe5, 0x0e,
+	0x1c, 0x81, 0x4a, 0x48, 0x01, 0x32, 0xb4, 0xdd, 0xf6, 0x18, 0xe7, 0x4a, 0x5c, 0xae, 0xee, 0x0f,
+	0xaf, 0x67, 0xdf, 0xfb, 0xb0, 0x3c, 0xf7, 0xef, 0x0f, 0xcb, 0x73, 0x66, 0x0b, 0x56, 0xa2, 0xac,
+	0x88, 0xa4, 0x00, 0x99, 0x26, 0xed, 0x51, 0xa7, 0xc5, 0x7c, 0x5e, 0x1c, 0x92, 0x17, 0x21, 0xd7,
+	0x72, 0xdb, 0xac, 0xd1, 0xa5, 0xbc, 0x5b, 0x38, 0xa5, 0xd6, 0xb2, 0x72, 0xe2, 0x0d, 0xca, 0xbb,
+	0x64, 0x05, 0xe6, 0x1d, 0x57, 0x32, 0xa5, 0xd6, 0x8c, 0xcd, 0x74, 0x5d, 0x0f, 0xcc, 0x6f, 0xc3,
+	0x79, 0xdc, 0xad, 0xdc, 0xcc, 0x17, 0x40, 0xf9, 0xae, 0x01, 0xc5, 0x71, 0x12, 0x10, 0xec, 0x3a,
+	0x3c, 0xa7, 0xed, 0xd4, 0x88, 0x4a, 0x5a, 0xd2, 0xb3, 0x37, 0xf4, 0x24, 0x29, 0x42, 0x96, 0x4b,
+	0xa5, 0x12, 0xdf, 0x29, 0x85, 0x6f, 0x34, 0x96, 0x22, 0xa8, 0x96, 0xda, 0x70, 0x06, 0x76, 0x93,
+	0x79, 0xb8, 0x83, 0x25, 0x9c, 0xfd, 0x9e, 0x9a, 0x34, 0x6f, 0xc1, 0x05, 0x85, 0xe3, 0x87, 0xb4,
+	0x67, 0xb5, 0xa9, 0x70, 0xbd, 0x13, 0x9b, 0xb9, 0x08, 0x8b, 0x2d, 0xd7, 0x39, 0x89, 0x23, 0x2f,
+	0xe7, 0x6e, 0xc4, 0x76, 0xf5, 0x81, 0x01, 0xab, 0x09, 0xd2, 0x70, 0x63, 0x1b, 0xf0, 0xbc, 0x8f,
+	0x2a, 0x2a, 0xd1, 0x07, 0xfb, 0x0c, 0xb7, 0xe6, 0x07, 0x51, 0x4d, 0xfb, 0xf9, 0xf3, 0xb8, 0xe7,
+	0x65, 0x0c, 0xa2, 0x11, 0xeb, 0xb4, 0x20, 0x32, 0x6f, 0xa1, 0xb2, 0x7b, 0xc2, 0xf5, 0x68, 0x67,
+	0xba, 0x32, 0xb2, 0x0c, 0xa9, 0x43, 0x76, 0x8c, 0xf1, 0x26, 0x3f, 0x43, 0xea, 0xb7, 0x51, 0xfd,
+	0x48, 0x18, 0xaa, 0x5f, 0x81, 0xf9, 0x23, 0xda, 0x1b, 0xf8, 0xca, 0xf5, 0xc0, 0xfc, 0x26, 0x2c,
+	0x63, 0x28, 0xb5, 0x3f, 0xd7, 0x26, 0x37, 0xe0, 0x74, 0x88, 0x0f, 0x55, 0x10, 0x48, 0xcb, 0xd8,
+	0x57, 0x5c, 0x8b, 0x75, 0xf5, 0x6d, 0xbe, 0x8d, 0x27, 0xfe, 0x60, 0x78, 0xdb, 0xed, 0x70, 0x5f,
+	0x05, 0x81, 0xb4, 0x3a, 0x31, 0x5a, 0xbe, 0xfa, 0x26, 0xaf, 0x03, 0x04, 0xb9, 0x4b, 0xed, 0x2d,
+	0xbf, 0x7b, 0xc5, 0x3f, 0xf2, 0x32, 0xd1, 0x55, 0x74, 0x9a, 0xc4, 0x44, 0x57, 0xb9, 0x1b, 0x98,
+	0xaa, 0x1e, 0xe2, 0x0c, 0x81, 0x7c, 0xdf, 0x40, 0xc3, 0xfa, 0xca, 0x11, 0xe7, 0x55, 0x48, 0xf7,
+	0xdc, 0x8e, 0xdc, 0x5d, 0x6a, 0x33, 0xbf, 0x7b, 0x36, 0x9e, 0x56, 0x6e, 0xbb, 0x9d, 0xba, 0x22,
+	0x21, 0xfb, 0x63, 0x40, 0x6d, 0x4c, 0x05, 0xa5, 0xf5, 0x84, 0x51, 0x8d, 0x32, 0xdf, 0x5d, 0xea,
+	0x51, 0xdb, 0xb7, 0x83, 0x59, 0x47, 0x80, 0xfe, 0x2c, 0x02, 0x7c, 0x0d, 0x16, 0xfa, 0x6a, 0x06,
+	0x33, 0x5f, 0x21, 0x0e, 0x51, 0x73, 0xd4, 0x72, 0x9f, 0x3e, 0x2a, 0xcf, 0xfd, 0xf1, 0x5f, 0x1f,
+	0x6f, 0x19, 0x75, 0x64, 0x31, 0x3f, 0x31, 0xe0, 0xb9, 0x9b, 0xa2, 0xbb, 0x47, 0x7b, 0xbd, 0x90,
+	0xb9, 0xa9, 0xd7, 0xe1, 0xbe, 0x63, 0xe4, 0x37, 0x39, 0x07, 0x99, 0x0e, 0xe5, 0x8d, 0x16, 0xed,
+	0xe3, 0x19, 0x59, 0xe8, 0x50, 0xbe, 0x47, 0xfb, 0xe4, 0x27, 0xb0, 0xdc, 0xf7, 0xdc, 0xbe, 0xcb,
+	0x99, 0x37, 0x3a, 0x67, 0xf2, 0x8c, 0x2c, 0xd6, 0x76, 0xff, 0xf7, 0xa8, 0x5c, 0xe9, 0x58, 0xa2,
+	0x3b, 0x68, 0x56, 0x5a, 0xae, 0x5d, 0xc5, 0xcb, 0x43, 0xff, 0xf7, 0x12, 0x6f, 0x1f, 0x56, 0xc5,
+	0x71, 0x9f, 0xf1, 0xca, 0x5e, 0x70, 0xc0, 0xeb, 0xcf, 0xfb, 0xb2, 0xfc, 0xc3, 0x79, 0x1e, 0xb2,
+	0x2d, 0x99, 0xb5, 0x1b, 0x56, 0xbb, 0x90, 0x5e, 0x33, 0x36, 0x53, 0xf5, 0x8c, 0x1a, 0xbf, 0xd9,
+	0x36, 0x0f, 0xe0, 0xcc, 0x4d, 0x2e, 0x2c, 0x9b, 0x0a, 0xb6, 0x4f, 0x03, 0x6b, 0x2c, 0x43, 0xaa,
+	0x43, 0x35, 0xf8, 0x74, 0x5d, 0x7e, 0xca, 0x19, 0x8f, 0x09, 0x85, 0x7b, 0xb1, 0x2e, 0x3f, 0xa5,
+	0xd4, 0x23, 0xbb, 0xc1, 0x3c, 0xcf, 0xd5, 0x07, 0x3a, 0x57, 0xcf, 0x1c, 0xd9, 0x37, 0xe5, 0xd0,
+	0xfc, 0xb3, 0x01, 0xe7, 0xfc, 0xac, 0xce, 0x38, 0xbf, 0x6d, 0xf1, 0x20, 0xa5, 0x30, 0xc8, 0x53,
+	0x35, 0xdb, 0xe8, 0x59, 0x5c, 0x60, 0x40, 0x8c, 0xb9, 0x67, 0x34, 0xeb, 0xc1, 0xa0, 0xdf, 0x63,
+	0xb5, 0x75, 0x69, 0xf2, 0xff, 0x3e, 0x2a, 0x03, 0x1d, 0xc9, 0xfb, 0xe8, 0x71, 0x19, 0x02, 0xe9,
+	0xda, 0x1d, 0xa1, 0x65, 0x89, 0x4e, 0xda, 0x7a, 0xc0, 0x59, 0x1b, 0x8d, 0x2d, 0x6d, 0xff, 0x03,
+	0xce, 0xda, 0x93, 0x80, 0xbf, 0x9f, 0xf6, 0xc3, 0xd7, 0xa3, 0x2d, 0x76, 0x30, 0xf4, 0xbd, 0xb9,
+	0x03, 0x29, 0x9b, 0xfb, 0x97, 0x62, 0x39, 0x0e, 0xf6, 0x0e, 0xef, 0xdc, 0x14, 0x5d, 0xe6, 0xb1,
+	0x81, 0x7d, 0x30, 0xac, 0x4b, 0x5a, 0xf2, 0x1d, 0x58, 0x14, 0x52, 0x48, 0x03, 0x2f, 0xd4, 0x54,
+	0xd2, 0x85, 0xaa, 0x54, 0xe1, 0x85, 0x9a, 0x17, 0xc1, 0x80, 0xec, 0xc1, 0x62, 0xdf, 0x63, 0x6d,
+	0x26, 0xf7, 0xe4, 0x7a, 0xbc, 0x90, 0x56, 0xa6, 0x9a, 0xaa, 0x3d, 0xc2, 0x24, 0x2f, 0x84, 0x66,
+	0xcf, 0x6d, 0x1d, 0xfa, 0xa9, 0x77, 0x5e, 0xf9, 0x3f, 0xaf, 0xe6, 0x74, 0xe2, 0x25, 0xab, 0x00,
+	0x9a, 0x44, 0xe5, 0x87, 0x05, 0x65, 0x91, 0x9c, 0x9a, 0x51, 0x57, 0xea, 0x1b, 0xfe, 0xb2, 0xac,
+	0x2c, 0x0a, 0x19, 0xb5, 0x8d, 0x62, 0x45, 0x97, 0x1d, 0x15, 0xbf, 0xec, 0xa8, 0x1c, 0xf8, 0x65,
+	0x47, 0x6d, 0x49, 0x3a, 0xeb, 0xe1, 0xe3, 0xb2, 0xa1, 0x9d, 0xa2, 0x25, 0xc9, 0xe5, 0xb1, 0x61,
+	0x9e, 0xfd, 0x72, 0xc2, 0x3c, 0x17, 0x09, 0x73, 0x62, 0xc2, 0x92, 0xde, 0x83, 0x4d, 0x87, 0x0d,
+	0x19, 0xd9, 0x10, 0x32, 0xc3, 0x1d, 0x3a, 0xdc, 0xa7, 0xfc, 0xbb, 0xe9, 0xec, 0xa9, 0xe5, 0x54,
+	0x3d, 0x2b, 0x86, 0x0d, 0xcb, 0x69, 0xb3, 0xa1, 0xb9, 0x85, 0x59, 0x7d, 0x14, 0x0a, 0x41, 0xca,
+	0x6d, 0x53, 0x41, 0xfd, 0x93, 0x2d, 0xbf, 0xcd, 0x4f, 0x52, 0xf0, 0x42, 0x40, 0x5c, 0x93, 0x52,
+	0x43, 0xa1, 0x23, 0x86, 0x7e, 0xe2, 0x9b, 0x1e, 0x3a, 0x62, 0xc8, 0x9f, 0x41, 0xe8, 0x7c, 0xed,
+	0xf5, 0x19, 0xbd, 0x6e, 0xbe, 0x84, 0x99, 0x2a, 0xec, 0xb8, 0x09, 0x8e, 0xfe, 0x38, 0x05, 0x67,
+	0x03, 0xfa, 0x2f, 0x9c, 0xf0, 0x9f, 0xbd, 0x87, 0xd3, 0xd3, 0x3c, 0x3c, 0x3f, 0xd9, 0xc3, 0x0b,
+	0xcf, 0xd8, 0xc3, 0x99, 0x2f, 0xc7, 0xc3, 0xd9, 0x29, 0x1e, 0xce, 0xc5, 0x3d, 0xbc, 0x1d, 0x3e,
+	0x9a, 0xda, 0x63, 0x13, 0x1c, 0xfc, 0x9f, 0x14, 0x14, 0x22, 0xc5, 0x1c, 0x75, 0x66, 0x29, 0x0f,
+	0x5f, 0x84, 0xdc, 0x21, 0x3b, 0x6e, 0x70, 0x41, 0x3d, 0xe1, 0x37, 0x25, 0x87, 0xec, 0xf8, 0x9e,
+	0x1c, 0x4b, 0x47, 0x48, 0x7c, 0x1e, 0xe3, 0x83, 0x9e, 0xc0, 0xe2, 0x37, 0x67, 0x53, 0x99, 0x52,
+	0x06, 0x3d, 0xf1, 0x75, 0x9e, 0xff, 0x4a, 0x4f, 0x7c, 0xa8, 0x58, 0xfd, 0x05, 0xb6, 0x85, 0x51,
+	0x57, 0x63, 0x70, 0xec, 0x43, 0x86, 0xeb, 0x79, 0xcc, 0xdd, 0xe7, 0xe2, 0x0e, 0xb9, 0x27, 0xa8,
+	0x60, 0xb5, 0x15, 0x69, 0x88, 0x8f, 0x1e, 0x97, 0x33, 0x28, 0x47, 0xdb, 0xc3, 0xe7, 0x96, 0x70,
+	0x1d, 0x36, 0x14, 0x8d, 0xa0, 0x7d, 0xc8, 0xc8, 0xf1, 0x2d, 0x76, 0x6c, 0xfe, 0x28, 0x1a, 0x6b,
+	0xf7, 0x7a, 0xae, 0x18, 0xd5, 0xeb, 0xaf, 0xc1, 0x3c, 0x97, 0x63, 0xd4, 0xbe, 0x1e, 0xd7, 0x3e,
+	0xa6, 0x81, 0xa9, 0x6b, 0x1e, 0xf3, 0x95, 0xe8, 0xce, 0x50, 0x30, 0xee, 0xec, 0x05, 0x58, 0x50,
+	0x9d, 0x88, 0x16, 0x9d, 0xab, 0xe3, 0xc8, 0x3c, 0x3b, 0x6a, 0xc0, 0x38, 0x7b, 0x9d, 0xb1, 0xe0,
+	0xa9, 0x60, 0x25, 0x3a, 0x8d, 0x62, 0x5e, 0x85, 0xac, 0xac, 0xc6, 0x1b, 0xf7, 0x19, 0x36, 0x38,
+	0xb5, 0xf3, 0x7f, 0x7f, 0x54, 0x3e, 0xab, 0x61, 0xf2, 0xf6, 0x61, 0xc5, 0x72, 0xab, 0x36, 0x15,
+	0xdd, 0xca, 0x9b, 0x8e, 0x90, 0x8d, 0x97, 0xe2, 0x36, 0xcb, 0xd8, 0x72, 0xee, 0xf7, 0xdc, 0x26,
+	0xed, 0xdd, 0xb1, 0x9c, 0x7d, 0xca, 0xef, 0x7a, 0xd6, 0xa8, 0xdf, 0x33, 0x5b, 0x50, 0x4a, 0x22,
+	0x40, 0xc5, 0x37, 0x60, 0xc9, 0xb6, 0x1c, 0xe9, 0xde, 0x46, 0x5f, 0x2e, 0xa0, 0xf6, 0x55, 0xe9,
+	0x86, 0x64, 0x04, 0x79, 0x3b, 0x10, 0xb5, 0xfb, 0x97, 0x33, 0x30, 0xaf, 0xb4, 0x90, 0xdf, 0x18,
+	0x90, 0xc1, 0xae, 0x97, 0x24, 0xd9, 0x38, 0xda, 0x63, 0x17, 0xaf, 0x4c, 0x23, 0xd3, 0x38, 0xcd,
+	0x6b, 0xbf, 0xfa, 0xeb, 0x3f, 0x7f, 0x7b, 0x6a, 0x9d, 0x5c, 0xaa, 0xc6, 0x9e, 0x7c, 0xb0, 0xf3,
+	0xad, 0xbe, 0x83, 0xc7, 0xe3, 0x01, 0xf9, 0xbd, 0x01, 0x4b, 0x91, 0xc7, 0x05, 0x72, 0x2d, 0x41,
+	0xcd, 0xb8, 0x47, 0x8c, 0xe2, 0xf6, 0x6c, 0xc4, 0x88, 0x6c, 0x57, 0x21, 0xdb, 0x26, 0x5b, 0x71,
+	0x64, 0xfe, 0x3b, 0x46, 0x0c, 0xe0, 0x9f, 0x0c, 0x58, 0x3e, 0xf9, 0x4e, 0x40, 0x2a, 0x09, 0x6a,
+	0x13, 0x9e, 0x27, 0x8a, 0xd5, 0x99, 0xe9, 0x11, 0xe9, 0x75, 0x85, 0xf4, 0x55, 0xb2, 0x1b, 0x47,
+	0x7a, 0xe4, 0xf3, 0x04, 0x60, 0xc3, 0x4f, 0x1f, 0x0f, 0xc8, 0xbb, 0x06, 0x64, 0xf0, 0x45, 0x20,
+	0xd1, 0xb5, 0xd1, 0xc7, 0x86, 0x44, 0xd7, 0x9e, 0x78, 0x58, 0x30, 0xb7, 0x15, 0xac, 0x2b, 0xe4,
+	0x72, 0x1c, 0x16, 0xbe, 0x30, 0xf0, 0x90, 0xe9, 0x3e, 0x30, 0xc0, 0xcf, 0x0d, 0x64, 0xb6, 0x73,
+	0x9c, 0x08, 0xe4, 0xc4, 0x13, 0x83, 0xb9, 0xa3, 0x80, 0x5c, 0x23, 0x57, 0xe3, 0x40, 0x30, 0xff,
+	0x04, 0x38, 0xaa, 0xef, 0x1c, 0xb2, 0xe3, 0x07, 0xe4, 0x6d, 0x48, 0xef, 0xb9, 0x6d, 0x46, 0xcc,
+	0xc4, 0x90, 0x19, 0xbd, 0x4b, 0x14, 0x2f, 0x4d, 0xa4, 0x41, 0x0c, 0x57, 0x15, 0x86, 0x4b, 0xe4,
+	0xe2, 0xb8, 0x68, 0x6a, 0x47, 0x2c, 0xf1, 0x53, 0x58, 0xd0, 0x5d, 0x34, 0xb9, 0x9c, 0x20, 0x39,
+	0xd2, 0xac, 0x17, 0xd7, 0xa7, 0x50, 0x21, 0x82, 0x35, 0x85, 0xa0, 0x48, 0x0a, 0x71, 0x04, 0xba,
+	0x43, 0x27, 0x43, 0xc8, 0x60, 0x83, 0x4e, 0xd6, 0xe2, 0x32, 0xa3, 0xbd, 0x7b, 0x71, 0x63, 0xda,
+	0xd5, 0xeb, 0xeb, 0x35, 0x95, 0xde, 0x0b, 0xa4, 0x18, 0xd7, 0xcb, 0x44, 0xb7, 0xd1, 0x92, 0xea,
+	0x7e, 0x0e, 0xf9, 0x50, 0x87, 0x3d, 0x83, 0xf6, 0x31, 0x7b, 0x1e, 0xd3, 0xa2, 0x9b, 0x57, 0x94,
+	0xee, 0x35, 0x52, 0x1a, 0xa3, 0x1b, 0xc9, 0x65, 0x8a, 0x24, 0xbf, 0x34, 0x20, 0xd4, 0x28, 0xcf,
+	0xa0, 0xff, 0x6a, 0x72, 0x7a, 0x3b, 0xd1, 0xcb, 0x9b, 0xeb, 0x0a, 0x43, 0x99, 0xac, 0x8e, 0xcd,
+	0x70, 0x7e, 0x8f, 0x4f, 0x7e, 0x06, 0x19, 0x6c, 0xa2, 0x12, 0xc3, 0x3f, 0xda, 0x6f, 0x27, 0x86,
+	0xff, 0x89, 0x5e, 0x6c, 0x92, 0x03, 0x74, 0x7d, 0x2d, 0x86, 0xe4, 0x3d, 0x03, 0x20, 0xa8, 0xee,
+	0xc9, 0xe6, 0x24, 0xd1, 0xe1, 0xce, 0x2d, 0xd1, 0x10, 0xf1, 0x56, 0x61, 0x92, 0x21, 0x34, 0x0e,
+	0x55, 0x80, 0x90, 0x5f, 0x1b, 0x90, 0x1b, 0x95, 0xa1, 0x64, 0x63, 0x92, 0xfc, 0xb0, 0x47, 0x36,
+	0xa7, 0x13, 0x22, 0x8e, 0xcb, 0x0a, 0x47, 0x89, 0x5c, 0x48, 0xc2, 0xa1, 0x42, 0xf2, 0x77, 0x06,
+	0x2c, 0x86, 0x6b, 0x1e, 0xb2, 0x35, 0x25, 0xdb, 0x84, 0x6a, 0xe0, 0xe2, 0xb5, 0x99, 0x68, 0x67,
+	0x4e, 0x4f, 0x0d, 0x4f, 0x32, 0x84, 0x52, 0xc4, 0xc3, 0x00, 0x9c, 0x2a, 0x5b, 0xa6, 0x81, 0x0b,
+	0x17, 0x4d, 0xd3, 0xc0, 0x45, 0xea, 0x20, 0x73, 0x43, 0x81, 0xbb, 0x48, 0xca, 0xc9, 0xe0, 0x54,
+	0x35, 0x25, 0xe3, 0x17, 0x8b, 0x9f, 0x09, 0xf7, 0x48, 0xb8, 0x66, 0x9a, 0x70, 0x8f, 0x44, 0x6a,
+	0xa8, 0x49, 0xf1, 0xeb, 0xd7, 0x56, 0x32, 0x67, 0x62, 0xcf, 0x77, 0x39, 0x31, 0x1b, 0x87, 0x7e,
+	0xda, 0x49, 0xcc, 0x99, 0xd1, 0x9f, 0x7a, 0x26, 0xe5, 0x4c, 0xdd, 0x94, 0x92, 0x3f, 0x18, 0x70,
+	0x3a, 0x56, 0x85, 0x91, 0xa4, 0x2b, 0x3c, 0xa9, 0xa0, 0x2b, 0xbe, 0x3c, 0x3b, 0xc3, 0x74, 0xc7,
+	0x44, 0x0a, 0xbf, 0xda, 0xf5, 0x4f, 0x9f, 0x94, 0x8c, 0xcf, 0x9e, 0x94, 0x8c, 0x7f, 0x3c, 0x29,
+	0x19, 0x0f, 0x9f, 0x96, 0xe6, 0x3e, 0x7b, 0x5a, 0x9a, 0xfb, 0xdb, 0xd3, 0xd2, 0xdc, 0x8f, 0xd7,
+	0xe2, 0x4d, 0x86, 0x14, 0x32, 0x94, 0x62, 0x54, 0x8b, 0xd1, 0x5c, 0x50, 0x2d, 0xcd, 0x2b, 0xff,
+	0x0f, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x68, 0x2e, 0x8a, 0x1b, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Account queries an Ethereum account.
+	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
+	// CosmosAccount queries an Ethereum account's Cosmos Address.
+	CosmosAccount(ctx context.Context, in *QueryCosmosAccountRequest, opts ...grpc.CallOption) (*QueryCosmosAccountResponse, error)
 	// ValidatorAccount queries an Ethereum account's from a validator consensus
 	// Address.
 	ValidatorAccount(ctx context.Context, in *QueryValidatorAccountRequest, opts ...grpc.CallOption) (*QueryValidatorAccountResponse, error)
@@ -1654,11 +2123,19 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// AccessList implements the `eth_createAccessList` rpc api
+	AccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
+	// StorageRange implements the `debug_storageRangeAt` rpc api
+	StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error)
+	// StorageSlots implements the `evm_getStorageSlots` rpc api
+	StorageSlots(ctx context.Context, in *QueryStorageSlotsRequest, opts ...grpc.CallOption) (*QueryStorageSlotsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
@@ -1761,9 +2238,18 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
-func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
-	out := new(QueryTraceTxResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceTx", in, out, opts...)
+func (c *queryClient) AccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryAccessListResponse, error) {
+	out := new(QueryAccessListResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/AccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
+	out := new(QueryTraceTxResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1779,6 +2265,33 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error) {
+	out := new(QueryStorageRangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/StorageRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageSlots(ctx context.Context, in *QueryStorageSlotsRequest, opts ...grpc.CallOption) (*QueryStorageSlotsResponse, error) {
+	out := new(QueryStorageSlotsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/StorageSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/BaseFee", in, out, opts...)
@@ -1828,11 +2341,19 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// AccessList implements the `eth_createAccessList` rpc api
+	AccessList(context.Context, *EthCallRequest) (*QueryAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
 	// `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
+	// StorageRange implements the `debug_storageRangeAt` rpc api
+	StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error)
+	// StorageSlots implements the `evm_getStorageSlots` rpc api
+	StorageSlots(context.Context, *QueryStorageSlotsRequest) (*QueryStorageSlotsResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork
 	// status.
@@ -1877,12 +2398,24 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) AccessList(ctx context.Context, req *EthCallRequest) (*QueryAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccessList not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
+func (*UnimplementedQueryServer) StorageRange(ctx context.Context, req *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageRange not implemented")
+}
+func (*UnimplementedQueryServer) StorageSlots(ctx context.Context, req *QueryStorageSlotsRequest) (*QueryStorageSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageSlots not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -2059,6 +2592,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/AccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2095,6 +2646,60 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/StorageRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRange(ctx, req.(*QueryStorageRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/StorageSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageSlots(ctx, req.(*QueryStorageSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -2149,6 +2754,7 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2189,6 +2795,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "AccessList",
+			Handler:    _Query_AccessList_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2197,6 +2807,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
+		{
+			MethodName: "StorageRange",
+			Handler:    _Query_StorageRange_Handler,
+		},
+		{
+			MethodName: "StorageSlots",
+			Handler:    _Query_StorageSlots_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -2907,6 +3529,55 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAccessListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3151,7 +3822,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3161,55 +3832,81 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x48
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BaseFee != nil {
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TraceConfig != nil {
 		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3219,20 +3916,27 @@ func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3242,495 +3946,1787 @@ func (m *QueryGlobalMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MinGasPrice.Size()
-		i -= size
-		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	return n
-}
-
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Predecessors) > 0 {
+		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxResult != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxResult))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.KeyStart) > 0 {
+		i -= len(m.KeyStart)
+		copy(dAtA[i:], m.KeyStart)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KeyStart)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStorageRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCosmosAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStorageRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStorageSlotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
-	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
-	}
-	return n
+func (m *QueryStorageSlotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStorageSlotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Slots) > 0 {
+		for iNdEx := len(m.Slots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStorageSlotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryStorageSlotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStorageSlotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsResponse) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConfigRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryConfigResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *EthCallRequest) Size() (n int) {
+func (m *QueryAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Args)
+	l = len(m.Balance)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.GasCap != 0 {
-		n += 1 + sovQuery(uint64(m.GasCap))
-	}
-	l = len(m.ProposerAddress)
+	l = len(m.CodeHash)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
 	}
 	return n
 }
 
-func (m *EstimateGasResponse) Size() (n int) {
+func (m *QueryCosmosAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Gas != 0 {
-		n += 1 + sovQuery(uint64(m.Gas))
-	}
-	l = len(m.Ret)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.VmError)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTraceTxRequest) Size() (n int) {
+func (m *QueryCosmosAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Predecessors) > 0 {
-		for _, e := range m.Predecessors {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProposerAddress)
+	l = len(m.CosmosAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
 	}
-	if m.BlockMaxGas != 0 {
-		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
 	}
 	return n
 }
 
-func (m *QueryTraceTxResponse) Size() (n int) {
+func (m *QueryValidatorAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
+	l = len(m.ConsAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTraceBlockRequest) Size() (n int) {
+func (m *QueryValidatorAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProposerAddress)
+	l = len(m.AccountAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
 	}
-	if m.BlockMaxGas != 0 {
-		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
 	}
 	return n
 }
 
-func (m *QueryTraceBlockResponse) Size() (n int) {
+func (m *QueryBalanceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
+func (m *QueryBalanceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
+func (m *QueryStorageRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
+func (m *QueryStorageResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
+func (m *QueryCodeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EthCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *EstimateGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccessListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Predecessors) > 0 {
+		for _, e := range m.Predecessors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KeyStart)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxResult != 0 {
+		n += 1 + sovQuery(uint64(m.MaxResult))
+	}
+	if len(m.Predecessors) > 0 {
+		for _, e := range m.Predecessors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryStorageRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageSlotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slots) > 0 {
+		for _, e := range m.Slots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStorageSlotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ChainConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3753,12 +5749,44 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3780,7 +5808,7 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3803,17 +5831,17 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3823,27 +5851,55 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &ChainConfig{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3866,7 +5922,7 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3889,15 +5945,15 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3925,7 +5981,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3948,7 +6004,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3971,15 +6027,15 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4007,13 +6063,63 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4023,43 +6129,26 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4081,7 +6170,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4104,15 +6193,15 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4140,7 +6229,43 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4163,7 +6288,7 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4186,17 +6311,17 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4206,48 +6331,31 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.AccountNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4257,11 +6365,28 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4283,7 +6408,7 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4306,17 +6431,67 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4326,23 +6501,24 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4365,7 +6541,7 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4388,17 +6564,17 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4408,29 +6584,31 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 			}
-			m.Sequence = 0
+			m.GasCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4440,16 +6618,50 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.GasCap |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.AccountNumber = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4459,7 +6671,7 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4485,7 +6697,7 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4508,17 +6720,36 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4528,77 +6759,29 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4626,7 +6809,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4649,7 +6832,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccessListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4672,17 +6855,17 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccessListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4692,27 +6875,48 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.AccessList = append(m.AccessList, AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4740,7 +6944,7 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4763,7 +6967,7 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4786,17 +6990,17 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4806,79 +7010,33 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4888,79 +7046,33 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4970,79 +7082,48 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5070,11 +7151,11 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5101,68 +7182,15 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5172,31 +7200,31 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5206,28 +7234,30 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5249,7 +7279,7 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5272,12 +7302,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5299,7 +7363,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5322,15 +7386,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5357,65 +7421,71 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5425,31 +7495,29 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			m.GasCap = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5459,12 +7527,26 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasCap |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5498,7 +7580,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5517,6 +7599,25 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5538,7 +7639,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5561,34 +7662,15 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-			}
-			m.Gas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5615,42 +7697,10 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5673,7 +7723,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5696,17 +7746,17 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5716,33 +7766,31 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 			}
-			var msglen int
+			m.GasCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5752,31 +7800,14 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasCap |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5803,12 +7834,14 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
@@ -5827,7 +7860,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
@@ -5859,7 +7892,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
@@ -5892,7 +7925,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5926,7 +7959,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5945,7 +7978,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
 			}
@@ -5985,7 +8018,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6008,10 +8041,10 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6069,7 +8102,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6088,21 +8121,53 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyStart", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6112,29 +8177,46 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &MsgEthereumTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyStart = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResult", wireType)
+			}
+			m.MaxResult = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResult |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6161,10 +8243,8 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6345,7 +8425,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6368,17 +8448,17 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6388,25 +8468,223 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Storage = append(m.Storage, State{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageSlotsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageSlotsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageSlotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slots = append(m.Slots, &QueryStorageRequest{})
+			if err := m.Slots[len(m.Slots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageSlotsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageSlotsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageSlotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
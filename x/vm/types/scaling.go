@@ -66,3 +66,17 @@ func ConvertCoinsDenomToExtendedDenom(coins sdk.Coins) sdk.Coins {
 	}
 	return convertedCoins.Sort()
 }
+
+// ConvertEVMGasPriceToCosmosFee returns the fee, in the EVM coin denom, of a
+// Cosmos tx with the given gas limit priced at the given EVM gas price, which
+// has 18 decimals and is per unit of EVM gas. The gas limit is translated into
+// EVM gas with the gas ratio and the fee is rounded up, so that a Cosmos tx
+// can't pay less than an EVM tx consuming the same gas.
+func ConvertEVMGasPriceToCosmosFee(gasPrice sdkmath.LegacyDec, gas uint64) sdkmath.Int {
+	evmGas := GetGasRatio().CosmosToEVMGas(gas)
+	fee := gasPrice.MulInt(sdkmath.NewIntFromUint64(evmGas)).Ceil().RoundInt()
+
+	// ceil(fee / conversionFactor), the fee is already an integer
+	conversionFactor := GetEVMCoinDecimals().ConversionFactor()
+	return fee.Add(conversionFactor).SubRaw(1).Quo(conversionFactor)
+}
@@ -239,3 +239,66 @@ func TestConvertAmountTo18DecimalsBigInt(t *testing.T) {
 		}
 	}
 }
+
+func TestConvertEVMGasPriceToCosmosFee(t *testing.T) {
+	testCases := []struct {
+		name     string
+		gasPrice math.LegacyDec
+		gas      uint64
+		ratio    evmtypes.GasRatio
+		exp6dec  math.Int
+		exp18dec math.Int
+	}{
+		{
+			name:     "zero gas price",
+			gasPrice: math.LegacyZeroDec(),
+			gas:      100_000,
+			ratio:    evmtypes.DefaultGasRatio,
+			exp6dec:  math.ZeroInt(),
+			exp18dec: math.ZeroInt(),
+		},
+		{
+			name:     "1 gwei",
+			gasPrice: math.LegacyNewDec(1e9),
+			gas:      100_000,
+			ratio:    evmtypes.DefaultGasRatio,
+			exp6dec:  math.NewInt(100),
+			exp18dec: math.NewInt(1e14),
+		},
+		{
+			name:     "fractional gas price rounded up",
+			gasPrice: math.LegacyMustNewDecFromStr("0.5"),
+			gas:      3,
+			ratio:    evmtypes.DefaultGasRatio,
+			exp6dec:  math.NewInt(1),
+			exp18dec: math.NewInt(2),
+		},
+		{
+			name:     "gas translated with the gas ratio",
+			gasPrice: math.LegacyNewDec(1e12),
+			gas:      10,
+			ratio:    evmtypes.GasRatio{CosmosGas: 1, EVMGas: 3},
+			exp6dec:  math.NewInt(30),
+			exp18dec: math.NewInt(3e13),
+		},
+	}
+
+	for _, coinInfo := range []evmtypes.EvmCoinInfo{
+		testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID],
+		testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID],
+	} {
+		for _, tc := range testCases {
+			t.Run(fmt.Sprintf("%d dec - %s", coinInfo.Decimals, tc.name), func(t *testing.T) {
+				configurator := evmtypes.NewEVMConfigurator()
+				configurator.ResetTestConfig()
+				require.NoError(t, configurator.WithEVMCoinInfo(coinInfo).WithGasRatio(tc.ratio).Configure())
+				res := evmtypes.ConvertEVMGasPriceToCosmosFee(tc.gasPrice, tc.gas)
+				exp := tc.exp18dec
+				if coinInfo.Decimals == evmtypes.SixDecimals {
+					exp = tc.exp6dec
+				}
+				require.True(t, exp.Equal(res), "expected %s, got %s", exp, res)
+			})
+		}
+	}
+}
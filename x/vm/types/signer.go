@@ -0,0 +1,33 @@
+package types
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// MakeSigner returns the signer of the EVM chain config for the given block. The
+// state machine, the ante handlers and the JSON-RPC build their signers from the
+// EVM chain config, so they all use the same EIP-155 chain id.
+func MakeSigner(blockNumber *big.Int, blockTime uint64) ethtypes.Signer {
+	return ethtypes.MakeSigner(GetEthChainConfig(), blockNumber, blockTime)
+}
+
+// LatestSigner returns the most permissive signer of the EVM chain config, which
+// accepts the txs of all the tx types the chain supports.
+func LatestSigner() ethtypes.Signer {
+	return ethtypes.LatestSigner(GetEthChainConfig())
+}
+
+// TxSigner returns the signer to recover the sender of the tx without relying
+// on the EVM chain config, e.g. on the client side. For replay-protected
+// transactions, use the most permissive signer, because we assume that signers
+// are backwards-compatible with old transactions. For non-protected
+// transactions, the frontier signer is used because the latest signer will
+// reject the unprotected transactions.
+func TxSigner(tx *ethtypes.Transaction) ethtypes.Signer {
+	if tx.Protected() {
+		return ethtypes.LatestSignerForChainID(tx.ChainId())
+	}
+	return ethtypes.FrontierSigner{}
+}
@@ -1,10 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	errorsmod "cosmossdk.io/errors"
 )
@@ -48,6 +52,156 @@ func (s Storage) Copy() Storage {
 	return cpy
 }
 
+// ArchivedStorage is the record of the archived storage of a contract, which
+// is restored once all its entries are.
+type ArchivedStorage struct {
+	// Root is the merkle root of the archived storage
+	Root common.Hash
+	// Entries is the number of entries of the archived storage
+	Entries uint64
+	// Restored is the number of entries restored so far
+	Restored uint64
+}
+
+// prefixes separating the leaves of the merkle tree of a storage from its
+// inner nodes
+var (
+	storageLeafPrefix = []byte{0}
+	storageNodePrefix = []byte{1}
+)
+
+// StorageLeaf returns the leaf of a storage entry in the merkle tree of the
+// storage.
+func StorageLeaf(key, value common.Hash) common.Hash {
+	return crypto.Keccak256Hash(storageLeafPrefix, key.Bytes(), value.Bytes())
+}
+
+// storageNode returns the parent node of two nodes of the merkle tree of a
+// storage.
+func storageNode(left, right common.Hash) common.Hash {
+	return crypto.Keccak256Hash(storageNodePrefix, left.Bytes(), right.Bytes())
+}
+
+// nextStorageLevel returns the parent nodes of a level of the merkle tree of a
+// storage. The last node is carried to the next level if it has no sibling.
+func nextStorageLevel(level []common.Hash) []common.Hash {
+	next := make([]common.Hash, 0, (len(level)+1)/2)
+	for i := 0; i+1 < len(level); i += 2 {
+		next = append(next, storageNode(level[i], level[i+1]))
+	}
+	if len(level)%2 == 1 {
+		next = append(next, level[len(level)-1])
+	}
+	return next
+}
+
+// sortedLeaves returns the entries of the storage sorted by key with their
+// leaves.
+func (s Storage) sortedLeaves() (Storage, []common.Hash) {
+	sorted := s.Copy()
+	slices.SortFunc(sorted, func(a, b State) int {
+		return bytes.Compare(common.HexToHash(a.Key).Bytes(), common.HexToHash(b.Key).Bytes())
+	})
+
+	leaves := make([]common.Hash, len(sorted))
+	for i, state := range sorted {
+		leaves[i] = StorageLeaf(common.HexToHash(state.Key), common.HexToHash(state.Value))
+	}
+	return sorted, leaves
+}
+
+// Root returns the root of the binary merkle tree of the entries of the
+// storage sorted by key, or the empty hash if the storage is empty. It's the
+// root of the archived storage of a contract, against which its entries are
+// proven to restore it.
+func (s Storage) Root() common.Hash {
+	if len(s) == 0 {
+		return common.Hash{}
+	}
+
+	_, level := s.sortedLeaves()
+	for len(level) > 1 {
+		level = nextStorageLevel(level)
+	}
+	return level[0]
+}
+
+// Proofs returns the entries of the storage sorted by key with the proofs of
+// their inclusion in the root of the storage.
+func (s Storage) Proofs() []StorageProof {
+	sorted, level := s.sortedLeaves()
+	proofs := make([]StorageProof, len(sorted))
+	for i, state := range sorted {
+		proofs[i] = StorageProof{State: state, Index: uint64(i)}
+	}
+
+	// positions of the ancestors of the leaves in the current level
+	positions := make([]int, len(sorted))
+	for i := range positions {
+		positions[i] = i
+	}
+	for len(level) > 1 {
+		for i, pos := range positions {
+			if sibling := pos ^ 1; sibling < len(level) {
+				proofs[i].Siblings = append(proofs[i].Siblings, level[sibling].Hex())
+			}
+			positions[i] = pos / 2
+		}
+		level = nextStorageLevel(level)
+	}
+	return proofs
+}
+
+// Validate performs a basic validation of the StorageProof fields.
+func (p StorageProof) Validate() error {
+	if err := p.State.Validate(); err != nil {
+		return err
+	}
+
+	for _, sibling := range p.Siblings {
+		if bz, err := hexutil.Decode(sibling); err != nil || len(bz) != common.HashLength {
+			return errorsmod.Wrapf(ErrInvalidState, "invalid storage proof sibling %s", sibling)
+		}
+	}
+	return nil
+}
+
+// Verify returns an error if the proof doesn't prove the inclusion of its entry
+// at its index in a storage of the given number of entries with the given root.
+func (p StorageProof) Verify(root common.Hash, entries uint64) error {
+	if p.Index >= entries {
+		return errorsmod.Wrapf(ErrInvalidState, "storage proof index %d out of %d entries", p.Index, entries)
+	}
+
+	node := StorageLeaf(common.HexToHash(p.State.Key), common.HexToHash(p.State.Value))
+	siblings := p.Siblings
+	for pos, size := p.Index, entries; size > 1; pos, size = pos/2, (size+1)/2 {
+		if pos^1 >= size {
+			// the node is carried to the next level
+			continue
+		}
+		if len(siblings) == 0 {
+			return errorsmod.Wrapf(ErrInvalidState, "storage proof of entry %d is too short", p.Index)
+		}
+
+		sibling := common.HexToHash(siblings[0])
+		siblings = siblings[1:]
+		if pos%2 == 0 {
+			node = storageNode(node, sibling)
+		} else {
+			node = storageNode(sibling, node)
+		}
+	}
+
+	if len(siblings) != 0 {
+		return errorsmod.Wrapf(ErrInvalidState, "storage proof of entry %d is too long", p.Index)
+	}
+	if node != root {
+		return errorsmod.Wrapf(ErrInvalidState, "storage proof of entry %d doesn't match the root %s", p.Index, root.Hex())
+	}
+	return nil
+}
+
 // Validate performs a basic validation of the State fields.
 // NOTE: state value can be empty
 func (s State) Validate() error {
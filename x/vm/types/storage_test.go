@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -80,3 +82,76 @@ func TestStorageString(t *testing.T) {
 	str := "key:\"0x00000000000000000000000000000000000000000000000000000000006b6579\" value:\"0x00000000000000000000000000000000000000000000000000000076616c7565\" \n"
 	require.Equal(t, str, storage.String())
 }
+
+func TestStorageRoot(t *testing.T) {
+	key1, value1 := common.BytesToHash([]byte{1}), common.BytesToHash([]byte{1, 2, 3})
+	key2, value2 := common.BytesToHash([]byte{2}), common.BytesToHash([]byte{4, 5, 6})
+	key3, value3 := common.BytesToHash([]byte{3}), common.BytesToHash([]byte{7, 8, 9})
+	leaf1, leaf2, leaf3 := StorageLeaf(key1, value1), StorageLeaf(key2, value2), StorageLeaf(key3, value3)
+
+	storage := Storage{NewState(key1, value1), NewState(key2, value2), NewState(key3, value3)}
+	// the last node without a sibling is carried to the next level
+	expRoot := storageNode(storageNode(leaf1, leaf2), leaf3)
+	require.Equal(t, expRoot, storage.Root())
+
+	// the root doesn't depend on the order of the entries
+	require.Equal(t, expRoot, Storage{NewState(key3, value3), NewState(key1, value1), NewState(key2, value2)}.Root())
+
+	// but on all the keys and values
+	require.NotEqual(t, expRoot, Storage{NewState(key1, value1), NewState(key2, value2)}.Root())
+	require.NotEqual(t, expRoot, Storage{NewState(key1, value2), NewState(key2, value1), NewState(key3, value3)}.Root())
+	require.Equal(t, leaf1, Storage{NewState(key1, value1)}.Root())
+	require.Equal(t, common.Hash{}, Storage{}.Root())
+}
+
+func TestStorageProofs(t *testing.T) {
+	for _, entries := range []int{1, 2, 3, 5, 8, 13} {
+		storage := make(Storage, entries)
+		for i := range storage {
+			// in the reverse order of the keys
+			storage[i] = NewState(common.BigToHash(big.NewInt(int64(entries-i))), common.BigToHash(big.NewInt(int64(i+1))))
+		}
+		root := storage.Root()
+		size := uint64(entries)
+
+		proofs := storage.Proofs()
+		require.Len(t, proofs, entries)
+		for i, proof := range proofs {
+			require.Equal(t, uint64(i), proof.Index)
+			require.Equal(t, storage[entries-1-i], proof.State)
+			require.NoError(t, proof.Validate())
+			require.NoError(t, proof.Verify(root, size), "entry %d of %d", i, entries)
+
+			// the proof only holds for its entry, index and root
+			invalid := proof
+			invalid.State.Value = common.Hash{}.Hex()
+			require.ErrorIs(t, invalid.Verify(root, size), ErrInvalidState)
+			require.ErrorIs(t, proof.Verify(common.Hash{1}, size), ErrInvalidState)
+			require.ErrorIs(t, proof.Verify(root, proof.Index), ErrInvalidState)
+			if entries > 1 {
+				invalid = proof
+				invalid.Index = (proof.Index + 1) % size
+				require.ErrorIs(t, invalid.Verify(root, size), ErrInvalidState)
+
+				invalid.Index = proof.Index
+				invalid.Siblings = proof.Siblings[1:]
+				require.ErrorIs(t, invalid.Verify(root, size), ErrInvalidState)
+			}
+			invalid = proof
+			invalid.Siblings = append(slices.Clone(proof.Siblings), root.Hex())
+			require.ErrorIs(t, invalid.Verify(root, size), ErrInvalidState)
+		}
+	}
+}
+
+func TestStorageProofValidate(t *testing.T) {
+	proof := Storage{NewState(common.Hash{1}, common.Hash{2}), NewState(common.Hash{3}, common.Hash{4})}.Proofs()[0]
+	require.NoError(t, proof.Validate())
+
+	proof.Siblings = []string{"0x1234"}
+	require.ErrorIs(t, proof.Validate(), ErrInvalidState)
+
+	proof.Siblings = nil
+	proof.State.Key = ""
+	require.ErrorIs(t, proof.Validate(), ErrInvalidState)
+}
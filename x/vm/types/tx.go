@@ -35,6 +35,9 @@ func (args *EvmTxArgs) ToTxData() (TxData, error) {
 // tip price:
 //
 //	tx_priority = tip_price / priority_reduction
+//
+// The tip price is the effective gas price after the base fee, i.e. the tip
+// actually paid once the fee cap exceeding base fee + tip cap is refunded.
 func GetTxPriority(txData TxData, baseFee *big.Int) (priority int64) {
 	// calculate priority based on effective gas price
 	tipPrice := txData.EffectiveGasPrice(baseFee)
@@ -54,6 +57,18 @@ func GetTxPriority(txData TxData, baseFee *big.Int) (priority int64) {
 	return priority
 }
 
+// ClampTxPriority returns the priority raised to the floor and lowered to the
+// ceiling, a zero ceiling is no ceiling.
+func ClampTxPriority(priority, floor, ceiling int64) int64 {
+	if ceiling > 0 && priority > ceiling {
+		priority = ceiling
+	}
+	if priority < floor {
+		priority = floor
+	}
+	return priority
+}
+
 // Failed returns if the contract execution failed in vm errors
 func (m *MsgEthereumTxResponse) Failed() bool {
 	return len(m.VmError) > 0
@@ -10,6 +10,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -37,7 +39,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgEthereumTx encapsulates an Ethereum transaction as an SDK message.
 type MsgEthereumTx struct {
-	// data is inner transaction data of the Ethereum transaction
+	// data is inner transaction data of the Ethereum transaction.
+	// It's only set by legacy encoded messages, new messages carry the
+	// transaction in raw instead.
 	Data *types.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	// size is the encoded storage size of the transaction (DEPRECATED)
 	Size_ float64 `protobuf:"fixed64,2,opt,name=size,proto3" json:"-"`
@@ -48,6 +52,9 @@ type MsgEthereumTx struct {
 	// against the address derived from the signature (V, R, S) using the
 	// secp256k1 elliptic curve
 	From []byte `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	// raw is the binary (EIP-2718) encoding of the Ethereum transaction, it's
+	// decoded a single time when the message is unmarshaled
+	Raw *EthereumTx `protobuf:"bytes,6,opt,name=raw,proto3,customtype=EthereumTx" json:"raw,omitempty"`
 }
 
 func (m *MsgEthereumTx) Reset()         { *m = MsgEthereumTx{} }
@@ -535,6 +542,346 @@ func (m *MsgRegisterPreinstallsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterPreinstallsResponse proto.InternalMessageInfo
 
+// MsgRecoverStuckFunds defines a Msg for sending the funds stuck in a module
+// account to a recipient.
+type MsgRecoverStuckFunds struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// module_name is the name of the module account holding the stuck funds.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// recipient is the address of the account receiving the recovered funds.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount defines the recovered coins.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgRecoverStuckFunds) Reset()         { *m = MsgRecoverStuckFunds{} }
+func (m *MsgRecoverStuckFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverStuckFunds) ProtoMessage()    {}
+func (*MsgRecoverStuckFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{10}
+}
+func (m *MsgRecoverStuckFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverStuckFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverStuckFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverStuckFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverStuckFunds.Merge(m, src)
+}
+func (m *MsgRecoverStuckFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverStuckFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverStuckFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverStuckFunds proto.InternalMessageInfo
+
+func (m *MsgRecoverStuckFunds) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRecoverStuckFunds) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *MsgRecoverStuckFunds) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgRecoverStuckFunds) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgRecoverStuckFundsResponse defines the response structure for executing a
+// MsgRecoverStuckFunds message.
+type MsgRecoverStuckFundsResponse struct {
+}
+
+func (m *MsgRecoverStuckFundsResponse) Reset()         { *m = MsgRecoverStuckFundsResponse{} }
+func (m *MsgRecoverStuckFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverStuckFundsResponse) ProtoMessage()    {}
+func (*MsgRecoverStuckFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{11}
+}
+func (m *MsgRecoverStuckFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverStuckFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverStuckFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverStuckFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverStuckFundsResponse.Merge(m, src)
+}
+func (m *MsgRecoverStuckFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverStuckFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverStuckFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverStuckFundsResponse proto.InternalMessageInfo
+
+// MsgArchiveContractStorage defines a Msg for archiving the expired storage of
+// a contract.
+type MsgArchiveContractStorage struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the hex address of the contract.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgArchiveContractStorage) Reset()         { *m = MsgArchiveContractStorage{} }
+func (m *MsgArchiveContractStorage) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveContractStorage) ProtoMessage()    {}
+func (*MsgArchiveContractStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{12}
+}
+func (m *MsgArchiveContractStorage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArchiveContractStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveContractStorage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgArchiveContractStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveContractStorage.Merge(m, src)
+}
+func (m *MsgArchiveContractStorage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveContractStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveContractStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgArchiveContractStorage proto.InternalMessageInfo
+
+func (m *MsgArchiveContractStorage) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgArchiveContractStorage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgArchiveContractStorageResponse defines the response structure for
+// executing a MsgArchiveContractStorage message.
+type MsgArchiveContractStorageResponse struct {
+	// storage_root is the hex merkle root of the archived storage, against which
+	// the restored entries are proven.
+	StorageRoot string `protobuf:"bytes,1,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty"`
+	// entries is the number of entries of the archived storage.
+	Entries uint64 `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *MsgArchiveContractStorageResponse) Reset()         { *m = MsgArchiveContractStorageResponse{} }
+func (m *MsgArchiveContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveContractStorageResponse) ProtoMessage()    {}
+func (*MsgArchiveContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{13}
+}
+func (m *MsgArchiveContractStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArchiveContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveContractStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgArchiveContractStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveContractStorageResponse.Merge(m, src)
+}
+func (m *MsgArchiveContractStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveContractStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveContractStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgArchiveContractStorageResponse proto.InternalMessageInfo
+
+func (m *MsgArchiveContractStorageResponse) GetStorageRoot() string {
+	if m != nil {
+		return m.StorageRoot
+	}
+	return ""
+}
+
+func (m *MsgArchiveContractStorageResponse) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+// MsgRestoreContractStorage defines a Msg for restoring the archived storage
+// of a contract.
+type MsgRestoreContractStorage struct {
+	// sender is the address of the account restoring the storage.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// address is the hex address of the contract.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// entries defines the restored entries of the archived storage with the
+	// proofs of their inclusion in its root. The storage is restored once all
+	// its entries are.
+	Entries []StorageProof `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *MsgRestoreContractStorage) Reset()         { *m = MsgRestoreContractStorage{} }
+func (m *MsgRestoreContractStorage) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreContractStorage) ProtoMessage()    {}
+func (*MsgRestoreContractStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{14}
+}
+func (m *MsgRestoreContractStorage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestoreContractStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestoreContractStorage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRestoreContractStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreContractStorage.Merge(m, src)
+}
+func (m *MsgRestoreContractStorage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestoreContractStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreContractStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRestoreContractStorage proto.InternalMessageInfo
+
+func (m *MsgRestoreContractStorage) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRestoreContractStorage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRestoreContractStorage) GetEntries() []StorageProof {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// MsgRestoreContractStorageResponse defines the response structure for
+// executing a MsgRestoreContractStorage message.
+type MsgRestoreContractStorageResponse struct {
+	// remaining is the number of entries of the archived storage left to
+	// restore, the storage is restored when it's 0.
+	Remaining uint64 `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *MsgRestoreContractStorageResponse) Reset()         { *m = MsgRestoreContractStorageResponse{} }
+func (m *MsgRestoreContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRestoreContractStorageResponse) ProtoMessage()    {}
+func (*MsgRestoreContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{15}
+}
+func (m *MsgRestoreContractStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRestoreContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRestoreContractStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRestoreContractStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRestoreContractStorageResponse.Merge(m, src)
+}
+func (m *MsgRestoreContractStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRestoreContractStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRestoreContractStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRestoreContractStorageResponse proto.InternalMessageInfo
+
+func (m *MsgRestoreContractStorageResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "cosmos.evm.vm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "cosmos.evm.vm.v1.LegacyTx")
@@ -546,83 +893,113 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.vm.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgRegisterPreinstalls)(nil), "cosmos.evm.vm.v1.MsgRegisterPreinstalls")
 	proto.RegisterType((*MsgRegisterPreinstallsResponse)(nil), "cosmos.evm.vm.v1.MsgRegisterPreinstallsResponse")
+	proto.RegisterType((*MsgRecoverStuckFunds)(nil), "cosmos.evm.vm.v1.MsgRecoverStuckFunds")
+	proto.RegisterType((*MsgRecoverStuckFundsResponse)(nil), "cosmos.evm.vm.v1.MsgRecoverStuckFundsResponse")
+	proto.RegisterType((*MsgArchiveContractStorage)(nil), "cosmos.evm.vm.v1.MsgArchiveContractStorage")
+	proto.RegisterType((*MsgArchiveContractStorageResponse)(nil), "cosmos.evm.vm.v1.MsgArchiveContractStorageResponse")
+	proto.RegisterType((*MsgRestoreContractStorage)(nil), "cosmos.evm.vm.v1.MsgRestoreContractStorage")
+	proto.RegisterType((*MsgRestoreContractStorageResponse)(nil), "cosmos.evm.vm.v1.MsgRestoreContractStorageResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/tx.proto", fileDescriptor_77a8ac5e8c9c4850) }
 
 var fileDescriptor_77a8ac5e8c9c4850 = []byte{
-	// 1128 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x6b, 0x23, 0x45,
-	0x1c, 0xef, 0x26, 0x9b, 0x5f, 0x93, 0x78, 0x77, 0xee, 0xb5, 0x76, 0x1b, 0xee, 0xb2, 0xb9, 0xd5,
-	0x3b, 0x73, 0x95, 0x66, 0xbd, 0x0a, 0x42, 0xe3, 0x53, 0x73, 0x6d, 0xa5, 0xd2, 0x62, 0x59, 0x73,
-	0x2f, 0x22, 0xc4, 0xe9, 0xee, 0x74, 0xb3, 0x98, 0xdd, 0x59, 0x77, 0x26, 0x21, 0x11, 0x04, 0xb9,
-	0x27, 0xf1, 0x49, 0xf0, 0x59, 0xf0, 0xc1, 0x07, 0xf5, 0xa9, 0x0f, 0xf7, 0xe4, 0x5f, 0x70, 0xf8,
-	0x74, 0x28, 0x88, 0x88, 0xe4, 0xa4, 0x15, 0x0a, 0x7d, 0xf4, 0x2f, 0x90, 0x99, 0xd9, 0x34, 0x9b,
-	0x26, 0xfd, 0x61, 0x41, 0x21, 0x84, 0x99, 0xf9, 0x7e, 0xbe, 0xdf, 0x99, 0xef, 0xe7, 0xf3, 0xd9,
-	0xd9, 0x05, 0x0b, 0x16, 0x26, 0x1e, 0x26, 0x06, 0xea, 0x7a, 0x06, 0xfb, 0x3d, 0x30, 0x68, 0xaf,
-	0x1a, 0x84, 0x98, 0x62, 0xe5, 0x86, 0x08, 0x55, 0x51, 0xd7, 0xab, 0xb2, 0xdf, 0x83, 0xe2, 0x8b,
-	0xd0, 0x73, 0x7d, 0x6c, 0xf0, 0x7f, 0x01, 0x2a, 0x16, 0x27, 0xf2, 0x19, 0x5c, 0xc4, 0xe6, 0xa3,
-	0x98, 0x47, 0x1c, 0x16, 0xf0, 0x88, 0x13, 0x05, 0xa2, 0x4d, 0x9b, 0x7c, 0x66, 0x44, 0xdb, 0x88,
-	0xd0, 0xac, 0x83, 0x1d, 0x2c, 0xd6, 0xd9, 0x28, 0x5a, 0xbd, 0xe5, 0x60, 0xec, 0xb4, 0x91, 0x01,
-	0x03, 0xd7, 0x80, 0xbe, 0x8f, 0x29, 0xa4, 0x2e, 0xf6, 0x87, 0x39, 0x0b, 0x51, 0x94, 0xcf, 0x76,
-	0x3b, 0x7b, 0x06, 0xf4, 0xfb, 0x22, 0xa4, 0x1f, 0x4a, 0xe0, 0x85, 0x6d, 0xe2, 0xac, 0xd3, 0x16,
-	0x0a, 0x51, 0xc7, 0x6b, 0xf4, 0x94, 0x0a, 0x90, 0x6d, 0x48, 0xa1, 0x2a, 0x95, 0xa5, 0x4a, 0x7e,
-	0x79, 0xb6, 0x2a, 0x72, 0xab, 0xc3, 0xdc, 0xea, 0xaa, 0xdf, 0x37, 0x39, 0x42, 0x29, 0x01, 0x99,
-	0xb8, 0x9f, 0x20, 0x35, 0x51, 0x96, 0x2a, 0x52, 0x1d, 0x1c, 0x0f, 0x34, 0x69, 0xe9, 0xbb, 0xa3,
-	0xfd, 0x45, 0xc9, 0xe4, 0xeb, 0xca, 0x2b, 0x40, 0x6e, 0x41, 0xd2, 0x52, 0x93, 0x65, 0xa9, 0x92,
-	0xab, 0xdf, 0xf8, 0x7b, 0xa0, 0x65, 0xc2, 0x76, 0x50, 0xd3, 0x97, 0xf4, 0x08, 0xc5, 0xa2, 0xca,
-	0x6b, 0xe0, 0xba, 0x8d, 0x82, 0x10, 0x59, 0x90, 0x22, 0xbb, 0xb9, 0x17, 0x62, 0x4f, 0x95, 0x79,
-	0x42, 0x42, 0x95, 0xcc, 0x6b, 0xa3, 0xd0, 0x46, 0x88, 0x3d, 0x45, 0x01, 0x32, 0x47, 0xa4, 0xca,
-	0x52, 0xa5, 0x60, 0xf2, 0x71, 0xed, 0xce, 0xe7, 0xdf, 0x68, 0x33, 0x5f, 0x1c, 0xed, 0x2f, 0xaa,
-	0x31, 0xaa, 0xc7, 0x7a, 0xd2, 0xbf, 0x4f, 0x80, 0xec, 0x16, 0x72, 0xa0, 0xd5, 0x6f, 0xf4, 0x94,
-	0x59, 0x90, 0xf2, 0xb1, 0x6f, 0x21, 0xde, 0xa1, 0x6c, 0x8a, 0x89, 0xf2, 0x26, 0xc8, 0x39, 0x90,
-	0x31, 0xee, 0x5a, 0xa2, 0xa3, 0x5c, 0x7d, 0xe1, 0xf7, 0x81, 0x36, 0x27, 0x6a, 0x12, 0xfb, 0xa3,
-	0xaa, 0x8b, 0x0d, 0x0f, 0xd2, 0x56, 0x75, 0xd3, 0xa7, 0x66, 0xd6, 0x81, 0x64, 0x87, 0x41, 0x95,
-	0x12, 0x48, 0x3a, 0x90, 0xf0, 0x1e, 0xe5, 0x7a, 0xe1, 0x60, 0xa0, 0x65, 0xdf, 0x86, 0x64, 0xcb,
-	0xf5, 0x5c, 0x6a, 0xb2, 0x80, 0x72, 0x0d, 0x24, 0x28, 0x16, 0x1d, 0x99, 0x09, 0x8a, 0x95, 0x15,
-	0x90, 0xea, 0xc2, 0x76, 0x07, 0xf1, 0x16, 0x72, 0xf5, 0x97, 0xcf, 0xdc, 0xe3, 0x60, 0xa0, 0xa5,
-	0x57, 0x3d, 0xdc, 0xf1, 0xa9, 0x29, 0x32, 0x58, 0xf3, 0x5c, 0x99, 0xb4, 0x68, 0x9e, 0x6b, 0x50,
-	0x00, 0x52, 0x57, 0xcd, 0xf0, 0x05, 0xa9, 0xcb, 0x66, 0xa1, 0x9a, 0x15, 0xb3, 0x90, 0xcd, 0x88,
-	0x9a, 0x13, 0x33, 0x52, 0xbb, 0xc7, 0x68, 0xfa, 0xe9, 0xc9, 0x52, 0xba, 0xd1, 0x5b, 0x83, 0x14,
-	0x32, 0xc2, 0x6e, 0xc6, 0x08, 0x1b, 0xd2, 0xa3, 0x3f, 0x4f, 0x82, 0xc2, 0xaa, 0x65, 0x21, 0x42,
-	0xb6, 0x5c, 0x42, 0x1b, 0x3d, 0xe5, 0x1d, 0x90, 0xb5, 0x5a, 0xd0, 0xf5, 0x9b, 0xae, 0xcd, 0x29,
-	0xcb, 0xd5, 0x8d, 0xf3, 0x0e, 0x9d, 0x79, 0xc8, 0xc0, 0x9b, 0x6b, 0xc7, 0x03, 0x2d, 0x63, 0x89,
-	0xa1, 0x19, 0x0d, 0xec, 0x11, 0xf7, 0x89, 0x33, 0xb9, 0x4f, 0xfe, 0x6b, 0xee, 0xe5, 0xf3, 0xb9,
-	0x4f, 0x4d, 0x72, 0x9f, 0xbe, 0x32, 0xf7, 0x99, 0x18, 0xf7, 0x1f, 0x82, 0x2c, 0xe4, 0x44, 0x21,
-	0xa2, 0x66, 0xcb, 0xc9, 0x4a, 0x7e, 0xf9, 0x76, 0xf5, 0xf4, 0x95, 0x50, 0x15, 0x54, 0x36, 0x3a,
-	0x41, 0x1b, 0xd5, 0xef, 0x3e, 0x1d, 0x68, 0x33, 0xc7, 0x03, 0x0d, 0xc0, 0x13, 0x7e, 0x7f, 0x78,
-	0xae, 0x81, 0x11, 0xdb, 0xe2, 0xb9, 0x38, 0xa9, 0x2a, 0xd4, 0xcd, 0x8d, 0xa9, 0x0b, 0xc6, 0xd4,
-	0xcd, 0x0f, 0xd5, 0x5d, 0x9c, 0x54, 0x77, 0x3e, 0xa6, 0x6e, 0x5c, 0x50, 0xfd, 0x6b, 0x19, 0x14,
-	0xd6, 0xfa, 0x3e, 0xf4, 0x5c, 0x6b, 0x03, 0xa1, 0xff, 0x45, 0xe1, 0x15, 0x90, 0x67, 0x0a, 0x53,
-	0x37, 0x68, 0x5a, 0x30, 0xb8, 0x58, 0x63, 0xe6, 0x87, 0x86, 0x1b, 0x3c, 0x84, 0xc1, 0x30, 0x75,
-	0x0f, 0x21, 0x9e, 0x2a, 0x5f, 0x26, 0x75, 0x03, 0x21, 0x96, 0x1a, 0xf9, 0x23, 0x75, 0xbe, 0x3f,
-	0xd2, 0x93, 0xfe, 0xc8, 0x5c, 0xd9, 0x1f, 0xd9, 0x33, 0xfc, 0x91, 0xfb, 0xef, 0xfc, 0x01, 0xc6,
-	0xfc, 0x91, 0x1f, 0xf3, 0x47, 0xe1, 0x92, 0xfe, 0x88, 0xdb, 0x41, 0xd7, 0x41, 0x71, 0xbd, 0x47,
-	0x91, 0x4f, 0x5c, 0xec, 0xbf, 0x1b, 0xf0, 0x17, 0xc9, 0xe8, 0x2e, 0xad, 0xc9, 0xac, 0x92, 0xfe,
-	0xad, 0x04, 0xe6, 0xc6, 0xee, 0x58, 0x13, 0x91, 0x00, 0xfb, 0x84, 0x33, 0xc1, 0x6f, 0x7d, 0x6e,
-	0xa4, 0xe8, 0x8e, 0xbf, 0x0f, 0xe4, 0x36, 0x76, 0x88, 0x9a, 0xe0, 0x2c, 0xcc, 0x4d, 0xb2, 0xb0,
-	0x85, 0x1d, 0x93, 0x43, 0x94, 0x1b, 0x20, 0x19, 0x22, 0xca, 0x1d, 0x52, 0x30, 0xd9, 0x50, 0x59,
-	0x00, 0xd9, 0xae, 0xd7, 0x44, 0x61, 0x88, 0xc3, 0xe8, 0x1e, 0xcd, 0x74, 0xbd, 0x75, 0x36, 0x65,
-	0x21, 0xe6, 0x8d, 0x0e, 0x41, 0xb6, 0x50, 0xd9, 0xcc, 0x38, 0x90, 0x3c, 0x22, 0xc8, 0x8e, 0x8e,
-	0xf9, 0xa3, 0x04, 0xae, 0x6f, 0x13, 0xe7, 0x51, 0x60, 0x43, 0x8a, 0x76, 0x60, 0x08, 0x3d, 0xc2,
-	0x6e, 0x1b, 0xd8, 0xa1, 0x2d, 0x1c, 0xba, 0xb4, 0x1f, 0xd9, 0x5d, 0xfd, 0xf9, 0xc9, 0xd2, 0x6c,
-	0x74, 0xa8, 0x55, 0xdb, 0x0e, 0x11, 0x21, 0xef, 0xd1, 0xd0, 0xf5, 0x1d, 0x73, 0x04, 0x55, 0xde,
-	0x02, 0xe9, 0x80, 0x57, 0xe0, 0xd6, 0xce, 0x2f, 0xab, 0x93, 0x6d, 0x88, 0x1d, 0xea, 0x39, 0xa6,
-	0xa3, 0xd0, 0x2a, 0x4a, 0xa9, 0x2d, 0x3f, 0x3e, 0xda, 0x5f, 0x1c, 0x15, 0x63, 0xfc, 0x6b, 0x31,
-	0xfe, 0x7b, 0x86, 0x78, 0x67, 0xc5, 0x0f, 0xaa, 0x2f, 0x80, 0xf9, 0x53, 0x4b, 0x43, 0x92, 0xf5,
-	0x5f, 0x25, 0xf0, 0xd2, 0x36, 0x71, 0x4c, 0xe4, 0xb8, 0x84, 0xa2, 0x70, 0x27, 0x44, 0xae, 0x4f,
-	0x28, 0x6c, 0xb7, 0xaf, 0xde, 0xde, 0x26, 0xc8, 0x07, 0xa3, 0x32, 0x91, 0x54, 0xb7, 0xa6, 0xf4,
-	0x78, 0x02, 0x8a, 0xf7, 0x19, 0xcf, 0xad, 0xad, 0x4c, 0x36, 0x7b, 0x6f, 0x4a, 0xb3, 0x53, 0x4e,
-	0xaf, 0x97, 0x41, 0x69, 0x7a, 0x64, 0xd8, 0xfa, 0xf2, 0x1f, 0x09, 0x90, 0xdc, 0x26, 0x8e, 0xf2,
-	0x29, 0x00, 0xb1, 0xaf, 0x16, 0x6d, 0xf2, 0xa0, 0x63, 0xf6, 0x2c, 0xbe, 0x7a, 0x01, 0xe0, 0x84,
-	0xda, 0xbb, 0x8f, 0x7f, 0xf9, 0xeb, 0xab, 0x84, 0xa6, 0xdf, 0x36, 0x26, 0xbf, 0xdc, 0x22, 0x74,
-	0x93, 0xf6, 0x94, 0x0f, 0x40, 0x61, 0xcc, 0x55, 0x77, 0xa6, 0xd6, 0x8f, 0x43, 0x8a, 0xf7, 0x2f,
-	0x84, 0x9c, 0x3c, 0x44, 0x1f, 0x83, 0x9b, 0xd3, 0xb4, 0xad, 0x4c, 0xad, 0x30, 0x05, 0x59, 0x7c,
-	0xfd, 0xb2, 0xc8, 0xe1, 0x96, 0xc5, 0xd4, 0x67, 0x4c, 0xc8, 0x7a, 0xed, 0xe9, 0x41, 0x49, 0x7a,
-	0x76, 0x50, 0x92, 0xfe, 0x3c, 0x28, 0x49, 0x5f, 0x1e, 0x96, 0x66, 0x9e, 0x1d, 0x96, 0x66, 0x7e,
-	0x3b, 0x2c, 0xcd, 0xbc, 0x5f, 0x76, 0x5c, 0xda, 0xea, 0xec, 0x56, 0x2d, 0xec, 0x19, 0xa7, 0xd5,
-	0xa4, 0xfd, 0x00, 0x91, 0xdd, 0x34, 0xff, 0x48, 0x7c, 0xe3, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8a, 0x31, 0x4a, 0xe9, 0x34, 0x0b, 0x00, 0x00,
+	// 1505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6b, 0x1b, 0xc7,
+	0x17, 0xf7, 0x4a, 0xb2, 0x7e, 0x8c, 0xf4, 0xcd, 0x8f, 0x8d, 0x93, 0xac, 0x85, 0xa3, 0x95, 0xf7,
+	0x9b, 0xa4, 0x8a, 0x83, 0xa5, 0xd8, 0x81, 0x96, 0xa8, 0xbd, 0x58, 0x4e, 0x5c, 0x52, 0xec, 0xd6,
+	0x6c, 0x9c, 0x4b, 0x29, 0x28, 0xe3, 0xdd, 0xf1, 0x6a, 0xb1, 0x76, 0x67, 0xbb, 0x33, 0x52, 0xe5,
+	0x40, 0xa1, 0x04, 0x0a, 0xa5, 0xa7, 0x42, 0x6f, 0x85, 0x42, 0x0f, 0x3d, 0xb4, 0xe9, 0xc5, 0x87,
+	0x9c, 0x7a, 0xeb, 0x2d, 0xf4, 0x14, 0x5a, 0x28, 0x25, 0x05, 0xa5, 0x38, 0x05, 0x43, 0x8e, 0xfd,
+	0x0b, 0xca, 0xcc, 0x8e, 0x7e, 0x79, 0x57, 0xb1, 0x63, 0x68, 0x41, 0xd8, 0x33, 0xef, 0x7d, 0xde,
+	0x9b, 0x79, 0x9f, 0xf7, 0xe6, 0xcd, 0x2c, 0x98, 0x36, 0x30, 0x71, 0x30, 0xa9, 0xa0, 0xb6, 0x53,
+	0x61, 0xbf, 0x85, 0x0a, 0xed, 0x94, 0x3d, 0x1f, 0x53, 0x2c, 0x9f, 0x0a, 0x54, 0x65, 0xd4, 0x76,
+	0xca, 0xec, 0xb7, 0x90, 0x3f, 0x0d, 0x1d, 0xdb, 0xc5, 0x15, 0xfe, 0x37, 0x00, 0xe5, 0x0b, 0xc2,
+	0x7e, 0x13, 0x12, 0x54, 0x69, 0x2f, 0x6c, 0x22, 0x0a, 0x17, 0x2a, 0x06, 0xb6, 0x5d, 0xa1, 0xcf,
+	0x87, 0xfc, 0x33, 0x77, 0x81, 0xee, 0xbc, 0xd0, 0x39, 0xc4, 0x62, 0x0a, 0x87, 0x58, 0x42, 0x21,
+	0x36, 0x55, 0xe7, 0xb3, 0x8a, 0xd8, 0x46, 0xa0, 0x9a, 0xb2, 0xb0, 0x85, 0x03, 0x39, 0x1b, 0x09,
+	0xe9, 0x8c, 0x85, 0xb1, 0xd5, 0x44, 0x15, 0xe8, 0xd9, 0x15, 0xe8, 0xba, 0x98, 0x42, 0x6a, 0x63,
+	0xb7, 0x67, 0x33, 0x2d, 0xb4, 0x7c, 0xb6, 0xd9, 0xda, 0xaa, 0x40, 0x77, 0x27, 0x50, 0x69, 0x9f,
+	0xc6, 0xc0, 0xff, 0xd6, 0x88, 0x75, 0x8b, 0x36, 0x90, 0x8f, 0x5a, 0xce, 0x46, 0x47, 0x2e, 0x81,
+	0x84, 0x09, 0x29, 0x54, 0xa4, 0xa2, 0x54, 0xca, 0x2e, 0x4e, 0x95, 0x03, 0xdb, 0x72, 0xcf, 0xb6,
+	0xbc, 0xe4, 0xee, 0xe8, 0x1c, 0x21, 0x17, 0x40, 0x82, 0xd8, 0xf7, 0x91, 0x12, 0x2b, 0x4a, 0x25,
+	0xa9, 0x06, 0x5e, 0x74, 0x55, 0x69, 0xfe, 0xbb, 0xfd, 0xdd, 0x39, 0x49, 0xe7, 0x72, 0xf9, 0x22,
+	0x48, 0x34, 0x20, 0x69, 0x28, 0xf1, 0xa2, 0x54, 0xca, 0xd4, 0x4e, 0xfd, 0xdd, 0x55, 0x53, 0x7e,
+	0xd3, 0xab, 0x6a, 0xf3, 0x9a, 0x40, 0x31, 0xad, 0x7c, 0x15, 0x9c, 0x34, 0x91, 0xe7, 0x23, 0x03,
+	0x52, 0x64, 0xd6, 0xb7, 0x7c, 0xec, 0x28, 0x09, 0x6e, 0x10, 0x53, 0x24, 0xfd, 0xc4, 0x40, 0xb5,
+	0xe2, 0x63, 0x47, 0x96, 0x41, 0x82, 0x23, 0x26, 0x8b, 0x52, 0x29, 0xa7, 0xf3, 0xb1, 0x5c, 0x04,
+	0x71, 0x1f, 0x7e, 0xa4, 0x24, 0x99, 0xa8, 0x76, 0xe2, 0x69, 0x57, 0x05, 0x83, 0x68, 0x74, 0xa6,
+	0xaa, 0xce, 0x7e, 0xf6, 0x8d, 0x3a, 0xf1, 0xf9, 0xfe, 0xee, 0x9c, 0x32, 0x94, 0x8c, 0x91, 0xa8,
+	0xb5, 0xef, 0x63, 0x20, 0xbd, 0x8a, 0x2c, 0x68, 0xec, 0x6c, 0x74, 0xe4, 0x29, 0x30, 0xe9, 0x62,
+	0xd7, 0x40, 0x9c, 0x83, 0x84, 0x1e, 0x4c, 0xe4, 0xd7, 0x41, 0xc6, 0x82, 0x2c, 0x27, 0xb6, 0x11,
+	0xc4, 0x9c, 0xa9, 0x4d, 0x3f, 0xed, 0xaa, 0x67, 0x03, 0x9f, 0xc4, 0xdc, 0x2e, 0xdb, 0xb8, 0xe2,
+	0x40, 0xda, 0x28, 0xdf, 0x76, 0xa9, 0x9e, 0xb6, 0x20, 0x59, 0x67, 0x50, 0xb9, 0x00, 0xe2, 0x16,
+	0x24, 0x9c, 0x85, 0x44, 0x2d, 0xb7, 0xd7, 0x55, 0xd3, 0x6f, 0x43, 0xb2, 0x6a, 0x3b, 0x36, 0xd5,
+	0x99, 0x42, 0x3e, 0x01, 0x62, 0x14, 0x07, 0x31, 0xeb, 0x31, 0x8a, 0xe5, 0x1b, 0x60, 0xb2, 0x0d,
+	0x9b, 0x2d, 0xc4, 0x83, 0xcc, 0xd4, 0xfe, 0x3f, 0x76, 0x8d, 0xbd, 0xae, 0x9a, 0x5c, 0x72, 0x70,
+	0xcb, 0xa5, 0x7a, 0x60, 0xc1, 0xe8, 0xe1, 0xb9, 0x4b, 0x06, 0xf4, 0xf0, 0x2c, 0xe5, 0x80, 0xd4,
+	0x56, 0x52, 0x5c, 0x20, 0xb5, 0xd9, 0xcc, 0x57, 0xd2, 0xc1, 0xcc, 0x67, 0x33, 0xa2, 0x64, 0x82,
+	0x19, 0xa9, 0x5e, 0x66, 0x34, 0xfd, 0xfc, 0x68, 0x3e, 0xb9, 0xd1, 0xb9, 0x09, 0x29, 0x64, 0x84,
+	0x9d, 0x19, 0x22, 0xac, 0x47, 0x8f, 0xf6, 0x2c, 0x0e, 0x72, 0x4b, 0x86, 0x81, 0x08, 0x59, 0xb5,
+	0x09, 0xdd, 0xe8, 0xc8, 0xef, 0x80, 0xb4, 0xd1, 0x80, 0xb6, 0x5b, 0xb7, 0x4d, 0x4e, 0x59, 0xa6,
+	0x56, 0x79, 0xd9, 0xa6, 0x53, 0xcb, 0x0c, 0x7c, 0xfb, 0xe6, 0x8b, 0xae, 0x9a, 0x32, 0x82, 0xa1,
+	0x2e, 0x06, 0xe6, 0x80, 0xfb, 0xd8, 0x58, 0xee, 0xe3, 0xaf, 0xcc, 0x7d, 0xe2, 0xe5, 0xdc, 0x4f,
+	0x86, 0xb9, 0x4f, 0x1e, 0x9b, 0xfb, 0xd4, 0x10, 0xf7, 0xf7, 0x40, 0x1a, 0x72, 0xa2, 0x10, 0x51,
+	0xd2, 0xc5, 0x78, 0x29, 0xbb, 0x78, 0xa1, 0x7c, 0xb0, 0xa9, 0x94, 0x03, 0x2a, 0x37, 0x5a, 0x5e,
+	0x13, 0xd5, 0x2e, 0x3d, 0xee, 0xaa, 0x13, 0x2f, 0xba, 0x2a, 0x80, 0x7d, 0x7e, 0x1f, 0x3e, 0x53,
+	0xc1, 0x80, 0xed, 0xe0, 0xe4, 0xf4, 0xbd, 0x06, 0xd9, 0xcd, 0x8c, 0x64, 0x17, 0x8c, 0x64, 0x37,
+	0xdb, 0xcb, 0xee, 0x5c, 0x38, 0xbb, 0xe7, 0x87, 0xb2, 0x3b, 0x9c, 0x50, 0xed, 0xeb, 0x04, 0xc8,
+	0xdd, 0xdc, 0x71, 0xa1, 0x63, 0x1b, 0x2b, 0x08, 0xfd, 0x27, 0x19, 0xbe, 0x01, 0xb2, 0x2c, 0xc3,
+	0xd4, 0xf6, 0xea, 0x06, 0xf4, 0x0e, 0xcf, 0x31, 0xab, 0x87, 0x0d, 0xdb, 0x5b, 0x86, 0x5e, 0xcf,
+	0x74, 0x0b, 0x21, 0x6e, 0x9a, 0x38, 0x8a, 0xe9, 0x0a, 0x42, 0xcc, 0x54, 0xd4, 0xc7, 0xe4, 0xcb,
+	0xeb, 0x23, 0x19, 0xae, 0x8f, 0xd4, 0xb1, 0xeb, 0x23, 0x3d, 0xa6, 0x3e, 0x32, 0xff, 0x5e, 0x7d,
+	0x80, 0x91, 0xfa, 0xc8, 0x8e, 0xd4, 0x47, 0xee, 0x88, 0xf5, 0x31, 0x5c, 0x0e, 0x9a, 0x06, 0xf2,
+	0xb7, 0x3a, 0x14, 0xb9, 0xc4, 0xc6, 0xee, 0x7b, 0x1e, 0xbf, 0x6a, 0x06, 0xbd, 0xb4, 0x9a, 0x60,
+	0x9e, 0xb4, 0x6f, 0x25, 0x70, 0x76, 0xa4, 0xc7, 0xea, 0x88, 0x78, 0xd8, 0x25, 0x9c, 0x09, 0x7e,
+	0x2f, 0xf0, 0x42, 0x12, 0xb7, 0xc0, 0x15, 0x90, 0x68, 0x62, 0x8b, 0x28, 0x31, 0xce, 0xc2, 0xd9,
+	0x30, 0x0b, 0xab, 0xd8, 0xd2, 0x39, 0x44, 0x3e, 0x05, 0xe2, 0x3e, 0xa2, 0xbc, 0x42, 0x72, 0x3a,
+	0x1b, 0xca, 0xd3, 0x20, 0xdd, 0x76, 0xea, 0xc8, 0xf7, 0xb1, 0x2f, 0xfa, 0x68, 0xaa, 0xed, 0xdc,
+	0x62, 0x53, 0xa6, 0x62, 0xb5, 0xd1, 0x22, 0xc8, 0x0c, 0xb2, 0xac, 0xa7, 0x2c, 0x48, 0xee, 0x12,
+	0x64, 0x8a, 0x6d, 0xfe, 0x28, 0x81, 0x93, 0x6b, 0xc4, 0xba, 0xeb, 0x99, 0x90, 0xa2, 0x75, 0xe8,
+	0x43, 0x87, 0xb0, 0x6e, 0x03, 0x5b, 0xb4, 0x81, 0x7d, 0x9b, 0xee, 0x88, 0x72, 0x57, 0x7e, 0x79,
+	0x34, 0x3f, 0x25, 0x36, 0xb5, 0x64, 0x9a, 0x3e, 0x22, 0xe4, 0x0e, 0xf5, 0x6d, 0xd7, 0xd2, 0x07,
+	0x50, 0xf9, 0x4d, 0x90, 0xf4, 0xb8, 0x07, 0x5e, 0xda, 0xd9, 0x45, 0x25, 0x1c, 0x46, 0xb0, 0x42,
+	0x2d, 0xc3, 0xf2, 0x18, 0xe4, 0x4a, 0x98, 0x54, 0x17, 0x1f, 0xec, 0xef, 0xce, 0x0d, 0x9c, 0x31,
+	0xfe, 0xd5, 0x21, 0xfe, 0x3b, 0x95, 0xe0, 0xce, 0x1a, 0xde, 0xa8, 0x36, 0x0d, 0xce, 0x1f, 0x10,
+	0xf5, 0x48, 0xd6, 0x7e, 0x93, 0xc0, 0xb9, 0x35, 0x62, 0xe9, 0xc8, 0xb2, 0x09, 0x45, 0xfe, 0xba,
+	0x8f, 0x6c, 0x97, 0x50, 0xd8, 0x6c, 0x1e, 0x3f, 0xbc, 0xdb, 0x20, 0xeb, 0x0d, 0xdc, 0x88, 0x54,
+	0xcd, 0x44, 0xc4, 0xd8, 0x07, 0x0d, 0xc7, 0x39, 0x6c, 0x5b, 0xbd, 0x11, 0x0e, 0xf6, 0x72, 0x44,
+	0xb0, 0x11, 0xbb, 0xd7, 0x8a, 0xa0, 0x10, 0xad, 0xe9, 0x87, 0xfe, 0x47, 0x0c, 0x4c, 0x71, 0x88,
+	0x81, 0xdb, 0xc8, 0xbf, 0x43, 0x5b, 0xc6, 0xf6, 0x4a, 0xcb, 0x35, 0x8f, 0x1f, 0xb8, 0x0a, 0xb2,
+	0x0e, 0x36, 0x5b, 0x4d, 0x54, 0x77, 0xa1, 0x23, 0xee, 0x7e, 0x1d, 0x04, 0xa2, 0x77, 0xa1, 0xc3,
+	0xaf, 0x27, 0x1f, 0x19, 0xb6, 0x67, 0x23, 0x97, 0x2a, 0xf1, 0xc3, 0x1c, 0xf7, 0xa1, 0xf2, 0x0e,
+	0x48, 0x42, 0xde, 0x24, 0x94, 0x04, 0x27, 0x73, 0xba, 0x47, 0x26, 0x7b, 0x4d, 0x96, 0xc5, 0x6b,
+	0xb2, 0xbc, 0x8c, 0x6d, 0xb7, 0xb6, 0xc2, 0x98, 0x7c, 0xf8, 0x4c, 0x2d, 0x59, 0x36, 0x6d, 0xb4,
+	0x36, 0xcb, 0x06, 0x76, 0xc4, 0xc3, 0x50, 0xfc, 0x9b, 0x27, 0xe6, 0x76, 0x85, 0xee, 0x78, 0x88,
+	0x70, 0x03, 0xf2, 0xd5, 0xfe, 0xee, 0x5c, 0xae, 0xc9, 0xef, 0xec, 0x3a, 0x7b, 0x8f, 0x12, 0x51,
+	0x6e, 0xc1, 0x82, 0xd5, 0x37, 0xc2, 0x19, 0xb8, 0x18, 0x99, 0x81, 0x03, 0x24, 0x6a, 0x05, 0x30,
+	0x13, 0x25, 0xef, 0xb3, 0xff, 0x83, 0x04, 0xa6, 0xd7, 0x88, 0xb5, 0xe4, 0x1b, 0x0d, 0xbb, 0x8d,
+	0x96, 0xb1, 0x4b, 0x7d, 0x68, 0xd0, 0x3b, 0x14, 0xfb, 0xd0, 0x42, 0xc7, 0x4e, 0x81, 0x02, 0x52,
+	0x30, 0xd0, 0x09, 0xfa, 0x7b, 0xd3, 0xea, 0x5b, 0xe1, 0x40, 0xae, 0x44, 0x04, 0x12, 0xbd, 0x1f,
+	0xed, 0x1e, 0x98, 0x1d, 0xab, 0xec, 0x37, 0xac, 0x59, 0x90, 0x23, 0x81, 0xa8, 0xee, 0x63, 0x4c,
+	0x45, 0xe3, 0xca, 0x0a, 0x99, 0x8e, 0x31, 0x65, 0xfb, 0x43, 0x2e, 0xf5, 0x6d, 0x44, 0xc4, 0xb5,
+	0xd6, 0x9b, 0x6a, 0xfb, 0x01, 0x1f, 0x3a, 0x62, 0xf0, 0x10, 0x1f, 0xd7, 0x40, 0x92, 0x20, 0xd7,
+	0x44, 0xfe, 0xa1, 0x64, 0x08, 0xdc, 0x78, 0x26, 0xe4, 0xe5, 0xc1, 0x1e, 0xe2, 0xbc, 0x9c, 0x0a,
+	0xe1, 0xb3, 0x29, 0xd6, 0x5d, 0xf7, 0x31, 0xde, 0x1a, 0x3e, 0x9d, 0x3d, 0xcb, 0xe0, 0x64, 0x8a,
+	0xb5, 0xc6, 0x71, 0x19, 0x1d, 0x8b, 0xb6, 0x04, 0x66, 0xc7, 0x2a, 0xfb, 0x5c, 0xce, 0xb0, 0xa3,
+	0xe2, 0x40, 0xdb, 0xb5, 0x5d, 0x4b, 0xbc, 0xaf, 0x07, 0x82, 0xc5, 0x9f, 0x26, 0x41, 0x7c, 0x8d,
+	0x58, 0xf2, 0xc7, 0x60, 0xe8, 0x11, 0x2f, 0xab, 0xe1, 0x38, 0x46, 0x6e, 0x96, 0xfc, 0x6b, 0x87,
+	0x00, 0xfa, 0xc5, 0x79, 0xe9, 0xc1, 0xaf, 0x7f, 0x7d, 0x19, 0x53, 0xb5, 0x0b, 0x95, 0xf0, 0x67,
+	0x99, 0x40, 0xd7, 0x69, 0x47, 0xfe, 0x00, 0xe4, 0x46, 0x2e, 0x84, 0xd9, 0x48, 0xff, 0xc3, 0x90,
+	0xfc, 0x95, 0x43, 0x21, 0x7d, 0x0a, 0x3e, 0x04, 0x67, 0xa2, 0xda, 0x72, 0x29, 0xd2, 0x43, 0x04,
+	0x32, 0x7f, 0xed, 0xa8, 0xc8, 0xfe, 0x92, 0xdb, 0xe0, 0x74, 0xb8, 0x1d, 0x5e, 0x1e, 0xe3, 0xe6,
+	0x00, 0x2e, 0x5f, 0x3e, 0x1a, 0xae, 0xbf, 0xd8, 0x7d, 0x70, 0x6e, 0xcc, 0xe9, 0xbf, 0x1a, 0xe9,
+	0x29, 0x1a, 0x9c, 0xbf, 0xfe, 0x0a, 0xe0, 0xe1, 0xb5, 0xc7, 0x9c, 0xb4, 0xab, 0x63, 0xa2, 0x88,
+	0x02, 0xe7, 0xaf, 0xbf, 0x02, 0xb8, 0xb7, 0x76, 0x7e, 0xf2, 0x13, 0x76, 0x94, 0x6a, 0xd5, 0xc7,
+	0x7b, 0x05, 0xe9, 0xc9, 0x5e, 0x41, 0xfa, 0x73, 0xaf, 0x20, 0x7d, 0xf1, 0xbc, 0x30, 0xf1, 0xe4,
+	0x79, 0x61, 0xe2, 0xf7, 0xe7, 0x85, 0x89, 0xf7, 0x8b, 0xe1, 0xde, 0xdd, 0x3f, 0x56, 0xbc, 0x73,
+	0x6f, 0x26, 0xf9, 0x67, 0xf6, 0xf5, 0x7f, 0x06, 0x00, 0xe4, 0xe6, 0xaa, 0x83, 0x96, 0x10, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -647,6 +1024,18 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// RecoverStuckFunds defines a governance operation for sending the funds
+	// stuck in a module account, e.g. sent to its address by an EVM value
+	// transfer, to a recipient. The authority is the same as is used for Params
+	// updates.
+	RecoverStuckFunds(ctx context.Context, in *MsgRecoverStuckFunds, opts ...grpc.CallOption) (*MsgRecoverStuckFundsResponse, error)
+	// ArchiveContractStorage defines a governance operation archiving the
+	// storage of a contract which hasn't been accessed for the storage expiry
+	// blocks.
+	ArchiveContractStorage(ctx context.Context, in *MsgArchiveContractStorage, opts ...grpc.CallOption) (*MsgArchiveContractStorageResponse, error)
+	// RestoreContractStorage defines a method restoring entries of the archived
+	// storage of a contract with the proofs of their inclusion in its root.
+	RestoreContractStorage(ctx context.Context, in *MsgRestoreContractStorage, opts ...grpc.CallOption) (*MsgRestoreContractStorageResponse, error)
 }
 
 type msgClient struct {
@@ -684,6 +1073,33 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) RecoverStuckFunds(ctx context.Context, in *MsgRecoverStuckFunds, opts ...grpc.CallOption) (*MsgRecoverStuckFundsResponse, error) {
+	out := new(MsgRecoverStuckFundsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Msg/RecoverStuckFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ArchiveContractStorage(ctx context.Context, in *MsgArchiveContractStorage, opts ...grpc.CallOption) (*MsgArchiveContractStorageResponse, error) {
+	out := new(MsgArchiveContractStorageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Msg/ArchiveContractStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RestoreContractStorage(ctx context.Context, in *MsgRestoreContractStorage, opts ...grpc.CallOption) (*MsgRestoreContractStorageResponse, error) {
+	out := new(MsgRestoreContractStorageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Msg/RestoreContractStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -696,6 +1112,18 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// RecoverStuckFunds defines a governance operation for sending the funds
+	// stuck in a module account, e.g. sent to its address by an EVM value
+	// transfer, to a recipient. The authority is the same as is used for Params
+	// updates.
+	RecoverStuckFunds(context.Context, *MsgRecoverStuckFunds) (*MsgRecoverStuckFundsResponse, error)
+	// ArchiveContractStorage defines a governance operation archiving the
+	// storage of a contract which hasn't been accessed for the storage expiry
+	// blocks.
+	ArchiveContractStorage(context.Context, *MsgArchiveContractStorage) (*MsgArchiveContractStorageResponse, error)
+	// RestoreContractStorage defines a method restoring entries of the archived
+	// storage of a contract with the proofs of their inclusion in its root.
+	RestoreContractStorage(context.Context, *MsgRestoreContractStorage) (*MsgRestoreContractStorageResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -711,6 +1139,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) RegisterPreinstalls(ctx context.Context, req *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (*UnimplementedMsgServer) RecoverStuckFunds(ctx context.Context, req *MsgRecoverStuckFunds) (*MsgRecoverStuckFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverStuckFunds not implemented")
+}
+func (*UnimplementedMsgServer) ArchiveContractStorage(ctx context.Context, req *MsgArchiveContractStorage) (*MsgArchiveContractStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveContractStorage not implemented")
+}
+func (*UnimplementedMsgServer) RestoreContractStorage(ctx context.Context, req *MsgRestoreContractStorage) (*MsgRestoreContractStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreContractStorage not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -770,6 +1207,61 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RecoverStuckFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverStuckFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverStuckFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Msg/RecoverStuckFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverStuckFunds(ctx, req.(*MsgRecoverStuckFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ArchiveContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArchiveContractStorage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Msg/ArchiveContractStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveContractStorage(ctx, req.(*MsgArchiveContractStorage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RestoreContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestoreContractStorage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RestoreContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Msg/RestoreContractStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestoreContractStorage(ctx, req.(*MsgRestoreContractStorage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -786,6 +1278,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "RecoverStuckFunds",
+			Handler:    _Msg_RecoverStuckFunds_Handler,
+		},
+		{
+			MethodName: "ArchiveContractStorage",
+			Handler:    _Msg_ArchiveContractStorage_Handler,
+		},
+		{
+			MethodName: "RestoreContractStorage",
+			Handler:    _Msg_RestoreContractStorage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
@@ -811,6 +1315,18 @@ func (m *MsgEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Raw != nil {
+		{
+			size := m.Raw.Size()
+			i -= size
+			if _, err := m.Raw.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
 		copy(dAtA[i:], m.From)
@@ -1409,6 +1925,238 @@ func (m *MsgRegisterPreinstallsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRecoverStuckFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverStuckFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverStuckFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverStuckFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverStuckFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverStuckFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveContractStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveContractStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveContractStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveContractStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveContractStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveContractStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Entries != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StorageRoot) > 0 {
+		i -= len(m.StorageRoot)
+		copy(dAtA[i:], m.StorageRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestoreContractStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreContractStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreContractStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRestoreContractStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRestoreContractStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRestoreContractStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1445,6 +2193,10 @@ func (m *MsgEthereumTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Raw != nil {
+		l = m.Raw.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1692,48 +2444,152 @@ func (m *MsgRegisterPreinstallsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRecoverStuckFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRecoverStuckFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+
+func (m *MsgArchiveContractStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgArchiveContractStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Entries != 0 {
+		n += 1 + sovTx(uint64(m.Entries))
+	}
+	return n
+}
+
+func (m *MsgRestoreContractStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRestoreContractStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		n += 1 + sovTx(uint64(m.Remaining))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowTx
 				}
 				if iNdEx >= l {
@@ -1872,6 +2728,41 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 				m.From = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v EthereumTx
+			m.Raw = &v
+			if err := m.Raw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3000,9 +3891,541 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPreinstalls: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPreinstalls: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preinstalls", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3012,24 +4435,24 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
-			if m.S == nil {
-				m.S = []byte{}
+			m.Preinstalls = append(m.Preinstalls, Preinstall{})
+			if err := m.Preinstalls[len(m.Preinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3053,7 +4476,7 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterPreinstallsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3076,10 +4499,10 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3103,7 +4526,7 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverStuckFunds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3126,15 +4549,15 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverStuckFunds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverStuckFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3162,13 +4585,13 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3178,31 +4601,29 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3212,31 +4633,29 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3246,43 +4665,76 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverStuckFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverStuckFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverStuckFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3304,7 +4756,7 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgArchiveContractStorage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3327,10 +4779,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgArchiveContractStorage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgArchiveContractStorage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3367,9 +4819,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3379,24 +4831,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3419,7 +4870,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgArchiveContractStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3442,12 +4893,63 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgArchiveContractStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgArchiveContractStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			m.Entries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3469,7 +4971,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreContractStorage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3492,15 +4994,15 @@ func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPreinstalls: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreContractStorage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPreinstalls: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreContractStorage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3528,11 +5030,43 @@ func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preinstalls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3559,8 +5093,8 @@ func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Preinstalls = append(m.Preinstalls, Preinstall{})
-			if err := m.Preinstalls[len(m.Preinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, StorageProof{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3585,7 +5119,7 @@ func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPreinstallsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRestoreContractStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3608,12 +5142,31 @@ func (m *MsgRegisterPreinstallsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRestoreContractStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRestoreContractStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -34,6 +34,12 @@ func IsEmptyCodeHash(bz []byte) bool {
 	return bytes.Equal(bz, EmptyCodeHash)
 }
 
+// PrevRandao returns the PREVRANDAO value of the block with the given CometBFT
+// hash, which is also returned as the mixHash of the block by the JSON-RPC.
+func PrevRandao(blockHash []byte) common.Hash {
+	return crypto.Keccak256Hash(blockHash)
+}
+
 // DecodeTxResponse decodes an protobuf-encoded byte slice into TxResponse
 func DecodeTxResponse(in []byte) (*MsgEthereumTxResponse, error) {
 	var txMsgData sdk.TxMsgData
@@ -53,6 +59,31 @@ func DecodeTxResponse(in []byte) (*MsgEthereumTxResponse, error) {
 	return &res, nil
 }
 
+// DecodeTxResponses decodes an protobuf-encoded byte slice into the TxResponses of
+// all the eth msgs of the tx. The responses of the other msgs are skipped.
+func DecodeTxResponses(in []byte) ([]*MsgEthereumTxResponse, error) {
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(in, &txMsgData); err != nil {
+		return nil, err
+	}
+
+	responseTypeURL := sdk.MsgTypeURL(&MsgEthereumTxResponse{})
+	responses := make([]*MsgEthereumTxResponse, 0, len(txMsgData.MsgResponses))
+	for _, msgResponse := range txMsgData.MsgResponses {
+		if msgResponse.TypeUrl != responseTypeURL {
+			continue
+		}
+
+		var res MsgEthereumTxResponse
+		if err := proto.Unmarshal(msgResponse.Value, &res); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to unmarshal tx response message data")
+		}
+		responses = append(responses, &res)
+	}
+
+	return responses, nil
+}
+
 // EncodeTransactionLogs encodes TransactionLogs slice into a protobuf-encoded byte slice.
 func EncodeTransactionLogs(res *TransactionLogs) ([]byte, error) {
 	return proto.Marshal(res)
@@ -100,7 +131,7 @@ func UnpackEthMsg(msg sdk.Msg) (
 		return nil, nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*MsgEthereumTx)(nil))
 	}
 
-	txData, err = UnpackTxData(msgEthTx.Data)
+	txData, err = msgEthTx.GetTxData()
 	if err != nil {
 		return nil, nil, errorsmod.Wrap(err, "failed to unpack tx data any for tx")
 	}
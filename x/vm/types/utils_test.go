@@ -17,6 +17,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestEvmDataEncoding(t *testing.T) {
@@ -46,6 +47,41 @@ func TestEvmDataEncoding(t *testing.T) {
 	require.Equal(t, ret, res.Ret)
 }
 
+func TestDecodeTxResponses(t *testing.T) {
+	responses := []*evmtypes.MsgEthereumTxResponse{
+		{
+			Hash: common.BytesToHash([]byte("hash1")).String(),
+			Logs: []*evmtypes.Log{{Data: []byte{1, 2}, BlockNumber: 17}},
+		},
+		{
+			Hash: common.BytesToHash([]byte("hash2")).String(),
+		},
+	}
+
+	txData := &sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{
+			codectypes.UnsafePackAny(responses[0]),
+			codectypes.UnsafePackAny(&banktypes.MsgSendResponse{}),
+			codectypes.UnsafePackAny(responses[1]),
+		},
+	}
+
+	txDataBz, err := proto.Marshal(txData)
+	require.NoError(t, err)
+
+	res, err := evmtypes.DecodeTxResponses(txDataBz)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	for i := range responses {
+		require.Equal(t, responses[i].Hash, res[i].Hash)
+		require.Equal(t, responses[i].Logs, res[i].Logs)
+	}
+
+	res, err = evmtypes.DecodeTxResponses(nil)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
 func TestUnwrapEthererumMsg(t *testing.T) {
 	chainID := big.NewInt(1)
 	_, err := evmtypes.UnwrapEthereumMsg(nil, common.Hash{})
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTxWitnessEvent returns the event carrying the JSON encoded witness of the
+// given eth tx.
+func NewTxWitnessEvent(txHash common.Hash, witness *cosmosevmtypes.TxWitness) (sdk.Event, error) {
+	bz, err := json.Marshal(witness)
+	if err != nil {
+		return sdk.Event{}, err
+	}
+	return sdk.NewEvent(
+		EventTypeTxWitness,
+		sdk.NewAttribute(AttributeKeyEthereumTxHash, txHash.Hex()),
+		sdk.NewAttribute(AttributeKeyTxWitness, string(bz)),
+	), nil
+}
+
+// NewModifiedAccountsEvent returns the event carrying the JSON encoded list of
+// the accounts modified by the given eth tx, sorted and without duplicates.
+func NewModifiedAccountsEvent(txHash common.Hash, accounts []common.Address) (sdk.Event, error) {
+	accounts = slices.Clone(accounts)
+	slices.SortFunc(accounts, func(a, b common.Address) int { return a.Cmp(b) })
+	bz, err := json.Marshal(slices.Compact(accounts))
+	if err != nil {
+		return sdk.Event{}, err
+	}
+	return sdk.NewEvent(
+		EventTypeModifiedAccounts,
+		sdk.NewAttribute(AttributeKeyEthereumTxHash, txHash.Hex()),
+		sdk.NewAttribute(AttributeKeyModifiedAccounts, string(bz)),
+	), nil
+}
@@ -38,6 +38,16 @@ func (w FeeMarketWrapper) GetBaseFee(ctx sdk.Context) *big.Int {
 	return types.ConvertAmountTo18DecimalsLegacy(baseFee).TruncateInt().BigInt()
 }
 
+// GetBlockBaseFee returns the base fee of the block converted to 18 decimals,
+// from the fee market params cached once per block.
+func (w FeeMarketWrapper) GetBlockBaseFee(ctx sdk.Context) *big.Int {
+	params := w.FeeMarketKeeper.GetBlockParams(ctx)
+	if params.NoBaseFee || params.BaseFee.IsNil() {
+		return nil
+	}
+	return types.ConvertAmountTo18DecimalsLegacy(params.BaseFee).TruncateInt().BigInt()
+}
+
 // CalculateBaseFee returns the calculated base fee converted to 18 decimals.
 func (w FeeMarketWrapper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	baseFee := w.FeeMarketKeeper.CalculateBaseFee(ctx)
@@ -49,7 +59,16 @@ func (w FeeMarketWrapper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 
 // GetParams returns the params with associated fees values converted to 18 decimals.
 func (w FeeMarketWrapper) GetParams(ctx sdk.Context) feemarkettypes.Params {
-	params := w.FeeMarketKeeper.GetParams(ctx)
+	return convertParamsTo18Decimals(w.FeeMarketKeeper.GetParams(ctx))
+}
+
+// GetBlockParams returns the params of the block, cached once per block, with
+// associated fees values converted to 18 decimals.
+func (w FeeMarketWrapper) GetBlockParams(ctx sdk.Context) feemarkettypes.Params {
+	return convertParamsTo18Decimals(w.FeeMarketKeeper.GetBlockParams(ctx))
+}
+
+func convertParamsTo18Decimals(params feemarkettypes.Params) feemarkettypes.Params {
 	if !params.BaseFee.IsNil() {
 		params.BaseFee = types.ConvertAmountTo18DecimalsLegacy(params.BaseFee)
 	}
@@ -72,6 +72,20 @@ func (mr *MockFeeMarketKeeperMockRecorder) GetBaseFee(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockFeeMarketKeeper)(nil).GetBaseFee), ctx)
 }
 
+// GetBlockParams mocks base method.
+func (m *MockFeeMarketKeeper) GetBlockParams(ctx types.Context) types3.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockParams", ctx)
+	ret0, _ := ret[0].(types3.Params)
+	return ret0
+}
+
+// GetBlockParams indicates an expected call of GetBlockParams.
+func (mr *MockFeeMarketKeeperMockRecorder) GetBlockParams(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockParams", reflect.TypeOf((*MockFeeMarketKeeper)(nil).GetBlockParams), ctx)
+}
+
 // GetParams mocks base method.
 func (m *MockFeeMarketKeeper) GetParams(ctx types.Context) types3.Params {
 	m.ctrl.T.Helper()
@@ -166,6 +180,20 @@ func (mr *MockBankWrapperMockRecorder) GetBalance(ctx, addr, denom any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankWrapper)(nil).GetBalance), ctx, addr, denom)
 }
 
+// GetSupply mocks base method.
+func (m *MockBankWrapper) GetSupply(ctx context.Context, denom string) types.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupply", ctx, denom)
+	ret0, _ := ret[0].(types.Coin)
+	return ret0
+}
+
+// GetSupply indicates an expected call of GetSupply.
+func (mr *MockBankWrapperMockRecorder) GetSupply(ctx, denom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockBankWrapper)(nil).GetSupply), ctx, denom)
+}
+
 // IsSendEnabledCoins mocks base method.
 func (m *MockBankWrapper) IsSendEnabledCoins(ctx context.Context, coins ...types.Coin) error {
 	m.ctrl.T.Helper()
@@ -185,6 +213,18 @@ func (mr *MockBankWrapperMockRecorder) IsSendEnabledCoins(ctx any, coins ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSendEnabledCoins", reflect.TypeOf((*MockBankWrapper)(nil).IsSendEnabledCoins), varargs...)
 }
 
+// IterateAccountBalances mocks base method.
+func (m *MockBankWrapper) IterateAccountBalances(ctx context.Context, account types.AccAddress, cb func(types.Coin) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateAccountBalances", ctx, account, cb)
+}
+
+// IterateAccountBalances indicates an expected call of IterateAccountBalances.
+func (mr *MockBankWrapperMockRecorder) IterateAccountBalances(ctx, account, cb any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAccountBalances", reflect.TypeOf((*MockBankWrapper)(nil).IterateAccountBalances), ctx, account, cb)
+}
+
 // MintAmountToAccount mocks base method.
 func (m *MockBankWrapper) MintAmountToAccount(ctx context.Context, recipientAddr types.AccAddress, amt *big.Int) error {
 	m.ctrl.T.Helper()